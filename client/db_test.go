@@ -354,6 +354,8 @@ func TestCommonMethods(t *testing.T) {
 		key{txnType, "DebugName"}:            {},
 		key{txnType, "InternalSetPriority"}:  {},
 		key{txnType, "NewBatch"}:             {},
+		key{txnType, "Proto"}:                {},
+		key{txnType, "Rollback"}:             {},
 		key{txnType, "Run"}:                  {},
 		key{txnType, "SetDebugName"}:         {},
 		key{txnType, "SetSnapshotIsolation"}: {},
@@ -85,6 +85,19 @@ func newTxn(db DB, depth int) *Txn {
 	return txn
 }
 
+// NewTxn returns a new transaction which is not run in a retry loop and needs
+// to be ended explicitly by Commit or Rollback. If txnProto is non-nil the
+// transaction resumes from the state it describes, which allows a transaction
+// to span several requests to a server (see Txn.Proto).
+func NewTxn(db DB, txnProto *proto.Transaction) *Txn {
+	txn := newTxn(db, 1 /* depth */)
+	if txnProto != nil {
+		txn.txn = *txnProto
+		txn.haveTxnWrite = txnProto.Writing
+	}
+	return txn
+}
+
 // SetDebugName sets the debug name associated with the transaction which will
 // appear in log files and the web UI. Each transaction starts out with an
 // automatically assigned debug name composed of the file and line number where
@@ -116,6 +129,12 @@ func (txn *Txn) SetSnapshotIsolation() {
 	txn.txn.Isolation = proto.SNAPSHOT
 }
 
+// Proto returns a copy of the state of the transaction from which it can be
+// resumed using NewTxn.
+func (txn *Txn) Proto() *proto.Transaction {
+	return gogoproto.Clone(&txn.txn).(*proto.Transaction)
+}
+
 // InternalSetPriority sets the transaction priority. It is intended for
 // internal (testing) use only.
 func (txn *Txn) InternalSetPriority(priority int32) {
@@ -278,6 +297,10 @@ func (txn *Txn) Run(b *Batch) error {
 // efficient than relying on the implicit commit performed when the transaction
 // function returns without error.
 func (txn *Txn) Commit(b *Batch) error {
+	if len(b.Results) == 0 && !txn.haveTxnWrite {
+		// A transaction which hasn't written anything has no state to commit.
+		return nil
+	}
 	args := &proto.EndTransactionRequest{Commit: true}
 	reply := &proto.EndTransactionResponse{}
 	b.calls = append(b.calls, proto.Call{Args: args, Reply: reply})
@@ -285,6 +308,20 @@ func (txn *Txn) Commit(b *Batch) error {
 	return txn.Run(b)
 }
 
+// Rollback aborts the transaction, discarding any writes it performed. It is
+// only needed for transactions created with NewTxn as the transaction function
+// passed to DB.Txn aborts by returning an error.
+func (txn *Txn) Rollback() error {
+	if !txn.haveTxnWrite {
+		// A transaction which hasn't written anything has no state to clean up.
+		return nil
+	}
+	return txn.send(proto.Call{
+		Args:  &proto.EndTransactionRequest{Commit: false},
+		Reply: &proto.EndTransactionResponse{},
+	})
+}
+
 func (txn *Txn) exec(retryable func(txn *Txn) error) (err error) {
 	// Run retryable in a retry loop until we encounter a success or
 	// error condition this loop isn't capable of handling.
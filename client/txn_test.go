@@ -249,6 +249,119 @@ func TestAbortMutatingTransaction(t *testing.T) {
 	}
 }
 
+// TestTxnResumeFromProto verifies that a transaction created with NewTxn
+// from the state returned by Proto continues the original transaction,
+// including the knowledge that it has written and must be ended.
+func TestTxnResumeFromProto(t *testing.T) {
+	defer leaktest.AfterTest(t)
+	var calls []proto.Method
+	var txnIDs [][]byte
+	db := newDB(newTestSender(func(call proto.Call) {
+		calls = append(calls, call.Method())
+		txnIDs = append(txnIDs, call.Args.Header().Txn.ID)
+		if proto.IsTransactionWrite(call.Args) {
+			// Mimic the coordinator which marks the transaction as writing.
+			call.Reply.Header().Txn.Writing = true
+		}
+	}))
+
+	txn := NewTxn(*db, nil)
+	// Read first so that the writing flag is picked up by updating the
+	// existing transaction state rather than by copying the first reply.
+	if _, err := txn.Get("a"); err != nil {
+		t.Fatal(err)
+	}
+	if err := txn.Put("a", "b"); err != nil {
+		t.Fatal(err)
+	}
+	txnProto := txn.Proto()
+	if !txnProto.Writing {
+		t.Fatalf("expected transaction to be writing: %s", txnProto)
+	}
+	// Proto returns a copy which doesn't alias the state of the transaction.
+	txnProto.Name = "foo"
+	if txn.txn.Name == txnProto.Name {
+		t.Errorf("expected Proto to return a copy")
+	}
+
+	if err := NewTxn(*db, txnProto).Commit(&Batch{}); err != nil {
+		t.Fatal(err)
+	}
+	expectedCalls := []proto.Method{proto.Get, proto.Put, proto.EndTransaction}
+	if !reflect.DeepEqual(expectedCalls, calls) {
+		t.Errorf("expected %s, got %s", expectedCalls, calls)
+	}
+	if !proto.TxnIDEqual(txnIDs[1], txnIDs[2]) {
+		t.Errorf("expected resumed transaction to have ID %q; got %q", txnIDs[1], txnIDs[2])
+	}
+}
+
+// TestCommitReadOnlyNewTxn verifies that committing a read-only
+// transaction created with NewTxn doesn't send EndTransaction.
+func TestCommitReadOnlyNewTxn(t *testing.T) {
+	defer leaktest.AfterTest(t)
+	var calls []proto.Method
+	db := newDB(newTestSender(func(call proto.Call) {
+		calls = append(calls, call.Method())
+	}))
+	txn := NewTxn(*db, nil)
+	if _, err := txn.Get("a"); err != nil {
+		t.Fatal(err)
+	}
+	if err := txn.Commit(&Batch{}); err != nil {
+		t.Fatal(err)
+	}
+	expectedCalls := []proto.Method{proto.Get}
+	if !reflect.DeepEqual(expectedCalls, calls) {
+		t.Errorf("expected %s, got %s", expectedCalls, calls)
+	}
+}
+
+// TestRollbackMutatingTransaction verifies that Rollback aborts a
+// transaction which has written.
+func TestRollbackMutatingTransaction(t *testing.T) {
+	defer leaktest.AfterTest(t)
+	var calls []proto.Method
+	db := newDB(newTestSender(func(call proto.Call) {
+		calls = append(calls, call.Method())
+		if et, ok := call.Args.(*proto.EndTransactionRequest); ok && et.Commit {
+			t.Errorf("expected commit to be false; got %t", et.Commit)
+		}
+	}))
+	txn := NewTxn(*db, nil)
+	if err := txn.Put("a", "b"); err != nil {
+		t.Fatal(err)
+	}
+	if err := txn.Rollback(); err != nil {
+		t.Fatal(err)
+	}
+	expectedCalls := []proto.Method{proto.Put, proto.EndTransaction}
+	if !reflect.DeepEqual(expectedCalls, calls) {
+		t.Errorf("expected %s, got %s", expectedCalls, calls)
+	}
+}
+
+// TestRollbackReadOnlyTransaction verifies that Rollback of a
+// transaction which hasn't written doesn't send EndTransaction.
+func TestRollbackReadOnlyTransaction(t *testing.T) {
+	defer leaktest.AfterTest(t)
+	var calls []proto.Method
+	db := newDB(newTestSender(func(call proto.Call) {
+		calls = append(calls, call.Method())
+	}))
+	txn := NewTxn(*db, nil)
+	if _, err := txn.Get("a"); err != nil {
+		t.Fatal(err)
+	}
+	if err := txn.Rollback(); err != nil {
+		t.Fatal(err)
+	}
+	expectedCalls := []proto.Method{proto.Get}
+	if !reflect.DeepEqual(expectedCalls, calls) {
+		t.Errorf("expected %s, got %s", expectedCalls, calls)
+	}
+}
+
 // TestRunTransactionRetryOnErrors verifies that the transaction
 // is retried on the correct errors.
 func TestRunTransactionRetryOnErrors(t *testing.T) {
@@ -166,6 +166,9 @@ var (
 	RangeIDGenerator = MakeKey(SystemPrefix, proto.Key("range-idgen"))
 	// NameMetadataPrefix is the key prefix for all name metadata.
 	NameMetadataPrefix = MakeKey(SystemPrefix, proto.Key("name-"))
+	// SerialPrefix is the key prefix for the sequences of the SERIAL columns
+	// of tables.
+	SerialPrefix = MakeKey(SystemPrefix, proto.Key("serial-"))
 	// StoreIDGenerator is the global store ID generator sequence.
 	StoreIDGenerator = MakeKey(SystemPrefix, proto.Key("store-idgen"))
 	// RangeTreeRoot specifies the root range in the range tree.
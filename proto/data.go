@@ -505,6 +505,9 @@ func (t *Transaction) Update(o *Transaction) {
 	if o.Status != PENDING {
 		t.Status = o.Status
 	}
+	if o.Writing {
+		t.Writing = true
+	}
 	if t.Epoch < o.Epoch {
 		t.Epoch = o.Epoch
 	}
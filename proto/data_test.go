@@ -324,6 +324,20 @@ func TestTxnIDEqual(t *testing.T) {
 	}
 }
 
+// TestTransactionUpdateWriting verifies that Update propagates the
+// Writing flag but never clears it.
+func TestTransactionUpdateWriting(t *testing.T) {
+	txn := Transaction{ID: []byte("A")}
+	txn.Update(&Transaction{ID: []byte("A"), Writing: true})
+	if !txn.Writing {
+		t.Errorf("expected Writing to be set")
+	}
+	txn.Update(&Transaction{ID: []byte("A")})
+	if !txn.Writing {
+		t.Errorf("expected Writing to remain set")
+	}
+}
+
 func TestTransactionString(t *testing.T) {
 	id := []byte("ת\x0f^\xe4-Fؽ\xf7\x16\xe4\xf9\xbe^\xbe")
 	ts1 := makeTS(10, 11)
@@ -182,6 +182,7 @@ func (s *Server) Start(selfBootstrap bool) error {
 	if err := s.node.start(s.rpc, s.ctx.Engines, s.ctx.NodeAttributes, s.stopper); err != nil {
 		return err
 	}
+	s.sqlServer.SetNodeID(s.node.Descriptor.NodeID)
 
 	// Begin recording runtime statistics.
 	runtime := status.NewRuntimeStatRecorder(s.node.Descriptor.NodeID, s.clock)
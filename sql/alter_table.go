@@ -0,0 +1,212 @@
+// Copyright 2015 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License. See the AUTHORS file
+// for names of contributors.
+
+package sql
+
+import (
+	"fmt"
+
+	"github.com/cockroachdb/cockroach/client"
+	"github.com/cockroachdb/cockroach/sql/parser"
+	"github.com/cockroachdb/cockroach/structured"
+	"github.com/cockroachdb/cockroach/util"
+)
+
+// AlterTable applies schema changes to a table. The changes to the
+// descriptor and to the existing rows of the table are performed within a
+// single transaction.
+// Privileges: WRITE on table.
+//   Notes: postgres requires being the table owner.
+//          mysql requires ALTER, CREATE and INSERT on the table.
+func (p *planner) AlterTable(n *parser.AlterTable) (planNode, error) {
+	if err := p.normalizeTableName(n.Table); err != nil {
+		return nil, err
+	}
+
+	dbDesc, err := p.getDatabaseDesc(n.Table.Database())
+	if err != nil {
+		return nil, err
+	}
+
+	tbKey := tableKey{dbDesc.ID, n.Table.Table()}
+	desc, err := lookupTableDescInTxn(p.txn, tbKey)
+	if err != nil {
+		return nil, err
+	}
+	if desc == nil {
+		if n.IfExists {
+			// Noop.
+			return &valuesNode{}, nil
+		}
+		return nil, fmt.Errorf("table %q does not exist", tbKey.Name())
+	}
+
+	if !desc.HasPrivilege(p.user, parser.PrivilegeWrite) {
+		return nil, fmt.Errorf("user %s does not have %s privilege on table %s",
+			p.user, parser.PrivilegeWrite, desc.Name)
+	}
+
+	for _, cmd := range n.Cmds {
+		switch t := cmd.(type) {
+		case *parser.AlterTableAddColumn:
+			if err = p.addColumn(desc, tbKey, t.ColumnDef); err == nil && t.ColumnDef.References != nil {
+				err = p.addColumnForeignKey(desc, n.Table, t.ColumnDef)
+			}
+		case *parser.AlterTableDropColumn:
+			err = dropColumn(p.txn, desc, t)
+		default:
+			err = util.Errorf("unsupported ALTER TABLE command: %T", cmd)
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if err := desc.Validate(); err != nil {
+		return nil, err
+	}
+	if err := p.txn.Put(structured.MakeDescMetadataKey(desc.ID), desc); err != nil {
+		return nil, err
+	}
+	return &valuesNode{}, nil
+}
+
+// addColumn adds the column to the descriptor and backfills the value of the
+// column, which is either NULL or its DEFAULT, for the existing rows. A
+// UNIQUE column also gets an index, which is backfilled once the statement
+// has committed like the index of CREATE INDEX. If that backfill fails, the
+// column is removed again.
+func (p *planner) addColumn(desc *structured.TableDescriptor, tbKey tableKey, d *parser.ColumnTableDef) error {
+	if d.PrimaryKey {
+		return fmt.Errorf("cannot add a PRIMARY KEY column to table %q", tbKey.Name())
+	}
+	if _, ok := d.Type.(*parser.SerialType); ok {
+		return fmt.Errorf("cannot add a SERIAL column to table %q", tbKey.Name())
+	}
+	if _, err := desc.FindColumnByName(string(d.Name)); err == nil {
+		return fmt.Errorf("column %q already exists", d.Name)
+	}
+
+	col := makeColumnDesc(d)
+	if err := validateDefaultExpr(col, d.DefaultExpr); err != nil {
+		return err
+	}
+	col.ID = desc.NextColumnID
+	desc.NextColumnID++
+	desc.Columns = append(desc.Columns, col)
+
+	if err := backfillColumn(p.txn, p.evalCtx, desc, col, d.DefaultExpr); err != nil {
+		return err
+	}
+
+	if len(d.CheckExprs) > 0 {
+		for _, expr := range d.CheckExprs {
+			if err := addCheckConstraint(desc, tbKey.Name(), "", expr, &col); err != nil {
+				return err
+			}
+		}
+		if err := p.validateCheckConstraints(desc); err != nil {
+			return err
+		}
+	}
+
+	if d.Unique {
+		index := structured.IndexDescriptor{
+			Name:        fmt.Sprintf("%s_%s_key", tbKey.Name(), d.Name),
+			Unique:      true,
+			ColumnNames: []string{col.Name},
+			ColumnIDs:   []structured.ID{col.ID},
+		}
+		return p.addNewIndex(desc, index, col.ID)
+	}
+	return nil
+}
+
+// addColumnForeignKey adds the foreign key of the REFERENCES constraint of an
+// added column and verifies that the existing rows satisfy it.
+func (p *planner) addColumnForeignKey(desc *structured.TableDescriptor, table *parser.QualifiedName,
+	d *parser.ColumnTableDef) error {
+	r := d.References
+	if err := p.addForeignKey(desc, table, false, "", parser.NameList{string(d.Name)}, r.Table, r.Columns,
+		r.Actions); err != nil {
+		return err
+	}
+	fk := makeFKHelper(p.txn, p.evalCtx)
+	fk.descs[desc.ID] = desc
+	colIDtoRowIndex := makeColIDtoRowIndex(desc)
+	return backfillRows(p.txn, desc, func(_ *client.Batch, values parser.DTuple, _ []byte) error {
+		return fk.checkReferences(desc, colIDtoRowIndex, values, nil)
+	})
+}
+
+// dropColumn removes the column from the descriptor and deletes the values of
+// the column from the existing rows. Columns which are part of an index can't
+// be dropped.
+func dropColumn(txn *client.Txn, desc *structured.TableDescriptor, n *parser.AlterTableDropColumn) error {
+	i := -1
+	for j, col := range desc.Columns {
+		if col.Name == string(n.Column) {
+			i = j
+			break
+		}
+	}
+	if i == -1 {
+		if n.IfExists {
+			// Noop.
+			return nil
+		}
+		return fmt.Errorf("column %q does not exist", n.Column)
+	}
+	col := desc.Columns[i]
+
+	// The foreign keys are checked first as they have an index on their
+	// columns.
+	for _, fk := range desc.ForeignKeys {
+		for _, id := range fk.ColumnIDs {
+			if id == col.ID {
+				return fmt.Errorf("column %q is referenced by foreign key %q", col.Name, fk.Name)
+			}
+		}
+	}
+
+	for _, index := range append([]structured.IndexDescriptor{desc.PrimaryIndex}, desc.Indexes...) {
+		for _, id := range index.ColumnIDs {
+			if id == col.ID {
+				return fmt.Errorf("column %q is referenced by index %q", col.Name, index.Name)
+			}
+		}
+	}
+
+	name, err := checkReferencingColumn(desc, col.Name)
+	if err != nil {
+		return err
+	}
+	if name != "" {
+		return fmt.Errorf("column %q is referenced by check constraint %q", col.Name, name)
+	}
+
+	// The values of the column are deleted before the column is removed from
+	// the descriptor as the scan of the rows decodes them.
+	if err := deleteColumn(txn, desc, col); err != nil {
+		return err
+	}
+	if col.Serial {
+		if err := txn.Del(structured.MakeSerialKey(desc.ID, col.ID)); err != nil {
+			return err
+		}
+	}
+	desc.Columns = append(desc.Columns[:i], desc.Columns[i+1:]...)
+	return nil
+}
@@ -0,0 +1,78 @@
+// Copyright 2015 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License. See the AUTHORS file
+// for names of contributors.
+
+package sql_test
+
+import (
+	"testing"
+
+	"github.com/cockroachdb/cockroach/proto"
+	"github.com/cockroachdb/cockroach/structured"
+	"github.com/cockroachdb/cockroach/util/leaktest"
+)
+
+func TestAlterTableColumnCells(t *testing.T) {
+	defer leaktest.AfterTest(t)
+	s, sqlDB, kvDB := setup(t)
+	defer cleanup(s, sqlDB)
+
+	if _, err := sqlDB.Exec(`
+CREATE DATABASE t;
+CREATE TABLE t.kv (k CHAR PRIMARY KEY, v CHAR);
+INSERT INTO t.kv VALUES ('a', 'x'), ('b', 'y'), ('c', NULL)
+`); err != nil {
+		t.Fatal(err)
+	}
+
+	nameKey := structured.MakeNameMetadataKey(structured.MaxReservedDescID+1, "kv")
+	gr, err := kvDB.Get(nameKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	desc := structured.TableDescriptor{}
+	if err := kvDB.GetProto(gr.ValueBytes(), &desc); err != nil {
+		t.Fatal(err)
+	}
+
+	primaryIndexPrefix := proto.Key(structured.MakeIndexKeyPrefix(desc.ID, desc.PrimaryIndex.ID))
+	expectKVs := func(expected int) {
+		if kvs, err := kvDB.Scan(primaryIndexPrefix, primaryIndexPrefix.PrefixEnd(), 0); err != nil {
+			t.Fatal(err)
+		} else if len(kvs) != expected {
+			t.Fatalf("expected %d key value pairs, but got %d", expected, len(kvs))
+		}
+	}
+
+	// There is a cell for each column of each row.
+	expectKVs(6)
+
+	// The default value is written for every row.
+	if _, err := sqlDB.Exec(`ALTER TABLE t.kv ADD COLUMN w CHAR DEFAULT 'z'`); err != nil {
+		t.Fatal(err)
+	}
+	expectKVs(9)
+
+	// A missing cell reads as NULL, so nothing is written for a new column
+	// without a default.
+	if _, err := sqlDB.Exec(`ALTER TABLE t.kv ADD COLUMN u CHAR`); err != nil {
+		t.Fatal(err)
+	}
+	expectKVs(9)
+
+	if _, err := sqlDB.Exec(`ALTER TABLE t.kv DROP COLUMN v, DROP COLUMN w`); err != nil {
+		t.Fatal(err)
+	}
+	expectKVs(3)
+}
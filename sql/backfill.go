@@ -0,0 +1,471 @@
+// Copyright 2015 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License. See the AUTHORS file
+// for names of contributors.
+
+package sql
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+
+	"github.com/cockroachdb/cockroach/client"
+	"github.com/cockroachdb/cockroach/proto"
+	"github.com/cockroachdb/cockroach/sql/parser"
+	"github.com/cockroachdb/cockroach/structured"
+	"github.com/cockroachdb/cockroach/util/log"
+)
+
+// backfillBatchSize is the number of rows for which writes are accumulated in
+// a single batch during a backfill. The backfill of a new index also runs
+// each batch in its own transaction.
+var backfillBatchSize = 1000
+
+// backfillRows calls fn for every row of the table to add the writes for the
+// row to a batch. The rows are read from the primary index within the
+// transaction and the batch is run every backfillBatchSize rows. The values
+// passed to fn are in the order of desc.Columns, with NULL for missing
+// values, and are only valid until fn returns.
+func backfillRows(txn *client.Txn, desc *structured.TableDescriptor,
+	fn func(b *client.Batch, values parser.DTuple, primaryIndexKey []byte) error) error {
+	colIDtoRowIndex := makeColIDtoRowIndex(desc)
+	primaryIndexKeyPrefix := structured.MakeIndexKeyPrefix(desc.ID, desc.PrimaryIndex.ID)
+
+	scan := &scanNode{
+		db:    txn,
+		desc:  desc,
+		index: &desc.PrimaryIndex,
+	}
+	values := make(parser.DTuple, len(desc.Columns))
+	b := &client.Batch{}
+	var rows int
+
+	for scan.Next() {
+		for i, col := range desc.Columns {
+			values[i] = parser.DNull
+			if d, ok := scan.vals[col.Name]; ok {
+				values[i] = d
+			}
+		}
+
+		primaryIndexKeySuffix, _, err := encodeIndexKey(desc.PrimaryIndex.ColumnIDs, colIDtoRowIndex, values, nil)
+		if err != nil {
+			return err
+		}
+		primaryIndexKey := bytes.Join([][]byte{primaryIndexKeyPrefix, primaryIndexKeySuffix}, nil)
+		if err := fn(b, values, primaryIndexKey); err != nil {
+			return err
+		}
+
+		if rows++; rows%backfillBatchSize == 0 {
+			if err := txn.Run(b); err != nil {
+				return err
+			}
+			b = &client.Batch{}
+		}
+	}
+	if err := scan.Err(); err != nil {
+		return err
+	}
+	if len(b.Results) == 0 {
+		return nil
+	}
+	return txn.Run(b)
+}
+
+// A newIndex identifies an index which was added to a table in the
+// write-only state and whose entries for the existing rows of the table
+// remain to be backfilled.
+type newIndex struct {
+	tableID structured.ID
+	indexID structured.ID
+	// The column added along with the index by ALTER TABLE ... ADD COLUMN ...
+	// UNIQUE, which is removed again if the backfill fails, or 0.
+	columnID structured.ID
+}
+
+// addNewIndex adds the index to an existing table in the write-only state and
+// schedules its backfill, which runs once the implicit transaction of the
+// statement has committed. The backfill needs the commit to happen at the
+// end of the request, so an index can't be added inside a transaction
+// block. The index of a column added by the statement is given by columnID.
+func (p *planner) addNewIndex(desc *structured.TableDescriptor, index structured.IndexDescriptor,
+	columnID structured.ID) error {
+	if !p.implicitTxn {
+		return fmt.Errorf("index %q cannot be added to table %q inside a transaction block",
+			index.Name, desc.Name)
+	}
+	index.ID = desc.NextIndexID
+	index.WriteOnly = true
+	desc.NextIndexID++
+	desc.Indexes = append(desc.Indexes, index)
+	p.newIndexes = append(p.newIndexes, newIndex{tableID: desc.ID, indexID: index.ID, columnID: columnID})
+	return nil
+}
+
+// backfillNewIndexes backfills the indexes added by the statements of the
+// transaction once it has committed. The failure of one backfill doesn't
+// stop the others, and the first error is returned.
+func (p *planner) backfillNewIndexes() error {
+	newIndexes := p.newIndexes
+	p.newIndexes = nil
+	var err error
+	for _, n := range newIndexes {
+		if bErr := backfillNewIndex(p.db, n); bErr != nil && err == nil {
+			err = bErr
+		}
+	}
+	return err
+}
+
+// backfillNewIndex writes the entries of a write-only index for the existing
+// rows of the table and then makes the index public. Each chunk of rows is
+// backfilled in its own transaction, so the backfill of a large table
+// neither runs into the limits of a single transaction nor blocks the writes
+// to the table for its duration. The rows written concurrently are indexed by
+// the writers themselves as they maintain the write-only index. If the
+// backfill fails, for instance because two rows violate the unique
+// constraint of the index, the index is removed again, along with the column
+// added with it, if any.
+//
+// The write-only state of the index is all that is recorded of the backfill,
+// so if the backfill is interrupted before it completes or removes the
+// index, for instance because the node stops, the index remains write-only:
+// it is maintained by the writes to the table but not used by reads. Running
+// CREATE INDEX for the index again resumes its backfill from the start, and
+// DROP INDEX removes it.
+func backfillNewIndex(db *client.DB, n newIndex) error {
+	var start proto.Key
+	for {
+		var next proto.Key
+		err := db.Txn(func(txn *client.Txn) error {
+			desc, index, err := getNewIndex(txn, n)
+			if err != nil || index == nil {
+				next = nil
+				return err
+			}
+			if start == nil {
+				start = proto.Key(structured.MakeIndexKeyPrefix(desc.ID, desc.PrimaryIndex.ID))
+			}
+			next, err = backfillIndexChunk(txn, desc, *index, start)
+			return err
+		})
+		if err != nil {
+			if rmErr := removeNewIndex(db, n); rmErr != nil {
+				log.Errorf("failure removing index %d of table %d: %s; removal caused by: %s",
+					n.indexID, n.tableID, rmErr, err)
+			}
+			return err
+		}
+		if next == nil {
+			break
+		}
+		start = next
+	}
+
+	return db.Txn(func(txn *client.Txn) error {
+		desc, index, err := getNewIndex(txn, n)
+		if err != nil || index == nil {
+			return err
+		}
+		index.WriteOnly = false
+		return txn.Put(structured.MakeDescMetadataKey(desc.ID), desc)
+	})
+}
+
+// removeNewIndex removes a write-only index and its entries from the table,
+// as well as the column added along with the index, if any.
+func removeNewIndex(db *client.DB, n newIndex) error {
+	return db.Txn(func(txn *client.Txn) error {
+		desc, index, err := getNewIndex(txn, n)
+		if err != nil || index == nil {
+			return err
+		}
+		removed := []structured.ID{n.indexID}
+		if n.columnID != 0 {
+			ids, err := removeNewColumn(txn, desc, n.columnID)
+			if err != nil {
+				return err
+			}
+			removed = append(removed, ids...)
+		}
+		for _, id := range removed {
+			for i := range desc.Indexes {
+				if desc.Indexes[i].ID == id {
+					desc.Indexes = append(desc.Indexes[:i], desc.Indexes[i+1:]...)
+					break
+				}
+			}
+		}
+
+		b := &client.Batch{}
+		b.Put(structured.MakeDescMetadataKey(desc.ID), desc)
+		for _, id := range removed {
+			indexPrefix := proto.Key(structured.MakeIndexKeyPrefix(desc.ID, id))
+			b.DelRange(indexPrefix, indexPrefix.PrefixEnd())
+		}
+		return txn.Run(b)
+	})
+}
+
+// removeNewColumn removes a column added along with a new index from the
+// table, together with its values and the CHECK constraints and foreign keys
+// on it. It returns the IDs of the other indexes on the column, which were
+// added along with it and are to be removed as well.
+func removeNewColumn(txn *client.Txn, desc *structured.TableDescriptor, id structured.ID) ([]structured.ID, error) {
+	i := -1
+	for j := range desc.Columns {
+		if desc.Columns[j].ID == id {
+			i = j
+			break
+		}
+	}
+	if i == -1 {
+		return nil, nil
+	}
+	col := desc.Columns[i]
+	// The values of the column are deleted before the column is removed from
+	// the descriptor as the scan of the rows decodes them.
+	if err := deleteColumn(txn, desc, col); err != nil {
+		return nil, err
+	}
+	desc.Columns = append(desc.Columns[:i], desc.Columns[i+1:]...)
+
+	checks := desc.Checks[:0]
+	for _, check := range desc.Checks {
+		expr, err := parser.ParseExpr(check.Expr)
+		if err != nil {
+			return nil, err
+		}
+		keep := true
+		for _, ref := range columnRefs(expr) {
+			if ref == col.Name {
+				keep = false
+				break
+			}
+		}
+		if keep {
+			checks = append(checks, check)
+		}
+	}
+	desc.Checks = checks
+
+	fks := desc.ForeignKeys[:0]
+	for _, fk := range desc.ForeignKeys {
+		if !hasColumnID(fk.ColumnIDs, id) {
+			fks = append(fks, fk)
+		}
+	}
+	desc.ForeignKeys = fks
+
+	var indexIDs []structured.ID
+	for _, index := range desc.Indexes {
+		if hasColumnID(index.ColumnIDs, id) {
+			indexIDs = append(indexIDs, index.ID)
+		}
+	}
+	return indexIDs, nil
+}
+
+func hasColumnID(ids []structured.ID, id structured.ID) bool {
+	for _, x := range ids {
+		if x == id {
+			return true
+		}
+	}
+	return false
+}
+
+// getNewIndex reads the descriptor of the table within the transaction and
+// returns it along with the write-only index. The returned index is nil if it
+// no longer exists or has already been made public.
+func getNewIndex(txn *client.Txn, n newIndex) (*structured.TableDescriptor, *structured.IndexDescriptor, error) {
+	desc, err := getTableDescByID(txn, n.tableID)
+	if err != nil {
+		return nil, nil, err
+	}
+	for i := range desc.Indexes {
+		if index := &desc.Indexes[i]; index.ID == n.indexID && index.WriteOnly {
+			return desc, index, nil
+		}
+	}
+	return desc, nil, nil
+}
+
+// backfillIndexChunk writes the entries of the index for at most
+// backfillBatchSize rows of the table, starting with the row at or after the
+// primary index key start. It returns the key at which to resume the
+// backfill, or nil once the last row of the table has been read. The entry
+// of a unique index is only written if the index has no entry for its values
+// yet, or the existing entry is the one of the same row.
+func backfillIndexChunk(txn *client.Txn, desc *structured.TableDescriptor, index structured.IndexDescriptor,
+	start proto.Key) (proto.Key, error) {
+	colIDtoRowIndex := makeColIDtoRowIndex(desc)
+	primaryIndexKeyPrefix := proto.Key(structured.MakeIndexKeyPrefix(desc.ID, desc.PrimaryIndex.ID))
+
+	scan := &scanNode{
+		db:    txn,
+		desc:  desc,
+		index: &desc.PrimaryIndex,
+		spans: []span{{start: start, end: primaryIndexKeyPrefix.PrefixEnd()}},
+	}
+	values := make(parser.DTuple, len(desc.Columns))
+	var entries []indexEntry
+	// The values of the index columns of the rows, for the error reported for
+	// a violation of the unique constraint.
+	var indexValues [][]string
+	var lastKey proto.Key
+
+	for len(entries) < backfillBatchSize && scan.Next() {
+		for i, col := range desc.Columns {
+			values[i] = parser.DNull
+			if d, ok := scan.vals[col.Name]; ok {
+				values[i] = d
+			}
+		}
+
+		primaryIndexKeySuffix, _, err := encodeIndexKey(desc.PrimaryIndex.ColumnIDs, colIDtoRowIndex, values, nil)
+		if err != nil {
+			return nil, err
+		}
+		lastKey = proto.Key(bytes.Join([][]byte{primaryIndexKeyPrefix, primaryIndexKeySuffix}, nil))
+		secondaryIndexEntries, err := encodeSecondaryIndexes(desc.ID, []structured.IndexDescriptor{index},
+			colIDtoRowIndex, values, primaryIndexKeySuffix)
+		if err != nil {
+			return nil, err
+		}
+		entries = append(entries, secondaryIndexEntries...)
+
+		vals := make([]string, len(index.ColumnIDs))
+		for i, id := range index.ColumnIDs {
+			vals[i] = values[colIDtoRowIndex[id]].String()
+		}
+		indexValues = append(indexValues, vals)
+	}
+	if err := scan.Err(); err != nil {
+		return nil, err
+	}
+
+	if len(entries) == 0 {
+		return nil, nil
+	}
+
+	if index.Unique {
+		// The entry of a unique index without NULL values has the primary key
+		// of the row as its value, which has to match the existing entry, if
+		// any. The existing entries are those written for the preceding rows
+		// of the backfill and by concurrent writes to the table.
+		b := &client.Batch{}
+		for _, entry := range entries {
+			b.Get(entry.key)
+		}
+		if err := txn.Run(b); err != nil {
+			return nil, err
+		}
+		seen := make(map[string][]byte, len(entries))
+		for i, entry := range entries {
+			if entry.value == nil {
+				// The values contain NULL, which doesn't violate the unique
+				// constraint.
+				continue
+			}
+			existing, ok := seen[string(entry.key)]
+			if !ok {
+				existing = b.Results[i].Rows[0].ValueBytes()
+			}
+			if existing != nil && !bytes.Equal(existing, entry.value) {
+				return nil, fmt.Errorf("duplicate key value (%s)=(%s) violates unique constraint %s",
+					strings.Join(index.ColumnNames, ","), strings.Join(indexValues[i], ","), index.Name)
+			}
+			seen[string(entry.key)] = entry.value
+		}
+	}
+
+	b := &client.Batch{}
+	for _, entry := range entries {
+		if log.V(2) {
+			log.Infof("Put %q -> %v", entry.key, entry.value)
+		}
+		b.Put(entry.key, entry.value)
+	}
+	if err := txn.Run(b); err != nil {
+		return nil, err
+	}
+
+	if len(entries) < backfillBatchSize {
+		return nil, nil
+	}
+	return lastKey.PrefixEnd(), nil
+}
+
+// backfillColumn writes the value of the column, which is either NULL or its
+// DEFAULT, for the existing rows of the table. The default is evaluated for
+// each row so that functions such as unique_rowid() give every row its own
+// value. The absence of the column's key is read as NULL, so nothing needs to
+// be written for a NULL value, but a non-nullable column without a default
+// can only be added to an empty table.
+func backfillColumn(txn *client.Txn, evalCtx parser.EvalContext, desc *structured.TableDescriptor,
+	col structured.ColumnDescriptor, defaultExpr parser.Expr) error {
+	if defaultExpr == nil && col.Nullable {
+		return nil
+	}
+
+	return backfillRows(txn, desc, func(b *client.Batch, _ parser.DTuple, primaryIndexKey []byte) error {
+		var val parser.Datum = parser.DNull
+		if defaultExpr != nil {
+			// The default can't refer to any columns.
+			var err error
+			if val, err = parser.EvalExpr(evalCtx, defaultExpr, valMap{}); err != nil {
+				return err
+			}
+		}
+		v, err := prepareVal(col, val)
+		if err != nil {
+			return err
+		}
+		if v == nil {
+			if col.Nullable {
+				return nil
+			}
+			return fmt.Errorf("column %q contains null values", col.Name)
+		}
+		key := structured.MakeColumnKey(col.ID, primaryIndexKey)
+		if log.V(2) {
+			log.Infof("Put %q -> %v", key, v)
+		}
+		b.Put(key, v)
+		return nil
+	})
+}
+
+// deleteColumn deletes the values of the column from the existing rows of
+// the table.
+func deleteColumn(txn *client.Txn, desc *structured.TableDescriptor, col structured.ColumnDescriptor) error {
+	return backfillRows(txn, desc, func(b *client.Batch, _ parser.DTuple, primaryIndexKey []byte) error {
+		key := structured.MakeColumnKey(col.ID, primaryIndexKey)
+		if log.V(2) {
+			log.Infof("Del %q", key)
+		}
+		b.Del(key)
+		return nil
+	})
+}
+
+func makeColIDtoRowIndex(desc *structured.TableDescriptor) map[structured.ID]int {
+	colIDtoRowIndex := make(map[structured.ID]int, len(desc.Columns))
+	for i, col := range desc.Columns {
+		colIDtoRowIndex[col.ID] = i
+	}
+	return colIDtoRowIndex
+}
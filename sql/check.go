@@ -0,0 +1,280 @@
+// Copyright 2015 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License. See the AUTHORS file
+// for names of contributors.
+
+package sql
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/cockroachdb/cockroach/client"
+	"github.com/cockroachdb/cockroach/sql/parser"
+	"github.com/cockroachdb/cockroach/structured"
+)
+
+// checkHelper verifies that a row which is written to a table satisfies the
+// constraints of the table: the values of the DECIMAL columns fit the
+// precision of their columns, the columns which are NOT NULL or part of the
+// primary key are not NULL and no CHECK constraint evaluates to false.
+type checkHelper struct {
+	desc     *structured.TableDescriptor
+	decimals []structured.ColumnDescriptor
+	notNull  map[structured.ID]struct{}
+	exprs    []parser.Expr
+	evalCtx  parser.EvalContext
+}
+
+func makeCheckHelper(desc *structured.TableDescriptor, evalCtx parser.EvalContext) (*checkHelper, error) {
+	c := &checkHelper{desc: desc, notNull: map[structured.ID]struct{}{}, evalCtx: evalCtx}
+	for _, col := range desc.Columns {
+		if !col.Nullable {
+			c.notNull[col.ID] = struct{}{}
+		}
+		if col.Type.Kind == structured.ColumnType_DECIMAL && col.Type.Precision > 0 {
+			c.decimals = append(c.decimals, col)
+		}
+	}
+	for _, id := range desc.PrimaryIndex.ColumnIDs {
+		c.notNull[id] = struct{}{}
+	}
+	for _, check := range desc.Checks {
+		expr, err := parser.ParseExpr(check.Expr)
+		if err != nil {
+			return nil, err
+		}
+		c.exprs = append(c.exprs, expr)
+	}
+	return c, nil
+}
+
+// checkRow verifies the row whose values are indexed by colIDtoRowIndex. A
+// column which is not part of the row is NULL. The values of the DECIMAL
+// columns are rounded in place to the scale of their columns.
+func (c *checkHelper) checkRow(colIDtoRowIndex map[structured.ID]int, values parser.DTuple) error {
+	for _, col := range c.decimals {
+		i, ok := colIDtoRowIndex[col.ID]
+		if !ok {
+			continue
+		}
+		if d, ok := values[i].(parser.DDecimal); ok {
+			v, err := limitDecimal(col, d)
+			if err != nil {
+				return err
+			}
+			values[i] = v
+		}
+	}
+
+	for _, col := range c.desc.Columns {
+		if _, ok := c.notNull[col.ID]; !ok {
+			continue
+		}
+		if i, ok := colIDtoRowIndex[col.ID]; !ok || values[i] == parser.DNull {
+			return fmt.Errorf("null value in column %q violates not-null constraint", col.Name)
+		}
+	}
+
+	if len(c.exprs) == 0 {
+		return nil
+	}
+	env := makeRowEnv(c.desc, "", colIDtoRowIndex, values)
+	for i, expr := range c.exprs {
+		d, err := parser.EvalExpr(c.evalCtx, expr, env)
+		if err != nil {
+			return err
+		}
+		// A CHECK constraint is satisfied unless it evaluates to false.
+		if d == parser.DNull {
+			continue
+		}
+		v, ok := d.(parser.DBool)
+		if !ok {
+			return fmt.Errorf("CHECK constraint %q did not evaluate to a boolean", c.desc.Checks[i].Name)
+		}
+		if !v {
+			return fmt.Errorf("failed to satisfy CHECK constraint %q: %s", c.desc.Checks[i].Name, expr)
+		}
+	}
+	return nil
+}
+
+// validateCheckConstraints verifies that the existing rows of the table
+// satisfy its constraints.
+func (p *planner) validateCheckConstraints(desc *structured.TableDescriptor) error {
+	c, err := makeCheckHelper(desc, p.evalCtx)
+	if err != nil {
+		return err
+	}
+	colIDtoRowIndex := makeColIDtoRowIndex(desc)
+	return backfillRows(p.txn, desc, func(_ *client.Batch, values parser.DTuple, _ []byte) error {
+		return c.checkRow(colIDtoRowIndex, values)
+	})
+}
+
+// addCheckConstraint adds a CHECK constraint to the descriptor after verifying
+// that the expression only refers to columns of the table. A constraint
+// without a name is named in the style of postgres: <table>_<column>_check
+// for a constraint on a column and <table>_check for a constraint on the
+// table, followed by a number if the name is already taken.
+func addCheckConstraint(desc *structured.TableDescriptor, tableName, name string,
+	expr parser.Expr, col *structured.ColumnDescriptor) error {
+	for _, ref := range columnRefs(expr) {
+		if _, err := desc.FindColumnByName(ref); err != nil {
+			return err
+		}
+	}
+
+	if name == "" {
+		base := tableName
+		if col != nil {
+			base += "_" + col.Name
+		}
+		base += "_check"
+		name = base
+		for i := 1; hasCheckConstraint(desc, name); i++ {
+			name = fmt.Sprintf("%s%d", base, i)
+		}
+	} else if hasCheckConstraint(desc, name) {
+		return fmt.Errorf("check constraint %q already exists", name)
+	}
+	desc.Checks = append(desc.Checks, structured.CheckConstraint{Name: name, Expr: expr.String()})
+	return nil
+}
+
+func hasCheckConstraint(desc *structured.TableDescriptor, name string) bool {
+	for _, check := range desc.Checks {
+		if check.Name == name {
+			return true
+		}
+	}
+	return false
+}
+
+// renameColumnInChecks rewrites the CHECK constraints which refer to the
+// column by its old name to refer to it by its new name.
+func renameColumnInChecks(desc *structured.TableDescriptor, oldName, newName string) error {
+	v := renameColumnVisitor{oldName: oldName, newName: newName}
+	for i := range desc.Checks {
+		expr, err := parser.ParseExpr(desc.Checks[i].Expr)
+		if err != nil {
+			return err
+		}
+		desc.Checks[i].Expr = parser.WalkExpr(&v, expr).String()
+	}
+	return nil
+}
+
+type renameColumnVisitor struct {
+	oldName, newName string
+}
+
+var _ parser.Visitor = &renameColumnVisitor{}
+
+func (v *renameColumnVisitor) Visit(expr parser.Expr) parser.Expr {
+	if qname, ok := expr.(*parser.QualifiedName); ok && columnRefName(qname) == v.oldName {
+		return &parser.QualifiedName{Base: parser.Name(v.newName)}
+	}
+	return expr
+}
+
+// checkReferencingColumn returns the name of a CHECK constraint which refers
+// to the column, or "" if there is none.
+func checkReferencingColumn(desc *structured.TableDescriptor, col string) (string, error) {
+	for _, check := range desc.Checks {
+		expr, err := parser.ParseExpr(check.Expr)
+		if err != nil {
+			return "", err
+		}
+		for _, ref := range columnRefs(expr) {
+			if ref == col {
+				return check.Name, nil
+			}
+		}
+	}
+	return "", nil
+}
+
+// checkExprsForColumn returns the expressions of the CHECK constraints which
+// refer to the column, joined with AND.
+func checkExprsForColumn(desc *structured.TableDescriptor, col string) (string, error) {
+	var exprs []string
+	for _, check := range desc.Checks {
+		expr, err := parser.ParseExpr(check.Expr)
+		if err != nil {
+			return "", err
+		}
+		for _, ref := range columnRefs(expr) {
+			if ref == col {
+				exprs = append(exprs, check.Expr)
+				break
+			}
+		}
+	}
+	return strings.Join(exprs, " AND "), nil
+}
+
+// makeRowEnv returns the environment in which an expression over the columns
+// of a row of the table is evaluated. The values of the row are indexed by
+// colIDtoRowIndex and a column which is not part of the row is NULL. The
+// columns can be referred to by name and, unless alias is empty, qualified by
+// the alias.
+func makeRowEnv(desc *structured.TableDescriptor, alias string,
+	colIDtoRowIndex map[structured.ID]int, values parser.DTuple) valMap {
+	env := valMap{}
+	for _, col := range desc.Columns {
+		d := parser.Datum(parser.DNull)
+		if i, ok := colIDtoRowIndex[col.ID]; ok {
+			d = values[i]
+		}
+		env[col.Name] = d
+		if alias != "" {
+			qname := &parser.QualifiedName{
+				Base:     parser.Name(alias),
+				Indirect: parser.Indirection{parser.NameIndirection(col.Name)},
+			}
+			env[qname.String()] = d
+		}
+	}
+	return env
+}
+
+// columnRefs returns the names of the columns referred to by the expression.
+func columnRefs(expr parser.Expr) []string {
+	var v columnRefVisitor
+	parser.WalkExpr(&v, expr)
+	return v.names
+}
+
+type columnRefVisitor struct {
+	names []string
+}
+
+var _ parser.Visitor = &columnRefVisitor{}
+
+func (v *columnRefVisitor) Visit(expr parser.Expr) parser.Expr {
+	if qname, ok := expr.(*parser.QualifiedName); ok {
+		v.names = append(v.names, columnRefName(qname))
+	}
+	return expr
+}
+
+// columnRefName returns the unquoted name of the column the qualified name
+// refers to. A qualified name with an indirection is returned formatted.
+func columnRefName(qname *parser.QualifiedName) string {
+	if len(qname.Indirect) == 0 {
+		return string(qname.Base)
+	}
+	return qname.String()
+}
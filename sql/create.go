@@ -19,9 +19,12 @@ package sql
 
 import (
 	"fmt"
+	"reflect"
+	"strings"
 
 	"github.com/cockroachdb/cockroach/security"
 	"github.com/cockroachdb/cockroach/sql/parser"
+	"github.com/cockroachdb/cockroach/structured"
 )
 
 // CreateDatabase creates a database.
@@ -63,8 +66,16 @@ func (p *planner) CreateTable(n *parser.CreateTable) (planNode, error) {
 			p.user, parser.PrivilegeWrite, dbDesc.Name)
 	}
 
-	desc, err := makeTableDesc(n)
-	if err != nil {
+	var desc structured.TableDescriptor
+	var rows planNode
+	if n.AsSource != nil {
+		if rows, err = p.makePlan(n.AsSource); err != nil {
+			return nil, err
+		}
+		if desc, err = makeTableDescAs(n, rows); err != nil {
+			return nil, err
+		}
+	} else if desc, err = makeTableDesc(n); err != nil {
 		return nil, err
 	}
 	// Inherit permissions from the database descriptor.
@@ -77,5 +88,140 @@ func (p *planner) CreateTable(n *parser.CreateTable) (planNode, error) {
 	if err := p.writeDescriptor(tableKey{dbDesc.ID, n.Table.Table()}, &desc, n.IfNotExists); err != nil {
 		return nil, err
 	}
+
+	// The ID of the descriptor is only set if it was written, and not if the
+	// table already existed. The foreign keys are added once the table has an
+	// ID, as a foreign key can refer to the table itself.
+	if desc.ID == 0 {
+		return &valuesNode{}, nil
+	}
+	if err := p.addForeignKeys(&desc, n); err != nil {
+		return nil, err
+	}
+
+	if rows != nil {
+		ih, err := p.makeInsertHelper(&desc, desc.VisibleColumns())
+		if err != nil {
+			return nil, err
+		}
+		if err := p.insertRows(ih, rows, nil, nil); err != nil {
+			return nil, err
+		}
+	}
+	return &valuesNode{}, nil
+}
+
+// addForeignKeys adds the foreign keys of the column and table definitions
+// of a CREATE TABLE statement to the descriptor of the table, which is
+// written again if there are any.
+func (p *planner) addForeignKeys(desc *structured.TableDescriptor, n *parser.CreateTable) error {
+	added := false
+	for _, def := range n.Defs {
+		var err error
+		switch d := def.(type) {
+		case *parser.ColumnTableDef:
+			if r := d.References; r != nil {
+				err = p.addForeignKey(desc, n.Table, true, "", parser.NameList{string(d.Name)}, r.Table, r.Columns,
+					r.Actions)
+				added = true
+			}
+		case *parser.ForeignKeyTableDef:
+			err = p.addForeignKey(desc, n.Table, true, string(d.Name), d.FromCols, d.Table, d.ToCols, d.Actions)
+			added = true
+		}
+		if err != nil {
+			return err
+		}
+	}
+	if !added {
+		return nil
+	}
+	if err := desc.Validate(); err != nil {
+		return err
+	}
+	return p.txn.Put(structured.MakeDescMetadataKey(desc.ID), desc)
+}
+
+// CreateIndex creates an index on an existing table. The index is added in
+// the write-only state within the transaction of the statement and its
+// entries for the existing rows of the table are backfilled from the primary
+// index once the transaction commits, after which the index is used by
+// reads. The backfill requires the commit to happen at the end of the
+// request, so CREATE INDEX can't be run in an explicit transaction. Running
+// CREATE INDEX for an index which is still write-only, because its backfill
+// was interrupted, resumes the backfill.
+// Privileges: WRITE on table.
+//   Notes: postgres requires CREATE on the table.
+//          mysql requires INDEX on the table.
+func (p *planner) CreateIndex(n *parser.CreateIndex) (planNode, error) {
+	if !p.implicitTxn {
+		return nil, fmt.Errorf("CREATE INDEX cannot run inside a transaction block")
+	}
+	if err := p.normalizeTableName(n.Table); err != nil {
+		return nil, err
+	}
+
+	dbDesc, err := p.getDatabaseDesc(n.Table.Database())
+	if err != nil {
+		return nil, err
+	}
+
+	// Writes to the table read the descriptor within their own transaction,
+	// so a write either precedes the addition of the write-only index and is
+	// seen by the backfill or follows it and maintains the new index.
+	//
+	// TODO: This doesn't hold for a write performed at snapshot
+	// isolation, which does not conflict with the write of the descriptor.
+	tbKey := tableKey{dbDesc.ID, n.Table.Table()}
+	desc, err := getTableDescInTxn(p.txn, tbKey)
+	if err != nil {
+		return nil, err
+	}
+
+	if !desc.HasPrivilege(p.user, parser.PrivilegeWrite) {
+		return nil, fmt.Errorf("user %s does not have %s privilege on table %s",
+			p.user, parser.PrivilegeWrite, desc.Name)
+	}
+
+	index := structured.IndexDescriptor{
+		Name:        string(n.Name),
+		Unique:      n.Unique,
+		ColumnNames: n.Columns,
+	}
+	if index.Name == "" {
+		// Generate a name in the style of postgres: <table>_<columns>_idx.
+		index.Name = fmt.Sprintf("%s_%s_idx", n.Table.Table(), strings.Join(n.Columns, "_"))
+	}
+	for _, idx := range append([]structured.IndexDescriptor{desc.PrimaryIndex}, desc.Indexes...) {
+		if idx.Name == index.Name {
+			if idx.WriteOnly && idx.Unique == index.Unique && reflect.DeepEqual(idx.ColumnNames, index.ColumnNames) {
+				// The backfill of the index was interrupted and is resumed.
+				p.newIndexes = append(p.newIndexes, newIndex{tableID: desc.ID, indexID: idx.ID})
+				return &valuesNode{}, nil
+			}
+			if n.IfNotExists {
+				// Noop.
+				return &valuesNode{}, nil
+			}
+			return nil, fmt.Errorf("index %q already exists", index.Name)
+		}
+	}
+	for _, name := range index.ColumnNames {
+		col, err := desc.FindColumnByName(name)
+		if err != nil {
+			return nil, err
+		}
+		index.ColumnIDs = append(index.ColumnIDs, col.ID)
+	}
+	if err := p.addNewIndex(desc, index, 0); err != nil {
+		return nil, err
+	}
+	if err := desc.Validate(); err != nil {
+		return nil, err
+	}
+
+	if err := p.txn.Put(structured.MakeDescMetadataKey(desc.ID), desc); err != nil {
+		return nil, err
+	}
 	return &valuesNode{}, nil
 }
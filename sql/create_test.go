@@ -23,8 +23,11 @@ import (
 
 	"github.com/cockroachdb/cockroach/client"
 	"github.com/cockroachdb/cockroach/keys"
+	"github.com/cockroachdb/cockroach/proto"
 	"github.com/cockroachdb/cockroach/server"
+	csql "github.com/cockroachdb/cockroach/sql"
 	"github.com/cockroachdb/cockroach/structured"
+	"github.com/cockroachdb/cockroach/testutils"
 	"github.com/cockroachdb/cockroach/util/leaktest"
 )
 
@@ -126,3 +129,257 @@ func TestDatabaseDescriptor(t *testing.T) {
 		t.Fatal("key is missing")
 	}
 }
+
+func TestCreateIndexBackfill(t *testing.T) {
+	defer leaktest.AfterTest(t)
+	s, sqlDB, kvDB := setup(t)
+	defer cleanup(s, sqlDB)
+
+	// Use small batches so that the backfill reads and writes the rows in
+	// several batches.
+	defer csql.SetScanBatchSize(3)()
+	defer csql.SetBackfillBatchSize(2)()
+
+	if _, err := sqlDB.Exec(`
+CREATE DATABASE t;
+CREATE TABLE t.kv (k CHAR PRIMARY KEY, v CHAR);
+INSERT INTO t.kv VALUES ('a', 'x'), ('b', 'y'), ('c', 'z'), ('d', 'x'), ('e', NULL)
+`); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := sqlDB.Exec(`CREATE UNIQUE INDEX foo ON t.kv (v)`); !testutils.IsError(err,
+		`duplicate key value \(v\)=\('x'\) violates unique constraint foo`) {
+		t.Fatalf("expected unique violation, but got %v", err)
+	}
+	if _, err := sqlDB.Exec(`CREATE INDEX foo ON t.kv (v)`); err != nil {
+		t.Fatal(err)
+	}
+
+	nameKey := structured.MakeNameMetadataKey(structured.MaxReservedDescID+1, "kv")
+	gr, err := kvDB.Get(nameKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	desc := structured.TableDescriptor{}
+	if err := kvDB.GetProto(gr.ValueBytes(), &desc); err != nil {
+		t.Fatal(err)
+	}
+
+	// The failed unique index was removed after its addition had committed,
+	// so its index ID wasn't reused.
+	if l := 1; len(desc.Indexes) != l {
+		t.Fatalf("expected %d index, but got %d", l, len(desc.Indexes))
+	}
+	if id := structured.ID(3); desc.Indexes[0].ID != id {
+		t.Fatalf("expected index ID %d, but got %d", id, desc.Indexes[0].ID)
+	}
+	if desc.Indexes[0].WriteOnly {
+		t.Fatal("expected the backfilled index to be public")
+	}
+
+	// The entries of the failed unique index were deleted.
+	failedPrefix := proto.Key(structured.MakeIndexKeyPrefix(desc.ID, 2))
+	if kvs, err := kvDB.Scan(failedPrefix, failedPrefix.PrefixEnd(), 0); err != nil {
+		t.Fatal(err)
+	} else if len(kvs) != 0 {
+		t.Fatalf("expected no key value pairs, but got %d", len(kvs))
+	}
+
+	// There is an index entry for every row, including the row with a NULL
+	// value.
+	indexPrefix := proto.Key(structured.MakeIndexKeyPrefix(desc.ID, desc.Indexes[0].ID))
+	if kvs, err := kvDB.Scan(indexPrefix, indexPrefix.PrefixEnd(), 0); err != nil {
+		t.Fatal(err)
+	} else if l := 5; len(kvs) != l {
+		t.Fatalf("expected %d key value pairs, but got %d", l, len(kvs))
+	}
+
+	var count int
+	if err := sqlDB.QueryRow(`SELECT COUNT(*) FROM t.kv WHERE v = 'x'`).Scan(&count); err != nil {
+		t.Fatal(err)
+	} else if count != 2 {
+		t.Fatalf("expected 2 rows, but got %d", count)
+	}
+}
+
+func TestCreateIndexInTransaction(t *testing.T) {
+	defer leaktest.AfterTest(t)
+	s, sqlDB, _ := setup(t)
+	defer cleanup(s, sqlDB)
+
+	if _, err := sqlDB.Exec(`
+CREATE DATABASE t;
+CREATE TABLE t.kv (k CHAR PRIMARY KEY, v CHAR);
+`); err != nil {
+		t.Fatal(err)
+	}
+
+	tx, err := sqlDB.Begin()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := tx.Exec(`CREATE INDEX foo ON t.kv (v)`); !testutils.IsError(err,
+		"CREATE INDEX cannot run inside a transaction block") {
+		t.Fatalf("expected error, but got %v", err)
+	}
+	if err := tx.Rollback(); err != nil {
+		t.Fatal(err)
+	}
+
+	// The index of a UNIQUE column is backfilled like the one of CREATE INDEX.
+	if tx, err = sqlDB.Begin(); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := tx.Exec(`ALTER TABLE t.kv ADD w INT UNIQUE`); !testutils.IsError(err,
+		`index "kv_w_key" cannot be added to table "t.kv" inside a transaction block`) {
+		t.Fatalf("expected error, but got %v", err)
+	}
+	if err := tx.Rollback(); err != nil {
+		t.Fatal(err)
+	}
+
+	// The index of a new table has no entries to backfill.
+	if tx, err = sqlDB.Begin(); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := tx.Exec(`CREATE TABLE t.ref (k CHAR PRIMARY KEY, kv CHAR REFERENCES t.kv)`); err != nil {
+		t.Fatal(err)
+	}
+	if err := tx.Commit(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestInterruptedIndexBackfill(t *testing.T) {
+	defer leaktest.AfterTest(t)
+	s, sqlDB, kvDB := setup(t)
+	defer cleanup(s, sqlDB)
+
+	if _, err := sqlDB.Exec(`
+CREATE DATABASE t;
+CREATE TABLE t.kv (k CHAR PRIMARY KEY, v CHAR);
+INSERT INTO t.kv VALUES ('a', 'x'), ('b', 'y'), ('c', 'z');
+`); err != nil {
+		t.Fatal(err)
+	}
+
+	// Add two write-only indexes, as left by backfills which were interrupted
+	// before they completed.
+	nameKey := structured.MakeNameMetadataKey(structured.MaxReservedDescID+1, "kv")
+	gr, err := kvDB.Get(nameKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	descKey := gr.ValueBytes()
+	desc := structured.TableDescriptor{}
+	if err := kvDB.GetProto(descKey, &desc); err != nil {
+		t.Fatal(err)
+	}
+	for _, name := range []string{"foo", "bar"} {
+		desc.Indexes = append(desc.Indexes, structured.IndexDescriptor{
+			Name:        name,
+			ID:          desc.NextIndexID,
+			ColumnNames: []string{"v"},
+			ColumnIDs:   []structured.ID{desc.Columns[1].ID},
+			WriteOnly:   true,
+		})
+		desc.NextIndexID++
+	}
+	if err := kvDB.Put(descKey, &desc); err != nil {
+		t.Fatal(err)
+	}
+	fooPrefix := proto.Key(structured.MakeIndexKeyPrefix(desc.ID, desc.Indexes[0].ID))
+	barPrefix := proto.Key(structured.MakeIndexKeyPrefix(desc.ID, desc.Indexes[1].ID))
+	if _, err := sqlDB.Exec(`INSERT INTO t.kv VALUES ('d', 'w')`); err != nil {
+		t.Fatal(err)
+	}
+
+	// A CREATE INDEX which doesn't match the write-only index fails.
+	if _, err := sqlDB.Exec(`CREATE UNIQUE INDEX foo ON t.kv (v)`); !testutils.IsError(err,
+		`index "foo" already exists`) {
+		t.Fatalf("expected error, but got %v", err)
+	}
+	// CREATE INDEX resumes the backfill of the index.
+	if _, err := sqlDB.Exec(`CREATE INDEX foo ON t.kv (v)`); err != nil {
+		t.Fatal(err)
+	}
+	// DROP INDEX removes the other index and its entries.
+	if _, err := sqlDB.Exec(`DROP INDEX t.kv.bar`); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := kvDB.GetProto(descKey, &desc); err != nil {
+		t.Fatal(err)
+	}
+	if l := 1; len(desc.Indexes) != l {
+		t.Fatalf("expected %d index, but got %d", l, len(desc.Indexes))
+	}
+	if desc.Indexes[0].Name != "foo" || desc.Indexes[0].WriteOnly {
+		t.Fatalf("expected the backfilled index foo to be public, but got %+v", desc.Indexes[0])
+	}
+	if kvs, err := kvDB.Scan(fooPrefix, fooPrefix.PrefixEnd(), 0); err != nil {
+		t.Fatal(err)
+	} else if l := 4; len(kvs) != l {
+		t.Fatalf("expected %d key value pairs, but got %d", l, len(kvs))
+	}
+	if kvs, err := kvDB.Scan(barPrefix, barPrefix.PrefixEnd(), 0); err != nil {
+		t.Fatal(err)
+	} else if len(kvs) != 0 {
+		t.Fatalf("expected no key value pairs, but got %d", len(kvs))
+	}
+}
+
+func TestWriteOnlyIndex(t *testing.T) {
+	defer leaktest.AfterTest(t)
+	s, sqlDB, kvDB := setup(t)
+	defer cleanup(s, sqlDB)
+
+	if _, err := sqlDB.Exec(`
+CREATE DATABASE t;
+CREATE TABLE t.kv (k CHAR PRIMARY KEY, v CHAR, CONSTRAINT foo INDEX (v));
+`); err != nil {
+		t.Fatal(err)
+	}
+
+	// Put the index back into the write-only state of an index which is being
+	// backfilled.
+	nameKey := structured.MakeNameMetadataKey(structured.MaxReservedDescID+1, "kv")
+	gr, err := kvDB.Get(nameKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	descKey := gr.ValueBytes()
+	desc := structured.TableDescriptor{}
+	if err := kvDB.GetProto(descKey, &desc); err != nil {
+		t.Fatal(err)
+	}
+	desc.Indexes[0].WriteOnly = true
+	if err := kvDB.Put(descKey, &desc); err != nil {
+		t.Fatal(err)
+	}
+
+	// Writes maintain the index.
+	if _, err := sqlDB.Exec(`
+INSERT INTO t.kv VALUES ('a', 'x'), ('b', 'y'), ('c', 'z');
+UPDATE t.kv SET v = 'w' WHERE k = 'b';
+DELETE FROM t.kv WHERE k = 'c';
+`); err != nil {
+		t.Fatal(err)
+	}
+	indexPrefix := proto.Key(structured.MakeIndexKeyPrefix(desc.ID, desc.Indexes[0].ID))
+	if kvs, err := kvDB.Scan(indexPrefix, indexPrefix.PrefixEnd(), 0); err != nil {
+		t.Fatal(err)
+	} else if l := 2; len(kvs) != l {
+		t.Fatalf("expected %d key value pairs, but got %d", l, len(kvs))
+	}
+
+	// Reads don't use the index.
+	var level int
+	var typ, description string
+	if err := sqlDB.QueryRow(`EXPLAIN SELECT k FROM t.kv WHERE v = 'x'`).Scan(&level, &typ, &description); err != nil {
+		t.Fatal(err)
+	} else if e := "t.kv@primary ALL"; description != e {
+		t.Fatalf("expected %q, but got %q", e, description)
+	}
+}
@@ -48,9 +48,7 @@ func (p *planner) Delete(n *parser.Delete) (planNode, error) {
 	// convenient access to index keys which we are not currently
 	// deleting.
 	node, err := p.Select(&parser.Select{
-		Exprs: parser.SelectExprs{
-			&parser.StarExpr{TableName: &parser.QualifiedName{Base: parser.Name(tableDesc.Name)}},
-		},
+		Exprs: allColumnsSelectExprs(tableDesc),
 		From:  parser.TableExprs{n.Table},
 		Where: n.Where,
 	})
@@ -69,49 +67,70 @@ func (p *planner) Delete(n *parser.Delete) (planNode, error) {
 		colIDtoRowIndex[c.ID] = i
 	}
 
-	primaryIndex := tableDesc.PrimaryIndex
-	primaryIndexKeyPrefix := structured.MakeIndexKeyPrefix(tableDesc.ID, primaryIndex.ID)
+	rh, err := p.makeReturningHelper(tableDesc, tableAlias(n.Table), n.Returning)
+	if err != nil {
+		return nil, err
+	}
 
+	fk := makeFKHelper(p.txn, p.evalCtx)
 	b := client.Batch{}
 
 	for node.Next() {
-		if err := node.Err(); err != nil {
-			return nil, err
-		}
 		values := node.Values()
 
-		primaryIndexKeySuffix, _, err := encodeIndexKey(primaryIndex.ColumnIDs, colIDtoRowIndex, values, nil)
-		if err != nil {
+		if err := fk.deleteRow(&b, tableDesc, colIDtoRowIndex, values); err != nil {
 			return nil, err
 		}
-		primaryIndexKey := bytes.Join([][]byte{primaryIndexKeyPrefix, primaryIndexKeySuffix}, nil)
-
-		// Delete the secondary indexes.
-		secondaryIndexEntries, err := encodeSecondaryIndexes(tableDesc.ID, tableDesc.Indexes, colIDtoRowIndex, values, primaryIndexKeySuffix)
-		if err != nil {
+		if err := rh.append(colIDtoRowIndex, values); err != nil {
 			return nil, err
 		}
+	}
+	if err := node.Err(); err != nil {
+		return nil, err
+	}
 
-		for _, secondaryIndexEntry := range secondaryIndexEntries {
-			if log.V(2) {
-				log.Infof("Del %q", secondaryIndexEntry.key)
-			}
-			b.Del(secondaryIndexEntry.key)
-		}
+	if err := p.txn.Run(&b); err != nil {
+		return nil, err
+	}
+	if err := fk.verifyPending(); err != nil {
+		return nil, err
+	}
+
+	// TODO(tamird/pmattis): return the number of affected rows
+	return rh.getResults(), nil
+}
+
+// deleteRow adds the writes which delete the row, whose values are indexed by
+// colIDtoRowIndex, and its secondary index entries to the batch.
+func deleteRow(b *client.Batch, tableDesc *structured.TableDescriptor,
+	colIDtoRowIndex map[structured.ID]int, values parser.DTuple) error {
+	primaryIndex := tableDesc.PrimaryIndex
+	primaryIndexKeyPrefix := structured.MakeIndexKeyPrefix(tableDesc.ID, primaryIndex.ID)
+	primaryIndexKeySuffix, _, err := encodeIndexKey(primaryIndex.ColumnIDs, colIDtoRowIndex, values, nil)
+	if err != nil {
+		return err
+	}
+	primaryIndexKey := bytes.Join([][]byte{primaryIndexKeyPrefix, primaryIndexKeySuffix}, nil)
+
+	// Delete the secondary indexes.
+	secondaryIndexEntries, err := encodeSecondaryIndexes(tableDesc.ID, tableDesc.Indexes, colIDtoRowIndex, values, primaryIndexKeySuffix)
+	if err != nil {
+		return err
+	}
 
-		// Delete the row.
-		rowStartKey := proto.Key(primaryIndexKey)
-		rowEndKey := rowStartKey.PrefixEnd()
+	for _, secondaryIndexEntry := range secondaryIndexEntries {
 		if log.V(2) {
-			log.Infof("DelRange %q - %q", rowStartKey, rowEndKey)
+			log.Infof("Del %q", secondaryIndexEntry.key)
 		}
-		b.DelRange(rowStartKey, rowEndKey)
+		b.Del(secondaryIndexEntry.key)
 	}
 
-	if err := p.db.Run(&b); err != nil {
-		return nil, err
+	// Delete the row.
+	rowStartKey := proto.Key(primaryIndexKey)
+	rowEndKey := rowStartKey.PrefixEnd()
+	if log.V(2) {
+		log.Infof("DelRange %q - %q", rowStartKey, rowEndKey)
 	}
-
-	// TODO(tamird/pmattis): return the number of affected rows
-	return &valuesNode{}, nil
+	b.DelRange(rowStartKey, rowEndKey)
+	return nil
 }
@@ -29,6 +29,9 @@ import (
 type conn struct {
 	sender  Sender
 	session []byte
+	// The state of the open transaction, if any. It is returned by the
+	// server and reflected back in the next request.
+	txn []byte
 }
 
 func (c *conn) Close() error {
@@ -40,6 +43,9 @@ func (c *conn) Prepare(query string) (driver.Stmt, error) {
 }
 
 func (c *conn) Begin() (driver.Tx, error) {
+	if _, err := c.Exec("BEGIN TRANSACTION", nil); err != nil {
+		return nil, err
+	}
 	return &tx{conn: c}, nil
 }
 
@@ -67,18 +73,12 @@ func (c *conn) Query(stmt string, args []driver.Value) (*rows, error) {
 		case string:
 			param.StringVal = &value
 		case time.Time:
-			// TODO(vivek): pass in time as an input that can be interpreted
-			// by the server.
-			time, err := value.MarshalBinary()
-			if err != nil {
-				return nil, err
-			}
-			param.BytesVal = time
+			param.TimeVal = NewTimestamp(value)
 		}
 		params = append(params, param)
 	}
 	return c.send(Request{
-		RequestHeader: RequestHeader{Session: c.session},
+		RequestHeader: RequestHeader{Session: c.session, Txn: c.txn},
 		Sql:           stmt,
 		Params:        params,
 	})
@@ -90,6 +90,9 @@ func (c *conn) send(args Request) (*rows, error) {
 	if err != nil {
 		return nil, err
 	}
+	// The transaction state is returned even if the request failed, as an
+	// error aborts the open transaction but does not end it.
+	c.txn = resp.Txn
 	if resp.Error != nil {
 		return nil, resp.Error
 	}
@@ -120,6 +123,10 @@ func (c *conn) send(args Request) (*rows, error) {
 				t[j] = datum.BytesVal
 			} else if datum.StringVal != nil {
 				t[j] = []byte(*datum.StringVal)
+			} else if datum.TimeVal != nil {
+				t[j] = datum.TimeVal.GoTime()
+			} else if datum.DecimalVal != nil {
+				t[j] = []byte(datum.DecimalVal.Value)
 			}
 			if !driver.IsScanValue(t[j]) {
 				panic(fmt.Sprintf("unsupported type %T returned by database", t[j]))
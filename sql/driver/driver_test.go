@@ -18,9 +18,11 @@
 package driver_test
 
 import (
+	"bytes"
 	"database/sql"
 	"fmt"
 	"testing"
+	"time"
 
 	"github.com/cockroachdb/cockroach/server"
 	"github.com/cockroachdb/cockroach/testutils"
@@ -204,6 +206,232 @@ func TestPlaceholders(t *testing.T) {
 
 }
 
+func TestTimestamps(t *testing.T) {
+	defer leaktest.AfterTest(t)
+	s, db := setup(t)
+	defer cleanup(s, db)
+
+	if _, err := db.Exec(`CREATE DATABASE t`); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := db.Exec(`CREATE TABLE t.events (ts TIMESTAMP PRIMARY KEY, d DATE)`); err != nil {
+		t.Fatal(err)
+	}
+	ts := time.Date(2015, 9, 2, 10, 30, 5, 123456789, time.FixedZone("EDT", -4*60*60))
+	if _, err := db.Exec(`INSERT INTO t.events VALUES ($1, $2::date)`, ts, ts); err != nil {
+		t.Fatal(err)
+	}
+
+	var rts, rday time.Time
+	if err := db.QueryRow(`SELECT ts, d FROM t.events WHERE ts = $1`, ts).Scan(&rts, &rday); err != nil {
+		t.Fatal(err)
+	}
+	if !rts.Equal(ts) {
+		t.Errorf("expected %s, but got %s", ts, rts)
+	}
+	if e := time.Date(2015, 9, 2, 0, 0, 0, 0, time.UTC); !rday.Equal(e) {
+		t.Errorf("expected %s, but got %s", e, rday)
+	}
+}
+
+func TestBytes(t *testing.T) {
+	defer leaktest.AfterTest(t)
+	s, db := setup(t)
+	defer cleanup(s, db)
+
+	if _, err := db.Exec(`CREATE DATABASE t`); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := db.Exec(`CREATE TABLE t.hashes (h BYTES PRIMARY KEY, v BLOB)`); err != nil {
+		t.Fatal(err)
+	}
+	h := []byte{0x00, 0xff, 0x80, '\'', 0x00}
+	if _, err := db.Exec(`INSERT INTO t.hashes VALUES ($1, x'00ff')`, h); err != nil {
+		t.Fatal(err)
+	}
+
+	var rh, rv []byte
+	if err := db.QueryRow(`SELECT h, v FROM t.hashes WHERE h = $1`, h).Scan(&rh, &rv); err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(rh, h) {
+		t.Errorf("expected %q, but got %q", h, rh)
+	}
+	if e := []byte{0x00, 0xff}; !bytes.Equal(rv, e) {
+		t.Errorf("expected %q, but got %q", e, rv)
+	}
+}
+
+func TestTransactions(t *testing.T) {
+	defer leaktest.AfterTest(t)
+	s, db := setup(t)
+	defer cleanup(s, db)
+
+	if _, err := db.Exec(`CREATE DATABASE t`); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := db.Exec(`CREATE TABLE t.kv (k CHAR PRIMARY KEY, v CHAR)`); err != nil {
+		t.Fatal(err)
+	}
+
+	countRows := func(q interface {
+		QueryRow(string, ...interface{}) *sql.Row
+	}) int {
+		var count int
+		if err := q.QueryRow(`SELECT COUNT(*) FROM t.kv`).Scan(&count); err != nil {
+			t.Fatal(err)
+		}
+		return count
+	}
+
+	// The writes of a rolled back transaction are discarded, though they are
+	// visible within the transaction.
+	tx, err := db.Begin()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := tx.Exec(`INSERT INTO t.kv VALUES ('a', 'b')`); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := tx.Exec(`INSERT INTO t.kv VALUES ('c', 'd')`); err != nil {
+		t.Fatal(err)
+	}
+	if count := countRows(tx); count != 2 {
+		t.Fatalf("expected 2 rows within the transaction, but found %d", count)
+	}
+	if err := tx.Rollback(); err != nil {
+		t.Fatal(err)
+	}
+	if count := countRows(db); count != 0 {
+		t.Fatalf("expected 0 rows after rollback, but found %d", count)
+	}
+
+	// The writes of a committed transaction are visible.
+	if tx, err = db.Begin(); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := tx.Exec(`SET TRANSACTION ISOLATION LEVEL SNAPSHOT`); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := tx.Exec(`INSERT INTO t.kv VALUES ('a', 'b')`); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := tx.Exec(`SET TRANSACTION ISOLATION LEVEL SERIALIZABLE`); !isError(err, "must be called before any query") {
+		t.Fatalf("expected error, but found %v", err)
+	}
+	if err := tx.Commit(); !isError(err, "cannot commit an aborted transaction") {
+		t.Fatalf("expected error, but found %v", err)
+	}
+	if count := countRows(db); count != 0 {
+		t.Fatalf("expected 0 rows after aborted commit, but found %d", count)
+	}
+
+	if tx, err = db.Begin(); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := tx.Exec(`INSERT INTO t.kv VALUES ('a', 'b')`); err != nil {
+		t.Fatal(err)
+	}
+	if err := tx.Commit(); err != nil {
+		t.Fatal(err)
+	}
+	if count := countRows(db); count != 1 {
+		t.Fatalf("expected 1 row after commit, but found %d", count)
+	}
+
+	// An error aborts the transaction and the statements which follow fail
+	// until it is rolled back.
+	if tx, err = db.Begin(); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := tx.Exec(`INSERT INTO t.kv VALUES ('c', 'd')`); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := tx.Exec(`INSERT INTO t.kv VALUES ('a', 'b')`); !isError(err, "duplicate key value") {
+		t.Fatalf("expected error, but found %v", err)
+	}
+	if _, err := tx.Exec(`INSERT INTO t.kv VALUES ('e', 'f')`); !isError(err, "current transaction is aborted") {
+		t.Fatalf("expected error, but found %v", err)
+	}
+	if err := tx.Rollback(); err != nil {
+		t.Fatal(err)
+	}
+	if count := countRows(db); count != 1 {
+		t.Fatalf("expected 1 row after rollback, but found %d", count)
+	}
+
+	// The transaction statements fail outside of their context.
+	if _, err := db.Exec(`COMMIT`); !isError(err, "there is no transaction in progress") {
+		t.Fatalf("expected error, but found %v", err)
+	}
+	if _, err := db.Exec(`BEGIN; BEGIN`); !isError(err, "there is already a transaction in progress") {
+		t.Fatalf("expected error, but found %v", err)
+	}
+}
+
+func TestMultipleStatements(t *testing.T) {
+	defer leaktest.AfterTest(t)
+	s, db := setup(t)
+	defer cleanup(s, db)
+
+	// The statements of a request are executed atomically.
+	if _, err := db.Exec(`
+CREATE DATABASE t;
+CREATE TABLE t.kv (k CHAR PRIMARY KEY, v CHAR);
+INSERT INTO t.kv VALUES ('a', 'b');
+`); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := db.Exec(`
+INSERT INTO t.kv VALUES ('c', 'd');
+INSERT INTO t.kv VALUES ('e', 'f');
+INSERT INTO t.kv VALUES ('a', 'b');
+INSERT INTO t.kv VALUES ('g', 'h');
+`); !isError(err, "duplicate key value") {
+		t.Fatalf("expected error, but found %v", err)
+	}
+
+	var count int
+	if err := db.QueryRow(`SELECT COUNT(*) FROM t.kv`).Scan(&count); err != nil {
+		t.Fatal(err)
+	}
+	if count != 1 {
+		t.Fatalf("expected 1 row, but found %d", count)
+	}
+}
+
+func TestReturning(t *testing.T) {
+	defer leaktest.AfterTest(t)
+	s, db := setup(t)
+	defer cleanup(s, db)
+
+	if _, err := db.Exec(`
+CREATE DATABASE t;
+CREATE TABLE t.kv (k INT PRIMARY KEY, v INT);
+`); err != nil {
+		t.Fatal(err)
+	}
+
+	// The rows returned by RETURNING are counted as the affected rows.
+	res, err := db.Exec(`INSERT INTO t.kv VALUES (1, 10), (2, 20) RETURNING k`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if n, err := res.RowsAffected(); err != nil {
+		t.Fatal(err)
+	} else if n != 2 {
+		t.Fatalf("expected 2 affected rows, but found %d", n)
+	}
+
+	var k, v int
+	if err := db.QueryRow(`UPDATE t.kv SET v = 30 WHERE k = $1 RETURNING k, v`, 2).Scan(&k, &v); err != nil {
+		t.Fatal(err)
+	}
+	if k != 2 || v != 30 {
+		t.Fatalf("expected (2, 30), but found (%d, %d)", k, v)
+	}
+}
+
 func TestInsecure(t *testing.T) {
 	defer leaktest.AfterTest(t)
 	// Start test server in insecure mode.
@@ -17,16 +17,18 @@
 
 package driver
 
-import "fmt"
-
+// tx implements the sql/driver.Tx interface. The transaction is started by
+// conn.Begin and its state is carried by the conn.
 type tx struct {
 	conn *conn
 }
 
 func (t *tx) Commit() error {
-	return fmt.Errorf("TODO(pmattis): unimplemented")
+	_, err := t.conn.Exec("COMMIT TRANSACTION", nil)
+	return err
 }
 
 func (t *tx) Rollback() error {
-	return fmt.Errorf("TODO(pmattis): unimplemented")
+	_, err := t.conn.Exec("ROLLBACK TRANSACTION", nil)
+	return err
 }
@@ -17,7 +17,10 @@
 
 package driver
 
-import "strconv"
+import (
+	"strconv"
+	"time"
+)
 
 const (
 	// Endpoint is the URL path prefix which accepts incoming
@@ -44,9 +47,25 @@ func (d Datum) String() string {
 	if d.StringVal != nil {
 		return *d.StringVal
 	}
+	if d.TimeVal != nil {
+		return d.TimeVal.GoTime().Format(time.RFC3339Nano)
+	}
+	if d.DecimalVal != nil {
+		return d.DecimalVal.Value
+	}
 	return "NULL"
 }
 
+// NewTimestamp converts the time to a timestamp.
+func NewTimestamp(t time.Time) *Datum_Timestamp {
+	return &Datum_Timestamp{Sec: t.Unix(), Nsec: uint32(t.Nanosecond())}
+}
+
+// GoTime converts the timestamp to a time.Time in UTC.
+func (t Datum_Timestamp) GoTime() time.Time {
+	return time.Unix(t.Sec, int64(t.Nsec)).UTC()
+}
+
 // Header returns the request header.
 func (r *RequestHeader) Header() *RequestHeader {
 	return r
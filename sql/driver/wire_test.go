@@ -19,6 +19,7 @@ package driver
 
 import (
 	"testing"
+	"time"
 
 	"github.com/cockroachdb/cockroach/util/leaktest"
 )
@@ -43,6 +44,14 @@ func dString(v string) Datum {
 	return Datum{StringVal: &v}
 }
 
+func dTime(v time.Time) Datum {
+	return Datum{TimeVal: NewTimestamp(v)}
+}
+
+func dDecimal(v string) Datum {
+	return Datum{DecimalVal: &Datum_Decimal{Value: v}}
+}
+
 func TestDatumString(t *testing.T) {
 	defer leaktest.AfterTest(t)
 
@@ -57,6 +66,9 @@ func TestDatumString(t *testing.T) {
 		{dFloat(4.5), "4.5"},
 		{dBytes([]byte("6")), "6"},
 		{dString("hello"), "hello"},
+		{dTime(time.Date(2015, 9, 2, 10, 30, 5, 7, time.UTC)), "2015-09-02T10:30:05.000000007Z"},
+		{dTime(time.Unix(-1, 0)), "1969-12-31T23:59:59Z"},
+		{dDecimal("-12.50"), "-12.50"},
 	}
 	for i, d := range testData {
 		s := d.datum.String()
@@ -21,18 +21,111 @@ import (
 	"fmt"
 
 	"github.com/cockroachdb/cockroach/client"
+	"github.com/cockroachdb/cockroach/proto"
 	"github.com/cockroachdb/cockroach/sql/parser"
 	"github.com/cockroachdb/cockroach/structured"
 )
 
+// DropIndex drops an index. The name of the index must be qualified with the
+// name of its table. The entries of the index are deleted in the same
+// transaction which removes the index from the table descriptor. A
+// write-only index, whose backfill is running or was interrupted, can be
+// dropped as well, which stops its backfill.
+// Privileges: WRITE on table.
+//   Notes: postgres allows only the index owner to DROP an index.
+//          mysql requires the INDEX privilege on the table.
+func (p *planner) DropIndex(n *parser.DropIndex) (planNode, error) {
+	for _, indexQualifiedName := range n.Names {
+		l := len(indexQualifiedName.Indirect)
+		if l == 0 {
+			return nil, fmt.Errorf("index name must be qualified with its table: %s", indexQualifiedName)
+		}
+		indexName, ok := indexQualifiedName.Indirect[l-1].(parser.NameIndirection)
+		if !ok {
+			return nil, fmt.Errorf("invalid index name: %s", indexQualifiedName)
+		}
+		tableQualifiedName := &parser.QualifiedName{
+			Base:     indexQualifiedName.Base,
+			Indirect: indexQualifiedName.Indirect[:l-1],
+		}
+		if err := p.normalizeTableName(tableQualifiedName); err != nil {
+			return nil, err
+		}
+
+		dbDesc, err := p.getDatabaseDesc(tableQualifiedName.Database())
+		if err != nil {
+			return nil, err
+		}
+
+		tbKey := tableKey{dbDesc.ID, tableQualifiedName.Table()}
+		desc, err := getTableDescInTxn(p.txn, tbKey)
+		if err != nil {
+			return nil, err
+		}
+
+		if !desc.HasPrivilege(p.user, parser.PrivilegeWrite) {
+			return nil, fmt.Errorf("user %s does not have %s privilege on table %s",
+				p.user, parser.PrivilegeWrite, desc.Name)
+		}
+
+		if desc.PrimaryIndex.Name == string(indexName) {
+			return nil, fmt.Errorf("cannot drop the primary index of table %q", tbKey.Name())
+		}
+		i := -1
+		for j, index := range desc.Indexes {
+			if index.Name == string(indexName) {
+				i = j
+				break
+			}
+		}
+		if i == -1 {
+			if n.IfExists {
+				// Noop.
+				continue
+			}
+			return nil, fmt.Errorf("index %q does not exist", string(indexName))
+		}
+
+		index := desc.Indexes[i]
+		if err := p.checkIndexNotReferenced(desc, index); err != nil {
+			return nil, err
+		}
+		desc.Indexes = append(desc.Indexes[:i], desc.Indexes[i+1:]...)
+		// The rows referring to a row of the referenced table by a foreign key
+		// of the table are looked up by an index on the referencing columns.
+		for j := range desc.ForeignKeys {
+			if referencingIndex(desc, &desc.ForeignKeys[j]) == nil {
+				return nil, fmt.Errorf("index %q is in use as a foreign key constraint", index.Name)
+			}
+		}
+
+		indexPrefix := proto.Key(structured.MakeIndexKeyPrefix(desc.ID, index.ID))
+		b := &client.Batch{}
+		b.Put(structured.MakeDescMetadataKey(desc.ID), desc)
+		b.DelRange(indexPrefix, indexPrefix.PrefixEnd())
+		if err := p.txn.Run(b); err != nil {
+			return nil, err
+		}
+	}
+	return &valuesNode{}, nil
+}
+
 // DropTable drops a table.
 // Privileges: WRITE on table.
 //   Notes: postgres allows only the table owner to DROP a table.
 //          mysql requires the DROP privilege on the table.
 func (p *planner) DropTable(n *parser.DropTable) (planNode, error) {
-	// TODO(XisiHuang): should do truncate and delete descriptor in
-	// the same txn
-	for i, tableQualifiedName := range n.Names {
+	// The descriptors of all of the tables are looked up first, as a table
+	// referenced by foreign keys can only be dropped together with the tables
+	// referencing it.
+	type droppedTable struct {
+		nameKey proto.Key
+		descKey proto.Key
+		desc    *structured.TableDescriptor
+	}
+	var tables []droppedTable
+	dropped := map[structured.ID]struct{}{}
+	for _, tableQualifiedName := range n.Names {
 		if err := p.normalizeTableName(tableQualifiedName); err != nil {
 			return nil, err
 		}
@@ -44,7 +137,7 @@ func (p *planner) DropTable(n *parser.DropTable) (planNode, error) {
 
 		tbKey := tableKey{dbDesc.ID, tableQualifiedName.Table()}
 		nameKey := tbKey.Key()
-		gr, err := p.db.Get(nameKey)
+		gr, err := p.txn.Get(nameKey)
 		if err != nil {
 			return nil, err
 		}
@@ -58,8 +151,8 @@ func (p *planner) DropTable(n *parser.DropTable) (planNode, error) {
 			return nil, fmt.Errorf("table %q does not exist", tbKey.Name())
 		}
 
-		tableDesc := structured.TableDescriptor{}
-		if err := p.db.GetProto(gr.ValueBytes(), &tableDesc); err != nil {
+		tableDesc := &structured.TableDescriptor{}
+		if err := p.txn.GetProto(gr.ValueBytes(), tableDesc); err != nil {
 			return nil, err
 		}
 		if err := tableDesc.Validate(); err != nil {
@@ -71,23 +164,91 @@ func (p *planner) DropTable(n *parser.DropTable) (planNode, error) {
 				p.user, parser.PrivilegeWrite, tableDesc.Name)
 		}
 
-		if _, err = p.Truncate(&parser.Truncate{Tables: n.Names[i : i+1]}); err != nil {
+		tables = append(tables, droppedTable{nameKey: nameKey, descKey: gr.ValueBytes(), desc: tableDesc})
+		dropped[tableDesc.ID] = struct{}{}
+	}
+
+	for _, t := range tables {
+		for _, id := range t.desc.ReferencedBy {
+			if _, ok := dropped[id]; !ok {
+				return nil, fmt.Errorf("cannot drop table %q because other objects depend on it", t.desc.Name)
+			}
+		}
+	}
+
+	for _, t := range tables {
+		// Remove the table from the tables referenced by the referenced tables
+		// which are not dropped.
+		if err := p.removeBackReferences(t.desc, dropped); err != nil {
 			return nil, err
 		}
 
-		// Delete table descriptor
-		descKey := gr.ValueBytes()
 		b := &client.Batch{}
-		b.Del(descKey)
-		b.Del(nameKey)
-		err = p.db.Run(b)
-		if err != nil {
+		truncateTable(b, t.desc)
+		for _, col := range t.desc.Columns {
+			if col.Serial {
+				b.Del(structured.MakeSerialKey(t.desc.ID, col.ID))
+			}
+		}
+		// Delete table descriptor
+		b.Del(t.descKey)
+		b.Del(t.nameKey)
+		if err := p.txn.Run(b); err != nil {
 			return nil, err
 		}
 	}
 	return &valuesNode{}, nil
 }
 
+// removeBackReferences removes the table from the ReferencedBy lists of the
+// tables it references by foreign keys, except for the skipped tables.
+func (p *planner) removeBackReferences(desc *structured.TableDescriptor, skip map[structured.ID]struct{}) error {
+	seen := map[structured.ID]struct{}{}
+	for _, fk := range desc.ForeignKeys {
+		if _, ok := skip[fk.Table]; ok {
+			continue
+		}
+		if _, ok := seen[fk.Table]; ok {
+			continue
+		}
+		seen[fk.Table] = struct{}{}
+		ref, err := getTableDescByID(p.txn, fk.Table)
+		if err != nil {
+			return err
+		}
+		for i, id := range ref.ReferencedBy {
+			if id == desc.ID {
+				ref.ReferencedBy = append(ref.ReferencedBy[:i], ref.ReferencedBy[i+1:]...)
+				break
+			}
+		}
+		if err := p.txn.Put(structured.MakeDescMetadataKey(ref.ID), ref); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// checkIndexNotReferenced returns an error if a foreign key references the
+// index of the table.
+func (p *planner) checkIndexNotReferenced(desc *structured.TableDescriptor, index structured.IndexDescriptor) error {
+	for _, id := range desc.ReferencedBy {
+		child := desc
+		if id != desc.ID {
+			var err error
+			if child, err = getTableDescByID(p.txn, id); err != nil {
+				return err
+			}
+		}
+		for _, fk := range child.ForeignKeys {
+			if fk.Table == desc.ID && fk.Index == index.ID {
+				return fmt.Errorf("index %q is in use as a foreign key constraint", index.Name)
+			}
+		}
+	}
+	return nil
+}
+
 // DropDatabase drops a database.
 // Privileges: WRITE on database.
 //   Notes: postgres allows only the database owner to DROP a database.
@@ -102,7 +263,7 @@ func (p *planner) DropDatabase(n *parser.DropDatabase) (planNode, error) {
 	}
 
 	nameKey := structured.MakeNameMetadataKey(structured.RootNamespaceID, string(n.Name))
-	gr, err := p.db.Get(nameKey)
+	gr, err := p.txn.Get(nameKey)
 	if err != nil {
 		return nil, err
 	}
@@ -116,7 +277,7 @@ func (p *planner) DropDatabase(n *parser.DropDatabase) (planNode, error) {
 
 	descKey := gr.ValueBytes()
 	desc := structured.DatabaseDescriptor{}
-	if err := p.db.GetProto(descKey, &desc); err != nil {
+	if err := p.txn.GetProto(descKey, &desc); err != nil {
 		return nil, err
 	}
 	if err := desc.Validate(); err != nil {
@@ -140,7 +301,7 @@ func (p *planner) DropDatabase(n *parser.DropDatabase) (planNode, error) {
 	b := &client.Batch{}
 	b.Del(descKey)
 	b.Del(nameKey)
-	if err := p.db.Run(b); err != nil {
+	if err := p.txn.Run(b); err != nil {
 		return nil, err
 	}
 	return &valuesNode{}, nil
@@ -97,6 +97,65 @@ func TestDropTable(t *testing.T) {
 	}
 }
 
+func TestDropIndex(t *testing.T) {
+	defer leaktest.AfterTest(t)
+	s, sqlDB, kvDB := setup(t)
+	defer cleanup(s, sqlDB)
+
+	if _, err := sqlDB.Exec(`
+CREATE DATABASE t;
+CREATE TABLE t.kv (k CHAR PRIMARY KEY, v CHAR, CONSTRAINT foo UNIQUE (v));
+INSERT INTO t.kv VALUES ('c', 'e'), ('a', 'c'),('b', 'd')
+`); err != nil {
+		t.Fatal(err)
+	}
+
+	nameKey := structured.MakeNameMetadataKey(structured.MaxReservedDescID+1, "kv")
+	gr, err := kvDB.Get(nameKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	descKey := gr.ValueBytes()
+	desc := structured.TableDescriptor{}
+	if err := kvDB.GetProto(descKey, &desc); err != nil {
+		t.Fatal(err)
+	}
+
+	indexPrefix := proto.Key(structured.MakeIndexKeyPrefix(desc.ID, desc.Indexes[0].ID))
+	if kvs, err := kvDB.Scan(indexPrefix, indexPrefix.PrefixEnd(), 0); err != nil {
+		t.Fatal(err)
+	} else if l := 3; len(kvs) != l {
+		t.Fatalf("expected %d key value pairs, but got %d", l, len(kvs))
+	}
+
+	if _, err := sqlDB.Exec("DROP INDEX t.kv.foo"); err != nil {
+		t.Fatal(err)
+	}
+
+	if kvs, err := kvDB.Scan(indexPrefix, indexPrefix.PrefixEnd(), 0); err != nil {
+		t.Fatal(err)
+	} else if l := 0; len(kvs) != l {
+		t.Fatalf("expected %d key value pairs, but got %d", l, len(kvs))
+	}
+
+	if err := kvDB.GetProto(descKey, &desc); err != nil {
+		t.Fatal(err)
+	}
+	if l := 0; len(desc.Indexes) != l {
+		t.Fatalf("expected %d indexes, but got %d", l, len(desc.Indexes))
+	}
+
+	// The rows are still present and writes no longer maintain the index.
+	if _, err := sqlDB.Exec(`INSERT INTO t.kv VALUES ('d', 'e')`); err != nil {
+		t.Fatal(err)
+	}
+	if kvs, err := kvDB.Scan(indexPrefix, indexPrefix.PrefixEnd(), 0); err != nil {
+		t.Fatal(err)
+	} else if l := 0; len(kvs) != l {
+		t.Fatalf("expected %d key value pairs, but got %d", l, len(kvs))
+	}
+}
+
 func TestDropDatabase(t *testing.T) {
 	defer leaktest.AfterTest(t)
 	s, sqlDB, kvDB := setup(t)
@@ -0,0 +1,203 @@
+// Copyright 2015 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License. See the AUTHORS file
+// for names of contributors.
+
+package sql
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+
+	"github.com/cockroachdb/cockroach/proto"
+	"github.com/cockroachdb/cockroach/sql/parser"
+	"github.com/cockroachdb/cockroach/structured"
+)
+
+// Explain executes the explain statement, returning a row for each node of
+// the plan of the statement. The rows are ordered by a pre-order traversal of
+// the plan and the level of each row is its depth in the plan. VERBOSE adds
+// the filter and render expressions of the nodes and the columns they
+// produce.
+// Privileges: the privileges required by the explained statement.
+func (p *planner) Explain(n *parser.Explain) (planNode, error) {
+	verbose := false
+	for _, opt := range n.Options {
+		if strings.EqualFold(opt, "VERBOSE") {
+			verbose = true
+		} else {
+			return nil, fmt.Errorf("unsupported EXPLAIN option: %s", opt)
+		}
+	}
+
+	switch n.Statement.(type) {
+	case *parser.Select, *parser.ParenSelect, *parser.Union, parser.Values:
+	default:
+		// The other statements, including INSERT, UPDATE, DELETE and UPSERT,
+		// perform their modifications when they are planned.
+		return nil, fmt.Errorf("EXPLAIN is not supported for %s statements",
+			strings.Fields(n.Statement.String())[0])
+	}
+
+	// The subqueries of the statement are planned but not run, and their
+	// plans follow the plan of the statement.
+	p.explaining = true
+	defer func() {
+		p.explaining = false
+		p.subqueryPlans = nil
+	}()
+	plan, err := p.makePlan(n.Statement)
+	if err != nil {
+		return nil, err
+	}
+	v := &valuesNode{columns: []string{"Level", "Type", "Description"}}
+	if verbose {
+		v.columns = append(v.columns, "Columns")
+	}
+	populateExplain(v, plan, 0, verbose)
+	for _, s := range p.subqueryPlans {
+		row := parser.DTuple{parser.DInt(0), parser.DString("subquery"), parser.DString(s.expr)}
+		if verbose {
+			row = append(row, parser.DString(fmt.Sprintf("(%s)", strings.Join(s.plan.Columns(), ", "))))
+		}
+		v.rows = append(v.rows, row)
+		populateExplain(v, s.plan, 1, verbose)
+	}
+	return v, nil
+}
+
+func populateExplain(v *valuesNode, plan planNode, level int, verbose bool) {
+	name, description, children := plan.ExplainPlan(verbose)
+	row := parser.DTuple{
+		parser.DInt(level),
+		parser.DString(name),
+		parser.DString(description),
+	}
+	if verbose {
+		row = append(row, parser.DString(fmt.Sprintf("(%s)", strings.Join(plan.Columns(), ", "))))
+	}
+	v.rows = append(v.rows, row)
+	for _, child := range children {
+		populateExplain(v, child, level+1, verbose)
+	}
+}
+
+// explainParts joins the non-empty parts of the description of a plan node.
+func explainParts(parts ...string) string {
+	var buf bytes.Buffer
+	for _, part := range parts {
+		if part == "" {
+			continue
+		}
+		if buf.Len() > 0 {
+			_, _ = buf.WriteString("; ")
+		}
+		_, _ = buf.WriteString(part)
+	}
+	return buf.String()
+}
+
+// explainExprs formats a list of expressions with the specified label, or
+// returns "" if the list is empty.
+func explainExprs(label string, exprs ...parser.Expr) string {
+	var buf bytes.Buffer
+	for _, expr := range exprs {
+		if expr == nil {
+			continue
+		}
+		if buf.Len() == 0 {
+			fmt.Fprintf(&buf, "%s: ", label)
+		} else {
+			_, _ = buf.WriteString(", ")
+		}
+		_, _ = buf.WriteString(expr.String())
+	}
+	return buf.String()
+}
+
+// prettySpans returns the spans of a scan of an index, with the values of the
+// index columns decoded from the keys. The spans are formatted as
+// "/start-/end" and an empty start or end denotes the beginning or end of the
+// index.
+func prettySpans(desc *structured.TableDescriptor, index *structured.IndexDescriptor, spans []span) string {
+	if len(spans) == 0 {
+		return "ALL"
+	}
+	ids := index.ColumnIDs
+	if index.ID != desc.PrimaryIndex.ID && !index.Unique {
+		// The keys of a non-unique secondary index are suffixed with the primary
+		// key columns.
+		ids = append(append([]structured.ID(nil), ids...), desc.PrimaryIndex.ColumnIDs...)
+	}
+	prefix := proto.Key(structured.MakeIndexKeyPrefix(desc.ID, index.ID))
+	var buf bytes.Buffer
+	for i, s := range spans {
+		if i > 0 {
+			_, _ = buf.WriteString(", ")
+		}
+		_, _ = buf.WriteString(prettyKey(desc, ids, prefix, s.start))
+		_, _ = buf.WriteString("-")
+		_, _ = buf.WriteString(prettyKey(desc, ids, prefix, s.end))
+	}
+	return buf.String()
+}
+
+// prettyKey formats the values of the columns encoded in a key of an index as
+// "/v1/v2". The part of the key which cannot be decoded, such as a key
+// produced by PrefixEnd, is quoted.
+func prettyKey(desc *structured.TableDescriptor, ids []structured.ID, prefix, key proto.Key) string {
+	if !bytes.HasPrefix(key, prefix) {
+		if key.Equal(prefix.PrefixEnd()) {
+			return ""
+		}
+		return fmt.Sprintf("%q", []byte(key))
+	}
+	rest := []byte(key[len(prefix):])
+	var buf bytes.Buffer
+	for _, id := range ids {
+		if len(rest) == 0 {
+			break
+		}
+		d, remaining, ok := decodePrettyVal(desc, id, rest)
+		if !ok {
+			break
+		}
+		fmt.Fprintf(&buf, "/%s", d)
+		rest = remaining
+	}
+	if len(rest) > 0 {
+		fmt.Fprintf(&buf, "/%q", rest)
+	}
+	return buf.String()
+}
+
+// decodePrettyVal decodes the value of a column from the start of a key. The
+// key may have been modified by PrefixEnd and so decoding failures, which
+// cause the encoding routines to panic, are recovered.
+func decodePrettyVal(desc *structured.TableDescriptor, id structured.ID, key []byte) (d parser.Datum, rest []byte, ok bool) {
+	col, err := desc.FindColumnByID(id)
+	if err != nil {
+		return nil, nil, false
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			ok = false
+		}
+	}()
+	vals := map[string]parser.Datum{}
+	if rest, err = decodeKeyVals(desc, []structured.ID{id}, vals, key); err != nil {
+		return nil, nil, false
+	}
+	return vals[col.Name], rest, true
+}
@@ -0,0 +1,192 @@
+// Copyright 2015 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License. See the AUTHORS file
+// for names of contributors.
+
+package sql_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/cockroachdb/cockroach/util/leaktest"
+)
+
+func TestExplain(t *testing.T) {
+	defer leaktest.AfterTest(t)
+	s, sqlDB, _ := setup(t)
+	defer cleanup(s, sqlDB)
+
+	for _, stmt := range []string{
+		`CREATE DATABASE t`,
+		`CREATE TABLE t.kv (k INT PRIMARY KEY, v INT, w CHAR, CONSTRAINT v INDEX (v))`,
+		`CREATE TABLE t.ab (a INT PRIMARY KEY, b INT)`,
+		`INSERT INTO t.kv VALUES (1, 2, 'a'), (3, 4, 'b')`,
+	} {
+		if _, err := sqlDB.Exec(stmt); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	explain := func(query string) string {
+		rows, err := sqlDB.Query(query)
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer rows.Close()
+		cols, err := rows.Columns()
+		if err != nil {
+			t.Fatal(err)
+		}
+		vals := make([]interface{}, len(cols))
+		for i := range vals {
+			vals[i] = new(string)
+		}
+		var lines []string
+		for rows.Next() {
+			if err := rows.Scan(vals...); err != nil {
+				t.Fatal(err)
+			}
+			var fields []string
+			for _, v := range vals {
+				fields = append(fields, *v.(*string))
+			}
+			lines = append(lines, strings.Join(fields, " | "))
+		}
+		if err := rows.Err(); err != nil {
+			t.Fatal(err)
+		}
+		return strings.Join(lines, "\n")
+	}
+
+	testData := []struct {
+		query    string
+		expected []string
+	}{
+		{`EXPLAIN SELECT * FROM t.kv`, []string{
+			"0 | scan | t.kv@primary ALL",
+		}},
+		{`EXPLAIN SELECT * FROM t.kv WHERE k = 3`, []string{
+			"0 | scan | t.kv@primary /3-/4",
+		}},
+		{`EXPLAIN SELECT * FROM t.kv WHERE k > 3 AND k <= 7`, []string{
+			"0 | scan | t.kv@primary /4-/8",
+		}},
+		{`EXPLAIN SELECT * FROM t.kv WHERE k IN (1, 5)`, []string{
+			"0 | scan | t.kv@primary /1-/2, /5-/6",
+		}},
+		{`EXPLAIN SELECT w FROM t.kv WHERE v = 2`, []string{
+			"0 | index-join | t.kv@primary",
+			"1 | scan | t.kv@v /2-/3",
+		}},
+		{`EXPLAIN SELECT * FROM t.kv WHERE w = 'a'`, []string{
+			"0 | scan | t.kv@primary ALL",
+		}},
+		{`EXPLAIN SELECT * FROM t.kv ORDER BY k LIMIT 5 OFFSET 2`, []string{
+			"0 | limit | count: 5, offset: 2",
+			"1 | nosort | +k",
+			"2 | scan | t.kv@primary ALL; max rows: 7",
+		}},
+		{`EXPLAIN SELECT * FROM t.kv ORDER BY w DESC LIMIT 5`, []string{
+			"0 | limit | count: 5, offset: 0",
+			"1 | sort | -w",
+			"2 | scan | t.kv@primary ALL",
+		}},
+		{`EXPLAIN SELECT v, count(*) FROM t.kv GROUP BY v`, []string{
+			"0 | group | render: v, count(*)",
+			"1 | scan | t.kv@primary ALL",
+		}},
+		{`EXPLAIN SELECT * FROM t.kv JOIN t.ab ON kv.v = ab.a`, []string{
+			"0 | render | ",
+			"1 | join | INNER; lookup: t.ab",
+			"2 | scan | t.kv@primary ALL",
+		}},
+		// A lookup key of another type than the indexed column can't be
+		// used to look up the inner rows.
+		{`EXPLAIN SELECT * FROM t.kv JOIN t.ab ON kv.w = ab.a`, []string{
+			"0 | render | ",
+			"1 | join | INNER",
+			"2 | scan | t.kv@primary ALL",
+			"2 | scan | t.ab@primary ALL",
+		}},
+		{`EXPLAIN SELECT * FROM t.kv JOIN t.ab ON kv.v + 1 = ab.a`, []string{
+			"0 | render | ",
+			"1 | join | INNER; lookup: t.ab",
+			"2 | scan | t.kv@primary ALL",
+		}},
+		{`EXPLAIN SELECT * FROM t.kv JOIN t.ab ON kv.v::FLOAT = ab.a`, []string{
+			"0 | render | ",
+			"1 | join | INNER",
+			"2 | scan | t.kv@primary ALL",
+			"2 | scan | t.ab@primary ALL",
+		}},
+		{`EXPLAIN SELECT * FROM t.kv JOIN t.ab ON kv.v = ab.b`, []string{
+			"0 | render | ",
+			"1 | join | INNER",
+			"2 | scan | t.kv@primary ALL",
+			"2 | scan | t.ab@primary ALL",
+		}},
+		{`EXPLAIN SELECT k FROM t.kv UNION ALL SELECT a FROM t.ab`, []string{
+			"0 | union | ALL",
+			"1 | scan | t.kv@primary ALL",
+			"1 | scan | t.ab@primary ALL",
+		}},
+		{`EXPLAIN SELECT 1`, []string{
+			"0 | empty | ",
+		}},
+		{`EXPLAIN VERBOSE SELECT k, v + 1 FROM t.kv WHERE k > 3 AND w = 'a'`, []string{
+			"0 | scan | t.kv@primary /4-; render: k, v + 1; filter: k > 3 AND w = 'a' | (k, v + 1)",
+		}},
+		{`EXPLAIN (VERBOSE) SELECT * FROM t.kv LEFT JOIN t.ab ON kv.v = ab.b`, []string{
+			"0 | render | render: kv.k, kv.v, kv.w, ab.a, ab.b | (k, v, w, a, b)",
+			"1 | join | LEFT OUTER; on: kv.v = ab.b | (kv.k, kv.v, kv.w, ab.a, ab.b)",
+			"2 | scan | t.kv@primary ALL; render: k, v, w | (k, v, w)",
+			"2 | scan | t.ab@primary ALL; render: a, b | (a, b)",
+		}},
+		// The subqueries are planned but not run: the scalar subquery would
+		// fail as it returns two rows.
+		{`EXPLAIN SELECT * FROM t.ab WHERE a = (SELECT k FROM t.kv)`, []string{
+			"0 | scan | t.ab@primary ALL",
+			"0 | subquery | (SELECT k FROM t.kv)",
+			"1 | scan | t.kv@primary ALL",
+		}},
+		{`EXPLAIN SELECT * FROM t.ab WHERE EXISTS (SELECT * FROM t.kv WHERE v = ab.b)`, []string{
+			"0 | scan | t.ab@primary ALL",
+			"0 | subquery | EXISTS (SELECT * FROM t.kv WHERE v = ab.b)",
+			"1 | scan | t.kv@primary ALL",
+		}},
+	}
+	for _, d := range testData {
+		expected := strings.Join(d.expected, "\n")
+		if result := explain(d.query); result != expected {
+			t.Errorf("%s: expected\n%s\nbut found\n%s", d.query, expected, result)
+		}
+	}
+
+	for _, d := range []struct {
+		query string
+		err   string
+	}{
+		{`EXPLAIN (DEBUG) SELECT 1`, `unsupported EXPLAIN option: DEBUG`},
+		{`EXPLAIN DELETE FROM t.kv`, `EXPLAIN is not supported for DELETE statements`},
+		{`EXPLAIN INSERT INTO t.kv VALUES (5, 6, 'c')`, `EXPLAIN is not supported for INSERT statements`},
+		{`EXPLAIN UPDATE t.kv SET v = 1`, `EXPLAIN is not supported for UPDATE statements`},
+		{`EXPLAIN UPSERT INTO t.kv VALUES (1, 1, 'a')`, `EXPLAIN is not supported for UPSERT statements`},
+		{`EXPLAIN SELECT * FROM t.kv LIMIT (SELECT 1)`,
+			`the value of subquery (SELECT 1) is needed to plan the statement`},
+	} {
+		if _, err := sqlDB.Exec(d.query); err == nil || !strings.Contains(err.Error(), d.err) {
+			t.Errorf("%s: expected %q, but found %v", d.query, d.err, err)
+		}
+	}
+}
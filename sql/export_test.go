@@ -0,0 +1,74 @@
+// Copyright 2015 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License. See the AUTHORS file
+// for names of contributors.
+
+package sql
+
+import "github.com/cockroachdb/cockroach/sql/parser"
+
+// SetScanBatchSize sets the number of key/value pairs retrieved by each KV
+// scan and returns a function which restores the previous value.
+func SetScanBatchSize(n int64) func() {
+	prev := scanBatchSize
+	scanBatchSize = n
+	return func() {
+		scanBatchSize = prev
+	}
+}
+
+// SetBackfillBatchSize sets the number of rows for which index entries are
+// written in a single batch by an index backfill and returns a function which
+// restores the previous value.
+func SetBackfillBatchSize(n int) func() {
+	prev := backfillBatchSize
+	backfillBatchSize = n
+	return func() {
+		backfillBatchSize = prev
+	}
+}
+
+// SetInsertBatchSize sets the number of rows for which writes are accumulated
+// in a single batch by an INSERT and returns a function which restores the
+// previous value.
+func SetInsertBatchSize(n int) func() {
+	prev := insertBatchSize
+	insertBatchSize = n
+	return func() {
+		insertBatchSize = prev
+	}
+}
+
+// SetSerialBlockSize sets the number of values of a SERIAL column allocated
+// at once by the servers created afterwards and returns a function which
+// restores the previous value.
+func SetSerialBlockSize(n int64) func() {
+	prev := serialBlockSize
+	serialBlockSize = n
+	return func() {
+		serialBlockSize = prev
+	}
+}
+
+// SetExecStmtHook sets a function which is called before each statement of
+// an implicit transaction is executed and returns a function which removes
+// it. An error returned by the hook aborts the attempt to run the
+// transaction.
+func SetExecStmtHook(f func(stmt string) error) func() {
+	testingExecStmtHook = func(stmt parser.Statement) error {
+		return f(stmt.String())
+	}
+	return func() {
+		testingExecStmtHook = nil
+	}
+}
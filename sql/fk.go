@@ -0,0 +1,617 @@
+// Copyright 2015 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License. See the AUTHORS file
+// for names of contributors.
+
+package sql
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/cockroachdb/cockroach/client"
+	"github.com/cockroachdb/cockroach/proto"
+	"github.com/cockroachdb/cockroach/sql/parser"
+	"github.com/cockroachdb/cockroach/structured"
+)
+
+// addForeignKey adds a foreign key from the columns fromCols of the table to
+// the columns toCols of the referenced table, which default to its primary
+// key. The referenced columns must form a unique index. If no index of the
+// table starts with the referencing columns, an index on them is added, so
+// that the rows referring to a row of the referenced table can be looked up.
+// The index of a table being created by the statement (newTable) has no
+// entries to backfill and is public right away, while the index of an
+// existing table is added like the index of CREATE INDEX and is only used
+// once it has been backfilled. The ID of the table is added to the tables
+// referenced by the referenced table, the descriptor of which is written if
+// it is another table.
+func (p *planner) addForeignKey(desc *structured.TableDescriptor, table *parser.QualifiedName,
+	newTable bool, name string, fromCols parser.NameList, refName *parser.QualifiedName, toCols parser.NameList,
+	actions parser.ReferenceActions) error {
+	if err := p.normalizeTableName(refName); err != nil {
+		return err
+	}
+	ref := desc
+	if refName.String() != table.String() {
+		var err error
+		if ref, err = p.getTableDesc(refName); err != nil {
+			return err
+		}
+		if !ref.HasPrivilege(p.user, parser.PrivilegeWrite) {
+			return fmt.Errorf("user %s does not have %s privilege on table %s",
+				p.user, parser.PrivilegeWrite, ref.Name)
+		}
+	}
+
+	if len(toCols) == 0 {
+		toCols = ref.PrimaryIndex.ColumnNames
+	}
+	if len(fromCols) != len(toCols) {
+		return fmt.Errorf("number of referencing and referenced columns for foreign key disagree")
+	}
+	var index *structured.IndexDescriptor
+	for i, idx := range append([]structured.IndexDescriptor{ref.PrimaryIndex}, ref.Indexes...) {
+		if idx.Unique && !idx.WriteOnly && sameColumnNames(idx.ColumnNames, toCols) {
+			if index, _ = ref.FindIndexByID(idx.ID); i == 0 {
+				// The primary index is preferred.
+				break
+			}
+		}
+	}
+	if index == nil {
+		return fmt.Errorf("there is no unique constraint matching given keys for referenced table %q",
+			refName.Table())
+	}
+
+	fk := structured.ForeignKeyReference{Name: name, Table: ref.ID, Index: index.ID}
+	// The referencing columns are stored in the order of the columns of the
+	// referenced index.
+	for _, toName := range index.ColumnNames {
+		j := 0
+		for toCols[j] != toName {
+			j++
+		}
+		from, err := desc.FindColumnByName(fromCols[j])
+		if err != nil {
+			return err
+		}
+		to, err := ref.FindColumnByName(toName)
+		if err != nil {
+			return err
+		}
+		if from.Type.Kind != to.Type.Kind {
+			return fmt.Errorf("key columns %q and %q are of incompatible types: %s and %s",
+				from.Name, to.Name, from.Type.SQLString(), to.Type.SQLString())
+		}
+		fk.ColumnIDs = append(fk.ColumnIDs, from.ID)
+	}
+
+	var err error
+	if fk.OnDelete, err = makeForeignKeyAction(actions.Delete); err != nil {
+		return err
+	}
+	if fk.OnUpdate, err = makeForeignKeyAction(actions.Update); err != nil {
+		return err
+	}
+
+	if fk.Name == "" {
+		base := fmt.Sprintf("%s_%s_fkey", table.Table(), strings.Join(fromCols, "_"))
+		fk.Name = base
+		for i := 1; hasForeignKey(desc, fk.Name); i++ {
+			fk.Name = fmt.Sprintf("%s%d", base, i)
+		}
+	} else if hasForeignKey(desc, fk.Name) {
+		return fmt.Errorf("foreign key %q already exists", fk.Name)
+	}
+	desc.ForeignKeys = append(desc.ForeignKeys, fk)
+
+	if referencingIndex(desc, &fk) == nil {
+		var index structured.IndexDescriptor
+		for _, id := range fk.ColumnIDs {
+			col, err := desc.FindColumnByID(id)
+			if err != nil {
+				return err
+			}
+			index.ColumnNames = append(index.ColumnNames, col.Name)
+			index.ColumnIDs = append(index.ColumnIDs, id)
+		}
+		base := fmt.Sprintf("%s_%s_idx", table.Table(), strings.Join(index.ColumnNames, "_"))
+		index.Name = base
+		for i := 1; hasIndex(desc, index.Name); i++ {
+			index.Name = fmt.Sprintf("%s%d", base, i)
+		}
+		if newTable {
+			index.ID = desc.NextIndexID
+			desc.NextIndexID++
+			desc.Indexes = append(desc.Indexes, index)
+		} else if err := p.addNewIndex(desc, index, 0); err != nil {
+			return err
+		}
+	}
+
+	for _, id := range ref.ReferencedBy {
+		if id == desc.ID {
+			return nil
+		}
+	}
+	ref.ReferencedBy = append(ref.ReferencedBy, desc.ID)
+	if ref == desc {
+		return nil
+	}
+	return p.txn.Put(structured.MakeDescMetadataKey(ref.ID), ref)
+}
+
+func makeForeignKeyAction(a parser.ReferenceAction) (structured.ForeignKeyReference_Action, error) {
+	switch a {
+	case parser.NoAction:
+		return structured.ForeignKeyReference_NO_ACTION, nil
+	case parser.Restrict:
+		return structured.ForeignKeyReference_RESTRICT, nil
+	case parser.Cascade:
+		return structured.ForeignKeyReference_CASCADE, nil
+	case parser.SetNull:
+		return structured.ForeignKeyReference_SET_NULL, nil
+	}
+	return 0, fmt.Errorf("unsupported foreign key action: %s", a)
+}
+
+func hasForeignKey(desc *structured.TableDescriptor, name string) bool {
+	for _, fk := range desc.ForeignKeys {
+		if fk.Name == name {
+			return true
+		}
+	}
+	return false
+}
+
+func hasIndex(desc *structured.TableDescriptor, name string) bool {
+	for _, index := range append([]structured.IndexDescriptor{desc.PrimaryIndex}, desc.Indexes...) {
+		if index.Name == name {
+			return true
+		}
+	}
+	return false
+}
+
+// referencingIndex returns an index of the table whose leading columns are
+// the columns of the foreign key, in any order, or nil if there is none.
+func referencingIndex(desc *structured.TableDescriptor, fk *structured.ForeignKeyReference) *structured.IndexDescriptor {
+	indexes := []*structured.IndexDescriptor{&desc.PrimaryIndex}
+	for i := range desc.Indexes {
+		if !desc.Indexes[i].WriteOnly {
+			indexes = append(indexes, &desc.Indexes[i])
+		}
+	}
+	for _, index := range indexes {
+		if len(index.ColumnIDs) < len(fk.ColumnIDs) {
+			continue
+		}
+		match := true
+		for _, id := range index.ColumnIDs[:len(fk.ColumnIDs)] {
+			found := false
+			for _, fkID := range fk.ColumnIDs {
+				if id == fkID {
+					found = true
+					break
+				}
+			}
+			if !found {
+				match = false
+				break
+			}
+		}
+		if match {
+			return index
+		}
+	}
+	return nil
+}
+
+// referencesTable returns whether a foreign key of the table references the
+// table with the given ID.
+func referencesTable(desc *structured.TableDescriptor, id structured.ID) bool {
+	for _, fk := range desc.ForeignKeys {
+		if fk.Table == id {
+			return true
+		}
+	}
+	return false
+}
+
+// fkHelper enforces the foreign keys of the rows written by a statement. It
+// verifies that the rows which are inserted or updated refer to existing
+// rows, and takes the actions of the foreign keys referencing the rows which
+// are deleted or updated. The checks for the foreign keys without an action
+// are deferred until the writes of the statement have been run, so that a
+// statement can delete both a referenced row and the rows referencing it.
+type fkHelper struct {
+	txn     *client.Txn
+	evalCtx parser.EvalContext
+	descs   map[structured.ID]*structured.TableDescriptor
+	checks  map[structured.ID]*checkHelper
+	deleted map[string]struct{}
+	pending []pendingReference
+}
+
+// pendingReference is a deferred check that no row of the child table
+// refers to the values by the foreign key.
+type pendingReference struct {
+	parent *structured.TableDescriptor
+	child  *structured.TableDescriptor
+	fk     *structured.ForeignKeyReference
+	values parser.DTuple
+}
+
+func makeFKHelper(txn *client.Txn, evalCtx parser.EvalContext) *fkHelper {
+	return &fkHelper{
+		txn:     txn,
+		evalCtx: evalCtx,
+		descs:   map[structured.ID]*structured.TableDescriptor{},
+		checks:  map[structured.ID]*checkHelper{},
+		deleted: map[string]struct{}{},
+	}
+}
+
+func (f *fkHelper) getTableDesc(id structured.ID) (*structured.TableDescriptor, error) {
+	if desc, ok := f.descs[id]; ok {
+		return desc, nil
+	}
+	desc, err := getTableDescByID(f.txn, id)
+	if err != nil {
+		return nil, err
+	}
+	f.descs[id] = desc
+	return desc, nil
+}
+
+func (f *fkHelper) getCheckHelper(desc *structured.TableDescriptor) (*checkHelper, error) {
+	if c, ok := f.checks[desc.ID]; ok {
+		return c, nil
+	}
+	c, err := makeCheckHelper(desc, f.evalCtx)
+	if err != nil {
+		return nil, err
+	}
+	f.checks[desc.ID] = c
+	return c, nil
+}
+
+// checkReferences verifies that the row, whose values are indexed by
+// colIDtoRowIndex, refers to existing rows by the foreign keys of the table.
+// Only the foreign keys containing one of the changed columns are verified,
+// or all of them if changed is nil. A foreign key with a NULL value does not
+// refer to any row.
+//
+// At serializable isolation, the read of a referenced row conflicts with a
+// concurrent transaction deleting it. At snapshot isolation it doesn't, so
+// the key which was read is conditionally written again with its value,
+// which fails if the row was deleted by a transaction that committed after
+// the read.
+func (f *fkHelper) checkReferences(desc *structured.TableDescriptor, colIDtoRowIndex map[structured.ID]int,
+	values parser.DTuple, changed map[structured.ID]struct{}) error {
+	for i := range desc.ForeignKeys {
+		fk := &desc.ForeignKeys[i]
+		if changed != nil && !containsAnyColumn(fk.ColumnIDs, changed) {
+			continue
+		}
+		vals, containsNull := columnValues(fk.ColumnIDs, colIDtoRowIndex, values)
+		if containsNull {
+			continue
+		}
+		ref, err := f.getTableDesc(fk.Table)
+		if err != nil {
+			return err
+		}
+		index, err := ref.FindIndexByID(fk.Index)
+		if err != nil {
+			return err
+		}
+		colMap := map[structured.ID]int{}
+		for j, id := range index.ColumnIDs {
+			colMap[id] = j
+		}
+		key, _, err := encodeIndexKey(index.ColumnIDs, colMap, vals, structured.MakeIndexKeyPrefix(ref.ID, index.ID))
+		if err != nil {
+			return err
+		}
+		rows, err := f.txn.Scan(key, proto.Key(key).PrefixEnd(), 1)
+		if err != nil {
+			return err
+		}
+		if len(rows) == 0 {
+			return fmt.Errorf("insert or update on table %q violates foreign key constraint %q",
+				desc.Name, fk.Name)
+		}
+		if f.txn.Proto().Isolation == proto.SNAPSHOT {
+			// A write which is older than the deletion is retried at a newer
+			// timestamp, at which the conditional put no longer finds the key.
+			val := rows[0].ValueBytes()
+			if val == nil {
+				// A nil expected value would require the key to not exist.
+				val = []byte{}
+			}
+			if err := f.txn.CPut(rows[0].Key, val, val); err != nil {
+				if _, ok := err.(*proto.ConditionFailedError); ok {
+					return fmt.Errorf("insert or update on table %q violates foreign key constraint %q",
+						desc.Name, fk.Name)
+				}
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// deleteRow adds the writes which delete the row to the batch, after taking
+// the actions of the foreign keys referencing the row. A row which has
+// already been deleted by the statement is skipped.
+func (f *fkHelper) deleteRow(b *client.Batch, desc *structured.TableDescriptor,
+	colIDtoRowIndex map[structured.ID]int, values parser.DTuple) error {
+	primaryIndexKey, _, err := encodeIndexKey(desc.PrimaryIndex.ColumnIDs, colIDtoRowIndex, values,
+		structured.MakeIndexKeyPrefix(desc.ID, desc.PrimaryIndex.ID))
+	if err != nil {
+		return err
+	}
+	if _, ok := f.deleted[string(primaryIndexKey)]; ok {
+		return nil
+	}
+	f.deleted[string(primaryIndexKey)] = struct{}{}
+
+	if err := f.handleReferences(b, desc, colIDtoRowIndex, values, nil); err != nil {
+		return err
+	}
+	return deleteRow(b, desc, colIDtoRowIndex, values)
+}
+
+// handleReferences takes the actions of the foreign keys referencing a row
+// of the table which is deleted, if newValues is nil, or updated to
+// newValues. The values of the row are indexed by colIDtoRowIndex.
+func (f *fkHelper) handleReferences(b *client.Batch, desc *structured.TableDescriptor,
+	colIDtoRowIndex map[structured.ID]int, oldValues, newValues parser.DTuple) error {
+	for _, childID := range desc.ReferencedBy {
+		child := desc
+		if childID != desc.ID {
+			var err error
+			if child, err = f.getTableDesc(childID); err != nil {
+				return err
+			}
+		}
+		for i := range child.ForeignKeys {
+			fk := &child.ForeignKeys[i]
+			if fk.Table != desc.ID {
+				continue
+			}
+			index, err := desc.FindIndexByID(fk.Index)
+			if err != nil {
+				return err
+			}
+			oldVals, containsNull := columnValues(index.ColumnIDs, colIDtoRowIndex, oldValues)
+			if containsNull {
+				continue
+			}
+			action := fk.OnDelete
+			var newVals parser.DTuple
+			if newValues != nil {
+				action = fk.OnUpdate
+				newVals, _ = columnValues(index.ColumnIDs, colIDtoRowIndex, newValues)
+				if tuplesEqual(oldVals, newVals) {
+					continue
+				}
+			}
+
+			if action == structured.ForeignKeyReference_NO_ACTION {
+				f.pending = append(f.pending, pendingReference{parent: desc, child: child, fk: fk, values: oldVals})
+				continue
+			}
+			rows, err := f.findReferencingRows(child, fk, oldVals)
+			if err != nil {
+				return err
+			}
+			if len(rows) == 0 {
+				continue
+			}
+			childColIDtoRowIndex := makeColIDtoRowIndex(child)
+			switch action {
+			case structured.ForeignKeyReference_RESTRICT:
+				return fkViolation(desc, child, fk)
+			case structured.ForeignKeyReference_CASCADE:
+				for _, row := range rows {
+					if newValues == nil {
+						err = f.deleteRow(b, child, childColIDtoRowIndex, row)
+					} else {
+						err = f.updateReferencingRow(b, child, fk, childColIDtoRowIndex, row, newVals)
+					}
+					if err != nil {
+						return err
+					}
+				}
+			case structured.ForeignKeyReference_SET_NULL:
+				nulls := make(parser.DTuple, len(fk.ColumnIDs))
+				for j := range nulls {
+					nulls[j] = parser.DNull
+				}
+				for _, row := range rows {
+					if err := f.updateReferencingRow(b, child, fk, childColIDtoRowIndex, row, nulls); err != nil {
+						return err
+					}
+				}
+			default:
+				return fmt.Errorf("unsupported foreign key action: %s", action)
+			}
+		}
+	}
+	return nil
+}
+
+// updateReferencingRow sets the columns of the foreign key of a row of the
+// child table to vals.
+func (f *fkHelper) updateReferencingRow(b *client.Batch, child *structured.TableDescriptor,
+	fk *structured.ForeignKeyReference, colIDtoRowIndex map[structured.ID]int, row, vals parser.DTuple) error {
+	cols := make([]structured.ColumnDescriptor, len(fk.ColumnIDs))
+	for i, id := range fk.ColumnIDs {
+		col, err := child.FindColumnByID(id)
+		if err != nil {
+			return err
+		}
+		cols[i] = *col
+	}
+	checks, err := f.getCheckHelper(child)
+	if err != nil {
+		return err
+	}
+	return updateRow(b, child, indexesContaining(child, cols), colIDtoRowIndex, row, cols, vals, checks, f)
+}
+
+// findReferencingRows returns the rows of the child table which refer to the
+// values by the foreign key, in the order of child.Columns. The rows are
+// looked up by the index on the referencing columns.
+func (f *fkHelper) findReferencingRows(child *structured.TableDescriptor,
+	fk *structured.ForeignKeyReference, vals parser.DTuple) ([]parser.DTuple, error) {
+	index, sp, err := referencingSpan(child, fk, vals)
+	if err != nil {
+		return nil, err
+	}
+	scan := &scanNode{
+		db:               f.txn,
+		desc:             child,
+		index:            index,
+		isSecondaryIndex: index != &child.PrimaryIndex,
+		spans:            []span{sp},
+		evalCtx:          f.evalCtx,
+	}
+	// A secondary index only contains the referencing and primary key
+	// columns, so the rows are retrieved from the primary index.
+	var plan planNode = scan
+	table := scan
+	if scan.isSecondaryIndex {
+		join, err := makeIndexJoin(scan)
+		if err != nil {
+			return nil, err
+		}
+		plan, table = join, join.table
+	}
+	var rows []parser.DTuple
+	for plan.Next() {
+		row := make(parser.DTuple, len(child.Columns))
+		for i, col := range child.Columns {
+			row[i] = parser.DNull
+			if d, ok := table.vals[col.Name]; ok {
+				row[i] = d
+			}
+		}
+		rows = append(rows, row)
+	}
+	return rows, plan.Err()
+}
+
+// referencingSpan returns the index on the referencing columns of the
+// foreign key of the child table and the span of its entries for the rows
+// which refer to the values.
+func referencingSpan(child *structured.TableDescriptor, fk *structured.ForeignKeyReference,
+	vals parser.DTuple) (*structured.IndexDescriptor, span, error) {
+	index := referencingIndex(child, fk)
+	if index == nil {
+		return nil, span{}, fmt.Errorf("there is no index on the columns of foreign key %q", fk.Name)
+	}
+	colMap := map[structured.ID]int{}
+	for j, id := range fk.ColumnIDs {
+		colMap[id] = j
+	}
+	key, _, err := encodeIndexKey(index.ColumnIDs[:len(fk.ColumnIDs)], colMap, vals,
+		structured.MakeIndexKeyPrefix(child.ID, index.ID))
+	if err != nil {
+		return nil, span{}, err
+	}
+	return index, span{start: proto.Key(key), end: proto.Key(key).PrefixEnd()}, nil
+}
+
+// verifyPending verifies, once the writes of the statement have been run,
+// that no rows refer to the deleted or updated rows by a foreign key without
+// an action. The referencing rows of all of the pending checks are looked up
+// by a single batch of scans.
+func (f *fkHelper) verifyPending() error {
+	if len(f.pending) == 0 {
+		return nil
+	}
+	b := &client.Batch{}
+	for _, r := range f.pending {
+		_, sp, err := referencingSpan(r.child, r.fk, r.values)
+		if err != nil {
+			return err
+		}
+		b.Scan(sp.start, sp.end, 1)
+	}
+	if err := f.txn.Run(b); err != nil {
+		return err
+	}
+	for i, r := range f.pending {
+		if len(b.Results[i].Rows) > 0 {
+			return fkViolation(r.parent, r.child, r.fk)
+		}
+	}
+	f.pending = nil
+	return nil
+}
+
+func fkViolation(parent, child *structured.TableDescriptor, fk *structured.ForeignKeyReference) error {
+	return fmt.Errorf("update or delete on table %q violates foreign key constraint %q on table %q",
+		parent.Name, fk.Name, child.Name)
+}
+
+// columnValues returns the values of the columns of the row, whose values
+// are indexed by colIDtoRowIndex, and whether any of them is NULL.
+func columnValues(ids []structured.ID, colIDtoRowIndex map[structured.ID]int,
+	values parser.DTuple) (parser.DTuple, bool) {
+	vals := make(parser.DTuple, len(ids))
+	containsNull := false
+	for i, id := range ids {
+		vals[i] = parser.DNull
+		if j, ok := colIDtoRowIndex[id]; ok {
+			vals[i] = values[j]
+		}
+		if vals[i] == parser.DNull {
+			containsNull = true
+		}
+	}
+	return vals, containsNull
+}
+
+func containsAnyColumn(ids []structured.ID, set map[structured.ID]struct{}) bool {
+	for _, id := range ids {
+		if _, ok := set[id]; ok {
+			return true
+		}
+	}
+	return false
+}
+
+// tuplesEqual returns whether the tuples have equal values. NULL is equal to
+// NULL.
+func tuplesEqual(a, b parser.DTuple) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] == parser.DNull || b[i] == parser.DNull {
+			if a[i] != b[i] {
+				return false
+			}
+			continue
+		}
+		if a[i].Compare(b[i]) != 0 {
+			return false
+		}
+	}
+	return true
+}
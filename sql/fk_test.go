@@ -0,0 +1,76 @@
+// Copyright 2015 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License. See the AUTHORS file
+// for names of contributors.
+
+package sql_test
+
+import (
+	"testing"
+
+	"github.com/cockroachdb/cockroach/testutils"
+	"github.com/cockroachdb/cockroach/util/leaktest"
+)
+
+// TestForeignKeySnapshotIsolation verifies that a row inserted at snapshot
+// isolation can't refer to a row which is deleted by a transaction that
+// commits after the snapshot was taken.
+func TestForeignKeySnapshotIsolation(t *testing.T) {
+	defer leaktest.AfterTest(t)
+	s, sqlDB, _ := setup(t)
+	defer cleanup(s, sqlDB)
+
+	if _, err := sqlDB.Exec(`
+CREATE DATABASE t;
+CREATE TABLE t.p (k INT PRIMARY KEY);
+CREATE TABLE t.c (k INT PRIMARY KEY, p INT REFERENCES t.p);
+INSERT INTO t.p VALUES (1);
+`); err != nil {
+		t.Fatal(err)
+	}
+
+	tx, err := sqlDB.Begin()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := tx.Exec(`SET TRANSACTION ISOLATION LEVEL SNAPSHOT`); err != nil {
+		t.Fatal(err)
+	}
+	// Take the snapshot before the referenced row is deleted.
+	var count int
+	if err := tx.QueryRow(`SELECT COUNT(*) FROM t.p`).Scan(&count); err != nil {
+		t.Fatal(err)
+	} else if count != 1 {
+		t.Fatalf("expected 1 row, but got %d", count)
+	}
+
+	if _, err := sqlDB.Exec(`DELETE FROM t.p WHERE k = 1`); err != nil {
+		t.Fatal(err)
+	}
+
+	// The referenced row is still visible in the snapshot, but the insert of
+	// the referencing row fails.
+	if _, err := tx.Exec(`INSERT INTO t.c VALUES (1, 1)`); !testutils.IsError(err,
+		`violates foreign key constraint "c_p_fkey"`) {
+		t.Fatalf("expected foreign key violation, but got %v", err)
+	}
+	if err := tx.Rollback(); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := sqlDB.QueryRow(`SELECT COUNT(*) FROM t.c`).Scan(&count); err != nil {
+		t.Fatal(err)
+	} else if count != 0 {
+		t.Fatalf("expected no referencing rows, but got %d", count)
+	}
+}
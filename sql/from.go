@@ -0,0 +1,578 @@
+// Copyright 2015 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License. See the AUTHORS file
+// for names of contributors.
+
+package sql
+
+import (
+	"fmt"
+
+	"github.com/cockroachdb/cockroach/sql/parser"
+	"github.com/cockroachdb/cockroach/structured"
+	"github.com/cockroachdb/cockroach/util"
+)
+
+// A fromTable is a table referenced by the FROM clause of a SELECT.
+type fromTable struct {
+	alias    string
+	desc     *structured.TableDescriptor
+	offset   int       // the index of the first column of the table in a joined row
+	scan     *scanNode // the scan of the table
+	nullable bool      // the table is on the NULL-extended side of an outer join
+}
+
+// A fromScope is a set of tables against which column references are
+// resolved.
+type fromScope struct {
+	tables []*fromTable
+	// using maps the names of the columns of USING and NATURAL joins, which may
+	// be referenced without qualification, to their index in the joined row.
+	using map[string]int
+}
+
+// findColumn returns the table and column referenced by qname along with the
+// index of the column in the joined row.
+func (s *fromScope) findColumn(qname *parser.QualifiedName) (*fromTable, *structured.ColumnDescriptor, int, error) {
+	switch len(qname.Indirect) {
+	case 0:
+		name := string(qname.Base)
+		if i, ok := s.using[name]; ok {
+			t, col := s.columnAt(i)
+			return t, col, i, nil
+		}
+		var table *fromTable
+		var column *structured.ColumnDescriptor
+		for _, t := range s.tables {
+			col, err := t.desc.FindColumnByName(name)
+			if err != nil {
+				continue
+			}
+			if table != nil {
+				return nil, nil, 0, fmt.Errorf("column reference \"%s\" is ambiguous", qname)
+			}
+			table, column = t, col
+		}
+		if table != nil {
+			return table, column, table.offset + columnIndex(table.desc, column), nil
+		}
+
+	case 1:
+		if name, ok := qname.Indirect[0].(parser.NameIndirection); ok {
+			for _, t := range s.tables {
+				if t.alias != string(qname.Base) {
+					continue
+				}
+				col, err := t.desc.FindColumnByName(string(name))
+				if err != nil {
+					break
+				}
+				return t, col, t.offset + columnIndex(t.desc, col), nil
+			}
+		}
+	}
+	return nil, nil, 0, fmt.Errorf("column \"%s\" not found", qname)
+}
+
+// columnAt returns the table and column at the specified index in the joined
+// row.
+func (s *fromScope) columnAt(i int) (*fromTable, *structured.ColumnDescriptor) {
+	for _, t := range s.tables {
+		if i >= t.offset && i < t.offset+len(t.desc.Columns) {
+			return t, &t.desc.Columns[i-t.offset]
+		}
+	}
+	return nil, nil
+}
+
+func columnIndex(desc *structured.TableDescriptor, col *structured.ColumnDescriptor) int {
+	for i := range desc.Columns {
+		if &desc.Columns[i] == col {
+			return i
+		}
+	}
+	return -1
+}
+
+// qualifiedColumnName returns the fully qualified name of a column of a table
+// in the FROM clause.
+func qualifiedColumnName(t *fromTable, col *structured.ColumnDescriptor) *parser.QualifiedName {
+	return &parser.QualifiedName{
+		Base:     parser.Name(t.alias),
+		Indirect: parser.Indirection{parser.NameIndirection(col.Name)},
+	}
+}
+
+// A nameResolver resolves the column references within an expression. A
+// reference to a column of the local table is replaced by the unqualified
+// column name so that it can be evaluated by a scan of the table. Any other
+// reference is replaced by a reference to the column in the joined row.
+type nameResolver struct {
+	scope *fromScope
+	row   parser.DTuple
+	local *fromTable
+	err   error
+}
+
+var _ parser.Visitor = &nameResolver{}
+
+func (v *nameResolver) Visit(expr parser.Expr) parser.Expr {
+	if v.err != nil {
+		return expr
+	}
+	qname, ok := expr.(*parser.QualifiedName)
+	if !ok {
+		return expr
+	}
+	t, col, i, err := v.scope.findColumn(qname)
+	if err != nil {
+		v.err = err
+		return expr
+	}
+	if t == v.local {
+		return &parser.QualifiedName{Base: parser.Name(col.Name)}
+	}
+	return &parser.DReference{Expr: qualifiedColumnName(t, col), Datum: &v.row[i]}
+}
+
+func (v *nameResolver) resolve(expr parser.Expr) (parser.Expr, error) {
+	expr = parser.WalkExpr(v, expr)
+	return expr, v.err
+}
+
+// tableCollector collects the tables referenced by an expression.
+type tableCollector struct {
+	scope  *fromScope
+	tables map[*fromTable]struct{}
+	err    error
+}
+
+var _ parser.Visitor = &tableCollector{}
+
+func (v *tableCollector) Visit(expr parser.Expr) parser.Expr {
+	if v.err != nil {
+		return expr
+	}
+	if qname, ok := expr.(*parser.QualifiedName); ok {
+		var t *fromTable
+		if t, _, _, v.err = v.scope.findColumn(qname); v.err == nil {
+			v.tables[t] = struct{}{}
+		}
+	}
+	return expr
+}
+
+// referencedTables returns the tables referenced by an expression.
+func (s *fromScope) referencedTables(expr parser.Expr) (map[*fromTable]struct{}, error) {
+	v := tableCollector{scope: s, tables: map[*fromTable]struct{}{}}
+	parser.WalkExpr(&v, expr)
+	return v.tables, v.err
+}
+
+// splitAnd returns the conjuncts of an expression.
+func splitAnd(expr parser.Expr, conjuncts []parser.Expr) []parser.Expr {
+	switch t := expr.(type) {
+	case *parser.AndExpr:
+		return splitAnd(t.Right, splitAnd(t.Left, conjuncts))
+	case *parser.ParenExpr:
+		if _, ok := t.Expr.(*parser.AndExpr); ok {
+			return splitAnd(t.Expr, conjuncts)
+		}
+	}
+	return append(conjuncts, expr)
+}
+
+// mergeAnd returns the conjunction of two expressions, either of which may be
+// nil.
+func mergeAnd(left, right parser.Expr) parser.Expr {
+	if left == nil {
+		return right
+	}
+	if right == nil {
+		return left
+	}
+	return &parser.AndExpr{Left: left, Right: right}
+}
+
+// A fromClause holds the tables of the FROM clause of a SELECT and the plan
+// which joins them.
+type fromClause struct {
+	scope   fromScope     // the scope of all of the tables
+	plan    planNode      // nil if there are no tables
+	numCols int           // the number of columns in the joined row
+	row     parser.DTuple // the joined row
+	joins   []*joinNode   // the joins, children before parents
+	hidden  map[int]bool  // the columns omitted by "*", such as duplicate USING columns
+}
+
+// makeFrom plans the FROM clause of a SELECT. A single table is retrieved by
+// a scan. Multiple tables are combined by joins in the order specified.
+func (p *planner) makeFrom(from parser.TableExprs) (*fromClause, error) {
+	f := &fromClause{hidden: map[int]bool{}}
+	var scope *fromScope
+	for _, expr := range from {
+		rightScope, right, err := f.build(p, expr)
+		if err != nil {
+			return nil, err
+		}
+		if f.plan == nil {
+			scope, f.plan = rightScope, right
+			continue
+		}
+		// A list of tables is equivalent to a CROSS JOIN.
+		n := f.newJoin(p, joinTypeInner, scope, f.plan, rightScope, right)
+		scope, f.plan = n.scope, n
+	}
+	if scope != nil {
+		f.scope = *scope
+	}
+
+	f.row = make(parser.DTuple, f.numCols)
+	for _, n := range f.joins {
+		n.row = f.row
+	}
+	return f, nil
+}
+
+// build plans a table expression, returning the scope of the tables in the
+// expression.
+func (f *fromClause) build(p *planner, expr parser.TableExpr) (*fromScope, planNode, error) {
+	switch t := expr.(type) {
+	case *parser.AliasedTableExpr:
+		return f.addTable(p, t)
+
+	case *parser.ParenTableExpr:
+		return f.build(p, t.Expr)
+
+	case *parser.JoinTableExpr:
+		var typ joinType
+		switch t.Join {
+		case "JOIN", "INNER JOIN", "CROSS JOIN", "NATURAL JOIN":
+			typ = joinTypeInner
+		case "LEFT JOIN":
+			typ = joinTypeLeftOuter
+		case "RIGHT JOIN":
+			typ = joinTypeRightOuter
+		default:
+			return nil, nil, util.Errorf("unsupported JOIN type: %s", t.Join)
+		}
+
+		leftScope, left, err := f.build(p, t.Left)
+		if err != nil {
+			return nil, nil, err
+		}
+		rightScope, right, err := f.build(p, t.Right)
+		if err != nil {
+			return nil, nil, err
+		}
+		n := f.newJoin(p, typ, leftScope, left, rightScope, right)
+
+		var using parser.NameList
+		switch cond := t.Cond.(type) {
+		case *parser.OnJoinCond:
+			n.cond = cond.Expr
+		case *parser.UsingJoinCond:
+			using = cond.Cols
+		case nil:
+			if t.Join == "NATURAL JOIN" {
+				using = commonColumns(leftScope, rightScope)
+			}
+		}
+		if err := f.addUsing(n, using); err != nil {
+			return nil, nil, err
+		}
+		return n.scope, n, nil
+	}
+	return nil, nil, util.Errorf("TODO(pmattis): unsupported FROM: %s", expr)
+}
+
+// addTable adds a table or a subquery to the FROM clause.
+func (f *fromClause) addTable(p *planner, ate *parser.AliasedTableExpr) (*fromScope, planNode, error) {
+	var desc *structured.TableDescriptor
+	var scan *scanNode
+	alias := string(ate.As)
+	switch t := ate.Expr.(type) {
+	case *parser.QualifiedName:
+		var err error
+		if desc, err = p.getTableDesc(t); err != nil {
+			return nil, nil, err
+		}
+		if !desc.HasPrivilege(p.user, parser.PrivilegeRead) {
+			return nil, nil, fmt.Errorf("user %s does not have %s privilege on table %s",
+				p.user, parser.PrivilegeRead, desc.Name)
+		}
+		if alias == "" {
+			alias = t.Table()
+		}
+		scan = &scanNode{
+			db:      p.txn,
+			desc:    desc,
+			index:   &desc.PrimaryIndex,
+			evalCtx: p.evalCtx,
+		}
+
+	case *parser.Subquery:
+		if alias == "" {
+			return nil, nil, fmt.Errorf("subquery in FROM must have an alias")
+		}
+		plan, err := p.makePlan(t.Select)
+		if err != nil {
+			return nil, nil, err
+		}
+		// The rows of the subquery are retrieved from its plan. The descriptor
+		// only provides the names of the columns.
+		desc = &structured.TableDescriptor{Name: alias}
+		for i, name := range plan.Columns() {
+			desc.Columns = append(desc.Columns, structured.ColumnDescriptor{
+				Name: name,
+				ID:   structured.ID(i + 1),
+			})
+		}
+		scan = &scanNode{
+			db:      p.txn,
+			source:  plan,
+			vals:    valMap{},
+			evalCtx: p.evalCtx,
+		}
+
+	default:
+		return nil, nil, util.Errorf("TODO(pmattis): unsupported FROM: %s", ate)
+	}
+
+	for _, t := range f.scope.tables {
+		if t.alias == alias {
+			return nil, nil, fmt.Errorf("table name \"%s\" specified more than once", alias)
+		}
+	}
+
+	for _, col := range desc.Columns {
+		scan.columns = append(scan.columns, col.Name)
+		scan.render = append(scan.render, &parser.QualifiedName{Base: parser.Name(col.Name)})
+	}
+
+	t := &fromTable{
+		alias:  alias,
+		desc:   desc,
+		offset: f.numCols,
+		scan:   scan,
+	}
+	f.numCols += len(desc.Columns)
+	// The scope of all of the tables is replaced by the scope of the root of
+	// the joins once all of the tables have been added.
+	f.scope.tables = append(f.scope.tables, t)
+	return &fromScope{tables: []*fromTable{t}}, scan, nil
+}
+
+// commonColumns returns the names of the columns of the left scope which are
+// also columns of the right scope.
+func commonColumns(left, right *fromScope) parser.NameList {
+	var names parser.NameList
+	seen := map[string]bool{}
+	for _, t := range left.tables {
+		for _, col := range t.desc.Columns {
+			if seen[col.Name] || col.Hidden {
+				continue
+			}
+			seen[col.Name] = true
+			qname := &parser.QualifiedName{Base: parser.Name(col.Name)}
+			if _, _, _, err := right.findColumn(qname); err == nil {
+				names = append(names, col.Name)
+			}
+		}
+	}
+	return names
+}
+
+// addUsing adds the equality of the named columns of the left and right
+// sides to the join condition. The columns can be referenced without
+// qualification and are only included once by "*".
+func (f *fromClause) addUsing(n *joinNode, using parser.NameList) error {
+	for _, name := range using {
+		qname := &parser.QualifiedName{Base: parser.Name(name)}
+		lt, lcol, li, err := n.leftScope.findColumn(qname)
+		if err != nil {
+			return err
+		}
+		rt, rcol, ri, err := n.rightScope.findColumn(qname)
+		if err != nil {
+			return err
+		}
+		n.cond = mergeAnd(n.cond, &parser.ComparisonExpr{
+			Operator: parser.EQ,
+			Left:     qualifiedColumnName(lt, lcol),
+			Right:    qualifiedColumnName(rt, rcol),
+		})
+		if n.joinType == joinTypeRightOuter {
+			n.scope.using[name] = ri
+			f.hidden[li] = true
+		} else {
+			n.scope.using[name] = li
+			f.hidden[ri] = true
+		}
+	}
+	return nil
+}
+
+// newJoin creates a join of the left and right plans.
+func (f *fromClause) newJoin(p *planner, typ joinType,
+	leftScope *fromScope, left planNode, rightScope *fromScope, right planNode) *joinNode {
+	n := &joinNode{
+		p:          p,
+		joinType:   typ,
+		left:       left,
+		right:      right,
+		leftScope:  leftScope,
+		rightScope: rightScope,
+		scope: &fromScope{
+			tables: append(append([]*fromTable(nil), leftScope.tables...), rightScope.tables...),
+			using:  map[string]int{},
+		},
+	}
+	// Unqualified references to the USING columns of either side remain
+	// valid unless both sides have such a column.
+	for _, s := range []*fromScope{leftScope, rightScope} {
+		for name, i := range s.using {
+			if _, ok := n.scope.using[name]; ok {
+				delete(n.scope.using, name)
+				continue
+			}
+			n.scope.using[name] = i
+		}
+	}
+
+	var nullable []*fromTable
+	switch typ {
+	case joinTypeLeftOuter:
+		nullable = rightScope.tables
+	case joinTypeRightOuter:
+		nullable = leftScope.tables
+	}
+	for _, t := range nullable {
+		t.nullable = true
+	}
+
+	n.start = leftScope.tables[0].offset
+	for _, t := range leftScope.tables {
+		n.leftWidth += len(t.desc.Columns)
+	}
+	for _, t := range rightScope.tables {
+		n.rightWidth += len(t.desc.Columns)
+	}
+	for _, t := range n.scope.tables {
+		for _, col := range t.desc.Columns {
+			n.columns = append(n.columns, qualifiedColumnName(t, &col).String())
+		}
+	}
+	f.joins = append(f.joins, n)
+	return n
+}
+
+// expandStar returns the names and expressions of the columns of the tables
+// in the FROM clause.
+func (f *fromClause) expandStar() ([]string, []parser.Expr) {
+	var columns []string
+	var exprs []parser.Expr
+	for _, t := range f.scope.tables {
+		for i, col := range t.desc.Columns {
+			if f.hidden[t.offset+i] || col.Hidden {
+				continue
+			}
+			columns = append(columns, col.Name)
+			if len(f.scope.tables) == 1 {
+				exprs = append(exprs, &parser.QualifiedName{Base: parser.Name(col.Name)})
+			} else {
+				exprs = append(exprs, qualifiedColumnName(t, &t.desc.Columns[i]))
+			}
+		}
+	}
+	return columns, exprs
+}
+
+// resolve resolves the column references in the render expressions and
+// filter of the scan and in the GROUP BY and HAVING clauses. When scanning a
+// single table, the references are to the columns of the table. Otherwise the
+// references are to the columns of the joined row, and the conjuncts of the
+// WHERE clause which only refer to a single table are moved to the scan of
+// that table.
+func (f *fromClause) resolve(n *parser.Select, s *scanNode) error {
+	v := nameResolver{scope: &f.scope, row: f.row}
+	if f.plan == s {
+		v.local = f.scope.tables[0]
+	}
+
+	var err error
+	for i := range s.render {
+		if s.render[i], err = v.resolve(s.render[i]); err != nil {
+			return err
+		}
+	}
+	for i := range n.GroupBy {
+		if n.GroupBy[i], err = v.resolve(n.GroupBy[i]); err != nil {
+			return err
+		}
+	}
+	if n.Having != nil {
+		if n.Having.Expr, err = v.resolve(n.Having.Expr); err != nil {
+			return err
+		}
+	}
+
+	if s.filter != nil {
+		filter := s.filter
+		s.filter = nil
+		for _, conjunct := range splitAnd(filter, nil) {
+			if v.local == nil && !containsAggregate(conjunct) {
+				t, err := f.pushDownTable(conjunct)
+				if err != nil {
+					return err
+				}
+				if t != nil {
+					local := nameResolver{scope: &f.scope, row: f.row, local: t}
+					if conjunct, err = local.resolve(conjunct); err != nil {
+						return err
+					}
+					t.scan.filter = mergeAnd(t.scan.filter, conjunct)
+					continue
+				}
+			}
+			if conjunct, err = v.resolve(conjunct); err != nil {
+				return err
+			}
+			s.filter = mergeAnd(s.filter, conjunct)
+		}
+	}
+
+	for _, j := range f.joins {
+		if err := j.finalize(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// pushDownTable returns the table whose scan can evaluate the conjunct of the
+// WHERE clause in place of the join, or nil if there is no such table. The
+// conjunct must only refer to a single table which is not on the
+// NULL-extended side of an outer join.
+func (f *fromClause) pushDownTable(conjunct parser.Expr) (*fromTable, error) {
+	tables, err := f.scope.referencedTables(conjunct)
+	if err != nil || len(tables) != 1 {
+		return nil, err
+	}
+	for t := range tables {
+		if !t.nullable {
+			return t, nil
+		}
+	}
+	return nil, nil
+}
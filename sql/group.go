@@ -0,0 +1,610 @@
+// Copyright 2015 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License. See the AUTHORS file
+// for names of contributors.
+
+package sql
+
+import (
+	"fmt"
+	"math"
+	"strings"
+
+	"github.com/cockroachdb/cockroach/sql/parser"
+	"github.com/cockroachdb/cockroach/util/decimal"
+)
+
+// aggregates maps the names of the supported aggregate functions to
+// constructors for their implementations.
+var aggregates = map[string]func() aggregateImpl{
+	"avg":   newAvgAggregate,
+	"count": newCountAggregate,
+	"max":   newMaxAggregate,
+	"min":   newMinAggregate,
+	"sum":   newSumAggregate,
+}
+
+// groupBy constructs a groupNode for the GROUP BY and HAVING clauses and the
+// aggregate functions of the SELECT. The render expressions of the scan are
+// moved to the groupNode which evaluates them once per group. The scan is
+// configured to render the GROUP BY expressions followed by the arguments of
+// the aggregate functions. numCols is the number of columns in the result,
+// which GROUP BY ordinals refer to. Returns nil if the SELECT does not group
+// rows.
+func (p *planner) groupBy(n *parser.Select, s *scanNode, numCols int) (*groupNode, error) {
+	if s.filter != nil && containsAggregate(s.filter) {
+		return nil, fmt.Errorf("aggregate functions are not allowed in WHERE")
+	}
+
+	hasAggregates := n.Having != nil
+	for _, expr := range s.render {
+		if containsAggregate(expr) {
+			hasAggregates = true
+			break
+		}
+	}
+	if len(n.GroupBy) == 0 && !hasAggregates {
+		return nil, nil
+	}
+
+	groupBy := make([]parser.Expr, 0, len(n.GroupBy))
+	for _, expr := range n.GroupBy {
+		if t, ok := expr.(parser.IntVal); ok {
+			// GROUP BY 1 refers to the first column of the result.
+			if t < 1 || int(t) > numCols {
+				return nil, fmt.Errorf("invalid GROUP BY index: %d not in range [1, %d]",
+					t, numCols)
+			}
+			expr = s.render[t-1]
+		}
+		if containsAggregate(expr) {
+			return nil, fmt.Errorf("aggregate functions are not allowed in GROUP BY")
+		}
+		groupBy = append(groupBy, expr)
+	}
+
+	group := &groupNode{
+		columns:   s.columns,
+		render:    s.render,
+		numGroups: len(groupBy),
+		key:       make(parser.DTuple, len(groupBy)),
+		evalCtx:   p.evalCtx,
+	}
+	if n.Having != nil {
+		group.having = n.Having.Expr
+	}
+
+	// Replace the aggregate functions with references to their results and
+	// collect the arguments of the aggregate functions.
+	av := extractAggregatesVisitor{group: group}
+	for i := range group.render {
+		group.render[i] = parser.WalkExpr(&av, group.render[i])
+	}
+	if group.having != nil {
+		group.having = parser.WalkExpr(&av, group.having)
+	}
+	if av.err != nil {
+		return nil, av.err
+	}
+
+	// Replace the GROUP BY expressions with references to the values of the
+	// current group. Any remaining column reference is an error as its value
+	// may differ between the rows of a group.
+	gv := groupKeyVisitor{group: group, groupBy: groupBy}
+	for i := range group.render {
+		group.render[i] = gv.replace(group.render[i])
+	}
+	if group.having != nil {
+		group.having = gv.replace(group.having)
+	}
+	if gv.err != nil {
+		return nil, gv.err
+	}
+
+	s.render = append(groupBy, av.args...)
+	s.columns = make([]string, 0, len(s.render))
+	for _, expr := range s.render {
+		s.columns = append(s.columns, expr.String())
+	}
+	return group, nil
+}
+
+// wrap configures the groupNode to group the rows of plan. A nil groupNode
+// returns the plan unchanged.
+func (n *groupNode) wrap(plan planNode) planNode {
+	if n == nil {
+		return plan
+	}
+	n.plan = plan
+	return n
+}
+
+type extractAggregatesVisitor struct {
+	group *groupNode
+	args  []parser.Expr
+	err   error
+}
+
+var _ parser.Visitor = &extractAggregatesVisitor{}
+
+func (v *extractAggregatesVisitor) Visit(expr parser.Expr) parser.Expr {
+	if v.err != nil {
+		return expr
+	}
+	t, ok := expr.(*parser.FuncExpr)
+	if !ok {
+		return expr
+	}
+	create, ok := aggregates[strings.ToLower(t.Name.String())]
+	if !ok {
+		return expr
+	}
+	if len(t.Exprs) != 1 {
+		v.err = fmt.Errorf("%s: incorrect number of arguments: %d vs 1", t.Name, len(t.Exprs))
+		return expr
+	}
+
+	arg := t.Exprs[0]
+	if _, ok := arg.(*parser.StarExpr); ok {
+		if !strings.EqualFold(t.Name.String(), "count") {
+			v.err = fmt.Errorf("%s(*) is not supported", t.Name)
+			return expr
+		}
+		// COUNT(*) counts every row which is equivalent to counting a non-NULL
+		// constant.
+		arg = parser.DInt(1)
+	}
+	if containsAggregate(arg) {
+		v.err = fmt.Errorf("aggregate function calls cannot be nested")
+		return expr
+	}
+
+	f := &aggregateFunc{create: create, distinct: t.Distinct}
+	v.group.funcs = append(v.group.funcs, f)
+	v.args = append(v.args, arg)
+	return &parser.DReference{Expr: t, Datum: &f.value}
+}
+
+type groupKeyVisitor struct {
+	group   *groupNode
+	groupBy []parser.Expr
+	err     error
+}
+
+var _ parser.Visitor = &groupKeyVisitor{}
+
+func (v *groupKeyVisitor) Visit(expr parser.Expr) parser.Expr {
+	if v.err != nil {
+		return expr
+	}
+	if ref, ok := expr.(*parser.DReference); ok {
+		if _, ok := ref.Expr.(*parser.QualifiedName); !ok {
+			// The result of an aggregate function.
+			return expr
+		}
+	}
+	s := expr.String()
+	for i, e := range v.groupBy {
+		if e.String() == s {
+			return &parser.DReference{Expr: expr, Datum: &v.group.key[i]}
+		}
+	}
+	switch expr.(type) {
+	case *parser.QualifiedName, *parser.DReference:
+		// A reference to a column, either of the table being scanned or of a
+		// joined row.
+		v.err = fmt.Errorf("column \"%s\" must appear in the GROUP BY clause or be used in an aggregate function", expr)
+	}
+	return expr
+}
+
+func (v *groupKeyVisitor) replace(expr parser.Expr) parser.Expr {
+	return parser.WalkExpr(v, expr)
+}
+
+type isAggregateVisitor struct {
+	aggregated bool
+}
+
+var _ parser.Visitor = &isAggregateVisitor{}
+
+func (v *isAggregateVisitor) Visit(expr parser.Expr) parser.Expr {
+	if t, ok := expr.(*parser.FuncExpr); ok {
+		if _, ok := aggregates[strings.ToLower(t.Name.String())]; ok {
+			v.aggregated = true
+		}
+	}
+	return expr
+}
+
+// containsAggregate returns true if the expression contains a call to an
+// aggregate function.
+func containsAggregate(expr parser.Expr) bool {
+	v := isAggregateVisitor{}
+	parser.WalkExpr(&v, expr)
+	return v.aggregated
+}
+
+// An aggregateFunc is a call to an aggregate function within the render or
+// HAVING expressions of a groupNode.
+type aggregateFunc struct {
+	create   func() aggregateImpl
+	distinct bool
+	value    parser.Datum // the result for the current group
+}
+
+// A group holds the state of the aggregate functions for the rows with the
+// same values for the GROUP BY expressions.
+type group struct {
+	key  parser.DTuple
+	aggs []aggregateImpl
+}
+
+// A groupNode groups the rows of its source plan by the values of the GROUP
+// BY expressions and computes the aggregate functions for each group. The
+// rows of the source plan contain the values of the GROUP BY expressions
+// followed by the arguments of the aggregate functions.
+type groupNode struct {
+	plan      planNode
+	columns   []string
+	render    []parser.Expr // rendering expressions for groups
+	having    parser.Expr   // filtering expression for groups
+	numGroups int           // the number of GROUP BY expressions
+	funcs     []*aggregateFunc
+	key       parser.DTuple // the GROUP BY values of the current group
+	evalCtx   parser.EvalContext
+
+	initialized bool
+	groups      []*group
+	nextGroup   int
+	row         parser.DTuple
+	err         error
+}
+
+func (n *groupNode) ExplainPlan(verbose bool) (name, description string, children []planNode) {
+	description = explainExprs("render", n.render...)
+	if verbose {
+		description = explainParts(description, explainExprs("having", n.having))
+	}
+	return "group", description, []planNode{n.plan}
+}
+
+func (n *groupNode) Columns() []string {
+	return n.columns
+}
+
+func (n *groupNode) Values() parser.DTuple {
+	return n.row
+}
+
+func (n *groupNode) Next() bool {
+	if n.err != nil {
+		return false
+	}
+	if !n.initialized {
+		n.initialized = true
+		if n.err = n.computeAggregates(); n.err != nil {
+			return false
+		}
+	}
+
+	for n.nextGroup < len(n.groups) {
+		g := n.groups[n.nextGroup]
+		n.nextGroup++
+
+		copy(n.key, g.key)
+		for i, f := range n.funcs {
+			if f.value, n.err = g.aggs[i].result(); n.err != nil {
+				return false
+			}
+		}
+
+		if n.having != nil {
+			var d parser.Datum
+			if d, n.err = parser.EvalExpr(n.evalCtx, n.having, nil); n.err != nil {
+				return false
+			}
+			if d == parser.DNull {
+				continue
+			}
+			v, ok := d.(parser.DBool)
+			if !ok {
+				n.err = fmt.Errorf("HAVING clause did not evaluate to a boolean")
+				return false
+			}
+			if !v {
+				continue
+			}
+		}
+
+		if n.row == nil {
+			n.row = make(parser.DTuple, len(n.render))
+		}
+		for i, e := range n.render {
+			if n.row[i], n.err = parser.EvalExpr(n.evalCtx, e, nil); n.err != nil {
+				return false
+			}
+		}
+		return true
+	}
+	return false
+}
+
+func (n *groupNode) Err() error {
+	return n.err
+}
+
+// computeAggregates retrieves all of the rows from the source plan and
+// accumulates them into groups.
+func (n *groupNode) computeAggregates() error {
+	groups := map[string]*group{}
+	for n.plan.Next() {
+		values := n.plan.Values()
+		key := values[:n.numGroups]
+		encoded, err := encodeDatumKey(nil, key)
+		if err != nil {
+			return err
+		}
+		g, ok := groups[string(encoded)]
+		if !ok {
+			g = n.newGroup(key)
+			groups[string(encoded)] = g
+		}
+		for i, agg := range g.aggs {
+			if err := agg.add(values[n.numGroups+i]); err != nil {
+				return err
+			}
+		}
+	}
+	if err := n.plan.Err(); err != nil {
+		return err
+	}
+	if len(n.groups) == 0 && n.numGroups == 0 {
+		// Without GROUP BY, the aggregate functions are computed over all of the
+		// rows, even if there are none.
+		n.newGroup(nil)
+	}
+	return nil
+}
+
+func (n *groupNode) newGroup(key parser.DTuple) *group {
+	g := &group{
+		key:  make(parser.DTuple, len(key)),
+		aggs: make([]aggregateImpl, len(n.funcs)),
+	}
+	copy(g.key, key)
+	for i, f := range n.funcs {
+		g.aggs[i] = f.create()
+		if f.distinct {
+			g.aggs[i] = &distinctAggregate{
+				aggregateImpl: g.aggs[i],
+				seen:          map[string]struct{}{},
+			}
+		}
+	}
+	n.groups = append(n.groups, g)
+	return g
+}
+
+// An aggregateImpl accumulates the values of an aggregate function for the
+// rows of a group.
+type aggregateImpl interface {
+	add(parser.Datum) error
+	result() (parser.Datum, error)
+}
+
+var _ aggregateImpl = &avgAggregate{}
+var _ aggregateImpl = &countAggregate{}
+var _ aggregateImpl = &distinctAggregate{}
+var _ aggregateImpl = &maxAggregate{}
+var _ aggregateImpl = &minAggregate{}
+var _ aggregateImpl = &sumAggregate{}
+
+// distinctAggregate passes each distinct value to the wrapped aggregate
+// function once.
+type distinctAggregate struct {
+	aggregateImpl
+	seen map[string]struct{}
+}
+
+func (a *distinctAggregate) add(datum parser.Datum) error {
+	if datum == parser.DNull {
+		return nil
+	}
+	encoded, err := encodeDatumKey(nil, datum)
+	if err != nil {
+		return err
+	}
+	if _, ok := a.seen[string(encoded)]; ok {
+		return nil
+	}
+	a.seen[string(encoded)] = struct{}{}
+	return a.aggregateImpl.add(datum)
+}
+
+type avgAggregate struct {
+	sumAggregate
+	count int
+}
+
+func newAvgAggregate() aggregateImpl {
+	return &avgAggregate{}
+}
+
+func (a *avgAggregate) add(datum parser.Datum) error {
+	if datum == parser.DNull {
+		return nil
+	}
+	if sum, ok := a.sum.(parser.DInt); ok {
+		if t, ok := datum.(parser.DInt); ok && addOverflows(int64(sum), int64(t)) {
+			// The average is a float anyway, so the sum continues as one.
+			a.sum = parser.DFloat(sum)
+		}
+	}
+	if err := a.sumAggregate.add(datum); err != nil {
+		return err
+	}
+	a.count++
+	return nil
+}
+
+func (a *avgAggregate) result() (parser.Datum, error) {
+	sum, err := a.sumAggregate.result()
+	if err != nil {
+		return parser.DNull, err
+	}
+	switch t := sum.(type) {
+	case parser.DInt:
+		return parser.DFloat(t) / parser.DFloat(a.count), nil
+	case parser.DFloat:
+		return t / parser.DFloat(a.count), nil
+	case parser.DDecimal:
+		// The average of decimals is rounded like the quotient of decimals.
+		return parser.EvalExpr(parser.EvalContext{}, &parser.BinaryExpr{
+			Operator: parser.Div, Left: t, Right: parser.DInt(a.count)}, nil)
+	}
+	return parser.DNull, nil
+}
+
+// addOverflows returns whether the sum of the integers overflows an int64.
+func addOverflows(a, b int64) bool {
+	return (b > 0 && a > math.MaxInt64-b) || (b < 0 && a < math.MinInt64-b)
+}
+
+type countAggregate struct {
+	count int
+}
+
+func newCountAggregate() aggregateImpl {
+	return &countAggregate{}
+}
+
+func (a *countAggregate) add(datum parser.Datum) error {
+	if datum == parser.DNull {
+		return nil
+	}
+	a.count++
+	return nil
+}
+
+func (a *countAggregate) result() (parser.Datum, error) {
+	return parser.DInt(a.count), nil
+}
+
+type maxAggregate struct {
+	max parser.Datum
+}
+
+func newMaxAggregate() aggregateImpl {
+	return &maxAggregate{max: parser.DNull}
+}
+
+func (a *maxAggregate) add(datum parser.Datum) error {
+	if datum == parser.DNull {
+		return nil
+	}
+	if a.max != parser.DNull && a.max.Type() != datum.Type() {
+		return fmt.Errorf("MAX: mismatched types: %s and %s", a.max.Type(), datum.Type())
+	}
+	if a.max == parser.DNull || datum.Compare(a.max) > 0 {
+		a.max = datum
+	}
+	return nil
+}
+
+func (a *maxAggregate) result() (parser.Datum, error) {
+	return a.max, nil
+}
+
+type minAggregate struct {
+	min parser.Datum
+}
+
+func newMinAggregate() aggregateImpl {
+	return &minAggregate{min: parser.DNull}
+}
+
+func (a *minAggregate) add(datum parser.Datum) error {
+	if datum == parser.DNull {
+		return nil
+	}
+	if a.min != parser.DNull && a.min.Type() != datum.Type() {
+		return fmt.Errorf("MIN: mismatched types: %s and %s", a.min.Type(), datum.Type())
+	}
+	if a.min == parser.DNull || datum.Compare(a.min) < 0 {
+		a.min = datum
+	}
+	return nil
+}
+
+func (a *minAggregate) result() (parser.Datum, error) {
+	return a.min, nil
+}
+
+type sumAggregate struct {
+	sum parser.Datum
+}
+
+func newSumAggregate() aggregateImpl {
+	return &sumAggregate{sum: parser.DNull}
+}
+
+func (a *sumAggregate) add(datum parser.Datum) error {
+	switch t := datum.(type) {
+	case parser.DInt:
+		switch sum := a.sum.(type) {
+		case parser.DInt:
+			if addOverflows(int64(sum), int64(t)) {
+				return fmt.Errorf("sum is out of range for type int")
+			}
+			a.sum = sum + t
+		case parser.DFloat:
+			a.sum = sum + parser.DFloat(t)
+		case parser.DDecimal:
+			a.sum = parser.DDecimal{Decimal: sum.Add(decimal.New(int64(t), 0))}
+		default:
+			a.sum = t
+		}
+	case parser.DFloat:
+		switch sum := a.sum.(type) {
+		case parser.DInt:
+			a.sum = parser.DFloat(sum) + t
+		case parser.DFloat:
+			a.sum = sum + t
+		case parser.DDecimal:
+			a.sum = parser.DFloat(sum.Float64()) + t
+		default:
+			a.sum = t
+		}
+	case parser.DDecimal:
+		switch sum := a.sum.(type) {
+		case parser.DInt:
+			a.sum = parser.DDecimal{Decimal: t.Add(decimal.New(int64(sum), 0))}
+		case parser.DFloat:
+			a.sum = sum + parser.DFloat(t.Float64())
+		case parser.DDecimal:
+			a.sum = parser.DDecimal{Decimal: sum.Add(t.Decimal)}
+		default:
+			a.sum = t
+		}
+	default:
+		if datum != parser.DNull {
+			return fmt.Errorf("unsupported type for aggregate: %s", datum.Type())
+		}
+	}
+	return nil
+}
+
+func (a *sumAggregate) result() (parser.Datum, error) {
+	return a.sum, nil
+}
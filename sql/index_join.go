@@ -0,0 +1,144 @@
+// Copyright 2015 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License. See the AUTHORS file
+// for names of contributors.
+
+package sql
+
+import (
+	"fmt"
+
+	"github.com/cockroachdb/cockroach/proto"
+	"github.com/cockroachdb/cockroach/sql/parser"
+	"github.com/cockroachdb/cockroach/structured"
+)
+
+// indexJoinBatchSize is the number of primary keys retrieved from the index
+// before looking up the corresponding rows in the table.
+const indexJoinBatchSize = 100
+
+// An indexJoinNode retrieves rows from a table using the primary keys
+// produced by a scan of one of the table's secondary indexes. The index is
+// scanned in batches and for each batch the corresponding table rows are
+// retrieved using one span per primary key.
+type indexJoinNode struct {
+	index            *scanNode
+	table            *scanNode
+	primaryKeyPrefix proto.Key
+	colIDtoRowIndex  map[structured.ID]int
+	err              error
+}
+
+// makeIndexJoin transforms a scan over a secondary index into an index join.
+// The filter and render expressions of the index scan are moved to the table
+// scan and the index scan is configured to output the primary key columns.
+func makeIndexJoin(indexScan *scanNode) (*indexJoinNode, error) {
+	desc := indexScan.desc
+	table := &scanNode{
+		db:      indexScan.db,
+		desc:    desc,
+		index:   &desc.PrimaryIndex,
+		columns: indexScan.columns,
+		filter:  indexScan.filter,
+		render:  indexScan.render,
+		evalCtx: indexScan.evalCtx,
+	}
+
+	indexScan.columns = nil
+	indexScan.filter = nil
+	indexScan.render = nil
+	colIDtoRowIndex := map[structured.ID]int{}
+	for i, id := range desc.PrimaryIndex.ColumnIDs {
+		col, err := desc.FindColumnByID(id)
+		if err != nil {
+			return nil, err
+		}
+		indexScan.columns = append(indexScan.columns, col.Name)
+		indexScan.render = append(indexScan.render, &parser.QualifiedName{Base: parser.Name(col.Name)})
+		colIDtoRowIndex[id] = i
+	}
+
+	return &indexJoinNode{
+		index:            indexScan,
+		table:            table,
+		primaryKeyPrefix: proto.Key(structured.MakeIndexKeyPrefix(desc.ID, desc.PrimaryIndex.ID)),
+		colIDtoRowIndex:  colIDtoRowIndex,
+	}, nil
+}
+
+func (n *indexJoinNode) Columns() []string {
+	return n.table.Columns()
+}
+
+func (n *indexJoinNode) Values() parser.DTuple {
+	return n.table.Values()
+}
+
+func (n *indexJoinNode) ExplainPlan(verbose bool) (name, description string, children []planNode) {
+	// The spans of the table are determined by the index scan.
+	description = fmt.Sprintf("%s@%s", n.table.desc.Name, n.table.index.Name)
+	if verbose {
+		description = explainParts(description,
+			explainExprs("render", n.table.render...), explainExprs("filter", n.table.filter))
+	}
+	return "index-join", description, []planNode{n.index}
+}
+
+func (n *indexJoinNode) Next() bool {
+	if n.err != nil {
+		return false
+	}
+
+	for {
+		if len(n.table.spans) > 0 {
+			if n.table.Next() {
+				return true
+			}
+			if n.err = n.table.Err(); n.err != nil {
+				return false
+			}
+		}
+
+		// The current batch of table rows is exhausted. Retrieve the next batch
+		// of primary keys from the index.
+		n.table.spans = n.table.spans[:0]
+		batchSize := indexJoinBatchSize
+		if n.index.maxRows > 0 && n.index.maxRows < int64(batchSize) {
+			// Only a limited number of rows are needed.
+			batchSize = int(n.index.maxRows)
+		}
+		for len(n.table.spans) < batchSize && n.index.Next() {
+			var key []byte
+			key, _, n.err = encodeIndexKey(n.table.index.ColumnIDs, n.colIDtoRowIndex,
+				n.index.Values(), n.primaryKeyPrefix)
+			if n.err != nil {
+				return false
+			}
+			n.table.spans = append(n.table.spans, span{
+				start: proto.Key(key),
+				end:   proto.Key(key).PrefixEnd(),
+			})
+		}
+		if n.err = n.index.Err(); n.err != nil {
+			return false
+		}
+		if len(n.table.spans) == 0 {
+			return false
+		}
+		n.table.resetScan()
+	}
+}
+
+func (n *indexJoinNode) Err() error {
+	return n.err
+}
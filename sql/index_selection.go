@@ -0,0 +1,451 @@
+// Copyright 2015 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License. See the AUTHORS file
+// for names of contributors.
+
+package sql
+
+import (
+	"bytes"
+	"sort"
+
+	"github.com/cockroachdb/cockroach/proto"
+	"github.com/cockroachdb/cockroach/sql/parser"
+	"github.com/cockroachdb/cockroach/structured"
+)
+
+// A columnConstraint is a comparison of a column against a constant value
+// extracted from a filter expression, such as "k > 1" or "k IN (1, 2, 3)".
+type columnConstraint struct {
+	op  parser.ComparisonOp
+	val parser.Datum
+}
+
+// columnConstraints maps column names to the constraints on those columns.
+type columnConstraints map[string][]columnConstraint
+
+func (c columnConstraints) add(ctx parser.EvalContext, name string, op parser.ComparisonOp, expr parser.Expr) {
+	// A constant expression is one that can be evaluated without an
+	// environment.
+	val, err := parser.EvalExpr(ctx, expr, nil)
+	if err != nil || val == parser.DNull {
+		return
+	}
+	c[name] = append(c[name], columnConstraint{op: op, val: val})
+}
+
+// analyzeFilter extracts the constraints on columns from the conjuncts of a
+// filter expression. Only comparisons between a column and a constant
+// expression are considered; everything else is left to the filter.
+func analyzeFilter(ctx parser.EvalContext, filter parser.Expr, constraints columnConstraints) {
+	switch t := filter.(type) {
+	case *parser.AndExpr:
+		analyzeFilter(ctx, t.Left, constraints)
+		analyzeFilter(ctx, t.Right, constraints)
+
+	case *parser.ParenExpr:
+		analyzeFilter(ctx, t.Expr, constraints)
+
+	case *parser.RangeCond:
+		// "k BETWEEN a AND b" is equivalent to "k >= a AND k <= b".
+		if qname, ok := t.Left.(*parser.QualifiedName); ok && !t.Not {
+			constraints.add(ctx, qname.String(), parser.GE, t.From)
+			constraints.add(ctx, qname.String(), parser.LE, t.To)
+		}
+
+	case *parser.ComparisonExpr:
+		if t.Operator == parser.Like {
+			analyzeLike(ctx, t, constraints)
+			return
+		}
+		op := t.Operator
+		left, right := t.Left, t.Right
+		if _, ok := left.(*parser.QualifiedName); !ok {
+			// Normalize "1 < k" to "k > 1".
+			left, right = right, left
+			switch op {
+			case parser.LT:
+				op = parser.GT
+			case parser.LE:
+				op = parser.GE
+			case parser.GT:
+				op = parser.LT
+			case parser.GE:
+				op = parser.LE
+			case parser.In:
+				return
+			}
+		}
+		switch op {
+		case parser.EQ, parser.LT, parser.LE, parser.GT, parser.GE, parser.In:
+		default:
+			return
+		}
+		if qname, ok := left.(*parser.QualifiedName); ok {
+			constraints.add(ctx, qname.String(), op, right)
+		}
+	}
+}
+
+// analyzeLike extracts the constraints on a column from a LIKE of the column
+// against a constant pattern. Every string matching "k LIKE 'abc%'" lies in
+// the range ['abc', 'abd'), and a pattern without wildcards such as
+// "k LIKE 'abc'" is equivalent to "k = 'abc'".
+func analyzeLike(ctx parser.EvalContext, t *parser.ComparisonExpr, constraints columnConstraints) {
+	qname, ok := t.Left.(*parser.QualifiedName)
+	if !ok {
+		return
+	}
+	d, err := parser.EvalExpr(ctx, t.Right, nil)
+	if err != nil {
+		return
+	}
+	pattern, ok := d.(parser.DString)
+	if !ok {
+		return
+	}
+	escape := parser.DefaultEscape
+	if t.Escape != nil {
+		d, err := parser.EvalExpr(ctx, t.Escape, nil)
+		if err != nil {
+			return
+		}
+		e, ok := d.(parser.DString)
+		if !ok {
+			return
+		}
+		escape = string(e)
+	}
+
+	name := qname.String()
+	prefix, exact := parser.LikePrefix(string(pattern), escape)
+	if exact {
+		constraints.add(ctx, name, parser.EQ, parser.DString(prefix))
+		return
+	}
+	if prefix == "" {
+		return
+	}
+	constraints.add(ctx, name, parser.GE, parser.DString(prefix))
+	if end := proto.Key(prefix).PrefixEnd(); bytes.Compare(end, []byte(prefix)) > 0 {
+		constraints.add(ctx, name, parser.LT, parser.DString(end))
+	}
+}
+
+// maxIndexSpans is the maximum number of spans we'll generate for an index
+// when expanding IN constraints.
+const maxIndexSpans = 1000
+
+// indexInfo holds the result of analyzing the constraints on the columns of an
+// index.
+type indexInfo struct {
+	index         *structured.IndexDescriptor
+	exactPrefix   int  // the number of leading columns constrained by equality
+	singlePrefix  int  // the number of leading columns constrained to one value
+	rangeBound    bool // the column following the exact prefix has a bound
+	spans         []span
+	isConstrained bool
+}
+
+// makeIndexInfo computes the spans of the index which contain all of the rows
+// satisfying the constraints. The leading index columns constrained by
+// equality (or by IN, which generates one span per value) form the prefix of
+// the spans. The first column which is not constrained by equality may
+// further narrow the spans with a lower and upper bound.
+func makeIndexInfo(desc *structured.TableDescriptor, index *structured.IndexDescriptor,
+	constraints columnConstraints) (indexInfo, error) {
+	info := indexInfo{index: index}
+	prefixes := [][]byte{structured.MakeIndexKeyPrefix(desc.ID, index.ID)}
+
+	for _, id := range index.ColumnIDs {
+		col, err := desc.FindColumnByID(id)
+		if err != nil {
+			return info, err
+		}
+		vals := exactValues(constraints[col.Name], col)
+		if vals == nil || len(prefixes)*len(vals) > maxIndexSpans {
+			break
+		}
+		var newPrefixes [][]byte
+		for _, prefix := range prefixes {
+			for _, val := range vals {
+				key, err := encodeTableKey(append([]byte(nil), prefix...), val)
+				if err != nil {
+					return info, err
+				}
+				newPrefixes = append(newPrefixes, key)
+			}
+		}
+		prefixes = newPrefixes
+		if len(vals) == 1 && info.singlePrefix == info.exactPrefix {
+			info.singlePrefix++
+		}
+		info.exactPrefix++
+	}
+
+	// Determine the bounds on the column following the exact prefix.
+	var lower, upper []columnConstraint
+	if info.exactPrefix < len(index.ColumnIDs) {
+		col, err := desc.FindColumnByID(index.ColumnIDs[info.exactPrefix])
+		if err != nil {
+			return info, err
+		}
+		for _, c := range constraints[col.Name] {
+			if !datumMatchesColumnType(c.val, col) {
+				continue
+			}
+			switch c.op {
+			case parser.GT, parser.GE:
+				lower = append(lower, c)
+			case parser.LT, parser.LE:
+				upper = append(upper, c)
+			}
+		}
+	}
+	info.rangeBound = len(lower) > 0 || len(upper) > 0
+
+	for _, prefix := range prefixes {
+		s := span{
+			start: proto.Key(prefix),
+			end:   proto.Key(prefix).PrefixEnd(),
+		}
+		for _, c := range lower {
+			key, err := encodeTableKey(append([]byte(nil), prefix...), c.val)
+			if err != nil {
+				return info, err
+			}
+			if c.op == parser.GT {
+				key = proto.Key(key).PrefixEnd()
+			}
+			if bytes.Compare(key, s.start) > 0 {
+				s.start = proto.Key(key)
+			}
+		}
+		for _, c := range upper {
+			key, err := encodeTableKey(append([]byte(nil), prefix...), c.val)
+			if err != nil {
+				return info, err
+			}
+			if c.op == parser.LE {
+				key = proto.Key(key).PrefixEnd()
+			}
+			if bytes.Compare(key, s.end) < 0 {
+				s.end = proto.Key(key)
+			}
+		}
+		if bytes.Compare(s.start, s.end) < 0 {
+			// Spans for contradictory constraints are empty and can be dropped.
+			info.spans = append(info.spans, s)
+		}
+	}
+
+	sort.Sort(spans(info.spans))
+	info.isConstrained = info.exactPrefix > 0 || info.rangeBound
+	return info, nil
+}
+
+// exactValues returns the values a column is constrained to by equality or IN
+// constraints, in sorted order and without duplicates. Returns nil if the
+// column is not constrained to a set of values.
+func exactValues(constraints []columnConstraint, col *structured.ColumnDescriptor) []parser.Datum {
+	var eq parser.Datum
+	for _, c := range constraints {
+		if c.op != parser.EQ || !datumMatchesColumnType(c.val, col) {
+			continue
+		}
+		if eq == nil {
+			eq = c.val
+		} else if eq.Compare(c.val) != 0 {
+			// "k = 1 AND k = 2" can never be satisfied.
+			return []parser.Datum{}
+		}
+	}
+	if eq != nil {
+		return []parser.Datum{eq}
+	}
+	for _, c := range constraints {
+		switch c.op {
+		case parser.In:
+			tuple, ok := c.val.(parser.DTuple)
+			if !ok {
+				continue
+			}
+			vals := make([]parser.Datum, 0, len(tuple))
+			for _, val := range tuple {
+				if val == parser.DNull {
+					// NULL never compares equal to anything.
+					continue
+				}
+				if !datumMatchesColumnType(val, col) {
+					vals = nil
+					break
+				}
+				vals = append(vals, val)
+			}
+			if vals != nil {
+				sort.Sort(datums(vals))
+				// "k IN (1, 1)" would otherwise generate duplicate spans.
+				r := vals[:0]
+				for i, val := range vals {
+					if i == 0 || val.Compare(vals[i-1]) != 0 {
+						r = append(r, val)
+					}
+				}
+				return r
+			}
+		}
+	}
+	return nil
+}
+
+type datums []parser.Datum
+
+func (d datums) Len() int {
+	return len(d)
+}
+
+func (d datums) Swap(i, j int) {
+	d[i], d[j] = d[j], d[i]
+}
+
+func (d datums) Less(i, j int) bool {
+	return d[i].Compare(d[j]) < 0
+}
+
+type spans []span
+
+func (s spans) Len() int {
+	return len(s)
+}
+
+func (s spans) Swap(i, j int) {
+	s[i], s[j] = s[j], s[i]
+}
+
+func (s spans) Less(i, j int) bool {
+	return bytes.Compare(s[i].start, s[j].start) < 0
+}
+
+// mergeSpans merges overlapping spans, such as the duplicate spans generated
+// by "k IN (1, 1)". The spans must be sorted by their start key.
+func mergeSpans(s []span) []span {
+	if len(s) == 0 {
+		return s
+	}
+	r := s[:1]
+	for _, cur := range s[1:] {
+		last := &r[len(r)-1]
+		if bytes.Compare(cur.start, last.end) <= 0 {
+			if bytes.Compare(cur.end, last.end) > 0 {
+				last.end = cur.end
+			}
+			continue
+		}
+		r = append(r, cur)
+	}
+	return r
+}
+
+// betterThan returns true if the index described by i is expected to require
+// scanning fewer rows than the index described by other.
+func (i indexInfo) betterThan(other indexInfo) bool {
+	if i.exactPrefix != other.exactPrefix {
+		return i.exactPrefix > other.exactPrefix
+	}
+	if i.rangeBound != other.rangeBound {
+		return i.rangeBound
+	}
+	// A fully constrained unique index matches at most one row per span.
+	return i.index.Unique && i.exactPrefix == len(i.index.ColumnIDs) &&
+		!(other.index.Unique && other.exactPrefix == len(other.index.ColumnIDs))
+}
+
+// datumMatchesColumnType returns true if the datum can be encoded in a key
+// for the column.
+func datumMatchesColumnType(d parser.Datum, col *structured.ColumnDescriptor) bool {
+	switch d.(type) {
+	case parser.DInt:
+		return col.Type.Kind == structured.ColumnType_INT ||
+			col.Type.Kind == structured.ColumnType_BIT
+	case parser.DFloat:
+		return col.Type.Kind == structured.ColumnType_FLOAT
+	case parser.DDecimal:
+		return col.Type.Kind == structured.ColumnType_DECIMAL
+	case parser.DString:
+		return col.Type.Kind == structured.ColumnType_CHAR ||
+			col.Type.Kind == structured.ColumnType_TEXT
+	case parser.DBytes:
+		return col.Type.Kind == structured.ColumnType_BLOB
+	case parser.DDate:
+		return col.Type.Kind == structured.ColumnType_DATE
+	case parser.DTime:
+		return col.Type.Kind == structured.ColumnType_TIME
+	case parser.DTimestamp:
+		return col.Type.Kind == structured.ColumnType_TIMESTAMP
+	case parser.DInterval:
+		return col.Type.Kind == structured.ColumnType_INTERVAL
+	}
+	return false
+}
+
+// selectIndex analyzes the filter of a scan and constrains the scan to the
+// spans of the index which contain the rows that can satisfy the filter. The
+// primary index is preferred when it constrains the scan as well as any of
+// the secondary indexes. If a secondary index is chosen, the returned plan
+// scans the spans of that index and looks up the corresponding rows in the
+// table.
+func (p *planner) selectIndex(s *scanNode) (planNode, error) {
+	if s.desc == nil || s.filter == nil {
+		return s, nil
+	}
+
+	constraints := columnConstraints{}
+	analyzeFilter(s.evalCtx, s.filter, constraints)
+	if len(constraints) == 0 {
+		return s, nil
+	}
+
+	best, err := makeIndexInfo(s.desc, &s.desc.PrimaryIndex, constraints)
+	if err != nil {
+		return nil, err
+	}
+	for i := range s.desc.Indexes {
+		if s.desc.Indexes[i].WriteOnly {
+			// The index is still being backfilled.
+			continue
+		}
+		info, err := makeIndexInfo(s.desc, &s.desc.Indexes[i], constraints)
+		if err != nil {
+			return nil, err
+		}
+		if info.betterThan(best) {
+			best = info
+		}
+	}
+	if !best.isConstrained {
+		return s, nil
+	}
+	if len(best.spans) == 0 {
+		// The constraints are contradictory and no row can match.
+		return &valuesNode{columns: s.columns}, nil
+	}
+
+	s.index = best.index
+	s.spans = mergeSpans(best.spans)
+	s.exactPrefix = best.singlePrefix
+	if best.index == &s.desc.PrimaryIndex {
+		return s, nil
+	}
+	s.isSecondaryIndex = true
+	return makeIndexJoin(s)
+}
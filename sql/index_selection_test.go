@@ -0,0 +1,190 @@
+// Copyright 2015 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License. See the AUTHORS file
+// for names of contributors.
+
+package sql
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/cockroachdb/cockroach/sql/parser"
+	"github.com/cockroachdb/cockroach/structured"
+	"github.com/cockroachdb/cockroach/util/leaktest"
+)
+
+func makeTestTableDesc(t *testing.T, schema string) *structured.TableDescriptor {
+	stmt, err := parser.Parse("CREATE TABLE test (" + schema + ")")
+	if err != nil {
+		t.Fatal(err)
+	}
+	desc, err := makeTableDesc(stmt[0].(*parser.CreateTable))
+	if err != nil {
+		t.Fatal(err)
+	}
+	desc.ID = 1000
+	if err := desc.AllocateIDs(); err != nil {
+		t.Fatal(err)
+	}
+	return &desc
+}
+
+func parseWhere(t *testing.T, where string) parser.Expr {
+	stmt, err := parser.Parse("SELECT * FROM test WHERE " + where)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return stmt[0].(*parser.Select).Where.Expr
+}
+
+func TestSelectIndex(t *testing.T) {
+	defer leaktest.AfterTest(t)
+
+	desc := makeTestTableDesc(t, `a INT PRIMARY KEY, b INT, c INT, d CHAR,
+CONSTRAINT b INDEX (b), CONSTRAINT bc INDEX (b, c), CONSTRAINT d UNIQUE (d)`)
+
+	testData := []struct {
+		where string
+		index string
+		spans int
+	}{
+		{`c = 1`, "primary", 0},
+		{`b != 1`, "primary", 0},
+		{`b = 1 OR c = 1`, "primary", 0},
+		{`b = 1.5`, "primary", 0},
+		{`a = 1`, "primary", 1},
+		{`a > 1 AND a < 10`, "primary", 1},
+		{`a BETWEEN 1 AND 10`, "primary", 1},
+		{`a NOT BETWEEN 1 AND 10`, "primary", 0},
+		{`a IN (1, 3, 5)`, "primary", 3},
+		{`a IN (3, 1, 3)`, "primary", 2},
+		{`a IN (1, NULL)`, "primary", 1},
+		{`a = 1 AND b = 1`, "primary", 1},
+		{`b = 1`, "b", 1},
+		{`1 = b`, "b", 1},
+		{`b > 1`, "b", 1},
+		{`b IN (1, 3)`, "b", 2},
+		// Adjacent spans are merged.
+		{`b IN (1, 2)`, "b", 1},
+		{`b = 1 AND c = 2`, "bc", 1},
+		{`b = 1 AND c > 2`, "bc", 1},
+		{`(b = 1) AND (c < 2)`, "bc", 1},
+		{`b IN (1, 3) AND c IN (3, 5)`, "bc", 4},
+		{`b IN (1, 2) AND c BETWEEN 3 AND 4`, "bc", 2},
+		{`d = 'foo'`, "d", 1},
+		{`b = 1 AND d = 'foo'`, "d", 1},
+		{`b = 1 + 2`, "b", 1},
+		{`d LIKE 'foo%'`, "d", 1},
+		{`d LIKE 'foo'`, "d", 1},
+		{`d LIKE 'f!%o%' ESCAPE '!'`, "d", 1},
+		{`d LIKE '%foo'`, "primary", 0},
+		{`d NOT LIKE 'foo%'`, "primary", 0},
+		{`d ILIKE 'foo%'`, "primary", 0},
+		{`d ~ '^foo'`, "primary", 0},
+	}
+	for _, d := range testData {
+		s := &scanNode{desc: desc, index: &desc.PrimaryIndex, filter: parseWhere(t, d.where)}
+		plan, err := (&planner{}).selectIndex(s)
+		if err != nil {
+			t.Fatalf("%s: %v", d.where, err)
+		}
+		if s.index.Name != d.index {
+			t.Errorf("%s: expected index %s, but found %s", d.where, d.index, s.index.Name)
+		}
+		if len(s.spans) != d.spans {
+			t.Errorf("%s: expected %d spans, but found %d", d.where, d.spans, len(s.spans))
+		}
+		if _, ok := plan.(*indexJoinNode); ok != (d.index != "primary") {
+			t.Errorf("%s: unexpected plan %T", d.where, plan)
+		}
+	}
+}
+
+func TestSelectIndexLike(t *testing.T) {
+	defer leaktest.AfterTest(t)
+
+	desc := makeTestTableDesc(t, `a CHAR PRIMARY KEY`)
+
+	// A LIKE with a constant prefix scans the same span as the equivalent
+	// range of the column.
+	testData := []struct {
+		like, where string
+	}{
+		{`a LIKE 'foo%'`, `a >= 'foo' AND a < 'fop'`},
+		{`a LIKE 'foo_bar'`, `a >= 'foo' AND a < 'fop'`},
+		{`a LIKE 'foo'`, `a = 'foo'`},
+		{`a LIKE 'fo\%%'`, `a >= 'fo%' AND a < 'fo&'`},
+		{`a LIKE 'fo%%' ESCAPE 'o'`, `a >= 'f%' AND a < 'f&'`},
+		{`a LIKE 'fo%' ESCAPE 'o'`, `a = 'f%'`},
+		{"a LIKE e'fo\\xff%'", "a >= e'fo\\xff' AND a < e'fp\\x00'"},
+	}
+	for _, d := range testData {
+		var spans [2][]span
+		for i, where := range []string{d.like, d.where} {
+			s := &scanNode{desc: desc, index: &desc.PrimaryIndex, filter: parseWhere(t, where)}
+			if _, err := (&planner{}).selectIndex(s); err != nil {
+				t.Fatalf("%s: %v", where, err)
+			}
+			spans[i] = s.spans
+		}
+		if !reflect.DeepEqual(spans[0], spans[1]) {
+			t.Errorf("%s: expected spans %v, but found %v", d.like, spans[1], spans[0])
+		}
+	}
+}
+
+func TestSelectIndexContradiction(t *testing.T) {
+	defer leaktest.AfterTest(t)
+
+	desc := makeTestTableDesc(t, `a INT PRIMARY KEY, b INT, CONSTRAINT b INDEX (b)`)
+	for _, where := range []string{`b > 2 AND b < 1`, `a = 1 AND a = 2`, `a IN (NULL)`} {
+		s := &scanNode{desc: desc, index: &desc.PrimaryIndex, filter: parseWhere(t, where)}
+		plan, err := (&planner{}).selectIndex(s)
+		if err != nil {
+			t.Fatalf("%s: %v", where, err)
+		}
+		if _, ok := plan.(*valuesNode); !ok {
+			t.Errorf("%s: expected an empty plan, but found %T", where, plan)
+		}
+	}
+}
+
+func TestExactValues(t *testing.T) {
+	defer leaktest.AfterTest(t)
+
+	desc := makeTestTableDesc(t, `a INT PRIMARY KEY, d CHAR`)
+	testData := []struct {
+		where    string
+		col      string
+		expected string
+	}{
+		{`a = 1`, "a", "(1)"},
+		{`a = 1 AND a = 1`, "a", "(1)"},
+		{`a = 1 AND a = 2`, "a", "()"},
+		{`a > 1`, "a", "()"},
+		{`a IN (3, 1, 3, NULL, 2)`, "a", "(1, 2, 3)"},
+		{`d IN ('b', 'a', 'b')`, "d", "('a', 'b')"},
+	}
+	for _, d := range testData {
+		col, err := desc.FindColumnByName(d.col)
+		if err != nil {
+			t.Fatal(err)
+		}
+		constraints := columnConstraints{}
+		analyzeFilter(parser.EvalContext{}, parseWhere(t, d.where), constraints)
+		if vals := parser.DTuple(exactValues(constraints[d.col], col)).String(); vals != d.expected {
+			t.Errorf("%s: expected %s, but found %s", d.where, d.expected, vals)
+		}
+	}
+}
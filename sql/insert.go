@@ -30,7 +30,7 @@ import (
 
 // Insert inserts rows into the database.
 // Privileges: WRITE on table
-//   Notes: postgres requires INSERT. No "on duplicate key update" option.
+//   Notes: postgres requires INSERT. Also requires UPDATE on "ON CONFLICT DO UPDATE".
 //          mysql requires INSERT. Also requires UPDATE on "ON DUPLICATE KEY UPDATE".
 func (p *planner) Insert(n *parser.Insert) (planNode, error) {
 	tableDesc, err := p.getTableDesc(n.Table)
@@ -49,18 +49,9 @@ func (p *planner) Insert(n *parser.Insert) (planNode, error) {
 		return nil, err
 	}
 
-	// Construct a map from column ID to the index the value appears at within a
-	// row.
-	colIDtoRowIndex := map[structured.ID]int{}
-	for i, c := range cols {
-		colIDtoRowIndex[c.ID] = i
-	}
-
-	// Verify we have at least the columns that are part of the primary key.
-	for i, id := range tableDesc.PrimaryIndex.ColumnIDs {
-		if _, ok := colIDtoRowIndex[id]; !ok {
-			return nil, fmt.Errorf("missing %q primary key column", tableDesc.PrimaryIndex.ColumnNames[i])
-		}
+	ih, err := p.makeInsertHelper(tableDesc, cols)
+	if err != nil {
+		return nil, err
 	}
 
 	// Transform the values into a rows object. This expands SELECT statements or
@@ -70,66 +61,281 @@ func (p *planner) Insert(n *parser.Insert) (planNode, error) {
 		return nil, err
 	}
 
-	primaryIndex := tableDesc.PrimaryIndex
-	primaryIndexKeyPrefix := structured.MakeIndexKeyPrefix(tableDesc.ID, primaryIndex.ID)
+	var conflict *conflictAction
+	if n.OnConflict != nil || n.Upsert {
+		if conflict, err = p.makeConflictAction(tableDesc, cols, n); err != nil {
+			return nil, err
+		}
+	}
 
-	b := client.Batch{}
+	rh, err := p.makeReturningHelper(tableDesc, n.Table.Table(), n.Returning)
+	if err != nil {
+		return nil, err
+	}
 
-	for rows.Next() {
-		values := rows.Values()
-		if len(values) != len(cols) {
-			return nil, fmt.Errorf("invalid values for columns: %d != %d", len(values), len(cols))
-		}
+	if err := p.insertRows(ih, rows, conflict, rh); err != nil {
+		return nil, err
+	}
+	// TODO(tamird/pmattis): return the number of affected rows
+	return rh.getResults(), nil
+}
+
+// insertHelper holds the state for inserting rows into a table. The values
+// of a row are those of the explicitly inserted columns followed by the
+// DEFAULT values of the other columns which have one and by the values
+// allocated for the other SERIAL columns.
+type insertHelper struct {
+	desc            *structured.TableDescriptor
+	cols            []structured.ColumnDescriptor
+	colIDtoRowIndex map[structured.ID]int
+	numExplicit     int
+	defaultExprs    []parser.Expr
+	serials         *serialAllocator
+	serialKeys      []proto.Key
+	checks          *checkHelper
+	fk              *fkHelper
+	evalCtx         parser.EvalContext
+}
 
-		primaryIndexKeySuffix, _, err := encodeIndexKey(primaryIndex.ColumnIDs, colIDtoRowIndex, values, nil)
+// makeInsertHelper returns the insertHelper for inserting values for the
+// columns cols into the table.
+func (p *planner) makeInsertHelper(desc *structured.TableDescriptor,
+	cols []structured.ColumnDescriptor) (*insertHelper, error) {
+	ih := &insertHelper{
+		desc:            desc,
+		cols:            cols,
+		colIDtoRowIndex: map[structured.ID]int{},
+		numExplicit:     len(cols),
+		serials:         p.serials,
+		fk:              makeFKHelper(p.txn, p.evalCtx),
+		evalCtx:         p.evalCtx,
+	}
+	// Construct a map from column ID to the index the value appears at within a
+	// row.
+	for i, c := range cols {
+		ih.colIDtoRowIndex[c.ID] = i
+	}
+
+	for _, col := range desc.Columns {
+		if _, ok := ih.colIDtoRowIndex[col.ID]; ok || col.DefaultExpr == nil {
+			continue
+		}
+		expr, err := parser.ParseExpr(*col.DefaultExpr)
 		if err != nil {
 			return nil, err
 		}
-		primaryIndexKey := bytes.Join([][]byte{primaryIndexKeyPrefix, primaryIndexKeySuffix}, nil)
+		ih.colIDtoRowIndex[col.ID] = len(ih.cols)
+		ih.cols = append(ih.cols, col)
+		ih.defaultExprs = append(ih.defaultExprs, expr)
+	}
+	for _, col := range desc.Columns {
+		if _, ok := ih.colIDtoRowIndex[col.ID]; ok || !col.Serial {
+			continue
+		}
+		ih.colIDtoRowIndex[col.ID] = len(ih.cols)
+		ih.cols = append(ih.cols, col)
+		ih.serialKeys = append(ih.serialKeys, structured.MakeSerialKey(desc.ID, col.ID))
+	}
+
+	// Verify we have at least the columns that are part of the primary key.
+	for i, id := range desc.PrimaryIndex.ColumnIDs {
+		if _, ok := ih.colIDtoRowIndex[id]; !ok {
+			return nil, fmt.Errorf("missing %q primary key column", desc.PrimaryIndex.ColumnNames[i])
+		}
+	}
 
-		// Write the secondary indexes.
-		secondaryIndexEntries, err := encodeSecondaryIndexes(tableDesc.ID, tableDesc.Indexes, colIDtoRowIndex, values, primaryIndexKeySuffix)
+	var err error
+	if ih.checks, err = makeCheckHelper(desc, p.evalCtx); err != nil {
+		return nil, err
+	}
+	return ih, nil
+}
+
+// makeRow returns the values of the row inserted for the values of the
+// explicitly inserted columns. The DEFAULT expressions are evaluated for each
+// row so that functions such as unique_rowid() return a new value each time.
+func (ih *insertHelper) makeRow(values parser.DTuple) (parser.DTuple, error) {
+	if len(values) != ih.numExplicit {
+		return nil, fmt.Errorf("invalid values for columns: %d != %d", len(values), ih.numExplicit)
+	}
+	if len(ih.cols) == ih.numExplicit {
+		return values, nil
+	}
+	row := make(parser.DTuple, 0, len(ih.cols))
+	row = append(row, values...)
+	for _, expr := range ih.defaultExprs {
+		d, err := parser.EvalExpr(ih.evalCtx, expr, valMap{})
 		if err != nil {
 			return nil, err
 		}
+		row = append(row, d)
+	}
+	for _, key := range ih.serialKeys {
+		v, err := ih.serials.allocate(key)
+		if err != nil {
+			return nil, err
+		}
+		row = append(row, parser.DInt(v))
+	}
+	return row, nil
+}
 
-		for _, secondaryIndexEntry := range secondaryIndexEntries {
-			if log.V(2) {
-				log.Infof("CPut %q -> %v", secondaryIndexEntry.key, secondaryIndexEntry.value)
-			}
-			b.CPut(secondaryIndexEntry.key, secondaryIndexEntry.value, nil)
+// insertBatchSize is the number of rows for which writes are accumulated in
+// a single batch by an INSERT.
+var insertBatchSize = 1000
+
+// insertRows inserts the rows of a plan into the table. The rows are streamed
+// from the plan and the writes are run every insertBatchSize rows. A plan
+// which reads the table itself would see the rows inserted by earlier
+// batches, so its rows are all retrieved before any are inserted. Each row is
+// verified to satisfy the constraints of the table before it is inserted.
+// The inserted rows are added to rh, which may be nil.
+func (p *planner) insertRows(ih *insertHelper, rows planNode, conflict *conflictAction, rh *returningHelper) error {
+	if planReadsTable(rows, ih.desc.ID) {
+		var err error
+		if rows, err = materializePlan(rows); err != nil {
+			return err
 		}
+	}
+	batchSize := insertBatchSize
+	if referencesTable(ih.desc, ih.desc.ID) {
+		// A row can refer to the rows inserted before it by the statement, which
+		// are only found once they have been written.
+		batchSize = 1
+	}
 
-		// Write the row.
-		for i, val := range values {
-			key := structured.MakeColumnKey(cols[i].ID, primaryIndexKey)
-			if log.V(2) {
-				log.Infof("CPut %q -> %v", key, val)
-			}
-			v, err := prepareVal(cols[i], val)
-			if err != nil {
-				return nil, err
+	b := client.Batch{}
+	var count int
+	for rows.Next() {
+		values, err := ih.makeRow(rows.Values())
+		if err != nil {
+			return err
+		}
+		if err := ih.checks.checkRow(ih.colIDtoRowIndex, values); err != nil {
+			return err
+		}
+		if conflict != nil {
+			err = p.insertOnConflict(&b, ih, values, conflict, rh)
+		} else if err = ih.insertRow(&b, values); err == nil {
+			err = rh.append(ih.colIDtoRowIndex, values)
+		}
+		if err != nil {
+			return convertBatchError(err)
+		}
+
+		if count++; count%batchSize == 0 {
+			if err := p.txn.Run(&b); err != nil {
+				return convertBatchError(err)
 			}
-			b.CPut(key, v, nil)
+			b = client.Batch{}
 		}
 	}
 	if err := rows.Err(); err != nil {
-		return nil, err
+		return err
+	}
+	if err := p.txn.Run(&b); err != nil {
+		return convertBatchError(err)
+	}
+	return ih.fk.verifyPending()
+}
+
+// insertRow adds the writes which insert the row into the table to the batch
+// after verifying the rows it refers to by foreign keys exist.
+func (ih *insertHelper) insertRow(b *client.Batch, values parser.DTuple) error {
+	if err := ih.fk.checkReferences(ih.desc, ih.colIDtoRowIndex, values, nil); err != nil {
+		return err
 	}
-	if err := p.db.Run(&b); err != nil {
-		if tErr, ok := err.(*proto.ConditionFailedError); ok {
-			return nil, fmt.Errorf("duplicate key value %q violates unique constraint %s", tErr.ActualValue.Bytes, "TODO(tamird)")
+	return insertRow(b, ih.desc, ih.cols, ih.colIDtoRowIndex, values)
+}
+
+// planReadsTable returns whether the plan scans the table with the given ID.
+//
+// TODO: A correlated subquery is planned each time it is evaluated
+// and its scans are not found.
+func planReadsTable(plan planNode, id structured.ID) bool {
+	switch t := plan.(type) {
+	case *scanNode:
+		if t.desc != nil && t.desc.ID == id {
+			return true
+		}
+	case *joinNode:
+		if t.lookupTable != nil && t.lookupTable.desc.ID == id {
+			return true
 		}
-		return nil, err
 	}
-	// TODO(tamird/pmattis): return the number of affected rows
-	return &valuesNode{}, nil
+	_, _, children := plan.ExplainPlan(false)
+	for _, child := range children {
+		if planReadsTable(child, id) {
+			return true
+		}
+	}
+	return false
+}
+
+// materializePlan retrieves all of the rows of a plan and returns a plan
+// which returns copies of them.
+func materializePlan(plan planNode) (planNode, error) {
+	v := &valuesNode{columns: plan.Columns()}
+	for plan.Next() {
+		v.rows = append(v.rows, append(parser.DTuple(nil), plan.Values()...))
+	}
+	return v, plan.Err()
+}
+
+// insertRow adds the writes which insert a row into the table to the batch.
+// The values are those of the columns cols, indexed by colIDtoRowIndex. The
+// writes fail if the primary key or the key of a unique index already exists.
+func insertRow(b *client.Batch, tableDesc *structured.TableDescriptor, cols []structured.ColumnDescriptor,
+	colIDtoRowIndex map[structured.ID]int, values parser.DTuple) error {
+	primaryIndex := tableDesc.PrimaryIndex
+	primaryIndexKeyPrefix := structured.MakeIndexKeyPrefix(tableDesc.ID, primaryIndex.ID)
+	primaryIndexKeySuffix, _, err := encodeIndexKey(primaryIndex.ColumnIDs, colIDtoRowIndex, values, nil)
+	if err != nil {
+		return err
+	}
+	primaryIndexKey := bytes.Join([][]byte{primaryIndexKeyPrefix, primaryIndexKeySuffix}, nil)
+
+	// Write the secondary indexes.
+	secondaryIndexEntries, err := encodeSecondaryIndexes(tableDesc.ID, tableDesc.Indexes, colIDtoRowIndex, values, primaryIndexKeySuffix)
+	if err != nil {
+		return err
+	}
+
+	for _, secondaryIndexEntry := range secondaryIndexEntries {
+		if log.V(2) {
+			log.Infof("CPut %q -> %v", secondaryIndexEntry.key, secondaryIndexEntry.value)
+		}
+		b.CPut(secondaryIndexEntry.key, secondaryIndexEntry.value, nil)
+	}
+
+	// Write the row.
+	for i, val := range values {
+		key := structured.MakeColumnKey(cols[i].ID, primaryIndexKey)
+		if log.V(2) {
+			log.Infof("CPut %q -> %v", key, val)
+		}
+		v, err := prepareVal(cols[i], val)
+		if err != nil {
+			return err
+		}
+		b.CPut(key, v, nil)
+	}
+	return nil
+}
+
+// convertBatchError converts the error returned by a batch of writes to a row
+// into the error reported to the user.
+func convertBatchError(err error) error {
+	if tErr, ok := err.(*proto.ConditionFailedError); ok {
+		return fmt.Errorf("duplicate key value %q violates unique constraint %s", tErr.ActualValue.Bytes, "TODO(tamird)")
+	}
+	return err
 }
 
 func (p *planner) processColumns(tableDesc *structured.TableDescriptor,
 	node parser.QualifiedNames) ([]structured.ColumnDescriptor, error) {
 	if node == nil {
-		return tableDesc.Columns, nil
+		return tableDesc.VisibleColumns(), nil
 	}
 
 	cols := make([]structured.ColumnDescriptor, len(node))
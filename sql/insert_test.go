@@ -0,0 +1,153 @@
+// Copyright 2015 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License. See the AUTHORS file
+// for names of contributors.
+
+package sql_test
+
+import (
+	"sync"
+	"testing"
+
+	csql "github.com/cockroachdb/cockroach/sql"
+	"github.com/cockroachdb/cockroach/util/leaktest"
+)
+
+func TestInsertSelectBatches(t *testing.T) {
+	defer leaktest.AfterTest(t)
+	s, sqlDB, _ := setup(t)
+	defer cleanup(s, sqlDB)
+
+	// Use small batches so that the rows are read and written in several
+	// batches.
+	defer csql.SetScanBatchSize(3)()
+	defer csql.SetInsertBatchSize(2)()
+
+	if _, err := sqlDB.Exec(`
+CREATE DATABASE t;
+CREATE TABLE t.kv (k INT PRIMARY KEY, v INT);
+INSERT INTO t.kv VALUES (1, 1), (2, 2), (3, 3), (4, 4), (5, 5), (6, 6), (7, 7);
+INSERT INTO t.kv SELECT k + 1, v FROM t.kv WHERE k = 7;
+CREATE TABLE t.copy AS SELECT k, v * 2 FROM t.kv;
+INSERT INTO t.kv SELECT k + 100, v FROM t.kv;
+`); err != nil {
+		t.Fatal(err)
+	}
+
+	// The rows inserted into t.kv by an INSERT which reads t.kv are not read by
+	// that INSERT.
+	for _, c := range []struct {
+		query    string
+		expected int
+	}{
+		{`SELECT COUNT(*) FROM t.kv`, 16},
+		{`SELECT COUNT(*) FROM t.copy`, 8},
+	} {
+		var count int
+		if err := sqlDB.QueryRow(c.query).Scan(&count); err != nil {
+			t.Fatal(err)
+		}
+		if count != c.expected {
+			t.Errorf("%s: expected %d, but got %d", c.query, c.expected, count)
+		}
+	}
+}
+
+func TestInsertRowIDNodeID(t *testing.T) {
+	defer leaktest.AfterTest(t)
+	s, sqlDB, _ := setup(t)
+	defer cleanup(s, sqlDB)
+
+	if _, err := sqlDB.Exec(`
+CREATE DATABASE t;
+CREATE TABLE t.v (v INT);
+INSERT INTO t.v VALUES (1), (2);
+`); err != nil {
+		t.Fatal(err)
+	}
+
+	// The low bits of the values of the hidden rowid column hold the ID of the
+	// node which generated them.
+	rows, err := sqlDB.Query(`SELECT rowid & 32767 FROM t.v`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer rows.Close()
+	nodeID := int64(s.Gossip().GetNodeID())
+	if nodeID == 0 {
+		t.Fatal("expected the node ID to be set")
+	}
+	var n int
+	for ; rows.Next(); n++ {
+		var id int64
+		if err := rows.Scan(&id); err != nil {
+			t.Fatal(err)
+		}
+		if id != nodeID {
+			t.Errorf("expected node ID %d, but found %d", nodeID, id)
+		}
+	}
+	if err := rows.Err(); err != nil {
+		t.Fatal(err)
+	}
+	if n != 2 {
+		t.Fatalf("expected 2 rows, but found %d", n)
+	}
+}
+
+func TestInsertSerialConcurrent(t *testing.T) {
+	defer leaktest.AfterTest(t)
+	// Use small blocks so that the concurrent inserts allocate several blocks.
+	defer csql.SetSerialBlockSize(2)()
+	s, sqlDB, _ := setup(t)
+	defer cleanup(s, sqlDB)
+
+	if _, err := sqlDB.Exec(`
+CREATE DATABASE t;
+CREATE TABLE t.s (id SERIAL PRIMARY KEY, n SERIAL, v INT);
+`); err != nil {
+		t.Fatal(err)
+	}
+
+	const workers, inserts = 5, 10
+	var wg sync.WaitGroup
+	errs := make(chan error, workers)
+	for i := 0; i < workers; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			for j := 0; j < inserts; j++ {
+				if _, err := sqlDB.Exec(`INSERT INTO t.s (v) VALUES ($1)`, i); err != nil {
+					errs <- err
+					return
+				}
+			}
+		}(i)
+	}
+	wg.Wait()
+	close(errs)
+	for err := range errs {
+		t.Fatal(err)
+	}
+
+	// Each value of the SERIAL columns is allocated once.
+	var count, ids, ns int
+	if err := sqlDB.QueryRow(`SELECT COUNT(*), COUNT(DISTINCT id), COUNT(DISTINCT n) FROM t.s`).Scan(
+		&count, &ids, &ns); err != nil {
+		t.Fatal(err)
+	}
+	if count != workers*inserts || ids != count || ns != count {
+		t.Fatalf("expected %d distinct values, but found %d rows with %d and %d distinct values",
+			workers*inserts, count, ids, ns)
+	}
+}
@@ -0,0 +1,420 @@
+// Copyright 2015 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License. See the AUTHORS file
+// for names of contributors.
+
+package sql
+
+import (
+	"fmt"
+
+	"github.com/cockroachdb/cockroach/sql/parser"
+	"github.com/cockroachdb/cockroach/structured"
+)
+
+type joinType int
+
+const (
+	joinTypeInner joinType = iota
+	joinTypeLeftOuter
+	joinTypeRightOuter
+)
+
+// A joinNode combines each row of its outer plan with the rows of its inner
+// plan which satisfy the join condition. The outer plan is the left side of
+// the join except for a RIGHT JOIN. For outer joins, an outer row without any
+// matching inner row is combined with NULL values.
+//
+// The joined rows of all of the joins in a FROM clause share a single row in
+// which each table occupies a fixed range of columns. Each join writes the
+// columns of its sides into the shared row, allowing expressions to refer to
+// the columns of the joined row through DReferences.
+//
+// By default the rows of the inner plan are retrieved once and the join
+// condition is evaluated for every pair of rows (a nested-loop join). If the
+// inner side is a table and the join condition constrains a leading column of
+// one of its indexes to a value of the column's type computed from the outer
+// row, the matching rows are instead looked up in the table for each outer
+// row (an index lookup join).
+type joinNode struct {
+	p          *planner
+	joinType   joinType
+	left       planNode
+	right      planNode
+	leftScope  *fromScope
+	rightScope *fromScope
+	scope      *fromScope
+	cond       parser.Expr // the join condition, nil for a cross join
+	columns    []string
+	row        parser.DTuple // the joined row shared by all of the joins
+	start      int           // the index of the first column of the join in the row
+	leftWidth  int
+	rightWidth int
+
+	// For an index lookup join, the inner table, the filter which selects the
+	// matching rows of the inner table and the expression computing the value
+	// of the indexed column.
+	lookupTable  *fromTable
+	lookupFilter parser.Expr
+	lookupKey    parser.Expr
+
+	initialized bool
+	innerRows   []parser.DTuple // the buffered inner rows of a nested-loop join
+	innerIndex  int
+	innerPlan   planNode // the inner rows of an index lookup join
+	active      bool     // iterating over the inner rows for the current outer row
+	matched     bool     // the current outer row has matched an inner row
+	err         error
+}
+
+func (n *joinNode) ExplainPlan(verbose bool) (name, description string, children []planNode) {
+	switch n.joinType {
+	case joinTypeInner:
+		description = "INNER"
+	case joinTypeLeftOuter:
+		description = "LEFT OUTER"
+	case joinTypeRightOuter:
+		description = "RIGHT OUTER"
+	}
+	if n.lookupTable != nil {
+		// The inner table is scanned separately for each outer row using the
+		// index selected for the lookup key.
+		description = fmt.Sprintf("%s; lookup: %s", description, n.lookupTable.desc.Name)
+		if verbose {
+			description = explainParts(description, explainExprs("key", n.lookupKey),
+				explainExprs("filter", n.lookupFilter))
+		}
+		return "join", description, []planNode{n.outer()}
+	}
+	if verbose {
+		description = explainParts(description, explainExprs("on", n.cond))
+	}
+	return "join", description, []planNode{n.left, n.right}
+}
+
+func (n *joinNode) Columns() []string {
+	return n.columns
+}
+
+func (n *joinNode) Values() parser.DTuple {
+	return n.row[n.start : n.start+n.leftWidth+n.rightWidth]
+}
+
+func (n *joinNode) Next() bool {
+	if n.err != nil {
+		return false
+	}
+	if !n.initialized {
+		n.initialized = true
+		if n.lookupTable == nil {
+			inner := n.inner()
+			for inner.Next() {
+				// The result from plan.Values() is only valid until the next call to
+				// plan.Next(), so make a copy.
+				values := inner.Values()
+				row := make(parser.DTuple, len(values))
+				copy(row, values)
+				n.innerRows = append(n.innerRows, row)
+			}
+			if n.err = inner.Err(); n.err != nil {
+				return false
+			}
+		}
+	}
+
+	for {
+		if n.active {
+			if n.nextInner() {
+				n.matched = true
+				return true
+			}
+			if n.err != nil {
+				return false
+			}
+			n.active = false
+			if !n.matched && n.joinType != joinTypeInner {
+				inner := n.innerColumns()
+				for i := range inner {
+					inner[i] = parser.DNull
+				}
+				return true
+			}
+		}
+
+		outer := n.outer()
+		if !outer.Next() {
+			n.err = outer.Err()
+			return false
+		}
+		copy(n.outerColumns(), outer.Values())
+		n.matched = false
+		if n.err = n.startInner(); n.err != nil {
+			return false
+		}
+		n.active = true
+	}
+}
+
+func (n *joinNode) Err() error {
+	return n.err
+}
+
+func (n *joinNode) outer() planNode {
+	if n.joinType == joinTypeRightOuter {
+		return n.right
+	}
+	return n.left
+}
+
+func (n *joinNode) inner() planNode {
+	if n.joinType == joinTypeRightOuter {
+		return n.left
+	}
+	return n.right
+}
+
+func (n *joinNode) leftColumns() parser.DTuple {
+	return n.row[n.start : n.start+n.leftWidth]
+}
+
+func (n *joinNode) rightColumns() parser.DTuple {
+	return n.row[n.start+n.leftWidth : n.start+n.leftWidth+n.rightWidth]
+}
+
+func (n *joinNode) outerColumns() parser.DTuple {
+	if n.joinType == joinTypeRightOuter {
+		return n.rightColumns()
+	}
+	return n.leftColumns()
+}
+
+func (n *joinNode) innerColumns() parser.DTuple {
+	if n.joinType == joinTypeRightOuter {
+		return n.leftColumns()
+	}
+	return n.rightColumns()
+}
+
+func (n *joinNode) innerScope() *fromScope {
+	if n.joinType == joinTypeRightOuter {
+		return n.leftScope
+	}
+	return n.rightScope
+}
+
+// startInner prepares the iteration over the inner rows for the current outer
+// row.
+func (n *joinNode) startInner() error {
+	if n.lookupTable == nil {
+		n.innerIndex = 0
+		return nil
+	}
+
+	n.innerPlan = nil
+	key, err := parser.EvalExpr(n.p.evalCtx, n.lookupKey, nil)
+	if err != nil {
+		return err
+	}
+	if key == parser.DNull {
+		// NULL is not equal to any value.
+		return nil
+	}
+	t := n.lookupTable
+	scan := &scanNode{
+		db:      n.p.txn,
+		desc:    t.desc,
+		index:   &t.desc.PrimaryIndex,
+		columns: t.scan.columns,
+		render:  t.scan.render,
+		filter:  n.lookupFilter,
+		evalCtx: n.p.evalCtx,
+	}
+	n.innerPlan, err = n.p.selectIndex(scan)
+	return err
+}
+
+// nextInner advances to the next inner row matching the current outer row.
+func (n *joinNode) nextInner() bool {
+	inner := n.innerColumns()
+	if n.lookupTable != nil {
+		if n.innerPlan == nil {
+			return false
+		}
+		if n.innerPlan.Next() {
+			copy(inner, n.innerPlan.Values())
+			return true
+		}
+		n.err = n.innerPlan.Err()
+		return false
+	}
+
+	for n.innerIndex < len(n.innerRows) {
+		copy(inner, n.innerRows[n.innerIndex])
+		n.innerIndex++
+		if n.cond == nil {
+			return true
+		}
+		d, err := parser.EvalExpr(n.p.evalCtx, n.cond, nil)
+		if err != nil {
+			n.err = err
+			return false
+		}
+		if d == parser.DNull {
+			continue
+		}
+		v, ok := d.(parser.DBool)
+		if !ok {
+			n.err = fmt.Errorf("JOIN condition did not evaluate to a boolean")
+			return false
+		}
+		if v {
+			return true
+		}
+	}
+	return false
+}
+
+// finalize resolves the column references of the join condition and chooses
+// between a nested-loop join and an index lookup join. It must be called
+// after the conjuncts of the WHERE clause have been pushed down to the scans
+// of the tables.
+func (n *joinNode) finalize() error {
+	var err error
+	if n.cond != nil {
+		if n.lookupTable, n.lookupKey, err = n.findLookup(); err != nil {
+			return err
+		}
+	}
+
+	if n.lookupTable != nil {
+		// The join condition is evaluated by the scan of the inner table with the
+		// references to the outer columns replaced by their current values.
+		t := n.lookupTable
+		v := nameResolver{scope: n.scope, row: n.row, local: t}
+		if n.lookupKey, err = v.resolve(n.lookupKey); err != nil {
+			return err
+		}
+		if n.cond, err = v.resolve(n.cond); err != nil {
+			return err
+		}
+		n.lookupFilter = mergeAnd(t.scan.filter, n.cond)
+	} else if n.cond != nil {
+		v := nameResolver{scope: n.scope, row: n.row}
+		if n.cond, err = v.resolve(n.cond); err != nil {
+			return err
+		}
+	}
+
+	// Select the indexes used to scan the tables on either side of the join.
+	// The inner table of an index lookup join is scanned separately for each
+	// outer row.
+	for _, side := range []*planNode{&n.left, &n.right} {
+		scan, ok := (*side).(*scanNode)
+		if !ok || (n.lookupTable != nil && scan == n.lookupTable.scan) {
+			continue
+		}
+		if *side, err = n.p.selectIndex(scan); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// findLookup determines whether the inner rows can be looked up using an
+// index of the inner table. It returns the inner table and the expression
+// computing the value of an indexed column from the outer row. The value must
+// have the type of the column: a value of another type can't be encoded in a
+// key of the index, and looking up the rows with it would scan the whole inner
+// table for every outer row.
+func (n *joinNode) findLookup() (*fromTable, parser.Expr, error) {
+	innerScope := n.innerScope()
+	if len(innerScope.tables) != 1 {
+		return nil, nil, nil
+	}
+	inner := innerScope.tables[0]
+	if n.inner() != inner.scan || inner.scan.source != nil {
+		// The inner side is not a scan of a table. Subqueries in the FROM
+		// clause have no indexes.
+		return nil, nil, nil
+	}
+
+	// The outer columns of the joined row hold samples of their types while
+	// the type of the lookup key is determined. They are overwritten by the
+	// values of the outer rows during execution.
+	types, err := planColumnTypes(n.outer())
+	if err != nil {
+		// The types of the outer columns are unknown.
+		return nil, nil, nil
+	}
+	copy(n.outerColumns(), types)
+
+	for _, conjunct := range splitAnd(n.cond, nil) {
+		c, ok := conjunct.(*parser.ComparisonExpr)
+		if !ok || c.Operator != parser.EQ {
+			continue
+		}
+		for _, sides := range [][2]parser.Expr{{c.Left, c.Right}, {c.Right, c.Left}} {
+			qname, ok := sides[0].(*parser.QualifiedName)
+			if !ok {
+				continue
+			}
+			t, col, _, err := n.scope.findColumn(qname)
+			if err != nil {
+				return nil, nil, err
+			}
+			if t != inner || !isLeadingIndexColumn(t.desc, col) {
+				continue
+			}
+			tables, err := n.scope.referencedTables(sides[1])
+			if err != nil {
+				return nil, nil, err
+			}
+			if len(tables) == 0 {
+				continue
+			}
+			outer := true
+			for t := range tables {
+				if t == inner {
+					outer = false
+				}
+			}
+			if !outer {
+				continue
+			}
+			v := nameResolver{scope: n.scope, row: n.row}
+			key, err := v.resolve(sides[1])
+			if err != nil {
+				return nil, nil, err
+			}
+			if sample, err := parser.TypeCheckExpr(n.p.evalCtx, key, nil); err == nil &&
+				datumMatchesColumnType(sample, col) {
+				return inner, key, nil
+			}
+		}
+	}
+	return nil, nil, nil
+}
+
+// isLeadingIndexColumn returns true if the column is the first column of one
+// of the indexes of the table.
+func isLeadingIndexColumn(desc *structured.TableDescriptor, col *structured.ColumnDescriptor) bool {
+	if desc.PrimaryIndex.ColumnIDs[0] == col.ID {
+		return true
+	}
+	for _, index := range desc.Indexes {
+		if !index.WriteOnly && index.ColumnIDs[0] == col.ID {
+			return true
+		}
+	}
+	return false
+}
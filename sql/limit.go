@@ -0,0 +1,117 @@
+// Copyright 2015 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License. See the AUTHORS file
+// for names of contributors.
+
+package sql
+
+import (
+	"fmt"
+	"math"
+
+	"github.com/cockroachdb/cockroach/sql/parser"
+)
+
+// limit constructs a limitNode for the LIMIT and OFFSET clauses of the
+// SELECT. The plan is returned unchanged if there is no LIMIT or OFFSET.
+func (p *planner) limit(limit *parser.Limit, plan planNode) (planNode, error) {
+	if limit == nil {
+		return plan, nil
+	}
+
+	var count, offset int64
+	data := []struct {
+		name       string
+		src        parser.Expr
+		dst        *int64
+		defaultVal int64
+	}{
+		{"LIMIT", limit.Count, &count, math.MaxInt64},
+		{"OFFSET", limit.Offset, &offset, 0},
+	}
+	for _, datum := range data {
+		if datum.src == nil {
+			*datum.dst = datum.defaultVal
+			continue
+		}
+		d, err := parser.EvalExpr(p.evalCtx, datum.src, nil)
+		if err != nil {
+			return nil, err
+		}
+		i, ok := d.(parser.DInt)
+		if !ok {
+			return nil, fmt.Errorf("argument of %s must be type %s, not type %s",
+				datum.name, parser.DInt(0).Type(), d.Type())
+		}
+		if i < 0 {
+			return nil, fmt.Errorf("argument of %s must not be negative", datum.name)
+		}
+		*datum.dst = int64(i)
+	}
+
+	// Only count+offset rows are needed from the underlying plan.
+	if count <= math.MaxInt64-offset {
+		setLimitHint(plan, count+offset)
+	}
+	return &limitNode{planNode: plan, count: count, offset: offset}, nil
+}
+
+// setLimitHint informs the scan underlying plan that at most numRows rows
+// will be consumed. The hint is only passed on if every row retrieved by the
+// scan is returned by the plan, that is, if there is no filter or sort in
+// between. An index join is only planned for a scan with a filter, which is
+// evaluated on the rows of the table, so it never receives the hint.
+func setLimitHint(plan planNode, numRows int64) {
+	switch t := plan.(type) {
+	case *scanNode:
+		if t.filter == nil {
+			t.maxRows = numRows
+		}
+	case *sortNode:
+		if !t.needSort {
+			setLimitHint(t.plan, numRows)
+		}
+	}
+}
+
+// A limitNode skips the first offset rows of its source plan and returns at
+// most count rows. It stops retrieving rows from the source plan as soon as
+// the limit is reached.
+type limitNode struct {
+	planNode
+	count    int64
+	offset   int64
+	rowIndex int64
+}
+
+func (n *limitNode) ExplainPlan(_ bool) (name, description string, children []planNode) {
+	count := "ALL"
+	if n.count != math.MaxInt64 {
+		count = fmt.Sprintf("%d", n.count)
+	}
+	return "limit", fmt.Sprintf("count: %s, offset: %d", count, n.offset), []planNode{n.planNode}
+}
+
+func (n *limitNode) Next() bool {
+	for n.rowIndex < n.offset {
+		if !n.planNode.Next() {
+			return false
+		}
+		n.rowIndex++
+	}
+	if n.rowIndex-n.offset >= n.count {
+		return false
+	}
+	n.rowIndex++
+	return n.planNode.Next()
+}
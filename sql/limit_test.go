@@ -0,0 +1,95 @@
+// Copyright 2015 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License. See the AUTHORS file
+// for names of contributors.
+
+package sql
+
+import (
+	"testing"
+
+	"github.com/cockroachdb/cockroach/util/leaktest"
+)
+
+func TestLimitHint(t *testing.T) {
+	defer leaktest.AfterTest(t)
+
+	desc := makeTestTableDesc(t, `a INT PRIMARY KEY, b INT, c INT, d INT,
+CONSTRAINT bc INDEX (b, c)`)
+
+	testData := []struct {
+		sql     string
+		maxRows int64
+	}{
+		{`SELECT a FROM test`, 0},
+		{`SELECT a FROM test LIMIT 10`, 10},
+		{`SELECT a FROM test LIMIT 10 OFFSET 5`, 15},
+		{`SELECT a FROM test OFFSET 5`, 0},
+		{`SELECT a FROM test LIMIT ALL`, 0},
+		{`SELECT a FROM test ORDER BY a LIMIT 10`, 10},
+		{`SELECT a FROM test ORDER BY b LIMIT 10`, 0},
+		// The filter is retained even when the spans of the scan imply it, so no
+		// limit is pushed down.
+		{`SELECT a FROM test WHERE a > 1 LIMIT 10`, 0},
+		{`SELECT a FROM test WHERE b = 1 ORDER BY c LIMIT 10`, 0},
+		// The filter of an index join is evaluated on the rows of the table.
+		{`SELECT d FROM test WHERE b = 1 LIMIT 10`, 0},
+	}
+	for _, d := range testData {
+		sel, s := makeTestScan(t, desc, d.sql)
+		p := &planner{}
+		sort, err := p.orderBy(sel, s)
+		if err != nil {
+			t.Fatalf("%s: %v", d.sql, err)
+		}
+		plan, err := p.selectIndex(s)
+		if err != nil {
+			t.Fatalf("%s: %v", d.sql, err)
+		}
+		if plan, err = sort.wrap(plan); err != nil {
+			t.Fatalf("%s: %v", d.sql, err)
+		}
+		if _, err := p.limit(sel.Limit, plan); err != nil {
+			t.Fatalf("%s: %v", d.sql, err)
+		}
+		if s.maxRows != d.maxRows {
+			t.Errorf("%s: expected maxRows=%d, but found %d", d.sql, d.maxRows, s.maxRows)
+		}
+	}
+}
+
+func TestScanBatchSize(t *testing.T) {
+	defer leaktest.AfterTest(t)
+
+	desc := makeTestTableDesc(t, `a INT PRIMARY KEY, b INT, c INT`)
+
+	testData := []struct {
+		maxRows          int64
+		isSecondaryIndex bool
+		expected         int64
+	}{
+		{0, false, scanBatchSize},
+		{1, false, 4},
+		{10, false, 31},
+		{1, true, 2},
+		{10, true, 11},
+		{scanBatchSize, false, scanBatchSize},
+		{scanBatchSize, true, scanBatchSize},
+	}
+	for _, d := range testData {
+		s := &scanNode{desc: desc, maxRows: d.maxRows, isSecondaryIndex: d.isSecondaryIndex}
+		if n := s.batchSize(); n != d.expected {
+			t.Errorf("%d %t: expected %d, but found %d", d.maxRows, d.isSecondaryIndex, d.expected, n)
+		}
+	}
+}
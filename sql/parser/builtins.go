@@ -18,76 +18,828 @@
 package parser
 
 import (
+	"errors"
 	"fmt"
+	"math"
+	"math/rand"
+	"reflect"
 	"strings"
+	"sync"
+	"time"
+	"unicode/utf8"
+
+	"github.com/cockroachdb/cockroach/proto"
+	"github.com/cockroachdb/cockroach/util/decimal"
+	"github.com/cockroachdb/cockroach/util/uuid"
 )
 
+var errAbsOfMinInt64 = errors.New("abs of min integer value (-9223372036854775808) not defined")
+var errSqrtOfNegNumber = errors.New("cannot take square root of a negative number")
+var errZeroToNegativePower = errors.New("zero raised to a negative power is undefined")
+var errNegativeToFractionalPower = errors.New("a negative number raised to a non-integer power yields a complex result")
+var errPowOutOfRange = errors.New("pow result is out of range")
+
+// A typeList describes the argument types accepted by an overload of a
+// builtin function.
+type typeList interface {
+	match(types []reflect.Type) bool
+}
+
+// argTypes is a typeList of a fixed number of arguments. A nil type matches
+// an argument of any type. A NULL argument matches any type.
+type argTypes []reflect.Type
+
+func (a argTypes) match(types []reflect.Type) bool {
+	if len(types) != len(a) {
+		return false
+	}
+	for i := range types {
+		if a[i] != nil && types[i] != nullType && types[i] != a[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// variadicType is a typeList of any number of arguments of the same type. A
+// nil type matches arguments of any type. A NULL argument matches any type.
+type variadicType struct {
+	typ reflect.Type
+}
+
+func (v variadicType) match(types []reflect.Type) bool {
+	for _, t := range types {
+		if v.typ != nil && t != nullType && t != v.typ {
+			return false
+		}
+	}
+	return true
+}
+
+// anyArg matches an argument of any type.
+var anyArg reflect.Type
+
+// builtin is a single overload of a builtin function. Unless nullableArgs is
+// set the function is never invoked with a NULL argument and the result is
+// NULL if any argument is NULL.
 type builtin struct {
-	nArgs int
-	fn    func(args DTuple) (Datum, error)
+	types        typeList
+	nullableArgs bool
+	fn           func(ctx EvalContext, args DTuple) (Datum, error)
 }
 
-// The map from function name to function data. Keep the list of functions
-// sorted please.
+// The map from function name to the overloads of the function. The first
+// overload whose argument types match the arguments is used. Keep the list of
+// functions sorted please.
 //
 // TODO(pmattis): Need to flesh out the supported functions. For example, these
-// are the math functions postgres supports:
+// are the math functions postgres supports which we're still missing:
 //
-//   - abs
 //   - acos
 //   - asin
 //   - atan
 //   - atan2
-//   - ceil/ceiling
 //   - cos
 //   - degrees
 //   - div
 //   - exp
-//   - floor
 //   - ln
 //   - log
-//   - mod
 //   - pi
-//   - pow/power
 //   - radians
-//   - round
 //   - sign
 //   - sin
-//   - sqrt
 //   - tan
 //   - trunc
 //
 // Need to figure out if there is a standard set of functions or if we can
 // customize this to our liking. Would be good to support type conversion
 // functions.
-var builtins = map[string]builtin{
-	"length": stringBuiltin(func(s string) (Datum, error) {
-		return DInt(len(s)), nil
-	}),
+var builtins = map[string][]builtin{
+	"abs": {
+		builtin{
+			types: argTypes{intType},
+			fn: func(_ EvalContext, args DTuple) (Datum, error) {
+				x := args[0].(DInt)
+				if x == math.MinInt64 {
+					return DNull, errAbsOfMinInt64
+				}
+				if x < 0 {
+					return -x, nil
+				}
+				return x, nil
+			},
+		},
+		floatBuiltin1(func(x float64) (Datum, error) {
+			return DFloat(math.Abs(x)), nil
+		}),
+		decimalBuiltin1(func(x decimal.Decimal) (Datum, error) {
+			if x.Sign() < 0 {
+				return DDecimal{x.Neg()}, nil
+			}
+			return DDecimal{x}, nil
+		}),
+	},
 
-	"lower": stringBuiltin(func(s string) (Datum, error) {
-		return DString(strings.ToLower(s)), nil
-	}),
+	"btrim": trimBuiltins(strings.Trim),
+
+	"ceil":    ceilBuiltins,
+	"ceiling": ceilBuiltins,
+
+	"coalesce": {
+		builtin{
+			types:        variadicType{anyArg},
+			nullableArgs: true,
+			fn: func(_ EvalContext, args DTuple) (Datum, error) {
+				if err := checkSameType(args); err != nil {
+					return DNull, err
+				}
+				for _, d := range args {
+					if d != DNull {
+						return d, nil
+					}
+				}
+				return DNull, nil
+			},
+		},
+	},
+
+	"concat": {
+		builtin{
+			types:        variadicType{anyArg},
+			nullableArgs: true,
+			fn: func(_ EvalContext, args DTuple) (Datum, error) {
+				var buf []byte
+				for _, d := range args {
+					switch t := d.(type) {
+					case dNull:
+					case DString:
+						buf = append(buf, t...)
+					case DBytes:
+						buf = append(buf, t...)
+					default:
+						buf = append(buf, d.String()...)
+					}
+				}
+				return DString(buf), nil
+			},
+		},
+	},
+
+	"date_trunc": {
+		builtin{
+			types: argTypes{stringType, timestampType},
+			fn: func(_ EvalContext, args DTuple) (Datum, error) {
+				return dateTrunc(string(args[0].(DString)), args[1].(DTimestamp).Time)
+			},
+		},
+		builtin{
+			types: argTypes{stringType, dateType},
+			fn: func(_ EvalContext, args DTuple) (Datum, error) {
+				return dateTrunc(string(args[0].(DString)), args[1].(DDate).Time)
+			},
+		},
+	},
+
+	"extract": {
+		builtin{
+			types: argTypes{stringType, timestampType},
+			fn: func(_ EvalContext, args DTuple) (Datum, error) {
+				return extractTimestamp(string(args[0].(DString)), args[1].(DTimestamp).Time)
+			},
+		},
+		builtin{
+			types: argTypes{stringType, dateType},
+			fn: func(_ EvalContext, args DTuple) (Datum, error) {
+				return extractTimestamp(string(args[0].(DString)), args[1].(DDate).Time)
+			},
+		},
+		builtin{
+			types: argTypes{stringType, timeType},
+			fn: func(_ EvalContext, args DTuple) (Datum, error) {
+				return extractTime(string(args[0].(DString)), args[1].(DTime).Duration)
+			},
+		},
+	},
+
+	"floor": {
+		builtin{
+			types: argTypes{intType},
+			fn: func(_ EvalContext, args DTuple) (Datum, error) {
+				return args[0], nil
+			},
+		},
+		floatBuiltin1(func(x float64) (Datum, error) {
+			return DFloat(math.Floor(x)), nil
+		}),
+		decimalBuiltin1(func(x decimal.Decimal) (Datum, error) {
+			r := x.Round(0)
+			if r.Cmp(x) > 0 {
+				r = r.Sub(decimal.New(1, 0))
+			}
+			return DDecimal{r}, nil
+		}),
+	},
+
+	"greatest": {
+		builtin{
+			types:        variadicType{anyArg},
+			nullableArgs: true,
+			fn: func(_ EvalContext, args DTuple) (Datum, error) {
+				return pickExtreme(args, true)
+			},
+		},
+	},
+
+	"least": {
+		builtin{
+			types:        variadicType{anyArg},
+			nullableArgs: true,
+			fn: func(_ EvalContext, args DTuple) (Datum, error) {
+				return pickExtreme(args, false)
+			},
+		},
+	},
+
+	"length": {
+		stringBuiltin1(func(s string) (Datum, error) {
+			return DInt(utf8.RuneCountInString(s)), nil
+		}),
+		builtin{
+			types: argTypes{bytesType},
+			fn: func(_ EvalContext, args DTuple) (Datum, error) {
+				return DInt(len(args[0].(DBytes))), nil
+			},
+		},
+	},
+
+	"lower": {
+		stringBuiltin1(func(s string) (Datum, error) {
+			return DString(strings.ToLower(s)), nil
+		}),
+	},
+
+	"ltrim": trimBuiltins(strings.TrimLeft),
+
+	"mod": modBuiltins(),
+
+	"now": {
+		builtin{
+			types: argTypes{},
+			fn: func(ctx EvalContext, args DTuple) (Datum, error) {
+				if ctx.StmtTimestamp.IsZero() {
+					return DTimestamp{time.Now().UTC()}, nil
+				}
+				return DTimestamp{ctx.StmtTimestamp.UTC()}, nil
+			},
+		},
+	},
+
+	"nullif": {
+		builtin{
+			types:        argTypes{anyArg, anyArg},
+			nullableArgs: true,
+			fn: func(_ EvalContext, args DTuple) (Datum, error) {
+				if args[0] == DNull || args[1] == DNull {
+					return args[0], nil
+				}
+				d, err := evalComparisonOp(EQ, args[0], args[1])
+				if err != nil {
+					return DNull, err
+				}
+				if d == DBool(true) {
+					return DNull, nil
+				}
+				return args[0], nil
+			},
+		},
+	},
+
+	"pow":   powBuiltins,
+	"power": powBuiltins,
+
+	"random": {
+		builtin{
+			types: argTypes{},
+			fn: func(_ EvalContext, args DTuple) (Datum, error) {
+				return DFloat(rand.Float64()), nil
+			},
+		},
+	},
+
+	"replace": {
+		builtin{
+			types: argTypes{stringType, stringType, stringType},
+			fn: func(_ EvalContext, args DTuple) (Datum, error) {
+				s, from, to := string(args[0].(DString)), string(args[1].(DString)), string(args[2].(DString))
+				if from == "" {
+					return args[0], nil
+				}
+				return DString(strings.Replace(s, from, to, -1)), nil
+			},
+		},
+	},
+
+	"round": {
+		builtin{
+			types: argTypes{intType},
+			fn: func(_ EvalContext, args DTuple) (Datum, error) {
+				return args[0], nil
+			},
+		},
+		floatBuiltin1(func(x float64) (Datum, error) {
+			// Round half away from zero.
+			if x < 0 {
+				return DFloat(-math.Floor(-x + 0.5)), nil
+			}
+			return DFloat(math.Floor(x + 0.5)), nil
+		}),
+		decimalBuiltin1(func(x decimal.Decimal) (Datum, error) {
+			return DDecimal{x.Round(0)}, nil
+		}),
+		builtin{
+			types: argTypes{decimalType, intType},
+			fn: func(_ EvalContext, args DTuple) (Datum, error) {
+				scale := args[1].(DInt)
+				if scale < -maxRoundScale || scale > maxRoundScale {
+					return DNull, fmt.Errorf("scale %d out of range", scale)
+				}
+				r := args[0].(DDecimal).Round(int32(scale))
+				if scale < 0 {
+					r = r.Round(0)
+				}
+				return DDecimal{r}, nil
+			},
+		},
+	},
+
+	"rtrim": trimBuiltins(strings.TrimRight),
+
+	"split_part": {
+		builtin{
+			types: argTypes{stringType, stringType, intType},
+			fn: func(_ EvalContext, args DTuple) (Datum, error) {
+				s, sep := string(args[0].(DString)), string(args[1].(DString))
+				field := args[2].(DInt)
+				if field <= 0 {
+					return DNull, fmt.Errorf("field position %d must be greater than zero", field)
+				}
+				var parts []string
+				if sep == "" {
+					parts = []string{s}
+				} else {
+					parts = strings.Split(s, sep)
+				}
+				if field > DInt(len(parts)) {
+					return DString(""), nil
+				}
+				return DString(parts[field-1]), nil
+			},
+		},
+	},
+
+	"sqrt": {
+		floatBuiltin1(sqrt),
+		builtin{
+			types: argTypes{intType},
+			fn: func(_ EvalContext, args DTuple) (Datum, error) {
+				return sqrt(float64(args[0].(DInt)))
+			},
+		},
+		decimalBuiltin1(func(x decimal.Decimal) (Datum, error) {
+			if x.Sign() < 0 {
+				return DNull, errSqrtOfNegNumber
+			}
+			scale := int32(decimalDivisionScale)
+			if x.Scale() > scale {
+				scale = x.Scale()
+			}
+			return DDecimal{x.Sqrt(scale)}, nil
+		}),
+	},
+
+	"strpos": {
+		builtin{
+			types: argTypes{stringType, stringType},
+			fn: func(_ EvalContext, args DTuple) (Datum, error) {
+				s, substr := string(args[0].(DString)), string(args[1].(DString))
+				i := strings.Index(s, substr)
+				if i < 0 {
+					return DInt(0), nil
+				}
+				return DInt(utf8.RuneCountInString(s[:i]) + 1), nil
+			},
+		},
+	},
+
+	"substr":    substrBuiltins,
+	"substring": substrBuiltins,
+
+	"unique_rowid": {
+		builtin{
+			types: argTypes{},
+			fn: func(ctx EvalContext, args DTuple) (Datum, error) {
+				v, err := generateUniqueInt(ctx.NodeID)
+				if err != nil {
+					return DNull, err
+				}
+				return DInt(v), nil
+			},
+		},
+	},
+
+	"upper": {
+		stringBuiltin1(func(s string) (Datum, error) {
+			return DString(strings.ToUpper(s)), nil
+		}),
+	},
+
+	"uuid_v4": {
+		builtin{
+			types: argTypes{},
+			fn: func(_ EvalContext, args DTuple) (Datum, error) {
+				return DBytes(uuid.NewUUID4()), nil
+			},
+		},
+	},
+}
+
+// maxRoundScale bounds the scale accepted by round(decimal, int).
+const maxRoundScale = 1000
 
-	"upper": stringBuiltin(func(s string) (Datum, error) {
-		return DString(strings.ToUpper(s)), nil
+var ceilBuiltins = []builtin{
+	{
+		types: argTypes{intType},
+		fn: func(_ EvalContext, args DTuple) (Datum, error) {
+			return args[0], nil
+		},
+	},
+	floatBuiltin1(func(x float64) (Datum, error) {
+		return DFloat(math.Ceil(x)), nil
+	}),
+	decimalBuiltin1(func(x decimal.Decimal) (Datum, error) {
+		r := x.Round(0)
+		if r.Cmp(x) < 0 {
+			r = r.Add(decimal.New(1, 0))
+		}
+		return DDecimal{r}, nil
 	}),
 }
 
-func argTypeError(arg Datum, expected string) error {
-	return fmt.Errorf("argument type mismatch: %s expected, but found %s",
-		expected, arg.Type())
+var powBuiltins = []builtin{
+	{
+		types: argTypes{floatType, floatType},
+		fn: func(_ EvalContext, args DTuple) (Datum, error) {
+			return DFloat(math.Pow(float64(args[0].(DFloat)), float64(args[1].(DFloat)))), nil
+		},
+	},
+	{
+		types: argTypes{intType, intType},
+		fn: func(_ EvalContext, args DTuple) (Datum, error) {
+			return DFloat(math.Pow(float64(args[0].(DInt)), float64(args[1].(DInt)))), nil
+		},
+	},
+	{
+		types: argTypes{decimalType, decimalType},
+		fn: func(_ EvalContext, args DTuple) (Datum, error) {
+			return decimalPow(args[0].(DDecimal).Decimal, args[1].(DDecimal).Decimal)
+		},
+	},
+	{
+		types: argTypes{decimalType, intType},
+		fn: func(_ EvalContext, args DTuple) (Datum, error) {
+			return decimalPow(args[0].(DDecimal).Decimal, decimal.New(int64(args[1].(DInt)), 0))
+		},
+	},
 }
 
-func stringBuiltin(f func(string) (Datum, error)) builtin {
-	return builtin{
-		nArgs: 1,
-		fn: func(args DTuple) (Datum, error) {
-			s, ok := args[0].(DString)
-			if !ok {
-				return DNull, argTypeError(args[0], "string")
+// decimalPow returns x^y. An integral exponent is computed exactly (or, if
+// negative, to the scale of a division); any other exponent is computed in
+// floating point.
+func decimalPow(x, y decimal.Decimal) (Datum, error) {
+	if x.Sign() == 0 && y.Sign() < 0 {
+		return DNull, errZeroToNegativePower
+	}
+	y = y.Reduce()
+	n, ok := y.Int64()
+	if y.Scale() > 0 || !ok {
+		if x.Sign() < 0 {
+			return DNull, errNegativeToFractionalPower
+		}
+		r, err := decimal.NewFromFloat(math.Pow(x.Float64(), y.Float64()))
+		if err != nil {
+			return DNull, errPowOutOfRange
+		}
+		return DDecimal{r}, nil
+	}
+	if n < -decimal.MaxScale || n > decimal.MaxScale {
+		return DNull, errPowOutOfRange
+	}
+	abs := n
+	if abs < 0 {
+		abs = -abs
+	}
+	// Bound the size of the result, which has up to abs times the digits of
+	// x and abs times its scale.
+	x = x.Reduce()
+	if int64(x.Precision())*abs > decimal.MaxScale || int64(x.Scale())*abs > decimal.MaxScale {
+		return DNull, errPowOutOfRange
+	}
+	r := x.Pow(abs)
+	if n < 0 {
+		scale := int32(decimalDivisionScale)
+		if r.Scale() > scale {
+			scale = r.Scale()
+		}
+		r = decimal.New(1, 0).Quo(r, scale)
+	}
+	return DDecimal{r}, nil
+}
+
+// substr(s, start[, count]) returns the characters of s beginning at the
+// 1-based position start. Positions outside of s are clamped, so
+// substr('hello', 0, 3) is 'he'.
+var substrBuiltins = []builtin{
+	{
+		types: argTypes{stringType, intType},
+		fn: func(_ EvalContext, args DTuple) (Datum, error) {
+			runes := []rune(string(args[0].(DString)))
+			start := int(args[1].(DInt))
+			if start > len(runes) {
+				return DString(""), nil
+			}
+			if start < 1 {
+				start = 1
+			}
+			return DString(runes[start-1:]), nil
+		},
+	},
+	{
+		types: argTypes{stringType, intType, intType},
+		fn: func(_ EvalContext, args DTuple) (Datum, error) {
+			runes := []rune(string(args[0].(DString)))
+			start, count := int64(args[1].(DInt)), int64(args[2].(DInt))
+			if count < 0 {
+				return DNull, fmt.Errorf("negative substring length %d not allowed", count)
 			}
-			return f(string(s))
+			// end is the 1-based position following the last character. The
+			// comparison is arranged to avoid overflowing start+count.
+			end := int64(len(runes)) + 1
+			if start < end-count {
+				end = start + count
+			}
+			if start < 1 {
+				start = 1
+			}
+			if end <= start {
+				return DString(""), nil
+			}
+			return DString(runes[start-1 : end-1]), nil
+		},
+	},
+}
+
+func modBuiltins() []builtin {
+	var b []builtin
+	for _, args := range [][2]reflect.Type{
+		{intType, intType},
+		{floatType, floatType},
+		{decimalType, decimalType},
+		{decimalType, intType},
+		{intType, decimalType},
+	} {
+		// The operation is looked up when invoked because some of the binOps
+		// are only registered by init.
+		op := binArgs{Mod, args[0], args[1]}
+		b = append(b, builtin{
+			types: argTypes{args[0], args[1]},
+			fn: func(_ EvalContext, args DTuple) (Datum, error) {
+				return binOps[op](args[0], args[1])
+			},
+		})
+	}
+	return b
+}
+
+// trimBuiltins returns the overloads of a trim function, removing spaces or
+// the characters of the optional second argument.
+func trimBuiltins(trim func(s, cutset string) string) []builtin {
+	return []builtin{
+		stringBuiltin1(func(s string) (Datum, error) {
+			return DString(trim(s, " ")), nil
+		}),
+		{
+			types: argTypes{stringType, stringType},
+			fn: func(_ EvalContext, args DTuple) (Datum, error) {
+				return DString(trim(string(args[0].(DString)), string(args[1].(DString)))), nil
+			},
+		},
+	}
+}
+
+// checkSameType returns an error if the non-NULL arguments are not all of the
+// same type.
+func checkSameType(args DTuple) error {
+	var first Datum
+	for _, d := range args {
+		if d == DNull {
+			continue
+		}
+		if first == nil {
+			first = d
+		} else if reflect.TypeOf(d) != reflect.TypeOf(first) {
+			return fmt.Errorf("incompatible argument types %s and %s", first.Type(), d.Type())
+		}
+	}
+	return nil
+}
+
+// pickExtreme returns the greatest (or least) of the non-NULL arguments, or
+// NULL if all of the arguments are NULL.
+func pickExtreme(args DTuple, greatest bool) (Datum, error) {
+	var res Datum = DNull
+	for _, d := range args {
+		if d == DNull {
+			continue
+		}
+		if res == DNull {
+			res = d
+			continue
+		}
+		left, right := res, d
+		if !greatest {
+			left, right = right, left
+		}
+		less, err := evalComparisonOp(LT, left, right)
+		if err != nil {
+			return DNull, err
+		}
+		if less == DBool(true) {
+			res = d
+		}
+	}
+	return res, nil
+}
+
+func sqrt(x float64) (Datum, error) {
+	if x < 0 {
+		return DNull, errSqrtOfNegNumber
+	}
+	return DFloat(math.Sqrt(x)), nil
+}
+
+// extractTimestamp returns the given field of a timestamp.
+func extractTimestamp(field string, t time.Time) (Datum, error) {
+	t = t.UTC()
+	switch strings.ToLower(field) {
+	case "year":
+		return DInt(t.Year()), nil
+	case "quarter":
+		return DInt((t.Month()-1)/3 + 1), nil
+	case "month":
+		return DInt(t.Month()), nil
+	case "week":
+		_, week := t.ISOWeek()
+		return DInt(week), nil
+	case "day":
+		return DInt(t.Day()), nil
+	case "dayofweek", "dow":
+		return DInt(t.Weekday()), nil
+	case "dayofyear", "doy":
+		return DInt(t.YearDay()), nil
+	case "epoch":
+		return DInt(t.Unix()), nil
+	}
+	hour, min, sec := t.Clock()
+	d := time.Duration(hour)*time.Hour + time.Duration(min)*time.Minute +
+		time.Duration(sec)*time.Second + time.Duration(t.Nanosecond())
+	return extractTime(field, d)
+}
+
+// extractTime returns the given field of a time of day.
+func extractTime(field string, d time.Duration) (Datum, error) {
+	switch strings.ToLower(field) {
+	case "hour":
+		return DInt(d / time.Hour), nil
+	case "minute":
+		return DInt(d % time.Hour / time.Minute), nil
+	case "second":
+		return DInt(d % time.Minute / time.Second), nil
+	case "millisecond":
+		return DInt(d % time.Minute / time.Millisecond), nil
+	case "microsecond":
+		return DInt(d % time.Minute / time.Microsecond), nil
+	case "epoch":
+		return DInt(d / time.Second), nil
+	}
+	return DNull, fmt.Errorf("unsupported extract field: %s", field)
+}
+
+// dateTrunc truncates a timestamp to the precision of the given field.
+func dateTrunc(field string, t time.Time) (Datum, error) {
+	t = t.UTC()
+	year, month, day := t.Date()
+	hour, min, sec := t.Clock()
+	nsec := t.Nanosecond()
+	switch strings.ToLower(field) {
+	case "year":
+		month, day, hour, min, sec, nsec = time.January, 1, 0, 0, 0, 0
+	case "quarter":
+		month = (month-1)/3*3 + 1
+		day, hour, min, sec, nsec = 1, 0, 0, 0, 0
+	case "month":
+		day, hour, min, sec, nsec = 1, 0, 0, 0, 0
+	case "week":
+		// Weeks begin on Monday.
+		day -= (int(t.Weekday()) + 6) % 7
+		hour, min, sec, nsec = 0, 0, 0, 0
+	case "day":
+		hour, min, sec, nsec = 0, 0, 0, 0
+	case "hour":
+		min, sec, nsec = 0, 0, 0
+	case "minute":
+		sec, nsec = 0, 0
+	case "second":
+		nsec = 0
+	case "millisecond":
+		nsec -= nsec % int(time.Millisecond)
+	case "microsecond":
+		nsec -= nsec % int(time.Microsecond)
+	default:
+		return DNull, fmt.Errorf("unsupported date_trunc field: %s", field)
+	}
+	return DTimestamp{time.Date(year, month, day, hour, min, sec, nsec, time.UTC)}, nil
+}
+
+// uniqueIntEpoch is the start of the timestamps of the integers returned by
+// generateUniqueInt, 2015-01-01 UTC in nanoseconds.
+const uniqueIntEpoch = 1420070400000000000
+
+const (
+	// uniqueIntPrecision is the precision of the timestamp portion of the
+	// integers returned by generateUniqueInt.
+	uniqueIntPrecision = 10 * time.Microsecond
+	// uniqueIntNodeIDBits is the number of low bits of the integers returned by
+	// generateUniqueInt which hold the node ID.
+	uniqueIntNodeIDBits = 15
+)
+
+var uniqueIntState struct {
+	sync.Mutex
+	timestamp int64
+}
+
+// generateUniqueInt returns an integer which is unique across the nodes of the
+// cluster. The high bits hold the time since uniqueIntEpoch in units of
+// uniqueIntPrecision and the low bits hold the node ID, so the integers
+// generated by the nodes are roughly ordered by time. The timestamp portion is
+// larger than the previously returned one, even if the wall time goes
+// backwards, so a node never returns the same integer twice while it runs.
+// Returns an error if the node ID does not fit in uniqueIntNodeIDBits.
+func generateUniqueInt(nodeID proto.NodeID) (int64, error) {
+	if nodeID < 0 || nodeID >= 1<<uniqueIntNodeIDBits {
+		return 0, fmt.Errorf("node ID %d does not fit in the %d bits of unique_rowid()",
+			nodeID, uniqueIntNodeIDBits)
+	}
+	now := time.Now().UnixNano()
+	if now < uniqueIntEpoch {
+		now = uniqueIntEpoch
+	}
+	ts := (now - uniqueIntEpoch) / int64(uniqueIntPrecision)
+
+	uniqueIntState.Lock()
+	if ts <= uniqueIntState.timestamp {
+		ts = uniqueIntState.timestamp + 1
+	}
+	uniqueIntState.timestamp = ts
+	uniqueIntState.Unlock()
+
+	return (ts << uniqueIntNodeIDBits) | int64(nodeID), nil
+}
+
+func stringBuiltin1(f func(string) (Datum, error)) builtin {
+	return builtin{
+		types: argTypes{stringType},
+		fn: func(_ EvalContext, args DTuple) (Datum, error) {
+			return f(string(args[0].(DString)))
+		},
+	}
+}
+
+func floatBuiltin1(f func(float64) (Datum, error)) builtin {
+	return builtin{
+		types: argTypes{floatType},
+		fn: func(_ EvalContext, args DTuple) (Datum, error) {
+			return f(float64(args[0].(DFloat)))
+		},
+	}
+}
+
+func decimalBuiltin1(f func(decimal.Decimal) (Datum, error)) builtin {
+	return builtin{
+		types: argTypes{decimalType},
+		fn: func(_ EvalContext, args DTuple) (Datum, error) {
+			return f(args[0].(DDecimal).Decimal)
 		},
 	}
 }
@@ -44,16 +44,18 @@ func (node *CreateDatabase) String() string {
 	return buf.String()
 }
 
-// TableDef represents a column or index definition within a CREATE TABLE
-// statement.
+// TableDef represents a column, index or check constraint definition within
+// a CREATE TABLE statement.
 type TableDef interface {
 	// Placeholder function to ensure that only desired types (*TableDef) conform
 	// to the TableDef interface.
 	tableDef()
 }
 
-func (*ColumnTableDef) tableDef() {}
-func (*IndexTableDef) tableDef()  {}
+func (*ColumnTableDef) tableDef()          {}
+func (*IndexTableDef) tableDef()           {}
+func (*CheckConstraintTableDef) tableDef() {}
+func (*ForeignKeyTableDef) tableDef()      {}
 
 // TableDefs represents a list of table definitions.
 type TableDefs []TableDef
@@ -82,11 +84,14 @@ const (
 // ColumnTableDef represents a column definition within a CREATE TABLE
 // statement.
 type ColumnTableDef struct {
-	Name       Name
-	Type       ColumnType
-	Nullable   Nullability
-	PrimaryKey bool
-	Unique     bool
+	Name        Name
+	Type        ColumnType
+	Nullable    Nullability
+	PrimaryKey  bool
+	Unique      bool
+	DefaultExpr Expr
+	CheckExprs  []Expr
+	References  *ReferencesConstraint
 }
 
 func newColumnTableDef(name Name, typ ColumnType,
@@ -97,7 +102,7 @@ func newColumnTableDef(name Name, typ ColumnType,
 		Nullable: SilentNull,
 	}
 	for _, c := range constraints {
-		switch c.(type) {
+		switch t := c.(type) {
 		case NotNullConstraint:
 			d.Nullable = NotNull
 		case NullConstraint:
@@ -106,6 +111,12 @@ func newColumnTableDef(name Name, typ ColumnType,
 			d.PrimaryKey = true
 		case UniqueConstraint:
 			d.Unique = true
+		case DefaultConstraint:
+			d.DefaultExpr = t.Expr
+		case CheckConstraint:
+			d.CheckExprs = append(d.CheckExprs, t.Expr)
+		case *ReferencesConstraint:
+			d.References = t
 		}
 	}
 	return d
@@ -120,11 +131,20 @@ func (node *ColumnTableDef) String() string {
 	case NotNull:
 		_, _ = buf.WriteString(" NOT NULL")
 	}
+	if node.DefaultExpr != nil {
+		fmt.Fprintf(&buf, " DEFAULT %s", node.DefaultExpr)
+	}
 	if node.PrimaryKey {
 		_, _ = buf.WriteString(" PRIMARY KEY")
 	} else if node.Unique {
 		_, _ = buf.WriteString(" UNIQUE")
 	}
+	for _, expr := range node.CheckExprs {
+		fmt.Fprintf(&buf, " CHECK (%s)", expr)
+	}
+	if node.References != nil {
+		fmt.Fprintf(&buf, " %s", node.References)
+	}
 	return buf.String()
 }
 
@@ -133,10 +153,13 @@ type ColumnConstraint interface {
 	columnConstraint()
 }
 
-func (NotNullConstraint) columnConstraint()    {}
-func (NullConstraint) columnConstraint()       {}
-func (PrimaryKeyConstraint) columnConstraint() {}
-func (UniqueConstraint) columnConstraint()     {}
+func (NotNullConstraint) columnConstraint()     {}
+func (NullConstraint) columnConstraint()        {}
+func (PrimaryKeyConstraint) columnConstraint()  {}
+func (UniqueConstraint) columnConstraint()      {}
+func (DefaultConstraint) columnConstraint()     {}
+func (CheckConstraint) columnConstraint()       {}
+func (*ReferencesConstraint) columnConstraint() {}
 
 // NotNullConstraint represents NOT NULL on a column.
 type NotNullConstraint struct{}
@@ -150,6 +173,75 @@ type PrimaryKeyConstraint struct{}
 // UniqueConstraint represents UNIQUE on a column.
 type UniqueConstraint struct{}
 
+// DefaultConstraint represents DEFAULT on a column.
+type DefaultConstraint struct {
+	Expr Expr
+}
+
+// CheckConstraint represents CHECK on a column.
+type CheckConstraint struct {
+	Expr Expr
+}
+
+// ReferencesConstraint represents REFERENCES on a column.
+type ReferencesConstraint struct {
+	Table   *QualifiedName
+	Columns NameList
+	Actions ReferenceActions
+}
+
+func (node *ReferencesConstraint) String() string {
+	var buf bytes.Buffer
+	fmt.Fprintf(&buf, "REFERENCES %s", node.Table)
+	if len(node.Columns) > 0 {
+		fmt.Fprintf(&buf, " (%s)", node.Columns)
+	}
+	fmt.Fprintf(&buf, "%s", node.Actions)
+	return buf.String()
+}
+
+// ReferenceAction is the action taken for the referencing rows of a foreign
+// key when the referenced row is deleted or updated.
+type ReferenceAction int
+
+// The values for ReferenceAction.
+const (
+	NoAction ReferenceAction = iota
+	Restrict
+	Cascade
+	SetNull
+	SetDefault
+)
+
+var referenceActionName = [...]string{
+	NoAction:   "NO ACTION",
+	Restrict:   "RESTRICT",
+	Cascade:    "CASCADE",
+	SetNull:    "SET NULL",
+	SetDefault: "SET DEFAULT",
+}
+
+func (a ReferenceAction) String() string {
+	return referenceActionName[a]
+}
+
+// ReferenceActions are the ON DELETE and ON UPDATE actions of a foreign key.
+type ReferenceActions struct {
+	Delete ReferenceAction
+	Update ReferenceAction
+}
+
+func (node ReferenceActions) String() string {
+	var buf bytes.Buffer
+	if node.Delete != NoAction {
+		fmt.Fprintf(&buf, " ON DELETE %s", node.Delete)
+	}
+	if node.Update != NoAction {
+		fmt.Fprintf(&buf, " ON UPDATE %s", node.Update)
+	}
+	return buf.String()
+}
+
 // IndexTableDef represents an index definition within a CREATE TABLE
 // statement.
 type IndexTableDef struct {
@@ -175,11 +267,81 @@ func (node *IndexTableDef) String() string {
 	return buf.String()
 }
 
+// CheckConstraintTableDef represents a check constraint within a CREATE
+// TABLE statement.
+type CheckConstraintTableDef struct {
+	Name Name
+	Expr Expr
+}
+
+func (node *CheckConstraintTableDef) String() string {
+	var buf bytes.Buffer
+	if node.Name != "" {
+		fmt.Fprintf(&buf, "CONSTRAINT %s ", node.Name)
+	}
+	fmt.Fprintf(&buf, "CHECK (%s)", node.Expr)
+	return buf.String()
+}
+
+// ForeignKeyTableDef represents a foreign key constraint within a CREATE
+// TABLE statement.
+type ForeignKeyTableDef struct {
+	Name     Name
+	FromCols NameList
+	Table    *QualifiedName
+	ToCols   NameList
+	Actions  ReferenceActions
+}
+
+func (node *ForeignKeyTableDef) String() string {
+	var buf bytes.Buffer
+	if node.Name != "" {
+		fmt.Fprintf(&buf, "CONSTRAINT %s ", node.Name)
+	}
+	fmt.Fprintf(&buf, "FOREIGN KEY (%s) REFERENCES %s", node.FromCols, node.Table)
+	if len(node.ToCols) > 0 {
+		fmt.Fprintf(&buf, " (%s)", node.ToCols)
+	}
+	fmt.Fprintf(&buf, "%s", node.Actions)
+	return buf.String()
+}
+
+// CreateIndex represents a CREATE INDEX statement.
+type CreateIndex struct {
+	Name        Name
+	Table       *QualifiedName
+	Unique      bool
+	IfNotExists bool
+	Columns     NameList
+}
+
+func (node *CreateIndex) String() string {
+	var buf bytes.Buffer
+	_, _ = buf.WriteString("CREATE ")
+	if node.Unique {
+		_, _ = buf.WriteString("UNIQUE ")
+	}
+	_, _ = buf.WriteString("INDEX ")
+	if node.IfNotExists {
+		_, _ = buf.WriteString("IF NOT EXISTS ")
+	}
+	if node.Name != "" {
+		fmt.Fprintf(&buf, "%s ", node.Name)
+	}
+	fmt.Fprintf(&buf, "ON %s (%s)", node.Table, node.Columns)
+	return buf.String()
+}
+
 // CreateTable represents a CREATE TABLE statement.
 type CreateTable struct {
 	IfNotExists bool
 	Table       *QualifiedName
 	Defs        TableDefs
+	// AsSource is the query of a CREATE TABLE ... AS statement, which defines
+	// the columns of the table and the rows it is populated with. The columns
+	// are optionally renamed by AsColumnNames.
+	AsColumnNames NameList
+	AsSource      SelectStatement
 }
 
 func (node *CreateTable) String() string {
@@ -188,6 +350,14 @@ func (node *CreateTable) String() string {
 	if node.IfNotExists {
 		_, _ = buf.WriteString(" IF NOT EXISTS")
 	}
+	if node.AsSource != nil {
+		fmt.Fprintf(&buf, " %s", node.Table)
+		if node.AsColumnNames != nil {
+			fmt.Fprintf(&buf, " (%s)", node.AsColumnNames)
+		}
+		fmt.Fprintf(&buf, " AS %s", node.AsSource)
+		return buf.String()
+	}
 	fmt.Fprintf(&buf, " %s (%s)", node.Table, node.Defs)
 	return buf.String()
 }
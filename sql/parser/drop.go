@@ -41,6 +41,22 @@ func (node *DropDatabase) String() string {
 	return buf.String()
 }
 
+// DropIndex represents a DROP INDEX statement.
+type DropIndex struct {
+	Names    QualifiedNames
+	IfExists bool
+}
+
+func (node *DropIndex) String() string {
+	var buf bytes.Buffer
+	_, _ = buf.WriteString("DROP INDEX ")
+	if node.IfExists {
+		_, _ = buf.WriteString("IF EXISTS ")
+	}
+	_, _ = buf.WriteString(node.Names.String())
+	return buf.String()
+}
+
 // DropTable represents a DROP TABLE statement.
 type DropTable struct {
 	Names    QualifiedNames
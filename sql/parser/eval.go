@@ -25,28 +25,43 @@ import (
 	"reflect"
 	"strconv"
 	"strings"
+	"time"
+
+	"github.com/cockroachdb/cockroach/proto"
+	"github.com/cockroachdb/cockroach/util/decimal"
 )
 
 var errZeroModulus = errors.New("zero modulus")
+var errDivByZero = errors.New("division by zero")
 
 // TODO(pmattis):
 //
-// - Support decimal arithmetic.
-//
 // - Allow partial expression evaluation to simplify expressions before being
 //   used in where clauses. Make Datum implement Expr and change EvalExpr to
 //   return an Expr.
 
-// A Datum holds either a bool, int64, float64, string or []Datum.
+// A Datum holds either a bool, int64, float64, string, date, time,
+// timestamp, interval or []Datum.
 type Datum interface {
 	Expr
 	Type() string
+	// Compare returns -1 if the receiver is less than other, 0 if receiver is
+	// equal to other and +1 if receiver is greater than other. NULL is less
+	// than every other value. Values of types which are not comparable are
+	// ordered by type name.
+	Compare(other Datum) int
 }
 
 var _ Datum = DBool(false)
 var _ Datum = DInt(0)
 var _ Datum = DFloat(0)
+var _ Datum = DDecimal{}
 var _ Datum = DString("")
+var _ Datum = DBytes("")
+var _ Datum = DDate{}
+var _ Datum = DTime{}
+var _ Datum = DTimestamp{}
+var _ Datum = DInterval{}
 var _ Datum = DTuple{}
 var _ Datum = dNull{}
 
@@ -65,6 +80,21 @@ func (d DBool) Type() string {
 	return "bool"
 }
 
+// Compare implements the Datum interface.
+func (d DBool) Compare(other Datum) int {
+	v, ok := other.(DBool)
+	if !ok {
+		return compareTypes(d, other)
+	}
+	if !d && v {
+		return -1
+	}
+	if d && !v {
+		return 1
+	}
+	return 0
+}
+
 func (d DBool) String() string {
 	return BoolVal(d).String()
 }
@@ -77,6 +107,25 @@ func (d DInt) Type() string {
 	return "int"
 }
 
+// Compare implements the Datum interface.
+func (d DInt) Compare(other Datum) int {
+	switch v := other.(type) {
+	case DInt:
+		if d < v {
+			return -1
+		}
+		if d > v {
+			return 1
+		}
+		return 0
+	case DFloat:
+		return DFloat(d).Compare(v)
+	case DDecimal:
+		return -v.Compare(d)
+	}
+	return compareTypes(d, other)
+}
+
 func (d DInt) String() string {
 	return strconv.FormatInt(int64(d), 10)
 }
@@ -89,10 +138,80 @@ func (d DFloat) Type() string {
 	return "float"
 }
 
+// Compare implements the Datum interface.
+func (d DFloat) Compare(other Datum) int {
+	var v DFloat
+	switch t := other.(type) {
+	case DFloat:
+		v = t
+	case DInt:
+		v = DFloat(t)
+	case DDecimal:
+		v = DFloat(t.Float64())
+	default:
+		return compareTypes(d, other)
+	}
+	if d < v {
+		return -1
+	}
+	if d > v {
+		return 1
+	}
+	return 0
+}
+
 func (d DFloat) String() string {
 	return strconv.FormatFloat(float64(d), 'g', -1, 64)
 }
 
+// DDecimal is the decimal Datum. It holds an exact decimal number.
+type DDecimal struct {
+	decimal.Decimal
+}
+
+// Type implements the Datum interface.
+func (d DDecimal) Type() string {
+	return "decimal"
+}
+
+// Compare implements the Datum interface.
+func (d DDecimal) Compare(other Datum) int {
+	switch t := other.(type) {
+	case DDecimal:
+		return d.Cmp(t.Decimal)
+	case DInt:
+		return d.Cmp(decimal.New(int64(t), 0))
+	case DFloat:
+		return DFloat(d.Float64()).Compare(t)
+	}
+	return compareTypes(d, other)
+}
+
+// decimalDivisionScale is the minimum scale of the quotient of decimals.
+const decimalDivisionScale = 16
+
+// toDecimal returns the decimal value of an int or decimal datum.
+func toDecimal(d Datum) decimal.Decimal {
+	if i, ok := d.(DInt); ok {
+		return decimal.New(int64(i), 0)
+	}
+	return d.(DDecimal).Decimal
+}
+
+// LimitDecimal rounds the decimal to the scale of a DECIMAL(precision, scale)
+// type, returning an error if the rounded value has more than precision
+// digits. A precision of 0 does not limit the decimal.
+func LimitDecimal(d DDecimal, precision, scale int) (Datum, error) {
+	if precision == 0 {
+		return d, nil
+	}
+	r := DDecimal{d.Round(int32(scale))}
+	if r.Precision() > precision {
+		return DNull, fmt.Errorf("value %s overflows DECIMAL(%d,%d)", d, precision, scale)
+	}
+	return r, nil
+}
+
 // DString is the string Datum.
 type DString string
 
@@ -101,10 +220,286 @@ func (d DString) Type() string {
 	return "string"
 }
 
+// Compare implements the Datum interface.
+func (d DString) Compare(other Datum) int {
+	v, ok := other.(DString)
+	if !ok {
+		return compareTypes(d, other)
+	}
+	if d < v {
+		return -1
+	}
+	if d > v {
+		return 1
+	}
+	return 0
+}
+
 func (d DString) String() string {
 	return StrVal(d).String()
 }
 
+// DBytes is the bytes Datum. The underlying type is a string because we want
+// the immutability, but this may contain arbitrary bytes.
+type DBytes string
+
+// Type implements the Datum interface.
+func (d DBytes) Type() string {
+	return "bytes"
+}
+
+// Compare implements the Datum interface.
+func (d DBytes) Compare(other Datum) int {
+	v, ok := other.(DBytes)
+	if !ok {
+		return compareTypes(d, other)
+	}
+	if d < v {
+		return -1
+	}
+	if d > v {
+		return 1
+	}
+	return 0
+}
+
+func (d DBytes) String() string {
+	return BytesVal(d).String()
+}
+
+const (
+	dateFormat      = "2006-01-02"
+	timeFormat      = "15:04:05.999999999"
+	timestampFormat = "2006-01-02 15:04:05.999999999"
+)
+
+// The formats in which the values of dates and timestamps are accepted. When
+// parsing, a fractional second may follow the seconds of a format.
+var timestampFormats = []string{
+	"2006-01-02 15:04:05Z07:00",
+	"2006-01-02T15:04:05Z07:00",
+	"2006-01-02 15:04:05-07",
+	"2006-01-02 15:04:05",
+	"2006-01-02T15:04:05",
+	"2006-01-02 15:04",
+	dateFormat,
+}
+
+// The formats in which the values of times are accepted.
+var timeFormats = []string{
+	"15:04:05",
+	"15:04",
+}
+
+// DDate is the date Datum. The time of a date is midnight UTC.
+type DDate struct {
+	time.Time
+}
+
+// MakeDDate returns the date of the time in UTC.
+func MakeDDate(t time.Time) DDate {
+	year, month, day := t.UTC().Date()
+	return DDate{time.Date(year, month, day, 0, 0, 0, 0, time.UTC)}
+}
+
+// Type implements the Datum interface.
+func (d DDate) Type() string {
+	return "date"
+}
+
+// Compare implements the Datum interface.
+func (d DDate) Compare(other Datum) int {
+	switch v := other.(type) {
+	case DDate:
+		return compareTimes(d.Time, v.Time)
+	case DTimestamp:
+		return compareTimes(d.Time, v.Time)
+	}
+	return compareTypes(d, other)
+}
+
+func (d DDate) String() string {
+	return d.Format(dateFormat)
+}
+
+// DTime is the time Datum. It holds the time elapsed since midnight.
+type DTime struct {
+	time.Duration
+}
+
+// MakeDTime returns the time of day of the time in UTC.
+func MakeDTime(t time.Time) DTime {
+	hour, min, sec := t.UTC().Clock()
+	return DTime{time.Duration(hour)*time.Hour + time.Duration(min)*time.Minute +
+		time.Duration(sec)*time.Second + time.Duration(t.Nanosecond())}
+}
+
+// Type implements the Datum interface.
+func (d DTime) Type() string {
+	return "time"
+}
+
+// Compare implements the Datum interface.
+func (d DTime) Compare(other Datum) int {
+	v, ok := other.(DTime)
+	if !ok {
+		return compareTypes(d, other)
+	}
+	return compareDurations(d.Duration, v.Duration)
+}
+
+func (d DTime) String() string {
+	return time.Time{}.Add(d.Duration).Format(timeFormat)
+}
+
+// DTimestamp is the timestamp Datum. The time of a timestamp is in UTC.
+type DTimestamp struct {
+	time.Time
+}
+
+// Type implements the Datum interface.
+func (d DTimestamp) Type() string {
+	return "timestamp"
+}
+
+// Compare implements the Datum interface.
+func (d DTimestamp) Compare(other Datum) int {
+	switch v := other.(type) {
+	case DTimestamp:
+		return compareTimes(d.Time, v.Time)
+	case DDate:
+		return compareTimes(d.Time, v.Time)
+	}
+	return compareTypes(d, other)
+}
+
+func (d DTimestamp) String() string {
+	return d.UTC().Format(timestampFormat)
+}
+
+// DInterval is the interval Datum.
+type DInterval struct {
+	time.Duration
+}
+
+// Type implements the Datum interface.
+func (d DInterval) Type() string {
+	return "interval"
+}
+
+// Compare implements the Datum interface.
+func (d DInterval) Compare(other Datum) int {
+	v, ok := other.(DInterval)
+	if !ok {
+		return compareTypes(d, other)
+	}
+	return compareDurations(d.Duration, v.Duration)
+}
+
+func (d DInterval) String() string {
+	return d.Duration.String()
+}
+
+func compareTimes(a, b time.Time) int {
+	if a.Before(b) {
+		return -1
+	}
+	if a.After(b) {
+		return 1
+	}
+	return 0
+}
+
+func compareDurations(a, b time.Duration) int {
+	if a < b {
+		return -1
+	}
+	if a > b {
+		return 1
+	}
+	return 0
+}
+
+// parseTimestamp parses the value of a date or timestamp. A value without a
+// time zone is in UTC.
+func parseTimestamp(s, typ string) (time.Time, error) {
+	s = strings.TrimSpace(s)
+	for _, format := range timestampFormats {
+		if t, err := time.ParseInLocation(format, s, time.UTC); err == nil {
+			return t.UTC(), nil
+		}
+	}
+	return time.Time{}, fmt.Errorf("could not parse %q as type %s", s, typ)
+}
+
+// parseTime parses the value of a time, which can also be given as a
+// timestamp.
+func parseTime(s string) (DTime, error) {
+	for _, format := range timeFormats {
+		if t, err := time.ParseInLocation(format, strings.TrimSpace(s), time.UTC); err == nil {
+			return MakeDTime(t), nil
+		}
+	}
+	t, err := parseTimestamp(s, "time")
+	if err != nil {
+		return DTime{}, err
+	}
+	return MakeDTime(t), nil
+}
+
+// The units of the quantities of an interval. Months and years are not
+// supported as their length varies.
+var intervalUnits = map[string]time.Duration{
+	"microsecond": time.Microsecond,
+	"millisecond": time.Millisecond,
+	"second":      time.Second,
+	"minute":      time.Minute,
+	"hour":        time.Hour,
+	"day":         24 * time.Hour,
+	"week":        7 * 24 * time.Hour,
+}
+
+// parseInterval parses the value of an interval, either in the format of
+// time.ParseDuration, such as "1h30m", or as a sequence of quantities and
+// units which may be followed by a time, such as "1 day 2 hours" or
+// "1 day 02:30:00".
+func parseInterval(s string) (DInterval, error) {
+	if d, err := time.ParseDuration(s); err == nil {
+		return DInterval{d}, nil
+	}
+	err := fmt.Errorf("could not parse %q as type interval", s)
+	fields := strings.Fields(strings.ToLower(s))
+	if len(fields) == 0 {
+		return DInterval{}, err
+	}
+	var d time.Duration
+	for i := 0; i < len(fields); i++ {
+		if strings.Contains(fields[i], ":") {
+			neg := strings.HasPrefix(fields[i], "-")
+			t, tErr := parseTime(strings.TrimPrefix(fields[i], "-"))
+			if tErr != nil {
+				return DInterval{}, err
+			}
+			if neg {
+				t.Duration = -t.Duration
+			}
+			d += t.Duration
+			continue
+		}
+		n, nErr := strconv.ParseFloat(fields[i], 64)
+		if nErr != nil || i+1 == len(fields) {
+			return DInterval{}, err
+		}
+		i++
+		unit, ok := intervalUnits[strings.TrimSuffix(fields[i], "s")]
+		if !ok {
+			return DInterval{}, err
+		}
+		d += time.Duration(n * float64(unit))
+	}
+	return DInterval{d}, nil
+}
+
 // DTuple is the tuple Datum.
 type DTuple []Datum
 
@@ -113,6 +508,30 @@ func (d DTuple) Type() string {
 	return "tuple"
 }
 
+// Compare implements the Datum interface.
+func (d DTuple) Compare(other Datum) int {
+	v, ok := other.(DTuple)
+	if !ok {
+		return compareTypes(d, other)
+	}
+	n := len(d)
+	if n > len(v) {
+		n = len(v)
+	}
+	for i := 0; i < n; i++ {
+		if c := d[i].Compare(v[i]); c != 0 {
+			return c
+		}
+	}
+	if len(d) < len(v) {
+		return -1
+	}
+	if len(d) > len(v) {
+		return 1
+	}
+	return 0
+}
+
 func (d DTuple) String() string {
 	var buf bytes.Buffer
 	_ = buf.WriteByte('(')
@@ -136,17 +555,46 @@ func (d dNull) Type() string {
 	return "NULL"
 }
 
+// Compare implements the Datum interface.
+func (d dNull) Compare(other Datum) int {
+	if other == DNull {
+		return 0
+	}
+	return -1
+}
+
 func (d dNull) String() string {
 	return "NULL"
 }
 
+// compareTypes orders datums of types which are not comparable with each
+// other. NULL is less than every other value.
+func compareTypes(d, other Datum) int {
+	if other == DNull {
+		return 1
+	}
+	if d.Type() < other.Type() {
+		return -1
+	}
+	if d.Type() > other.Type() {
+		return 1
+	}
+	return 0
+}
+
 var (
-	boolType   = reflect.TypeOf(DBool(false))
-	intType    = reflect.TypeOf(DInt(0))
-	floatType  = reflect.TypeOf(DFloat(0))
-	stringType = reflect.TypeOf(DString(""))
-	tupleType  = reflect.TypeOf(DTuple{})
-	nullType   = reflect.TypeOf(DNull)
+	boolType      = reflect.TypeOf(DBool(false))
+	intType       = reflect.TypeOf(DInt(0))
+	floatType     = reflect.TypeOf(DFloat(0))
+	decimalType   = reflect.TypeOf(DDecimal{})
+	stringType    = reflect.TypeOf(DString(""))
+	bytesType     = reflect.TypeOf(DBytes(""))
+	dateType      = reflect.TypeOf(DDate{})
+	timeType      = reflect.TypeOf(DTime{})
+	timestampType = reflect.TypeOf(DTimestamp{})
+	intervalType  = reflect.TypeOf(DInterval{})
+	tupleType     = reflect.TypeOf(DTuple{})
+	nullType      = reflect.TypeOf(DNull)
 )
 
 type unaryArgs struct {
@@ -163,6 +611,9 @@ var unaryOps = map[unaryArgs]func(Datum) (Datum, error){
 	unaryArgs{UnaryPlus, floatType}: func(d Datum) (Datum, error) {
 		return d, nil
 	},
+	unaryArgs{UnaryPlus, decimalType}: func(d Datum) (Datum, error) {
+		return d, nil
+	},
 
 	unaryArgs{UnaryMinus, intType}: func(d Datum) (Datum, error) {
 		return -d.(DInt), nil
@@ -170,6 +621,12 @@ var unaryOps = map[unaryArgs]func(Datum) (Datum, error){
 	unaryArgs{UnaryMinus, floatType}: func(d Datum) (Datum, error) {
 		return -d.(DFloat), nil
 	},
+	unaryArgs{UnaryMinus, decimalType}: func(d Datum) (Datum, error) {
+		return DDecimal{d.(DDecimal).Neg()}, nil
+	},
+	unaryArgs{UnaryMinus, intervalType}: func(d Datum) (Datum, error) {
+		return DInterval{-d.(DInterval).Duration}, nil
+	},
 
 	unaryArgs{UnaryComplement, intType}: func(d Datum) (Datum, error) {
 		return ^d.(DInt), nil
@@ -241,9 +698,100 @@ var binOps = map[binArgs]func(Datum, Datum) (Datum, error){
 		return DFloat(math.Mod(float64(left.(DFloat)), float64(right.(DFloat)))), nil
 	},
 
+	binArgs{Plus, dateType, intType}: func(left Datum, right Datum) (Datum, error) {
+		return DDate{left.(DDate).AddDate(0, 0, int(right.(DInt)))}, nil
+	},
+	binArgs{Plus, intType, dateType}: func(left Datum, right Datum) (Datum, error) {
+		return DDate{right.(DDate).AddDate(0, 0, int(left.(DInt)))}, nil
+	},
+	binArgs{Plus, dateType, intervalType}: func(left Datum, right Datum) (Datum, error) {
+		return DTimestamp{left.(DDate).Add(right.(DInterval).Duration)}, nil
+	},
+	binArgs{Plus, intervalType, dateType}: func(left Datum, right Datum) (Datum, error) {
+		return DTimestamp{right.(DDate).Add(left.(DInterval).Duration)}, nil
+	},
+	binArgs{Plus, dateType, timeType}: func(left Datum, right Datum) (Datum, error) {
+		return DTimestamp{left.(DDate).Add(right.(DTime).Duration)}, nil
+	},
+	binArgs{Plus, timeType, dateType}: func(left Datum, right Datum) (Datum, error) {
+		return DTimestamp{right.(DDate).Add(left.(DTime).Duration)}, nil
+	},
+	binArgs{Plus, timeType, intervalType}: func(left Datum, right Datum) (Datum, error) {
+		return makeTimeOfDay(left.(DTime).Duration + right.(DInterval).Duration), nil
+	},
+	binArgs{Plus, intervalType, timeType}: func(left Datum, right Datum) (Datum, error) {
+		return makeTimeOfDay(right.(DTime).Duration + left.(DInterval).Duration), nil
+	},
+	binArgs{Plus, timestampType, intervalType}: func(left Datum, right Datum) (Datum, error) {
+		return DTimestamp{left.(DTimestamp).Add(right.(DInterval).Duration)}, nil
+	},
+	binArgs{Plus, intervalType, timestampType}: func(left Datum, right Datum) (Datum, error) {
+		return DTimestamp{right.(DTimestamp).Add(left.(DInterval).Duration)}, nil
+	},
+	binArgs{Plus, intervalType, intervalType}: func(left Datum, right Datum) (Datum, error) {
+		return DInterval{left.(DInterval).Duration + right.(DInterval).Duration}, nil
+	},
+
+	binArgs{Minus, dateType, intType}: func(left Datum, right Datum) (Datum, error) {
+		return DDate{left.(DDate).AddDate(0, 0, -int(right.(DInt)))}, nil
+	},
+	binArgs{Minus, dateType, dateType}: func(left Datum, right Datum) (Datum, error) {
+		// The difference of two dates is the number of days between them.
+		return DInt(left.(DDate).Sub(right.(DDate).Time) / (24 * time.Hour)), nil
+	},
+	binArgs{Minus, dateType, intervalType}: func(left Datum, right Datum) (Datum, error) {
+		return DTimestamp{left.(DDate).Add(-right.(DInterval).Duration)}, nil
+	},
+	binArgs{Minus, timeType, intervalType}: func(left Datum, right Datum) (Datum, error) {
+		return makeTimeOfDay(left.(DTime).Duration - right.(DInterval).Duration), nil
+	},
+	binArgs{Minus, timeType, timeType}: func(left Datum, right Datum) (Datum, error) {
+		return DInterval{left.(DTime).Duration - right.(DTime).Duration}, nil
+	},
+	binArgs{Minus, timestampType, intervalType}: func(left Datum, right Datum) (Datum, error) {
+		return DTimestamp{left.(DTimestamp).Add(-right.(DInterval).Duration)}, nil
+	},
+	binArgs{Minus, timestampType, timestampType}: func(left Datum, right Datum) (Datum, error) {
+		return DInterval{left.(DTimestamp).Sub(right.(DTimestamp).Time)}, nil
+	},
+	binArgs{Minus, intervalType, intervalType}: func(left Datum, right Datum) (Datum, error) {
+		return DInterval{left.(DInterval).Duration - right.(DInterval).Duration}, nil
+	},
+
+	binArgs{Mult, intervalType, intType}: func(left Datum, right Datum) (Datum, error) {
+		return DInterval{left.(DInterval).Duration * time.Duration(right.(DInt))}, nil
+	},
+	binArgs{Mult, intType, intervalType}: func(left Datum, right Datum) (Datum, error) {
+		return DInterval{time.Duration(left.(DInt)) * right.(DInterval).Duration}, nil
+	},
+	binArgs{Mult, intervalType, floatType}: func(left Datum, right Datum) (Datum, error) {
+		return DInterval{time.Duration(float64(left.(DInterval).Duration) * float64(right.(DFloat)))}, nil
+	},
+	binArgs{Mult, floatType, intervalType}: func(left Datum, right Datum) (Datum, error) {
+		return DInterval{time.Duration(float64(left.(DFloat)) * float64(right.(DInterval).Duration))}, nil
+	},
+
+	binArgs{Div, intervalType, intType}: func(left Datum, right Datum) (Datum, error) {
+		r := right.(DInt)
+		if r == 0 {
+			return nil, errDivByZero
+		}
+		return DInterval{left.(DInterval).Duration / time.Duration(r)}, nil
+	},
+	binArgs{Div, intervalType, floatType}: func(left Datum, right Datum) (Datum, error) {
+		r := right.(DFloat)
+		if r == 0 {
+			return nil, errDivByZero
+		}
+		return DInterval{time.Duration(float64(left.(DInterval).Duration) / float64(r))}, nil
+	},
+
 	binArgs{Concat, stringType, stringType}: func(left Datum, right Datum) (Datum, error) {
 		return left.(DString) + right.(DString), nil
 	},
+	binArgs{Concat, bytesType, bytesType}: func(left Datum, right Datum) (Datum, error) {
+		return left.(DBytes) + right.(DBytes), nil
+	},
 	binArgs{Concat, boolType, stringType}: func(left Datum, right Datum) (Datum, error) {
 		return DString(left.String()) + right.(DString), nil
 	},
@@ -276,6 +824,9 @@ var cmpOps = map[cmpArgs]func(Datum, Datum) (Datum, error){
 	cmpArgs{EQ, stringType, stringType}: func(left Datum, right Datum) (Datum, error) {
 		return DBool(left.(DString) == right.(DString)), nil
 	},
+	cmpArgs{EQ, bytesType, bytesType}: func(left Datum, right Datum) (Datum, error) {
+		return DBool(left.(DBytes) == right.(DBytes)), nil
+	},
 	cmpArgs{EQ, boolType, boolType}: func(left Datum, right Datum) (Datum, error) {
 		return DBool(left.(DBool) == right.(DBool)), nil
 	},
@@ -289,6 +840,9 @@ var cmpOps = map[cmpArgs]func(Datum, Datum) (Datum, error){
 	cmpArgs{LT, stringType, stringType}: func(left Datum, right Datum) (Datum, error) {
 		return DBool(left.(DString) < right.(DString)), nil
 	},
+	cmpArgs{LT, bytesType, bytesType}: func(left Datum, right Datum) (Datum, error) {
+		return DBool(left.(DBytes) < right.(DBytes)), nil
+	},
 	cmpArgs{LT, boolType, boolType}: func(left Datum, right Datum) (Datum, error) {
 		return DBool(!left.(DBool) && right.(DBool)), nil
 	},
@@ -302,6 +856,9 @@ var cmpOps = map[cmpArgs]func(Datum, Datum) (Datum, error){
 	cmpArgs{LE, stringType, stringType}: func(left Datum, right Datum) (Datum, error) {
 		return DBool(left.(DString) <= right.(DString)), nil
 	},
+	cmpArgs{LE, bytesType, bytesType}: func(left Datum, right Datum) (Datum, error) {
+		return DBool(left.(DBytes) <= right.(DBytes)), nil
+	},
 	cmpArgs{LE, boolType, boolType}: func(left Datum, right Datum) (Datum, error) {
 		return DBool(!left.(DBool) || right.(DBool)), nil
 	},
@@ -313,20 +870,115 @@ var cmpOps = map[cmpArgs]func(Datum, Datum) (Datum, error){
 	},
 }
 
+// makeTimeOfDay returns the time of day which is the given duration after
+// midnight, wrapping around at the end of the day.
+func makeTimeOfDay(d time.Duration) DTime {
+	const day = 24 * time.Hour
+	return DTime{((d % day) + day) % day}
+}
+
 func init() {
 	// This avoids an init-loop if we try to initialize this operation when
 	// cmpOps is declared. The loop is caused by evalTupleEQ using cmpOps
 	// internally.
 	cmpOps[cmpArgs{EQ, tupleType, tupleType}] = evalTupleEQ
 
+	// The dates, times, timestamps and intervals are compared by their Compare
+	// methods. A date is also comparable with a timestamp.
+	for _, args := range [][2]reflect.Type{
+		{dateType, dateType},
+		{timeType, timeType},
+		{timestampType, timestampType},
+		{intervalType, intervalType},
+		{dateType, timestampType},
+		{timestampType, dateType},
+	} {
+		cmpOps[cmpArgs{EQ, args[0], args[1]}] = func(left Datum, right Datum) (Datum, error) {
+			return DBool(left.Compare(right) == 0), nil
+		}
+		cmpOps[cmpArgs{LT, args[0], args[1]}] = func(left Datum, right Datum) (Datum, error) {
+			return DBool(left.Compare(right) < 0), nil
+		}
+		cmpOps[cmpArgs{LE, args[0], args[1]}] = func(left Datum, right Datum) (Datum, error) {
+			return DBool(left.Compare(right) <= 0), nil
+		}
+	}
+
+	// The arithmetic on decimals is exact, other than division which rounds the
+	// quotient. An int operand is converted to a decimal.
+	for _, args := range [][2]reflect.Type{
+		{decimalType, decimalType},
+		{decimalType, intType},
+		{intType, decimalType},
+	} {
+		binOps[binArgs{Plus, args[0], args[1]}] = func(left Datum, right Datum) (Datum, error) {
+			return DDecimal{toDecimal(left).Add(toDecimal(right))}, nil
+		}
+		binOps[binArgs{Minus, args[0], args[1]}] = func(left Datum, right Datum) (Datum, error) {
+			return DDecimal{toDecimal(left).Sub(toDecimal(right))}, nil
+		}
+		binOps[binArgs{Mult, args[0], args[1]}] = func(left Datum, right Datum) (Datum, error) {
+			return DDecimal{toDecimal(left).Mul(toDecimal(right))}, nil
+		}
+		binOps[binArgs{Div, args[0], args[1]}] = func(left Datum, right Datum) (Datum, error) {
+			l, r := toDecimal(left), toDecimal(right)
+			if r.Sign() == 0 {
+				return nil, errDivByZero
+			}
+			scale := int32(decimalDivisionScale)
+			if l.Scale() > scale {
+				scale = l.Scale()
+			}
+			if r.Scale() > scale {
+				scale = r.Scale()
+			}
+			return DDecimal{l.Quo(r, scale)}, nil
+		}
+		binOps[binArgs{Mod, args[0], args[1]}] = func(left Datum, right Datum) (Datum, error) {
+			r := toDecimal(right)
+			if r.Sign() == 0 {
+				return nil, errZeroModulus
+			}
+			return DDecimal{toDecimal(left).Rem(r)}, nil
+		}
+		cmpOps[cmpArgs{EQ, args[0], args[1]}] = func(left Datum, right Datum) (Datum, error) {
+			return DBool(left.Compare(right) == 0), nil
+		}
+		cmpOps[cmpArgs{LT, args[0], args[1]}] = func(left Datum, right Datum) (Datum, error) {
+			return DBool(left.Compare(right) < 0), nil
+		}
+		cmpOps[cmpArgs{LE, args[0], args[1]}] = func(left Datum, right Datum) (Datum, error) {
+			return DBool(left.Compare(right) <= 0), nil
+		}
+	}
+
 	cmpOps[cmpArgs{In, boolType, tupleType}] = evalTupleIN
 	cmpOps[cmpArgs{In, intType, tupleType}] = evalTupleIN
 	cmpOps[cmpArgs{In, floatType, tupleType}] = evalTupleIN
+	cmpOps[cmpArgs{In, decimalType, tupleType}] = evalTupleIN
 	cmpOps[cmpArgs{In, stringType, tupleType}] = evalTupleIN
+	cmpOps[cmpArgs{In, bytesType, tupleType}] = evalTupleIN
+	cmpOps[cmpArgs{In, dateType, tupleType}] = evalTupleIN
+	cmpOps[cmpArgs{In, timeType, tupleType}] = evalTupleIN
+	cmpOps[cmpArgs{In, timestampType, tupleType}] = evalTupleIN
+	cmpOps[cmpArgs{In, intervalType, tupleType}] = evalTupleIN
 	cmpOps[cmpArgs{In, tupleType, tupleType}] = evalTupleIN
 }
 
-// Env defines the interface for retrieving column values.
+// EvalContext holds the state of the node and statement in which an
+// expression is evaluated, which is needed by some builtin functions.
+type EvalContext struct {
+	// The ID of the node evaluating the expression. It is folded into the
+	// values returned by unique_rowid().
+	NodeID proto.NodeID
+	// The time at which the statement being evaluated started. It is
+	// returned by now() so that all uses within a statement agree.
+	StmtTimestamp time.Time
+}
+
+// Env defines the interface for retrieving column values. An unqualified
+// column is retrieved by its name as is, and a qualified column by the
+// formatted qualified name.
 type Env interface {
 	Get(name string) (Datum, bool)
 }
@@ -352,7 +1004,7 @@ var emptyEnv *nilEnv
 // environment. Expression evaluation is a mostly straightforward walk over the
 // parse tree. The only significant complexity is the handling of types and
 // implicit conversions. See binOps and cmpOps for more details.
-func EvalExpr(expr Expr, env Env) (Datum, error) {
+func EvalExpr(ctx EvalContext, expr Expr, env Env) (Datum, error) {
 	if env == nil {
 		// This avoids having to worry about `env` being a nil interface
 		// anywhere else.
@@ -368,32 +1020,32 @@ func EvalExpr(expr Expr, env Env) (Datum, error) {
 
 	switch t := expr.(type) {
 	case *AndExpr:
-		return evalAndExpr(t, env)
+		return evalAndExpr(ctx, t, env)
 
 	case *OrExpr:
-		return evalOrExpr(t, env)
+		return evalOrExpr(ctx, t, env)
 
 	case *NotExpr:
-		return evalNotExpr(t, env)
+		return evalNotExpr(ctx, t, env)
 
 	case *ParenExpr:
-		return EvalExpr(t.Expr, env)
+		return EvalExpr(ctx, t.Expr, env)
 
 	case *ComparisonExpr:
-		return evalComparisonExpr(t, env)
+		return evalComparisonExpr(ctx, t, env)
 
 	case *RangeCond:
-		return evalRangeCond(t, env)
+		return evalRangeCond(ctx, t, env)
 
 	case *NullCheck:
-		return evalNullCheck(t, env)
+		return evalNullCheck(ctx, t, env)
 
 	case *ExistsExpr:
 		// The subquery within the exists should have been executed before
 		// expression evaluation and the exists nodes replaced with the result.
 
 	case BytesVal:
-		return DString(t), nil
+		return DBytes(t), nil
 
 	case StrVal:
 		return DString(t), nil
@@ -418,7 +1070,13 @@ func EvalExpr(expr Expr, env Env) (Datum, error) {
 		return DNull, nil
 
 	case *QualifiedName:
-		if d, ok := env.Get(t.String()); ok {
+		name := t.String()
+		if len(t.Indirect) == 0 {
+			// The name is not formatted, which would quote a column named after
+			// an expression such as count(*).
+			name = string(t.Base)
+		}
+		if d, ok := env.Get(name); ok {
 			return d, nil
 		}
 		return DNull, fmt.Errorf("column \"%s\" not found", t)
@@ -426,7 +1084,7 @@ func EvalExpr(expr Expr, env Env) (Datum, error) {
 	case Tuple:
 		tuple := make(DTuple, 0, len(t))
 		for _, v := range t {
-			d, err := EvalExpr(v, env)
+			d, err := EvalExpr(ctx, v, env)
 			if err != nil {
 				return DNull, err
 			}
@@ -442,19 +1100,33 @@ func EvalExpr(expr Expr, env Env) (Datum, error) {
 		// the result placed into the expression tree.
 
 	case *BinaryExpr:
-		return evalBinaryExpr(t, env)
+		return evalBinaryExpr(ctx, t, env)
 
 	case *UnaryExpr:
-		return evalUnaryExpr(t, env)
+		return evalUnaryExpr(ctx, t, env)
 
 	case *FuncExpr:
-		return evalFuncExpr(t, env)
+		return evalFuncExpr(ctx, t, env)
 
 	case *CaseExpr:
-		return evalCaseExpr(t, env)
+		return evalCaseExpr(ctx, t, env)
 
 	case *CastExpr:
-		return evalCastExpr(t, env)
+		return evalCastExpr(ctx, t, env)
+
+	case *DReference:
+		return *t.Datum, nil
+
+	case *DSubquery:
+		args := make(DTuple, 0, len(t.Args))
+		for _, e := range t.Args {
+			d, err := EvalExpr(ctx, e, env)
+			if err != nil {
+				return DNull, err
+			}
+			args = append(args, d)
+		}
+		return t.Eval(args)
 
 	default:
 		panic(fmt.Sprintf("eval: unsupported expression type: %T", expr))
@@ -463,8 +1135,8 @@ func EvalExpr(expr Expr, env Env) (Datum, error) {
 	return DNull, fmt.Errorf("eval: unexpected expression: %T", expr)
 }
 
-func evalAndExpr(expr *AndExpr, env Env) (Datum, error) {
-	left, err := EvalExpr(expr.Left, env)
+func evalAndExpr(ctx EvalContext, expr *AndExpr, env Env) (Datum, error) {
+	left, err := EvalExpr(ctx, expr.Left, env)
 	if err != nil {
 		return DNull, err
 	}
@@ -476,7 +1148,7 @@ func evalAndExpr(expr *AndExpr, env Env) (Datum, error) {
 	} else if !v {
 		return v, nil
 	}
-	right, err := EvalExpr(expr.Right, env)
+	right, err := EvalExpr(ctx, expr.Right, env)
 	if err != nil {
 		return DNull, err
 	}
@@ -491,8 +1163,8 @@ func evalAndExpr(expr *AndExpr, env Env) (Datum, error) {
 	return DBool(true), nil
 }
 
-func evalOrExpr(expr *OrExpr, env Env) (Datum, error) {
-	left, err := EvalExpr(expr.Left, env)
+func evalOrExpr(ctx EvalContext, expr *OrExpr, env Env) (Datum, error) {
+	left, err := EvalExpr(ctx, expr.Left, env)
 	if err != nil {
 		return DNull, err
 	}
@@ -503,7 +1175,7 @@ func evalOrExpr(expr *OrExpr, env Env) (Datum, error) {
 			return v, nil
 		}
 	}
-	right, err := EvalExpr(expr.Right, env)
+	right, err := EvalExpr(ctx, expr.Right, env)
 	if err != nil {
 		return DNull, err
 	}
@@ -521,8 +1193,8 @@ func evalOrExpr(expr *OrExpr, env Env) (Datum, error) {
 	return DBool(false), nil
 }
 
-func evalNotExpr(expr *NotExpr, env Env) (Datum, error) {
-	d, err := EvalExpr(expr.Expr, env)
+func evalNotExpr(ctx EvalContext, expr *NotExpr, env Env) (Datum, error) {
+	d, err := EvalExpr(ctx, expr.Expr, env)
 	if err != nil {
 		return DNull, err
 	}
@@ -536,12 +1208,12 @@ func evalNotExpr(expr *NotExpr, env Env) (Datum, error) {
 	return !v, nil
 }
 
-func evalRangeCond(expr *RangeCond, env Env) (Datum, error) {
+func evalRangeCond(ctx EvalContext, expr *RangeCond, env Env) (Datum, error) {
 	// A range such as "left BETWEEN from AND to" is equivalent to "left >= from
 	// AND left <= to". The only tricky part is that we evaluate "left" only
 	// once.
 
-	left, err := EvalExpr(expr.Left, env)
+	left, err := EvalExpr(ctx, expr.Left, env)
 	if err != nil {
 		return DNull, err
 	}
@@ -556,7 +1228,7 @@ func evalRangeCond(expr *RangeCond, env Env) (Datum, error) {
 
 	var v DBool
 	for _, l := range limits {
-		arg, err := EvalExpr(l.expr, env)
+		arg, err := EvalExpr(ctx, l.expr, env)
 		if err != nil {
 			return DNull, err
 		}
@@ -577,8 +1249,8 @@ func evalRangeCond(expr *RangeCond, env Env) (Datum, error) {
 	return v, nil
 }
 
-func evalNullCheck(expr *NullCheck, env Env) (Datum, error) {
-	d, err := EvalExpr(expr.Expr, env)
+func evalNullCheck(ctx EvalContext, expr *NullCheck, env Env) (Datum, error) {
+	d, err := EvalExpr(ctx, expr.Expr, env)
 	if err != nil {
 		return DNull, err
 	}
@@ -589,16 +1261,19 @@ func evalNullCheck(expr *NullCheck, env Env) (Datum, error) {
 	return DBool(v), nil
 }
 
-func evalComparisonExpr(expr *ComparisonExpr, env Env) (Datum, error) {
-	left, err := EvalExpr(expr.Left, env)
+func evalComparisonExpr(ctx EvalContext, expr *ComparisonExpr, env Env) (Datum, error) {
+	left, err := EvalExpr(ctx, expr.Left, env)
 	if err != nil {
 		return DNull, err
 	}
-	right, err := EvalExpr(expr.Right, env)
+	right, err := EvalExpr(ctx, expr.Right, env)
 	if err != nil {
 		return DNull, err
 	}
 
+	if isPatternOp(expr.Operator) {
+		return evalPatternMatch(ctx, expr, left, right, env)
+	}
 	return evalComparisonOp(expr.Operator, left, right)
 }
 
@@ -636,21 +1311,16 @@ func evalComparisonOp(op ComparisonOp, left, right Datum) (Datum, error) {
 		return d, err
 	}
 
-	switch op {
-	case Like, NotLike:
-		return DNull, fmt.Errorf("TODO(pmattis): unsupported comparison operator: %s", op)
-	}
-
 	return DNull, fmt.Errorf("unsupported comparison operator: <%s> %s <%s>",
 		left.Type(), op, right.Type())
 }
 
-func evalBinaryExpr(expr *BinaryExpr, env Env) (Datum, error) {
-	left, err := EvalExpr(expr.Left, env)
+func evalBinaryExpr(ctx EvalContext, expr *BinaryExpr, env Env) (Datum, error) {
+	left, err := EvalExpr(ctx, expr.Left, env)
 	if err != nil {
 		return DNull, err
 	}
-	right, err := EvalExpr(expr.Right, env)
+	right, err := EvalExpr(ctx, expr.Right, env)
 	if err != nil {
 		return DNull, err
 	}
@@ -662,8 +1332,8 @@ func evalBinaryExpr(expr *BinaryExpr, env Env) (Datum, error) {
 		left.Type(), expr.Operator, right.Type())
 }
 
-func evalUnaryExpr(expr *UnaryExpr, env Env) (Datum, error) {
-	d, err := EvalExpr(expr.Expr, env)
+func evalUnaryExpr(ctx EvalContext, expr *UnaryExpr, env Env) (Datum, error) {
+	d, err := EvalExpr(ctx, expr.Expr, env)
 	if err != nil {
 		return DNull, err
 	}
@@ -675,45 +1345,66 @@ func evalUnaryExpr(expr *UnaryExpr, env Env) (Datum, error) {
 		expr.Operator, d.Type())
 }
 
-func evalFuncExpr(expr *FuncExpr, env Env) (Datum, error) {
-	name := strings.ToLower(expr.Name.String())
-	b, ok := builtins[name]
+func evalFuncExpr(ctx EvalContext, expr *FuncExpr, env Env) (Datum, error) {
+	// The name is looked up unquoted so that functions whose names are
+	// keywords, such as "extract", are found.
+	var candidates []builtin
+	ok := len(expr.Name.Indirect) == 0
+	if ok {
+		candidates, ok = builtins[strings.ToLower(string(expr.Name.Base))]
+	}
 	if !ok {
 		return DNull, fmt.Errorf("%s: unknown function", expr.Name)
 	}
-	if b.nArgs != -1 && b.nArgs != len(expr.Exprs) {
-		return DNull, fmt.Errorf("%s: incorrect number of arguments: %d vs %d",
-			expr.Name, b.nArgs, len(expr.Exprs))
-	}
 
 	args := make(DTuple, 0, len(expr.Exprs))
+	types := make([]reflect.Type, 0, len(expr.Exprs))
 	for _, e := range expr.Exprs {
-		arg, err := EvalExpr(e, env)
+		arg, err := EvalExpr(ctx, e, env)
 		if err != nil {
 			return DNull, err
 		}
 		args = append(args, arg)
+		types = append(types, reflect.TypeOf(arg))
 	}
 
-	res, err := b.fn(args)
-	if err != nil {
-		return DNull, fmt.Errorf("%s: %v", expr.Name, err)
+	for _, b := range candidates {
+		if !b.types.match(types) {
+			continue
+		}
+		if !b.nullableArgs {
+			for _, arg := range args {
+				if arg == DNull {
+					return DNull, nil
+				}
+			}
+		}
+		res, err := b.fn(ctx, args)
+		if err != nil {
+			return DNull, fmt.Errorf("%s: %v", expr.Name, err)
+		}
+		return res, nil
 	}
-	return res, nil
+
+	typeNames := make([]string, 0, len(args))
+	for _, arg := range args {
+		typeNames = append(typeNames, arg.Type())
+	}
+	return DNull, fmt.Errorf("%s(%s): unknown signature", expr.Name, strings.Join(typeNames, ", "))
 }
 
-func evalCaseExpr(expr *CaseExpr, env Env) (Datum, error) {
+func evalCaseExpr(ctx EvalContext, expr *CaseExpr, env Env) (Datum, error) {
 	if expr.Expr != nil {
 		// CASE <val> WHEN <expr> THEN ...
 		//
 		// For each "when" expression we compare for equality to <val>.
-		val, err := EvalExpr(expr.Expr, env)
+		val, err := EvalExpr(ctx, expr.Expr, env)
 		if err != nil {
 			return DNull, err
 		}
 
 		for _, when := range expr.Whens {
-			arg, err := EvalExpr(when.Cond, env)
+			arg, err := EvalExpr(ctx, when.Cond, env)
 			if err != nil {
 				return DNull, err
 			}
@@ -724,26 +1415,26 @@ func evalCaseExpr(expr *CaseExpr, env Env) (Datum, error) {
 			if v, err := getBool(d); err != nil {
 				return DNull, err
 			} else if v {
-				return EvalExpr(when.Val, env)
+				return EvalExpr(ctx, when.Val, env)
 			}
 		}
 	} else {
 		// CASE WHEN <bool-expr> THEN ...
 		for _, when := range expr.Whens {
-			d, err := EvalExpr(when.Cond, env)
+			d, err := EvalExpr(ctx, when.Cond, env)
 			if err != nil {
 				return DNull, err
 			}
 			if v, err := getBool(d); err != nil {
 				return DNull, err
 			} else if v {
-				return EvalExpr(when.Val, env)
+				return EvalExpr(ctx, when.Val, env)
 			}
 		}
 	}
 
 	if expr.Else != nil {
-		return EvalExpr(expr.Else, env)
+		return EvalExpr(ctx, expr.Else, env)
 	}
 	return DNull, nil
 }
@@ -814,8 +1505,8 @@ func evalTupleIN(arg, values Datum) (Datum, error) {
 	return DBool(false), nil
 }
 
-func evalCastExpr(expr *CastExpr, env Env) (Datum, error) {
-	d, err := EvalExpr(expr.Expr, env)
+func evalCastExpr(ctx EvalContext, expr *CastExpr, env Env) (Datum, error) {
+	d, err := EvalExpr(ctx, expr.Expr, env)
 	if err != nil {
 		return DNull, err
 	}
@@ -829,6 +1520,8 @@ func evalCastExpr(expr *CastExpr, env Env) (Datum, error) {
 			return DBool(v != 0), nil
 		case DFloat:
 			return DBool(v != 0), nil
+		case DDecimal:
+			return DBool(v.Sign() != 0), nil
 		case DString:
 			// TODO(pmattis): strconv.ParseBool is more permissive than the SQL
 			// spec. Is that ok?
@@ -850,12 +1543,21 @@ func evalCastExpr(expr *CastExpr, env Env) (Datum, error) {
 			return d, nil
 		case DFloat:
 			return DInt(v), nil
+		case DDecimal:
+			i, ok := v.Int64()
+			if !ok {
+				return DNull, fmt.Errorf("%s is out of range for type int", v)
+			}
+			return DInt(i), nil
 		case DString:
 			i, err := strconv.ParseInt(string(v), 0, 64)
 			if err != nil {
 				return DNull, err
 			}
 			return DInt(i), nil
+		case DTimestamp:
+			// The seconds since the Unix epoch.
+			return DInt(v.Unix()), nil
 		}
 
 	case *FloatType:
@@ -869,6 +1571,8 @@ func evalCastExpr(expr *CastExpr, env Env) (Datum, error) {
 			return DFloat(v), nil
 		case DFloat:
 			return d, nil
+		case DDecimal:
+			return DFloat(v.Float64()), nil
 		case DString:
 			f, err := strconv.ParseFloat(string(v), 64)
 			if err != nil {
@@ -877,13 +1581,64 @@ func evalCastExpr(expr *CastExpr, env Env) (Datum, error) {
 			return DFloat(f), nil
 		}
 
-	case *CharType, *TextType, *BlobType:
+	case *DecimalType:
+		var dd DDecimal
+		if n, ok := expr.Expr.(NumVal); ok {
+			// A numeric literal is converted exactly rather than through its float
+			// value.
+			v, err := decimal.Parse(string(n))
+			if err != nil {
+				return DNull, err
+			}
+			dd = DDecimal{v}
+		} else {
+			switch v := d.(type) {
+			case DBool:
+				if v {
+					dd = DDecimal{decimal.New(1, 0)}
+				} else {
+					dd = DDecimal{decimal.New(0, 0)}
+				}
+			case DInt:
+				dd = DDecimal{decimal.New(int64(v), 0)}
+			case DFloat:
+				v2, err := decimal.NewFromFloat(float64(v))
+				if err != nil {
+					return DNull, err
+				}
+				dd = DDecimal{v2}
+			case DDecimal:
+				dd = v
+			case DString:
+				v2, err := decimal.Parse(string(v))
+				if err != nil {
+					return DNull, fmt.Errorf("could not parse %q as type decimal", string(v))
+				}
+				dd = DDecimal{v2}
+			default:
+				return DNull, fmt.Errorf("invalid cast: %s -> %s", d.Type(), expr.Type)
+			}
+		}
+		t := expr.Type.(*DecimalType)
+		return LimitDecimal(dd, t.Prec, t.Scale)
+
+	case *BlobType:
+		switch v := d.(type) {
+		case DString:
+			return DBytes(v), nil
+		case DBytes:
+			return d, nil
+		}
+
+	case *CharType, *TextType:
 		var s DString
 		switch d.(type) {
-		case DBool, DInt, DFloat, dNull:
+		case DBool, DInt, DFloat, DDecimal, DDate, DTime, DTimestamp, DInterval, dNull:
 			s = DString(d.String())
 		case DString:
 			s = d.(DString)
+		case DBytes:
+			s = DString(d.(DBytes))
 		}
 		if c, ok := expr.Type.(*CharType); ok {
 			// If the CHAR type specifies a limit we truncate to that limit:
@@ -894,13 +1649,192 @@ func evalCastExpr(expr *CastExpr, env Env) (Datum, error) {
 		}
 		return s, nil
 
+	case *DateType:
+		switch v := d.(type) {
+		case DString:
+			t, err := parseTimestamp(string(v), "date")
+			if err != nil {
+				return DNull, err
+			}
+			return MakeDDate(t), nil
+		case DDate:
+			return d, nil
+		case DTimestamp:
+			return MakeDDate(v.Time), nil
+		}
+
+	case *TimeType:
+		switch v := d.(type) {
+		case DString:
+			return parseTime(string(v))
+		case DTime:
+			return d, nil
+		case DTimestamp:
+			return MakeDTime(v.Time), nil
+		}
+
+	case *TimestampType:
+		switch v := d.(type) {
+		case DString:
+			t, err := parseTimestamp(string(v), "timestamp")
+			if err != nil {
+				return DNull, err
+			}
+			return DTimestamp{t}, nil
+		case DInt:
+			// The seconds since the Unix epoch.
+			return DTimestamp{time.Unix(int64(v), 0).UTC()}, nil
+		case DDate:
+			return DTimestamp{v.Time}, nil
+		case DTimestamp:
+			return d, nil
+		}
+
+	case *IntervalType:
+		switch v := d.(type) {
+		case DString:
+			return parseInterval(string(v))
+		case DInterval:
+			return d, nil
+		}
+
 		// TODO(pmattis): unimplemented.
 		// case *BitType:
-		// case *DecimalType:
-		// case *DateType:
-		// case *TimeType:
-		// case *TimestampType:
 	}
 
 	return DNull, fmt.Errorf("invalid cast: %s -> %s", d.Type(), expr.Type)
 }
+
+// TypeCheckExpr returns a sample value of the type of the expression, or DNull
+// if the type cannot be determined, for instance for the NULL literal. The
+// variables of the environment and the referenced datums hold samples of the
+// types of the columns rather than their values. The operators and functions
+// of the expression are evaluated on the samples of their arguments, except
+// for those whose type does not depend on the values, such as comparisons and
+// casts. An error evaluating an operator or function on the samples, such as
+// a division by zero, makes the type of its result unknown, as the values at
+// execution time may differ.
+func TypeCheckExpr(ctx EvalContext, expr Expr, env Env) (Datum, error) {
+	switch t := expr.(type) {
+	case *AndExpr, *OrExpr, *NotExpr, *ComparisonExpr, *RangeCond, *NullCheck, *ExistsExpr:
+		return DBool(true), nil
+
+	case *ParenExpr:
+		return TypeCheckExpr(ctx, t.Expr, env)
+
+	case Tuple:
+		return typeCheckExprs(ctx, Exprs(t), env)
+
+	case Row:
+		return typeCheckExprs(ctx, Exprs(t), env)
+
+	case *BinaryExpr:
+		left, err := TypeCheckExpr(ctx, t.Left, env)
+		if err != nil {
+			return DNull, err
+		}
+		right, err := TypeCheckExpr(ctx, t.Right, env)
+		if err != nil {
+			return DNull, err
+		}
+		return evalSample(ctx, &BinaryExpr{Operator: t.Operator, Left: left, Right: right})
+
+	case *UnaryExpr:
+		d, err := TypeCheckExpr(ctx, t.Expr, env)
+		if err != nil {
+			return DNull, err
+		}
+		return evalSample(ctx, &UnaryExpr{Operator: t.Operator, Expr: d})
+
+	case *FuncExpr:
+		args, err := typeCheckExprs(ctx, t.Exprs, env)
+		if err != nil {
+			return DNull, err
+		}
+		if len(t.Name.Indirect) == 0 && strings.EqualFold(string(t.Name.Base), "nullif") && len(args) > 0 {
+			// The samples of the arguments may well be equal.
+			return args[0], nil
+		}
+		exprs := make(Exprs, len(args))
+		for i, arg := range args {
+			exprs[i] = arg
+		}
+		return evalSample(ctx, &FuncExpr{Name: t.Name, Distinct: t.Distinct, Exprs: exprs})
+
+	case *CaseExpr:
+		// The type is that of the first result whose type is known.
+		for _, when := range t.Whens {
+			d, err := TypeCheckExpr(ctx, when.Val, env)
+			if err != nil || d != DNull {
+				return d, err
+			}
+		}
+		if t.Else != nil {
+			return TypeCheckExpr(ctx, t.Else, env)
+		}
+		return DNull, nil
+
+	case *CastExpr:
+		return castTypeSample(t.Type), nil
+
+	case *DReference:
+		if *t.Datum == nil {
+			return DNull, nil
+		}
+		return *t.Datum, nil
+
+	case *DSubquery:
+		return DNull, nil
+	}
+
+	return EvalExpr(ctx, expr, env)
+}
+
+func typeCheckExprs(ctx EvalContext, exprs Exprs, env Env) (DTuple, error) {
+	tuple := make(DTuple, 0, len(exprs))
+	for _, e := range exprs {
+		d, err := TypeCheckExpr(ctx, e, env)
+		if err != nil {
+			return nil, err
+		}
+		tuple = append(tuple, d)
+	}
+	return tuple, nil
+}
+
+// evalSample evaluates an operator or function on the samples of the types of
+// its arguments.
+func evalSample(ctx EvalContext, expr Expr) (Datum, error) {
+	d, err := EvalExpr(ctx, expr, nil)
+	if err != nil {
+		return DNull, nil
+	}
+	return d, nil
+}
+
+// castTypeSample returns a sample value of the type of a cast.
+func castTypeSample(t ColumnType) Datum {
+	switch t.(type) {
+	case *BoolType:
+		return DBool(true)
+	case *BitType, *IntType, *SerialType:
+		return DInt(1)
+	case *FloatType:
+		return DFloat(1)
+	case *DecimalType:
+		return DDecimal{Decimal: decimal.New(1, 0)}
+	case *CharType, *TextType:
+		return DString("")
+	case *BlobType:
+		return DBytes("")
+	case *DateType:
+		return MakeDDate(time.Unix(0, 0))
+	case *TimeType:
+		return DTime{}
+	case *TimestampType:
+		return DTimestamp{Time: time.Unix(0, 0).UTC()}
+	case *IntervalType:
+		return DInterval{}
+	}
+	return DNull
+}
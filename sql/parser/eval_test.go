@@ -19,8 +19,10 @@ package parser
 
 import (
 	"testing"
+	"time"
 
 	"github.com/cockroachdb/cockroach/testutils"
+	"github.com/cockroachdb/cockroach/util/decimal"
 )
 
 func TestEvalExpr(t *testing.T) {
@@ -58,12 +60,17 @@ func TestEvalExpr(t *testing.T) {
 		// String concatenation.
 		{`'a' || 'b'`, `'ab'`, nil},
 		{`'a' || (1 + 2)`, `'a3'`, nil},
+		// Bytes concatenation.
+		{`b'a' || x'00ff'`, `x'6100ff'`, nil},
+		{`x'' || b''`, `x''`, nil},
 		// Column lookup.
 		{`a`, `1`, mapEnv{"a": DInt(1)}},
 		{`a`, `3.1`, mapEnv{"a": DFloat(3.1)}},
 		{`a`, `'c'`, mapEnv{"a": DString("c")}},
 		{`a.b + 1`, `2`, mapEnv{"a.b": DInt(1)}},
 		{`a OR b`, `true`, mapEnv{"a": DBool(false), "b": DBool(true)}},
+		{`"count(*)" + 1`, `4`, mapEnv{"count(*)": DInt(3)}},
+		{`"A"`, `1`, mapEnv{"A": DInt(1)}},
 		// Boolean expressions.
 		{`false AND true`, `false`, nil},
 		{`false AND NULL`, `false`, nil},
@@ -142,10 +149,132 @@ func TestEvalExpr(t *testing.T) {
 		{`1+1 IN (2, 3, 4)`, `true`, nil},
 		{`'a0' IN ('a'||0, 'b'||1, 'c'||2)`, `true`, nil},
 		{`(1,2) IN ((0+1,1+1), (3,4), (5,6))`, `true`, nil},
+		// LIKE and ILIKE expressions.
+		{`'abc' LIKE 'abc'`, `true`, nil},
+		{`'abc' LIKE 'ab'`, `false`, nil},
+		{`'abc' LIKE 'a%'`, `true`, nil},
+		{`'abc' LIKE '%b%'`, `true`, nil},
+		{`'abc' LIKE '_b_'`, `true`, nil},
+		{`'abc' LIKE '__'`, `false`, nil},
+		{`e'a\nb' LIKE 'a_b'`, `true`, nil},
+		{`'a.c' LIKE 'a.c'`, `true`, nil},
+		{`'abc' LIKE 'a.c'`, `false`, nil},
+		{`'a%c' LIKE 'a\%c'`, `true`, nil},
+		{`'abc' LIKE 'a\%c'`, `false`, nil},
+		{`'a%c' LIKE 'a!%c' ESCAPE '!'`, `true`, nil},
+		{`'a\c' LIKE 'a\c' ESCAPE ''`, `true`, nil},
+		{`'abc' NOT LIKE 'A%'`, `true`, nil},
+		{`'abc' ILIKE 'A%'`, `true`, nil},
+		{`'abc' NOT ILIKE 'A_C'`, `false`, nil},
+		{`NULL LIKE 'a'`, `NULL`, nil},
+		{`'a' LIKE NULL`, `NULL`, nil},
+		// SIMILAR TO expressions.
+		{`'abc' SIMILAR TO 'abc'`, `true`, nil},
+		{`'abc' SIMILAR TO 'a'`, `false`, nil},
+		{`'abc' SIMILAR TO '%(b|d)%'`, `true`, nil},
+		{`'abd' SIMILAR TO 'a(b|c)c'`, `false`, nil},
+		{`'abc' SIMILAR TO 'a_c'`, `true`, nil},
+		{`'abc' SIMILAR TO 'a.c'`, `false`, nil},
+		{`'aaa' SIMILAR TO 'a+'`, `true`, nil},
+		{`'a1' SIMILAR TO '[a-z][0-9]'`, `true`, nil},
+		{`'a%' SIMILAR TO 'a#%' ESCAPE '#'`, `true`, nil},
+		{`'abc' NOT SIMILAR TO 'a%'`, `false`, nil},
+		// Regular expression matches.
+		{`'abc' ~ 'b'`, `true`, nil},
+		{`'abc' ~ '^b'`, `false`, nil},
+		{`'abc' ~ 'B'`, `false`, nil},
+		{`'abc' ~* 'B'`, `true`, nil},
+		{`'abc' !~ 'b'`, `false`, nil},
+		{`'abc' !~* 'D'`, `true`, nil},
 		// Func expressions.
 		{`length('hel'||'lo')`, `5`, nil},
 		{`lower('HELLO')`, `'hello'`, nil},
 		{`UPPER('hello')`, `'HELLO'`, nil},
+		{`length('héllo')`, `5`, nil},
+		{`length(b'h\xc3\xa9llo')`, `6`, nil},
+		{`lower(NULL)`, `NULL`, nil},
+		{`substr('hello', 2)`, `'ello'`, nil},
+		{`substr('hello', 2, 3)`, `'ell'`, nil},
+		{`substr('hello', 0, 3)`, `'he'`, nil},
+		{`substr('hello', -5, 3)`, `''`, nil},
+		{`substr('hello', 4, 10)`, `'lo'`, nil},
+		{`substr('hello', 10)`, `''`, nil},
+		{`substr('héllo', 2, 2)`, `'él'`, nil},
+		{`substr('hello', 2, 9223372036854775807)`, `'ello'`, nil},
+		{`SUBSTRING('hello' FROM 2 FOR 3)`, `'ell'`, nil},
+		{`SUBSTRING('hello' FOR 2)`, `'he'`, nil},
+		{`concat('a', 1, NULL, true, b'c')`, `'a1truec'`, nil},
+		{`concat()`, `''`, nil},
+		{`btrim('  hi  ')`, `'hi'`, nil},
+		{`btrim('xxhixx', 'x')`, `'hi'`, nil},
+		{`ltrim('  hi  ')`, `'hi  '`, nil},
+		{`rtrim('  hi  ')`, `'  hi'`, nil},
+		{`TRIM(BOTH 'x' FROM 'xhix')`, `'hi'`, nil},
+		{`TRIM(LEADING 'x' FROM 'xhix')`, `'hix'`, nil},
+		{`TRIM(TRAILING FROM ' hi ')`, `' hi'`, nil},
+		{`replace('abcabc', 'b', 'xy')`, `'axycaxyc'`, nil},
+		{`replace('abc', '', 'x')`, `'abc'`, nil},
+		{`split_part('a,b,c', ',', 2)`, `'b'`, nil},
+		{`split_part('a,b,c', ',', 4)`, `''`, nil},
+		{`split_part('abc', '', 1)`, `'abc'`, nil},
+		{`strpos('héllo', 'l')`, `3`, nil},
+		{`strpos('hello', 'x')`, `0`, nil},
+		{`POSITION('l' IN 'hello')`, `3`, nil},
+		{`abs(-1)`, `1`, nil},
+		{`abs(-1.5)`, `1.5`, nil},
+		{`abs(DECIMAL '-1.50')`, `1.50`, nil},
+		{`ceil(1.2)`, `2`, nil},
+		{`ceiling(-1.2)`, `-1`, nil},
+		{`ceil(DECIMAL '1.2')`, `2`, nil},
+		{`ceil(DECIMAL '-1.2')`, `-1`, nil},
+		{`ceil(3)`, `3`, nil},
+		{`floor(1.8)`, `1`, nil},
+		{`floor(DECIMAL '1.8')`, `1`, nil},
+		{`floor(DECIMAL '-1.2')`, `-2`, nil},
+		{`round(2.5)`, `3`, nil},
+		{`round(-2.5)`, `-3`, nil},
+		{`round(DECIMAL '1.45')`, `1`, nil},
+		{`round(DECIMAL '1.45', 1)`, `1.5`, nil},
+		{`round(DECIMAL '1250', -2)`, `1300`, nil},
+		{`mod(7, 3)`, `1`, nil},
+		{`mod(-7.5, 2.0)`, `-1.5`, nil},
+		{`mod(DECIMAL '7.5', 2)`, `1.5`, nil},
+		{`pow(2, 10)`, `1024`, nil},
+		{`power(2.0, 0.5)`, `1.4142135623730951`, nil},
+		{`sqrt(16)`, `4`, nil},
+		{`sqrt(2.25)`, `1.5`, nil},
+		{`pow(DECIMAL '1.5', 2)`, `2.25`, nil},
+		{`pow(DECIMAL '2', DECIMAL '-2')`, `0.2500000000000000`, nil},
+		{`pow(DECIMAL '-2', DECIMAL '3.0')`, `-8`, nil},
+		{`pow(DECIMAL '2', DECIMAL '0.5')`, `1.4142135623730951`, nil},
+		{`sqrt(DECIMAL '2')`, `1.4142135623730950`, nil},
+		{`sqrt(DECIMAL '6.25')`, `2.5000000000000000`, nil},
+		{`coalesce(NULL, 2, 3)`, `2`, nil},
+		{`coalesce(NULL, NULL)`, `NULL`, nil},
+		{`nullif(1, 1)`, `NULL`, nil},
+		{`nullif(1, 2)`, `1`, nil},
+		{`nullif(NULL, 1)`, `NULL`, nil},
+		{`nullif(1, NULL)`, `1`, nil},
+		{`greatest(1, 3, NULL, 2)`, `3`, nil},
+		{`least('b', 'a', 'c')`, `'a'`, nil},
+		{`greatest(NULL, NULL)`, `NULL`, nil},
+		{`extract(year FROM '2015-08-25 04:45:45.53453'::timestamp)`, `2015`, nil},
+		{`extract(quarter FROM '2015-08-25'::date)`, `3`, nil},
+		{`extract(month FROM '2015-08-25'::date)`, `8`, nil},
+		{`extract(week FROM '2015-08-25'::date)`, `35`, nil},
+		{`extract(dow FROM '2015-08-25'::date)`, `2`, nil},
+		{`extract(doy FROM '2015-08-25'::date)`, `237`, nil},
+		{`extract(hour FROM '2015-08-25 04:45:45.53453'::timestamp)`, `4`, nil},
+		{`extract(second FROM '2015-08-25 04:45:45.53453'::timestamp)`, `45`, nil},
+		{`extract(millisecond FROM '2015-08-25 04:45:45.53453'::timestamp)`, `45534`, nil},
+		{`extract(epoch FROM '2015-08-25'::date)`, `1440460800`, nil},
+		{`extract(minute FROM '04:45:45'::time)`, `45`, nil},
+		{`extract(epoch FROM '01:00:01'::time)`, `3601`, nil},
+		{`date_trunc('month', '2015-08-25 04:45:45.53453'::timestamp)`, `2015-08-01 00:00:00`, nil},
+		{`date_trunc('quarter', '2015-08-25'::date)`, `2015-07-01 00:00:00`, nil},
+		{`date_trunc('week', '2015-08-25'::date)`, `2015-08-24 00:00:00`, nil},
+		{`date_trunc('hour', '2015-08-25 04:45:45.53453'::timestamp)`, `2015-08-25 04:00:00`, nil},
+		{`length(uuid_v4())`, `16`, nil},
 		// Cast expressions.
 		{`true::boolean`, `true`, nil},
 		{`true::int`, `1`, nil},
@@ -184,6 +313,80 @@ func TestEvalExpr(t *testing.T) {
 		{`'hello'::text`, `'hello'`, nil},
 		{`CAST('123' AS int) + 1`, `124`, nil},
 		{`'hello'::char(2)`, `'he'`, nil},
+		{`'hello'::bytes`, `x'68656c6c6f'`, nil},
+		{`x'68'::blob`, `x'68'`, nil},
+		{`b'hi'::text`, `'hi'`, nil},
+		// Bytes comparisons.
+		{`x'00' = b'\x00'`, `true`, nil},
+		{`x'00' < x'0000'`, `true`, nil},
+		{`x'ff' <= x'00ff'`, `false`, nil},
+		{`x'ff' IN (x'00', x'ff')`, `true`, nil},
+		// Dates, times, timestamps and intervals.
+		{`DATE '2015-08-25'`, `2015-08-25`, nil},
+		{`'2015-08-25 16:30:00'::date`, `2015-08-25`, nil},
+		{`TIME '16:30'`, `16:30:00`, nil},
+		{`'16:30:01.5'::time`, `16:30:01.5`, nil},
+		{`TIMESTAMP '2015-08-25 16:30:00'`, `2015-08-25 16:30:00`, nil},
+		{`TIMESTAMP '2015-08-25T16:30:00.123+02:00'`, `2015-08-25 14:30:00.123`, nil},
+		{`DATE '2015-08-25'::timestamp`, `2015-08-25 00:00:00`, nil},
+		{`TIMESTAMP '2015-08-25 16:30:00'::time`, `16:30:00`, nil},
+		{`0::timestamp`, `1970-01-01 00:00:00`, nil},
+		{`TIMESTAMP '1970-01-01 00:01:00'::int`, `60`, nil},
+		{`INTERVAL '1h30m'`, `1h30m0s`, nil},
+		{`INTERVAL '1 day 2 hours'`, `26h0m0s`, nil},
+		{`INTERVAL '1 week -02:30'`, `165h30m0s`, nil},
+		{`'1.5 seconds'::interval`, `1.5s`, nil},
+		{`length(DATE '2015-08-25'::text)`, `10`, nil},
+		{`DATE '2015-08-25' + 7`, `2015-09-01`, nil},
+		{`7 + DATE '2015-08-25'`, `2015-09-01`, nil},
+		{`DATE '2015-08-25' - 25`, `2015-07-31`, nil},
+		{`DATE '2015-08-25' - DATE '2015-08-01'`, `24`, nil},
+		{`DATE '2015-08-25' + INTERVAL '1h'`, `2015-08-25 01:00:00`, nil},
+		{`DATE '2015-08-25' + TIME '10:00'`, `2015-08-25 10:00:00`, nil},
+		{`TIME '23:00' + INTERVAL '2h'`, `01:00:00`, nil},
+		{`TIME '01:00' - INTERVAL '2h'`, `23:00:00`, nil},
+		{`TIME '23:00' - TIME '01:00'`, `22h0m0s`, nil},
+		{`TIMESTAMP '2015-08-25 23:00' + INTERVAL '2h'`, `2015-08-26 01:00:00`, nil},
+		{`INTERVAL '2h' + TIMESTAMP '2015-08-25 23:00'`, `2015-08-26 01:00:00`, nil},
+		{`TIMESTAMP '2015-08-25 23:00' - INTERVAL '1 day'`, `2015-08-24 23:00:00`, nil},
+		{`TIMESTAMP '2015-08-26' - TIMESTAMP '2015-08-25 23:00'`, `1h0m0s`, nil},
+		{`INTERVAL '1h' + INTERVAL '30m'`, `1h30m0s`, nil},
+		{`INTERVAL '1h' - INTERVAL '30m'`, `30m0s`, nil},
+		{`-INTERVAL '1h'`, `-1h0m0s`, nil},
+		{`INTERVAL '1h' * 3`, `3h0m0s`, nil},
+		{`2 * INTERVAL '1h'`, `2h0m0s`, nil},
+		{`INTERVAL '1h' * 1.5`, `1h30m0s`, nil},
+		{`INTERVAL '1h' / 4`, `15m0s`, nil},
+		{`INTERVAL '1h' / 0.5`, `2h0m0s`, nil},
+		{`DATE '2015-08-25' < DATE '2015-08-26'`, `true`, nil},
+		{`DATE '2015-08-25' = TIMESTAMP '2015-08-25 00:00'`, `true`, nil},
+		{`TIMESTAMP '2015-08-25 00:01' > DATE '2015-08-25'`, `true`, nil},
+		{`TIME '10:00' <= TIME '09:00'`, `false`, nil},
+		{`INTERVAL '1h' = INTERVAL '60m'`, `true`, nil},
+		{`DATE '2015-08-25' IN (DATE '2015-08-24', DATE '2015-08-25')`, `true`, nil},
+		{`a - INTERVAL '1 day'`, `2015-08-24 12:00:00`, mapEnv{"a": DTimestamp{time.Date(2015, 8, 25, 12, 0, 0, 0, time.UTC)}}},
+		// Decimal arithmetic is exact.
+		{`0.1::decimal + 0.2::decimal`, `0.3`, nil},
+		{`DECIMAL '1.10' + 2`, `3.10`, nil},
+		{`3 - DECIMAL '0.25'`, `2.75`, nil},
+		{`DECIMAL '1.10' * DECIMAL '0.5'`, `0.550`, nil},
+		{`DECIMAL '1' / 3`, `0.3333333333333333`, nil},
+		{`DECIMAL '10.00' / DECIMAL '4'`, `2.5000000000000000`, nil},
+		{`DECIMAL '7.5' % 2`, `1.5`, nil},
+		{`-DECIMAL '1.5'`, `-1.5`, nil},
+		{`123456789012345678901234567890.5::decimal * 2`, `246913578024691357802469135781.0`, nil},
+		{`1.235::decimal(4,2)`, `1.24`, nil},
+		{`'-1.235'::decimal(10,2)`, `-1.24`, nil},
+		{`12.5::decimal(3)`, `13`, nil},
+		{`2.5::float::decimal`, `2.5`, nil},
+		{`DECIMAL '2.5'::int`, `3`, nil},
+		{`DECIMAL '2.5'::float`, `2.5`, nil},
+		{`DECIMAL '2.50'::text`, `'2.50'`, nil},
+		{`DECIMAL '1.0' = 1`, `true`, nil},
+		{`DECIMAL '1.00' = DECIMAL '1'`, `true`, nil},
+		{`DECIMAL '0.1' < DECIMAL '0.10000000000000000001'`, `true`, nil},
+		{`2 <= DECIMAL '1.99'`, `false`, nil},
+		{`DECIMAL '2' IN (1, 2)`, `true`, nil},
 	}
 	for _, d := range testData {
 		q, err := Parse("SELECT " + d.expr)
@@ -191,7 +394,7 @@ func TestEvalExpr(t *testing.T) {
 			t.Fatalf("%s: %v", d.expr, err)
 		}
 		expr := q[0].(*Select).Exprs[0].(*NonStarExpr).Expr
-		r, err := EvalExpr(expr, d.env)
+		r, err := EvalExpr(EvalContext{}, expr, d.env)
 		if err != nil {
 			t.Fatalf("%s: %v", d.expr, err)
 		}
@@ -214,19 +417,59 @@ func TestEvalExprError(t *testing.T) {
 		{`~0.1`, `unsupported unary operator:`},
 		{`'10' > 2`, `unsupported comparison operator:`},
 		{`1 IN ('a', 'b')`, `unsupported comparison operator:`},
+		{`x'00' = '0'`, `unsupported comparison operator:`},
+		{`x'00' || 'a'`, `unsupported binary operator:`},
+		{`1::bytes`, `invalid cast: int -> BYTES`},
+		{`1 LIKE 'a'`, `unsupported comparison operator: <int> LIKE <string>`},
+		{`'a' LIKE 'a\'`, `LIKE pattern must not end with escape character`},
+		{`'a' LIKE 'a' ESCAPE 'ab'`, `invalid escape string: 'ab'`},
+		{`'a' ~ '('`, `invalid regular expression:`},
 		{`a`, `column \"a\" not found`},
 		{`1 AND true`, `cannot convert int to bool`},
 		{`1.0 AND true`, `cannot convert float to bool`},
 		{`'a' AND true`, `cannot convert string to bool`},
 		{`(1, 2) AND true`, `cannot convert tuple to bool`},
-		{`lower()`, `incorrect number of arguments`},
-		{`lower(1, 2)`, `incorrect number of arguments`},
-		{`lower(1)`, `argument type mismatch`},
+		{`lower()`, `lower\(\): unknown signature`},
+		{`lower(1, 2)`, `lower\(int, int\): unknown signature`},
+		{`lower(1)`, `lower\(int\): unknown signature`},
+		{`random(1)`, `random\(int\): unknown signature`},
+		{`foo(1)`, `foo: unknown function`},
+		{`abs(-9223372036854775807 - 1)`, `abs of min integer value`},
+		{`sqrt(-1.0)`, `cannot take square root of a negative number`},
+		{`sqrt(DECIMAL '-1')`, `cannot take square root of a negative number`},
+		{`pow(DECIMAL '0', -1)`, `zero raised to a negative power is undefined`},
+		{`pow(DECIMAL '-8', DECIMAL '0.5')`, `a negative number raised to a non-integer power yields a complex result`},
+		{`pow(DECIMAL '10', 100000)`, `pow result is out of range`},
+		{`pow(DECIMAL '1.5', -20000)`, `pow result is out of range`},
+		{`mod(1, 0)`, `zero modulus`},
+		{`substr('abc', 1, -1)`, `negative substring length -1 not allowed`},
+		{`split_part('a,b', ',', 0)`, `field position 0 must be greater than zero`},
+		{`coalesce(1, 'a')`, `incompatible argument types int and string`},
+		{`greatest(1, 'a')`, `unsupported comparison operator:`},
+		{`extract(century FROM '2015-08-25'::date)`, `unsupported extract field: century`},
+		{`extract(year FROM '12:00'::time)`, `unsupported extract field: year`},
+		{`date_trunc('decade', '2015-08-25'::date)`, `unsupported date_trunc field: decade`},
+		{`round(DECIMAL '1', 10000)`, `scale 10000 out of range`},
 		{`1::bit`, `invalid cast: int -> BIT`},
-		{`1::decimal`, `invalid cast: int -> DECIMAL`},
+		{`'abc'::decimal`, `could not parse "abc" as type decimal`},
+		{`'1e2000000000'::decimal`, `could not parse "1e2000000000" as type decimal`},
+		{`1e-2000000000::decimal`, `decimal "1e-2000000000" out of range`},
+		{`123.45::decimal(4,2)`, `value 123.45 overflows DECIMAL\(4,2\)`},
+		{`DECIMAL '1' / 0`, `division by zero`},
+		{`DECIMAL '1' % DECIMAL '0.0'`, `zero modulus`},
+		{`DECIMAL '1e20'::int`, `100000000000000000000 is out of range for type int`},
+		{`DECIMAL '1' + 1.5`, `unsupported binary operator:`},
 		{`1::date`, `invalid cast: int -> DATE`},
 		{`1::time`, `invalid cast: int -> TIME`},
-		{`1::timestamp`, `invalid cast: int -> TIMESTAMP`},
+		{`1::interval`, `invalid cast: int -> INTERVAL`},
+		{`'2015-13-01'::date`, `could not parse "2015-13-01" as type date`},
+		{`'25:00'::time`, `could not parse "25:00" as type time`},
+		{`'yesterday'::timestamp`, `could not parse "yesterday" as type timestamp`},
+		{`'1 month'::interval`, `could not parse "1 month" as type interval`},
+		{`'1 day 2'::interval`, `could not parse "1 day 2" as type interval`},
+		{`INTERVAL '1h' / 0`, `division by zero`},
+		{`DATE '2015-08-25' + 1.5`, `unsupported binary operator:`},
+		{`DATE '2015-08-25' < TIME '10:00'`, `unsupported comparison operator:`},
 		// TODO(pmattis): Check for overflow.
 		// {`~0 + 1`, `0`, nil},
 	}
@@ -236,8 +479,209 @@ func TestEvalExprError(t *testing.T) {
 			t.Fatalf("%s: %v", d.expr, err)
 		}
 		expr := q[0].(*Select).Exprs[0].(*NonStarExpr).Expr
-		if _, err := EvalExpr(expr, mapEnv{}); !testutils.IsError(err, d.expected) {
+		if _, err := EvalExpr(EvalContext{}, expr, mapEnv{}); !testutils.IsError(err, d.expected) {
 			t.Errorf("%s: expected %s, but found %v", d.expr, d.expected, err)
 		}
 	}
 }
+
+func TestTypeCheckExpr(t *testing.T) {
+	testData := []struct {
+		expr     string
+		expected string
+	}{
+		{`a`, `int`},
+		{`a + 1`, `int`},
+		{`a % (a - 1)`, `NULL`},
+		{`a / 2`, `float`},
+		{`-f`, `float`},
+		{`a > 2 AND s = 'x'`, `bool`},
+		{`s || 'x'`, `string`},
+		{`upper(s)`, `string`},
+		{`nullif(a, 1)`, `int`},
+		{`coalesce(NULL, f)`, `float`},
+		{`length(NULL)`, `NULL`},
+		{`CASE WHEN a > 1 THEN NULL ELSE f END`, `float`},
+		{`CASE WHEN a > 1 THEN NULL END`, `NULL`},
+		{`CAST(NULL AS INT)`, `int`},
+		{`s::date`, `date`},
+		{`(a, s)`, `tuple`},
+		{`NULL`, `NULL`},
+	}
+	env := mapEnv{"a": DInt(1), "f": DFloat(1), "s": DString("")}
+	for _, d := range testData {
+		expr, err := ParseExpr(d.expr)
+		if err != nil {
+			t.Fatalf("%s: %v", d.expr, err)
+		}
+		r, err := TypeCheckExpr(EvalContext{}, expr, env)
+		if err != nil {
+			t.Fatalf("%s: %v", d.expr, err)
+		}
+		if typ := r.Type(); d.expected != typ {
+			t.Errorf("%s: expected %s, but found %s", d.expr, d.expected, typ)
+		}
+	}
+}
+
+func TestEvalNow(t *testing.T) {
+	expr, err := ParseExpr(`now()`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ts := time.Date(2015, 8, 25, 4, 45, 45, 0, time.UTC)
+	d, err := EvalExpr(EvalContext{StmtTimestamp: ts}, expr, mapEnv{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if v := d.(DTimestamp); !v.Equal(ts) {
+		t.Errorf("expected now() to be the statement timestamp %s, but found %s", ts, v)
+	}
+}
+
+func TestEvalNonDeterministicFuncs(t *testing.T) {
+	var prev DInt
+	for i := 0; i < 10; i++ {
+		expr, err := ParseExpr(`unique_rowid()`)
+		if err != nil {
+			t.Fatal(err)
+		}
+		d, err := EvalExpr(EvalContext{}, expr, mapEnv{})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if v := d.(DInt); v <= prev {
+			t.Fatalf("expected unique_rowid() > %d, but found %d", prev, v)
+		} else {
+			prev = v
+		}
+
+		if expr, err = ParseExpr(`random()`); err != nil {
+			t.Fatal(err)
+		}
+		if d, err = EvalExpr(EvalContext{}, expr, mapEnv{}); err != nil {
+			t.Fatal(err)
+		}
+		if v := d.(DFloat); v < 0 || v >= 1 {
+			t.Fatalf("expected random() in [0, 1), but found %s", v)
+		}
+	}
+}
+
+func TestGenerateUniqueInt(t *testing.T) {
+	const mask = 1<<uniqueIntNodeIDBits - 1
+
+	// The node ID is held by the low bits, so nodes generating integers at
+	// the same time don't collide.
+	a, err := generateUniqueInt(1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	b, err := generateUniqueInt(2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if a&mask != 1 || b&mask != 2 {
+		t.Fatalf("expected node IDs 1 and 2, but found %d and %d", a&mask, b&mask)
+	}
+	if b <= a {
+		t.Fatalf("expected %d > %d", b, a)
+	}
+
+	// The integers keep increasing when the wall time goes backwards.
+	uniqueIntState.Lock()
+	uniqueIntState.timestamp += int64(time.Hour / uniqueIntPrecision)
+	uniqueIntState.Unlock()
+	c, err := generateUniqueInt(1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if c>>uniqueIntNodeIDBits <= b>>uniqueIntNodeIDBits+int64(time.Hour/uniqueIntPrecision) {
+		t.Fatalf("expected %d to be an hour later than %d", c, b)
+	}
+	if d, err := generateUniqueInt(1); err != nil {
+		t.Fatal(err)
+	} else if d <= c {
+		t.Fatalf("expected %d > %d", d, c)
+	}
+
+	// Node IDs which don't fit in the low bits are rejected.
+	if _, err := generateUniqueInt(1 << uniqueIntNodeIDBits); !testutils.IsError(err, "does not fit") {
+		t.Fatalf("expected an error, but found %v", err)
+	}
+}
+
+func TestEvalPatternCache(t *testing.T) {
+	expr, err := ParseExpr(`a LIKE b`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	c := expr.(*ComparisonExpr)
+
+	// The compiled pattern is reused while the pattern is unchanged and
+	// recompiled when it changes.
+	var prev *compiledPattern
+	for i, d := range []struct {
+		a, b      string
+		expected  DBool
+		recompile bool
+	}{
+		{"abc", "a%", true, true},
+		{"bcd", "a%", false, false},
+		{"bcd", "b%", true, true},
+		{"abc", "b%", false, false},
+	} {
+		r, err := EvalExpr(EvalContext{}, c, mapEnv{"a": DString(d.a), "b": DString(d.b)})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if r != d.expected {
+			t.Errorf("%d: expected %s, but found %s", i, d.expected, r)
+		}
+		if recompiled := c.pattern != prev; recompiled != d.recompile {
+			t.Errorf("%d: expected recompile %t, but found %t", i, d.recompile, recompiled)
+		}
+		prev = c.pattern
+	}
+}
+
+func TestDatumCompare(t *testing.T) {
+	testData := []struct {
+		left, right Datum
+		expected    int
+	}{
+		{DNull, DNull, 0},
+		{DNull, DInt(1), -1},
+		{DInt(1), DNull, 1},
+		{DBool(false), DBool(true), -1},
+		{DBool(true), DBool(true), 0},
+		{DInt(1), DInt(2), -1},
+		{DInt(2), DInt(1), 1},
+		{DInt(1), DFloat(1.5), -1},
+		{DFloat(1.5), DInt(1), 1},
+		{DFloat(1), DInt(1), 0},
+		{DString("a"), DString("b"), -1},
+		{DString("b"), DString("b"), 0},
+		{DBytes("\x00"), DBytes("\xff"), -1},
+		{DBytes("a"), DString("a"), -1},
+		{DTuple{DInt(1), DInt(2)}, DTuple{DInt(1), DInt(3)}, -1},
+		{DTuple{DInt(1)}, DTuple{DInt(1), DInt(3)}, -1},
+		{DTuple{DInt(1), DNull}, DTuple{DInt(1), DNull}, 0},
+		{MakeDDate(time.Unix(0, 0)), MakeDDate(time.Unix(86400, 0)), -1},
+		{MakeDDate(time.Unix(0, 0)), DTimestamp{time.Unix(0, 0)}, 0},
+		{DTimestamp{time.Unix(1, 0)}, MakeDDate(time.Unix(0, 0)), 1},
+		{DTime{time.Hour}, DTime{time.Minute}, 1},
+		{DInterval{time.Hour}, DInterval{time.Hour}, 0},
+		{DInterval{time.Hour}, DNull, 1},
+		{DDecimal{decimal.New(150, 2)}, DDecimal{decimal.New(15, 1)}, 0},
+		{DDecimal{decimal.New(15, 1)}, DInt(2), -1},
+		{DInt(2), DDecimal{decimal.New(15, 1)}, 1},
+		{DFloat(1.25), DDecimal{decimal.New(125, 2)}, 0},
+		{DDecimal{decimal.New(1, 0)}, DNull, 1},
+	}
+	for _, d := range testData {
+		if c := d.left.Compare(d.right); c != d.expected {
+			t.Errorf("%s.Compare(%s): expected %d, but found %d", d.left, d.right, d.expected, c)
+		}
+	}
+}
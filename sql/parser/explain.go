@@ -0,0 +1,40 @@
+// Copyright 2015 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License. See the AUTHORS file
+// for names of contributors.
+
+package parser
+
+import (
+	"bytes"
+	"strings"
+)
+
+// Explain represents an EXPLAIN statement.
+type Explain struct {
+	// Options defines how EXPLAIN should operate (VERBOSE, etc.)
+	Options   []string
+	Statement Statement
+}
+
+func (node *Explain) String() string {
+	var buf bytes.Buffer
+	_, _ = buf.WriteString("EXPLAIN ")
+	if len(node.Options) > 0 {
+		_, _ = buf.WriteString("(")
+		_, _ = buf.WriteString(strings.Join(node.Options, ", "))
+		_, _ = buf.WriteString(") ")
+	}
+	_, _ = buf.WriteString(node.Statement.String())
+	return buf.String()
+}
@@ -53,11 +53,19 @@ func (*UnaryExpr) expr()      {}
 func (*FuncExpr) expr()       {}
 func (*CaseExpr) expr()       {}
 func (*CastExpr) expr()       {}
+func (*DReference) expr()     {}
+func (*DSubquery) expr()      {}
 func (*StarExpr) expr()       {}
 func (DBool) expr()           {}
 func (DInt) expr()            {}
 func (DFloat) expr()          {}
+func (DDecimal) expr()        {}
 func (DString) expr()         {}
+func (DBytes) expr()          {}
+func (DDate) expr()           {}
+func (DTime) expr()           {}
+func (DTimestamp) expr()      {}
+func (DInterval) expr()       {}
 func (DTuple) expr()          {}
 func (dNull) expr()           {}
 
@@ -112,6 +120,14 @@ const (
 	NotIn
 	Like
 	NotLike
+	ILike
+	NotILike
+	SimilarTo
+	NotSimilarTo
+	RegMatch
+	NotRegMatch
+	RegIMatch
+	NotRegIMatch
 )
 
 var comparisonOpName = [...]string{
@@ -123,8 +139,16 @@ var comparisonOpName = [...]string{
 	NE:      "!=",
 	In:      "IN",
 	NotIn:   "NOT IN",
-	Like:    "LIKE",
-	NotLike: "NOT LIKE",
+	Like:         "LIKE",
+	NotLike:      "NOT LIKE",
+	ILike:        "ILIKE",
+	NotILike:     "NOT ILIKE",
+	SimilarTo:    "SIMILAR TO",
+	NotSimilarTo: "NOT SIMILAR TO",
+	RegMatch:     "~",
+	NotRegMatch:  "!~",
+	RegIMatch:    "~*",
+	NotRegIMatch: "!~*",
 }
 
 func (i ComparisonOp) String() string {
@@ -138,9 +162,19 @@ func (i ComparisonOp) String() string {
 type ComparisonExpr struct {
 	Operator    ComparisonOp
 	Left, Right Expr
+	// Escape is the escape character of a LIKE, ILIKE or SIMILAR TO pattern,
+	// or nil if the default escape character is used.
+	Escape Expr
+
+	// The compiled pattern of the most recent evaluation of a pattern
+	// matching operator, which is reused while the pattern is unchanged.
+	pattern *compiledPattern
 }
 
 func (node *ComparisonExpr) String() string {
+	if node.Escape != nil {
+		return fmt.Sprintf("%s %s %s ESCAPE %s", node.Left, node.Operator, node.Right, node.Escape)
+	}
 	return fmt.Sprintf("%s %s %s", node.Left, node.Operator, node.Right)
 }
 
@@ -481,3 +515,34 @@ type CastExpr struct {
 func (n *CastExpr) String() string {
 	return fmt.Sprintf("CAST(%s AS %s)", n.Expr, n.Type)
 }
+
+// nowFuncExpr returns the now() function call to which CURRENT_TIMESTAMP
+// and its relatives are translated.
+func nowFuncExpr() *FuncExpr {
+	return &FuncExpr{Name: &QualifiedName{Base: Name("now")}}
+}
+
+// DReference is a reference to a Datum which is computed outside of
+// expression evaluation, such as the result of an aggregate function. The
+// referenced Datum may change between evaluations of the expression.
+type DReference struct {
+	Expr  Expr   // the replaced expression, used for formatting
+	Datum *Datum // the current value of the expression
+}
+
+func (n *DReference) String() string {
+	return n.Expr.String()
+}
+
+// DSubquery is a subquery which refers to columns of the enclosing query. It
+// is evaluated for each row of the enclosing query by passing the values of
+// the referenced columns to Eval.
+type DSubquery struct {
+	Expr Expr  // the *Subquery or *ExistsExpr, used for formatting
+	Args Exprs // the referenced columns of the enclosing query
+	Eval func(args DTuple) (Datum, error)
+}
+
+func (n *DSubquery) String() string {
+	return n.Expr.String()
+}
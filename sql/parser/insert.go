@@ -28,16 +28,26 @@ import (
 	"fmt"
 )
 
-// Insert represents an INSERT statement.
+// Insert represents an INSERT or UPSERT statement.
 type Insert struct {
-	Table   *QualifiedName
-	Columns QualifiedNames
-	Rows    SelectStatement
+	Table      *QualifiedName
+	Columns    QualifiedNames
+	Rows       SelectStatement
+	OnConflict *OnConflict
+	// Upsert is true for an UPSERT statement, which replaces the values of
+	// the row with the same primary key, if any.
+	Upsert    bool
+	Returning SelectExprs
 }
 
 func (node *Insert) String() string {
 	var buf bytes.Buffer
-	fmt.Fprintf(&buf, "INSERT INTO %s", node.Table)
+	if node.Upsert {
+		_, _ = buf.WriteString("UPSERT")
+	} else {
+		_, _ = buf.WriteString("INSERT")
+	}
+	fmt.Fprintf(&buf, " INTO %s", node.Table)
 	if node.Columns != nil {
 		fmt.Fprintf(&buf, "(%s)", node.Columns)
 	}
@@ -46,5 +56,41 @@ func (node *Insert) String() string {
 	} else {
 		fmt.Fprintf(&buf, " %s", node.Rows)
 	}
+	if node.OnConflict != nil {
+		_, _ = buf.WriteString(node.OnConflict.String())
+	}
+	_, _ = buf.WriteString(returningString(node.Returning))
+	return buf.String()
+}
+
+// returningString formats the RETURNING clause of an INSERT, UPDATE or
+// DELETE statement.
+func returningString(exprs SelectExprs) string {
+	if exprs == nil {
+		return ""
+	}
+	return fmt.Sprintf(" RETURNING%s", exprs)
+}
+
+// OnConflict represents the ON CONFLICT clause of an INSERT statement. An
+// empty Columns list matches a conflict on any unique index.
+type OnConflict struct {
+	Columns   NameList
+	DoNothing bool
+	Exprs     UpdateExprs
+	Where     *Where
+}
+
+func (node *OnConflict) String() string {
+	var buf bytes.Buffer
+	_, _ = buf.WriteString(" ON CONFLICT")
+	if len(node.Columns) > 0 {
+		fmt.Fprintf(&buf, " (%s)", node.Columns)
+	}
+	if node.DoNothing {
+		_, _ = buf.WriteString(" DO NOTHING")
+	} else {
+		fmt.Fprintf(&buf, " DO UPDATE SET %s%s", node.Exprs, node.Where)
+	}
 	return buf.String()
 }
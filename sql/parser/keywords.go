@@ -49,12 +49,15 @@ var keywords = map[string]int{
 	"BEGIN":             BEGIN,
 	"BETWEEN":           BETWEEN,
 	"BIGINT":            BIGINT,
+	"BIGSERIAL":         BIGSERIAL,
 	"BINARY":            BINARY,
 	"BIT":               BIT,
 	"BLOB":              BLOB,
 	"BOOLEAN":           BOOLEAN,
 	"BOTH":              BOTH,
 	"BY":                BY,
+	"BYTEA":             BYTEA,
+	"BYTES":             BYTES,
 	"CACHE":             CACHE,
 	"CALLED":            CALLED,
 	"CASCADE":           CASCADE,
@@ -181,6 +184,7 @@ var keywords = map[string]int{
 	"HOUR":              HOUR,
 	"IDENTITY":          IDENTITY,
 	"IF":                IF,
+	"ILIKE":             ILIKE,
 	"IMMEDIATE":         IMMEDIATE,
 	"IMMUTABLE":         IMMUTABLE,
 	"IMPLICIT":          IMPLICIT,
@@ -334,6 +338,7 @@ var keywords = map[string]int{
 	"SELECT":            SELECT,
 	"SEQUENCE":          SEQUENCE,
 	"SEQUENCES":         SEQUENCES,
+	"SERIAL":            SERIAL,
 	"SERIALIZABLE":      SERIALIZABLE,
 	"SERVER":            SERVER,
 	"SESSION":           SESSION,
@@ -347,6 +352,7 @@ var keywords = map[string]int{
 	"SIMPLE":            SIMPLE,
 	"SKIP":              SKIP,
 	"SMALLINT":          SMALLINT,
+	"SMALLSERIAL":       SMALLSERIAL,
 	"SNAPSHOT":          SNAPSHOT,
 	"SOME":              SOME,
 	"SQL":               SQL,
@@ -397,6 +403,7 @@ var keywords = map[string]int{
 	"UNLOGGED":          UNLOGGED,
 	"UNTIL":             UNTIL,
 	"UPDATE":            UPDATE,
+	"UPSERT":            UPSERT,
 	"USER":              USER,
 	"USING":             USING,
 	"VACUUM":            VACUUM,
@@ -26,6 +26,7 @@ package parser
 import (
 	"bytes"
 	"errors"
+	"fmt"
 )
 
 //go:generate make
@@ -52,3 +53,23 @@ func Parse(sql string) (StatementList, error) {
 	}
 	return s.stmts, nil
 }
+
+// ParseExpr parses the sql of a single expression.
+func ParseExpr(sql string) (Expr, error) {
+	stmts, err := Parse("SELECT " + sql)
+	if err != nil {
+		return nil, err
+	}
+	if len(stmts) != 1 {
+		return nil, fmt.Errorf("expected 1 expression, but found %d statements", len(stmts))
+	}
+	sel, ok := stmts[0].(*Select)
+	if !ok || len(sel.Exprs) != 1 || sel.From != nil {
+		return nil, fmt.Errorf("expected 1 expression: %s", sql)
+	}
+	expr, ok := sel.Exprs[0].(*NonStarExpr)
+	if !ok {
+		return nil, fmt.Errorf("expected 1 expression: %s", sql)
+	}
+	return expr.Expr, nil
+}
@@ -33,6 +33,12 @@ func TestParse(t *testing.T) {
 		{``},
 		{`VALUES ("")`},
 
+		{`BEGIN TRANSACTION`},
+		{`BEGIN TRANSACTION ISOLATION LEVEL SNAPSHOT`},
+		{`BEGIN TRANSACTION ISOLATION LEVEL SERIALIZABLE`},
+		{`COMMIT TRANSACTION`},
+		{`ROLLBACK TRANSACTION`},
+
 		{`CREATE DATABASE a`},
 		{`CREATE DATABASE IF NOT EXISTS a`},
 		{`CREATE TABLE a ()`},
@@ -41,22 +47,49 @@ func TestParse(t *testing.T) {
 		{`CREATE TABLE a (b CHAR)`},
 		{`CREATE TABLE a (b CHAR(3))`},
 		{`CREATE TABLE a (b FLOAT)`},
+		{`CREATE TABLE a (b DECIMAL, c DECIMAL(10), d NUMERIC(10,2))`},
 		{`CREATE TABLE a (b INT NULL)`},
 		{`CREATE TABLE a (b INT NOT NULL)`},
 		{`CREATE TABLE a (b INT PRIMARY KEY)`},
+		{`CREATE TABLE a (b SERIAL PRIMARY KEY, c SMALLSERIAL, d BIGSERIAL)`},
+		{`CREATE TABLE a (b DATE, c TIME, d TIMESTAMP, e INTERVAL)`},
 		{`CREATE TABLE a (b INT UNIQUE)`},
 		{`CREATE TABLE a (b INT NULL PRIMARY KEY)`},
+		{`CREATE TABLE a (b INT DEFAULT 1)`},
+		{`CREATE TABLE a (b INT NOT NULL DEFAULT 'foo' UNIQUE)`},
+		{`CREATE TABLE a (b INT CHECK (b > 0))`},
+		{`CREATE TABLE a (b INT DEFAULT 1 CHECK (b > 0) CHECK (b < 10))`},
+		{`CREATE TABLE a (b INT, c INT, CHECK (b < c))`},
+		{`CREATE TABLE a (b INT, c INT, CONSTRAINT d CHECK (b + c > 0))`},
+		{`CREATE TABLE a (b INT REFERENCES c)`},
+		{`CREATE TABLE a (b INT REFERENCES c (d) ON DELETE CASCADE)`},
+		{`CREATE TABLE a (b INT REFERENCES c.d (e) ON DELETE SET NULL ON UPDATE RESTRICT)`},
+		{`CREATE TABLE a (b INT, c INT, FOREIGN KEY (b, c) REFERENCES d (e, f))`},
+		{`CREATE TABLE a (b INT, CONSTRAINT c FOREIGN KEY (b) REFERENCES d ON UPDATE CASCADE)`},
+		{`CREATE TABLE a (b INT DEFAULT length('foo'))`},
 		// "0" lost quotes previously.
 		{`CREATE TABLE a (b INT, c TEXT, PRIMARY KEY (b, c, "0"))`},
 		{`CREATE TABLE a (b INT, c TEXT, INDEX (b, c))`},
 		{`CREATE TABLE a (b INT, c TEXT, CONSTRAINT d INDEX (b, c))`},
+		{`CREATE INDEX a ON b (c)`},
+		{`CREATE INDEX a ON b.c (d)`},
+		{`CREATE INDEX ON a (b)`},
+		{`CREATE UNIQUE INDEX a ON b (c)`},
+		{`CREATE UNIQUE INDEX a ON b.c (d)`},
+		{`CREATE INDEX IF NOT EXISTS a ON b (c, d)`},
+		{`CREATE UNIQUE INDEX IF NOT EXISTS a ON b (c)`},
 		{`CREATE TABLE a (b INT, UNIQUE (b))`},
 		{`CREATE TABLE a.b (b INT)`},
 		{`CREATE TABLE IF NOT EXISTS a (b INT)`},
+		{`CREATE TABLE a AS SELECT b, c FROM d`},
+		{`CREATE TABLE a.b (c, d) AS SELECT * FROM e`},
+		{`CREATE TABLE IF NOT EXISTS a AS SELECT b FROM c WHERE d > 1`},
 
 		{`DELETE FROM a`},
 		{`DELETE FROM a.b`},
 		{`DELETE FROM a WHERE a = b`},
+		{`DELETE FROM a WHERE a = b RETURNING a, b`},
+		{`DELETE FROM a RETURNING *`},
 
 		{`DROP DATABASE a`},
 		{`DROP DATABASE IF EXISTS a`},
@@ -64,6 +97,28 @@ func TestParse(t *testing.T) {
 		{`DROP TABLE a.b`},
 		{`DROP TABLE a, b`},
 		{`DROP TABLE IF EXISTS a`},
+		{`DROP INDEX a.b`},
+		{`DROP INDEX a.b, c.d.e`},
+		{`DROP INDEX IF EXISTS a.b`},
+
+		{`ALTER TABLE a ADD b INT`},
+		{`ALTER TABLE a ADD COLUMN b INT NOT NULL DEFAULT 1`},
+		{`ALTER TABLE IF EXISTS a ADD COLUMN b INT, ADD COLUMN c CHAR`},
+		{`ALTER TABLE a DROP b`},
+		{`ALTER TABLE a DROP COLUMN b, DROP COLUMN IF EXISTS c`},
+		{`ALTER TABLE IF EXISTS a.b DROP COLUMN c`},
+
+		{`ALTER DATABASE a RENAME TO b`},
+		{`ALTER TABLE a RENAME TO b`},
+		{`ALTER TABLE a.b RENAME TO c.d`},
+		{`ALTER TABLE IF EXISTS a RENAME TO b`},
+		{`ALTER TABLE a RENAME COLUMN b TO c`},
+		{`ALTER TABLE IF EXISTS a.b RENAME COLUMN c TO d`},
+
+		{`EXPLAIN SELECT 1`},
+		{`EXPLAIN (DEBUG) SELECT 1`},
+		{`EXPLAIN (A, B, C) SELECT 1`},
+		{`EXPLAIN DELETE FROM a WHERE b = 1`},
 
 		{`SHOW DATABASES`},
 		{`SHOW TABLES`},
@@ -112,6 +167,15 @@ func TestParse(t *testing.T) {
 		{`INSERT INTO a(a, a.b) VALUES (1, 2)`},
 		{`INSERT INTO a SELECT b, c FROM d`},
 		{`INSERT INTO a DEFAULT VALUES`},
+		{`INSERT INTO a VALUES (1, 2) RETURNING a, b + 1 AS c`},
+		{`INSERT INTO a VALUES (1) ON CONFLICT DO NOTHING RETURNING *`},
+		{`UPSERT INTO a VALUES (1, 2) RETURNING a.b`},
+		{`INSERT INTO a VALUES (1) ON CONFLICT DO NOTHING`},
+		{`INSERT INTO a VALUES (1, 2) ON CONFLICT (a) DO NOTHING`},
+		{`INSERT INTO a VALUES (1, 2) ON CONFLICT (a, b) DO UPDATE SET b = excluded.b`},
+		{`INSERT INTO a VALUES (1, 2) ON CONFLICT (a) DO UPDATE SET b = b + 1 WHERE b < 3`},
+		{`UPSERT INTO a VALUES (1, 2)`},
+		{`UPSERT INTO a(a, b) SELECT b, c FROM d`},
 
 		{`SELECT 1 + 1`},
 		{`SELECT - - 5`},
@@ -169,8 +233,22 @@ func TestParse(t *testing.T) {
 		{`SELECT a FROM "t\n"`}, // no escaping in sql identifiers
 		{`SELECT a FROM "t"""`}, // no escaping in sql identifiers
 
+		{`SELECT x'00ff' FROM t`},
+		{`SELECT x'' || x'41' FROM t`},
+
 		{`SELECT "FROM" FROM t`},
 		{`SELECT CAST(1 AS TEXT)`},
+		{`SELECT CAST('a' AS BYTES)`},
+		{`SELECT CAST('1h' AS INTERVAL)`},
+		{`SELECT now()`},
+		{`SELECT "coalesce"(a, b, c)`},
+		{`SELECT "nullif"(a, b)`},
+		{`SELECT "greatest"(a, b)`},
+		{`SELECT "least"(a, b)`},
+		{`SELECT "extract"('YEAR', a)`},
+		{`SELECT "substring"(a, 2, 3)`},
+		{`SELECT btrim(a)`},
+		{`SELECT strpos(a, 'b')`},
 		{`SELECT FROM t AS bar`},
 		{`SELECT FROM (SELECT 1 FROM t)`},
 		{`SELECT FROM (SELECT 1 FROM t) AS bar`},
@@ -180,6 +258,13 @@ func TestParse(t *testing.T) {
 
 		{`SELECT DISTINCT 1 FROM t`},
 		{`SELECT COUNT(DISTINCT a) FROM t`},
+		{`SELECT COUNT(*) FROM t`},
+
+		{`SELECT 1 FROM t GROUP BY a`},
+		{`SELECT 1 FROM t GROUP BY a, b + 1`},
+		{`SELECT a, SUM(b) FROM t WHERE c > 1 GROUP BY a HAVING SUM(b) > 10`},
+		{`SELECT COUNT(*) FROM t HAVING COUNT(*) > 1`},
+		{`SELECT a FROM t GROUP BY a ORDER BY a LIMIT 1`},
 
 		{`SELECT FROM t WHERE b = - 2`},
 		{`SELECT FROM t WHERE a = b`},
@@ -196,6 +281,16 @@ func TestParse(t *testing.T) {
 		{`SELECT FROM t WHERE a NOT IN (b, c)`},
 		{`SELECT FROM t WHERE a LIKE b`},
 		{`SELECT FROM t WHERE a NOT LIKE b`},
+		{`SELECT FROM t WHERE a LIKE b ESCAPE c`},
+		{`SELECT FROM t WHERE a NOT LIKE b ESCAPE c`},
+		{`SELECT FROM t WHERE a ILIKE b`},
+		{`SELECT FROM t WHERE a NOT ILIKE b ESCAPE c`},
+		{`SELECT FROM t WHERE a SIMILAR TO b`},
+		{`SELECT FROM t WHERE a NOT SIMILAR TO b ESCAPE c`},
+		{`SELECT FROM t WHERE a ~ b`},
+		{`SELECT FROM t WHERE a !~ b`},
+		{`SELECT FROM t WHERE a ~* b`},
+		{`SELECT FROM t WHERE a !~* b`},
 		{`SELECT FROM t WHERE a BETWEEN b AND c`},
 		{`SELECT FROM t WHERE a NOT BETWEEN b AND c`},
 		{`SELECT FROM t WHERE a IS NULL`},
@@ -235,6 +330,12 @@ func TestParse(t *testing.T) {
 		{`SELECT FROM t UNION SELECT 1 FROM t UNION SELECT 1 FROM t`},
 		{`SELECT FROM t EXCEPT SELECT 1 FROM t`},
 		{`SELECT FROM t INTERSECT SELECT 1 FROM t`},
+		{`SELECT FROM t UNION ALL SELECT 1 FROM t`},
+		{`SELECT FROM t EXCEPT ALL SELECT 1 FROM t`},
+		{`SELECT FROM t INTERSECT ALL SELECT 1 FROM t`},
+		{`SELECT FROM t UNION SELECT 1 FROM t ORDER BY 1 DESC`},
+		{`SELECT FROM t UNION SELECT 1 FROM t LIMIT 1 OFFSET 2`},
+		{`SELECT FROM t EXCEPT SELECT 1 FROM t ORDER BY a LIMIT 1`},
 
 		{`SELECT FROM t1 JOIN t2 ON a = b`},
 		{`SELECT FROM t1 JOIN t2 USING (a)`},
@@ -246,6 +347,12 @@ func TestParse(t *testing.T) {
 		{`SELECT FROM t1 INNER JOIN t2 USING (a)`},
 		{`SELECT FROM t1 FULL JOIN t2 USING (a)`},
 
+		{`SELECT FROM t ORDER BY a`},
+		{`SELECT FROM t ORDER BY a ASC`},
+		{`SELECT FROM t ORDER BY a DESC`},
+		{`SELECT FROM t ORDER BY a, b DESC, c ASC`},
+		{`SELECT FROM t ORDER BY a + b DESC`},
+		{`SELECT FROM t ORDER BY a LIMIT b`},
 		{`SELECT FROM t LIMIT a`},
 		{`SELECT FROM t OFFSET b`},
 		{`SELECT FROM t LIMIT a OFFSET b`},
@@ -254,6 +361,8 @@ func TestParse(t *testing.T) {
 		{`SET a = 3, 4`},
 		{`SET a = '3'`},
 		{`SET a = 3.0`},
+		{`SET TRANSACTION ISOLATION LEVEL SNAPSHOT`},
+		{`SET TRANSACTION ISOLATION LEVEL SERIALIZABLE`},
 
 		// TODO(pmattis): Is this a postgres extension?
 		{`TABLE a`}, // Shorthand for: SELECT * FROM a
@@ -267,6 +376,7 @@ func TestParse(t *testing.T) {
 		{`UPDATE a SET b = 3, c = 4`},
 		{`UPDATE a SET b = 3 + 4`},
 		{`UPDATE a SET b = 3 WHERE a = b`},
+		{`UPDATE a SET b = 3 WHERE a = b RETURNING a, b AS c`},
 		{`UPDATE T AS "0" SET K = ''`},                 // "0" lost its quotes
 		{`SELECT * FROM "0" JOIN "0" USING (id, "0")`}, // last "0" lost its quotes.
 	}
@@ -290,6 +400,19 @@ func TestParse2(t *testing.T) {
 		sql      string
 		expected string
 	}{
+		{`SELECT EXTRACT(YEAR FROM a)`, `SELECT "extract"('YEAR', a)`},
+		{`SELECT EXTRACT(epoch FROM a)`, `SELECT "extract"('epoch', a)`},
+		{`SELECT SUBSTRING(a FROM 2 FOR 3)`, `SELECT "substring"(a, 2, 3)`},
+		{`SELECT SUBSTRING(a FOR 3 FROM 2)`, `SELECT "substring"(a, 2, 3)`},
+		{`SELECT SUBSTRING(a FROM 2)`, `SELECT "substring"(a, 2)`},
+		{`SELECT SUBSTRING(a FOR 3)`, `SELECT "substring"(a, 1, 3)`},
+		{`SELECT TRIM(a)`, `SELECT btrim(a)`},
+		{`SELECT TRIM(BOTH 'x' FROM a)`, `SELECT btrim(a, 'x')`},
+		{`SELECT TRIM(LEADING 'x' FROM a)`, `SELECT ltrim(a, 'x')`},
+		{`SELECT TRIM(TRAILING FROM a)`, `SELECT rtrim(a)`},
+		{`SELECT POSITION('b' IN a)`, `SELECT strpos(a, 'b')`},
+		{`SELECT COALESCE(a, b)`, `SELECT "coalesce"(a, b)`},
+		{`SELECT NULLIF(a, b)`, `SELECT "nullif"(a, b)`},
 		// TODO(pmattis): Handle octal and hexadecimal numbers.
 		// {`SELECT 010 FROM t`, ``},
 		// {`SELECT 0xf0 FROM t`, ``},
@@ -309,6 +432,11 @@ func TestParse2(t *testing.T) {
 			`SELECT e'\n\\'`},
 		{`SELECT "a'a" FROM t`,
 			`SELECT "a'a" FROM t`},
+		// Bytes literals are always formatted as hexadecimal.
+		{`SELECT b'a\x00'`,
+			`SELECT x'6100'`},
+		{`SELECT X'0A'`,
+			`SELECT x'0a'`},
 		// Comments are stripped.
 		{`SELECT 1 FROM t -- hello world`,
 			`SELECT 1 FROM t`},
@@ -316,23 +444,63 @@ func TestParse2(t *testing.T) {
 			`SELECT 1 FROM t`},
 		{`SELECT /* hello */ 1 FROM /* world */ t`,
 			`SELECT 1 FROM t`},
+		// The default NO ACTION is not printed and ON DELETE precedes ON UPDATE.
+		{`CREATE TABLE a (b INT REFERENCES c ON UPDATE CASCADE ON DELETE NO ACTION)`,
+			`CREATE TABLE a (b INT REFERENCES c ON UPDATE CASCADE)`},
+		{`CREATE TABLE a (b INT REFERENCES c ON UPDATE SET DEFAULT ON DELETE RESTRICT)`,
+			`CREATE TABLE a (b INT REFERENCES c ON DELETE RESTRICT ON UPDATE SET DEFAULT)`},
+		// Typed literals are casts of strings.
+		{`SELECT DATE '2015-08-25'`,
+			`SELECT CAST('2015-08-25' AS DATE)`},
+		{`SELECT TIMESTAMP WITHOUT TIME ZONE '2015-08-25 16:30:00'`,
+			`SELECT CAST('2015-08-25 16:30:00' AS TIMESTAMP)`},
+		{`SELECT INTERVAL '1 day' DAY`,
+			`SELECT CAST('1 day' AS INTERVAL)`},
+		{`SELECT NUMERIC(10,2) '12.50'`,
+			`SELECT CAST('12.50' AS NUMERIC(10,2))`},
+		// The current date and time are computed from now().
+		{`SELECT CURRENT_TIMESTAMP, LOCALTIMESTAMP(3)`,
+			`SELECT now(), now()`},
+		{`SELECT CURRENT_DATE, CURRENT_TIME`,
+			`SELECT CAST(now() AS DATE), CAST(now() AS TIME)`},
 		// Alias expressions are always output using AS.
 		{`SELECT 1 FROM t t1`,
 			`SELECT 1 FROM t AS t1`},
 		// Alternate not-equal operator.
 		{`SELECT FROM t WHERE a <> b`,
 			`SELECT FROM t WHERE a != b`},
+		// EXPLAIN VERBOSE is shorthand for EXPLAIN (VERBOSE).
+		{`EXPLAIN VERBOSE SELECT 1`,
+			`EXPLAIN (VERBOSE) SELECT 1`},
 		// OUTER is syntactic sugar.
 		{`SELECT FROM t1 LEFT OUTER JOIN t2 ON a = b`,
 			`SELECT FROM t1 LEFT JOIN t2 ON a = b`},
 		{`SELECT FROM t1 RIGHT OUTER JOIN t2 ON a = b`,
 			`SELECT FROM t1 RIGHT JOIN t2 ON a = b`},
-		// TODO(pmattis): Handle UNION ALL.
-		{`SELECT FROM t UNION ALL SELECT 1 FROM t`,
+		// DISTINCT is the default for set operations.
+		{`SELECT FROM t UNION DISTINCT SELECT 1 FROM t`,
 			`SELECT FROM t UNION SELECT 1 FROM t`},
 		// We allow OFFSET before LIMIT, but always output LIMIT first.
 		{`SELECT FROM t OFFSET a LIMIT b`,
 			`SELECT FROM t LIMIT b OFFSET a`},
+		// The transaction statements have several aliases.
+		{`BEGIN`,
+			`BEGIN TRANSACTION`},
+		{`START TRANSACTION`,
+			`BEGIN TRANSACTION`},
+		{`COMMIT`,
+			`COMMIT TRANSACTION`},
+		{`END WORK`,
+			`COMMIT TRANSACTION`},
+		{`ROLLBACK`,
+			`ROLLBACK TRANSACTION`},
+		{`ABORT`,
+			`ROLLBACK TRANSACTION`},
+		// Isolation levels weaker than SNAPSHOT are upgraded to SNAPSHOT.
+		{`BEGIN ISOLATION LEVEL READ COMMITTED`,
+			`BEGIN TRANSACTION ISOLATION LEVEL SNAPSHOT`},
+		{`SET TRANSACTION ISOLATION LEVEL REPEATABLE READ`,
+			`SET TRANSACTION ISOLATION LEVEL SNAPSHOT`},
 		// Shorthand type cast.
 		{`SELECT '1'::INT`,
 			`SELECT CAST('1' AS INT)`},
@@ -375,18 +543,12 @@ func TestParseSyntax(t *testing.T) {
 		{`SELECT ((1)) FROM t WHERE ((a)) IN (((1))) AND ((a, b)) IN ((((1, 1))), ((2, 2)))`},
 		{`SELECT e'\'\"\b\n\r\t\\' FROM t`},
 		{`SELECT '\x' FROM t`},
-		{`SELECT 1 FROM t GROUP BY a`},
-		{`SELECT 1 FROM t ORDER BY a`},
-		{`SELECT 1 FROM t ORDER BY a ASC`},
-		{`SELECT 1 FROM t ORDER BY a DESC`},
-		{`CREATE INDEX a ON b (c)`},
-		{`CREATE INDEX a ON b.c (d)`},
-		{`CREATE INDEX ON a (b)`},
-		{`CREATE UNIQUE INDEX a ON b (c)`},
-		{`CREATE UNIQUE INDEX a ON b.c (d)`},
 		{`CREATE UNIQUE INDEX a ON b USING foo (c)`},
-		{`DROP INDEX a`},
-		{`DROP INDEX IF EXISTS a`},
+		{`CREATE INDEX CONCURRENTLY a ON b (c)`},
+		{`CREATE INDEX a ON b (c ASC, d DESC)`},
+		{`DROP INDEX a CASCADE`},
+		{`ALTER TABLE a DROP COLUMN b CASCADE`},
+		{`ALTER TABLE a RENAME b TO c`},
 	}
 	for _, d := range testData {
 		if _, err := Parse(d.sql); err != nil {
@@ -447,6 +609,26 @@ CREATE DATABASE a b c
 			`invalid floating point constant
 SELECT 1e-
        ^
+`},
+		{`SELECT a FROM t GROUP BY ()`,
+			`unimplemented: empty grouping set at or near ")"
+SELECT a FROM t GROUP BY ()
+                          ^
+`},
+		{`SELECT a FROM t ORDER BY a USING <`,
+			`unimplemented: ORDER BY USING at or near "EOF"
+SELECT a FROM t ORDER BY a USING <
+                                  ^
+`},
+		{`(SELECT a FROM t ORDER BY a) ORDER BY a`,
+			`multiple ORDER BY clauses not allowed at or near "EOF"
+(SELECT a FROM t ORDER BY a) ORDER BY a
+                                       ^
+`},
+		{`(SELECT a FROM t LIMIT 1) LIMIT 2`,
+			`multiple LIMIT clauses not allowed at or near "EOF"
+(SELECT a FROM t LIMIT 1) LIMIT 2
+                                 ^
 `},
 		{"SELECT foo''",
 			`syntax error at or near ""
@@ -0,0 +1,227 @@
+// Copyright 2015 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License. See the AUTHORS file
+// for names of contributors.
+
+package parser
+
+import (
+	"bytes"
+	"fmt"
+	"regexp"
+	"unicode/utf8"
+)
+
+// DefaultEscape is the escape character of a LIKE, ILIKE or SIMILAR TO
+// pattern which does not specify one with ESCAPE.
+const DefaultEscape = `\`
+
+// compiledPattern holds the regular expression compiled from the pattern of a
+// pattern matching operator and the pattern and escape it was compiled from.
+type compiledPattern struct {
+	pattern string
+	escape  string
+	re      *regexp.Regexp
+}
+
+func isPatternOp(op ComparisonOp) bool {
+	switch op {
+	case Like, NotLike, ILike, NotILike, SimilarTo, NotSimilarTo,
+		RegMatch, NotRegMatch, RegIMatch, NotRegIMatch:
+		return true
+	}
+	return false
+}
+
+// evalPatternMatch evaluates a LIKE, ILIKE, SIMILAR TO or regular expression
+// match of the left string against the right pattern. The compiled pattern
+// is cached in the expression so that a constant pattern is compiled only
+// once per statement.
+func evalPatternMatch(ctx EvalContext, expr *ComparisonExpr, left, right Datum, env Env) (Datum, error) {
+	if left == DNull || right == DNull {
+		return DNull, nil
+	}
+	s, ok := left.(DString)
+	p, ok2 := right.(DString)
+	if !ok || !ok2 {
+		return DNull, fmt.Errorf("unsupported comparison operator: <%s> %s <%s>",
+			left.Type(), expr.Operator, right.Type())
+	}
+
+	escape := DefaultEscape
+	if expr.Escape != nil {
+		d, err := EvalExpr(ctx, expr.Escape, env)
+		if err != nil {
+			return DNull, err
+		}
+		if d == DNull {
+			return DNull, nil
+		}
+		e, ok := d.(DString)
+		if !ok || utf8.RuneCountInString(string(e)) > 1 {
+			return DNull, fmt.Errorf("invalid escape string: %s", d)
+		}
+		escape = string(e)
+	}
+
+	c := expr.pattern
+	if c == nil || c.pattern != string(p) || c.escape != escape {
+		re, err := compilePattern(expr.Operator, string(p), escape)
+		if err != nil {
+			return DNull, err
+		}
+		c = &compiledPattern{pattern: string(p), escape: escape, re: re}
+		expr.pattern = c
+	}
+
+	match := c.re.MatchString(string(s))
+	switch expr.Operator {
+	case NotLike, NotILike, NotSimilarTo, NotRegMatch, NotRegIMatch:
+		match = !match
+	}
+	return DBool(match), nil
+}
+
+// compilePattern translates the pattern of a pattern matching operator into a
+// regular expression and compiles it. An empty escape disables escaping.
+func compilePattern(op ComparisonOp, pattern, escape string) (*regexp.Regexp, error) {
+	var expr string
+	var err error
+	switch op {
+	case Like, NotLike:
+		expr, err = likeToRegexp(pattern, escape)
+	case ILike, NotILike:
+		expr, err = likeToRegexp(pattern, escape)
+		expr = "(?i)" + expr
+	case SimilarTo, NotSimilarTo:
+		expr, err = similarToRegexp(pattern, escape)
+	case RegMatch, NotRegMatch:
+		expr = pattern
+	case RegIMatch, NotRegIMatch:
+		expr = "(?i)" + pattern
+	default:
+		return nil, fmt.Errorf("unsupported pattern matching operator: %s", op)
+	}
+	if err != nil {
+		return nil, err
+	}
+	re, err := regexp.Compile(expr)
+	if err != nil {
+		return nil, fmt.Errorf("invalid regular expression: %s", err)
+	}
+	return re, nil
+}
+
+// likeToRegexp translates a LIKE pattern into an anchored regular expression.
+// "%" matches any sequence of characters and "_" matches any single
+// character; every other character, including an escaped "%" or "_", matches
+// itself.
+func likeToRegexp(pattern, escape string) (string, error) {
+	var buf bytes.Buffer
+	_, _ = buf.WriteString("^(?s:")
+	for i := 0; i < len(pattern); {
+		r, n := utf8.DecodeRuneInString(pattern[i:])
+		c := pattern[i : i+n]
+		i += n
+		switch {
+		case c == escape:
+			if i == len(pattern) {
+				return "", fmt.Errorf("LIKE pattern must not end with escape character")
+			}
+			_, n = utf8.DecodeRuneInString(pattern[i:])
+			_, _ = buf.WriteString(regexp.QuoteMeta(pattern[i : i+n]))
+			i += n
+		case r == '%':
+			_, _ = buf.WriteString(".*")
+		case r == '_':
+			_ = buf.WriteByte('.')
+		default:
+			_, _ = buf.WriteString(regexp.QuoteMeta(c))
+		}
+	}
+	_, _ = buf.WriteString(")$")
+	return buf.String(), nil
+}
+
+// similarToRegexp translates a SIMILAR TO pattern into an anchored regular
+// expression. SIMILAR TO patterns are regular expressions in which "%" and
+// "_" are the LIKE wildcards and ".", "^" and "$" are not special.
+func similarToRegexp(pattern, escape string) (string, error) {
+	var buf bytes.Buffer
+	_, _ = buf.WriteString("^(?s:")
+	inBracket := false
+	for i := 0; i < len(pattern); {
+		r, n := utf8.DecodeRuneInString(pattern[i:])
+		c := pattern[i : i+n]
+		i += n
+		switch {
+		case c == escape:
+			if i == len(pattern) {
+				return "", fmt.Errorf("SIMILAR TO pattern must not end with escape character")
+			}
+			_, n = utf8.DecodeRuneInString(pattern[i:])
+			_, _ = buf.WriteString(regexp.QuoteMeta(pattern[i : i+n]))
+			i += n
+		case inBracket:
+			// The characters of a bracket expression are passed through.
+			if r == ']' {
+				inBracket = false
+			}
+			if r == '\\' {
+				_ = buf.WriteByte('\\')
+			}
+			_, _ = buf.WriteString(c)
+		case r == '[':
+			inBracket = true
+			_ = buf.WriteByte('[')
+		case r == '%':
+			_, _ = buf.WriteString(".*")
+		case r == '_':
+			_ = buf.WriteByte('.')
+		case r == '(':
+			_, _ = buf.WriteString("(?:")
+		case r == '.', r == '^', r == '$', r == '\\':
+			_, _ = buf.WriteString(regexp.QuoteMeta(c))
+		default:
+			_, _ = buf.WriteString(c)
+		}
+	}
+	_, _ = buf.WriteString(")$")
+	return buf.String(), nil
+}
+
+// LikePrefix returns the prefix of every string matched by the LIKE pattern,
+// which is the pattern up to its first wildcard. Exact is true if the pattern
+// contains no wildcards and so matches only the prefix itself.
+func LikePrefix(pattern, escape string) (prefix string, exact bool) {
+	var buf bytes.Buffer
+	for i := 0; i < len(pattern); {
+		_, n := utf8.DecodeRuneInString(pattern[i:])
+		c := pattern[i : i+n]
+		i += n
+		switch {
+		case c == escape:
+			if i == len(pattern) {
+				return buf.String(), false
+			}
+			_, n = utf8.DecodeRuneInString(pattern[i:])
+			_, _ = buf.WriteString(pattern[i : i+n])
+			i += n
+		case c == "%", c == "_":
+			return buf.String(), false
+		default:
+			_, _ = buf.WriteString(c)
+		}
+	}
+	return buf.String(), true
+}
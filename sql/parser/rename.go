@@ -23,17 +23,56 @@
 
 package parser
 
-import "fmt"
+import "bytes"
 
-// TODO(tschottdorf): This isn't even referenced from the grammar yet.
-func (*RenameTable) statement() {}
+// RenameDatabase represents a RENAME DATABASE statement.
+type RenameDatabase struct {
+	Name    Name
+	NewName Name
+}
+
+func (node *RenameDatabase) String() string {
+	return "ALTER DATABASE " + node.Name.String() + " RENAME TO " + node.NewName.String()
+}
 
 // RenameTable represents a RENAME TABLE statement.
 type RenameTable struct {
+	Name     *QualifiedName
+	NewName  *QualifiedName
+	IfExists bool
+}
+
+func (node *RenameTable) String() string {
+	var buf bytes.Buffer
+	_, _ = buf.WriteString("ALTER TABLE ")
+	if node.IfExists {
+		_, _ = buf.WriteString("IF EXISTS ")
+	}
+	_, _ = buf.WriteString(node.Name.String())
+	_, _ = buf.WriteString(" RENAME TO ")
+	_, _ = buf.WriteString(node.NewName.String())
+	return buf.String()
+}
+
+// RenameColumn represents a RENAME COLUMN statement.
+type RenameColumn struct {
+	Table   *QualifiedName
 	Name    Name
 	NewName Name
+	// IfExists refers to the table, not the column.
+	IfExists bool
 }
 
-func (node *RenameTable) String() string {
-	return fmt.Sprintf("RENAME TABLE %s %s", node.Name, node.NewName)
+func (node *RenameColumn) String() string {
+	var buf bytes.Buffer
+	_, _ = buf.WriteString("ALTER TABLE ")
+	if node.IfExists {
+		_, _ = buf.WriteString("IF EXISTS ")
+	}
+	_, _ = buf.WriteString(node.Table.String())
+	_, _ = buf.WriteString(" RENAME COLUMN ")
+	_, _ = buf.WriteString(node.Name.String())
+	_, _ = buf.WriteString(" TO ")
+	_, _ = buf.WriteString(node.NewName.String())
+	return buf.String()
 }
@@ -23,6 +23,7 @@ package parser
 
 import (
 	"bytes"
+	"encoding/hex"
 	"fmt"
 	"strconv"
 	"strings"
@@ -30,7 +31,9 @@ import (
 
 const eof = -1
 const errUnterminated = "unterminated string"
-const errUnsupportedEscape = "octal, hex and unicode escape not supported"
+const errUnsupportedEscape = "octal and unicode escape not supported"
+const errInvalidHexEscape = "invalid hexadecimal escape"
+const errInvalidHexBytes = "invalid hexadecimal bytes literal"
 
 type scanner struct {
 	in        string
@@ -73,7 +76,7 @@ func (s *scanner) Lex(lval *sqlSymType) int {
 	switch lval.id {
 	case NOT:
 		switch s.nextTok.id {
-		case BETWEEN, IN, LIKE, SIMILAR:
+		case BETWEEN, IN, LIKE, ILIKE, SIMILAR:
 			lval.id = NOT_LA
 		}
 
@@ -163,11 +166,11 @@ func (s *scanner) scan(lval *sqlSymType) {
 		return
 
 	case 'b', 'B':
-		// Bit string?
+		// Bytes string?
 		if s.peek() == '\'' {
 			// [bB]'[^']'{whitespace}*
 			s.pos++
-			if s.scanString(lval, '\'', false) {
+			if s.scanString(lval, '\'', true) {
 				lval.id = BCONST
 			}
 			return
@@ -194,7 +197,14 @@ func (s *scanner) scan(lval *sqlSymType) {
 			// [xX]'[^']'{whitespace}*
 			s.pos++
 			if s.scanString(lval, '\'', false) {
+				b, err := hex.DecodeString(lval.str)
+				if err != nil {
+					lval.id = ERROR
+					lval.str = errInvalidHexBytes
+					return
+				}
 				lval.id = XCONST
+				lval.str = string(b)
 			}
 			return
 		}
@@ -219,6 +229,25 @@ func (s *scanner) scan(lval *sqlSymType) {
 			s.pos++
 			lval.id = NOT_EQUALS
 			return
+		case '~': // !~
+			s.pos++
+			switch s.peek() {
+			case '*': // !~*
+				s.pos++
+				lval.id = NOT_REGIMATCH
+				return
+			}
+			lval.id = NOT_REGMATCH
+			return
+		}
+		return
+
+	case '~':
+		switch s.peek() {
+		case '*': // ~*
+			s.pos++
+			lval.id = REGIMATCH
+			return
 		}
 		return
 
@@ -515,14 +544,29 @@ func (s *scanner) scanString(lval *sqlSymType, ch int, allowEscapes bool) bool {
 				}
 
 				switch t {
-				// TODO(pmattis): Handle other back-slash escapes? Octal? Hexadecimal?
-				// Unicode?
+				// TODO(pmattis): Handle other back-slash escapes? Octal? Unicode?
 				case 'b', 'f', 'n', 'r', 't', '\'':
 					lval.str += string(decodeMap[byte(t)])
 					s.pos++
 					start = s.pos
 					continue
-				case 'x', 'u', 'U':
+				case 'x':
+					// \xh or \xhh is a hexadecimal byte value.
+					s.pos++
+					var v int
+					n := 0
+					for ; n < 2 && isHexDigit(s.peek()); n++ {
+						v = v<<4 | hexDigitValue(s.next())
+					}
+					if n == 0 {
+						lval.id = ERROR
+						lval.str = errInvalidHexEscape
+						return false
+					}
+					lval.str += string([]byte{byte(v)})
+					start = s.pos
+					continue
+				case 'u', 'U':
 					fallthrough
 				case '0', '1', '2', '3', '4', '5', '6', '7':
 					lval.id = ERROR
@@ -549,6 +593,22 @@ func isDigit(ch int) bool {
 	return ch >= '0' && ch <= '9'
 }
 
+func isHexDigit(ch int) bool {
+	return (ch >= '0' && ch <= '9') ||
+		(ch >= 'a' && ch <= 'f') ||
+		(ch >= 'A' && ch <= 'F')
+}
+
+func hexDigitValue(ch int) int {
+	switch {
+	case ch >= 'a':
+		return ch - 'a' + 10
+	case ch >= 'A':
+		return ch - 'A' + 10
+	}
+	return ch - '0'
+}
+
 func isIdent(s string) bool {
 	if len(s) == 0 || !isIdentStart(int(s[0])) {
 		return false
@@ -65,6 +65,9 @@ func TestScanner(t *testing.T) {
 		{`||`, []int{CONCAT}},
 		{`#`, []int{'#'}},
 		{`~`, []int{'~'}},
+		{`~*`, []int{REGIMATCH}},
+		{`!~`, []int{NOT_REGMATCH}},
+		{`!~*`, []int{NOT_REGIMATCH}},
 		{`$1`, []int{PARAM}},
 		{`$a`, []int{'$', IDENT}},
 		{`a`, []int{IDENT}},
@@ -76,12 +79,13 @@ func TestScanner(t *testing.T) {
 		{`b'a'`, []int{BCONST}},
 		{`e'a'`, []int{SCONST}},
 		{`e'a'`, []int{SCONST}},
-		{`x'a'`, []int{XCONST}},
-		{`X'a'`, []int{XCONST}},
+		{`x'0a'`, []int{XCONST}},
+		{`X'0A'`, []int{XCONST}},
 		{`NOT`, []int{NOT}},
 		{`NOT BETWEEN`, []int{NOT_LA, BETWEEN}},
 		{`NOT IN`, []int{NOT_LA, IN}},
 		{`NOT SIMILAR`, []int{NOT_LA, SIMILAR}},
+		{`NOT ILIKE`, []int{NOT_LA, ILIKE}},
 		{`NULLS`, []int{NULLS}},
 		{`NULLS FIRST`, []int{NULLS_LA, FIRST}},
 		{`NULLS LAST`, []int{NULLS_LA, LAST}},
@@ -246,6 +250,16 @@ func TestScanString(t *testing.T) {
 		{`e'\\0'`, `\0`},
 		{`'\0'`, `\0`},
 		{`e'\0'`, errUnsupportedEscape},
+		{`e'\x41\x4'`, "A\x04"},
+		{`e'\x414'`, "A4"},
+		{`e'\x'`, errInvalidHexEscape},
+		{`b'\x00\xff'`, "\x00\xff"},
+		{`b'a\\b'`, `a\b`},
+		{`b'\n'`, "\n"},
+		{`x'00fF'`, "\x00\xff"},
+		{`x''`, ``},
+		{`x'0'`, errInvalidHexBytes},
+		{`x'0g'`, errInvalidHexBytes},
 		{`"''"`, `''`},
 		{`'""'''`, `""'`},
 		{`""""`, `"`},
@@ -48,6 +48,50 @@ func (node *ParenSelect) String() string {
 	return fmt.Sprintf("(%s)", node.Select)
 }
 
+// setOrderByLimit sets the ORDER BY and LIMIT clauses which follow the
+// select clause stmt. The clauses are added to the statement inside any
+// parentheses. A clause which was already given inside the parentheses
+// cannot be given again.
+func setOrderByLimit(stmt Statement, orderBy OrderBy, limit *Limit) error {
+	if orderBy == nil && limit == nil {
+		return nil
+	}
+	for {
+		p, ok := stmt.(*ParenSelect)
+		if !ok {
+			break
+		}
+		stmt = p.Select
+	}
+	var dstOrderBy *OrderBy
+	var dstLimit **Limit
+	switch t := stmt.(type) {
+	case *Select:
+		dstOrderBy, dstLimit = &t.OrderBy, &t.Limit
+	case *Union:
+		dstOrderBy, dstLimit = &t.OrderBy, &t.Limit
+	default:
+		return fmt.Errorf("unimplemented: ORDER BY or LIMIT on VALUES")
+	}
+	if orderBy != nil {
+		if *dstOrderBy != nil {
+			return fmt.Errorf("multiple ORDER BY clauses not allowed")
+		}
+		if *dstLimit != nil {
+			// The rows would be sorted before the inner LIMIT is applied.
+			return fmt.Errorf("unimplemented: ORDER BY on a parenthesized select with LIMIT")
+		}
+		*dstOrderBy = orderBy
+	}
+	if limit != nil {
+		if *dstLimit != nil {
+			return fmt.Errorf("multiple LIMIT clauses not allowed")
+		}
+		*dstLimit = limit
+	}
+	return nil
+}
+
 // Select represents a SELECT statement.
 type Select struct {
 	Distinct    string
@@ -302,20 +346,40 @@ func (node OrderBy) String() string {
 	return buf.String()
 }
 
+// Direction for ordering results.
+type Direction int
+
+// Direction values.
+const (
+	DefaultDirection Direction = iota
+	Ascending
+	Descending
+)
+
+var directionName = [...]string{
+	DefaultDirection: "",
+	Ascending:        "ASC",
+	Descending:       "DESC",
+}
+
+func (d Direction) String() string {
+	if d < 0 || d > Direction(len(directionName)-1) {
+		return fmt.Sprintf("Direction(%d)", d)
+	}
+	return directionName[d]
+}
+
 // Order represents an ordering expression.
 type Order struct {
 	Expr      Expr
-	Direction string
+	Direction Direction
 }
 
-// Order.Direction
-const (
-	astAsc  = " ASC"
-	astDesc = " DESC"
-)
-
 func (node *Order) String() string {
-	return fmt.Sprintf("%s%s", node.Expr, node.Direction)
+	if node.Direction == DefaultDirection {
+		return node.Expr.String()
+	}
+	return fmt.Sprintf("%s %s", node.Expr, node.Direction)
 }
 
 // Limit represents a LIMIT clause.
@@ -12,6 +12,7 @@ type sqlSymType struct {
 	pos            int
 	empty          struct{}
 	ival           int
+	boolVal        bool
 	str            string
 	strs           []string
 	qname          *QualifiedName
@@ -25,6 +26,11 @@ type sqlSymType struct {
 	colConstraint  ColumnConstraint
 	colConstraints []ColumnConstraint
 	colType        ColumnType
+	alterTableCmd  AlterTableCmd
+	alterTableCmds AlterTableCmds
+	isoLevel       IsolationLevel
+	refAction      ReferenceAction
+	refActions     ReferenceActions
 	expr           Expr
 	exprs          Exprs
 	selExpr        SelectExpr
@@ -36,7 +42,12 @@ type sqlSymType struct {
 	whens          []*When
 	updateExpr     *UpdateExpr
 	updateExprs    []*UpdateExpr
+	onConflict     *OnConflict
 	limit          *Limit
+	groupBy        GroupBy
+	orderBy        OrderBy
+	order          *Order
+	dir            Direction
 	targetList     TargetList
 	targetListPtr  *TargetList
 	privilegeType  PrivilegeType
@@ -55,420 +66,430 @@ const DOT_DOT = 57354
 const LESS_EQUALS = 57355
 const GREATER_EQUALS = 57356
 const NOT_EQUALS = 57357
-const ERROR = 57358
-const ABORT = 57359
-const ABSOLUTE = 57360
-const ACCESS = 57361
-const ACTION = 57362
-const ADD = 57363
-const ADMIN = 57364
-const AFTER = 57365
-const AGGREGATE = 57366
-const ALL = 57367
-const ALSO = 57368
-const ALTER = 57369
-const ALWAYS = 57370
-const ANALYSE = 57371
-const ANALYZE = 57372
-const AND = 57373
-const ANY = 57374
-const ARRAY = 57375
-const AS = 57376
-const ASC = 57377
-const ASSERTION = 57378
-const ASSIGNMENT = 57379
-const ASYMMETRIC = 57380
-const AT = 57381
-const ATTRIBUTE = 57382
-const AUTHORIZATION = 57383
-const BACKWARD = 57384
-const BEFORE = 57385
-const BEGIN = 57386
-const BETWEEN = 57387
-const BIGINT = 57388
-const BINARY = 57389
-const BIT = 57390
-const BLOB = 57391
-const BOOLEAN = 57392
-const BOTH = 57393
-const BY = 57394
-const CACHE = 57395
-const CALLED = 57396
-const CASCADE = 57397
-const CASCADED = 57398
-const CASE = 57399
-const CAST = 57400
-const CATALOG = 57401
-const CHAIN = 57402
-const CHAR = 57403
-const CHARACTER = 57404
-const CHARACTERISTICS = 57405
-const CHECK = 57406
-const CHECKPOINT = 57407
-const CLASS = 57408
-const CLOSE = 57409
-const CLUSTER = 57410
-const COALESCE = 57411
-const COLLATE = 57412
-const COLLATION = 57413
-const COLUMN = 57414
-const COLUMNS = 57415
-const COMMENT = 57416
-const COMMENTS = 57417
-const COMMIT = 57418
-const COMMITTED = 57419
-const CONCAT = 57420
-const CONCURRENTLY = 57421
-const CONFIGURATION = 57422
-const CONFLICT = 57423
-const CONNECTION = 57424
-const CONSTRAINT = 57425
-const CONSTRAINTS = 57426
-const CONTENT = 57427
-const CONTINUE = 57428
-const CONVERSION = 57429
-const COPY = 57430
-const COST = 57431
-const CREATE = 57432
-const CROSS = 57433
-const CSV = 57434
-const CUBE = 57435
-const CURRENT = 57436
-const CURRENT_CATALOG = 57437
-const CURRENT_DATE = 57438
-const CURRENT_ROLE = 57439
-const CURRENT_SCHEMA = 57440
-const CURRENT_TIME = 57441
-const CURRENT_TIMESTAMP = 57442
-const CURRENT_USER = 57443
-const CURSOR = 57444
-const CYCLE = 57445
-const DATA = 57446
-const DATABASE = 57447
-const DATABASES = 57448
-const DATE = 57449
-const DAY = 57450
-const DEALLOCATE = 57451
-const DEC = 57452
-const DECIMAL = 57453
-const DECLARE = 57454
-const DEFAULT = 57455
-const DEFAULTS = 57456
-const DEFERRABLE = 57457
-const DEFERRED = 57458
-const DEFINER = 57459
-const DELETE = 57460
-const DELIMITER = 57461
-const DELIMITERS = 57462
-const DESC = 57463
-const DICTIONARY = 57464
-const DISABLE = 57465
-const DISCARD = 57466
-const DISTINCT = 57467
-const DO = 57468
-const DOCUMENT = 57469
-const DOMAIN = 57470
-const DOUBLE = 57471
-const DROP = 57472
-const EACH = 57473
-const ELSE = 57474
-const ENABLE = 57475
-const ENCODING = 57476
-const ENCRYPTED = 57477
-const END = 57478
-const ENUM = 57479
-const ESCAPE = 57480
-const EVENT = 57481
-const EXCEPT = 57482
-const EXCLUDE = 57483
-const EXCLUDING = 57484
-const EXCLUSIVE = 57485
-const EXECUTE = 57486
-const EXISTS = 57487
-const EXPLAIN = 57488
-const EXTENSION = 57489
-const EXTERNAL = 57490
-const EXTRACT = 57491
-const FALSE = 57492
-const FAMILY = 57493
-const FETCH = 57494
-const FILTER = 57495
-const FIRST = 57496
-const FLOAT = 57497
-const FOLLOWING = 57498
-const FOR = 57499
-const FORCE = 57500
-const FOREIGN = 57501
-const FORWARD = 57502
-const FREEZE = 57503
-const FROM = 57504
-const FULL = 57505
-const FUNCTION = 57506
-const FUNCTIONS = 57507
-const GLOBAL = 57508
-const GRANT = 57509
-const GRANTED = 57510
-const GRANTS = 57511
-const GREATEST = 57512
-const GROUP = 57513
-const GROUPING = 57514
-const HANDLER = 57515
-const HAVING = 57516
-const HEADER = 57517
-const HOLD = 57518
-const HOUR = 57519
-const IDENTITY = 57520
-const IF = 57521
-const IMMEDIATE = 57522
-const IMMUTABLE = 57523
-const IMPLICIT = 57524
-const IMPORT = 57525
-const IN = 57526
-const INCLUDING = 57527
-const INCREMENT = 57528
-const INDEX = 57529
-const INDEXES = 57530
-const INHERIT = 57531
-const INHERITS = 57532
-const INITIALLY = 57533
-const INLINE = 57534
-const INNER = 57535
-const INOUT = 57536
-const INPUT = 57537
-const INSENSITIVE = 57538
-const INSERT = 57539
-const INSTEAD = 57540
-const INT = 57541
-const INTEGER = 57542
-const INTERSECT = 57543
-const INTERVAL = 57544
-const INTO = 57545
-const INVOKER = 57546
-const IS = 57547
-const ISOLATION = 57548
-const JOIN = 57549
-const KEY = 57550
-const LABEL = 57551
-const LANGUAGE = 57552
-const LARGE = 57553
-const LAST = 57554
-const LATERAL = 57555
-const LEADING = 57556
-const LEAKPROOF = 57557
-const LEAST = 57558
-const LEFT = 57559
-const LEVEL = 57560
-const LIKE = 57561
-const LIMIT = 57562
-const LISTEN = 57563
-const LOAD = 57564
-const LOCAL = 57565
-const LOCALTIME = 57566
-const LOCALTIMESTAMP = 57567
-const LOCATION = 57568
-const LOCK = 57569
-const LOCKED = 57570
-const LOGGED = 57571
-const MAPPING = 57572
-const MATCH = 57573
-const MATERIALIZED = 57574
-const MAXVALUE = 57575
-const MINUTE = 57576
-const MINVALUE = 57577
-const MODE = 57578
-const MONTH = 57579
-const MOVE = 57580
-const NAME = 57581
-const NAMES = 57582
-const NATIONAL = 57583
-const NATURAL = 57584
-const NCHAR = 57585
-const NEXT = 57586
-const NO = 57587
-const NONE = 57588
-const NOT = 57589
-const NOTHING = 57590
-const NOTIFY = 57591
-const NOWAIT = 57592
-const NULL = 57593
-const NULLIF = 57594
-const NULLS = 57595
-const NUMERIC = 57596
-const OBJECT = 57597
-const OF = 57598
-const OFF = 57599
-const OFFSET = 57600
-const OIDS = 57601
-const ON = 57602
-const ONLY = 57603
-const OPTION = 57604
-const OPTIONS = 57605
-const OR = 57606
-const ORDER = 57607
-const ORDINALITY = 57608
-const OUT = 57609
-const OUTER = 57610
-const OVER = 57611
-const OVERLAPS = 57612
-const OVERLAY = 57613
-const OWNED = 57614
-const OWNER = 57615
-const PARSER = 57616
-const PARTIAL = 57617
-const PARTITION = 57618
-const PASSING = 57619
-const PASSWORD = 57620
-const PLACING = 57621
-const PLANS = 57622
-const POLICY = 57623
-const POSITION = 57624
-const PRECEDING = 57625
-const PRECISION = 57626
-const PRESERVE = 57627
-const PREPARE = 57628
-const PREPARED = 57629
-const PRIMARY = 57630
-const PRIOR = 57631
-const PRIVILEGES = 57632
-const PROCEDURAL = 57633
-const PROCEDURE = 57634
-const PROGRAM = 57635
-const QUOTE = 57636
-const RANGE = 57637
-const READ = 57638
-const REAL = 57639
-const REASSIGN = 57640
-const RECHECK = 57641
-const RECURSIVE = 57642
-const REF = 57643
-const REFERENCES = 57644
-const REFRESH = 57645
-const REINDEX = 57646
-const RELATIVE = 57647
-const RELEASE = 57648
-const RENAME = 57649
-const REPEATABLE = 57650
-const REPLACE = 57651
-const REPLICA = 57652
-const RESET = 57653
-const RESTART = 57654
-const RESTRICT = 57655
-const RETURNING = 57656
-const RETURNS = 57657
-const REVOKE = 57658
-const RIGHT = 57659
-const ROLE = 57660
-const ROLLBACK = 57661
-const ROLLUP = 57662
-const ROW = 57663
-const ROWS = 57664
-const RULE = 57665
-const SAVEPOINT = 57666
-const SCHEMA = 57667
-const SCROLL = 57668
-const SEARCH = 57669
-const SECOND = 57670
-const SECURITY = 57671
-const SELECT = 57672
-const SEQUENCE = 57673
-const SEQUENCES = 57674
-const SERIALIZABLE = 57675
-const SERVER = 57676
-const SESSION = 57677
-const SESSION_USER = 57678
-const SET = 57679
-const SETS = 57680
-const SETOF = 57681
-const SHARE = 57682
-const SHOW = 57683
-const SIMILAR = 57684
-const SIMPLE = 57685
-const SKIP = 57686
-const SMALLINT = 57687
-const SNAPSHOT = 57688
-const SOME = 57689
-const SQL = 57690
-const STABLE = 57691
-const STANDALONE = 57692
-const START = 57693
-const STATEMENT = 57694
-const STATISTICS = 57695
-const STDIN = 57696
-const STDOUT = 57697
-const STORAGE = 57698
-const STRICT = 57699
-const STRIP = 57700
-const SUBSTRING = 57701
-const SYMMETRIC = 57702
-const SYSID = 57703
-const SYSTEM = 57704
-const TABLE = 57705
-const TABLES = 57706
-const TABLESAMPLE = 57707
-const TABLESPACE = 57708
-const TEMP = 57709
-const TEMPLATE = 57710
-const TEMPORARY = 57711
-const TEXT = 57712
-const THEN = 57713
-const TIME = 57714
-const TIMESTAMP = 57715
-const TO = 57716
-const TRAILING = 57717
-const TRANSACTION = 57718
-const TRANSFORM = 57719
-const TREAT = 57720
-const TRIGGER = 57721
-const TRIM = 57722
-const TRUE = 57723
-const TRUNCATE = 57724
-const TRUSTED = 57725
-const TYPE = 57726
-const TYPES = 57727
-const UNBOUNDED = 57728
-const UNCOMMITTED = 57729
-const UNENCRYPTED = 57730
-const UNION = 57731
-const UNIQUE = 57732
-const UNKNOWN = 57733
-const UNLISTEN = 57734
-const UNLOGGED = 57735
-const UNTIL = 57736
-const UPDATE = 57737
-const USER = 57738
-const USING = 57739
-const VACUUM = 57740
-const VALID = 57741
-const VALIDATE = 57742
-const VALIDATOR = 57743
-const VALUE = 57744
-const VALUES = 57745
-const VARCHAR = 57746
-const VARIADIC = 57747
-const VARYING = 57748
-const VERBOSE = 57749
-const VERSION = 57750
-const VIEW = 57751
-const VIEWS = 57752
-const VOLATILE = 57753
-const WHEN = 57754
-const WHERE = 57755
-const WHITESPACE = 57756
-const WINDOW = 57757
-const WITH = 57758
-const WITHIN = 57759
-const WITHOUT = 57760
-const WORK = 57761
-const WRAPPER = 57762
-const WRITE = 57763
-const YEAR = 57764
-const YES = 57765
-const ZONE = 57766
-const NOT_LA = 57767
-const NULLS_LA = 57768
-const WITH_LA = 57769
-const POSTFIXOP = 57770
-const UMINUS = 57771
+const NOT_REGMATCH = 57358
+const REGIMATCH = 57359
+const NOT_REGIMATCH = 57360
+const ERROR = 57361
+const ABORT = 57362
+const ABSOLUTE = 57363
+const ACCESS = 57364
+const ACTION = 57365
+const ADD = 57366
+const ADMIN = 57367
+const AFTER = 57368
+const AGGREGATE = 57369
+const ALL = 57370
+const ALSO = 57371
+const ALTER = 57372
+const ALWAYS = 57373
+const ANALYSE = 57374
+const ANALYZE = 57375
+const AND = 57376
+const ANY = 57377
+const ARRAY = 57378
+const AS = 57379
+const ASC = 57380
+const ASSERTION = 57381
+const ASSIGNMENT = 57382
+const ASYMMETRIC = 57383
+const AT = 57384
+const ATTRIBUTE = 57385
+const AUTHORIZATION = 57386
+const BACKWARD = 57387
+const BEFORE = 57388
+const BEGIN = 57389
+const BETWEEN = 57390
+const BIGINT = 57391
+const BIGSERIAL = 57392
+const BINARY = 57393
+const BIT = 57394
+const BLOB = 57395
+const BOOLEAN = 57396
+const BOTH = 57397
+const BY = 57398
+const BYTEA = 57399
+const BYTES = 57400
+const CACHE = 57401
+const CALLED = 57402
+const CASCADE = 57403
+const CASCADED = 57404
+const CASE = 57405
+const CAST = 57406
+const CATALOG = 57407
+const CHAIN = 57408
+const CHAR = 57409
+const CHARACTER = 57410
+const CHARACTERISTICS = 57411
+const CHECK = 57412
+const CHECKPOINT = 57413
+const CLASS = 57414
+const CLOSE = 57415
+const CLUSTER = 57416
+const COALESCE = 57417
+const COLLATE = 57418
+const COLLATION = 57419
+const COLUMN = 57420
+const COLUMNS = 57421
+const COMMENT = 57422
+const COMMENTS = 57423
+const COMMIT = 57424
+const COMMITTED = 57425
+const CONCAT = 57426
+const CONCURRENTLY = 57427
+const CONFIGURATION = 57428
+const CONFLICT = 57429
+const CONNECTION = 57430
+const CONSTRAINT = 57431
+const CONSTRAINTS = 57432
+const CONTENT = 57433
+const CONTINUE = 57434
+const CONVERSION = 57435
+const COPY = 57436
+const COST = 57437
+const CREATE = 57438
+const CROSS = 57439
+const CSV = 57440
+const CUBE = 57441
+const CURRENT = 57442
+const CURRENT_CATALOG = 57443
+const CURRENT_DATE = 57444
+const CURRENT_ROLE = 57445
+const CURRENT_SCHEMA = 57446
+const CURRENT_TIME = 57447
+const CURRENT_TIMESTAMP = 57448
+const CURRENT_USER = 57449
+const CURSOR = 57450
+const CYCLE = 57451
+const DATA = 57452
+const DATABASE = 57453
+const DATABASES = 57454
+const DATE = 57455
+const DAY = 57456
+const DEALLOCATE = 57457
+const DEC = 57458
+const DECIMAL = 57459
+const DECLARE = 57460
+const DEFAULT = 57461
+const DEFAULTS = 57462
+const DEFERRABLE = 57463
+const DEFERRED = 57464
+const DEFINER = 57465
+const DELETE = 57466
+const DELIMITER = 57467
+const DELIMITERS = 57468
+const DESC = 57469
+const DICTIONARY = 57470
+const DISABLE = 57471
+const DISCARD = 57472
+const DISTINCT = 57473
+const DO = 57474
+const DOCUMENT = 57475
+const DOMAIN = 57476
+const DOUBLE = 57477
+const DROP = 57478
+const EACH = 57479
+const ELSE = 57480
+const ENABLE = 57481
+const ENCODING = 57482
+const ENCRYPTED = 57483
+const END = 57484
+const ENUM = 57485
+const ESCAPE = 57486
+const EVENT = 57487
+const EXCEPT = 57488
+const EXCLUDE = 57489
+const EXCLUDING = 57490
+const EXCLUSIVE = 57491
+const EXECUTE = 57492
+const EXISTS = 57493
+const EXPLAIN = 57494
+const EXTENSION = 57495
+const EXTERNAL = 57496
+const EXTRACT = 57497
+const FALSE = 57498
+const FAMILY = 57499
+const FETCH = 57500
+const FILTER = 57501
+const FIRST = 57502
+const FLOAT = 57503
+const FOLLOWING = 57504
+const FOR = 57505
+const FORCE = 57506
+const FOREIGN = 57507
+const FORWARD = 57508
+const FREEZE = 57509
+const FROM = 57510
+const FULL = 57511
+const FUNCTION = 57512
+const FUNCTIONS = 57513
+const GLOBAL = 57514
+const GRANT = 57515
+const GRANTED = 57516
+const GRANTS = 57517
+const GREATEST = 57518
+const GROUP = 57519
+const GROUPING = 57520
+const HANDLER = 57521
+const HAVING = 57522
+const HEADER = 57523
+const HOLD = 57524
+const HOUR = 57525
+const IDENTITY = 57526
+const IF = 57527
+const ILIKE = 57528
+const IMMEDIATE = 57529
+const IMMUTABLE = 57530
+const IMPLICIT = 57531
+const IMPORT = 57532
+const IN = 57533
+const INCLUDING = 57534
+const INCREMENT = 57535
+const INDEX = 57536
+const INDEXES = 57537
+const INHERIT = 57538
+const INHERITS = 57539
+const INITIALLY = 57540
+const INLINE = 57541
+const INNER = 57542
+const INOUT = 57543
+const INPUT = 57544
+const INSENSITIVE = 57545
+const INSERT = 57546
+const INSTEAD = 57547
+const INT = 57548
+const INTEGER = 57549
+const INTERSECT = 57550
+const INTERVAL = 57551
+const INTO = 57552
+const INVOKER = 57553
+const IS = 57554
+const ISOLATION = 57555
+const JOIN = 57556
+const KEY = 57557
+const LABEL = 57558
+const LANGUAGE = 57559
+const LARGE = 57560
+const LAST = 57561
+const LATERAL = 57562
+const LEADING = 57563
+const LEAKPROOF = 57564
+const LEAST = 57565
+const LEFT = 57566
+const LEVEL = 57567
+const LIKE = 57568
+const LIMIT = 57569
+const LISTEN = 57570
+const LOAD = 57571
+const LOCAL = 57572
+const LOCALTIME = 57573
+const LOCALTIMESTAMP = 57574
+const LOCATION = 57575
+const LOCK = 57576
+const LOCKED = 57577
+const LOGGED = 57578
+const MAPPING = 57579
+const MATCH = 57580
+const MATERIALIZED = 57581
+const MAXVALUE = 57582
+const MINUTE = 57583
+const MINVALUE = 57584
+const MODE = 57585
+const MONTH = 57586
+const MOVE = 57587
+const NAME = 57588
+const NAMES = 57589
+const NATIONAL = 57590
+const NATURAL = 57591
+const NCHAR = 57592
+const NEXT = 57593
+const NO = 57594
+const NONE = 57595
+const NOT = 57596
+const NOTHING = 57597
+const NOTIFY = 57598
+const NOWAIT = 57599
+const NULL = 57600
+const NULLIF = 57601
+const NULLS = 57602
+const NUMERIC = 57603
+const OBJECT = 57604
+const OF = 57605
+const OFF = 57606
+const OFFSET = 57607
+const OIDS = 57608
+const ON = 57609
+const ONLY = 57610
+const OPTION = 57611
+const OPTIONS = 57612
+const OR = 57613
+const ORDER = 57614
+const ORDINALITY = 57615
+const OUT = 57616
+const OUTER = 57617
+const OVER = 57618
+const OVERLAPS = 57619
+const OVERLAY = 57620
+const OWNED = 57621
+const OWNER = 57622
+const PARSER = 57623
+const PARTIAL = 57624
+const PARTITION = 57625
+const PASSING = 57626
+const PASSWORD = 57627
+const PLACING = 57628
+const PLANS = 57629
+const POLICY = 57630
+const POSITION = 57631
+const PRECEDING = 57632
+const PRECISION = 57633
+const PRESERVE = 57634
+const PREPARE = 57635
+const PREPARED = 57636
+const PRIMARY = 57637
+const PRIOR = 57638
+const PRIVILEGES = 57639
+const PROCEDURAL = 57640
+const PROCEDURE = 57641
+const PROGRAM = 57642
+const QUOTE = 57643
+const RANGE = 57644
+const READ = 57645
+const REAL = 57646
+const REASSIGN = 57647
+const RECHECK = 57648
+const RECURSIVE = 57649
+const REF = 57650
+const REFERENCES = 57651
+const REFRESH = 57652
+const REINDEX = 57653
+const RELATIVE = 57654
+const RELEASE = 57655
+const RENAME = 57656
+const REPEATABLE = 57657
+const REPLACE = 57658
+const REPLICA = 57659
+const RESET = 57660
+const RESTART = 57661
+const RESTRICT = 57662
+const RETURNING = 57663
+const RETURNS = 57664
+const REVOKE = 57665
+const RIGHT = 57666
+const ROLE = 57667
+const ROLLBACK = 57668
+const ROLLUP = 57669
+const ROW = 57670
+const ROWS = 57671
+const RULE = 57672
+const SAVEPOINT = 57673
+const SCHEMA = 57674
+const SCROLL = 57675
+const SEARCH = 57676
+const SECOND = 57677
+const SECURITY = 57678
+const SELECT = 57679
+const SEQUENCE = 57680
+const SEQUENCES = 57681
+const SERIAL = 57682
+const SERIALIZABLE = 57683
+const SERVER = 57684
+const SESSION = 57685
+const SESSION_USER = 57686
+const SET = 57687
+const SETS = 57688
+const SETOF = 57689
+const SHARE = 57690
+const SHOW = 57691
+const SIMILAR = 57692
+const SIMPLE = 57693
+const SKIP = 57694
+const SMALLINT = 57695
+const SMALLSERIAL = 57696
+const SNAPSHOT = 57697
+const SOME = 57698
+const SQL = 57699
+const STABLE = 57700
+const STANDALONE = 57701
+const START = 57702
+const STATEMENT = 57703
+const STATISTICS = 57704
+const STDIN = 57705
+const STDOUT = 57706
+const STORAGE = 57707
+const STRICT = 57708
+const STRIP = 57709
+const SUBSTRING = 57710
+const SYMMETRIC = 57711
+const SYSID = 57712
+const SYSTEM = 57713
+const TABLE = 57714
+const TABLES = 57715
+const TABLESAMPLE = 57716
+const TABLESPACE = 57717
+const TEMP = 57718
+const TEMPLATE = 57719
+const TEMPORARY = 57720
+const TEXT = 57721
+const THEN = 57722
+const TIME = 57723
+const TIMESTAMP = 57724
+const TO = 57725
+const TRAILING = 57726
+const TRANSACTION = 57727
+const TRANSFORM = 57728
+const TREAT = 57729
+const TRIGGER = 57730
+const TRIM = 57731
+const TRUE = 57732
+const TRUNCATE = 57733
+const TRUSTED = 57734
+const TYPE = 57735
+const TYPES = 57736
+const UNBOUNDED = 57737
+const UNCOMMITTED = 57738
+const UNENCRYPTED = 57739
+const UNION = 57740
+const UNIQUE = 57741
+const UNKNOWN = 57742
+const UNLISTEN = 57743
+const UNLOGGED = 57744
+const UNTIL = 57745
+const UPDATE = 57746
+const UPSERT = 57747
+const USER = 57748
+const USING = 57749
+const VACUUM = 57750
+const VALID = 57751
+const VALIDATE = 57752
+const VALIDATOR = 57753
+const VALUE = 57754
+const VALUES = 57755
+const VARCHAR = 57756
+const VARIADIC = 57757
+const VARYING = 57758
+const VERBOSE = 57759
+const VERSION = 57760
+const VIEW = 57761
+const VIEWS = 57762
+const VOLATILE = 57763
+const WHEN = 57764
+const WHERE = 57765
+const WHITESPACE = 57766
+const WINDOW = 57767
+const WITH = 57768
+const WITHIN = 57769
+const WITHOUT = 57770
+const WORK = 57771
+const WRAPPER = 57772
+const WRITE = 57773
+const YEAR = 57774
+const YES = 57775
+const ZONE = 57776
+const NOT_LA = 57777
+const NULLS_LA = 57778
+const WITH_LA = 57779
+const POSTFIXOP = 57780
+const UMINUS = 57781
 
 var sqlToknames = [...]string{
 	"$end",
@@ -486,6 +507,9 @@ var sqlToknames = [...]string{
 	"LESS_EQUALS",
 	"GREATER_EQUALS",
 	"NOT_EQUALS",
+	"NOT_REGMATCH",
+	"REGIMATCH",
+	"NOT_REGIMATCH",
 	"ERROR",
 	"ABORT",
 	"ABSOLUTE",
@@ -517,12 +541,15 @@ var sqlToknames = [...]string{
 	"BEGIN",
 	"BETWEEN",
 	"BIGINT",
+	"BIGSERIAL",
 	"BINARY",
 	"BIT",
 	"BLOB",
 	"BOOLEAN",
 	"BOTH",
 	"BY",
+	"BYTEA",
+	"BYTES",
 	"CACHE",
 	"CALLED",
 	"CASCADE",
@@ -650,6 +677,7 @@ var sqlToknames = [...]string{
 	"HOUR",
 	"IDENTITY",
 	"IF",
+	"ILIKE",
 	"IMMEDIATE",
 	"IMMUTABLE",
 	"IMPLICIT",
@@ -803,6 +831,7 @@ var sqlToknames = [...]string{
 	"SELECT",
 	"SEQUENCE",
 	"SEQUENCES",
+	"SERIAL",
 	"SERIALIZABLE",
 	"SERVER",
 	"SESSION",
@@ -816,6 +845,7 @@ var sqlToknames = [...]string{
 	"SIMPLE",
 	"SKIP",
 	"SMALLINT",
+	"SMALLSERIAL",
 	"SNAPSHOT",
 	"SOME",
 	"SQL",
@@ -866,6 +896,7 @@ var sqlToknames = [...]string{
 	"UNLOGGED",
 	"UNTIL",
 	"UPDATE",
+	"UPSERT",
 	"USER",
 	"USING",
 	"VACUUM",
@@ -928,3855 +959,4093 @@ const sqlEofCode = 1
 const sqlErrCode = 2
 const sqlMaxDepth = 200
 
-//line sql.y:4075
+//line sql.y:4526
 
 //line yacctab:1
 var sqlExca = [...]int{
 	-1, 0,
 	1, 19,
-	448, 19,
-	-2, 410,
+	458, 19,
+	-2, 394,
 	-1, 1,
 	1, -1,
 	-2, 0,
 	-1, 33,
-	1, 379,
-	260, 379,
-	314, 379,
-	416, 379,
-	446, 379,
-	448, 379,
-	-2, 391,
+	1, 363,
+	267, 363,
+	321, 363,
+	456, 363,
+	458, 363,
+	-2, 375,
 	-1, 46,
-	363, 185,
-	-2, 288,
+	372, 181,
+	-2, 281,
 	-1, 48,
-	1, 382,
-	260, 382,
-	314, 382,
-	416, 382,
-	446, 382,
-	448, 382,
-	-2, 390,
+	1, 366,
+	267, 366,
+	321, 366,
+	456, 366,
+	458, 366,
+	-2, 374,
 	-1, 57,
 	1, 19,
-	448, 19,
-	-2, 410,
+	458, 19,
+	-2, 394,
 	-1, 93,
-	1, 157,
-	448, 157,
-	-2, 1059,
-	-1, 440,
-	152, 421,
-	157, 421,
-	220, 421,
-	258, 421,
-	-2, 386,
-	-1, 443,
-	152, 420,
-	157, 420,
-	220, 420,
-	258, 420,
-	-2, 383,
-	-1, 564,
-	152, 420,
-	157, 420,
-	220, 420,
-	258, 420,
-	-2, 387,
-	-1, 630,
-	445, 907,
+	1, 153,
+	458, 153,
+	-2, 1061,
+	-1, 446,
+	158, 405,
+	163, 405,
+	227, 405,
+	265, 405,
+	-2, 370,
+	-1, 449,
+	158, 404,
+	163, 404,
+	227, 404,
+	265, 404,
+	-2, 367,
+	-1, 567,
+	158, 404,
+	163, 404,
+	227, 404,
+	265, 404,
+	-2, 371,
+	-1, 633,
+	455, 907,
 	-2, 902,
-	-1, 631,
-	445, 908,
+	-1, 634,
+	455, 908,
 	-2, 903,
-	-1, 637,
-	6, 593,
-	445, 593,
-	-2, 1206,
-	-1, 649,
-	445, 1233,
-	-2, 739,
-	-1, 662,
-	6, 559,
-	-2, 1189,
-	-1, 663,
-	6, 585,
-	445, 585,
-	-2, 1190,
-	-1, 664,
-	6, 566,
-	-2, 1191,
+	-1, 640,
+	6, 582,
+	455, 582,
+	-2, 1210,
+	-1, 652,
+	455, 1239,
+	-2, 736,
 	-1, 665,
-	6, 585,
-	62, 585,
-	445, 585,
+	6, 548,
 	-2, 1192,
 	-1, 666,
-	6, 585,
-	62, 585,
-	445, 585,
-	-2, 1193,
+	6, 574,
+	455, 574,
+	-2, 1194,
 	-1, 667,
-	6, 588,
+	6, 555,
 	-2, 1195,
 	-1, 668,
-	6, 555,
+	6, 574,
+	68, 574,
+	455, 574,
 	-2, 1196,
 	-1, 669,
-	6, 555,
+	6, 574,
+	68, 574,
+	455, 574,
 	-2, 1197,
 	-1, 670,
-	6, 568,
-	-2, 1200,
+	6, 577,
+	-2, 1199,
 	-1, 671,
-	6, 556,
-	-2, 1204,
+	6, 544,
+	-2, 1200,
 	-1, 672,
-	6, 557,
-	-2, 1205,
+	6, 544,
+	-2, 1201,
 	-1, 673,
-	6, 555,
-	-2, 1212,
+	6, 557,
+	-2, 1204,
 	-1, 674,
-	6, 560,
-	-2, 1217,
+	6, 545,
+	-2, 1208,
 	-1, 675,
-	6, 558,
-	-2, 1220,
+	6, 546,
+	-2, 1209,
 	-1, 676,
-	6, 596,
-	-2, 1222,
+	6, 544,
+	-2, 1216,
 	-1, 677,
-	6, 596,
-	-2, 1223,
+	6, 549,
+	-2, 1221,
 	-1, 678,
-	6, 583,
-	62, 583,
-	445, 583,
-	-2, 1227,
-	-1, 959,
-	140, 391,
-	152, 391,
-	157, 391,
-	201, 391,
-	220, 391,
-	258, 391,
-	265, 391,
-	389, 391,
-	-2, 705,
-	-1, 969,
-	445, 886,
+	6, 547,
+	-2, 1225,
+	-1, 679,
+	6, 585,
+	-2, 1228,
+	-1, 680,
+	6, 585,
+	-2, 1229,
+	-1, 681,
+	6, 572,
+	68, 572,
+	455, 572,
+	-2, 1233,
+	-1, 960,
+	146, 375,
+	158, 375,
+	163, 375,
+	208, 375,
+	227, 375,
+	265, 375,
+	272, 375,
+	398, 375,
+	-2, 702,
+	-1, 970,
+	455, 886,
 	-2, 880,
-	-1, 1066,
-	445, 292,
-	-2, 994,
-	-1, 1197,
+	-1, 1065,
+	455, 285,
+	-2, 996,
+	-1, 1204,
 	13, 0,
 	14, 0,
 	15, 0,
-	428, 0,
-	429, 0,
-	430, 0,
-	-2, 629,
-	-1, 1198,
+	438, 0,
+	439, 0,
+	440, 0,
+	-2, 618,
+	-1, 1205,
 	13, 0,
 	14, 0,
 	15, 0,
-	428, 0,
-	429, 0,
-	430, 0,
-	-2, 630,
-	-1, 1199,
+	438, 0,
+	439, 0,
+	440, 0,
+	-2, 619,
+	-1, 1206,
 	13, 0,
 	14, 0,
 	15, 0,
-	428, 0,
-	429, 0,
-	430, 0,
-	-2, 631,
-	-1, 1201,
+	438, 0,
+	439, 0,
+	440, 0,
+	-2, 620,
+	-1, 1208,
 	13, 0,
 	14, 0,
 	15, 0,
-	428, 0,
-	429, 0,
-	430, 0,
-	-2, 633,
-	-1, 1202,
+	438, 0,
+	439, 0,
+	440, 0,
+	-2, 622,
+	-1, 1209,
 	13, 0,
 	14, 0,
 	15, 0,
-	428, 0,
-	429, 0,
-	430, 0,
-	-2, 634,
-	-1, 1203,
+	438, 0,
+	439, 0,
+	440, 0,
+	-2, 623,
+	-1, 1210,
 	13, 0,
 	14, 0,
 	15, 0,
-	428, 0,
-	429, 0,
-	430, 0,
+	438, 0,
+	439, 0,
+	440, 0,
+	-2, 624,
+	-1, 1213,
+	48, 0,
+	186, 0,
+	191, 0,
+	226, 0,
+	350, 0,
+	435, 0,
+	-2, 629,
+	-1, 1219,
+	48, 0,
+	186, 0,
+	191, 0,
+	226, 0,
+	350, 0,
+	435, 0,
+	-2, 633,
+	-1, 1257,
+	277, 779,
+	-2, 782,
+	-1, 1288,
+	168, 820,
+	455, 907,
+	-2, 902,
+	-1, 1289,
+	168, 821,
+	-2, 1188,
+	-1, 1290,
+	168, 822,
+	-2, 1058,
+	-1, 1291,
+	168, 823,
+	-2, 973,
+	-1, 1292,
+	168, 824,
+	-2, 1017,
+	-1, 1293,
+	168, 825,
+	-2, 1055,
+	-1, 1294,
+	168, 826,
+	-2, 1123,
+	-1, 1295,
+	168, 827,
+	-2, 889,
+	-1, 1466,
+	97, 479,
+	169, 479,
+	200, 479,
+	214, 479,
+	224, 479,
+	249, 479,
+	324, 479,
+	-2, 375,
+	-1, 1480,
+	48, 0,
+	186, 0,
+	191, 0,
+	226, 0,
+	350, 0,
+	435, 0,
+	-2, 631,
+	-1, 1481,
+	48, 0,
+	186, 0,
+	191, 0,
+	226, 0,
+	350, 0,
+	435, 0,
 	-2, 635,
-	-1, 1206,
-	45, 0,
-	184, 0,
-	219, 0,
-	342, 0,
-	425, 0,
-	-2, 640,
-	-1, 1244,
-	270, 782,
-	-2, 785,
-	-1, 1451,
-	91, 495,
-	163, 495,
-	193, 495,
-	207, 495,
-	217, 495,
-	242, 495,
-	317, 495,
-	-2, 391,
-	-1, 1465,
-	45, 0,
-	184, 0,
-	219, 0,
-	342, 0,
-	425, 0,
-	-2, 642,
-	-1, 1470,
-	45, 0,
-	184, 0,
-	219, 0,
-	342, 0,
-	425, 0,
-	-2, 644,
-	-1, 1494,
-	270, 781,
-	-2, 784,
-	-1, 1676,
-	45, 0,
-	184, 0,
-	219, 0,
-	342, 0,
-	425, 0,
-	-2, 641,
-	-1, 1678,
-	45, 0,
-	184, 0,
-	219, 0,
-	342, 0,
-	425, 0,
-	-2, 646,
-	-1, 1684,
-	205, 0,
-	-2, 657,
+	-1, 1487,
+	48, 0,
+	186, 0,
+	191, 0,
+	226, 0,
+	350, 0,
+	435, 0,
+	-2, 637,
+	-1, 1511,
+	277, 778,
+	-2, 781,
+	-1, 1691,
+	48, 0,
+	186, 0,
+	191, 0,
+	226, 0,
+	350, 0,
+	435, 0,
+	-2, 630,
 	-1, 1694,
-	270, 783,
-	-2, 786,
-	-1, 1734,
+	48, 0,
+	186, 0,
+	191, 0,
+	226, 0,
+	350, 0,
+	435, 0,
+	-2, 639,
+	-1, 1697,
+	48, 0,
+	186, 0,
+	191, 0,
+	226, 0,
+	350, 0,
+	435, 0,
+	-2, 634,
+	-1, 1701,
+	212, 0,
+	-2, 654,
+	-1, 1711,
+	277, 780,
+	-2, 783,
+	-1, 1751,
 	13, 0,
 	14, 0,
 	15, 0,
-	428, 0,
-	429, 0,
-	430, 0,
-	-2, 686,
-	-1, 1735,
+	438, 0,
+	439, 0,
+	440, 0,
+	-2, 683,
+	-1, 1752,
 	13, 0,
 	14, 0,
 	15, 0,
-	428, 0,
-	429, 0,
-	430, 0,
-	-2, 687,
-	-1, 1736,
+	438, 0,
+	439, 0,
+	440, 0,
+	-2, 684,
+	-1, 1753,
 	13, 0,
 	14, 0,
 	15, 0,
-	428, 0,
-	429, 0,
-	430, 0,
-	-2, 688,
-	-1, 1738,
+	438, 0,
+	439, 0,
+	440, 0,
+	-2, 685,
+	-1, 1755,
 	13, 0,
 	14, 0,
 	15, 0,
-	428, 0,
-	429, 0,
-	430, 0,
-	-2, 690,
-	-1, 1739,
+	438, 0,
+	439, 0,
+	440, 0,
+	-2, 687,
+	-1, 1756,
 	13, 0,
 	14, 0,
 	15, 0,
-	428, 0,
-	429, 0,
-	430, 0,
-	-2, 691,
-	-1, 1740,
+	438, 0,
+	439, 0,
+	440, 0,
+	-2, 688,
+	-1, 1757,
 	13, 0,
 	14, 0,
 	15, 0,
-	428, 0,
-	429, 0,
-	430, 0,
-	-2, 692,
-	-1, 1821,
-	447, 1153,
-	-2, 548,
-	-1, 1881,
-	45, 0,
-	184, 0,
-	219, 0,
-	342, 0,
-	425, 0,
-	-2, 643,
-	-1, 1885,
-	45, 0,
-	184, 0,
-	219, 0,
-	342, 0,
-	425, 0,
-	-2, 645,
-	-1, 1886,
-	205, 0,
+	438, 0,
+	439, 0,
+	440, 0,
+	-2, 689,
+	-1, 1835,
+	457, 939,
+	-2, 532,
+	-1, 1836,
+	457, 938,
+	-2, 533,
+	-1, 1837,
+	457, 1155,
+	-2, 534,
+	-1, 1895,
+	48, 0,
+	186, 0,
+	191, 0,
+	226, 0,
+	350, 0,
+	435, 0,
+	-2, 632,
+	-1, 1896,
+	48, 0,
+	186, 0,
+	191, 0,
+	226, 0,
+	350, 0,
+	435, 0,
+	-2, 636,
+	-1, 1900,
+	48, 0,
+	186, 0,
+	191, 0,
+	226, 0,
+	350, 0,
+	435, 0,
+	-2, 638,
+	-1, 1901,
+	212, 0,
+	-2, 655,
+	-1, 1905,
+	48, 0,
+	186, 0,
+	191, 0,
+	226, 0,
+	350, 0,
+	435, 0,
 	-2, 658,
-	-1, 1890,
-	45, 0,
-	184, 0,
-	219, 0,
-	342, 0,
-	425, 0,
+	-1, 1906,
+	48, 0,
+	186, 0,
+	191, 0,
+	226, 0,
+	350, 0,
+	435, 0,
+	-2, 660,
+	-1, 2007,
+	48, 0,
+	186, 0,
+	191, 0,
+	226, 0,
+	350, 0,
+	435, 0,
+	-2, 640,
+	-1, 2008,
+	48, 0,
+	186, 0,
+	191, 0,
+	226, 0,
+	350, 0,
+	435, 0,
+	-2, 659,
+	-1, 2009,
+	48, 0,
+	186, 0,
+	191, 0,
+	226, 0,
+	350, 0,
+	435, 0,
 	-2, 661,
-	-1, 1891,
-	45, 0,
-	184, 0,
-	219, 0,
-	342, 0,
-	425, 0,
-	-2, 663,
-	-1, 1996,
-	45, 0,
-	184, 0,
-	219, 0,
-	342, 0,
-	425, 0,
-	-2, 647,
-	-1, 1997,
-	45, 0,
-	184, 0,
-	219, 0,
-	342, 0,
-	425, 0,
-	-2, 662,
-	-1, 1998,
-	45, 0,
-	184, 0,
-	219, 0,
-	342, 0,
-	425, 0,
-	-2, 664,
-	-1, 2006,
-	205, 0,
-	-2, 693,
-	-1, 2073,
-	205, 0,
-	-2, 694,
-	-1, 2136,
-	45, 0,
-	219, 0,
-	342, 0,
-	425, 0,
-	-2, 1188,
+	-1, 2017,
+	212, 0,
+	-2, 690,
+	-1, 2080,
+	212, 0,
+	-2, 691,
+	-1, 2138,
+	48, 0,
+	186, 0,
+	226, 0,
+	350, 0,
+	435, 0,
+	-2, 1191,
 }
 
-const sqlNprod = 1325
+const sqlNprod = 1332
 const sqlPrivate = 57344
 
 var sqlTokenNames []string
 var sqlStates []string
 
-const sqlLast = 34618
+const sqlLast = 36241
 
 var sqlAct = [...]int{
 
-	615, 2162, 2135, 2112, 2129, 1420, 2114, 1133, 1023, 1076,
-	1920, 1108, 2113, 1630, 950, 2134, 1030, 2022, 2080, 2025,
-	1714, 1351, 629, 1973, 1830, 1590, 1391, 1148, 2032, 1937,
-	1240, 1454, 1873, 1852, 628, 95, 2041, 1866, 1635, 1867,
-	1921, 1388, 1628, 419, 427, 444, 1836, 1808, 1789, 768,
-	1774, 1858, 455, 455, 706, 1141, 465, 68, 13, 466,
-	632, 465, 95, 476, 95, 1685, 1381, 1385, 32, 1848,
-	1155, 962, 692, 1362, 786, 723, 1363, 1645, 1440, 1322,
-	1554, 513, 465, 465, 878, 621, 95, 95, 537, 730,
-	1497, 94, 965, 1012, 1458, 1443, 1654, 1432, 1553, 524,
-	1450, 696, 1068, 1347, 1031, 1061, 590, 1006, 1257, 1428,
-	1261, 98, 958, 1223, 1251, 13, 1220, 1146, 1299, 449,
-	995, 1143, 911, 1124, 999, 451, 47, 70, 18, 766,
-	1386, 69, 10, 71, 6, 1099, 449, 740, 917, 547,
-	443, 884, 591, 600, 454, 886, 84, 738, 1142, 724,
-	679, 482, 570, 475, 47, 475, 474, 65, 48, 572,
-	571, 767, 764, 77, 90, 471, 452, 527, 708, 887,
-	918, 885, 874, 729, 73, 2170, 1254, 1024, 2013, 488,
-	448, 49, 448, 47, 2132, 18, 1489, 1985, 53, 10,
-	2108, 6, 47, 1889, 2102, 2098, 1491, 1137, 2013, 681,
-	2094, 1492, 2075, 1049, 462, 1889, 441, 2063, 2062, 472,
-	1985, 1137, 2014, 1331, 2058, 2013, 918, 484, 489, 456,
-	478, 55, 1999, 1988, 480, 1889, 1989, 523, 1975, 440,
-	919, 516, 519, 521, 1746, 1987, 1984, 73, 1985, 1985,
-	1982, 1960, 1693, 1137, 1961, 1255, 1941, 1934, 1933, 1137,
-	1935, 1137, 1914, 1893, 1626, 1489, 1489, 36, 525, 1888,
-	1786, 56, 1889, 1137, 1380, 1028, 1784, 30, 1802, 1137,
-	1054, 1430, 1689, 920, 51, 1489, 1625, 1801, 1612, 1137,
-	1588, 1613, 1938, 1049, 37, 52, 1584, 1579, 1569, 1049,
-	1489, 1570, 1567, 1566, 1565, 1489, 1489, 1489, 1049, 528,
-	1137, 922, 1256, 50, 707, 1253, 1494, 1493, 1490, 1489,
-	1489, 1138, 1022, 1489, 1137, 1021, 39, 712, 1236, 694,
-	713, 1131, 584, 693, 1095, 2093, 585, 512, 461, 1614,
-	46, 694, 921, 2034, 577, 693, 780, 2133, 57, 2083,
-	935, 538, 1302, 780, 780, 1074, 1615, 53, 526, 2070,
-	1528, 1008, 1542, 1543, 1544, 2053, 1992, 1917, 1008, 1915,
-	1007, 1348, 1906, 1842, 1496, 726, 1489, 1007, 1905, 1900,
-	27, 1899, 1898, 1897, 1880, 1768, 40, 1759, 1005, 1077,
-	55, 1756, 1755, 1754, 1697, 1009, 28, 1666, 1644, 1624,
-	920, 1622, 1576, 1575, 1572, 1571, 1258, 1561, 875, 1552,
-	1882, 1527, 1524, 1522, 1520, 920, 1519, 29, 1518, 1598,
-	1348, 1797, 1517, 1507, 53, 1501, 1318, 1541, 922, 1107,
-	56, 1096, 972, 1232, 1528, 1013, 709, 552, 584, 966,
-	465, 50, 2131, 922, 558, 583, 1336, 1716, 2082, 2068,
-	1629, 2008, 1978, 1970, 1956, 1930, 1925, 55, 1912, 921,
-	1877, 1865, 1863, 2069, 455, 1349, 1683, 1331, 1346, 1668,
-	1662, 1659, 50, 1602, 921, 465, 1600, 1551, 1515, 1514,
-	465, 465, 1528, 703, 1542, 1543, 1544, 53, 1506, 1485,
-	1484, 1479, 53, 1225, 1000, 1003, 565, 56, 592, 592,
-	1252, 1528, 1884, 1542, 1543, 1544, 1457, 1994, 697, 1993,
-	51, 758, 1345, 919, 1307, 1266, 1136, 1015, 920, 1075,
-	55, 52, 465, 1462, 993, 55, 992, 1798, 991, 465,
-	1800, 687, 990, 1546, 989, 1382, 44, 988, 987, 50,
-	986, 564, 985, 716, 984, 873, 922, 691, 983, 1541,
-	95, 982, 465, 95, 1545, 95, 43, 1233, 981, 980,
-	56, 871, 1841, 979, 66, 56, 31, 95, 1541, 41,
-	1767, 1077, 707, 51, 42, 685, 970, 921, 51, 968,
-	53, 967, 50, 467, 52, 588, 1879, 34, 555, 52,
-	1528, 35, 776, 1670, 966, 1671, 455, 688, 726, 916,
-	1952, 38, 67, 1008, 1455, 488, 1771, 1027, 531, 1332,
-	1421, 1574, 1007, 55, 898, 1573, 903, 1463, 1106, 1077,
-	441, 545, 532, 912, 566, 781, 567, 411, 472, 1254,
-	586, 78, 45, 759, 418, 415, 951, 952, 953, 954,
-	955, 75, 761, 440, 489, 699, 960, 977, 2130, 1392,
-	1636, 488, 488, 56, 1962, 715, 1936, 759, 876, 1274,
-	784, 1281, 1849, 996, 546, 694, 51, 1024, 975, 693,
-	410, 2020, 1717, 1262, 752, 1510, 1545, 52, 1328, 1352,
-	2090, 2147, 58, 1398, 449, 2148, 734, 2126, 2092, 1375,
-	489, 489, 1804, 1089, 428, 50, 413, 969, 1255, 785,
-	1954, 465, 755, 769, 923, 924, 925, 926, 927, 929,
-	930, 928, 931, 465, 864, 1036, 95, 868, 95, 869,
-	1953, 1039, 95, 411, 411, 867, 1618, 1617, 1616, 1505,
-	1504, 1503, 1502, 1466, 896, 1020, 2012, 1211, 888, 95,
-	913, 895, 465, 916, 447, 882, 441, 883, 95, 441,
-	441, 1055, 1059, 1070, 1052, 1256, 79, 465, 1253, 95,
-	1048, 59, 465, 1277, 1621, 465, 410, 410, 1026, 907,
-	1082, 550, 908, 909, 1017, 1312, 728, 1538, 1539, 1540,
-	1018, 1529, 1530, 1531, 1532, 1533, 1535, 1536, 1534, 1537,
-	1686, 580, 581, 1058, 1311, 1010, 997, 998, 727, 1123,
-	1187, 563, 762, 562, 1001, 446, 80, 475, 1004, 1038,
-	894, 1122, 561, 475, 560, 784, 556, 430, 1011, 1119,
-	529, 623, 2116, 925, 926, 927, 929, 930, 928, 931,
-	1047, 1050, 1278, 62, 1014, 488, 923, 924, 925, 926,
-	927, 929, 930, 928, 931, 1128, 2076, 892, 47, 1258,
-	1080, 1222, 1222, 1315, 785, 702, 1071, 1531, 1532, 1533,
-	1535, 1536, 1534, 1537, 1258, 2159, 1085, 1098, 1090, 448,
-	1097, 973, 1037, 484, 489, 1042, 530, 1100, 1040, 741,
-	1041, 1101, 1056, 2011, 465, 742, 1229, 2052, 81, 1279,
-	1113, 736, 1276, 1227, 1053, 1876, 1706, 1703, 890, 1538,
-	1539, 1540, 784, 1529, 1530, 1531, 1532, 1533, 1535, 1536,
-	1534, 1537, 78, 1087, 1209, 64, 2051, 465, 1538, 1539,
-	1540, 737, 1529, 1530, 1531, 1532, 1533, 1535, 1536, 1534,
-	1537, 1323, 95, 2165, 2117, 437, 1139, 1102, 1604, 1129,
-	2031, 785, 893, 1252, 929, 930, 928, 931, 774, 721,
-	773, 1151, 1376, 726, 1704, 1262, 994, 894, 961, 60,
-	592, 1435, 2147, 1150, 1188, 1189, 1190, 1191, 1192, 1193,
-	1194, 1195, 1196, 1197, 1198, 1199, 1200, 1201, 1202, 1203,
-	1204, 1205, 1206, 1280, 2105, 2004, 1121, 956, 743, 1070,
-	1258, 1438, 436, 445, 892, 1125, 1126, 891, 594, 1374,
-	1086, 708, 2118, 1513, 2158, 1653, 1130, 1655, 1667, 448,
-	2050, 2106, 1639, 1117, 1152, 1436, 1535, 1536, 1534, 1537,
-	1285, 727, 1123, 1186, 1271, 433, 1283, 1780, 1293, 1295,
-	1300, 1303, 1103, 1316, 1115, 1230, 2115, 79, 465, 1116,
-	1329, 1234, 1631, 2146, 2144, 1017, 465, 746, 1258, 1971,
-	1395, 1326, 1017, 1210, 686, 1619, 1475, 777, 1477, 1100,
-	465, 541, 697, 1339, 82, 1324, 1341, 518, 1059, 1241,
-	784, 1344, 1153, 1062, 575, 511, 1781, 1275, 548, 1354,
-	1355, 1473, 1357, 1359, 1360, 1081, 437, 1528, 1207, 893,
-	465, 63, 741, 906, 1943, 1367, 1368, 1369, 742, 1100,
-	1092, 1942, 1334, 747, 1431, 749, 449, 680, 636, 785,
-	1231, 1928, 1093, 1796, 748, 1437, 2047, 1384, 95, 1606,
-	1396, 1335, 1109, 2163, 915, 1397, 881, 1327, 1964, 2174,
-	1176, 2157, 1909, 1321, 1911, 1333, 435, 1094, 434, 2111,
-	1963, 1780, 1702, 436, 891, 1605, 779, 1775, 1427, 2081,
-	1593, 527, 1844, 1442, 1446, 1449, 1442, 1403, 1401, 1741,
-	1773, 1744, 438, 1400, 1929, 1371, 2046, 778, 1067, 750,
-	760, 1861, 912, 1468, 1221, 2043, 1435, 1151, 1394, 1330,
-	1151, 408, 1471, 1353, 683, 1350, 1118, 1476, 602, 1150,
-	1781, 2164, 1150, 1650, 1337, 745, 1592, 682, 1342, 784,
-	1649, 743, 1340, 446, 1423, 2042, 1438, 470, 1228, 1390,
-	1776, 1208, 1831, 1453, 1777, 2166, 574, 488, 2142, 901,
-	1433, 1378, 1079, 1105, 1596, 557, 449, 1642, 1104, 402,
-	1436, 1646, 1444, 1429, 1372, 1377, 2007, 1460, 785, 1373,
-	1152, 1365, 525, 1152, 463, 1370, 1265, 1795, 1465, 463,
-	1908, 1779, 1470, 1439, 1399, 1434, 489, 1958, 744, 709,
-	746, 574, 403, 1910, 1402, 1782, 569, 2173, 1051, 1176,
-	514, 463, 47, 1860, 1425, 1452, 1447, 1488, 1424, 2044,
-	1426, 1742, 574, 528, 573, 1643, 1461, 435, 1498, 434,
-	1743, 1084, 1217, 1845, 1219, 1965, 633, 1065, 1010, 1555,
-	1957, 1682, 1495, 1511, 449, 1523, 1001, 1516, 1004, 902,
-	439, 1478, 1472, 438, 998, 997, 747, 1215, 749, 1237,
-	1242, 1243, 1474, 1246, 1776, 404, 1577, 748, 1777, 573,
-	1437, 960, 526, 1456, 1469, 1088, 1467, 1300, 1300, 1300,
-	1294, 918, 465, 405, 1304, 1305, 1306, 916, 544, 1585,
-	573, 542, 539, 1778, 775, 469, 1556, 916, 1487, 569,
-	916, 1580, 978, 1601, 592, 1779, 1176, 575, 1317, 449,
-	889, 866, 1264, 697, 1638, 1610, 727, 722, 1366, 1782,
-	1608, 1509, 750, 1589, 1393, 1114, 1594, 754, 575, 751,
-	711, 1623, 710, 705, 1528, 1711, 1919, 578, 459, 1063,
-	465, 1134, 2148, 763, 95, 1969, 465, 771, 745, 1070,
-	1859, 535, 1632, 1922, 1213, 1582, 1073, 1070, 1212, 1641,
-	1072, 880, 1609, 1218, 1611, 1558, 1559, 1560, 1069, 1583,
-	1840, 1431, 431, 920, 1938, 2033, 1578, 756, 1013, 2072,
-	1581, 920, 1647, 3, 1587, 582, 1586, 2045, 2059, 1991,
-	1843, 1110, 1658, 757, 1029, 914, 1660, 1673, 1446, 1442,
-	1591, 1541, 1442, 1151, 1599, 1459, 1151, 1778, 2171, 922,
-	74, 744, 2172, 1528, 920, 1150, 72, 25, 1150, 1995,
-	1045, 1043, 1878, 759, 1620, 1044, 1760, 1135, 1634, 1709,
-	1674, 1568, 921, 1044, 1379, 1633, 1648, 579, 460, 1651,
-	921, 468, 83, 1435, 1675, 1676, 510, 1678, 1529, 1530,
-	1531, 1532, 1533, 1535, 1536, 1534, 1537, 1314, 536, 1684,
-	406, 1313, 1310, 1309, 1715, 1690, 1152, 1308, 407, 1152,
-	1695, 1665, 1270, 1438, 25, 409, 1444, 1695, 1269, 1268,
-	1267, 1259, 1652, 1656, 1657, 1895, 1829, 1433, 1214, 1710,
-	971, 1712, 553, 1663, 1699, 1700, 1701, 1436, 1216, 1664,
-	551, 549, 533, 449, 1721, 1672, 429, 1723, 76, 865,
-	1234, 540, 1902, 412, 904, 414, 416, 417, 1482, 2104,
-	1512, 2003, 1434, 1972, 1263, 976, 1486, 26, 607, 1772,
-	1833, 1387, 772, 720, 1176, 1696, 1751, 1752, 543, 2110,
-	95, 1499, 1500, 1273, 684, 1758, 634, 1785, 1158, 916,
-	635, 1791, 1159, 916, 1002, 622, 1718, 481, 463, 1805,
-	714, 1806, 1705, 1707, 1708, 1799, 1803, 1823, 1824, 1825,
-	1826, 1827, 1828, 1151, 1722, 483, 1032, 1059, 1792, 1811,
-	1839, 1226, 1260, 1550, 1749, 1150, 1508, 974, 606, 1847,
-	612, 1790, 1769, 689, 1563, 611, 1238, 1437, 463, 701,
-	1788, 1990, 1872, 1750, 2019, 1834, 1764, 603, 1837, 1846,
-	916, 735, 1868, 1870, 1812, 1770, 88, 1442, 89, 1449,
-	1763, 1838, 1151, 1151, 1634, 1815, 1151, 1850, 1853, 1176,
-	1761, 1047, 1325, 1762, 1150, 1150, 1152, 1807, 1150, 1810,
-	733, 1151, 1822, 1766, 1832, 1025, 1874, 733, 900, 631,
-	1869, 1154, 1120, 1150, 897, 1856, 1857, 61, 1881, 1862,
-	1607, 879, 1885, 1886, 432, 1875, 1525, 1903, 1890, 1891,
-	733, 1176, 1292, 1284, 1894, 784, 1282, 784, 1176, 1896,
-	1272, 905, 1161, 568, 97, 1152, 1152, 576, 1017, 1152,
-	872, 1809, 97, 97, 1901, 1871, 1854, 1851, 1904, 1887,
-	554, 97, 97, 1361, 1152, 97, 695, 1176, 1033, 589,
-	97, 97, 97, 97, 785, 1140, 785, 487, 1791, 587,
-	910, 457, 458, 1835, 1926, 1383, 95, 1913, 534, 1091,
-	97, 97, 97, 465, 700, 97, 97, 947, 1111, 1127,
-	1151, 2089, 1603, 54, 17, 1792, 16, 15, 14, 12,
-	1669, 1945, 1150, 1907, 11, 1529, 1530, 1531, 1532, 1533,
-	1535, 1536, 1534, 1537, 1176, 1422, 9, 8, 7, 24,
-	23, 22, 1939, 5, 1940, 21, 20, 19, 1691, 4,
-	2, 1923, 1946, 1, 1918, 0, 0, 0, 0, 0,
-	1343, 0, 1384, 95, 0, 0, 0, 0, 1959, 0,
-	1974, 0, 0, 1152, 0, 0, 0, 1955, 0, 0,
-	1948, 1949, 0, 1665, 916, 0, 1870, 1927, 0, 1016,
-	0, 1161, 1595, 0, 1944, 1597, 0, 1951, 0, 0,
-	0, 1034, 0, 1176, 1151, 0, 960, 1460, 0, 0,
-	0, 1979, 0, 0, 1747, 0, 1150, 0, 0, 0,
-	0, 1968, 0, 1983, 1950, 1757, 0, 0, 1966, 1977,
-	733, 0, 0, 1996, 1997, 1998, 0, 1980, 0, 0,
-	0, 0, 0, 2015, 449, 1078, 0, 0, 0, 0,
-	1083, 0, 920, 463, 1967, 0, 1791, 2024, 95, 95,
-	95, 0, 2002, 0, 2018, 0, 0, 1152, 0, 0,
-	0, 2023, 0, 0, 2037, 2038, 697, 465, 1151, 2029,
-	922, 2017, 1839, 1792, 0, 0, 0, 1811, 1161, 2039,
-	1150, 1791, 465, 0, 0, 1286, 1790, 2016, 2009, 916,
-	0, 2056, 0, 0, 2021, 0, 1868, 0, 0, 0,
-	1449, 921, 0, 1151, 449, 2048, 0, 0, 1792, 935,
-	2036, 0, 1812, 1838, 1176, 1150, 1853, 2055, 2049, 2060,
-	2054, 0, 1151, 1815, 1791, 2065, 1176, 1874, 1175, 1160,
-	2066, 1152, 2067, 2078, 1150, 2064, 0, 1810, 95, 2026,
-	2028, 2026, 465, 2084, 95, 0, 1151, 2086, 0, 0,
-	2035, 1792, 0, 0, 0, 0, 2040, 0, 1150, 2071,
-	0, 0, 463, 0, 2077, 0, 1152, 0, 0, 1974,
-	2087, 0, 0, 0, 0, 0, 0, 0, 1176, 1868,
-	1176, 0, 0, 0, 2074, 1152, 2095, 465, 2096, 2099,
-	2101, 2097, 2100, 0, 2024, 733, 0, 0, 95, 1176,
-	2121, 0, 2122, 2109, 0, 1178, 0, 463, 2023, 1152,
-	2124, 2120, 0, 0, 0, 0, 2103, 2123, 1177, 0,
-	0, 2128, 1176, 2119, 0, 0, 0, 0, 2141, 2085,
-	2143, 2125, 2140, 2127, 1787, 2091, 97, 2145, 1794, 97,
-	0, 2150, 1791, 97, 0, 0, 2139, 2139, 2153, 2156,
-	2154, 2152, 2155, 2151, 0, 0, 0, 0, 0, 0,
-	2167, 0, 1176, 97, 1151, 2169, 2168, 1175, 1160, 1792,
-	0, 0, 0, 0, 97, 1343, 1150, 2139, 0, 97,
-	97, 0, 97, 0, 2176, 2175, 0, 0, 0, 2026,
-	0, 0, 0, 487, 2177, 1864, 0, 0, 1864, 0,
-	0, 0, 0, 0, 0, 0, 1161, 0, 961, 0,
-	0, 0, 2139, 0, 0, 0, 733, 1157, 1176, 1286,
-	1286, 97, 0, 0, 733, 0, 0, 1152, 97, 1417,
-	1418, 1419, 0, 0, 0, 0, 0, 0, 1338, 487,
-	487, 1480, 1481, 0, 1178, 0, 0, 0, 783, 97,
-	0, 97, 97, 0, 97, 608, 33, 1177, 0, 0,
-	97, 0, 0, 0, 1175, 1160, 97, 0, 1364, 0,
+	634, 2137, 2116, 2131, 1935, 2162, 1075, 2117, 2086, 1024,
+	2118, 1647, 1110, 2136, 2032, 1435, 1406, 1032, 1880, 1316,
+	951, 1731, 1607, 1984, 1846, 632, 1366, 1135, 450, 1887,
+	1150, 2040, 1702, 631, 1866, 97, 1469, 1936, 1881, 1645,
+	1403, 1852, 2049, 97, 97, 1822, 1805, 540, 1790, 720,
+	1872, 753, 97, 97, 1396, 472, 97, 68, 13, 1157,
+	760, 32, 97, 97, 97, 97, 1862, 1455, 492, 1571,
+	98, 1013, 635, 709, 782, 695, 1400, 1378, 1143, 874,
+	1377, 97, 97, 97, 1660, 966, 97, 97, 1270, 1514,
+	1336, 1473, 605, 963, 624, 1007, 1458, 1669, 1570, 1067,
+	1465, 1447, 1362, 699, 1443, 94, 1060, 1033, 1313, 682,
+	1274, 593, 455, 1236, 959, 13, 1233, 1148, 1000, 907,
+	996, 1264, 1145, 70, 18, 1397, 69, 10, 1126, 455,
+	751, 71, 6, 550, 728, 730, 1101, 1401, 493, 449,
+	457, 47, 603, 880, 882, 1026, 913, 594, 469, 1144,
+	761, 460, 573, 84, 469, 489, 574, 575, 480, 719,
+	65, 749, 883, 77, 881, 478, 703, 1267, 482, 47,
+	482, 48, 73, 73, 521, 469, 711, 494, 90, 2169,
+	870, 18, 2024, 454, 10, 458, 1025, 2134, 2112, 6,
+	1996, 1904, 2106, 2102, 49, 1139, 2024, 916, 47, 2098,
+	53, 2082, 1048, 1029, 1904, 468, 2070, 47, 454, 1996,
+	2069, 479, 2025, 1139, 2010, 2024, 914, 1904, 490, 447,
+	1508, 530, 1346, 485, 914, 1509, 1999, 487, 918, 2000,
+	1998, 1995, 523, 1996, 1996, 55, 1268, 1506, 462, 526,
+	528, 1993, 446, 916, 1139, 2065, 1986, 915, 942, 943,
+	944, 1972, 1953, 1948, 1973, 1139, 1949, 1947, 1929, 1763,
+	1139, 1506, 917, 1908, 1903, 1802, 1506, 1904, 1139, 1800,
+	1706, 1710, 1139, 1506, 918, 1816, 56, 1643, 1642, 1629,
+	946, 1139, 1630, 1605, 1815, 1601, 1048, 1596, 1048, 51,
+	1506, 1395, 1586, 1584, 1269, 1587, 1506, 1266, 1583, 1582,
+	52, 1506, 1506, 1511, 1510, 1507, 1506, 1506, 917, 1140,
+	1506, 1023, 1139, 1053, 1022, 1445, 931, 716, 50, 1048,
+	717, 916, 1631, 1139, 710, 1249, 697, 1133, 1094, 587,
+	696, 588, 2097, 519, 467, 2042, 697, 57, 2089, 1632,
+	696, 53, 541, 776, 1009, 1009, 776, 763, 776, 2135,
+	2077, 2061, 918, 1008, 1008, 2003, 1932, 1930, 1921, 1920,
+	1915, 1914, 1913, 1912, 1894, 1858, 1776, 1363, 1773, 1772,
+	1513, 1006, 1010, 1098, 1073, 1771, 55, 1714, 1681, 1659,
+	1641, 1639, 1593, 1592, 1589, 1588, 917, 1578, 1271, 1569,
+	1544, 1541, 1109, 1539, 1537, 1506, 1536, 1535, 1534, 1524,
+	1518, 1332, 1363, 973, 967, 1014, 50, 871, 587, 586,
+	1245, 1076, 2133, 53, 53, 1615, 1351, 56, 939, 1811,
+	1733, 53, 2088, 947, 2075, 1646, 2019, 1989, 1981, 1968,
+	1944, 1939, 1927, 97, 1879, 712, 97, 1891, 1877, 1364,
+	97, 1784, 1700, 1683, 1677, 1674, 1619, 1617, 55, 55,
+	1361, 1568, 1532, 1531, 1545, 1523, 55, 1502, 937, 50,
+	97, 1501, 1496, 1238, 1001, 1004, 1472, 1360, 1321, 1279,
+	1138, 97, 1016, 916, 994, 568, 97, 97, 97, 993,
+	97, 992, 991, 990, 989, 1265, 988, 987, 986, 56,
+	56, 985, 984, 916, 66, 983, 982, 56, 942, 943,
+	944, 981, 51, 51, 918, 980, 971, 1346, 1477, 969,
+	51, 580, 968, 52, 52, 915, 50, 473, 591, 97,
+	2076, 52, 2005, 690, 918, 626, 97, 1812, 469, 2004,
+	1814, 50, 67, 492, 492, 1545, 567, 1246, 917, 1028,
+	1074, 691, 779, 97, 916, 97, 97, 869, 97, 942,
+	943, 944, 1893, 1685, 97, 916, 916, 1857, 917, 916,
+	97, 967, 1686, 692, 1964, 694, 931, 534, 469, 704,
+	704, 1787, 1347, 1470, 710, 918, 688, 1009, 1436, 1545,
+	1591, 1590, 940, 75, 1108, 558, 1008, 772, 1478, 97,
+	918, 548, 97, 1076, 535, 417, 978, 78, 2132, 763,
+	424, 1407, 1974, 493, 493, 770, 758, 769, 1558, 917,
+	763, 723, 780, 569, 421, 570, 1950, 931, 723, 777,
+	1076, 917, 1863, 2030, 917, 479, 1897, 1734, 962, 447,
+	921, 922, 923, 925, 926, 924, 927, 723, 941, 416,
+	1025, 702, 494, 494, 705, 589, 1275, 549, 1342, 1527,
+	997, 781, 446, 1367, 2094, 2023, 2150, 2149, 742, 1413,
+	2128, 2096, 1818, 58, 1088, 872, 434, 938, 419, 1966,
+	455, 1965, 1635, 1634, 919, 920, 921, 922, 923, 925,
+	926, 924, 927, 724, 941, 745, 453, 1069, 1390, 1326,
+	1633, 417, 1522, 1325, 97, 765, 1521, 779, 1638, 1520,
+	1545, 1519, 1482, 1194, 863, 417, 1220, 97, 1054, 97,
+	1051, 97, 970, 890, 97, 97, 97, 911, 492, 97,
+	773, 860, 97, 97, 864, 79, 865, 892, 97, 884,
+	891, 878, 97, 879, 1047, 416, 684, 97, 553, 97,
+	909, 718, 97, 59, 559, 97, 1299, 1081, 452, 416,
+	888, 436, 447, 1069, 414, 447, 447, 925, 926, 924,
+	927, 1027, 941, 1027, 1085, 597, 890, 780, 1235, 1121,
+	1018, 1049, 1057, 1235, 1019, 903, 1011, 779, 904, 905,
+	764, 1125, 532, 566, 726, 565, 1017, 2083, 493, 998,
+	999, 1002, 1012, 1124, 564, 1005, 563, 618, 443, 1036,
+	1329, 886, 706, 888, 2022, 408, 781, 1720, 1271, 2060,
+	775, 2159, 454, 1703, 723, 727, 1039, 1621, 1130, 482,
+	2059, 482, 1099, 1242, 1046, 1337, 1267, 494, 1723, 1077,
+	1240, 774, 95, 1070, 1082, 1015, 80, 469, 409, 533,
+	425, 433, 2015, 1084, 1079, 711, 889, 780, 1050, 461,
+	461, 1031, 974, 471, 1100, 2039, 442, 47, 78, 471,
+	95, 483, 95, 97, 63, 1721, 1796, 97, 490, 1055,
+	97, 995, 1040, 1044, 1042, 1391, 97, 1043, 520, 471,
+	471, 1052, 1890, 95, 95, 957, 781, 1548, 1549, 1550,
+	1552, 1553, 1551, 1554, 1086, 1268, 1530, 1670, 2055, 889,
+	1682, 410, 887, 97, 919, 920, 921, 922, 923, 925,
+	926, 924, 927, 1131, 941, 1797, 1127, 1128, 97, 81,
+	411, 2120, 1271, 1141, 919, 920, 921, 922, 923, 925,
+	926, 924, 927, 1275, 941, 1796, 2149, 1217, 451, 454,
+	1153, 1791, 1104, 61, 60, 1654, 2058, 62, 1152, 2119,
+	779, 2054, 1132, 1269, 1789, 887, 1266, 1123, 1119, 1271,
+	2051, 2158, 469, 1648, 583, 584, 1546, 1547, 1548, 1549,
+	1550, 1552, 1553, 1551, 1554, 919, 920, 921, 922, 923,
+	925, 926, 924, 927, 1797, 941, 79, 1250, 1255, 1256,
+	2050, 1259, 1636, 578, 683, 723, 941, 941, 1976, 1623,
+	941, 1389, 441, 2109, 440, 1287, 2148, 469, 1308, 1154,
+	1975, 2146, 1318, 1319, 1320, 1552, 1553, 1551, 1554, 1105,
+	780, 1982, 1117, 1193, 1118, 1330, 1622, 1243, 444, 97,
+	2110, 97, 764, 1125, 2121, 1247, 1331, 439, 97, 1018,
+	1410, 1340, 577, 764, 759, 689, 1018, 1271, 97, 97,
+	1792, 577, 97, 1254, 1793, 97, 572, 97, 1083, 781,
+	97, 771, 2173, 1155, 544, 2052, 36, 551, 97, 97,
+	525, 97, 97, 97, 518, 1215, 30, 779, 1341, 97,
+	1218, 1080, 1446, 902, 97, 97, 97, 1348, 97, 2157,
+	455, 1795, 1955, 37, 1954, 492, 1484, 82, 443, 412,
+	1244, 1234, 1942, 2122, 712, 1798, 1758, 413, 1761, 97,
+	97, 576, 1090, 1411, 1349, 1214, 2165, 97, 1350, 1792,
+	576, 723, 1344, 1793, 1091, 64, 1335, 639, 39, 1111,
+	723, 1546, 1547, 1548, 1549, 1550, 1552, 1553, 1551, 1554,
+	97, 1353, 46, 1810, 1265, 97, 97, 780, 97, 578,
+	1093, 1241, 877, 2115, 1450, 1418, 442, 1924, 578, 1926,
+	1795, 1719, 2087, 897, 1092, 493, 1977, 1416, 1657, 748,
+	1409, 1379, 1153, 1943, 1798, 1153, 1382, 1368, 577, 1345,
+	1152, 1450, 27, 1152, 1352, 1453, 781, 1365, 40, 1355,
+	531, 1120, 1875, 1665, 1357, 1794, 1438, 1664, 28, 1448,
+	477, 2172, 476, 1061, 494, 452, 1230, 1847, 1232, 1451,
+	2144, 455, 1453, 1459, 686, 1405, 1613, 1380, 1475, 29,
+	1393, 1385, 1388, 1078, 1387, 1392, 1668, 1658, 1468, 1759,
+	555, 1228, 560, 471, 1449, 2053, 1451, 561, 1760, 1216,
+	1970, 1154, 469, 1107, 1154, 1454, 1492, 576, 1494, 1106,
+	1661, 1414, 1874, 1417, 898, 1444, 1278, 461, 1499, 2018,
+	1573, 1923, 1440, 1572, 1794, 1439, 1503, 1467, 471, 1462,
+	1441, 1490, 1699, 471, 471, 471, 1540, 707, 1809, 47,
+	1610, 1516, 1517, 1011, 1969, 1476, 1495, 1471, 1512, 1925,
+	1087, 914, 547, 545, 542, 475, 1277, 1002, 1066, 1005,
+	455, 572, 441, 979, 440, 885, 862, 2163, 685, 1452,
+	1653, 999, 998, 1627, 1625, 1606, 471, 1408, 1116, 744,
+	741, 715, 714, 471, 1567, 445, 1446, 1609, 444, 1226,
+	97, 713, 1485, 1225, 1483, 1580, 1452, 1728, 1231, 44,
+	95, 1934, 471, 95, 900, 95, 2150, 97, 767, 581,
+	1069, 867, 97, 465, 1072, 1602, 1136, 95, 1600, 43,
+	538, 1071, 97, 1504, 876, 97, 455, 1856, 97, 31,
+	1069, 2041, 41, 1488, 1952, 2164, 437, 42, 1493, 746,
+	1014, 1068, 3, 53, 1526, 2079, 461, 1662, 585, 912,
+	2066, 34, 1873, 2002, 1859, 35, 97, 1112, 1450, 747,
+	2166, 1030, 72, 25, 910, 97, 38, 1688, 1474, 97,
+	2170, 97, 74, 2171, 1649, 1545, 2006, 1097, 55, 1575,
+	1576, 1577, 1095, 1892, 1777, 1726, 1096, 1689, 1064, 1453,
+	1599, 1585, 1096, 1394, 1656, 1595, 1328, 45, 1327, 1036,
+	474, 1324, 1163, 1448, 83, 1137, 1626, 1323, 1628, 1598,
+	517, 1604, 582, 1451, 1603, 1322, 466, 97, 415, 56,
+	25, 97, 1283, 97, 97, 1227, 1608, 97, 1616, 1282,
+	1281, 1153, 51, 539, 1153, 1229, 636, 1280, 1449, 1152,
+	1272, 1910, 1152, 52, 1845, 1727, 1637, 1612, 1651, 972,
+	1614, 471, 1684, 556, 554, 552, 418, 1644, 420, 422,
+	423, 50, 536, 1652, 471, 1489, 1038, 435, 95, 76,
+	861, 95, 1041, 95, 1650, 1491, 95, 543, 1917, 471,
+	912, 2108, 1708, 1529, 1663, 95, 2014, 1666, 1983, 1058,
+	1459, 97, 1062, 1276, 471, 977, 95, 26, 469, 471,
+	1154, 469, 471, 1154, 1716, 1717, 1718, 610, 1788, 1671,
+	1672, 1667, 1849, 1452, 1402, 768, 757, 546, 1680, 1678,
+	752, 2114, 1286, 455, 1679, 687, 637, 1687, 1160, 638,
+	1161, 1003, 625, 488, 1034, 1239, 1273, 1525, 975, 609,
+	1247, 1253, 615, 614, 1163, 1251, 1804, 2001, 1764, 1886,
+	2029, 606, 1853, 725, 88, 1713, 89, 1339, 1783, 1774,
+	896, 1122, 893, 1624, 875, 438, 1542, 97, 1306, 1722,
+	1724, 1725, 1296, 1298, 97, 1285, 97, 901, 97, 571,
+	97, 579, 1735, 868, 1823, 1868, 97, 1865, 97, 1813,
+	1817, 779, 1834, 779, 97, 97, 97, 97, 97, 97,
+	1766, 1739, 557, 1376, 97, 698, 1035, 97, 592, 1142,
+	590, 906, 1825, 1183, 97, 1806, 463, 464, 1851, 1785,
+	1102, 1398, 1850, 537, 1103, 1780, 1089, 471, 1951, 2035,
+	1767, 948, 1113, 1115, 1129, 97, 2093, 97, 97, 1860,
+	1781, 1620, 97, 1826, 1163, 1778, 1651, 1854, 1779, 54,
+	1153, 1153, 17, 1786, 1153, 16, 1883, 15, 1152, 1152,
+	471, 780, 1152, 780, 1829, 14, 1824, 12, 11, 1153,
+	1838, 1821, 1046, 1889, 1888, 95, 1437, 1152, 9, 8,
+	1848, 7, 24, 23, 22, 5, 21, 20, 19, 1842,
+	1156, 4, 2, 1902, 97, 1, 0, 0, 0, 0,
+	781, 1803, 781, 1870, 1871, 1808, 0, 1876, 0, 1885,
+	0, 0, 0, 1300, 0, 0, 1018, 469, 469, 1154,
+	1154, 469, 0, 1154, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 1154, 0,
+	0, 0, 0, 0, 0, 97, 0, 0, 0, 0,
+	97, 0, 97, 0, 0, 1183, 0, 0, 0, 97,
+	1878, 0, 0, 1878, 0, 0, 916, 0, 0, 1922,
+	0, 942, 943, 944, 0, 97, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 471, 0, 1343, 0,
+	0, 1834, 0, 0, 0, 471, 0, 918, 0, 0,
+	0, 0, 0, 1937, 0, 1102, 471, 0, 1933, 1354,
+	0, 0, 1356, 0, 1058, 0, 0, 1359, 97, 97,
+	0, 0, 1971, 1967, 97, 1369, 1370, 0, 1372, 1374,
+	1375, 917, 1358, 1956, 0, 0, 471, 1962, 97, 931,
+	97, 471, 1383, 1384, 0, 1102, 0, 0, 0, 962,
+	1475, 1946, 0, 1960, 1961, 1183, 0, 1941, 1994, 0,
+	0, 1153, 0, 1963, 0, 0, 1399, 95, 0, 1152,
+	0, 1182, 1978, 0, 1412, 0, 0, 1980, 0, 0,
+	0, 0, 1988, 0, 0, 1163, 0, 0, 0, 0,
+	0, 455, 0, 0, 0, 0, 0, 1442, 0, 1698,
+	2013, 0, 1457, 1461, 1464, 1457, 0, 0, 0, 0,
+	97, 97, 97, 97, 1991, 0, 0, 0, 0, 2028,
+	2020, 0, 0, 0, 1979, 1834, 97, 97, 469, 97,
+	1154, 2033, 0, 0, 97, 0, 0, 0, 0, 2047,
+	1497, 1498, 97, 97, 0, 1825, 2063, 1806, 0, 2026,
+	97, 0, 0, 0, 0, 0, 2031, 97, 0, 1300,
+	1300, 455, 0, 1992, 0, 1992, 0, 0, 0, 0,
+	2044, 2043, 0, 2056, 1854, 2057, 1826, 0, 2048, 2062,
+	0, 2067, 1163, 0, 0, 97, 1153, 0, 0, 2072,
+	2074, 1888, 0, 0, 1152, 2071, 2073, 1829, 97, 1824,
+	97, 0, 97, 2078, 1162, 595, 595, 0, 1564, 1565,
+	1566, 0, 2081, 1182, 0, 700, 0, 2036, 2038, 0,
+	0, 723, 2084, 2090, 1163, 0, 97, 1300, 1300, 1300,
+	0, 1163, 0, 0, 0, 2064, 97, 0, 0, 2101,
+	0, 2099, 0, 0, 97, 0, 2100, 0, 2105, 2104,
+	97, 2103, 0, 0, 2107, 1154, 0, 0, 0, 2111,
+	1163, 2113, 0, 0, 2124, 2126, 2125, 0, 731, 0,
+	2033, 0, 0, 2130, 732, 2068, 0, 2129, 0, 0,
+	0, 0, 0, 2143, 2142, 0, 2147, 1594, 2145, 0,
+	0, 1185, 2092, 0, 97, 2152, 1183, 0, 2155, 2154,
+	2156, 2153, 0, 2091, 471, 0, 0, 2095, 0, 912,
+	0, 0, 0, 1182, 0, 2168, 2167, 1163, 894, 912,
+	899, 0, 912, 0, 0, 1618, 0, 908, 0, 0,
+	0, 0, 2175, 2174, 0, 0, 1036, 0, 0, 731,
+	952, 953, 954, 955, 956, 732, 1162, 0, 0, 0,
+	961, 0, 0, 1640, 0, 0, 0, 0, 0, 0,
+	0, 0, 471, 0, 0, 0, 95, 0, 471, 0,
+	0, 0, 976, 1358, 0, 1545, 0, 1559, 1560, 1561,
+	733, 0, 0, 0, 0, 1184, 1163, 0, 0, 0,
+	1695, 1696, 0, 1183, 0, 0, 0, 919, 920, 921,
+	922, 923, 925, 926, 924, 927, 0, 941, 0, 1300,
+	1300, 0, 0, 0, 1673, 0, 0, 0, 1675, 0,
+	1461, 1457, 0, 0, 1457, 0, 0, 0, 0, 0,
+	0, 0, 0, 1185, 0, 1183, 0, 0, 0, 1021,
+	736, 0, 1183, 0, 0, 0, 1162, 0, 1558, 0,
+	0, 733, 0, 1742, 1743, 1744, 1745, 1746, 1747, 1748,
+	1749, 1750, 1751, 1752, 1753, 1754, 1755, 1756, 1757, 0,
+	1762, 1183, 1300, 1300, 1300, 1300, 1300, 1300, 1300, 1300,
+	1300, 1300, 1300, 1300, 1300, 1300, 1300, 1300, 1732, 1300,
+	0, 0, 0, 0, 0, 0, 737, 0, 739, 0,
+	0, 0, 0, 0, 1163, 0, 0, 738, 0, 0,
+	0, 736, 1737, 0, 0, 0, 1163, 0, 0, 1741,
+	0, 0, 0, 0, 0, 0, 0, 1184, 1183, 0,
+	0, 0, 0, 1185, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 1770, 0,
+	0, 0, 0, 1159, 1182, 1563, 0, 0, 1386, 0,
+	0, 0, 740, 0, 95, 0, 0, 737, 1163, 739,
+	1163, 1801, 0, 912, 0, 1807, 1562, 912, 738, 0,
+	0, 0, 0, 1819, 0, 1820, 0, 0, 0, 735,
+	1163, 1839, 1840, 1841, 471, 1843, 1844, 1183, 0, 0,
+	0, 1058, 0, 0, 1855, 1828, 0, 0, 0, 0,
+	0, 1861, 0, 1163, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 1184, 0, 1381,
+	0, 0, 912, 740, 1882, 1884, 1432, 1433, 1434, 1457,
+	0, 1464, 0, 0, 0, 0, 0, 0, 0, 0,
+	1163, 1182, 0, 0, 734, 0, 0, 595, 0, 0,
+	735, 1195, 1196, 1197, 1198, 1199, 1200, 1201, 1202, 1203,
+	1204, 1205, 1206, 1207, 1208, 1209, 1210, 1211, 1212, 1213,
+	0, 1219, 0, 1221, 1222, 1223, 1224, 1162, 0, 0,
+	0, 1918, 0, 1182, 0, 1159, 0, 0, 0, 0,
+	1182, 0, 0, 916, 1945, 1163, 0, 1431, 942, 943,
+	944, 0, 0, 0, 0, 1183, 0, 0, 0, 0,
+	0, 0, 0, 1300, 0, 734, 1284, 1183, 1297, 1182,
+	1307, 1309, 1314, 1317, 918, 0, 0, 0, 0, 0,
+	0, 0, 1807, 0, 0, 0, 0, 1940, 0, 95,
+	0, 0, 0, 0, 0, 0, 471, 0, 0, 0,
+	0, 0, 0, 0, 700, 0, 0, 1338, 917, 0,
+	0, 0, 1957, 0, 1185, 0, 931, 0, 0, 1183,
+	0, 1183, 0, 0, 1162, 0, 1182, 0, 0, 0,
+	0, 0, 1959, 0, 0, 1159, 611, 33, 0, 731,
+	0, 1183, 1555, 1556, 1557, 732, 1546, 1547, 1548, 1549,
+	1550, 1552, 1553, 1551, 1554, 1399, 95, 0, 0, 0,
+	0, 1985, 0, 0, 1183, 33, 1162, 0, 0, 0,
+	2017, 0, 0, 1162, 0, 912, 1693, 1884, 0, 0,
+	0, 0, 0, 0, 448, 0, 0, 456, 0, 1300,
+	0, 0, 0, 0, 33, 1182, 1997, 0, 1997, 0,
+	0, 1183, 1162, 33, 456, 0, 1415, 0, 1184, 0,
+	0, 1185, 0, 0, 0, 908, 0, 731, 2011, 0,
+	0, 0, 0, 732, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 33, 0, 1286, 1286, 1286, 0,
-	0, 0, 0, 0, 1416, 97, 0, 0, 97, 0,
-	0, 1161, 0, 442, 0, 0, 450, 0, 1547, 1548,
-	1549, 0, 1720, 33, 0, 0, 0, 0, 0, 1724,
-	0, 0, 33, 450, 0, 920, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 463, 0, 0, 0,
-	0, 1178, 0, 1161, 0, 0, 0, 0, 1753, 0,
-	1161, 741, 0, 922, 1177, 0, 1157, 742, 0, 0,
-	0, 0, 0, 923, 924, 925, 926, 927, 929, 930,
-	928, 931, 0, 0, 0, 0, 0, 0, 0, 1161,
-	0, 0, 0, 0, 921, 0, 0, 0, 0, 0,
-	0, 0, 935, 0, 0, 0, 0, 0, 0, 1981,
-	97, 1981, 0, 783, 0, 1814, 0, 0, 0, 0,
-	0, 0, 97, 0, 97, 97, 0, 97, 0, 0,
-	97, 97, 0, 487, 0, 2107, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 1161, 0, 97, 0,
-	0, 97, 97, 0, 0, 0, 0, 97, 0, 0,
-	0, 97, 1681, 1157, 0, 0, 97, 0, 97, 0,
-	743, 97, 0, 0, 97, 0, 1286, 1286, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 1807, 2034, 95,
+	95, 733, 0, 1185, 0, 0, 1183, 0, 0, 1162,
+	1185, 0, 0, 2045, 2046, 2080, 471, 0, 0, 0,
+	0, 1855, 0, 0, 0, 0, 0, 0, 1828, 1807,
+	471, 0, 0, 0, 1300, 0, 0, 912, 0, 1185,
+	0, 0, 0, 0, 1882, 0, 1480, 1481, 1464, 0,
+	0, 0, 1487, 0, 0, 1184, 0, 0, 0, 0,
+	0, 736, 0, 1182, 0, 0, 0, 0, 0, 0,
+	731, 0, 1807, 0, 0, 1182, 732, 0, 1162, 733,
+	0, 1505, 0, 0, 0, 95, 0, 471, 0, 95,
+	0, 0, 1515, 0, 0, 0, 1185, 1184, 0, 0,
+	0, 0, 0, 0, 1184, 0, 0, 1528, 0, 0,
+	0, 1533, 0, 1985, 0, 0, 0, 737, 0, 739,
+	0, 0, 0, 1882, 0, 0, 1159, 1182, 738, 1182,
+	0, 471, 0, 1184, 0, 0, 961, 2034, 0, 736,
+	0, 0, 1314, 1314, 1314, 0, 0, 0, 0, 1182,
+	0, 1428, 1429, 1430, 0, 1419, 1420, 1421, 1422, 1423,
+	1424, 1425, 1426, 1427, 0, 1185, 1597, 0, 0, 595,
+	0, 0, 1182, 0, 0, 0, 0, 0, 0, 700,
+	0, 1807, 733, 740, 0, 0, 0, 0, 0, 0,
+	1184, 0, 1611, 0, 0, 737, 1162, 739, 0, 0,
+	0, 0, 0, 0, 0, 0, 738, 0, 1162, 1182,
+	735, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 1159, 919, 920, 921, 922, 923, 925,
+	926, 924, 927, 0, 941, 0, 0, 0, 0, 0,
+	0, 0, 736, 916, 0, 932, 933, 934, 942, 943,
+	944, 0, 0, 0, 0, 0, 0, 743, 0, 1184,
+	1162, 740, 1162, 0, 1182, 1159, 935, 0, 0, 0,
+	0, 0, 1159, 0, 918, 734, 0, 0, 0, 0,
+	946, 0, 1162, 1185, 0, 0, 0, 0, 735, 0,
+	0, 0, 0, 0, 0, 1185, 0, 0, 737, 0,
+	739, 1159, 0, 0, 0, 1162, 0, 0, 917, 738,
+	1690, 1691, 0, 0, 1694, 0, 931, 0, 1697, 0,
+	0, 0, 0, 0, 0, 0, 0, 1701, 0, 0,
+	0, 0, 0, 1707, 0, 0, 0, 0, 1712, 0,
+	0, 0, 1162, 0, 448, 1712, 0, 1185, 0, 1185,
+	0, 0, 0, 734, 0, 0, 0, 0, 1159, 1729,
+	729, 0, 0, 0, 740, 0, 0, 0, 0, 1185,
+	0, 0, 1738, 0, 0, 1740, 0, 1184, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 1184,
+	0, 735, 1185, 0, 0, 0, 0, 1162, 0, 0,
+	0, 0, 0, 0, 1768, 1769, 0, 0, 1545, 0,
+	1559, 1560, 1561, 1775, 0, 0, 0, 0, 939, 0,
+	0, 0, 0, 947, 0, 0, 0, 1159, 0, 1185,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 1679, 1680,
-	783, 0, 1175, 1160, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 1161, 0, 0, 0, 0,
-	0, 0, 0, 0, 2061, 0, 0, 0, 0, 746,
-	1034, 0, 0, 1528, 0, 1542, 1543, 1544, 1286, 1286,
-	1286, 1286, 1286, 1286, 1286, 1286, 1286, 1286, 1286, 1286,
-	1286, 1286, 1286, 1286, 0, 1286, 0, 0, 0, 0,
-	1725, 1726, 1727, 1728, 1729, 1730, 1731, 1732, 1733, 1734,
-	1735, 1736, 1737, 1738, 1739, 1740, 0, 1745, 0, 1178,
-	0, 0, 0, 0, 0, 747, 97, 749, 1627, 0,
-	97, 0, 1177, 97, 1637, 0, 748, 1175, 1160, 97,
-	1541, 0, 0, 0, 0, 1947, 0, 0, 0, 0,
+	0, 1184, 0, 1184, 945, 916, 0, 932, 933, 934,
+	942, 943, 944, 0, 0, 0, 734, 0, 937, 0,
+	0, 0, 0, 1184, 0, 0, 0, 448, 935, 0,
+	448, 448, 0, 0, 0, 0, 918, 0, 0, 0,
+	0, 1558, 946, 0, 1185, 0, 1184, 0, 0, 0,
+	0, 958, 0, 1864, 1867, 960, 0, 0, 0, 964,
+	965, 0, 0, 936, 0, 0, 0, 0, 0, 0,
+	917, 0, 0, 0, 0, 0, 0, 0, 931, 0,
+	0, 0, 0, 1184, 1895, 1896, 0, 0, 0, 0,
+	1900, 1901, 0, 0, 0, 0, 1905, 1906, 0, 0,
+	0, 0, 1909, 0, 0, 1159, 0, 1911, 916, 0,
+	932, 933, 934, 942, 943, 944, 0, 1159, 0, 0,
+	0, 0, 1916, 0, 0, 0, 1919, 0, 0, 0,
+	0, 935, 0, 0, 0, 0, 0, 0, 1184, 918,
+	0, 0, 940, 0, 0, 946, 0, 0, 0, 0,
+	33, 0, 33, 0, 0, 1928, 0, 0, 0, 0,
+	0, 0, 0, 33, 0, 0, 0, 0, 0, 1159,
+	0, 1159, 0, 917, 0, 0, 0, 0, 0, 0,
+	939, 931, 0, 0, 0, 947, 0, 0, 0, 0,
+	0, 1159, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 945, 1958, 0, 0,
+	0, 0, 0, 0, 1159, 0, 0, 0, 0, 0,
+	937, 0, 0, 0, 0, 0, 0, 938, 0, 0,
+	928, 929, 930, 0, 919, 920, 921, 922, 923, 925,
+	926, 924, 927, 0, 941, 0, 1333, 0, 0, 0,
+	0, 1159, 1334, 0, 0, 0, 0, 0, 0, 0,
+	0, 961, 0, 0, 0, 936, 1990, 0, 0, 0,
+	0, 0, 0, 0, 916, 0, 932, 933, 934, 942,
+	943, 944, 0, 939, 0, 0, 0, 0, 947, 2007,
+	2008, 2009, 0, 0, 0, 0, 0, 916, 0, 932,
+	933, 934, 942, 943, 944, 918, 1159, 0, 0, 945,
+	0, 946, 0, 0, 0, 0, 0, 0, 0, 0,
+	935, 0, 0, 937, 0, 0, 0, 0, 918, 0,
+	0, 700, 0, 0, 946, 0, 2027, 0, 0, 917,
+	0, 0, 0, 0, 940, 0, 0, 931, 0, 916,
+	0, 932, 933, 934, 942, 943, 944, 0, 0, 0,
+	0, 1147, 917, 0, 0, 0, 0, 0, 936, 0,
+	931, 0, 935, 0, 0, 0, 0, 0, 1867, 0,
+	918, 0, 0, 0, 0, 0, 946, 0, 0, 0,
+	0, 0, 0, 0, 1237, 1555, 1556, 1557, 0, 1546,
+	1547, 1548, 1549, 1550, 1552, 1553, 1551, 1554, 0, 0,
+	0, 0, 0, 0, 917, 0, 0, 0, 0, 0,
+	0, 0, 931, 0, 0, 0, 0, 0, 0, 938,
+	0, 0, 928, 929, 930, 0, 919, 920, 921, 922,
+	923, 925, 926, 924, 927, 0, 941, 940, 0, 939,
+	0, 0, 0, 1581, 947, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 97, 0, 0, 463,
-	0, 0, 463, 0, 0, 0, 1161, 0, 0, 1175,
-	1160, 97, 0, 0, 0, 0, 1175, 1160, 1161, 0,
-	0, 750, 0, 0, 1413, 1414, 1415, 0, 1404, 1405,
-	1406, 1407, 1408, 1409, 1410, 1411, 1412, 920, 783, 1986,
-	0, 1986, 0, 0, 1178, 1175, 1160, 745, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 1177, 0, 0,
-	2000, 1157, 0, 0, 0, 922, 0, 0, 0, 0,
-	1161, 0, 1161, 0, 0, 0, 0, 0, 0, 0,
-	0, 920, 0, 936, 937, 938, 1178, 442, 0, 0,
-	0, 1161, 0, 1178, 0, 0, 921, 1545, 0, 1177,
-	0, 939, 1175, 1160, 935, 0, 1177, 0, 0, 922,
-	744, 0, 0, 0, 1161, 945, 0, 97, 0, 97,
-	0, 0, 1178, 1814, 0, 97, 923, 924, 925, 926,
-	927, 929, 930, 928, 931, 1177, 0, 0, 97, 97,
-	921, 0, 97, 0, 0, 97, 0, 97, 935, 0,
-	97, 0, 0, 0, 1161, 0, 1157, 0, 97, 97,
-	1286, 97, 97, 97, 1677, 0, 0, 783, 0, 97,
-	0, 1175, 1160, 0, 97, 97, 97, 0, 97, 1178,
-	0, 0, 1931, 0, 0, 487, 0, 0, 0, 0,
-	0, 0, 1177, 0, 0, 0, 97, 97, 1157, 0,
-	0, 0, 0, 0, 97, 1157, 0, 0, 0, 0,
-	1161, 0, 0, 442, 0, 0, 442, 442, 463, 463,
-	0, 0, 463, 0, 0, 0, 0, 97, 0, 0,
-	0, 0, 97, 97, 1157, 97, 0, 957, 0, 0,
-	0, 959, 0, 0, 0, 963, 964, 0, 1178, 1528,
-	0, 1542, 1543, 1544, 946, 0, 0, 0, 0, 0,
-	0, 1177, 0, 0, 0, 0, 0, 0, 0, 1883,
-	0, 0, 0, 0, 0, 944, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 1286, 0, 941,
-	0, 1157, 1175, 1160, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 1175, 1160, 0, 0, 0, 2006,
-	0, 0, 0, 0, 0, 0, 1541, 0, 0, 0,
-	1538, 1539, 1540, 0, 1529, 1530, 1531, 1532, 1533, 1535,
-	1536, 1534, 1537, 0, 940, 0, 33, 0, 0, 0,
-	0, 0, 1528, 0, 1542, 1543, 1544, 0, 33, 0,
-	0, 1932, 0, 0, 0, 0, 1175, 1160, 1175, 1160,
-	1157, 0, 1688, 0, 0, 0, 0, 0, 0, 1178,
-	920, 0, 936, 937, 938, 0, 0, 1175, 1160, 0,
-	0, 1178, 1177, 0, 0, 0, 1286, 0, 0, 0,
-	939, 0, 0, 0, 1177, 0, 0, 0, 922, 0,
-	1175, 1160, 0, 0, 945, 97, 0, 741, 2073, 1541,
-	0, 0, 943, 742, 0, 0, 0, 0, 0, 0,
-	0, 97, 0, 0, 0, 0, 97, 0, 0, 921,
-	463, 0, 0, 1178, 0, 1178, 97, 935, 0, 97,
-	1175, 1160, 97, 1545, 0, 0, 1177, 0, 1177, 0,
-	0, 0, 0, 0, 1178, 0, 0, 0, 923, 924,
-	925, 926, 927, 929, 930, 928, 931, 1177, 0, 0,
-	97, 0, 0, 0, 0, 0, 0, 1178, 0, 97,
-	0, 1157, 0, 97, 0, 97, 0, 0, 0, 0,
-	1177, 0, 0, 1157, 0, 942, 1175, 1160, 932, 933,
-	934, 0, 923, 924, 925, 926, 927, 929, 930, 928,
-	931, 0, 0, 0, 1319, 0, 743, 1178, 0, 0,
-	1320, 0, 0, 0, 0, 733, 0, 0, 0, 0,
-	1177, 97, 0, 0, 0, 97, 1545, 97, 97, 0,
-	2057, 97, 0, 946, 0, 1157, 0, 1157, 0, 920,
-	0, 936, 937, 938, 1145, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 944, 0, 1157, 0, 920, 939,
-	936, 937, 938, 1178, 0, 746, 0, 922, 941, 0,
-	0, 0, 1224, 945, 0, 0, 1177, 0, 939, 1157,
-	0, 0, 0, 0, 0, 0, 922, 0, 0, 0,
-	2088, 0, 945, 97, 0, 0, 0, 0, 921, 0,
-	0, 0, 0, 0, 0, 0, 935, 0, 0, 0,
-	0, 0, 0, 940, 0, 0, 0, 921, 0, 1157,
-	0, 747, 0, 749, 0, 935, 0, 0, 0, 0,
-	0, 0, 748, 0, 0, 1034, 0, 0, 0, 0,
+	0, 0, 939, 0, 0, 456, 0, 947, 0, 0,
+	2123, 0, 0, 0, 0, 0, 2127, 0, 0, 937,
+	0, 0, 0, 0, 0, 0, 0, 0, 945, 0,
+	0, 2141, 2141, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 937, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 939, 0, 0, 0, 0, 947,
+	2141, 0, 938, 0, 0, 928, 929, 930, 0, 919,
+	920, 921, 922, 923, 925, 926, 924, 927, 0, 941,
+	945, 0, 0, 2151, 0, 0, 0, 936, 0, 33,
+	0, 0, 2141, 0, 937, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 916, 0, 932, 933, 934, 942,
+	943, 944, 0, 0, 0, 33, 0, 0, 0, 0,
+	0, 0, 0, 1463, 0, 0, 1466, 935, 0, 0,
+	0, 0, 0, 0, 0, 918, 0, 0, 0, 936,
+	0, 946, 0, 940, 0, 0, 0, 0, 916, 0,
+	932, 933, 934, 942, 943, 944, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 940, 0, 0, 917,
+	0, 935, 0, 0, 0, 0, 0, 931, 0, 918,
+	0, 0, 0, 0, 1545, 946, 1559, 1560, 1561, 0,
+	0, 0, 0, 0, 0, 1237, 0, 1545, 0, 1559,
+	1560, 1561, 0, 0, 0, 0, 0, 1899, 0, 0,
+	0, 0, 0, 917, 0, 960, 1500, 0, 940, 0,
+	1898, 931, 0, 0, 0, 0, 0, 0, 938, 0,
+	0, 928, 929, 930, 0, 919, 920, 921, 922, 923,
+	925, 926, 924, 927, 0, 941, 0, 0, 0, 0,
+	0, 938, 0, 0, 928, 929, 930, 1558, 919, 920,
+	921, 922, 923, 925, 926, 924, 927, 0, 941, 916,
+	1558, 0, 2085, 0, 942, 943, 944, 0, 0, 939,
+	0, 960, 0, 0, 947, 1545, 0, 1559, 1560, 1561,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 450, 0, 0, 1538, 1539, 1540, 0,
-	1529, 1530, 1531, 1532, 1533, 1535, 1536, 1534, 1537, 97,
-	0, 0, 0, 0, 0, 1157, 97, 0, 97, 0,
-	0, 0, 97, 753, 0, 0, 0, 750, 97, 0,
-	97, 943, 0, 783, 1820, 783, 97, 97, 97, 97,
-	97, 97, 0, 0, 0, 0, 97, 0, 0, 97,
-	0, 0, 946, 745, 741, 0, 0, 0, 97, 0,
-	742, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 946, 0, 944, 0, 0, 33, 0, 0, 97,
-	0, 97, 97, 0, 0, 0, 97, 941, 0, 1538,
-	1539, 1540, 944, 1529, 1530, 1531, 1532, 1533, 1535, 1536,
-	1534, 1537, 33, 920, 0, 0, 941, 0, 0, 0,
-	1448, 0, 0, 1451, 942, 0, 744, 932, 933, 934,
-	0, 923, 924, 925, 926, 927, 929, 930, 928, 931,
-	0, 922, 940, 0, 0, 0, 97, 920, 1564, 936,
-	937, 938, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 940, 0, 0, 0, 0, 0, 939, 0, 0,
-	0, 0, 921, 743, 0, 922, 0, 0, 0, 0,
-	935, 945, 0, 0, 0, 920, 1224, 936, 937, 938,
+	918, 0, 0, 938, 0, 945, 928, 929, 930, 0,
+	919, 920, 921, 922, 923, 925, 926, 924, 927, 937,
+	941, 0, 0, 939, 2021, 0, 0, 0, 947, 0,
+	0, 0, 0, 0, 917, 0, 0, 0, 0, 0,
+	0, 0, 931, 0, 0, 0, 0, 0, 0, 945,
+	916, 0, 932, 933, 934, 942, 943, 944, 1558, 0,
+	0, 0, 0, 937, 936, 0, 0, 0, 0, 916,
+	0, 0, 0, 935, 942, 943, 944, 0, 0, 0,
+	0, 918, 0, 0, 0, 1562, 0, 946, 0, 916,
+	0, 932, 933, 934, 942, 943, 944, 0, 1562, 0,
+	918, 0, 1692, 0, 0, 0, 0, 0, 936, 0,
+	0, 0, 935, 0, 0, 917, 0, 0, 0, 0,
+	918, 0, 1147, 931, 916, 1147, 946, 0, 0, 942,
+	943, 944, 0, 0, 917, 0, 0, 0, 0, 0,
+	0, 0, 931, 940, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 917, 918, 0, 0, 0, 0,
+	0, 0, 931, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 960, 0,
+	0, 0, 0, 0, 0, 0, 1562, 940, 0, 917,
+	0, 0, 0, 0, 0, 0, 0, 931, 0, 0,
+	0, 0, 1486, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 959, 1483, 97, 0, 97, 921, 0, 0, 0,
-	0, 0, 97, 922, 935, 0, 0, 0, 0, 945,
-	943, 0, 920, 0, 936, 937, 938, 0, 0, 0,
-	97, 0, 746, 0, 0, 0, 0, 0, 0, 943,
-	1464, 0, 939, 0, 921, 0, 1820, 0, 0, 0,
-	922, 0, 935, 0, 0, 0, 945, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 959, 0, 0, 0,
-	0, 97, 97, 0, 0, 0, 0, 0, 0, 97,
-	1528, 921, 1542, 1543, 1544, 0, 0, 0, 747, 935,
-	749, 0, 0, 97, 0, 97, 0, 0, 0, 748,
-	1687, 0, 0, 942, 0, 0, 932, 933, 934, 0,
-	923, 924, 925, 926, 927, 929, 930, 928, 931, 0,
-	946, 0, 942, 0, 2149, 932, 933, 934, 0, 923,
-	924, 925, 926, 927, 929, 930, 928, 931, 0, 0,
-	0, 944, 0, 2079, 0, 0, 0, 1541, 0, 0,
-	739, 0, 97, 0, 750, 941, 0, 0, 946, 0,
-	0, 0, 0, 0, 0, 0, 97, 97, 97, 97,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 944,
-	745, 0, 1820, 97, 97, 920, 97, 936, 937, 938,
-	0, 97, 0, 941, 0, 946, 0, 0, 0, 0,
-	940, 97, 0, 0, 0, 939, 1145, 0, 97, 1145,
-	0, 0, 0, 922, 0, 97, 944, 0, 0, 945,
-	0, 0, 920, 0, 936, 937, 938, 0, 0, 0,
-	941, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 939, 744, 921, 0, 0, 0, 0, 0,
-	922, 0, 935, 0, 0, 0, 945, 97, 0, 0,
-	959, 97, 0, 97, 0, 0, 0, 0, 1167, 0,
-	1182, 1162, 1174, 0, 1545, 940, 0, 0, 943, 0,
-	0, 921, 0, 1184, 1183, 0, 0, 0, 97, 935,
-	0, 0, 0, 0, 0, 0, 0, 0, 97, 0,
-	0, 0, 0, 0, 0, 0, 97, 0, 0, 0,
-	0, 0, 0, 97, 0, 0, 943, 97, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 1179,
-	0, 0, 1172, 1171, 923, 924, 925, 926, 927, 929,
-	930, 928, 931, 0, 0, 0, 0, 0, 0, 0,
-	0, 1170, 0, 943, 0, 0, 0, 0, 946, 0,
-	33, 942, 0, 0, 932, 933, 934, 0, 923, 924,
-	925, 926, 927, 929, 930, 928, 931, 1169, 0, 944,
-	0, 0, 2030, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 941, 0, 946, 0, 0, 0, 942,
-	0, 0, 932, 933, 934, 0, 923, 924, 925, 926,
-	927, 929, 930, 928, 931, 0, 944, 0, 0, 0,
-	0, 1164, 1165, 0, 779, 1145, 1145, 0, 0, 1145,
-	941, 0, 0, 0, 0, 0, 942, 0, 940, 932,
-	933, 934, 0, 923, 924, 925, 926, 927, 929, 930,
-	928, 931, 0, 0, 0, 0, 0, 2010, 0, 0,
+	0, 0, 0, 0, 0, 939, 0, 0, 938, 0,
+	947, 928, 929, 930, 0, 919, 920, 921, 922, 923,
+	925, 926, 924, 927, 0, 941, 0, 0, 0, 2016,
+	0, 945, 0, 0, 0, 0, 0, 1479, 0, 0,
+	0, 0, 0, 0, 939, 937, 0, 0, 0, 947,
+	0, 0, 938, 0, 0, 928, 929, 930, 33, 919,
+	920, 921, 922, 923, 925, 926, 924, 927, 0, 941,
+	945, 0, 0, 2012, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 937, 0, 0, 0, 0, 0,
+	936, 1555, 1556, 1557, 0, 1546, 1547, 1548, 1549, 1550,
+	1552, 1553, 1551, 1554, 1555, 1556, 1557, 0, 1546, 1547,
+	1548, 1549, 1550, 1552, 1553, 1551, 1554, 0, 0, 0,
+	0, 1147, 1147, 0, 0, 1147, 0, 0, 0, 936,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 940, 1173, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 1538, 1539, 1540,
-	0, 1529, 1530, 1531, 1532, 1533, 1535, 1536, 1534, 1537,
-	0, 0, 0, 0, 0, 0, 943, 0, 0, 1168,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 940,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 1924, 0, 0,
-	0, 0, 0, 943, 0, 0, 0, 0, 0, 0,
-	0, 1156, 0, 0, 0, 0, 0, 1166, 0, 0,
+	919, 920, 921, 922, 923, 925, 926, 924, 927, 0,
+	941, 0, 1555, 1556, 1557, 0, 1546, 1547, 1548, 1549,
+	1550, 1552, 1553, 1551, 1554, 0, 0, 0, 940, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 1163, 0, 1181, 1180, 0, 0, 0, 942,
-	0, 0, 932, 933, 934, 0, 923, 924, 925, 926,
-	927, 929, 930, 928, 931, 0, 0, 0, 0, 33,
-	2005, 0, 0, 0, 0, 0, 1185, 0, 0, 0,
-	0, 959, 0, 0, 0, 0, 942, 1145, 0, 932,
-	933, 934, 0, 923, 924, 925, 926, 927, 929, 930,
-	928, 931, 0, 0, 0, 0, 0, 2001, 0, 0,
-	1819, 721, 1813, 0, 0, 726, 0, 0, 0, 1417,
-	1418, 1419, 0, 99, 100, 101, 102, 103, 104, 105,
-	106, 787, 107, 108, 109, 788, 789, 790, 791, 792,
-	793, 794, 110, 111, 795, 112, 113, 490, 114, 115,
-	116, 959, 1167, 491, 1182, 1162, 1174, 796, 117, 118,
-	119, 120, 121, 797, 798, 420, 122, 1184, 1183, 123,
-	799, 124, 125, 126, 127, 0, 800, 492, 801, 128,
-	129, 130, 131, 132, 1416, 493, 133, 134, 135, 802,
-	136, 137, 138, 139, 140, 141, 803, 494, 142, 143,
-	144, 804, 805, 806, 495, 807, 808, 809, 145, 146,
-	147, 148, 149, 1179, 150, 151, 1172, 1171, 152, 810,
-	153, 811, 154, 155, 156, 157, 158, 812, 159, 160,
-	161, 813, 814, 162, 163, 661, 165, 166, 815, 167,
-	168, 169, 816, 170, 171, 172, 817, 173, 174, 175,
-	176, 0, 177, 178, 179, 0, 818, 180, 819, 181,
-	182, 1169, 183, 820, 184, 821, 185, 496, 822, 497,
-	186, 187, 188, 823, 189, 190, 0, 824, 0, 191,
-	825, 192, 193, 194, 195, 196, 197, 198, 199, 200,
-	826, 201, 202, 203, 204, 205, 206, 827, 207, 498,
-	0, 208, 209, 210, 211, 1164, 1165, 828, 779, 829,
-	212, 499, 213, 500, 214, 215, 216, 217, 218, 830,
-	831, 219, 0, 501, 220, 502, 832, 221, 222, 421,
-	833, 834, 223, 224, 225, 226, 227, 228, 229, 230,
-	231, 232, 233, 234, 235, 236, 422, 0, 503, 0,
-	237, 238, 0, 835, 239, 240, 241, 836, 0, 242,
-	1173, 243, 244, 245, 837, 246, 838, 839, 247, 248,
-	840, 841, 249, 0, 504, 250, 505, 0, 251, 252,
-	253, 254, 255, 256, 257, 842, 258, 259, 0, 260,
-	0, 263, 261, 262, 843, 264, 265, 266, 267, 268,
-	269, 270, 271, 1168, 272, 273, 274, 275, 844, 276,
-	277, 278, 279, 280, 281, 282, 283, 284, 285, 286,
-	845, 287, 288, 506, 289, 290, 291, 0, 292, 293,
-	294, 295, 296, 297, 298, 299, 846, 300, 301, 302,
-	303, 423, 847, 304, 305, 1816, 306, 307, 507, 308,
-	309, 1166, 310, 848, 311, 312, 313, 314, 315, 316,
-	317, 318, 319, 320, 321, 0, 849, 322, 323, 850,
-	324, 508, 325, 326, 327, 328, 1821, 851, 1181, 1180,
-	852, 853, 424, 330, 0, 331, 0, 854, 332, 333,
-	334, 335, 336, 337, 338, 855, 856, 339, 340, 341,
-	342, 343, 857, 858, 344, 345, 346, 347, 348, 0,
-	1185, 859, 349, 509, 350, 351, 352, 353, 860, 861,
-	354, 862, 863, 355, 356, 357, 358, 359, 360, 361,
-	362, 0, 0, 0, 1413, 1414, 1415, 782, 1817, 1818,
-	1406, 1407, 1408, 1409, 1410, 1411, 1412, 0, 0, 0,
-	99, 100, 101, 102, 103, 104, 105, 106, 787, 107,
-	108, 109, 788, 789, 790, 791, 792, 793, 794, 110,
-	111, 795, 112, 113, 490, 114, 115, 116, 363, 364,
-	491, 365, 0, 366, 796, 117, 118, 119, 120, 121,
-	797, 798, 420, 122, 367, 368, 123, 799, 124, 125,
-	126, 127, 369, 800, 492, 801, 128, 129, 130, 131,
-	132, 0, 493, 133, 134, 135, 802, 136, 137, 138,
-	139, 140, 141, 803, 494, 142, 143, 144, 804, 805,
-	806, 495, 807, 808, 809, 145, 146, 147, 148, 149,
-	370, 150, 151, 371, 372, 152, 810, 153, 811, 154,
-	155, 156, 157, 158, 812, 159, 160, 161, 813, 814,
-	162, 163, 164, 165, 166, 815, 167, 168, 169, 816,
-	170, 171, 172, 817, 173, 174, 175, 176, 373, 177,
-	178, 179, 374, 818, 180, 819, 181, 182, 375, 183,
-	820, 184, 821, 185, 496, 822, 497, 186, 187, 188,
-	823, 189, 190, 376, 824, 377, 191, 825, 192, 193,
-	194, 195, 196, 197, 198, 199, 200, 826, 201, 202,
-	203, 204, 205, 206, 827, 207, 498, 378, 208, 209,
-	210, 211, 379, 380, 828, 381, 829, 212, 499, 213,
-	500, 214, 215, 216, 217, 218, 830, 831, 219, 382,
-	501, 220, 502, 832, 221, 222, 421, 833, 834, 223,
-	224, 225, 226, 227, 228, 229, 230, 231, 232, 233,
-	234, 235, 236, 422, 383, 503, 384, 237, 238, 385,
-	835, 239, 240, 241, 836, 386, 242, 387, 243, 244,
-	245, 837, 246, 838, 839, 247, 248, 840, 841, 249,
-	388, 504, 250, 505, 389, 251, 252, 253, 254, 255,
-	256, 257, 842, 258, 259, 390, 260, 391, 263, 261,
-	262, 843, 264, 265, 266, 267, 268, 269, 270, 271,
-	392, 272, 273, 274, 275, 844, 276, 277, 278, 279,
-	280, 281, 282, 283, 284, 285, 286, 845, 287, 288,
-	506, 289, 290, 291, 393, 292, 293, 294, 295, 296,
-	297, 298, 299, 846, 300, 301, 302, 303, 423, 847,
-	304, 305, 394, 306, 307, 507, 308, 309, 395, 310,
-	848, 311, 312, 313, 314, 315, 316, 317, 318, 319,
-	320, 321, 396, 849, 322, 323, 850, 324, 508, 325,
-	326, 327, 328, 329, 851, 425, 397, 852, 853, 424,
-	330, 398, 331, 399, 854, 332, 333, 334, 335, 336,
-	337, 338, 855, 856, 339, 340, 341, 342, 343, 857,
-	858, 344, 345, 346, 347, 348, 400, 401, 859, 349,
-	509, 350, 351, 352, 353, 860, 861, 354, 862, 863,
-	355, 356, 357, 358, 359, 360, 361, 362, 782, 0,
-	0, 0, 0, 0, 0, 0, 0, 1019, 0, 0,
-	0, 99, 100, 101, 102, 103, 104, 105, 106, 787,
-	107, 108, 109, 788, 789, 790, 791, 792, 793, 794,
-	110, 111, 795, 112, 113, 490, 114, 115, 116, 363,
-	364, 491, 365, 0, 366, 796, 117, 118, 119, 120,
-	121, 797, 798, 420, 122, 367, 368, 123, 799, 124,
-	125, 126, 127, 369, 800, 492, 801, 128, 129, 130,
-	131, 132, 0, 493, 133, 134, 135, 802, 136, 137,
-	138, 139, 140, 141, 803, 494, 142, 143, 144, 804,
-	805, 806, 495, 807, 808, 809, 145, 146, 147, 148,
-	149, 370, 150, 151, 371, 372, 152, 810, 153, 811,
-	154, 155, 156, 157, 158, 812, 159, 160, 161, 813,
-	814, 162, 163, 164, 165, 166, 815, 167, 168, 169,
-	816, 170, 171, 172, 817, 173, 174, 175, 176, 373,
-	177, 178, 179, 374, 818, 180, 819, 181, 182, 375,
-	183, 820, 184, 821, 185, 496, 822, 497, 186, 187,
-	188, 823, 189, 190, 376, 824, 377, 191, 825, 192,
-	193, 194, 195, 196, 197, 198, 199, 200, 826, 201,
-	202, 203, 204, 205, 206, 827, 207, 498, 378, 208,
-	209, 210, 211, 379, 380, 828, 381, 829, 212, 499,
-	213, 500, 214, 215, 216, 217, 218, 830, 831, 219,
-	382, 501, 220, 502, 832, 221, 222, 421, 833, 834,
-	223, 224, 225, 226, 227, 228, 229, 230, 231, 232,
-	233, 234, 235, 236, 422, 383, 503, 384, 237, 238,
-	385, 835, 239, 240, 241, 836, 386, 242, 387, 243,
-	244, 245, 837, 246, 838, 839, 247, 248, 840, 841,
-	249, 388, 504, 250, 505, 389, 251, 252, 253, 254,
-	255, 256, 257, 842, 258, 259, 390, 260, 391, 263,
-	261, 262, 843, 264, 265, 266, 267, 268, 269, 270,
-	271, 392, 272, 273, 274, 275, 844, 276, 277, 278,
-	279, 280, 281, 282, 283, 284, 285, 286, 845, 287,
-	288, 506, 289, 290, 291, 393, 292, 293, 294, 295,
-	296, 297, 298, 299, 846, 300, 301, 302, 303, 423,
-	847, 304, 305, 394, 306, 307, 507, 308, 309, 395,
-	310, 848, 311, 312, 313, 314, 315, 316, 317, 318,
-	319, 320, 321, 396, 849, 322, 323, 850, 324, 508,
-	325, 326, 327, 328, 329, 851, 425, 397, 852, 853,
-	424, 330, 398, 331, 399, 854, 332, 333, 334, 335,
-	336, 337, 338, 855, 856, 339, 340, 341, 342, 343,
-	857, 858, 344, 345, 346, 347, 348, 400, 401, 859,
-	349, 509, 350, 351, 352, 353, 860, 861, 354, 862,
-	863, 355, 356, 357, 358, 359, 360, 361, 362, 630,
-	617, 618, 619, 620, 616, 604, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 1938, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 938, 0, 0, 928, 929, 930,
+	0, 919, 920, 921, 922, 923, 925, 926, 924, 927,
+	0, 941, 0, 0, 0, 1931, 0, 0, 0, 0,
+	919, 920, 921, 922, 923, 925, 926, 924, 927, 0,
+	941, 0, 0, 938, 0, 0, 928, 929, 930, 0,
+	919, 920, 921, 922, 923, 925, 926, 924, 927, 0,
+	941, 0, 0, 0, 1907, 0, 33, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 960, 0, 0, 0,
+	0, 0, 1147, 0, 0, 919, 920, 921, 922, 923,
+	925, 926, 924, 927, 0, 941, 0, 0, 0, 0,
+	0, 0, 1833, 758, 1827, 0, 0, 763, 0, 0,
+	0, 1432, 1433, 1434, 0, 0, 0, 0, 99, 100,
+	101, 102, 103, 104, 105, 106, 783, 107, 108, 109,
+	784, 785, 786, 787, 788, 789, 790, 110, 111, 791,
+	112, 113, 496, 114, 115, 116, 960, 1174, 1170, 497,
+	1189, 1164, 1181, 792, 117, 1836, 1835, 120, 121, 122,
+	123, 793, 794, 426, 124, 1191, 1190, 125, 795, 126,
+	127, 128, 129, 0, 796, 498, 797, 130, 131, 132,
+	133, 134, 1431, 499, 135, 136, 137, 798, 138, 139,
+	140, 141, 142, 143, 799, 500, 144, 145, 146, 800,
+	801, 802, 501, 803, 804, 805, 147, 148, 149, 150,
+	151, 1186, 152, 153, 1179, 1178, 154, 806, 155, 807,
+	156, 157, 158, 159, 160, 808, 161, 162, 163, 809,
+	810, 164, 165, 664, 167, 168, 811, 169, 170, 171,
+	812, 172, 173, 174, 813, 175, 176, 177, 178, 0,
+	179, 180, 181, 0, 814, 182, 815, 183, 184, 1176,
+	185, 816, 186, 817, 187, 502, 818, 503, 188, 189,
+	190, 819, 191, 192, 0, 820, 0, 193, 821, 194,
+	195, 196, 197, 198, 504, 199, 200, 201, 202, 822,
+	203, 204, 205, 206, 207, 208, 823, 209, 505, 0,
+	210, 211, 212, 213, 1171, 1172, 824, 775, 825, 214,
+	506, 215, 507, 216, 217, 218, 219, 220, 826, 827,
+	221, 0, 508, 222, 509, 828, 223, 224, 427, 829,
+	830, 225, 226, 227, 228, 229, 230, 231, 232, 233,
+	234, 235, 236, 237, 238, 428, 0, 510, 0, 239,
+	240, 0, 831, 241, 242, 243, 832, 0, 244, 1180,
+	245, 246, 247, 833, 248, 834, 835, 249, 250, 836,
+	837, 251, 0, 511, 252, 512, 0, 253, 254, 255,
+	256, 257, 258, 259, 838, 260, 261, 0, 262, 0,
+	265, 263, 264, 839, 266, 267, 268, 269, 270, 271,
+	272, 273, 1175, 274, 275, 276, 277, 840, 278, 279,
+	280, 281, 282, 283, 284, 285, 286, 287, 288, 841,
+	289, 290, 513, 291, 292, 293, 0, 294, 295, 296,
+	297, 298, 299, 300, 301, 842, 302, 303, 1168, 304,
+	305, 429, 843, 306, 307, 1830, 308, 309, 514, 310,
+	311, 1173, 1169, 312, 844, 313, 314, 315, 316, 317,
+	318, 319, 320, 321, 322, 323, 0, 845, 324, 325,
+	846, 326, 515, 327, 328, 329, 330, 1837, 847, 1188,
+	1187, 848, 849, 430, 332, 0, 333, 0, 850, 334,
+	335, 336, 337, 338, 339, 340, 851, 852, 341, 342,
+	343, 344, 345, 346, 853, 854, 347, 348, 349, 350,
+	351, 0, 1192, 855, 352, 516, 353, 354, 355, 356,
+	856, 857, 357, 858, 859, 358, 359, 360, 361, 362,
+	363, 364, 365, 778, 0, 0, 1428, 1429, 1430, 0,
+	1831, 1832, 1421, 1422, 1423, 1424, 1425, 1426, 1427, 99,
+	100, 101, 102, 103, 104, 105, 106, 783, 107, 108,
+	109, 784, 785, 786, 787, 788, 789, 790, 110, 111,
+	791, 112, 113, 496, 114, 115, 116, 366, 367, 368,
+	497, 369, 0, 370, 792, 117, 118, 119, 120, 121,
+	122, 123, 793, 794, 426, 124, 371, 372, 125, 795,
+	126, 127, 128, 129, 373, 796, 498, 797, 130, 131,
+	132, 133, 134, 0, 499, 135, 136, 137, 798, 138,
+	139, 140, 141, 142, 143, 799, 500, 144, 145, 146,
+	800, 801, 802, 501, 803, 804, 805, 147, 148, 149,
+	150, 151, 374, 152, 153, 375, 376, 154, 806, 155,
+	807, 156, 157, 158, 159, 160, 808, 161, 162, 163,
+	809, 810, 164, 165, 166, 167, 168, 811, 169, 170,
+	171, 812, 172, 173, 174, 813, 175, 176, 177, 178,
+	377, 179, 180, 181, 378, 814, 182, 815, 183, 184,
+	379, 185, 816, 186, 817, 187, 502, 818, 503, 188,
+	189, 190, 819, 191, 192, 380, 820, 381, 193, 821,
+	194, 195, 196, 197, 198, 504, 199, 200, 201, 202,
+	822, 203, 204, 205, 206, 207, 208, 823, 209, 505,
+	382, 210, 211, 212, 213, 383, 384, 824, 385, 825,
+	214, 506, 215, 507, 216, 217, 218, 219, 220, 826,
+	827, 221, 386, 508, 222, 509, 828, 223, 224, 427,
+	829, 830, 225, 226, 227, 228, 229, 230, 231, 232,
+	233, 234, 235, 236, 237, 238, 428, 387, 510, 388,
+	239, 240, 389, 831, 241, 242, 243, 832, 390, 244,
+	391, 245, 246, 247, 833, 248, 834, 835, 249, 250,
+	836, 837, 251, 392, 511, 252, 512, 393, 253, 254,
+	255, 256, 257, 258, 259, 838, 260, 261, 394, 262,
+	395, 265, 263, 264, 839, 266, 267, 268, 269, 270,
+	271, 272, 273, 396, 274, 275, 276, 277, 840, 278,
+	279, 280, 281, 282, 283, 284, 285, 286, 287, 288,
+	841, 289, 290, 513, 291, 292, 293, 397, 294, 295,
+	296, 297, 298, 299, 300, 301, 842, 302, 303, 398,
+	304, 305, 429, 843, 306, 307, 399, 308, 309, 514,
+	310, 311, 400, 401, 312, 844, 313, 314, 315, 316,
+	317, 318, 319, 320, 321, 322, 323, 402, 845, 324,
+	325, 846, 326, 515, 327, 328, 329, 330, 331, 847,
+	431, 403, 848, 849, 430, 332, 404, 333, 405, 850,
+	334, 335, 336, 337, 338, 339, 340, 851, 852, 341,
+	342, 343, 344, 345, 346, 853, 854, 347, 348, 349,
+	350, 351, 406, 407, 855, 352, 516, 353, 354, 355,
+	356, 856, 857, 357, 858, 859, 358, 359, 360, 361,
+	362, 363, 364, 365, 778, 0, 0, 0, 0, 0,
+	0, 0, 0, 1020, 0, 0, 0, 0, 0, 0,
+	99, 100, 101, 102, 103, 104, 105, 106, 783, 107,
+	108, 109, 784, 785, 786, 787, 788, 789, 790, 110,
+	111, 791, 112, 113, 496, 114, 115, 116, 366, 367,
+	368, 497, 369, 0, 370, 792, 117, 118, 119, 120,
+	121, 122, 123, 793, 794, 426, 124, 371, 372, 125,
+	795, 126, 127, 128, 129, 373, 796, 498, 797, 130,
+	131, 132, 133, 134, 0, 499, 135, 136, 137, 798,
+	138, 139, 140, 141, 142, 143, 799, 500, 144, 145,
+	146, 800, 801, 802, 501, 803, 804, 805, 147, 148,
+	149, 150, 151, 374, 152, 153, 375, 376, 154, 806,
+	155, 807, 156, 157, 158, 159, 160, 808, 161, 162,
+	163, 809, 810, 164, 165, 166, 167, 168, 811, 169,
+	170, 171, 812, 172, 173, 174, 813, 175, 176, 177,
+	178, 377, 179, 180, 181, 378, 814, 182, 815, 183,
+	184, 379, 185, 816, 186, 817, 187, 502, 818, 503,
+	188, 189, 190, 819, 191, 192, 380, 820, 381, 193,
+	821, 194, 195, 196, 197, 198, 504, 199, 200, 201,
+	202, 822, 203, 204, 205, 206, 207, 208, 823, 209,
+	505, 382, 210, 211, 212, 213, 383, 384, 824, 385,
+	825, 214, 506, 215, 507, 216, 217, 218, 219, 220,
+	826, 827, 221, 386, 508, 222, 509, 828, 223, 224,
+	427, 829, 830, 225, 226, 227, 228, 229, 230, 231,
+	232, 233, 234, 235, 236, 237, 238, 428, 387, 510,
+	388, 239, 240, 389, 831, 241, 242, 243, 832, 390,
+	244, 391, 245, 246, 247, 833, 248, 834, 835, 249,
+	250, 836, 837, 251, 392, 511, 252, 512, 393, 253,
+	254, 255, 256, 257, 258, 259, 838, 260, 261, 394,
+	262, 395, 265, 263, 264, 839, 266, 267, 268, 269,
+	270, 271, 272, 273, 396, 274, 275, 276, 277, 840,
+	278, 279, 280, 281, 282, 283, 284, 285, 286, 287,
+	288, 841, 289, 290, 513, 291, 292, 293, 397, 294,
+	295, 296, 297, 298, 299, 300, 301, 842, 302, 303,
+	398, 304, 305, 429, 843, 306, 307, 399, 308, 309,
+	514, 310, 311, 400, 401, 312, 844, 313, 314, 315,
+	316, 317, 318, 319, 320, 321, 322, 323, 402, 845,
+	324, 325, 846, 326, 515, 327, 328, 329, 330, 331,
+	847, 431, 403, 848, 849, 430, 332, 404, 333, 405,
+	850, 334, 335, 336, 337, 338, 339, 340, 851, 852,
+	341, 342, 343, 344, 345, 346, 853, 854, 347, 348,
+	349, 350, 351, 406, 407, 855, 352, 516, 353, 354,
+	355, 356, 856, 857, 357, 858, 859, 358, 359, 360,
+	361, 362, 363, 364, 365, 633, 620, 621, 622, 623,
+	619, 607, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 99, 100, 101, 102, 103, 104, 105, 106, 0,
+	107, 108, 109, 0, 0, 0, 0, 613, 0, 0,
+	110, 111, 0, 112, 113, 496, 114, 115, 116, 366,
+	665, 368, 497, 666, 0, 667, 0, 117, 118, 119,
+	120, 121, 122, 123, 630, 653, 426, 124, 668, 669,
+	125, 0, 126, 127, 128, 129, 661, 0, 641, 0,
+	130, 131, 132, 133, 134, 0, 499, 135, 136, 137,
+	0, 138, 139, 140, 141, 142, 143, 0, 500, 144,
+	145, 146, 651, 642, 647, 652, 643, 644, 648, 147,
+	148, 149, 150, 151, 670, 152, 153, 671, 672, 154,
+	0, 155, 0, 156, 157, 158, 159, 160, 0, 161,
+	162, 163, 0, 0, 164, 165, 664, 167, 168, 0,
+	169, 170, 171, 0, 172, 173, 174, 0, 175, 176,
+	177, 178, 612, 179, 180, 181, 654, 628, 182, 0,
+	183, 184, 673, 185, 0, 186, 0, 187, 502, 0,
+	503, 188, 189, 190, 0, 191, 192, 662, 0, 616,
+	193, 0, 194, 195, 196, 197, 198, 504, 199, 200,
+	201, 202, 0, 203, 204, 205, 206, 207, 208, 0,
+	209, 505, 382, 210, 211, 212, 213, 674, 675, 0,
+	640, 0, 214, 506, 215, 507, 216, 217, 218, 219,
+	220, 0, 0, 221, 663, 508, 222, 509, 0, 223,
+	224, 427, 645, 646, 225, 226, 227, 228, 229, 230,
+	231, 232, 233, 234, 235, 236, 237, 238, 428, 387,
+	510, 388, 239, 240, 389, 601, 241, 242, 243, 629,
+	660, 244, 676, 245, 246, 247, 0, 248, 0, 0,
+	249, 250, 0, 0, 251, 392, 511, 252, 512, 655,
+	253, 254, 255, 256, 257, 258, 259, 0, 260, 261,
+	656, 262, 395, 265, 263, 264, 0, 266, 267, 268,
+	269, 270, 271, 272, 273, 677, 274, 275, 276, 277,
+	0, 278, 279, 280, 281, 282, 283, 284, 285, 286,
+	287, 288, 0, 289, 290, 513, 291, 292, 293, 617,
+	294, 295, 296, 297, 298, 299, 300, 301, 53, 302,
+	303, 398, 304, 305, 429, 649, 306, 307, 399, 308,
+	309, 514, 310, 311, 678, 401, 312, 0, 313, 314,
+	315, 316, 317, 318, 319, 320, 321, 322, 323, 657,
+	0, 324, 325, 55, 326, 515, 327, 328, 329, 330,
+	331, 0, 679, 680, 0, 0, 430, 332, 658, 333,
+	659, 627, 334, 335, 336, 337, 338, 339, 340, 0,
+	604, 341, 342, 343, 344, 345, 346, 650, 0, 347,
+	348, 349, 350, 351, 495, 681, 0, 352, 516, 353,
+	354, 355, 356, 0, 0, 357, 0, 51, 358, 359,
+	360, 361, 362, 363, 364, 365, 602, 0, 52, 0,
+	0, 0, 0, 598, 599, 633, 620, 621, 622, 623,
+	619, 607, 0, 600, 0, 0, 608, 1987, 0, 0,
+	0, 99, 100, 101, 102, 103, 104, 105, 106, 1261,
+	107, 108, 109, 0, 0, 0, 0, 613, 0, 0,
+	110, 111, 0, 112, 113, 496, 114, 115, 116, 366,
+	665, 368, 497, 666, 0, 667, 0, 117, 118, 119,
+	120, 121, 122, 123, 630, 653, 426, 124, 668, 669,
+	125, 0, 126, 127, 128, 129, 661, 0, 641, 0,
+	130, 131, 132, 133, 134, 0, 499, 135, 136, 137,
+	0, 138, 139, 140, 141, 142, 143, 0, 500, 144,
+	145, 146, 651, 642, 647, 652, 643, 644, 648, 147,
+	148, 149, 150, 151, 670, 152, 153, 671, 672, 154,
+	0, 155, 0, 156, 157, 158, 159, 160, 0, 161,
+	162, 163, 1262, 0, 164, 165, 664, 167, 168, 0,
+	169, 170, 171, 0, 172, 173, 174, 0, 175, 176,
+	177, 178, 612, 179, 180, 181, 654, 628, 182, 0,
+	183, 184, 673, 185, 0, 186, 0, 187, 502, 0,
+	503, 188, 189, 190, 0, 191, 192, 662, 0, 616,
+	193, 0, 194, 195, 196, 197, 198, 504, 199, 200,
+	201, 202, 0, 203, 204, 205, 206, 207, 208, 0,
+	209, 505, 382, 210, 211, 212, 213, 674, 675, 0,
+	640, 0, 214, 506, 215, 507, 216, 217, 218, 219,
+	220, 0, 0, 221, 663, 508, 222, 509, 0, 223,
+	224, 427, 645, 646, 225, 226, 227, 228, 229, 230,
+	231, 232, 233, 234, 235, 236, 237, 238, 428, 387,
+	510, 388, 239, 240, 389, 601, 241, 242, 243, 629,
+	660, 244, 676, 245, 246, 247, 0, 248, 0, 0,
+	249, 250, 0, 0, 251, 392, 511, 252, 512, 655,
+	253, 254, 255, 256, 257, 258, 259, 0, 260, 261,
+	656, 262, 395, 265, 263, 264, 0, 266, 267, 268,
+	269, 270, 271, 272, 273, 677, 274, 275, 276, 277,
+	0, 278, 279, 280, 281, 282, 283, 284, 285, 286,
+	287, 288, 0, 289, 290, 513, 291, 292, 293, 617,
+	294, 295, 296, 297, 298, 299, 300, 301, 0, 302,
+	303, 398, 304, 305, 429, 649, 306, 307, 399, 308,
+	309, 514, 310, 311, 678, 401, 312, 0, 313, 314,
+	315, 316, 317, 318, 319, 320, 321, 322, 323, 657,
+	0, 324, 325, 0, 326, 515, 327, 328, 329, 330,
+	331, 0, 679, 680, 0, 0, 430, 332, 658, 333,
+	659, 627, 334, 335, 336, 337, 338, 339, 340, 0,
+	604, 341, 342, 343, 344, 345, 346, 650, 0, 347,
+	348, 349, 350, 351, 406, 681, 1260, 352, 516, 353,
+	354, 355, 356, 0, 0, 357, 0, 0, 358, 359,
+	360, 361, 362, 363, 364, 365, 602, 0, 0, 0,
+	0, 0, 0, 598, 599, 1263, 633, 620, 621, 622,
+	623, 619, 607, 600, 0, 0, 608, 1258, 0, 0,
 	0, 0, 99, 100, 101, 102, 103, 104, 105, 106,
-	0, 107, 108, 109, 0, 0, 0, 0, 610, 0,
-	0, 110, 111, 0, 112, 113, 490, 114, 115, 116,
-	363, 662, 491, 663, 0, 664, 0, 117, 118, 119,
-	120, 121, 627, 650, 420, 122, 665, 666, 123, 0,
-	124, 125, 126, 127, 658, 0, 638, 0, 128, 129,
-	130, 131, 132, 0, 493, 133, 134, 135, 0, 136,
-	137, 138, 139, 140, 141, 0, 494, 142, 143, 144,
-	648, 639, 644, 649, 640, 641, 645, 145, 146, 147,
-	148, 149, 667, 150, 151, 668, 669, 152, 0, 153,
-	0, 154, 155, 156, 157, 158, 0, 159, 160, 161,
-	0, 0, 162, 163, 661, 165, 166, 0, 167, 168,
-	169, 0, 170, 171, 172, 0, 173, 174, 175, 176,
-	609, 177, 178, 179, 651, 625, 180, 0, 181, 182,
-	670, 183, 0, 184, 0, 185, 496, 0, 497, 186,
-	187, 188, 0, 189, 190, 659, 0, 613, 191, 0,
-	192, 193, 194, 195, 196, 197, 198, 199, 200, 0,
-	201, 202, 203, 204, 205, 206, 0, 207, 498, 378,
-	208, 209, 210, 211, 671, 672, 0, 637, 0, 212,
-	499, 213, 500, 214, 215, 216, 217, 218, 0, 0,
-	219, 660, 501, 220, 502, 0, 221, 222, 421, 642,
-	643, 223, 224, 225, 226, 227, 228, 229, 230, 231,
-	232, 233, 234, 235, 236, 422, 383, 503, 384, 237,
-	238, 385, 598, 239, 240, 241, 626, 657, 242, 673,
-	243, 244, 245, 0, 246, 0, 0, 247, 248, 0,
-	0, 249, 388, 504, 250, 505, 652, 251, 252, 253,
-	254, 255, 256, 257, 0, 258, 259, 653, 260, 391,
-	263, 261, 262, 0, 264, 265, 266, 267, 268, 269,
-	270, 271, 674, 272, 273, 274, 275, 0, 276, 277,
-	278, 279, 280, 281, 282, 283, 284, 285, 286, 0,
-	287, 288, 506, 289, 290, 291, 614, 292, 293, 294,
-	295, 296, 297, 298, 299, 53, 300, 301, 302, 303,
-	423, 646, 304, 305, 394, 306, 307, 507, 308, 309,
-	675, 310, 0, 311, 312, 313, 314, 315, 316, 317,
-	318, 319, 320, 321, 654, 0, 322, 323, 55, 324,
-	508, 325, 326, 327, 328, 329, 0, 676, 677, 0,
-	0, 424, 330, 655, 331, 656, 624, 332, 333, 334,
-	335, 336, 337, 338, 0, 601, 339, 340, 341, 342,
-	343, 647, 0, 344, 345, 346, 347, 348, 485, 678,
-	0, 349, 509, 350, 351, 352, 353, 0, 0, 354,
-	0, 51, 355, 356, 357, 358, 359, 360, 361, 362,
-	599, 0, 52, 0, 0, 0, 0, 595, 596, 630,
-	617, 618, 619, 620, 616, 604, 0, 597, 0, 0,
-	605, 1976, 99, 100, 101, 102, 103, 104, 105, 106,
-	1248, 107, 108, 109, 0, 0, 0, 0, 610, 0,
-	0, 110, 111, 0, 112, 113, 490, 114, 115, 116,
-	363, 662, 491, 663, 0, 664, 0, 117, 118, 119,
-	120, 121, 627, 650, 420, 122, 665, 666, 123, 0,
-	124, 125, 126, 127, 658, 0, 638, 0, 128, 129,
-	130, 131, 132, 0, 493, 133, 134, 135, 0, 136,
-	137, 138, 139, 140, 141, 0, 494, 142, 143, 144,
-	648, 639, 644, 649, 640, 641, 645, 145, 146, 147,
-	148, 149, 667, 150, 151, 668, 669, 152, 0, 153,
-	0, 154, 155, 156, 157, 158, 0, 159, 160, 161,
-	1249, 0, 162, 163, 661, 165, 166, 0, 167, 168,
-	169, 0, 170, 171, 172, 0, 173, 174, 175, 176,
-	609, 177, 178, 179, 651, 625, 180, 0, 181, 182,
-	670, 183, 0, 184, 0, 185, 496, 0, 497, 186,
-	187, 188, 0, 189, 190, 659, 0, 613, 191, 0,
-	192, 193, 194, 195, 196, 197, 198, 199, 200, 0,
-	201, 202, 203, 204, 205, 206, 0, 207, 498, 378,
-	208, 209, 210, 211, 671, 672, 0, 637, 0, 212,
-	499, 213, 500, 214, 215, 216, 217, 218, 0, 0,
-	219, 660, 501, 220, 502, 0, 221, 222, 421, 642,
-	643, 223, 224, 225, 226, 227, 228, 229, 230, 231,
-	232, 233, 234, 235, 236, 422, 383, 503, 384, 237,
-	238, 385, 598, 239, 240, 241, 626, 657, 242, 673,
-	243, 244, 245, 0, 246, 0, 0, 247, 248, 0,
-	0, 249, 388, 504, 250, 505, 652, 251, 252, 253,
-	254, 255, 256, 257, 0, 258, 259, 653, 260, 391,
-	263, 261, 262, 0, 264, 265, 266, 267, 268, 269,
-	270, 271, 674, 272, 273, 274, 275, 0, 276, 277,
-	278, 279, 280, 281, 282, 283, 284, 285, 286, 0,
-	287, 288, 506, 289, 290, 291, 614, 292, 293, 294,
-	295, 296, 297, 298, 299, 0, 300, 301, 302, 303,
-	423, 646, 304, 305, 394, 306, 307, 507, 308, 309,
-	675, 310, 0, 311, 312, 313, 314, 315, 316, 317,
-	318, 319, 320, 321, 654, 0, 322, 323, 0, 324,
-	508, 325, 326, 327, 328, 329, 0, 676, 677, 0,
-	0, 424, 330, 655, 331, 656, 624, 332, 333, 334,
-	335, 336, 337, 338, 0, 601, 339, 340, 341, 342,
-	343, 647, 0, 344, 345, 346, 347, 348, 400, 678,
-	1247, 349, 509, 350, 351, 352, 353, 0, 0, 354,
-	0, 0, 355, 356, 357, 358, 359, 360, 361, 362,
-	599, 0, 0, 0, 0, 0, 0, 595, 596, 1250,
-	630, 617, 618, 619, 620, 616, 604, 597, 0, 0,
-	605, 1245, 0, 99, 100, 101, 102, 103, 104, 105,
-	106, 0, 107, 108, 109, 0, 0, 0, 0, 610,
-	0, 0, 110, 111, 0, 112, 113, 490, 114, 115,
-	116, 363, 662, 491, 663, 0, 664, 0, 117, 118,
-	119, 120, 121, 627, 650, 420, 122, 665, 666, 123,
-	0, 124, 125, 126, 127, 658, 0, 638, 0, 128,
-	129, 130, 131, 132, 0, 493, 133, 134, 135, 0,
-	136, 137, 138, 139, 140, 141, 0, 494, 142, 143,
-	144, 648, 639, 644, 649, 640, 641, 645, 145, 146,
-	147, 148, 149, 667, 150, 151, 668, 669, 152, 698,
-	153, 0, 154, 155, 156, 157, 158, 0, 159, 160,
-	161, 0, 0, 162, 163, 661, 165, 166, 0, 167,
-	168, 169, 0, 170, 171, 172, 0, 173, 174, 175,
-	176, 609, 177, 178, 179, 651, 625, 180, 0, 181,
-	182, 670, 183, 0, 184, 0, 185, 496, 0, 497,
-	186, 187, 188, 0, 189, 190, 659, 0, 613, 191,
-	0, 192, 193, 194, 195, 196, 197, 198, 199, 200,
-	0, 201, 202, 203, 204, 205, 206, 0, 207, 498,
-	378, 208, 209, 210, 211, 671, 672, 0, 637, 0,
-	212, 499, 213, 500, 214, 215, 216, 217, 218, 0,
-	0, 219, 660, 501, 220, 502, 0, 221, 222, 421,
-	642, 643, 223, 224, 225, 226, 227, 228, 229, 230,
-	231, 232, 233, 234, 235, 236, 422, 383, 503, 384,
-	237, 238, 385, 598, 239, 240, 241, 626, 657, 242,
-	673, 243, 244, 245, 0, 246, 0, 0, 247, 248,
-	0, 0, 249, 388, 504, 250, 505, 652, 251, 252,
-	253, 254, 255, 256, 257, 0, 258, 259, 653, 260,
-	391, 263, 261, 262, 0, 264, 265, 266, 267, 268,
-	269, 270, 271, 674, 272, 273, 274, 275, 0, 276,
-	277, 278, 279, 280, 281, 282, 283, 284, 285, 286,
-	0, 287, 288, 506, 289, 290, 291, 614, 292, 293,
-	294, 295, 296, 297, 298, 299, 53, 300, 301, 302,
-	303, 423, 646, 304, 305, 394, 306, 307, 507, 308,
-	309, 675, 310, 0, 311, 312, 313, 314, 315, 316,
-	317, 318, 319, 320, 321, 654, 0, 322, 323, 55,
-	324, 508, 325, 326, 327, 328, 329, 0, 676, 677,
-	0, 0, 424, 330, 655, 331, 656, 624, 332, 333,
-	334, 335, 336, 337, 338, 0, 601, 339, 340, 341,
-	342, 343, 647, 0, 344, 345, 346, 347, 348, 485,
-	678, 0, 349, 509, 350, 351, 352, 353, 0, 0,
-	354, 0, 51, 355, 356, 357, 358, 359, 360, 361,
-	362, 599, 0, 52, 0, 0, 0, 0, 595, 596,
-	630, 617, 618, 619, 620, 616, 604, 0, 597, 0,
-	0, 605, 0, 99, 100, 101, 102, 103, 104, 105,
-	106, 0, 107, 108, 109, 0, 0, 0, 0, 610,
-	0, 0, 110, 111, 0, 112, 113, 490, 114, 115,
-	116, 363, 662, 491, 663, 0, 664, 0, 117, 118,
-	119, 120, 121, 627, 650, 420, 122, 665, 666, 123,
-	0, 124, 125, 126, 127, 658, 0, 638, 0, 128,
-	129, 130, 131, 132, 0, 493, 133, 134, 135, 0,
-	136, 137, 138, 139, 140, 141, 0, 494, 142, 143,
-	144, 648, 639, 644, 649, 640, 641, 645, 145, 146,
-	147, 148, 149, 667, 150, 151, 668, 669, 152, 0,
-	153, 0, 154, 155, 156, 157, 158, 0, 159, 160,
-	161, 0, 0, 162, 163, 661, 165, 166, 0, 167,
-	168, 169, 0, 170, 171, 172, 0, 173, 174, 175,
-	176, 609, 177, 178, 179, 651, 625, 180, 0, 181,
-	182, 670, 183, 0, 184, 0, 185, 496, 0, 497,
-	186, 187, 188, 0, 189, 190, 659, 0, 613, 191,
-	0, 192, 193, 194, 195, 196, 197, 198, 199, 200,
-	0, 201, 202, 203, 204, 205, 206, 0, 207, 498,
-	378, 208, 209, 210, 211, 671, 672, 0, 637, 0,
-	212, 499, 213, 500, 214, 215, 216, 217, 218, 0,
-	0, 219, 660, 501, 220, 502, 0, 221, 222, 421,
-	642, 643, 223, 224, 225, 226, 227, 228, 229, 230,
-	231, 232, 233, 234, 235, 236, 422, 383, 503, 384,
-	237, 238, 385, 598, 239, 240, 241, 626, 657, 242,
-	673, 243, 244, 245, 0, 246, 0, 0, 247, 248,
-	0, 0, 249, 388, 504, 250, 505, 652, 251, 252,
-	253, 254, 255, 256, 257, 0, 258, 259, 653, 260,
-	391, 263, 261, 262, 0, 264, 265, 266, 267, 268,
-	269, 270, 271, 674, 272, 273, 274, 275, 0, 276,
-	277, 278, 279, 280, 281, 282, 283, 284, 285, 286,
-	0, 287, 288, 506, 289, 290, 291, 614, 292, 293,
-	294, 295, 296, 297, 298, 299, 53, 300, 301, 302,
-	303, 423, 646, 304, 305, 394, 306, 307, 507, 308,
-	309, 675, 310, 0, 311, 312, 313, 314, 315, 316,
-	317, 318, 319, 320, 321, 654, 0, 322, 323, 55,
-	324, 508, 325, 326, 327, 328, 329, 0, 676, 677,
-	0, 0, 424, 330, 655, 331, 656, 624, 332, 333,
-	334, 335, 336, 337, 338, 0, 601, 339, 340, 341,
-	342, 343, 647, 0, 344, 345, 346, 347, 348, 485,
-	678, 0, 349, 509, 350, 351, 352, 353, 0, 0,
-	354, 0, 51, 355, 356, 357, 358, 359, 360, 361,
-	362, 599, 0, 52, 0, 0, 0, 0, 595, 596,
-	630, 617, 618, 619, 620, 616, 604, 0, 597, 0,
-	0, 605, 0, 99, 100, 101, 102, 103, 104, 105,
-	106, 0, 107, 108, 109, 0, 0, 0, 0, 610,
-	0, 0, 110, 111, 0, 112, 113, 490, 114, 115,
-	116, 363, 662, 491, 663, 0, 664, 1296, 117, 118,
-	119, 120, 121, 627, 650, 420, 122, 665, 666, 123,
-	0, 124, 125, 126, 127, 658, 0, 638, 0, 128,
-	129, 130, 131, 132, 0, 493, 133, 134, 135, 0,
-	136, 137, 138, 139, 140, 141, 0, 494, 142, 143,
-	144, 648, 639, 644, 649, 640, 641, 645, 145, 146,
-	147, 148, 149, 667, 150, 151, 668, 669, 152, 0,
-	153, 0, 154, 155, 156, 157, 158, 0, 159, 160,
-	161, 0, 0, 162, 163, 661, 165, 166, 0, 167,
-	168, 169, 0, 170, 171, 172, 0, 173, 174, 175,
-	176, 609, 177, 178, 179, 651, 625, 180, 0, 181,
-	182, 670, 183, 0, 184, 0, 185, 496, 1301, 497,
-	186, 187, 188, 0, 189, 190, 659, 0, 613, 191,
-	0, 192, 193, 194, 195, 196, 197, 198, 199, 200,
-	0, 201, 202, 203, 204, 205, 206, 0, 207, 498,
-	378, 208, 209, 210, 211, 671, 672, 0, 637, 0,
-	212, 499, 213, 500, 214, 215, 216, 217, 218, 0,
-	1297, 219, 660, 501, 220, 502, 0, 221, 222, 421,
-	642, 643, 223, 224, 225, 226, 227, 228, 229, 230,
-	231, 232, 233, 234, 235, 236, 422, 383, 503, 384,
-	237, 238, 385, 598, 239, 240, 241, 626, 657, 242,
-	673, 243, 244, 245, 0, 246, 0, 0, 247, 248,
-	0, 0, 249, 388, 504, 250, 505, 652, 251, 252,
-	253, 254, 255, 256, 257, 0, 258, 259, 653, 260,
-	391, 263, 261, 262, 0, 264, 265, 266, 267, 268,
-	269, 270, 271, 674, 272, 273, 274, 275, 0, 276,
-	277, 278, 279, 280, 281, 282, 283, 284, 285, 286,
-	0, 287, 288, 506, 289, 290, 291, 614, 292, 293,
-	294, 295, 296, 297, 298, 299, 0, 300, 301, 302,
-	303, 423, 646, 304, 305, 394, 306, 307, 507, 308,
-	309, 675, 310, 0, 311, 312, 313, 314, 315, 316,
-	317, 318, 319, 320, 321, 654, 0, 322, 323, 0,
-	324, 508, 325, 326, 327, 328, 329, 0, 676, 677,
-	0, 1298, 424, 330, 655, 331, 656, 624, 332, 333,
-	334, 335, 336, 337, 338, 0, 601, 339, 340, 341,
-	342, 343, 647, 0, 344, 345, 346, 347, 348, 400,
-	678, 0, 349, 509, 350, 351, 352, 353, 0, 0,
-	354, 0, 0, 355, 356, 357, 358, 359, 360, 361,
-	362, 599, 0, 0, 0, 0, 0, 0, 595, 596,
-	630, 617, 618, 619, 620, 616, 604, 0, 597, 0,
-	0, 605, 0, 99, 100, 101, 102, 103, 104, 105,
-	106, 0, 107, 108, 109, 0, 0, 0, 0, 610,
-	0, 0, 110, 111, 0, 112, 113, 490, 114, 115,
-	116, 363, 662, 491, 663, 0, 664, 0, 117, 118,
-	119, 120, 121, 627, 650, 420, 122, 665, 666, 123,
-	0, 124, 125, 126, 127, 658, 0, 638, 0, 128,
-	129, 130, 131, 132, 0, 493, 133, 134, 135, 0,
-	136, 137, 138, 139, 140, 141, 0, 494, 142, 143,
-	144, 648, 639, 644, 649, 640, 641, 645, 145, 146,
-	147, 148, 149, 667, 150, 151, 668, 669, 152, 0,
-	153, 0, 154, 155, 156, 157, 158, 0, 159, 160,
-	161, 0, 0, 162, 163, 661, 165, 166, 0, 167,
-	168, 169, 0, 170, 171, 172, 0, 173, 174, 175,
-	176, 609, 177, 178, 179, 651, 625, 180, 0, 181,
-	182, 670, 183, 0, 184, 0, 185, 496, 0, 497,
-	186, 187, 188, 0, 189, 190, 659, 0, 613, 191,
-	0, 192, 193, 194, 195, 196, 197, 198, 199, 200,
-	0, 201, 202, 203, 204, 205, 206, 0, 207, 498,
-	378, 208, 209, 210, 211, 671, 672, 0, 637, 0,
-	212, 499, 213, 500, 214, 215, 216, 217, 218, 0,
-	0, 219, 660, 501, 220, 502, 0, 221, 222, 421,
-	642, 643, 223, 224, 225, 226, 227, 228, 229, 230,
-	231, 232, 233, 234, 235, 236, 422, 383, 503, 384,
-	237, 238, 385, 598, 239, 240, 241, 626, 657, 242,
-	673, 243, 244, 245, 0, 246, 0, 0, 247, 248,
-	0, 0, 249, 388, 504, 250, 505, 652, 251, 252,
-	253, 254, 255, 256, 257, 0, 258, 259, 653, 260,
-	391, 263, 261, 262, 0, 264, 265, 266, 267, 268,
-	269, 270, 271, 674, 272, 273, 274, 275, 0, 276,
-	277, 278, 279, 280, 281, 282, 283, 284, 285, 286,
-	0, 287, 288, 506, 289, 290, 291, 614, 292, 293,
-	294, 295, 296, 297, 298, 299, 0, 300, 301, 302,
-	303, 423, 646, 304, 305, 394, 306, 307, 507, 308,
-	309, 675, 310, 0, 311, 312, 313, 314, 315, 316,
-	317, 318, 319, 320, 321, 654, 0, 322, 323, 0,
-	324, 508, 325, 326, 327, 328, 329, 0, 676, 677,
-	0, 0, 424, 330, 655, 331, 656, 624, 332, 333,
-	334, 335, 336, 337, 338, 0, 601, 339, 340, 341,
-	342, 343, 647, 0, 344, 345, 346, 347, 348, 400,
-	678, 0, 349, 509, 350, 351, 352, 353, 0, 0,
-	354, 0, 0, 355, 356, 357, 358, 359, 360, 361,
-	362, 599, 0, 0, 0, 0, 0, 0, 595, 596,
-	630, 617, 618, 619, 620, 616, 604, 0, 597, 0,
-	0, 605, 1748, 99, 100, 101, 102, 103, 104, 105,
-	106, 0, 107, 108, 109, 0, 0, 0, 0, 610,
-	0, 0, 110, 111, 0, 112, 113, 490, 114, 115,
-	116, 363, 662, 491, 663, 0, 664, 0, 117, 118,
-	119, 120, 121, 627, 650, 420, 122, 665, 666, 123,
-	0, 124, 125, 126, 127, 658, 0, 638, 0, 128,
-	129, 130, 131, 132, 0, 493, 133, 134, 135, 0,
-	136, 137, 138, 139, 140, 141, 0, 494, 142, 143,
-	144, 648, 639, 644, 649, 640, 641, 645, 145, 146,
-	147, 148, 149, 667, 150, 151, 668, 669, 152, 0,
-	153, 0, 154, 155, 156, 157, 158, 0, 159, 160,
-	161, 0, 0, 162, 163, 661, 165, 166, 0, 167,
-	168, 169, 0, 170, 171, 172, 0, 173, 174, 175,
-	176, 609, 177, 178, 179, 651, 625, 180, 0, 181,
-	182, 670, 183, 0, 184, 0, 185, 496, 0, 497,
-	186, 187, 188, 0, 189, 190, 659, 0, 613, 191,
-	0, 192, 193, 194, 195, 196, 197, 198, 199, 200,
-	0, 201, 202, 203, 204, 205, 206, 0, 207, 498,
-	378, 208, 209, 210, 211, 671, 672, 0, 637, 0,
-	212, 499, 213, 500, 214, 215, 216, 217, 218, 0,
-	0, 219, 660, 501, 220, 502, 0, 221, 222, 421,
-	642, 643, 223, 224, 225, 226, 227, 228, 229, 230,
-	231, 232, 233, 234, 235, 236, 422, 383, 503, 384,
-	237, 238, 385, 598, 239, 240, 241, 626, 657, 242,
-	673, 243, 244, 245, 0, 246, 0, 0, 247, 248,
-	0, 0, 249, 388, 504, 250, 505, 652, 251, 252,
-	253, 254, 255, 256, 257, 0, 258, 259, 653, 260,
-	391, 263, 261, 262, 0, 264, 265, 266, 267, 268,
-	269, 270, 271, 674, 272, 273, 274, 275, 0, 276,
-	277, 278, 279, 280, 281, 282, 283, 284, 285, 286,
-	0, 287, 288, 506, 289, 290, 291, 614, 292, 293,
-	294, 295, 296, 297, 298, 299, 0, 300, 301, 302,
-	303, 423, 646, 304, 305, 394, 306, 307, 507, 308,
-	309, 675, 310, 0, 311, 312, 313, 314, 315, 316,
-	317, 318, 319, 320, 321, 654, 0, 322, 323, 0,
-	324, 508, 325, 326, 327, 328, 329, 0, 676, 677,
-	0, 0, 424, 330, 655, 331, 656, 624, 332, 333,
-	334, 335, 336, 337, 338, 0, 601, 339, 340, 341,
-	342, 343, 647, 0, 344, 345, 346, 347, 348, 400,
-	678, 0, 349, 509, 350, 351, 352, 353, 0, 0,
-	354, 0, 0, 355, 356, 357, 358, 359, 360, 361,
-	362, 599, 0, 0, 0, 0, 0, 0, 595, 596,
-	630, 617, 618, 619, 620, 616, 604, 0, 597, 0,
-	0, 605, 1692, 99, 100, 101, 102, 103, 104, 105,
-	106, 0, 107, 108, 109, 0, 0, 0, 0, 610,
-	0, 0, 110, 111, 0, 112, 113, 490, 114, 115,
-	116, 363, 662, 491, 663, 0, 664, 0, 117, 118,
-	119, 120, 121, 627, 650, 420, 122, 665, 666, 123,
-	0, 124, 125, 126, 127, 658, 0, 638, 0, 128,
-	129, 130, 131, 132, 0, 493, 133, 134, 135, 0,
-	136, 137, 138, 139, 140, 141, 0, 494, 142, 143,
-	144, 648, 639, 644, 649, 640, 641, 645, 145, 146,
-	147, 148, 149, 667, 150, 151, 668, 669, 152, 0,
-	153, 0, 154, 155, 156, 157, 158, 0, 159, 160,
-	161, 0, 0, 162, 163, 661, 165, 166, 0, 167,
-	168, 169, 0, 170, 171, 172, 0, 173, 174, 175,
-	176, 609, 177, 178, 179, 651, 625, 180, 0, 181,
-	182, 670, 183, 0, 184, 0, 185, 496, 0, 497,
-	186, 187, 188, 0, 189, 190, 659, 0, 613, 191,
-	0, 192, 193, 194, 195, 196, 197, 198, 199, 200,
-	0, 201, 202, 203, 204, 205, 206, 0, 207, 498,
-	378, 208, 209, 210, 211, 671, 672, 0, 637, 0,
-	212, 499, 213, 500, 214, 215, 216, 217, 218, 0,
-	0, 219, 660, 501, 220, 502, 0, 221, 222, 421,
-	642, 643, 223, 224, 225, 226, 227, 228, 229, 230,
-	231, 232, 233, 234, 235, 236, 422, 383, 503, 384,
-	237, 238, 385, 598, 239, 240, 241, 626, 657, 242,
-	673, 243, 244, 245, 0, 246, 0, 0, 247, 248,
-	0, 0, 249, 388, 504, 250, 505, 652, 251, 252,
-	253, 254, 255, 256, 257, 0, 258, 259, 653, 260,
-	391, 263, 261, 262, 0, 264, 265, 266, 267, 268,
-	269, 270, 271, 674, 272, 273, 274, 275, 0, 276,
-	277, 278, 279, 280, 281, 282, 283, 284, 285, 286,
-	0, 287, 288, 506, 289, 290, 291, 614, 292, 293,
-	294, 295, 296, 297, 298, 299, 0, 300, 301, 302,
-	303, 423, 646, 304, 305, 394, 306, 307, 507, 308,
-	309, 675, 310, 0, 311, 312, 313, 314, 315, 316,
-	317, 318, 319, 320, 321, 654, 0, 322, 323, 0,
-	324, 508, 325, 326, 327, 328, 329, 0, 676, 677,
-	0, 0, 424, 330, 655, 331, 656, 624, 332, 333,
-	334, 335, 336, 337, 338, 0, 601, 339, 340, 341,
-	342, 343, 647, 0, 344, 345, 346, 347, 348, 400,
-	678, 0, 349, 509, 350, 351, 352, 353, 0, 0,
-	354, 0, 0, 355, 356, 357, 358, 359, 360, 361,
-	362, 599, 0, 0, 0, 0, 0, 0, 595, 596,
-	630, 617, 618, 619, 620, 616, 604, 0, 597, 0,
-	0, 605, 1244, 99, 100, 101, 102, 103, 104, 105,
-	106, 0, 107, 108, 109, 0, 0, 0, 0, 610,
-	0, 0, 110, 111, 0, 112, 113, 490, 114, 115,
-	116, 363, 662, 491, 663, 0, 664, 0, 117, 118,
-	119, 120, 121, 627, 650, 420, 122, 665, 666, 123,
-	0, 124, 125, 126, 127, 658, 0, 638, 0, 128,
-	129, 130, 131, 132, 0, 493, 133, 134, 135, 0,
-	136, 137, 138, 139, 140, 141, 0, 494, 142, 143,
-	144, 648, 639, 644, 649, 640, 641, 645, 145, 146,
-	147, 148, 149, 667, 150, 151, 668, 669, 152, 0,
-	153, 0, 154, 155, 156, 157, 158, 0, 159, 160,
-	161, 0, 0, 162, 163, 661, 165, 166, 0, 167,
-	168, 169, 0, 170, 171, 172, 0, 173, 174, 175,
-	176, 609, 177, 178, 179, 651, 625, 180, 0, 181,
-	182, 670, 183, 0, 184, 0, 185, 496, 0, 497,
-	186, 187, 188, 0, 189, 190, 659, 0, 613, 191,
-	0, 192, 193, 194, 195, 196, 197, 198, 199, 200,
-	0, 201, 202, 203, 204, 205, 206, 0, 207, 498,
-	378, 208, 209, 210, 211, 671, 672, 0, 637, 0,
-	212, 499, 213, 500, 214, 215, 216, 217, 218, 0,
-	0, 219, 660, 501, 220, 502, 0, 221, 222, 421,
-	642, 643, 223, 224, 225, 226, 227, 228, 229, 230,
-	231, 232, 233, 234, 235, 236, 422, 383, 503, 384,
-	237, 238, 385, 598, 239, 240, 241, 626, 657, 242,
-	673, 243, 244, 245, 0, 246, 0, 0, 247, 248,
-	0, 0, 249, 388, 504, 250, 505, 652, 251, 252,
-	253, 254, 255, 256, 257, 0, 258, 259, 653, 260,
-	391, 263, 261, 262, 0, 264, 265, 266, 267, 268,
-	269, 270, 271, 674, 272, 273, 274, 275, 0, 276,
-	277, 278, 279, 280, 281, 282, 283, 284, 285, 286,
-	0, 287, 288, 506, 289, 290, 291, 614, 292, 293,
-	294, 295, 296, 297, 298, 299, 0, 300, 301, 302,
-	303, 423, 646, 304, 305, 394, 306, 307, 507, 308,
-	309, 675, 310, 0, 311, 312, 313, 314, 315, 316,
-	317, 318, 319, 320, 321, 654, 0, 322, 323, 0,
-	324, 508, 325, 326, 327, 328, 329, 0, 676, 677,
-	0, 0, 424, 330, 655, 331, 656, 624, 332, 333,
-	334, 335, 336, 337, 338, 0, 601, 339, 340, 341,
-	342, 343, 647, 0, 344, 345, 346, 347, 348, 400,
-	678, 0, 349, 509, 350, 351, 352, 353, 0, 0,
-	354, 0, 0, 355, 356, 357, 358, 359, 360, 361,
-	362, 599, 0, 0, 0, 0, 0, 0, 595, 596,
-	630, 617, 618, 619, 620, 616, 604, 0, 597, 966,
-	1239, 605, 0, 99, 100, 101, 102, 103, 104, 105,
-	106, 0, 107, 108, 109, 0, 0, 0, 0, 610,
-	0, 0, 110, 111, 0, 112, 113, 490, 114, 115,
-	116, 363, 662, 491, 663, 0, 664, 0, 117, 118,
-	119, 120, 121, 627, 650, 420, 122, 665, 666, 123,
-	0, 124, 125, 126, 127, 658, 0, 638, 0, 128,
-	129, 130, 131, 132, 0, 493, 133, 134, 135, 0,
-	136, 137, 138, 139, 140, 141, 0, 494, 142, 143,
-	144, 648, 639, 644, 649, 640, 641, 645, 145, 146,
-	147, 148, 149, 667, 150, 151, 668, 669, 152, 0,
-	153, 0, 154, 155, 156, 157, 158, 0, 159, 160,
-	161, 0, 0, 162, 163, 661, 165, 166, 0, 167,
-	168, 169, 0, 170, 171, 172, 0, 173, 174, 175,
-	176, 609, 177, 178, 179, 651, 625, 180, 0, 181,
-	182, 670, 183, 0, 184, 0, 185, 496, 0, 497,
-	186, 187, 188, 0, 189, 190, 659, 0, 613, 191,
-	0, 192, 193, 194, 195, 196, 197, 198, 199, 200,
-	0, 201, 202, 203, 204, 205, 206, 0, 207, 498,
-	378, 208, 209, 210, 211, 671, 672, 0, 637, 0,
-	212, 499, 213, 500, 214, 215, 216, 217, 218, 0,
-	0, 219, 660, 501, 220, 502, 0, 221, 222, 421,
-	642, 643, 223, 224, 225, 226, 227, 228, 229, 230,
-	231, 232, 233, 234, 235, 236, 422, 383, 503, 384,
-	237, 238, 385, 598, 239, 240, 241, 626, 657, 242,
-	673, 243, 244, 245, 0, 246, 0, 0, 247, 248,
-	0, 0, 249, 388, 504, 250, 505, 652, 251, 252,
-	253, 254, 255, 256, 257, 0, 258, 259, 653, 260,
-	391, 263, 261, 262, 0, 264, 265, 266, 267, 268,
-	269, 270, 271, 674, 272, 273, 274, 275, 0, 276,
-	277, 278, 279, 280, 281, 282, 283, 284, 285, 286,
-	0, 287, 288, 506, 289, 290, 291, 614, 292, 293,
-	294, 295, 296, 297, 298, 299, 0, 300, 301, 302,
-	303, 423, 646, 304, 305, 394, 306, 307, 507, 308,
-	309, 675, 310, 0, 311, 312, 313, 314, 315, 316,
-	317, 318, 319, 320, 321, 654, 0, 322, 323, 0,
-	324, 508, 325, 326, 327, 328, 329, 0, 676, 677,
-	0, 0, 424, 330, 655, 331, 656, 624, 332, 333,
-	334, 335, 336, 337, 338, 0, 601, 339, 340, 341,
-	342, 343, 647, 0, 344, 345, 346, 347, 348, 400,
-	678, 1698, 349, 509, 350, 351, 352, 353, 0, 0,
-	354, 0, 0, 355, 356, 357, 358, 359, 360, 361,
-	362, 599, 0, 0, 0, 0, 0, 0, 595, 596,
-	630, 617, 618, 619, 620, 616, 604, 0, 597, 0,
-	0, 605, 0, 99, 100, 101, 102, 103, 104, 105,
-	106, 0, 107, 108, 109, 0, 0, 0, 0, 610,
-	0, 0, 110, 111, 0, 112, 113, 490, 114, 115,
-	116, 363, 662, 491, 663, 0, 664, 0, 117, 118,
-	119, 120, 121, 627, 650, 420, 122, 665, 666, 123,
-	0, 124, 125, 126, 127, 658, 0, 638, 0, 128,
-	129, 130, 131, 132, 0, 493, 133, 134, 135, 0,
-	136, 137, 138, 139, 140, 141, 0, 494, 142, 143,
-	144, 648, 639, 644, 649, 640, 641, 645, 145, 146,
-	147, 148, 149, 667, 150, 151, 668, 669, 152, 698,
-	153, 0, 154, 155, 156, 157, 158, 0, 159, 160,
-	161, 0, 0, 162, 163, 661, 165, 166, 0, 167,
-	168, 169, 0, 170, 171, 172, 0, 173, 174, 175,
-	176, 609, 177, 178, 179, 651, 625, 180, 0, 181,
-	182, 670, 183, 0, 184, 0, 185, 496, 0, 497,
-	186, 187, 188, 0, 189, 190, 659, 0, 613, 191,
-	0, 192, 193, 194, 195, 196, 197, 198, 199, 200,
-	0, 201, 202, 203, 204, 205, 206, 0, 207, 498,
-	378, 208, 209, 210, 211, 671, 672, 0, 637, 0,
-	212, 499, 213, 500, 214, 215, 216, 217, 218, 0,
-	0, 219, 660, 501, 220, 502, 0, 221, 222, 421,
-	642, 643, 223, 224, 225, 226, 227, 228, 229, 230,
-	231, 232, 233, 234, 235, 236, 422, 383, 503, 384,
-	237, 238, 385, 598, 239, 240, 241, 626, 657, 242,
-	673, 243, 244, 245, 0, 246, 0, 0, 247, 248,
-	0, 0, 249, 388, 504, 250, 505, 652, 251, 252,
-	253, 254, 255, 256, 257, 0, 258, 259, 653, 260,
-	391, 263, 261, 262, 0, 264, 265, 266, 267, 268,
-	269, 270, 271, 674, 272, 273, 274, 275, 0, 276,
-	277, 278, 279, 280, 281, 282, 283, 284, 285, 286,
-	0, 287, 288, 506, 289, 290, 291, 614, 292, 293,
-	294, 295, 296, 297, 298, 299, 0, 300, 301, 302,
-	303, 423, 646, 304, 305, 394, 306, 307, 507, 308,
-	309, 675, 310, 0, 311, 312, 313, 314, 315, 316,
-	317, 318, 319, 320, 321, 654, 0, 322, 323, 0,
-	324, 508, 325, 326, 327, 328, 329, 0, 676, 677,
-	0, 0, 424, 330, 655, 331, 656, 624, 332, 333,
-	334, 335, 336, 337, 338, 0, 601, 339, 340, 341,
-	342, 343, 647, 0, 344, 345, 346, 347, 348, 400,
-	678, 0, 349, 509, 350, 351, 352, 353, 0, 0,
-	354, 0, 0, 355, 356, 357, 358, 359, 360, 361,
-	362, 599, 0, 0, 0, 0, 0, 0, 595, 596,
-	630, 617, 618, 619, 620, 616, 604, 0, 597, 0,
-	0, 605, 0, 99, 100, 101, 102, 103, 104, 105,
-	106, 0, 107, 108, 109, 0, 0, 0, 0, 610,
-	0, 0, 110, 111, 0, 112, 113, 490, 114, 115,
-	116, 363, 662, 491, 663, 0, 664, 0, 117, 118,
-	119, 120, 121, 627, 650, 420, 122, 665, 666, 123,
-	0, 124, 125, 126, 127, 658, 0, 638, 0, 128,
-	129, 130, 131, 132, 0, 493, 133, 134, 135, 0,
-	136, 137, 138, 139, 140, 141, 0, 494, 142, 143,
-	144, 648, 639, 644, 649, 640, 641, 645, 145, 146,
-	147, 148, 149, 667, 150, 151, 668, 669, 152, 0,
-	153, 0, 154, 155, 156, 157, 158, 0, 159, 160,
-	161, 0, 0, 162, 163, 661, 165, 166, 0, 167,
-	168, 169, 0, 170, 171, 172, 0, 173, 174, 175,
-	176, 609, 177, 178, 179, 651, 625, 180, 0, 181,
-	182, 670, 183, 0, 184, 0, 185, 496, 0, 497,
-	186, 187, 188, 0, 189, 190, 659, 0, 613, 191,
-	0, 192, 193, 194, 195, 196, 197, 198, 199, 200,
-	0, 201, 202, 203, 204, 205, 206, 0, 207, 498,
-	378, 208, 209, 210, 211, 671, 672, 0, 637, 0,
-	212, 499, 213, 500, 214, 215, 216, 217, 218, 0,
-	0, 219, 660, 501, 220, 502, 0, 221, 222, 421,
-	642, 643, 223, 224, 225, 226, 227, 228, 229, 230,
-	231, 232, 233, 234, 235, 236, 422, 383, 503, 384,
-	237, 238, 385, 598, 239, 240, 241, 626, 657, 242,
-	673, 243, 244, 245, 0, 246, 0, 0, 247, 248,
-	0, 0, 249, 388, 504, 250, 505, 652, 251, 252,
-	253, 254, 255, 256, 257, 0, 258, 259, 653, 260,
-	391, 263, 261, 262, 0, 264, 265, 266, 267, 268,
-	269, 270, 271, 674, 272, 273, 274, 275, 0, 276,
-	277, 278, 279, 280, 281, 282, 283, 284, 285, 286,
-	0, 287, 288, 506, 289, 290, 291, 614, 292, 293,
-	294, 295, 296, 297, 298, 299, 0, 300, 301, 302,
-	303, 423, 646, 304, 305, 394, 306, 307, 507, 308,
-	309, 675, 310, 0, 311, 312, 313, 314, 315, 316,
-	317, 318, 319, 320, 321, 654, 0, 322, 323, 0,
-	324, 508, 325, 326, 327, 328, 329, 0, 676, 677,
-	0, 0, 424, 330, 655, 331, 656, 624, 332, 333,
-	334, 335, 336, 337, 338, 0, 601, 339, 340, 341,
-	342, 343, 647, 0, 344, 345, 346, 347, 348, 400,
-	678, 0, 349, 509, 350, 351, 352, 353, 0, 0,
-	354, 0, 0, 355, 356, 357, 358, 359, 360, 361,
-	362, 599, 0, 0, 0, 0, 0, 0, 595, 596,
-	593, 630, 617, 618, 619, 620, 616, 604, 597, 0,
-	0, 605, 0, 0, 99, 100, 101, 102, 103, 104,
-	105, 106, 0, 107, 108, 109, 0, 0, 0, 0,
-	610, 0, 0, 110, 111, 0, 112, 113, 490, 114,
-	115, 116, 363, 662, 491, 663, 0, 664, 0, 117,
-	118, 119, 120, 121, 627, 650, 420, 122, 665, 666,
-	123, 0, 124, 125, 126, 127, 658, 0, 638, 0,
-	128, 129, 130, 131, 132, 0, 493, 133, 134, 135,
-	0, 136, 137, 138, 139, 140, 141, 0, 494, 142,
-	143, 144, 648, 639, 644, 649, 640, 641, 645, 145,
-	146, 147, 148, 149, 667, 150, 151, 668, 669, 152,
-	0, 153, 0, 154, 155, 156, 157, 158, 0, 159,
-	160, 161, 0, 0, 162, 163, 661, 165, 166, 0,
-	167, 168, 169, 0, 170, 171, 172, 0, 173, 174,
-	175, 176, 609, 177, 178, 179, 651, 625, 180, 0,
-	181, 182, 670, 183, 0, 184, 0, 185, 496, 1301,
-	497, 186, 187, 188, 0, 189, 190, 659, 0, 613,
-	191, 0, 192, 193, 194, 195, 196, 197, 198, 199,
-	200, 0, 201, 202, 203, 204, 205, 206, 0, 207,
-	498, 378, 208, 209, 210, 211, 671, 672, 0, 637,
-	0, 212, 499, 213, 500, 214, 215, 216, 217, 218,
-	0, 0, 219, 660, 501, 220, 502, 0, 221, 222,
-	421, 642, 643, 223, 224, 225, 226, 227, 228, 229,
-	230, 231, 232, 233, 234, 235, 236, 422, 383, 503,
-	384, 237, 238, 385, 598, 239, 240, 241, 626, 657,
-	242, 673, 243, 244, 245, 0, 246, 0, 0, 247,
-	248, 0, 0, 249, 388, 504, 250, 505, 652, 251,
-	252, 253, 254, 255, 256, 257, 0, 258, 259, 653,
-	260, 391, 263, 261, 262, 0, 264, 265, 266, 267,
-	268, 269, 270, 271, 674, 272, 273, 274, 275, 0,
-	276, 277, 278, 279, 280, 281, 282, 283, 284, 285,
-	286, 0, 287, 288, 506, 289, 290, 291, 614, 292,
-	293, 294, 295, 296, 297, 298, 299, 0, 300, 301,
-	302, 303, 423, 646, 304, 305, 394, 306, 307, 507,
-	308, 309, 675, 310, 0, 311, 312, 313, 314, 315,
-	316, 317, 318, 319, 320, 321, 654, 0, 322, 323,
-	0, 324, 508, 325, 326, 327, 328, 329, 0, 676,
-	677, 0, 0, 424, 330, 655, 331, 656, 624, 332,
-	333, 334, 335, 336, 337, 338, 0, 601, 339, 340,
-	341, 342, 343, 647, 0, 344, 345, 346, 347, 348,
-	400, 678, 0, 349, 509, 350, 351, 352, 353, 0,
-	0, 354, 0, 0, 355, 356, 357, 358, 359, 360,
-	361, 362, 599, 0, 0, 0, 0, 0, 0, 595,
-	596, 630, 617, 618, 619, 620, 616, 604, 0, 597,
-	0, 0, 605, 0, 99, 100, 101, 102, 103, 104,
-	105, 106, 899, 107, 108, 109, 0, 0, 0, 0,
-	610, 0, 0, 110, 111, 0, 112, 113, 490, 114,
-	115, 116, 363, 662, 491, 663, 0, 664, 0, 117,
-	118, 119, 120, 121, 627, 650, 420, 122, 665, 666,
-	123, 0, 124, 125, 126, 127, 658, 0, 638, 0,
-	128, 129, 130, 131, 132, 0, 493, 133, 134, 135,
-	0, 136, 137, 138, 139, 140, 141, 0, 494, 142,
-	143, 144, 648, 639, 644, 649, 640, 641, 645, 145,
-	146, 147, 148, 149, 667, 150, 151, 668, 669, 152,
-	0, 153, 0, 154, 155, 156, 157, 158, 0, 159,
-	160, 161, 0, 0, 162, 163, 661, 165, 166, 0,
-	167, 168, 169, 0, 170, 171, 172, 0, 173, 174,
-	175, 176, 609, 177, 178, 179, 651, 625, 180, 0,
-	181, 182, 670, 183, 0, 184, 0, 185, 496, 0,
-	497, 186, 187, 188, 0, 189, 190, 659, 0, 613,
-	191, 0, 192, 193, 194, 195, 196, 197, 198, 199,
-	200, 0, 201, 202, 203, 204, 205, 206, 0, 207,
-	498, 378, 208, 209, 210, 211, 671, 672, 0, 637,
-	0, 212, 499, 213, 500, 214, 215, 216, 217, 218,
-	0, 0, 219, 660, 501, 220, 502, 0, 221, 222,
-	421, 642, 643, 223, 224, 225, 226, 227, 228, 229,
-	230, 231, 232, 233, 234, 235, 236, 422, 383, 503,
-	384, 237, 238, 385, 598, 239, 240, 241, 626, 657,
-	242, 673, 243, 244, 245, 0, 246, 0, 0, 247,
-	248, 0, 0, 249, 388, 504, 250, 505, 652, 251,
-	252, 253, 254, 255, 256, 257, 0, 258, 259, 653,
-	260, 391, 263, 261, 262, 0, 264, 265, 266, 267,
-	268, 269, 270, 271, 674, 272, 273, 274, 275, 0,
-	276, 277, 278, 279, 280, 281, 282, 283, 284, 285,
-	286, 0, 287, 288, 506, 289, 290, 291, 614, 292,
-	293, 294, 295, 296, 297, 298, 299, 0, 300, 301,
-	302, 303, 423, 646, 304, 305, 394, 306, 307, 507,
-	308, 309, 675, 310, 0, 311, 312, 313, 314, 315,
-	316, 317, 318, 319, 320, 321, 654, 0, 322, 323,
-	0, 324, 508, 325, 326, 327, 328, 329, 0, 676,
-	677, 0, 0, 424, 330, 655, 331, 656, 624, 332,
-	333, 334, 335, 336, 337, 338, 0, 601, 339, 340,
-	341, 342, 343, 647, 0, 344, 345, 346, 347, 348,
-	400, 678, 0, 349, 509, 350, 351, 352, 353, 0,
-	0, 354, 0, 0, 355, 356, 357, 358, 359, 360,
-	361, 362, 599, 0, 0, 0, 0, 0, 0, 595,
-	596, 630, 617, 618, 619, 620, 616, 604, 0, 597,
-	0, 0, 605, 0, 99, 100, 101, 102, 103, 104,
-	105, 106, 0, 107, 108, 109, 0, 0, 0, 0,
-	610, 0, 0, 110, 111, 0, 112, 113, 490, 114,
-	115, 116, 363, 662, 491, 663, 0, 664, 0, 117,
-	118, 119, 120, 121, 627, 650, 420, 122, 665, 666,
-	123, 0, 124, 125, 126, 127, 658, 0, 638, 0,
-	128, 129, 130, 131, 132, 0, 493, 133, 134, 135,
-	0, 136, 137, 138, 139, 140, 141, 0, 494, 142,
-	143, 2138, 648, 639, 644, 649, 640, 641, 645, 145,
-	146, 147, 148, 149, 667, 150, 151, 668, 669, 152,
-	0, 153, 0, 154, 155, 156, 157, 158, 0, 159,
-	160, 161, 0, 0, 162, 163, 661, 165, 166, 0,
-	167, 168, 169, 0, 170, 171, 172, 0, 173, 174,
-	175, 176, 609, 177, 178, 179, 651, 625, 180, 0,
-	181, 182, 670, 183, 0, 184, 0, 185, 496, 0,
-	497, 186, 187, 188, 0, 189, 190, 659, 0, 613,
-	191, 0, 192, 193, 194, 195, 196, 197, 198, 199,
-	200, 0, 201, 202, 203, 204, 205, 206, 0, 207,
-	498, 378, 208, 209, 210, 211, 671, 672, 0, 637,
-	0, 212, 499, 213, 500, 214, 215, 216, 217, 218,
-	0, 0, 219, 660, 501, 220, 502, 0, 221, 222,
-	421, 642, 643, 223, 224, 225, 226, 227, 228, 229,
-	230, 231, 232, 233, 234, 235, 236, 422, 383, 503,
-	384, 237, 238, 385, 598, 239, 240, 241, 626, 657,
-	242, 673, 243, 244, 245, 0, 246, 0, 0, 247,
-	248, 0, 0, 249, 388, 504, 250, 505, 652, 251,
-	252, 253, 254, 255, 256, 257, 0, 258, 259, 653,
-	260, 391, 263, 261, 262, 0, 264, 265, 266, 267,
-	268, 269, 270, 271, 674, 272, 273, 274, 275, 0,
-	276, 277, 278, 279, 280, 281, 282, 283, 284, 285,
-	286, 0, 287, 288, 506, 289, 290, 291, 614, 292,
-	293, 294, 295, 296, 297, 298, 299, 0, 300, 301,
-	302, 303, 423, 646, 304, 305, 394, 306, 307, 507,
-	308, 309, 675, 310, 0, 311, 312, 313, 314, 315,
-	316, 317, 318, 319, 320, 321, 654, 0, 322, 323,
-	0, 324, 508, 325, 326, 327, 328, 329, 0, 676,
-	677, 0, 0, 424, 330, 655, 331, 656, 624, 332,
-	333, 334, 335, 2137, 337, 338, 0, 601, 339, 340,
-	341, 342, 343, 647, 0, 344, 345, 346, 347, 348,
-	400, 678, 0, 349, 509, 350, 351, 352, 353, 0,
-	0, 354, 0, 0, 355, 356, 357, 358, 359, 360,
-	361, 362, 599, 0, 0, 0, 0, 0, 0, 595,
-	596, 630, 617, 618, 619, 620, 616, 604, 0, 597,
-	0, 0, 605, 0, 99, 100, 101, 102, 103, 104,
-	105, 106, 0, 107, 108, 109, 0, 0, 0, 0,
-	610, 0, 0, 110, 111, 0, 112, 113, 490, 114,
-	115, 116, 2136, 662, 491, 663, 0, 664, 0, 117,
-	118, 119, 120, 121, 627, 650, 420, 122, 665, 666,
-	123, 0, 124, 125, 126, 127, 658, 0, 638, 0,
-	128, 129, 130, 131, 132, 0, 493, 133, 134, 135,
-	0, 136, 137, 138, 139, 140, 141, 0, 494, 142,
-	143, 2138, 648, 639, 644, 649, 640, 641, 645, 145,
-	146, 147, 148, 149, 667, 150, 151, 668, 669, 152,
-	0, 153, 0, 154, 155, 156, 157, 158, 0, 159,
-	160, 161, 0, 0, 162, 163, 661, 165, 166, 0,
-	167, 168, 169, 0, 170, 171, 172, 0, 173, 174,
-	175, 176, 609, 177, 178, 179, 651, 625, 180, 0,
-	181, 182, 670, 183, 0, 184, 0, 185, 496, 0,
-	497, 186, 187, 188, 0, 189, 190, 659, 0, 613,
-	191, 0, 192, 193, 194, 195, 196, 197, 198, 199,
-	200, 0, 201, 202, 203, 204, 205, 206, 0, 207,
-	498, 378, 208, 209, 210, 211, 671, 672, 0, 637,
-	0, 212, 499, 213, 500, 214, 215, 216, 217, 218,
-	0, 0, 219, 660, 501, 220, 502, 0, 221, 222,
-	421, 642, 643, 223, 224, 225, 226, 227, 228, 229,
-	230, 231, 232, 233, 234, 235, 236, 422, 383, 503,
-	384, 237, 238, 385, 598, 239, 240, 241, 626, 657,
-	242, 673, 243, 244, 245, 0, 246, 0, 0, 247,
-	248, 0, 0, 249, 388, 504, 250, 505, 652, 251,
-	252, 253, 254, 255, 256, 257, 0, 258, 259, 653,
-	260, 391, 263, 261, 262, 0, 264, 265, 266, 267,
-	268, 269, 270, 271, 674, 272, 273, 274, 275, 0,
-	276, 277, 278, 279, 280, 281, 282, 283, 284, 285,
-	286, 0, 287, 288, 506, 289, 290, 291, 614, 292,
-	293, 294, 295, 296, 297, 298, 299, 0, 300, 301,
-	302, 303, 423, 646, 304, 305, 394, 306, 307, 507,
-	308, 309, 675, 310, 0, 311, 312, 313, 314, 315,
-	316, 317, 318, 319, 320, 321, 654, 0, 322, 323,
-	0, 324, 508, 325, 326, 327, 328, 329, 0, 676,
-	677, 0, 0, 424, 330, 655, 331, 656, 624, 332,
-	333, 334, 335, 2137, 337, 338, 0, 601, 339, 340,
-	341, 342, 343, 647, 0, 344, 345, 346, 347, 348,
-	400, 678, 0, 349, 509, 350, 351, 352, 353, 0,
-	0, 354, 0, 0, 355, 356, 357, 358, 359, 360,
-	361, 362, 599, 0, 0, 0, 0, 0, 0, 595,
-	596, 630, 617, 618, 619, 620, 616, 604, 0, 597,
-	0, 0, 605, 0, 99, 100, 101, 102, 103, 104,
-	105, 106, 0, 107, 108, 109, 0, 0, 0, 0,
-	610, 0, 0, 110, 111, 0, 112, 113, 490, 114,
-	115, 116, 363, 662, 491, 663, 0, 664, 0, 117,
-	118, 119, 120, 121, 627, 650, 420, 122, 665, 666,
-	123, 0, 124, 125, 126, 127, 658, 0, 638, 0,
-	128, 129, 130, 131, 132, 0, 493, 133, 134, 135,
-	0, 136, 137, 138, 139, 140, 141, 0, 494, 142,
-	143, 144, 648, 639, 644, 649, 640, 641, 645, 145,
-	146, 147, 148, 149, 667, 150, 151, 668, 669, 152,
-	0, 153, 0, 154, 155, 156, 157, 158, 0, 159,
-	160, 161, 0, 0, 162, 163, 661, 165, 166, 0,
-	167, 168, 169, 0, 170, 171, 172, 0, 173, 174,
-	175, 176, 609, 177, 178, 179, 651, 625, 180, 0,
-	181, 182, 670, 183, 0, 184, 0, 185, 496, 0,
-	497, 186, 187, 188, 0, 189, 190, 659, 0, 613,
-	191, 0, 192, 193, 194, 195, 196, 197, 198, 199,
-	200, 0, 201, 202, 203, 204, 205, 206, 0, 207,
-	498, 378, 208, 209, 210, 211, 671, 672, 0, 637,
-	0, 212, 499, 213, 500, 214, 215, 216, 217, 218,
-	0, 0, 219, 660, 501, 220, 502, 0, 221, 222,
-	421, 642, 643, 223, 224, 225, 226, 227, 228, 229,
-	230, 231, 232, 233, 234, 235, 236, 422, 383, 503,
-	384, 237, 238, 385, 598, 239, 240, 241, 626, 657,
-	242, 673, 243, 244, 245, 0, 246, 0, 0, 247,
-	248, 0, 0, 249, 388, 504, 250, 505, 652, 251,
-	252, 253, 254, 255, 256, 257, 0, 258, 259, 653,
-	260, 391, 263, 261, 262, 0, 264, 265, 266, 267,
-	268, 269, 270, 271, 674, 272, 273, 274, 275, 0,
-	276, 277, 278, 279, 280, 281, 282, 283, 284, 285,
-	286, 0, 287, 288, 506, 289, 290, 291, 614, 292,
-	293, 294, 295, 296, 297, 298, 299, 0, 300, 301,
-	302, 303, 423, 646, 304, 305, 394, 306, 307, 507,
-	308, 309, 675, 310, 0, 311, 312, 313, 314, 315,
-	316, 317, 318, 319, 320, 321, 654, 0, 322, 323,
-	0, 324, 508, 325, 326, 327, 328, 329, 0, 676,
-	677, 0, 0, 424, 330, 655, 331, 656, 624, 332,
-	333, 334, 335, 336, 337, 338, 0, 601, 339, 340,
-	341, 342, 343, 647, 0, 344, 345, 346, 347, 348,
-	400, 678, 0, 349, 509, 350, 351, 352, 353, 0,
-	0, 354, 0, 0, 355, 356, 357, 358, 359, 360,
-	361, 362, 599, 0, 0, 0, 0, 0, 0, 595,
-	596, 630, 617, 618, 619, 620, 616, 604, 0, 597,
-	0, 0, 605, 0, 99, 100, 101, 102, 103, 104,
-	105, 106, 0, 107, 108, 109, 0, 0, 0, 0,
-	610, 0, 0, 110, 111, 0, 112, 113, 490, 114,
-	115, 116, 363, 662, 491, 663, 0, 664, 0, 117,
-	118, 119, 120, 121, 627, 650, 420, 122, 665, 666,
-	123, 0, 124, 125, 126, 127, 658, 0, 638, 0,
-	128, 129, 130, 131, 132, 0, 493, 133, 134, 135,
-	0, 136, 137, 138, 139, 140, 141, 0, 494, 142,
-	143, 144, 648, 639, 644, 649, 640, 641, 645, 145,
-	146, 147, 148, 149, 667, 150, 151, 668, 669, 152,
-	0, 153, 0, 154, 155, 156, 157, 158, 0, 159,
-	160, 161, 0, 0, 162, 163, 661, 165, 166, 0,
-	167, 168, 169, 0, 170, 171, 172, 0, 173, 174,
-	175, 176, 609, 177, 178, 179, 651, 625, 180, 0,
-	181, 182, 670, 183, 0, 184, 0, 185, 496, 0,
-	497, 186, 187, 188, 0, 189, 190, 659, 0, 613,
-	191, 0, 192, 193, 194, 195, 196, 197, 198, 199,
-	200, 0, 201, 202, 203, 204, 205, 206, 0, 207,
-	498, 378, 208, 209, 210, 211, 671, 672, 0, 637,
-	0, 212, 499, 213, 500, 214, 215, 216, 217, 218,
-	0, 0, 219, 660, 501, 220, 502, 0, 221, 222,
-	421, 642, 643, 223, 224, 225, 226, 227, 228, 229,
-	230, 231, 232, 233, 234, 235, 236, 422, 383, 503,
-	384, 237, 238, 385, 598, 239, 240, 241, 626, 657,
-	242, 673, 243, 244, 245, 0, 246, 0, 0, 247,
-	248, 0, 0, 249, 388, 504, 250, 505, 652, 251,
-	252, 253, 254, 255, 256, 257, 0, 258, 259, 653,
-	260, 391, 263, 261, 262, 0, 264, 265, 266, 267,
-	268, 269, 270, 271, 674, 272, 273, 274, 275, 0,
-	276, 277, 278, 279, 280, 281, 282, 283, 284, 285,
-	286, 0, 287, 288, 506, 289, 290, 291, 614, 292,
-	293, 294, 295, 296, 297, 298, 299, 0, 300, 301,
-	302, 303, 423, 646, 304, 305, 394, 306, 307, 507,
-	308, 309, 675, 310, 0, 311, 312, 313, 314, 315,
-	316, 317, 318, 319, 320, 321, 654, 0, 322, 323,
-	0, 324, 508, 325, 326, 327, 328, 329, 0, 676,
-	677, 0, 0, 424, 330, 655, 331, 656, 624, 332,
-	333, 334, 335, 336, 337, 338, 0, 601, 339, 340,
-	341, 342, 343, 647, 0, 344, 345, 346, 347, 348,
-	400, 678, 0, 349, 509, 350, 351, 352, 353, 0,
-	0, 354, 0, 0, 355, 356, 357, 358, 359, 360,
-	361, 362, 599, 0, 0, 0, 0, 0, 0, 595,
-	596, 630, 617, 618, 619, 620, 616, 604, 0, 597,
-	0, 0, 1855, 0, 99, 100, 101, 102, 103, 104,
-	105, 106, 0, 107, 108, 109, 0, 0, 0, 0,
-	610, 0, 0, 110, 111, 0, 112, 113, 490, 114,
-	115, 116, 363, 662, 491, 663, 0, 664, 0, 117,
-	118, 119, 120, 121, 627, 650, 420, 122, 665, 666,
-	123, 0, 124, 125, 126, 127, 658, 0, 638, 0,
-	128, 129, 130, 131, 132, 0, 493, 133, 134, 135,
-	0, 136, 137, 138, 139, 140, 141, 0, 494, 142,
-	143, 144, 648, 639, 644, 649, 640, 641, 645, 145,
-	146, 147, 148, 149, 667, 150, 151, 668, 669, 152,
-	0, 153, 0, 154, 155, 156, 157, 158, 0, 159,
-	160, 161, 0, 0, 162, 163, 661, 165, 166, 0,
-	167, 168, 169, 0, 170, 171, 172, 0, 173, 174,
-	175, 176, 609, 177, 178, 179, 651, 625, 180, 0,
-	181, 182, 670, 183, 0, 184, 0, 185, 496, 0,
-	497, 186, 187, 188, 0, 189, 190, 659, 0, 613,
-	191, 0, 192, 193, 194, 195, 196, 197, 198, 199,
-	200, 0, 201, 202, 203, 204, 205, 206, 0, 207,
-	498, 378, 208, 209, 210, 211, 671, 672, 0, 637,
-	0, 212, 499, 213, 500, 214, 215, 216, 217, 218,
-	0, 0, 219, 660, 501, 220, 502, 0, 221, 222,
-	421, 642, 643, 223, 224, 225, 226, 227, 228, 229,
-	230, 231, 232, 233, 234, 235, 236, 422, 383, 503,
-	384, 237, 238, 385, 0, 239, 240, 241, 626, 657,
-	242, 673, 243, 244, 245, 0, 246, 0, 0, 247,
-	248, 0, 0, 249, 388, 504, 250, 505, 652, 251,
-	252, 253, 254, 255, 256, 257, 0, 258, 259, 653,
-	260, 391, 263, 261, 262, 0, 264, 265, 266, 267,
-	268, 269, 270, 271, 674, 272, 273, 274, 275, 0,
-	276, 277, 278, 279, 280, 281, 282, 283, 284, 285,
-	286, 0, 287, 288, 506, 289, 290, 291, 1291, 292,
-	293, 294, 295, 296, 297, 298, 299, 0, 300, 301,
-	302, 303, 423, 646, 304, 305, 394, 306, 307, 507,
-	308, 309, 675, 310, 0, 311, 312, 313, 314, 315,
-	316, 317, 318, 319, 320, 321, 654, 0, 322, 323,
-	0, 324, 508, 325, 326, 327, 328, 329, 0, 676,
-	677, 0, 0, 424, 330, 655, 331, 656, 624, 332,
-	333, 334, 335, 336, 337, 338, 0, 0, 339, 340,
-	341, 342, 343, 647, 0, 344, 345, 346, 347, 348,
-	400, 678, 0, 349, 509, 350, 351, 352, 353, 0,
-	0, 354, 0, 0, 355, 356, 357, 358, 359, 360,
-	361, 362, 0, 0, 0, 0, 0, 0, 0, 1287,
-	1288, 630, 617, 618, 619, 620, 616, 604, 0, 1289,
-	0, 0, 1290, 0, 99, 100, 101, 102, 103, 104,
-	105, 106, 0, 107, 108, 109, 0, 0, 0, 0,
-	610, 0, 0, 110, 111, 0, 112, 113, 490, 114,
-	115, 116, 0, 662, 491, 663, 0, 664, 0, 117,
-	118, 119, 120, 121, 627, 650, 420, 122, 665, 666,
-	123, 0, 124, 125, 126, 127, 658, 0, 638, 0,
-	128, 129, 130, 131, 132, 0, 493, 133, 134, 135,
-	0, 136, 137, 138, 139, 140, 141, 0, 494, 142,
-	143, 2138, 648, 639, 644, 649, 640, 641, 645, 145,
-	146, 147, 148, 149, 667, 150, 151, 668, 669, 152,
-	0, 153, 0, 154, 155, 156, 157, 158, 0, 159,
-	160, 161, 0, 0, 162, 163, 661, 165, 166, 0,
-	167, 168, 169, 0, 170, 171, 172, 0, 173, 174,
-	175, 176, 609, 177, 178, 179, 651, 625, 180, 0,
-	181, 182, 670, 183, 0, 184, 0, 185, 496, 0,
-	497, 186, 187, 188, 0, 189, 190, 659, 0, 613,
-	191, 0, 192, 193, 194, 195, 196, 197, 198, 199,
-	200, 0, 201, 202, 203, 204, 205, 206, 0, 207,
-	498, 378, 208, 209, 210, 211, 671, 672, 0, 637,
-	0, 212, 0, 213, 500, 214, 215, 216, 217, 218,
-	0, 0, 219, 660, 501, 220, 0, 0, 221, 222,
-	421, 642, 643, 223, 224, 225, 226, 227, 228, 229,
-	230, 231, 232, 233, 234, 235, 236, 422, 383, 503,
-	384, 237, 238, 385, 598, 239, 240, 241, 626, 657,
-	242, 673, 243, 244, 245, 0, 246, 0, 0, 247,
-	248, 0, 0, 249, 388, 504, 250, 505, 652, 251,
-	252, 253, 254, 255, 256, 257, 0, 258, 259, 653,
-	260, 391, 263, 261, 262, 0, 264, 265, 266, 267,
-	268, 269, 270, 271, 674, 272, 273, 274, 275, 0,
-	276, 277, 278, 279, 280, 281, 282, 283, 284, 285,
-	286, 0, 287, 288, 506, 289, 290, 291, 614, 292,
-	293, 294, 295, 296, 297, 298, 299, 0, 300, 301,
-	302, 303, 423, 646, 304, 305, 394, 306, 307, 0,
-	308, 309, 675, 310, 0, 311, 312, 313, 314, 315,
-	316, 317, 318, 319, 320, 321, 654, 0, 322, 323,
-	0, 324, 508, 325, 326, 327, 328, 329, 0, 676,
-	677, 0, 0, 424, 330, 655, 331, 656, 624, 332,
-	333, 334, 335, 2137, 337, 338, 0, 601, 339, 340,
-	341, 342, 343, 647, 0, 344, 345, 346, 347, 348,
-	400, 678, 0, 349, 509, 350, 351, 352, 353, 0,
-	0, 354, 0, 0, 355, 356, 357, 358, 359, 360,
-	361, 362, 0, 0, 0, 0, 0, 0, 0, 595,
-	596, 630, 0, 0, 0, 0, 0, 0, 0, 597,
-	0, 0, 605, 0, 99, 100, 101, 102, 103, 104,
-	105, 106, 0, 107, 108, 109, 0, 0, 0, 0,
-	0, 0, 0, 110, 111, 0, 112, 113, 490, 114,
-	115, 116, 363, 364, 491, 365, 0, 366, 0, 117,
-	118, 119, 120, 121, 0, 650, 420, 122, 367, 368,
-	123, 0, 124, 125, 126, 127, 658, 0, 638, 0,
-	128, 129, 130, 131, 132, 0, 493, 133, 134, 135,
-	0, 136, 137, 138, 139, 140, 141, 0, 494, 142,
-	143, 144, 648, 639, 644, 649, 640, 641, 645, 145,
-	146, 147, 148, 149, 370, 150, 151, 371, 372, 152,
-	0, 153, 0, 154, 155, 156, 157, 158, 0, 159,
-	160, 161, 0, 0, 162, 163, 164, 165, 166, 0,
-	167, 168, 169, 0, 170, 171, 172, 0, 173, 174,
-	175, 176, 373, 177, 178, 179, 651, 0, 180, 0,
-	181, 182, 375, 183, 0, 184, 0, 185, 496, 0,
-	497, 186, 187, 188, 0, 189, 190, 659, 0, 377,
-	191, 0, 192, 193, 194, 195, 196, 197, 198, 199,
-	200, 0, 201, 202, 203, 204, 205, 206, 0, 207,
-	498, 378, 208, 209, 210, 211, 379, 380, 0, 381,
-	0, 212, 499, 213, 500, 214, 215, 216, 217, 218,
-	1144, 0, 219, 660, 501, 220, 502, 0, 221, 222,
-	421, 642, 643, 223, 224, 225, 226, 227, 228, 229,
-	230, 231, 232, 233, 234, 235, 236, 422, 383, 503,
-	384, 237, 238, 385, 0, 239, 240, 241, 0, 657,
-	242, 387, 243, 244, 245, 0, 246, 0, 464, 247,
-	248, 0, 0, 249, 388, 504, 250, 505, 652, 251,
-	252, 253, 254, 255, 256, 257, 0, 258, 259, 653,
-	260, 391, 263, 261, 262, 0, 264, 265, 266, 267,
-	268, 269, 270, 271, 392, 272, 273, 274, 275, 0,
-	276, 277, 278, 279, 280, 281, 282, 283, 284, 285,
-	286, 0, 287, 288, 506, 289, 290, 291, 393, 1149,
-	293, 294, 295, 296, 297, 298, 299, 53, 300, 301,
-	302, 303, 423, 646, 304, 305, 394, 306, 307, 507,
-	308, 309, 395, 310, 0, 311, 312, 313, 314, 315,
-	316, 317, 318, 319, 320, 321, 654, 0, 322, 323,
-	55, 324, 508, 325, 326, 327, 328, 329, 0, 425,
-	397, 0, 0, 424, 330, 655, 331, 656, 0, 332,
-	333, 334, 335, 336, 337, 338, 0, 0, 339, 340,
-	341, 342, 343, 647, 0, 344, 345, 346, 347, 348,
-	485, 401, 0, 349, 509, 350, 351, 352, 353, 0,
-	0, 354, 630, 51, 355, 356, 357, 358, 359, 360,
-	361, 362, 0, 0, 52, 99, 100, 101, 102, 103,
-	104, 105, 106, 0, 107, 108, 109, 0, 0, 0,
-	0, 0, 1147, 0, 110, 111, 0, 112, 113, 490,
-	114, 115, 116, 363, 364, 491, 365, 0, 366, 0,
-	117, 118, 119, 120, 121, 0, 650, 420, 122, 367,
-	368, 123, 0, 124, 125, 126, 127, 658, 0, 638,
-	0, 128, 129, 130, 131, 132, 0, 493, 133, 134,
-	135, 0, 136, 137, 138, 139, 140, 141, 0, 494,
-	142, 143, 144, 648, 639, 644, 649, 640, 641, 645,
-	145, 146, 147, 148, 149, 370, 150, 151, 371, 372,
-	152, 0, 153, 0, 154, 155, 156, 157, 158, 0,
-	159, 160, 161, 0, 0, 162, 163, 164, 165, 166,
-	0, 167, 168, 169, 0, 170, 171, 172, 0, 173,
-	174, 175, 176, 373, 177, 178, 179, 651, 0, 180,
-	0, 181, 182, 375, 183, 0, 184, 0, 185, 496,
-	0, 497, 186, 187, 188, 0, 189, 190, 659, 0,
-	377, 191, 0, 192, 193, 194, 195, 196, 197, 198,
-	199, 200, 0, 201, 202, 203, 204, 205, 206, 0,
-	207, 498, 378, 208, 209, 210, 211, 379, 380, 0,
-	381, 0, 212, 499, 213, 500, 214, 215, 216, 217,
-	218, 1144, 0, 219, 660, 501, 220, 502, 0, 221,
-	222, 421, 642, 643, 223, 224, 225, 226, 227, 228,
-	229, 230, 231, 232, 233, 234, 235, 236, 422, 383,
-	503, 384, 237, 238, 385, 0, 239, 240, 241, 0,
-	657, 242, 387, 243, 244, 245, 0, 246, 0, 464,
-	247, 248, 0, 0, 249, 388, 504, 250, 505, 652,
-	251, 252, 253, 254, 255, 256, 257, 0, 258, 259,
-	653, 260, 391, 263, 261, 262, 0, 264, 265, 266,
-	267, 268, 269, 270, 271, 392, 272, 273, 274, 275,
-	0, 276, 277, 278, 279, 280, 281, 282, 283, 284,
-	285, 286, 0, 287, 288, 506, 289, 290, 291, 393,
-	1149, 293, 294, 295, 296, 297, 298, 299, 0, 300,
-	301, 302, 303, 423, 646, 304, 305, 394, 306, 307,
-	507, 308, 309, 395, 310, 0, 311, 312, 313, 314,
-	315, 316, 317, 318, 319, 320, 321, 654, 0, 322,
-	323, 0, 324, 508, 325, 326, 327, 328, 329, 0,
-	425, 397, 0, 0, 424, 330, 655, 331, 656, 0,
-	332, 333, 334, 335, 336, 337, 338, 0, 0, 339,
-	340, 341, 342, 343, 647, 0, 344, 345, 346, 347,
-	348, 400, 401, 0, 349, 509, 350, 351, 352, 353,
-	0, 0, 354, 630, 0, 355, 356, 357, 358, 359,
-	360, 361, 362, 0, 0, 0, 99, 100, 101, 102,
-	103, 104, 105, 106, 0, 107, 108, 109, 0, 0,
-	0, 0, 0, 1147, 0, 110, 111, 0, 112, 113,
-	490, 114, 115, 116, 363, 364, 491, 365, 0, 366,
-	0, 117, 118, 119, 120, 121, 0, 650, 420, 122,
-	367, 368, 123, 0, 124, 125, 126, 127, 658, 0,
-	638, 0, 128, 129, 130, 131, 132, 0, 493, 133,
-	134, 135, 0, 136, 137, 138, 139, 140, 141, 0,
-	494, 142, 143, 144, 648, 639, 644, 649, 640, 641,
-	645, 145, 146, 147, 148, 149, 370, 150, 151, 371,
-	372, 152, 0, 153, 0, 154, 155, 156, 157, 158,
-	0, 159, 160, 161, 0, 0, 162, 163, 164, 165,
-	166, 0, 167, 168, 169, 0, 170, 171, 172, 0,
-	173, 174, 175, 176, 373, 177, 178, 179, 651, 0,
-	180, 0, 181, 182, 375, 183, 0, 184, 0, 185,
-	496, 0, 497, 186, 187, 188, 0, 189, 190, 659,
-	0, 377, 191, 0, 192, 193, 194, 195, 196, 197,
-	198, 199, 200, 0, 201, 202, 203, 204, 205, 206,
-	0, 207, 498, 378, 208, 209, 210, 211, 379, 380,
-	0, 381, 0, 212, 499, 213, 500, 214, 215, 216,
-	217, 218, 0, 0, 219, 660, 501, 220, 502, 0,
-	221, 222, 421, 642, 643, 223, 224, 225, 226, 227,
-	228, 229, 230, 231, 232, 233, 234, 235, 236, 422,
-	383, 503, 384, 237, 238, 385, 0, 239, 240, 241,
-	0, 657, 242, 387, 243, 244, 245, 0, 246, 0,
-	0, 247, 248, 0, 0, 249, 388, 504, 250, 505,
-	652, 251, 252, 253, 254, 255, 256, 257, 0, 258,
-	259, 653, 260, 391, 263, 261, 262, 0, 264, 265,
-	266, 267, 268, 269, 270, 271, 392, 272, 273, 274,
-	275, 0, 276, 277, 278, 279, 280, 281, 282, 283,
-	284, 285, 286, 0, 287, 288, 506, 289, 290, 291,
-	393, 292, 293, 294, 295, 296, 297, 298, 299, 0,
-	300, 301, 302, 303, 423, 646, 304, 305, 394, 306,
-	307, 507, 308, 309, 395, 310, 0, 311, 312, 313,
-	314, 315, 316, 317, 318, 319, 320, 321, 654, 0,
-	322, 323, 0, 324, 508, 325, 326, 327, 328, 329,
-	0, 425, 397, 0, 0, 424, 330, 655, 331, 656,
-	0, 332, 333, 334, 335, 336, 337, 338, 0, 0,
-	339, 340, 341, 342, 343, 647, 0, 344, 345, 346,
-	347, 348, 400, 401, 0, 349, 509, 350, 351, 352,
-	353, 0, 0, 354, 630, 0, 355, 356, 357, 358,
-	359, 360, 361, 362, 0, 0, 0, 99, 100, 101,
-	102, 103, 104, 105, 106, 0, 107, 108, 109, 0,
-	0, 0, 0, 0, 1793, 0, 110, 111, 0, 112,
-	113, 490, 114, 115, 116, 363, 364, 491, 365, 0,
-	366, 0, 117, 118, 119, 120, 121, 0, 650, 420,
-	122, 367, 368, 123, 0, 124, 125, 126, 127, 658,
-	0, 638, 0, 128, 129, 130, 131, 132, 0, 493,
-	133, 134, 135, 0, 136, 137, 138, 139, 140, 141,
-	0, 494, 142, 143, 144, 648, 639, 644, 649, 640,
-	641, 645, 145, 146, 147, 148, 149, 370, 150, 151,
-	371, 372, 152, 0, 153, 0, 154, 155, 156, 157,
-	158, 0, 159, 160, 161, 0, 0, 162, 163, 164,
-	165, 166, 0, 167, 168, 169, 0, 170, 171, 172,
-	0, 173, 174, 175, 176, 373, 177, 178, 179, 651,
-	0, 180, 0, 181, 182, 375, 183, 0, 184, 0,
-	185, 496, 0, 497, 186, 187, 188, 0, 189, 190,
-	659, 0, 377, 191, 0, 192, 193, 194, 195, 196,
-	197, 198, 199, 200, 0, 201, 202, 203, 204, 205,
-	206, 0, 207, 498, 378, 208, 209, 210, 211, 379,
-	380, 0, 381, 0, 212, 499, 213, 500, 214, 215,
-	216, 217, 218, 0, 0, 219, 660, 501, 220, 502,
-	0, 221, 222, 421, 642, 643, 223, 224, 225, 226,
-	227, 228, 229, 230, 231, 232, 233, 234, 235, 236,
-	422, 383, 503, 384, 237, 238, 385, 0, 239, 240,
-	241, 0, 657, 242, 387, 243, 244, 245, 0, 246,
-	0, 0, 247, 248, 0, 0, 249, 388, 504, 250,
-	505, 652, 251, 252, 253, 254, 255, 256, 257, 0,
-	258, 259, 653, 260, 391, 263, 261, 262, 0, 264,
-	265, 266, 267, 268, 269, 270, 271, 392, 272, 273,
-	274, 275, 0, 276, 277, 278, 279, 280, 281, 282,
-	283, 284, 285, 286, 0, 287, 288, 506, 289, 290,
-	291, 393, 1149, 293, 294, 295, 296, 297, 298, 299,
-	0, 300, 301, 302, 303, 423, 646, 304, 305, 394,
-	306, 307, 507, 308, 309, 395, 310, 0, 311, 312,
-	313, 314, 315, 316, 317, 318, 319, 320, 321, 654,
-	0, 322, 323, 0, 324, 508, 325, 326, 327, 328,
-	329, 0, 425, 397, 0, 0, 424, 330, 655, 331,
-	656, 0, 332, 333, 334, 335, 336, 337, 338, 0,
-	0, 339, 340, 341, 342, 343, 647, 0, 344, 345,
-	346, 347, 348, 400, 401, 0, 349, 509, 350, 351,
-	352, 353, 0, 0, 354, 486, 0, 355, 356, 357,
-	358, 359, 360, 361, 362, 0, 0, 0, 99, 100,
-	101, 102, 103, 104, 105, 106, 0, 107, 108, 109,
-	0, 0, 0, 0, 0, 50, 0, 110, 111, 0,
-	112, 113, 490, 114, 115, 116, 363, 364, 491, 365,
-	0, 366, 0, 117, 118, 119, 120, 121, 0, 0,
-	420, 122, 367, 368, 123, 0, 124, 125, 126, 127,
-	369, 0, 492, 0, 128, 129, 130, 131, 132, 0,
-	493, 133, 134, 135, 0, 136, 137, 138, 139, 140,
-	141, 0, 494, 142, 143, 144, 0, 0, 0, 495,
-	0, 0, 0, 145, 146, 147, 148, 149, 370, 150,
-	151, 371, 372, 152, 0, 153, 0, 154, 155, 156,
-	157, 158, 0, 159, 160, 161, 0, 0, 162, 163,
-	164, 165, 166, 0, 167, 168, 169, 0, 170, 171,
-	172, 0, 173, 174, 175, 176, 373, 177, 178, 179,
-	374, 0, 180, 0, 181, 182, 375, 183, 0, 184,
-	0, 185, 496, 0, 497, 186, 187, 188, 0, 189,
-	190, 376, 0, 377, 191, 0, 192, 193, 194, 195,
-	196, 197, 198, 199, 200, 0, 201, 202, 203, 204,
-	205, 206, 0, 207, 498, 378, 208, 209, 210, 211,
-	379, 380, 0, 381, 0, 212, 499, 213, 500, 214,
-	215, 216, 217, 218, 0, 0, 219, 382, 501, 220,
-	502, 0, 221, 222, 421, 0, 0, 223, 224, 225,
-	226, 227, 228, 229, 230, 231, 232, 233, 234, 235,
-	236, 422, 383, 503, 384, 237, 238, 385, 0, 239,
-	240, 241, 0, 386, 242, 387, 243, 244, 245, 0,
-	246, 0, 0, 247, 248, 0, 0, 249, 388, 504,
-	250, 505, 389, 251, 252, 253, 254, 255, 256, 257,
-	0, 258, 259, 390, 260, 391, 263, 261, 262, 0,
-	264, 265, 266, 267, 268, 269, 270, 271, 392, 272,
-	273, 274, 275, 0, 276, 277, 278, 279, 280, 281,
-	282, 283, 284, 285, 286, 0, 287, 288, 506, 289,
-	290, 291, 393, 292, 293, 294, 295, 296, 297, 298,
-	299, 53, 300, 301, 302, 303, 423, 0, 304, 305,
-	394, 306, 307, 507, 308, 309, 395, 310, 0, 311,
-	312, 313, 314, 315, 316, 317, 318, 319, 320, 321,
-	396, 0, 322, 323, 55, 324, 508, 325, 326, 327,
-	328, 329, 0, 425, 397, 0, 0, 424, 330, 398,
-	331, 399, 0, 332, 333, 334, 335, 336, 337, 338,
-	0, 0, 339, 340, 341, 342, 343, 0, 0, 344,
-	345, 346, 347, 348, 485, 401, 0, 349, 509, 350,
-	351, 352, 353, 0, 0, 354, 0, 51, 355, 356,
-	357, 358, 359, 360, 361, 362, 0, 0, 52, 0,
-	0, 0, 0, 0, 486, 721, 725, 0, 0, 726,
-	0, 0, 0, 0, 0, 0, 50, 99, 100, 101,
+	0, 107, 108, 109, 0, 0, 0, 0, 613, 0,
+	0, 110, 111, 0, 112, 113, 496, 114, 115, 116,
+	366, 665, 368, 497, 666, 0, 667, 0, 117, 118,
+	119, 120, 121, 122, 123, 630, 653, 426, 124, 668,
+	669, 125, 0, 126, 127, 128, 129, 661, 0, 641,
+	0, 130, 131, 132, 133, 134, 0, 499, 135, 136,
+	137, 0, 138, 139, 140, 141, 142, 143, 0, 500,
+	144, 145, 146, 651, 642, 647, 652, 643, 644, 648,
+	147, 148, 149, 150, 151, 670, 152, 153, 671, 672,
+	154, 701, 155, 0, 156, 157, 158, 159, 160, 0,
+	161, 162, 163, 0, 0, 164, 165, 664, 167, 168,
+	0, 169, 170, 171, 0, 172, 173, 174, 0, 175,
+	176, 177, 178, 612, 179, 180, 181, 654, 628, 182,
+	0, 183, 184, 673, 185, 0, 186, 0, 187, 502,
+	0, 503, 188, 189, 190, 0, 191, 192, 662, 0,
+	616, 193, 0, 194, 195, 196, 197, 198, 504, 199,
+	200, 201, 202, 0, 203, 204, 205, 206, 207, 208,
+	0, 209, 505, 382, 210, 211, 212, 213, 674, 675,
+	0, 640, 0, 214, 506, 215, 507, 216, 217, 218,
+	219, 220, 0, 0, 221, 663, 508, 222, 509, 0,
+	223, 224, 427, 645, 646, 225, 226, 227, 228, 229,
+	230, 231, 232, 233, 234, 235, 236, 237, 238, 428,
+	387, 510, 388, 239, 240, 389, 601, 241, 242, 243,
+	629, 660, 244, 676, 245, 246, 247, 0, 248, 0,
+	0, 249, 250, 0, 0, 251, 392, 511, 252, 512,
+	655, 253, 254, 255, 256, 257, 258, 259, 0, 260,
+	261, 656, 262, 395, 265, 263, 264, 0, 266, 267,
+	268, 269, 270, 271, 272, 273, 677, 274, 275, 276,
+	277, 0, 278, 279, 280, 281, 282, 283, 284, 285,
+	286, 287, 288, 0, 289, 290, 513, 291, 292, 293,
+	617, 294, 295, 296, 297, 298, 299, 300, 301, 53,
+	302, 303, 398, 304, 305, 429, 649, 306, 307, 399,
+	308, 309, 514, 310, 311, 678, 401, 312, 0, 313,
+	314, 315, 316, 317, 318, 319, 320, 321, 322, 323,
+	657, 0, 324, 325, 55, 326, 515, 327, 328, 329,
+	330, 331, 0, 679, 680, 0, 0, 430, 332, 658,
+	333, 659, 627, 334, 335, 336, 337, 338, 339, 340,
+	0, 604, 341, 342, 343, 344, 345, 346, 650, 0,
+	347, 348, 349, 350, 351, 495, 681, 0, 352, 516,
+	353, 354, 355, 356, 0, 0, 357, 0, 51, 358,
+	359, 360, 361, 362, 363, 364, 365, 602, 0, 52,
+	0, 0, 0, 0, 598, 599, 633, 620, 621, 622,
+	623, 619, 607, 0, 600, 0, 0, 608, 0, 0,
+	0, 0, 99, 100, 101, 102, 103, 104, 105, 106,
+	0, 107, 108, 109, 0, 0, 0, 0, 613, 0,
+	0, 110, 111, 0, 112, 113, 496, 114, 115, 116,
+	366, 665, 368, 497, 666, 0, 667, 0, 117, 118,
+	119, 120, 121, 122, 123, 630, 653, 426, 124, 668,
+	669, 125, 0, 126, 127, 128, 129, 661, 0, 641,
+	0, 130, 131, 132, 133, 134, 0, 499, 135, 136,
+	137, 0, 138, 139, 140, 141, 142, 143, 0, 500,
+	144, 145, 146, 651, 642, 647, 652, 643, 644, 648,
+	147, 148, 149, 150, 151, 670, 152, 153, 671, 672,
+	154, 0, 155, 0, 156, 157, 158, 159, 160, 0,
+	161, 162, 163, 0, 0, 164, 165, 664, 167, 168,
+	0, 169, 170, 171, 0, 172, 173, 174, 0, 175,
+	176, 177, 178, 612, 179, 180, 181, 654, 628, 182,
+	0, 183, 184, 673, 185, 0, 186, 0, 187, 502,
+	0, 503, 188, 189, 190, 0, 191, 192, 662, 0,
+	616, 193, 0, 194, 195, 196, 197, 198, 504, 199,
+	200, 201, 202, 0, 203, 204, 205, 206, 207, 208,
+	0, 209, 505, 382, 210, 211, 212, 213, 674, 675,
+	0, 640, 0, 214, 506, 215, 507, 216, 217, 218,
+	219, 220, 0, 0, 221, 663, 508, 222, 509, 0,
+	223, 224, 427, 645, 646, 225, 226, 227, 228, 229,
+	230, 231, 232, 233, 234, 235, 236, 237, 238, 428,
+	387, 510, 388, 239, 240, 389, 601, 241, 242, 243,
+	629, 660, 244, 676, 245, 246, 247, 0, 248, 0,
+	0, 249, 250, 0, 0, 251, 392, 511, 252, 512,
+	655, 253, 254, 255, 256, 257, 258, 259, 0, 260,
+	261, 656, 262, 395, 265, 263, 264, 0, 266, 267,
+	268, 269, 270, 271, 272, 273, 677, 274, 275, 276,
+	277, 0, 278, 279, 280, 281, 282, 283, 284, 285,
+	286, 287, 288, 0, 289, 290, 513, 291, 292, 293,
+	617, 294, 295, 296, 297, 298, 299, 300, 301, 53,
+	302, 303, 398, 304, 305, 429, 649, 306, 307, 399,
+	308, 309, 514, 310, 311, 678, 401, 312, 0, 313,
+	314, 315, 316, 317, 318, 319, 320, 321, 322, 323,
+	657, 0, 324, 325, 55, 326, 515, 327, 328, 329,
+	330, 331, 0, 679, 680, 0, 0, 430, 332, 658,
+	333, 659, 627, 334, 335, 336, 337, 338, 339, 340,
+	0, 604, 341, 342, 343, 344, 345, 346, 650, 0,
+	347, 348, 349, 350, 351, 495, 681, 0, 352, 516,
+	353, 354, 355, 356, 0, 0, 357, 0, 51, 358,
+	359, 360, 361, 362, 363, 364, 365, 602, 0, 52,
+	0, 0, 0, 0, 598, 599, 633, 620, 621, 622,
+	623, 619, 607, 0, 600, 0, 0, 608, 0, 0,
+	0, 0, 99, 100, 101, 102, 103, 104, 105, 106,
+	0, 107, 108, 109, 0, 0, 0, 0, 613, 0,
+	0, 110, 111, 0, 112, 113, 496, 114, 115, 116,
+	366, 665, 368, 497, 666, 0, 667, 1310, 117, 118,
+	119, 120, 121, 122, 123, 630, 653, 426, 124, 668,
+	669, 125, 0, 126, 127, 128, 129, 661, 0, 641,
+	0, 130, 131, 132, 133, 134, 0, 499, 135, 136,
+	137, 0, 138, 139, 140, 141, 142, 143, 0, 500,
+	144, 145, 146, 651, 642, 647, 652, 643, 644, 648,
+	147, 148, 149, 150, 151, 670, 152, 153, 671, 672,
+	154, 0, 155, 0, 156, 157, 158, 159, 160, 0,
+	161, 162, 163, 0, 0, 164, 165, 664, 167, 168,
+	0, 169, 170, 171, 0, 172, 173, 174, 0, 175,
+	176, 177, 178, 612, 179, 180, 181, 654, 628, 182,
+	0, 183, 184, 673, 185, 0, 186, 0, 187, 502,
+	1315, 503, 188, 189, 190, 0, 191, 192, 662, 0,
+	616, 193, 0, 194, 195, 196, 197, 198, 504, 199,
+	200, 201, 202, 0, 203, 204, 205, 206, 207, 208,
+	0, 209, 505, 382, 210, 211, 212, 213, 674, 675,
+	0, 640, 0, 214, 506, 215, 507, 216, 217, 218,
+	219, 220, 0, 1311, 221, 663, 508, 222, 509, 0,
+	223, 224, 427, 645, 646, 225, 226, 227, 228, 229,
+	230, 231, 232, 233, 234, 235, 236, 237, 238, 428,
+	387, 510, 388, 239, 240, 389, 601, 241, 242, 243,
+	629, 660, 244, 676, 245, 246, 247, 0, 248, 0,
+	0, 249, 250, 0, 0, 251, 392, 511, 252, 512,
+	655, 253, 254, 255, 256, 257, 258, 259, 0, 260,
+	261, 656, 262, 395, 265, 263, 264, 0, 266, 267,
+	268, 269, 270, 271, 272, 273, 677, 274, 275, 276,
+	277, 0, 278, 279, 280, 281, 282, 283, 284, 285,
+	286, 287, 288, 0, 289, 290, 513, 291, 292, 293,
+	617, 294, 295, 296, 297, 298, 299, 300, 301, 0,
+	302, 303, 398, 304, 305, 429, 649, 306, 307, 399,
+	308, 309, 514, 310, 311, 678, 401, 312, 0, 313,
+	314, 315, 316, 317, 318, 319, 320, 321, 322, 323,
+	657, 0, 324, 325, 0, 326, 515, 327, 328, 329,
+	330, 331, 0, 679, 680, 0, 1312, 430, 332, 658,
+	333, 659, 627, 334, 335, 336, 337, 338, 339, 340,
+	0, 604, 341, 342, 343, 344, 345, 346, 650, 0,
+	347, 348, 349, 350, 351, 406, 681, 0, 352, 516,
+	353, 354, 355, 356, 0, 0, 357, 0, 0, 358,
+	359, 360, 361, 362, 363, 364, 365, 602, 0, 0,
+	0, 0, 0, 0, 598, 599, 633, 620, 621, 622,
+	623, 619, 607, 0, 600, 0, 0, 608, 0, 0,
+	0, 0, 99, 100, 101, 102, 103, 104, 105, 106,
+	0, 107, 108, 109, 0, 0, 0, 0, 613, 0,
+	0, 110, 111, 0, 112, 113, 496, 114, 115, 116,
+	366, 665, 368, 497, 666, 0, 667, 0, 117, 118,
+	119, 120, 121, 122, 123, 630, 653, 426, 124, 668,
+	669, 125, 0, 126, 127, 128, 129, 661, 0, 641,
+	0, 130, 131, 132, 133, 134, 0, 499, 135, 136,
+	137, 0, 138, 139, 140, 141, 142, 143, 0, 500,
+	144, 145, 146, 651, 642, 647, 652, 643, 644, 648,
+	147, 148, 149, 150, 151, 670, 152, 153, 671, 672,
+	154, 0, 155, 0, 156, 157, 158, 159, 160, 0,
+	161, 162, 163, 0, 0, 164, 165, 664, 167, 168,
+	0, 169, 170, 171, 0, 172, 173, 174, 0, 175,
+	176, 177, 178, 612, 179, 180, 181, 654, 628, 182,
+	0, 183, 184, 673, 185, 0, 186, 0, 187, 502,
+	0, 503, 188, 189, 190, 0, 191, 192, 662, 0,
+	616, 193, 0, 194, 195, 196, 197, 198, 504, 199,
+	200, 201, 202, 0, 203, 204, 205, 206, 207, 208,
+	0, 209, 505, 382, 210, 211, 212, 213, 674, 675,
+	0, 640, 0, 214, 506, 215, 507, 216, 217, 218,
+	219, 220, 0, 0, 221, 663, 508, 222, 509, 0,
+	223, 224, 427, 645, 646, 225, 226, 227, 228, 229,
+	230, 231, 232, 233, 234, 235, 236, 237, 238, 428,
+	387, 510, 388, 239, 240, 389, 601, 241, 242, 243,
+	629, 660, 244, 676, 245, 246, 247, 0, 248, 0,
+	0, 249, 250, 0, 0, 251, 392, 511, 252, 512,
+	655, 253, 254, 255, 256, 257, 258, 259, 0, 260,
+	261, 656, 262, 395, 265, 263, 264, 0, 266, 267,
+	268, 269, 270, 271, 272, 273, 677, 274, 275, 276,
+	277, 0, 278, 279, 280, 281, 282, 283, 284, 285,
+	286, 287, 288, 0, 289, 290, 513, 291, 292, 293,
+	617, 294, 295, 296, 297, 298, 299, 300, 301, 0,
+	302, 303, 398, 304, 305, 429, 649, 306, 307, 399,
+	308, 309, 514, 310, 311, 678, 401, 312, 0, 313,
+	314, 315, 316, 317, 318, 319, 320, 321, 322, 323,
+	657, 0, 324, 325, 0, 326, 515, 327, 328, 329,
+	330, 331, 0, 679, 680, 0, 0, 430, 332, 658,
+	333, 659, 627, 334, 335, 336, 337, 338, 339, 340,
+	0, 604, 341, 342, 343, 344, 345, 346, 650, 0,
+	347, 348, 349, 350, 351, 406, 681, 0, 352, 516,
+	353, 354, 355, 356, 0, 0, 357, 0, 0, 358,
+	359, 360, 361, 362, 363, 364, 365, 602, 0, 0,
+	0, 0, 0, 0, 598, 599, 633, 620, 621, 622,
+	623, 619, 607, 0, 600, 0, 0, 608, 1765, 0,
+	0, 0, 99, 100, 101, 102, 103, 104, 105, 106,
+	0, 107, 108, 109, 0, 0, 0, 0, 613, 0,
+	0, 110, 111, 0, 112, 113, 496, 114, 115, 116,
+	366, 665, 368, 497, 666, 0, 667, 0, 117, 118,
+	119, 120, 121, 122, 123, 630, 653, 426, 124, 668,
+	669, 125, 0, 126, 127, 128, 129, 661, 0, 641,
+	0, 130, 131, 132, 133, 134, 0, 499, 135, 136,
+	137, 0, 138, 139, 140, 141, 142, 143, 0, 500,
+	144, 145, 146, 651, 642, 647, 652, 643, 644, 648,
+	147, 148, 149, 150, 151, 670, 152, 153, 671, 672,
+	154, 0, 155, 0, 156, 157, 158, 159, 160, 0,
+	161, 162, 163, 0, 0, 164, 165, 664, 167, 168,
+	0, 169, 170, 171, 0, 172, 173, 174, 0, 175,
+	176, 177, 178, 612, 179, 180, 181, 654, 628, 182,
+	0, 183, 184, 673, 185, 0, 186, 0, 187, 502,
+	0, 503, 188, 189, 190, 0, 191, 192, 662, 0,
+	616, 193, 0, 194, 195, 196, 197, 198, 504, 199,
+	200, 201, 202, 0, 203, 204, 205, 206, 207, 208,
+	0, 209, 505, 382, 210, 211, 212, 213, 674, 675,
+	0, 640, 0, 214, 506, 215, 507, 216, 217, 218,
+	219, 220, 0, 0, 221, 663, 508, 222, 509, 0,
+	223, 224, 427, 645, 646, 225, 226, 227, 228, 229,
+	230, 231, 232, 233, 234, 235, 236, 237, 238, 428,
+	387, 510, 388, 239, 240, 389, 601, 241, 242, 243,
+	629, 660, 244, 676, 245, 246, 247, 0, 248, 0,
+	0, 249, 250, 0, 0, 251, 392, 511, 252, 512,
+	655, 253, 254, 255, 256, 257, 258, 259, 0, 260,
+	261, 656, 262, 395, 265, 263, 264, 0, 266, 267,
+	268, 269, 270, 271, 272, 273, 677, 274, 275, 276,
+	277, 0, 278, 279, 280, 281, 282, 283, 284, 285,
+	286, 287, 288, 0, 289, 290, 513, 291, 292, 293,
+	617, 294, 295, 296, 297, 298, 299, 300, 301, 0,
+	302, 303, 398, 304, 305, 429, 649, 306, 307, 399,
+	308, 309, 514, 310, 311, 678, 401, 312, 0, 313,
+	314, 315, 316, 317, 318, 319, 320, 321, 322, 323,
+	657, 0, 324, 325, 0, 326, 515, 327, 328, 329,
+	330, 331, 0, 679, 680, 0, 0, 430, 332, 658,
+	333, 659, 627, 334, 335, 336, 337, 338, 339, 340,
+	0, 604, 341, 342, 343, 344, 345, 346, 650, 0,
+	347, 348, 349, 350, 351, 406, 681, 0, 352, 516,
+	353, 354, 355, 356, 0, 0, 357, 0, 0, 358,
+	359, 360, 361, 362, 363, 364, 365, 602, 0, 0,
+	0, 0, 0, 0, 598, 599, 633, 620, 621, 622,
+	623, 619, 607, 0, 600, 0, 0, 608, 1709, 0,
+	0, 0, 99, 100, 101, 102, 103, 104, 105, 106,
+	0, 107, 108, 109, 0, 0, 0, 0, 613, 0,
+	0, 110, 111, 0, 112, 113, 496, 114, 115, 116,
+	366, 665, 368, 497, 666, 0, 667, 0, 117, 118,
+	119, 120, 121, 122, 123, 630, 653, 426, 124, 668,
+	669, 125, 0, 126, 127, 128, 129, 661, 0, 641,
+	0, 130, 131, 132, 133, 134, 0, 499, 135, 136,
+	137, 0, 138, 139, 140, 141, 142, 143, 0, 500,
+	144, 145, 146, 651, 642, 647, 652, 643, 644, 648,
+	147, 148, 149, 150, 151, 670, 152, 153, 671, 672,
+	154, 0, 155, 0, 156, 157, 158, 159, 160, 0,
+	161, 162, 163, 0, 0, 164, 165, 664, 167, 168,
+	0, 169, 170, 171, 0, 172, 173, 174, 0, 175,
+	176, 177, 178, 612, 179, 180, 181, 654, 628, 182,
+	0, 183, 184, 673, 185, 0, 186, 0, 187, 502,
+	0, 503, 188, 189, 190, 0, 191, 192, 662, 0,
+	616, 193, 0, 194, 195, 196, 197, 198, 504, 199,
+	200, 201, 202, 0, 203, 204, 205, 206, 207, 208,
+	0, 209, 505, 382, 210, 211, 212, 213, 674, 675,
+	0, 640, 0, 214, 506, 215, 507, 216, 217, 218,
+	219, 220, 0, 0, 221, 663, 508, 222, 509, 0,
+	223, 224, 427, 645, 646, 225, 226, 227, 228, 229,
+	230, 231, 232, 233, 234, 235, 236, 237, 238, 428,
+	387, 510, 388, 239, 240, 389, 601, 241, 242, 243,
+	629, 660, 244, 676, 245, 246, 247, 0, 248, 0,
+	0, 249, 250, 0, 0, 251, 392, 511, 252, 512,
+	655, 253, 254, 255, 256, 257, 258, 259, 0, 260,
+	261, 656, 262, 395, 265, 263, 264, 0, 266, 267,
+	268, 269, 270, 271, 272, 273, 677, 274, 275, 276,
+	277, 0, 278, 279, 280, 281, 282, 283, 284, 285,
+	286, 287, 288, 0, 289, 290, 513, 291, 292, 293,
+	617, 294, 295, 296, 297, 298, 299, 300, 301, 0,
+	302, 303, 398, 304, 305, 429, 649, 306, 307, 399,
+	308, 309, 514, 310, 311, 678, 401, 312, 0, 313,
+	314, 315, 316, 317, 318, 319, 320, 321, 322, 323,
+	657, 0, 324, 325, 0, 326, 515, 327, 328, 329,
+	330, 331, 0, 679, 680, 0, 0, 430, 332, 658,
+	333, 659, 627, 334, 335, 336, 337, 338, 339, 340,
+	0, 604, 341, 342, 343, 344, 345, 346, 650, 0,
+	347, 348, 349, 350, 351, 406, 681, 0, 352, 516,
+	353, 354, 355, 356, 0, 0, 357, 0, 0, 358,
+	359, 360, 361, 362, 363, 364, 365, 602, 0, 0,
+	0, 0, 0, 0, 598, 599, 633, 620, 621, 622,
+	623, 619, 607, 0, 600, 0, 0, 608, 1257, 0,
+	0, 0, 99, 100, 101, 102, 103, 104, 105, 106,
+	0, 107, 108, 109, 0, 0, 0, 0, 613, 0,
+	0, 110, 111, 0, 112, 113, 496, 114, 115, 116,
+	366, 665, 368, 497, 666, 0, 667, 0, 117, 118,
+	119, 120, 121, 122, 123, 630, 653, 426, 124, 668,
+	669, 125, 0, 126, 127, 128, 129, 661, 0, 641,
+	0, 130, 131, 132, 133, 134, 0, 499, 135, 136,
+	137, 0, 138, 139, 140, 141, 142, 143, 0, 500,
+	144, 145, 146, 651, 642, 647, 652, 643, 644, 648,
+	147, 148, 149, 150, 151, 670, 152, 153, 671, 672,
+	154, 0, 155, 0, 156, 157, 158, 159, 160, 0,
+	161, 162, 163, 0, 0, 164, 165, 664, 167, 168,
+	0, 169, 170, 171, 0, 172, 173, 174, 0, 175,
+	176, 177, 178, 612, 179, 180, 181, 654, 628, 182,
+	0, 183, 184, 673, 185, 0, 186, 0, 187, 502,
+	0, 503, 188, 189, 190, 0, 191, 192, 662, 0,
+	616, 193, 0, 194, 195, 196, 197, 198, 504, 199,
+	200, 201, 202, 0, 203, 204, 205, 206, 207, 208,
+	0, 209, 505, 382, 210, 211, 212, 213, 674, 675,
+	0, 640, 0, 214, 506, 215, 507, 216, 217, 218,
+	219, 220, 0, 0, 221, 663, 508, 222, 509, 0,
+	223, 224, 427, 645, 646, 225, 226, 227, 228, 229,
+	230, 231, 232, 233, 234, 235, 236, 237, 238, 428,
+	387, 510, 388, 239, 240, 389, 601, 241, 242, 243,
+	629, 660, 244, 676, 245, 246, 247, 0, 248, 0,
+	0, 249, 250, 0, 0, 251, 392, 511, 252, 512,
+	655, 253, 254, 255, 256, 257, 258, 259, 0, 260,
+	261, 656, 262, 395, 265, 263, 264, 0, 266, 267,
+	268, 269, 270, 271, 272, 273, 677, 274, 275, 276,
+	277, 0, 278, 279, 280, 281, 282, 283, 284, 285,
+	286, 287, 288, 0, 289, 290, 513, 291, 292, 293,
+	617, 294, 295, 296, 297, 298, 299, 300, 301, 0,
+	302, 303, 398, 304, 305, 429, 649, 306, 307, 399,
+	308, 309, 514, 310, 311, 678, 401, 312, 0, 313,
+	314, 315, 316, 317, 318, 319, 320, 321, 322, 323,
+	657, 0, 324, 325, 0, 326, 515, 327, 328, 329,
+	330, 331, 0, 679, 680, 0, 0, 430, 332, 658,
+	333, 659, 627, 334, 335, 336, 337, 338, 339, 340,
+	0, 604, 341, 342, 343, 344, 345, 346, 650, 0,
+	347, 348, 349, 350, 351, 406, 681, 0, 352, 516,
+	353, 354, 355, 356, 0, 0, 357, 0, 0, 358,
+	359, 360, 361, 362, 363, 364, 365, 602, 0, 0,
+	0, 0, 0, 0, 598, 599, 633, 620, 621, 622,
+	623, 619, 607, 0, 600, 967, 1252, 608, 0, 0,
+	0, 0, 99, 100, 101, 102, 103, 104, 105, 106,
+	0, 107, 108, 109, 0, 0, 0, 0, 613, 0,
+	0, 110, 111, 0, 112, 113, 496, 114, 115, 116,
+	366, 665, 368, 497, 666, 0, 667, 0, 117, 118,
+	119, 120, 121, 122, 123, 630, 653, 426, 124, 668,
+	669, 125, 0, 126, 127, 128, 129, 661, 0, 641,
+	0, 130, 131, 132, 133, 134, 0, 499, 135, 136,
+	137, 0, 138, 139, 140, 141, 142, 143, 0, 500,
+	144, 145, 146, 651, 642, 647, 652, 643, 644, 648,
+	147, 148, 149, 150, 151, 670, 152, 153, 671, 672,
+	154, 0, 155, 0, 156, 157, 158, 159, 160, 0,
+	161, 162, 163, 0, 0, 164, 165, 664, 167, 168,
+	0, 169, 170, 171, 0, 172, 173, 174, 0, 175,
+	176, 177, 178, 612, 179, 180, 181, 654, 628, 182,
+	0, 183, 184, 673, 185, 0, 186, 0, 187, 502,
+	0, 503, 188, 189, 190, 0, 191, 192, 662, 0,
+	616, 193, 0, 194, 195, 196, 197, 198, 504, 199,
+	200, 201, 202, 0, 203, 204, 205, 206, 207, 208,
+	0, 209, 505, 382, 210, 211, 212, 213, 674, 675,
+	0, 640, 0, 214, 506, 215, 507, 216, 217, 218,
+	219, 220, 0, 0, 221, 663, 508, 222, 509, 0,
+	223, 224, 427, 645, 646, 225, 226, 227, 228, 229,
+	230, 231, 232, 233, 234, 235, 236, 237, 238, 428,
+	387, 510, 388, 239, 240, 389, 601, 241, 242, 243,
+	629, 660, 244, 676, 245, 246, 247, 0, 248, 0,
+	0, 249, 250, 0, 0, 251, 392, 511, 252, 512,
+	655, 253, 254, 255, 256, 257, 258, 259, 0, 260,
+	261, 656, 262, 395, 265, 263, 264, 0, 266, 267,
+	268, 269, 270, 271, 272, 273, 677, 274, 275, 276,
+	277, 0, 278, 279, 280, 281, 282, 283, 284, 285,
+	286, 287, 288, 0, 289, 290, 513, 291, 292, 293,
+	617, 294, 295, 296, 297, 298, 299, 300, 301, 0,
+	302, 303, 398, 304, 305, 429, 649, 306, 307, 399,
+	308, 309, 514, 310, 311, 678, 401, 312, 0, 313,
+	314, 315, 316, 317, 318, 319, 320, 321, 322, 323,
+	657, 0, 324, 325, 0, 326, 515, 327, 328, 329,
+	330, 331, 0, 679, 680, 0, 0, 430, 332, 658,
+	333, 659, 627, 334, 335, 336, 337, 338, 339, 340,
+	0, 604, 341, 342, 343, 344, 345, 346, 650, 0,
+	347, 348, 349, 350, 351, 406, 681, 1715, 352, 516,
+	353, 354, 355, 356, 0, 0, 357, 0, 0, 358,
+	359, 360, 361, 362, 363, 364, 365, 602, 0, 0,
+	0, 0, 0, 0, 598, 599, 633, 620, 621, 622,
+	623, 619, 607, 0, 600, 0, 0, 608, 0, 0,
+	0, 0, 99, 100, 101, 102, 103, 104, 105, 106,
+	0, 107, 108, 109, 0, 0, 0, 0, 613, 0,
+	0, 110, 111, 0, 112, 113, 496, 114, 115, 116,
+	366, 665, 368, 497, 666, 0, 667, 0, 117, 118,
+	119, 120, 121, 122, 123, 630, 653, 426, 124, 668,
+	669, 125, 0, 126, 127, 128, 129, 661, 0, 641,
+	0, 130, 131, 132, 133, 134, 0, 499, 135, 136,
+	137, 0, 138, 139, 140, 141, 142, 143, 0, 500,
+	144, 145, 146, 651, 642, 647, 652, 643, 644, 648,
+	147, 148, 149, 150, 151, 670, 152, 153, 671, 672,
+	154, 701, 155, 0, 156, 157, 158, 159, 160, 0,
+	161, 162, 163, 0, 0, 164, 165, 664, 167, 168,
+	0, 169, 170, 171, 0, 172, 173, 174, 0, 175,
+	176, 177, 178, 612, 179, 180, 181, 654, 628, 182,
+	0, 183, 184, 673, 185, 0, 186, 0, 187, 502,
+	0, 503, 188, 189, 190, 0, 191, 192, 662, 0,
+	616, 193, 0, 194, 195, 196, 197, 198, 504, 199,
+	200, 201, 202, 0, 203, 204, 205, 206, 207, 208,
+	0, 209, 505, 382, 210, 211, 212, 213, 674, 675,
+	0, 640, 0, 214, 506, 215, 507, 216, 217, 218,
+	219, 220, 0, 0, 221, 663, 508, 222, 509, 0,
+	223, 224, 427, 645, 646, 225, 226, 227, 228, 229,
+	230, 231, 232, 233, 234, 235, 236, 237, 238, 428,
+	387, 510, 388, 239, 240, 389, 601, 241, 242, 243,
+	629, 660, 244, 676, 245, 246, 247, 0, 248, 0,
+	0, 249, 250, 0, 0, 251, 392, 511, 252, 512,
+	655, 253, 254, 255, 256, 257, 258, 259, 0, 260,
+	261, 656, 262, 395, 265, 263, 264, 0, 266, 267,
+	268, 269, 270, 271, 272, 273, 677, 274, 275, 276,
+	277, 0, 278, 279, 280, 281, 282, 283, 284, 285,
+	286, 287, 288, 0, 289, 290, 513, 291, 292, 293,
+	617, 294, 295, 296, 297, 298, 299, 300, 301, 0,
+	302, 303, 398, 304, 305, 429, 649, 306, 307, 399,
+	308, 309, 514, 310, 311, 678, 401, 312, 0, 313,
+	314, 315, 316, 317, 318, 319, 320, 321, 322, 323,
+	657, 0, 324, 325, 0, 326, 515, 327, 328, 329,
+	330, 331, 0, 679, 680, 0, 0, 430, 332, 658,
+	333, 659, 627, 334, 335, 336, 337, 338, 339, 340,
+	0, 604, 341, 342, 343, 344, 345, 346, 650, 0,
+	347, 348, 349, 350, 351, 406, 681, 0, 352, 516,
+	353, 354, 355, 356, 0, 0, 357, 0, 0, 358,
+	359, 360, 361, 362, 363, 364, 365, 602, 0, 0,
+	0, 0, 0, 0, 598, 599, 633, 620, 621, 622,
+	623, 619, 607, 0, 600, 0, 0, 608, 0, 0,
+	0, 0, 99, 100, 101, 102, 103, 104, 105, 106,
+	0, 107, 108, 109, 0, 0, 0, 0, 613, 0,
+	0, 110, 111, 0, 112, 113, 496, 114, 115, 116,
+	366, 665, 368, 497, 666, 0, 667, 0, 117, 118,
+	119, 120, 121, 122, 123, 630, 653, 426, 124, 668,
+	669, 125, 0, 126, 127, 128, 129, 661, 0, 641,
+	0, 130, 131, 132, 133, 134, 0, 499, 135, 136,
+	137, 0, 138, 139, 140, 141, 142, 143, 0, 500,
+	144, 145, 146, 651, 642, 647, 652, 643, 644, 648,
+	147, 148, 149, 150, 151, 670, 152, 153, 671, 672,
+	154, 0, 155, 0, 156, 157, 158, 159, 160, 0,
+	161, 162, 163, 0, 0, 164, 165, 664, 167, 168,
+	0, 169, 170, 171, 0, 172, 173, 174, 0, 175,
+	176, 177, 178, 612, 179, 180, 181, 654, 628, 182,
+	0, 183, 184, 673, 185, 0, 186, 0, 187, 502,
+	0, 503, 188, 189, 190, 0, 191, 192, 662, 0,
+	616, 193, 0, 194, 195, 196, 197, 198, 504, 199,
+	200, 201, 202, 0, 203, 204, 205, 206, 207, 208,
+	0, 209, 505, 382, 210, 211, 212, 213, 674, 675,
+	0, 640, 0, 214, 506, 215, 507, 216, 217, 218,
+	219, 220, 0, 0, 221, 663, 508, 222, 509, 0,
+	223, 224, 427, 645, 646, 225, 226, 227, 228, 229,
+	230, 231, 232, 233, 234, 235, 236, 237, 238, 428,
+	387, 510, 388, 239, 240, 389, 601, 241, 242, 243,
+	629, 660, 244, 676, 245, 246, 247, 0, 248, 0,
+	0, 249, 250, 0, 0, 251, 392, 511, 252, 512,
+	655, 253, 254, 255, 256, 257, 258, 259, 0, 260,
+	261, 656, 262, 395, 265, 263, 264, 0, 266, 267,
+	268, 269, 270, 271, 272, 273, 677, 274, 275, 276,
+	277, 0, 278, 279, 280, 281, 282, 283, 284, 285,
+	286, 287, 288, 0, 289, 290, 513, 291, 292, 293,
+	617, 294, 295, 296, 297, 298, 299, 300, 301, 0,
+	302, 303, 398, 304, 305, 429, 649, 306, 307, 399,
+	308, 309, 514, 310, 311, 678, 401, 312, 0, 313,
+	314, 315, 316, 317, 318, 319, 320, 321, 322, 323,
+	657, 0, 324, 325, 0, 326, 515, 327, 328, 329,
+	330, 331, 0, 679, 680, 0, 0, 430, 332, 658,
+	333, 659, 627, 334, 335, 336, 337, 338, 339, 340,
+	0, 604, 341, 342, 343, 344, 345, 346, 650, 0,
+	347, 348, 349, 350, 351, 406, 681, 0, 352, 516,
+	353, 354, 355, 356, 0, 0, 357, 0, 0, 358,
+	359, 360, 361, 362, 363, 364, 365, 602, 0, 0,
+	0, 0, 0, 0, 598, 599, 596, 633, 620, 621,
+	622, 623, 619, 607, 600, 0, 0, 608, 0, 0,
+	0, 0, 0, 99, 100, 101, 102, 103, 104, 105,
+	106, 0, 107, 108, 109, 0, 0, 0, 0, 613,
+	0, 0, 110, 111, 0, 112, 113, 496, 114, 115,
+	116, 366, 665, 368, 497, 666, 0, 667, 0, 117,
+	118, 119, 120, 121, 122, 123, 630, 653, 426, 124,
+	668, 669, 125, 0, 126, 127, 128, 129, 661, 0,
+	641, 0, 130, 131, 132, 133, 134, 0, 499, 135,
+	136, 137, 0, 138, 139, 140, 141, 142, 143, 0,
+	500, 144, 145, 146, 651, 642, 647, 652, 643, 644,
+	648, 147, 148, 149, 150, 151, 670, 152, 153, 671,
+	672, 154, 0, 155, 0, 156, 157, 158, 159, 160,
+	0, 161, 162, 163, 0, 0, 164, 165, 664, 167,
+	168, 0, 169, 170, 171, 0, 172, 173, 174, 0,
+	175, 176, 177, 178, 612, 179, 180, 181, 654, 628,
+	182, 0, 183, 184, 673, 185, 0, 186, 0, 187,
+	502, 1315, 503, 188, 189, 190, 0, 191, 192, 662,
+	0, 616, 193, 0, 194, 195, 196, 197, 198, 504,
+	199, 200, 201, 202, 0, 203, 204, 205, 206, 207,
+	208, 0, 209, 505, 382, 210, 211, 212, 213, 674,
+	675, 0, 640, 0, 214, 506, 215, 507, 216, 217,
+	218, 219, 220, 0, 0, 221, 663, 508, 222, 509,
+	0, 223, 224, 427, 645, 646, 225, 226, 227, 228,
+	229, 230, 231, 232, 233, 234, 235, 236, 237, 238,
+	428, 387, 510, 388, 239, 240, 389, 601, 241, 242,
+	243, 629, 660, 244, 676, 245, 246, 247, 0, 248,
+	0, 0, 249, 250, 0, 0, 251, 392, 511, 252,
+	512, 655, 253, 254, 255, 256, 257, 258, 259, 0,
+	260, 261, 656, 262, 395, 265, 263, 264, 0, 266,
+	267, 268, 269, 270, 271, 272, 273, 677, 274, 275,
+	276, 277, 0, 278, 279, 280, 281, 282, 283, 284,
+	285, 286, 287, 288, 0, 289, 290, 513, 291, 292,
+	293, 617, 294, 295, 296, 297, 298, 299, 300, 301,
+	0, 302, 303, 398, 304, 305, 429, 649, 306, 307,
+	399, 308, 309, 514, 310, 311, 678, 401, 312, 0,
+	313, 314, 315, 316, 317, 318, 319, 320, 321, 322,
+	323, 657, 0, 324, 325, 0, 326, 515, 327, 328,
+	329, 330, 331, 0, 679, 680, 0, 0, 430, 332,
+	658, 333, 659, 627, 334, 335, 336, 337, 338, 339,
+	340, 0, 604, 341, 342, 343, 344, 345, 346, 650,
+	0, 347, 348, 349, 350, 351, 406, 681, 0, 352,
+	516, 353, 354, 355, 356, 0, 0, 357, 0, 0,
+	358, 359, 360, 361, 362, 363, 364, 365, 602, 0,
+	0, 0, 0, 0, 0, 598, 599, 633, 620, 621,
+	622, 623, 619, 607, 0, 600, 0, 0, 608, 0,
+	0, 0, 0, 99, 100, 101, 102, 103, 104, 105,
+	106, 895, 107, 108, 109, 0, 0, 0, 0, 613,
+	0, 0, 110, 111, 0, 112, 113, 496, 114, 115,
+	116, 366, 665, 368, 497, 666, 0, 667, 0, 117,
+	118, 119, 120, 121, 122, 123, 630, 653, 426, 124,
+	668, 669, 125, 0, 126, 127, 128, 129, 661, 0,
+	641, 0, 130, 131, 132, 133, 134, 0, 499, 135,
+	136, 137, 0, 138, 139, 140, 141, 142, 143, 0,
+	500, 144, 145, 146, 651, 642, 647, 652, 643, 644,
+	648, 147, 148, 149, 150, 151, 670, 152, 153, 671,
+	672, 154, 0, 155, 0, 156, 157, 158, 159, 160,
+	0, 161, 162, 163, 0, 0, 164, 165, 664, 167,
+	168, 0, 169, 170, 171, 0, 172, 173, 174, 0,
+	175, 176, 177, 178, 612, 179, 180, 181, 654, 628,
+	182, 0, 183, 184, 673, 185, 0, 186, 0, 187,
+	502, 0, 503, 188, 189, 190, 0, 191, 192, 662,
+	0, 616, 193, 0, 194, 195, 196, 197, 198, 504,
+	199, 200, 201, 202, 0, 203, 204, 205, 206, 207,
+	208, 0, 209, 505, 382, 210, 211, 212, 213, 674,
+	675, 0, 640, 0, 214, 506, 215, 507, 216, 217,
+	218, 219, 220, 0, 0, 221, 663, 508, 222, 509,
+	0, 223, 224, 427, 645, 646, 225, 226, 227, 228,
+	229, 230, 231, 232, 233, 234, 235, 236, 237, 238,
+	428, 387, 510, 388, 239, 240, 389, 601, 241, 242,
+	243, 629, 660, 244, 676, 245, 246, 247, 0, 248,
+	0, 0, 249, 250, 0, 0, 251, 392, 511, 252,
+	512, 655, 253, 254, 255, 256, 257, 258, 259, 0,
+	260, 261, 656, 262, 395, 265, 263, 264, 0, 266,
+	267, 268, 269, 270, 271, 272, 273, 677, 274, 275,
+	276, 277, 0, 278, 279, 280, 281, 282, 283, 284,
+	285, 286, 287, 288, 0, 289, 290, 513, 291, 292,
+	293, 617, 294, 295, 296, 297, 298, 299, 300, 301,
+	0, 302, 303, 398, 304, 305, 429, 649, 306, 307,
+	399, 308, 309, 514, 310, 311, 678, 401, 312, 0,
+	313, 314, 315, 316, 317, 318, 319, 320, 321, 322,
+	323, 657, 0, 324, 325, 0, 326, 515, 327, 328,
+	329, 330, 331, 0, 679, 680, 0, 0, 430, 332,
+	658, 333, 659, 627, 334, 335, 336, 337, 338, 339,
+	340, 0, 604, 341, 342, 343, 344, 345, 346, 650,
+	0, 347, 348, 349, 350, 351, 406, 681, 0, 352,
+	516, 353, 354, 355, 356, 0, 0, 357, 0, 0,
+	358, 359, 360, 361, 362, 363, 364, 365, 602, 0,
+	0, 0, 0, 0, 0, 598, 599, 633, 620, 621,
+	622, 623, 619, 607, 0, 600, 0, 0, 608, 0,
+	0, 0, 0, 99, 100, 101, 102, 103, 104, 105,
+	106, 0, 107, 108, 109, 0, 0, 0, 0, 613,
+	0, 0, 110, 111, 0, 112, 113, 496, 114, 115,
+	116, 366, 665, 368, 497, 666, 0, 667, 0, 117,
+	118, 119, 120, 121, 122, 123, 630, 653, 426, 124,
+	668, 669, 125, 0, 126, 127, 128, 129, 661, 0,
+	641, 0, 130, 131, 132, 133, 134, 0, 499, 135,
+	136, 137, 0, 138, 139, 140, 141, 142, 143, 0,
+	500, 144, 145, 2140, 651, 642, 647, 652, 643, 644,
+	648, 147, 148, 149, 150, 151, 670, 152, 153, 671,
+	672, 154, 0, 155, 0, 156, 157, 158, 159, 160,
+	0, 161, 162, 163, 0, 0, 164, 165, 664, 167,
+	168, 0, 169, 170, 171, 0, 172, 173, 174, 0,
+	175, 176, 177, 178, 612, 179, 180, 181, 654, 628,
+	182, 0, 183, 184, 673, 185, 0, 186, 0, 187,
+	502, 0, 503, 188, 189, 190, 0, 191, 192, 662,
+	0, 616, 193, 0, 194, 195, 196, 197, 198, 504,
+	199, 200, 201, 202, 0, 203, 204, 205, 206, 207,
+	208, 0, 209, 505, 382, 210, 211, 212, 213, 674,
+	675, 0, 640, 0, 214, 506, 215, 507, 216, 217,
+	218, 219, 220, 0, 0, 221, 663, 508, 222, 509,
+	0, 223, 224, 427, 645, 646, 225, 226, 227, 228,
+	229, 230, 231, 232, 233, 234, 235, 236, 237, 238,
+	428, 387, 510, 388, 239, 240, 389, 601, 241, 242,
+	243, 629, 660, 244, 676, 245, 246, 247, 0, 248,
+	0, 0, 249, 250, 0, 0, 251, 392, 511, 252,
+	512, 655, 253, 254, 255, 256, 257, 258, 259, 0,
+	260, 261, 656, 262, 395, 265, 263, 264, 0, 266,
+	267, 268, 269, 270, 271, 272, 273, 677, 274, 275,
+	276, 277, 0, 278, 279, 280, 281, 282, 283, 284,
+	285, 286, 287, 288, 0, 289, 290, 513, 291, 292,
+	293, 617, 294, 295, 296, 297, 298, 299, 300, 301,
+	0, 302, 303, 398, 304, 305, 429, 649, 306, 307,
+	399, 308, 309, 514, 310, 311, 678, 401, 312, 0,
+	313, 314, 315, 316, 317, 318, 319, 320, 321, 322,
+	323, 657, 0, 324, 325, 0, 326, 515, 327, 328,
+	329, 330, 331, 0, 679, 680, 0, 0, 430, 332,
+	658, 333, 659, 627, 334, 335, 336, 337, 2139, 339,
+	340, 0, 604, 341, 342, 343, 344, 345, 346, 650,
+	0, 347, 348, 349, 350, 351, 406, 681, 0, 352,
+	516, 353, 354, 355, 356, 0, 0, 357, 0, 0,
+	358, 359, 360, 361, 362, 363, 364, 365, 602, 0,
+	0, 0, 0, 0, 0, 598, 599, 633, 620, 621,
+	622, 623, 619, 607, 0, 600, 0, 0, 608, 0,
+	0, 0, 0, 99, 100, 101, 102, 103, 104, 105,
+	106, 0, 107, 108, 109, 0, 0, 0, 0, 613,
+	0, 0, 110, 111, 0, 112, 113, 496, 114, 115,
+	116, 2138, 665, 368, 497, 666, 0, 667, 0, 117,
+	118, 119, 120, 121, 122, 123, 630, 653, 426, 124,
+	668, 669, 125, 0, 126, 127, 128, 129, 661, 0,
+	641, 0, 130, 131, 132, 133, 134, 0, 499, 135,
+	136, 137, 0, 138, 139, 140, 141, 142, 143, 0,
+	500, 144, 145, 2140, 651, 642, 647, 652, 643, 644,
+	648, 147, 148, 149, 150, 151, 670, 152, 153, 671,
+	672, 154, 0, 155, 0, 156, 157, 158, 159, 160,
+	0, 161, 162, 163, 0, 0, 164, 165, 664, 167,
+	168, 0, 169, 170, 171, 0, 172, 173, 174, 0,
+	175, 176, 177, 178, 612, 179, 180, 181, 654, 628,
+	182, 0, 183, 184, 673, 185, 0, 186, 0, 187,
+	502, 0, 503, 188, 189, 190, 0, 191, 192, 662,
+	0, 616, 193, 0, 194, 195, 196, 197, 198, 504,
+	199, 200, 201, 202, 0, 203, 204, 205, 206, 207,
+	208, 0, 209, 505, 382, 210, 211, 212, 213, 674,
+	675, 0, 640, 0, 214, 506, 215, 507, 216, 217,
+	218, 219, 220, 0, 0, 221, 663, 508, 222, 509,
+	0, 223, 224, 427, 645, 646, 225, 226, 227, 228,
+	229, 230, 231, 232, 233, 234, 235, 236, 237, 238,
+	428, 387, 510, 388, 239, 240, 389, 601, 241, 242,
+	243, 629, 660, 244, 676, 245, 246, 247, 0, 248,
+	0, 0, 249, 250, 0, 0, 251, 392, 511, 252,
+	512, 655, 253, 254, 255, 256, 257, 258, 259, 0,
+	260, 261, 656, 262, 395, 265, 263, 264, 0, 266,
+	267, 268, 269, 270, 271, 272, 273, 677, 274, 275,
+	276, 277, 0, 278, 279, 280, 281, 282, 283, 284,
+	285, 286, 287, 288, 0, 289, 290, 513, 291, 292,
+	293, 617, 294, 295, 296, 297, 298, 299, 300, 301,
+	0, 302, 303, 398, 304, 305, 429, 649, 306, 307,
+	399, 308, 309, 514, 310, 311, 678, 401, 312, 0,
+	313, 314, 315, 316, 317, 318, 319, 320, 321, 322,
+	323, 657, 0, 324, 325, 0, 326, 515, 327, 328,
+	329, 330, 331, 0, 679, 680, 0, 0, 430, 332,
+	658, 333, 659, 627, 334, 335, 336, 337, 2139, 339,
+	340, 0, 604, 341, 342, 343, 344, 345, 346, 650,
+	0, 347, 348, 349, 350, 351, 406, 681, 0, 352,
+	516, 353, 354, 355, 356, 0, 0, 357, 0, 0,
+	358, 359, 360, 361, 362, 363, 364, 365, 602, 0,
+	0, 0, 0, 0, 0, 598, 599, 633, 620, 621,
+	622, 623, 619, 607, 0, 600, 0, 0, 608, 0,
+	0, 0, 0, 99, 100, 101, 102, 103, 104, 105,
+	106, 0, 107, 108, 109, 0, 0, 0, 0, 613,
+	0, 0, 110, 111, 0, 112, 113, 496, 114, 115,
+	116, 366, 665, 368, 497, 666, 0, 667, 0, 117,
+	118, 119, 120, 121, 122, 123, 630, 653, 426, 124,
+	668, 669, 125, 0, 126, 127, 128, 129, 661, 0,
+	641, 0, 130, 131, 132, 133, 134, 0, 499, 135,
+	136, 137, 0, 138, 139, 140, 141, 142, 143, 0,
+	500, 144, 145, 146, 651, 642, 647, 652, 643, 644,
+	648, 147, 148, 149, 150, 151, 670, 152, 153, 671,
+	672, 154, 0, 155, 0, 156, 157, 158, 159, 160,
+	0, 161, 162, 163, 0, 0, 164, 165, 664, 167,
+	168, 0, 169, 170, 171, 0, 172, 173, 174, 0,
+	175, 176, 177, 178, 612, 179, 180, 181, 654, 628,
+	182, 0, 183, 184, 673, 185, 0, 186, 0, 187,
+	502, 0, 503, 188, 189, 190, 0, 191, 192, 662,
+	0, 616, 193, 0, 194, 195, 196, 197, 198, 504,
+	199, 200, 201, 202, 0, 203, 204, 205, 206, 207,
+	208, 0, 209, 505, 382, 210, 211, 212, 213, 674,
+	675, 0, 640, 0, 214, 506, 215, 507, 216, 217,
+	218, 219, 220, 0, 0, 221, 663, 508, 222, 509,
+	0, 223, 224, 427, 645, 646, 225, 226, 227, 228,
+	229, 230, 231, 232, 233, 234, 235, 236, 237, 238,
+	428, 387, 510, 388, 239, 240, 389, 601, 241, 242,
+	243, 629, 660, 244, 676, 245, 246, 247, 0, 248,
+	0, 0, 249, 250, 0, 0, 251, 392, 511, 252,
+	512, 655, 253, 254, 255, 256, 257, 258, 259, 0,
+	260, 261, 656, 262, 395, 265, 263, 264, 0, 266,
+	267, 268, 269, 270, 271, 272, 273, 677, 274, 275,
+	276, 277, 0, 278, 279, 280, 281, 282, 283, 284,
+	285, 286, 287, 288, 0, 289, 290, 513, 291, 292,
+	293, 617, 294, 295, 296, 297, 298, 299, 300, 301,
+	0, 302, 303, 398, 304, 305, 429, 649, 306, 307,
+	399, 308, 309, 514, 310, 311, 678, 401, 312, 0,
+	313, 314, 315, 316, 317, 318, 319, 320, 321, 322,
+	323, 657, 0, 324, 325, 0, 326, 515, 327, 328,
+	329, 330, 331, 0, 679, 680, 0, 0, 430, 332,
+	658, 333, 659, 627, 334, 335, 336, 337, 338, 339,
+	340, 0, 604, 341, 342, 343, 344, 345, 346, 650,
+	0, 347, 348, 349, 350, 351, 406, 681, 0, 352,
+	516, 353, 354, 355, 356, 0, 0, 357, 0, 0,
+	358, 359, 360, 361, 362, 363, 364, 365, 602, 0,
+	0, 0, 0, 0, 0, 598, 599, 633, 620, 621,
+	622, 623, 619, 607, 0, 600, 0, 0, 608, 0,
+	0, 0, 0, 99, 100, 101, 102, 103, 104, 105,
+	106, 0, 107, 108, 109, 0, 0, 0, 0, 613,
+	0, 0, 110, 111, 0, 112, 113, 496, 114, 115,
+	116, 366, 665, 368, 497, 666, 0, 667, 0, 117,
+	118, 119, 120, 121, 122, 123, 630, 653, 426, 124,
+	668, 669, 125, 0, 126, 127, 128, 129, 661, 0,
+	641, 0, 130, 131, 132, 133, 134, 0, 499, 135,
+	136, 137, 0, 138, 139, 140, 141, 142, 143, 0,
+	500, 144, 145, 146, 651, 642, 647, 652, 643, 644,
+	648, 147, 148, 149, 150, 151, 670, 152, 153, 671,
+	672, 154, 0, 155, 0, 156, 157, 158, 159, 160,
+	0, 161, 162, 163, 0, 0, 164, 165, 664, 167,
+	168, 0, 169, 170, 171, 0, 172, 173, 174, 0,
+	175, 176, 177, 178, 612, 179, 180, 181, 654, 628,
+	182, 0, 183, 184, 673, 185, 0, 186, 0, 187,
+	502, 0, 503, 188, 189, 190, 0, 191, 192, 662,
+	0, 616, 193, 0, 194, 195, 196, 197, 198, 504,
+	199, 200, 201, 202, 0, 203, 204, 205, 206, 207,
+	208, 0, 209, 505, 382, 210, 211, 212, 213, 674,
+	675, 0, 640, 0, 214, 506, 215, 507, 216, 217,
+	218, 219, 220, 0, 0, 221, 663, 508, 222, 509,
+	0, 223, 224, 427, 645, 646, 225, 226, 227, 228,
+	229, 230, 231, 232, 233, 234, 235, 236, 237, 238,
+	428, 387, 510, 388, 239, 240, 389, 601, 241, 242,
+	243, 629, 660, 244, 676, 245, 246, 247, 0, 248,
+	0, 0, 249, 250, 0, 0, 251, 392, 511, 252,
+	512, 655, 253, 254, 255, 256, 257, 258, 259, 0,
+	260, 261, 656, 262, 395, 265, 263, 264, 0, 266,
+	267, 268, 269, 270, 271, 272, 273, 677, 274, 275,
+	276, 277, 0, 278, 279, 280, 281, 282, 283, 284,
+	285, 286, 287, 288, 0, 289, 290, 513, 291, 292,
+	293, 617, 294, 295, 296, 297, 298, 299, 300, 301,
+	0, 302, 303, 398, 304, 305, 429, 649, 306, 307,
+	399, 308, 309, 514, 310, 311, 678, 401, 312, 0,
+	313, 314, 315, 316, 317, 318, 319, 320, 321, 322,
+	323, 657, 0, 324, 325, 0, 326, 515, 327, 328,
+	329, 330, 331, 0, 679, 680, 0, 0, 430, 332,
+	658, 333, 659, 627, 334, 335, 336, 337, 338, 339,
+	340, 0, 604, 341, 342, 343, 344, 345, 346, 650,
+	0, 347, 348, 349, 350, 351, 406, 681, 0, 352,
+	516, 353, 354, 355, 356, 0, 0, 357, 0, 0,
+	358, 359, 360, 361, 362, 363, 364, 365, 602, 0,
+	0, 0, 0, 0, 0, 598, 599, 1288, 620, 1295,
+	622, 623, 619, 607, 0, 600, 0, 0, 1869, 0,
+	0, 0, 0, 99, 100, 101, 102, 103, 104, 105,
+	106, 0, 107, 108, 109, 0, 0, 0, 0, 613,
+	0, 0, 110, 111, 0, 112, 113, 496, 114, 115,
+	116, 366, 665, 368, 497, 666, 0, 667, 0, 117,
+	118, 119, 120, 121, 122, 123, 630, 653, 426, 124,
+	668, 669, 125, 0, 126, 127, 128, 129, 661, 0,
+	641, 0, 130, 131, 132, 133, 134, 0, 499, 135,
+	136, 137, 0, 138, 139, 140, 141, 142, 143, 0,
+	500, 144, 145, 146, 651, 642, 647, 652, 643, 644,
+	648, 147, 148, 149, 150, 151, 670, 1291, 153, 671,
+	672, 154, 0, 155, 0, 156, 157, 158, 159, 160,
+	0, 161, 162, 163, 0, 0, 164, 165, 664, 167,
+	168, 0, 169, 170, 171, 0, 172, 173, 174, 0,
+	175, 176, 177, 178, 612, 179, 180, 181, 654, 628,
+	182, 0, 183, 184, 673, 185, 0, 186, 0, 187,
+	502, 0, 503, 188, 189, 190, 0, 191, 192, 662,
+	0, 616, 193, 0, 194, 195, 1292, 197, 198, 504,
+	199, 200, 201, 202, 0, 203, 204, 205, 206, 207,
+	208, 0, 209, 505, 382, 210, 211, 212, 213, 674,
+	675, 0, 640, 0, 214, 506, 215, 507, 216, 217,
+	218, 219, 220, 0, 0, 221, 663, 508, 222, 509,
+	0, 223, 224, 427, 645, 646, 225, 226, 227, 228,
+	229, 230, 231, 232, 1293, 234, 235, 1290, 237, 238,
+	428, 387, 510, 388, 239, 240, 389, 601, 241, 242,
+	243, 629, 660, 244, 676, 245, 246, 247, 0, 248,
+	0, 0, 249, 250, 0, 0, 251, 392, 511, 252,
+	512, 655, 253, 254, 255, 256, 257, 258, 259, 0,
+	260, 261, 656, 262, 395, 265, 263, 264, 0, 266,
+	267, 268, 269, 270, 271, 272, 273, 677, 274, 275,
+	276, 277, 0, 278, 279, 280, 281, 282, 283, 284,
+	285, 286, 287, 288, 0, 289, 290, 513, 291, 292,
+	293, 617, 294, 295, 296, 297, 298, 299, 1294, 301,
+	0, 302, 303, 398, 304, 305, 429, 649, 306, 307,
+	399, 308, 309, 514, 310, 311, 678, 401, 312, 0,
+	313, 314, 315, 316, 317, 318, 319, 320, 321, 322,
+	323, 657, 0, 324, 325, 0, 326, 515, 327, 328,
+	329, 330, 331, 0, 679, 680, 0, 0, 430, 332,
+	658, 333, 659, 627, 334, 335, 336, 337, 338, 339,
+	340, 0, 604, 341, 342, 343, 344, 345, 346, 650,
+	0, 347, 348, 349, 350, 351, 406, 681, 0, 352,
+	516, 353, 354, 355, 356, 0, 0, 357, 0, 0,
+	358, 359, 360, 361, 362, 1289, 364, 365, 602, 0,
+	0, 0, 0, 0, 0, 598, 599, 633, 620, 621,
+	622, 623, 619, 607, 0, 600, 0, 0, 608, 0,
+	0, 0, 0, 99, 100, 101, 102, 103, 104, 105,
+	106, 0, 107, 108, 109, 0, 0, 0, 0, 613,
+	0, 0, 110, 111, 0, 112, 113, 496, 114, 115,
+	116, 366, 665, 368, 497, 666, 0, 667, 0, 117,
+	118, 119, 120, 121, 122, 123, 630, 653, 426, 124,
+	668, 669, 125, 0, 126, 127, 128, 129, 661, 0,
+	641, 0, 130, 131, 132, 133, 134, 0, 499, 135,
+	136, 137, 0, 138, 139, 140, 141, 142, 143, 0,
+	500, 144, 145, 146, 651, 642, 647, 652, 643, 644,
+	648, 147, 148, 149, 150, 151, 670, 152, 153, 671,
+	672, 154, 0, 155, 0, 156, 157, 158, 159, 160,
+	0, 161, 162, 163, 0, 0, 164, 165, 664, 167,
+	168, 0, 169, 170, 171, 0, 172, 173, 174, 0,
+	175, 176, 177, 178, 612, 179, 180, 181, 654, 628,
+	182, 0, 183, 184, 673, 185, 0, 186, 0, 187,
+	502, 0, 503, 188, 189, 190, 0, 191, 192, 662,
+	0, 616, 193, 0, 194, 195, 196, 197, 198, 504,
+	199, 200, 201, 202, 0, 203, 204, 205, 206, 207,
+	208, 0, 209, 505, 382, 210, 211, 212, 213, 674,
+	675, 0, 640, 0, 214, 506, 215, 507, 216, 217,
+	218, 219, 220, 0, 0, 221, 663, 508, 222, 509,
+	0, 223, 224, 427, 645, 646, 225, 226, 227, 228,
+	229, 230, 231, 232, 233, 234, 235, 236, 237, 238,
+	428, 387, 510, 388, 239, 240, 389, 0, 241, 242,
+	243, 629, 660, 244, 676, 245, 246, 247, 0, 248,
+	0, 0, 249, 250, 0, 0, 251, 392, 511, 252,
+	512, 655, 253, 254, 255, 256, 257, 258, 259, 0,
+	260, 261, 656, 262, 395, 265, 263, 264, 0, 266,
+	267, 268, 269, 270, 271, 272, 273, 677, 274, 275,
+	276, 277, 0, 278, 279, 280, 281, 282, 283, 284,
+	285, 286, 287, 288, 0, 289, 290, 513, 291, 292,
+	293, 1305, 294, 295, 296, 297, 298, 299, 300, 301,
+	0, 302, 303, 398, 304, 305, 429, 649, 306, 307,
+	399, 308, 309, 514, 310, 311, 678, 401, 312, 0,
+	313, 314, 315, 316, 317, 318, 319, 320, 321, 322,
+	323, 657, 0, 324, 325, 0, 326, 515, 327, 328,
+	329, 330, 331, 0, 679, 680, 0, 0, 430, 332,
+	658, 333, 659, 627, 334, 335, 336, 337, 338, 339,
+	340, 0, 0, 341, 342, 343, 344, 345, 346, 650,
+	0, 347, 348, 349, 350, 351, 406, 681, 0, 352,
+	516, 353, 354, 355, 356, 0, 0, 357, 0, 0,
+	358, 359, 360, 361, 362, 363, 364, 365, 0, 0,
+	0, 0, 0, 0, 0, 1301, 1302, 633, 620, 621,
+	622, 623, 619, 607, 0, 1303, 0, 0, 1304, 0,
+	0, 0, 0, 99, 100, 101, 102, 103, 104, 105,
+	106, 0, 107, 108, 109, 0, 0, 0, 0, 613,
+	0, 0, 110, 111, 0, 112, 113, 496, 114, 115,
+	116, 0, 665, 368, 497, 666, 0, 667, 0, 117,
+	118, 119, 120, 121, 122, 123, 630, 653, 426, 124,
+	668, 669, 125, 0, 126, 127, 128, 129, 661, 0,
+	641, 0, 130, 131, 132, 133, 134, 0, 499, 135,
+	136, 137, 0, 138, 139, 140, 141, 142, 143, 0,
+	500, 144, 145, 2140, 651, 642, 647, 652, 643, 644,
+	648, 147, 148, 149, 150, 151, 670, 152, 153, 671,
+	672, 154, 0, 155, 0, 156, 157, 158, 159, 160,
+	0, 161, 162, 163, 0, 0, 164, 165, 664, 167,
+	168, 0, 169, 170, 171, 0, 172, 173, 174, 0,
+	175, 176, 177, 178, 612, 179, 180, 181, 654, 628,
+	182, 0, 183, 184, 673, 185, 0, 186, 0, 187,
+	502, 0, 503, 188, 189, 190, 0, 191, 192, 662,
+	0, 616, 193, 0, 194, 195, 196, 197, 198, 0,
+	199, 200, 201, 202, 0, 203, 204, 205, 206, 207,
+	208, 0, 209, 505, 382, 210, 211, 212, 213, 674,
+	675, 0, 640, 0, 214, 0, 215, 507, 216, 217,
+	218, 219, 220, 0, 0, 221, 663, 508, 222, 0,
+	0, 223, 224, 427, 645, 646, 225, 226, 227, 228,
+	229, 230, 231, 232, 233, 234, 235, 236, 237, 238,
+	428, 387, 510, 388, 239, 240, 389, 601, 241, 242,
+	243, 629, 660, 244, 676, 245, 246, 247, 0, 248,
+	0, 0, 249, 250, 0, 0, 251, 392, 511, 252,
+	512, 655, 253, 254, 255, 256, 257, 258, 259, 0,
+	260, 261, 656, 262, 395, 265, 263, 264, 0, 266,
+	267, 268, 269, 270, 271, 272, 273, 677, 274, 275,
+	276, 277, 0, 278, 279, 280, 281, 282, 283, 284,
+	285, 286, 287, 288, 0, 289, 290, 513, 291, 292,
+	293, 617, 294, 295, 296, 297, 298, 299, 300, 301,
+	0, 302, 303, 398, 304, 305, 429, 649, 306, 307,
+	399, 308, 309, 0, 310, 311, 678, 401, 312, 0,
+	313, 314, 315, 316, 317, 318, 319, 320, 321, 322,
+	323, 657, 0, 324, 325, 0, 326, 515, 327, 328,
+	329, 330, 331, 0, 679, 680, 0, 0, 430, 332,
+	658, 333, 659, 627, 334, 335, 336, 337, 2139, 339,
+	340, 0, 604, 341, 342, 343, 344, 345, 346, 650,
+	0, 347, 348, 349, 350, 351, 406, 681, 0, 352,
+	516, 353, 354, 355, 356, 0, 0, 357, 0, 0,
+	358, 359, 360, 361, 362, 363, 364, 365, 0, 0,
+	0, 0, 0, 633, 0, 598, 599, 0, 0, 0,
+	0, 0, 0, 0, 0, 600, 0, 0, 608, 99,
+	100, 101, 102, 103, 104, 105, 106, 0, 107, 108,
+	109, 0, 0, 0, 0, 0, 0, 0, 110, 111,
+	0, 112, 113, 496, 114, 115, 116, 366, 367, 368,
+	497, 369, 0, 370, 0, 117, 118, 119, 120, 121,
+	122, 123, 0, 653, 426, 124, 371, 372, 125, 0,
+	126, 127, 128, 129, 661, 0, 641, 0, 130, 131,
+	132, 133, 134, 0, 499, 135, 136, 137, 0, 138,
+	139, 140, 141, 142, 143, 0, 500, 144, 145, 146,
+	651, 642, 647, 652, 643, 644, 648, 147, 148, 149,
+	150, 151, 374, 152, 153, 375, 376, 154, 0, 155,
+	0, 156, 157, 158, 159, 160, 0, 161, 162, 163,
+	0, 0, 164, 165, 166, 167, 168, 0, 169, 170,
+	171, 0, 172, 173, 174, 0, 175, 176, 177, 178,
+	377, 179, 180, 181, 654, 0, 182, 0, 183, 184,
+	379, 185, 0, 186, 0, 187, 502, 0, 503, 188,
+	189, 190, 0, 191, 192, 662, 0, 381, 193, 0,
+	194, 195, 196, 197, 198, 504, 199, 200, 201, 202,
+	0, 203, 204, 205, 206, 207, 208, 0, 209, 505,
+	382, 210, 211, 212, 213, 383, 384, 0, 385, 0,
+	214, 506, 215, 507, 216, 217, 218, 219, 220, 1146,
+	0, 221, 663, 508, 222, 509, 0, 223, 224, 427,
+	645, 646, 225, 226, 227, 228, 229, 230, 231, 232,
+	233, 234, 235, 236, 237, 238, 428, 387, 510, 388,
+	239, 240, 389, 0, 241, 242, 243, 0, 660, 244,
+	391, 245, 246, 247, 0, 248, 0, 470, 249, 250,
+	0, 0, 251, 392, 511, 252, 512, 655, 253, 254,
+	255, 256, 257, 258, 259, 0, 260, 261, 656, 262,
+	395, 265, 263, 264, 0, 266, 267, 268, 269, 270,
+	271, 272, 273, 396, 274, 275, 276, 277, 0, 278,
+	279, 280, 281, 282, 283, 284, 285, 286, 287, 288,
+	0, 289, 290, 513, 291, 292, 293, 397, 1151, 295,
+	296, 297, 298, 299, 300, 301, 53, 302, 303, 398,
+	304, 305, 429, 649, 306, 307, 399, 308, 309, 514,
+	310, 311, 400, 401, 312, 0, 313, 314, 315, 316,
+	317, 318, 319, 320, 321, 322, 323, 657, 0, 324,
+	325, 55, 326, 515, 327, 328, 329, 330, 331, 0,
+	431, 403, 0, 0, 430, 332, 658, 333, 659, 0,
+	334, 335, 336, 337, 338, 339, 340, 0, 0, 341,
+	342, 343, 344, 345, 346, 650, 0, 347, 348, 349,
+	350, 351, 495, 407, 0, 352, 516, 353, 354, 355,
+	356, 633, 0, 357, 0, 51, 358, 359, 360, 361,
+	362, 363, 364, 365, 0, 0, 52, 99, 100, 101,
 	102, 103, 104, 105, 106, 0, 107, 108, 109, 0,
-	0, 0, 0, 0, 0, 0, 110, 111, 0, 112,
-	113, 490, 114, 115, 116, 363, 364, 491, 365, 0,
-	366, 0, 117, 118, 119, 120, 121, 0, 0, 420,
-	122, 367, 368, 123, 0, 124, 125, 126, 127, 369,
-	0, 492, 0, 128, 129, 130, 131, 132, 0, 493,
-	133, 134, 135, 0, 136, 137, 138, 139, 140, 141,
-	0, 494, 142, 143, 144, 0, 0, 0, 495, 0,
-	0, 0, 145, 146, 147, 148, 149, 370, 150, 151,
-	371, 372, 152, 770, 153, 0, 154, 155, 156, 157,
-	158, 0, 159, 160, 161, 0, 0, 162, 163, 164,
-	165, 166, 0, 167, 168, 169, 0, 170, 171, 172,
-	0, 173, 174, 175, 176, 373, 177, 178, 179, 374,
-	718, 180, 0, 181, 182, 375, 183, 0, 184, 0,
-	185, 496, 0, 497, 186, 187, 188, 0, 189, 190,
-	376, 0, 377, 191, 0, 192, 193, 194, 195, 196,
-	197, 198, 199, 200, 0, 201, 202, 203, 204, 205,
-	206, 0, 207, 498, 378, 208, 209, 210, 211, 379,
-	380, 0, 381, 0, 212, 499, 213, 500, 214, 215,
-	216, 217, 218, 0, 0, 219, 382, 501, 220, 502,
-	0, 221, 222, 421, 0, 0, 223, 224, 225, 226,
+	0, 0, 0, 0, 1149, 0, 110, 111, 0, 112,
+	113, 496, 114, 115, 116, 366, 367, 368, 497, 369,
+	0, 370, 0, 117, 118, 119, 120, 121, 122, 123,
+	0, 653, 426, 124, 371, 372, 125, 0, 126, 127,
+	128, 129, 661, 0, 641, 0, 130, 131, 132, 133,
+	134, 0, 499, 135, 136, 137, 0, 138, 139, 140,
+	141, 142, 143, 0, 500, 144, 145, 146, 651, 642,
+	647, 652, 643, 644, 648, 147, 148, 149, 150, 151,
+	374, 152, 153, 375, 376, 154, 0, 155, 0, 156,
+	157, 158, 159, 160, 0, 161, 162, 163, 0, 0,
+	164, 165, 166, 167, 168, 0, 169, 170, 171, 0,
+	172, 173, 174, 0, 175, 176, 177, 178, 377, 179,
+	180, 181, 654, 0, 182, 0, 183, 184, 379, 185,
+	0, 186, 0, 187, 502, 0, 503, 188, 189, 190,
+	0, 191, 192, 662, 0, 381, 193, 0, 194, 195,
+	196, 197, 198, 504, 199, 200, 201, 202, 0, 203,
+	204, 205, 206, 207, 208, 0, 209, 505, 382, 210,
+	211, 212, 213, 383, 384, 0, 385, 0, 214, 506,
+	215, 507, 216, 217, 218, 219, 220, 1146, 0, 221,
+	663, 508, 222, 509, 0, 223, 224, 427, 645, 646,
+	225, 226, 227, 228, 229, 230, 231, 232, 233, 234,
+	235, 236, 237, 238, 428, 387, 510, 388, 239, 240,
+	389, 0, 241, 242, 243, 0, 660, 244, 391, 245,
+	246, 247, 0, 248, 0, 470, 249, 250, 0, 0,
+	251, 392, 511, 252, 512, 655, 253, 254, 255, 256,
+	257, 258, 259, 0, 260, 261, 656, 262, 395, 265,
+	263, 264, 0, 266, 267, 268, 269, 270, 271, 272,
+	273, 396, 274, 275, 276, 277, 0, 278, 279, 280,
+	281, 282, 283, 284, 285, 286, 287, 288, 0, 289,
+	290, 513, 291, 292, 293, 397, 1151, 295, 296, 297,
+	298, 299, 300, 301, 0, 302, 303, 398, 304, 305,
+	429, 649, 306, 307, 399, 308, 309, 514, 310, 311,
+	400, 401, 312, 0, 313, 314, 315, 316, 317, 318,
+	319, 320, 321, 322, 323, 657, 0, 324, 325, 0,
+	326, 515, 327, 328, 329, 330, 331, 0, 431, 403,
+	0, 0, 430, 332, 658, 333, 659, 0, 334, 335,
+	336, 337, 338, 339, 340, 0, 0, 341, 342, 343,
+	344, 345, 346, 650, 0, 347, 348, 349, 350, 351,
+	406, 407, 0, 352, 516, 353, 354, 355, 356, 633,
+	0, 357, 0, 0, 358, 359, 360, 361, 362, 363,
+	364, 365, 0, 0, 0, 99, 100, 101, 102, 103,
+	104, 105, 106, 0, 107, 108, 109, 0, 0, 0,
+	0, 0, 1149, 0, 110, 111, 0, 112, 113, 496,
+	114, 115, 116, 366, 367, 368, 497, 369, 0, 370,
+	0, 117, 118, 119, 120, 121, 122, 123, 0, 653,
+	426, 124, 371, 372, 125, 0, 126, 127, 128, 129,
+	661, 0, 641, 0, 130, 131, 132, 133, 134, 0,
+	499, 135, 136, 137, 0, 138, 139, 140, 141, 142,
+	143, 0, 500, 144, 145, 146, 651, 642, 647, 652,
+	643, 644, 648, 147, 148, 149, 150, 151, 374, 152,
+	153, 375, 376, 154, 0, 155, 0, 156, 157, 158,
+	159, 160, 0, 161, 162, 163, 0, 0, 164, 165,
+	166, 167, 168, 0, 169, 170, 171, 0, 172, 173,
+	174, 0, 175, 176, 177, 178, 377, 179, 180, 181,
+	654, 0, 182, 0, 183, 184, 379, 185, 0, 186,
+	0, 187, 502, 0, 503, 188, 189, 190, 0, 191,
+	192, 662, 0, 381, 193, 0, 194, 195, 196, 197,
+	198, 504, 199, 200, 201, 202, 0, 203, 204, 205,
+	206, 207, 208, 0, 209, 505, 382, 210, 211, 212,
+	213, 383, 384, 0, 385, 0, 214, 506, 215, 507,
+	216, 217, 218, 219, 220, 0, 0, 221, 663, 508,
+	222, 509, 0, 223, 224, 427, 645, 646, 225, 226,
 	227, 228, 229, 230, 231, 232, 233, 234, 235, 236,
-	422, 383, 503, 384, 237, 238, 385, 0, 239, 240,
-	241, 0, 386, 242, 387, 243, 244, 245, 0, 246,
-	719, 0, 247, 248, 0, 0, 249, 388, 504, 250,
-	505, 389, 251, 252, 253, 254, 255, 256, 257, 0,
-	258, 259, 390, 260, 391, 263, 261, 262, 0, 264,
-	265, 266, 267, 268, 269, 270, 271, 392, 272, 273,
-	274, 275, 0, 276, 277, 278, 279, 280, 281, 282,
-	283, 284, 285, 286, 0, 287, 288, 506, 289, 290,
-	291, 393, 292, 293, 294, 295, 296, 297, 298, 299,
-	0, 300, 301, 302, 303, 423, 0, 304, 305, 394,
-	306, 307, 507, 308, 309, 395, 310, 0, 311, 312,
-	313, 314, 315, 316, 317, 318, 319, 320, 321, 396,
-	0, 322, 323, 0, 324, 508, 325, 326, 327, 328,
-	329, 0, 425, 397, 0, 0, 424, 330, 398, 331,
-	399, 717, 332, 333, 334, 335, 336, 337, 338, 0,
-	0, 339, 340, 341, 342, 343, 0, 0, 344, 345,
-	346, 347, 348, 400, 401, 0, 349, 509, 350, 351,
-	352, 353, 0, 0, 354, 0, 0, 355, 356, 357,
-	358, 359, 360, 361, 362, 486, 721, 725, 0, 0,
-	726, 0, 727, 722, 0, 0, 0, 0, 99, 100,
-	101, 102, 103, 104, 105, 106, 0, 107, 108, 109,
-	0, 0, 0, 0, 0, 0, 0, 110, 111, 0,
-	112, 113, 490, 114, 115, 116, 363, 364, 491, 365,
-	0, 366, 0, 117, 118, 119, 120, 121, 0, 0,
-	420, 122, 367, 368, 123, 0, 124, 125, 126, 127,
-	369, 0, 492, 0, 128, 129, 130, 131, 132, 0,
-	493, 133, 134, 135, 0, 136, 137, 138, 139, 140,
-	141, 0, 494, 142, 143, 144, 0, 0, 0, 495,
-	0, 0, 0, 145, 146, 147, 148, 149, 370, 150,
-	151, 371, 372, 152, 765, 153, 0, 154, 155, 156,
-	157, 158, 0, 159, 160, 161, 0, 0, 162, 163,
-	164, 165, 166, 0, 167, 168, 169, 0, 170, 171,
-	172, 0, 173, 174, 175, 176, 373, 177, 178, 179,
-	374, 718, 180, 0, 181, 182, 375, 183, 0, 184,
-	0, 185, 496, 0, 497, 186, 187, 188, 0, 189,
-	190, 376, 0, 377, 191, 0, 192, 193, 194, 195,
-	196, 197, 198, 199, 200, 0, 201, 202, 203, 204,
-	205, 206, 0, 207, 498, 378, 208, 209, 210, 211,
-	379, 380, 0, 381, 0, 212, 499, 213, 500, 214,
-	215, 216, 217, 218, 0, 0, 219, 382, 501, 220,
-	502, 0, 221, 222, 421, 0, 0, 223, 224, 225,
-	226, 227, 228, 229, 230, 231, 232, 233, 234, 235,
-	236, 422, 383, 503, 384, 237, 238, 385, 0, 239,
-	240, 241, 0, 386, 242, 387, 243, 244, 245, 0,
-	246, 719, 0, 247, 248, 0, 0, 249, 388, 504,
-	250, 505, 389, 251, 252, 253, 254, 255, 256, 257,
-	0, 258, 259, 390, 260, 391, 263, 261, 262, 0,
-	264, 265, 266, 267, 268, 269, 270, 271, 392, 272,
-	273, 274, 275, 0, 276, 277, 278, 279, 280, 281,
-	282, 283, 284, 285, 286, 0, 287, 288, 506, 289,
-	290, 291, 393, 292, 293, 294, 295, 296, 297, 298,
-	299, 0, 300, 301, 302, 303, 423, 0, 304, 305,
-	394, 306, 307, 507, 308, 309, 395, 310, 0, 311,
-	312, 313, 314, 315, 316, 317, 318, 319, 320, 321,
-	396, 0, 322, 323, 0, 324, 508, 325, 326, 327,
-	328, 329, 0, 425, 397, 0, 0, 424, 330, 398,
-	331, 399, 717, 332, 333, 334, 335, 336, 337, 338,
-	0, 0, 339, 340, 341, 342, 343, 0, 0, 344,
-	345, 346, 347, 348, 400, 401, 0, 349, 509, 350,
-	351, 352, 353, 0, 0, 354, 0, 0, 355, 356,
-	357, 358, 359, 360, 361, 362, 486, 721, 725, 0,
-	0, 726, 0, 727, 722, 0, 0, 0, 0, 99,
+	237, 238, 428, 387, 510, 388, 239, 240, 389, 0,
+	241, 242, 243, 0, 660, 244, 391, 245, 246, 247,
+	0, 248, 0, 0, 249, 250, 0, 0, 251, 392,
+	511, 252, 512, 655, 253, 254, 255, 256, 257, 258,
+	259, 0, 260, 261, 656, 262, 395, 265, 263, 264,
+	0, 266, 267, 268, 269, 270, 271, 272, 273, 396,
+	274, 275, 276, 277, 0, 278, 279, 280, 281, 282,
+	283, 284, 285, 286, 287, 288, 0, 289, 290, 513,
+	291, 292, 293, 397, 1151, 295, 296, 297, 298, 299,
+	300, 301, 0, 302, 303, 398, 304, 305, 429, 649,
+	306, 307, 399, 308, 309, 514, 310, 311, 400, 401,
+	312, 0, 313, 314, 315, 316, 317, 318, 319, 320,
+	321, 322, 323, 657, 0, 324, 325, 0, 326, 515,
+	327, 328, 329, 330, 331, 0, 431, 403, 0, 0,
+	430, 332, 658, 333, 659, 0, 334, 335, 336, 337,
+	338, 339, 340, 0, 0, 341, 342, 343, 344, 345,
+	346, 650, 0, 347, 348, 349, 350, 351, 406, 407,
+	0, 352, 516, 353, 354, 355, 356, 491, 0, 357,
+	0, 0, 358, 359, 360, 361, 362, 363, 364, 365,
+	0, 0, 0, 99, 100, 101, 102, 103, 104, 105,
+	106, 0, 107, 108, 109, 0, 0, 0, 0, 0,
+	50, 0, 110, 111, 0, 112, 113, 496, 114, 115,
+	116, 366, 367, 368, 497, 369, 0, 370, 0, 117,
+	118, 119, 120, 121, 122, 123, 0, 0, 426, 124,
+	371, 372, 125, 0, 126, 127, 128, 129, 373, 0,
+	498, 0, 130, 131, 132, 133, 134, 0, 499, 135,
+	136, 137, 0, 138, 139, 140, 141, 142, 143, 0,
+	500, 144, 145, 146, 0, 0, 0, 501, 0, 0,
+	0, 147, 148, 149, 150, 151, 374, 152, 153, 375,
+	376, 154, 0, 155, 0, 156, 157, 158, 159, 160,
+	0, 161, 162, 163, 0, 0, 164, 165, 166, 167,
+	168, 0, 169, 170, 171, 0, 172, 173, 174, 0,
+	175, 176, 177, 178, 377, 179, 180, 181, 378, 0,
+	182, 0, 183, 184, 379, 185, 0, 186, 0, 187,
+	502, 0, 503, 188, 189, 190, 0, 191, 192, 380,
+	0, 381, 193, 0, 194, 195, 196, 197, 198, 504,
+	199, 200, 201, 202, 0, 203, 204, 205, 206, 207,
+	208, 0, 209, 505, 382, 210, 211, 212, 213, 383,
+	384, 0, 385, 0, 214, 506, 215, 507, 216, 217,
+	218, 219, 220, 0, 0, 221, 386, 508, 222, 509,
+	0, 223, 224, 427, 0, 0, 225, 226, 227, 228,
+	229, 230, 231, 232, 233, 234, 235, 236, 237, 238,
+	428, 387, 510, 388, 239, 240, 389, 0, 241, 242,
+	243, 0, 390, 244, 391, 245, 246, 247, 0, 248,
+	0, 0, 249, 250, 0, 0, 251, 392, 511, 252,
+	512, 393, 253, 254, 255, 256, 257, 258, 259, 0,
+	260, 261, 394, 262, 395, 265, 263, 264, 0, 266,
+	267, 268, 269, 270, 271, 272, 273, 396, 274, 275,
+	276, 277, 0, 278, 279, 280, 281, 282, 283, 284,
+	285, 286, 287, 288, 0, 289, 290, 513, 291, 292,
+	293, 397, 294, 295, 296, 297, 298, 299, 300, 301,
+	53, 302, 303, 398, 304, 305, 429, 0, 306, 307,
+	399, 308, 309, 514, 310, 311, 400, 401, 312, 0,
+	313, 314, 315, 316, 317, 318, 319, 320, 321, 322,
+	323, 402, 0, 324, 325, 55, 326, 515, 327, 328,
+	329, 330, 331, 0, 431, 403, 0, 0, 430, 332,
+	404, 333, 405, 0, 334, 335, 336, 337, 338, 339,
+	340, 0, 0, 341, 342, 343, 344, 345, 346, 0,
+	0, 347, 348, 349, 350, 351, 495, 407, 0, 352,
+	516, 353, 354, 355, 356, 0, 0, 357, 0, 51,
+	358, 359, 360, 361, 362, 363, 364, 365, 0, 0,
+	52, 0, 0, 491, 758, 762, 0, 0, 763, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 50, 99,
 	100, 101, 102, 103, 104, 105, 106, 0, 107, 108,
 	109, 0, 0, 0, 0, 0, 0, 0, 110, 111,
-	0, 112, 113, 490, 114, 115, 116, 363, 364, 491,
-	365, 0, 366, 0, 117, 118, 119, 120, 121, 0,
-	0, 420, 122, 367, 368, 123, 0, 124, 125, 126,
-	127, 369, 0, 492, 0, 128, 129, 130, 131, 132,
-	0, 493, 133, 134, 135, 0, 136, 137, 138, 139,
-	140, 141, 0, 494, 142, 143, 144, 0, 0, 0,
-	495, 0, 0, 0, 145, 146, 147, 148, 149, 370,
-	150, 151, 371, 372, 152, 0, 153, 0, 154, 155,
-	156, 157, 158, 0, 159, 160, 161, 0, 0, 162,
-	163, 164, 165, 166, 0, 167, 168, 169, 0, 170,
-	171, 172, 0, 173, 174, 175, 176, 373, 177, 178,
-	179, 374, 718, 180, 0, 181, 182, 375, 183, 0,
-	184, 0, 185, 496, 0, 497, 186, 187, 188, 0,
-	189, 190, 376, 0, 377, 191, 0, 192, 193, 194,
-	195, 196, 197, 198, 199, 200, 0, 201, 202, 203,
-	204, 205, 206, 0, 207, 498, 378, 208, 209, 210,
-	211, 379, 380, 0, 381, 0, 212, 499, 213, 500,
-	214, 215, 216, 217, 218, 0, 0, 219, 382, 501,
-	220, 502, 0, 221, 222, 421, 0, 0, 223, 224,
-	225, 226, 227, 228, 229, 230, 231, 232, 233, 234,
-	235, 236, 422, 383, 503, 384, 237, 238, 385, 0,
-	239, 240, 241, 0, 386, 242, 387, 243, 244, 245,
-	0, 246, 719, 0, 247, 248, 0, 0, 249, 388,
-	504, 250, 505, 389, 251, 252, 253, 254, 255, 256,
-	257, 0, 258, 259, 390, 260, 391, 263, 261, 262,
-	0, 264, 265, 266, 267, 268, 269, 270, 271, 392,
-	272, 273, 274, 275, 0, 276, 277, 278, 279, 280,
-	281, 282, 283, 284, 285, 286, 0, 287, 288, 506,
-	289, 290, 291, 393, 292, 293, 294, 295, 296, 297,
-	298, 299, 0, 300, 301, 302, 303, 423, 0, 304,
-	305, 394, 306, 307, 507, 308, 309, 395, 310, 0,
-	311, 312, 313, 314, 315, 316, 317, 318, 319, 320,
-	321, 396, 0, 322, 323, 0, 324, 508, 325, 326,
-	327, 328, 329, 0, 425, 397, 0, 0, 424, 330,
-	398, 331, 399, 717, 332, 333, 334, 335, 336, 337,
-	338, 0, 0, 339, 340, 341, 342, 343, 0, 0,
-	344, 345, 346, 347, 348, 400, 401, 0, 349, 509,
-	350, 351, 352, 353, 0, 0, 354, 0, 0, 355,
-	356, 357, 358, 359, 360, 361, 362, 96, 0, 0,
-	0, 0, 0, 0, 727, 722, 1417, 1418, 1419, 0,
+	0, 112, 113, 496, 114, 115, 116, 366, 367, 368,
+	497, 369, 0, 370, 0, 117, 118, 119, 120, 121,
+	122, 123, 0, 0, 426, 124, 371, 372, 125, 0,
+	126, 127, 128, 129, 373, 0, 498, 0, 130, 131,
+	132, 133, 134, 0, 499, 135, 136, 137, 0, 138,
+	139, 140, 141, 142, 143, 0, 500, 144, 145, 146,
+	0, 0, 0, 501, 0, 0, 0, 147, 148, 149,
+	150, 151, 374, 152, 153, 375, 376, 154, 766, 155,
+	0, 156, 157, 158, 159, 160, 0, 161, 162, 163,
+	0, 0, 164, 165, 166, 167, 168, 0, 169, 170,
+	171, 0, 172, 173, 174, 0, 175, 176, 177, 178,
+	377, 179, 180, 181, 378, 755, 182, 0, 183, 184,
+	379, 185, 0, 186, 0, 187, 502, 0, 503, 188,
+	189, 190, 0, 191, 192, 380, 0, 381, 193, 0,
+	194, 195, 196, 197, 198, 504, 199, 200, 201, 202,
+	0, 203, 204, 205, 206, 207, 208, 0, 209, 505,
+	382, 210, 211, 212, 213, 383, 384, 0, 385, 0,
+	214, 506, 215, 507, 216, 217, 218, 219, 220, 0,
+	0, 221, 386, 508, 222, 509, 0, 223, 224, 427,
+	0, 0, 225, 226, 227, 228, 229, 230, 231, 232,
+	233, 234, 235, 236, 237, 238, 428, 387, 510, 388,
+	239, 240, 389, 0, 241, 242, 243, 0, 390, 244,
+	391, 245, 246, 247, 0, 248, 756, 0, 249, 250,
+	0, 0, 251, 392, 511, 252, 512, 393, 253, 254,
+	255, 256, 257, 258, 259, 0, 260, 261, 394, 262,
+	395, 265, 263, 264, 0, 266, 267, 268, 269, 270,
+	271, 272, 273, 396, 274, 275, 276, 277, 0, 278,
+	279, 280, 281, 282, 283, 284, 285, 286, 287, 288,
+	0, 289, 290, 513, 291, 292, 293, 397, 294, 295,
+	296, 297, 298, 299, 300, 301, 0, 302, 303, 398,
+	304, 305, 429, 0, 306, 307, 399, 308, 309, 514,
+	310, 311, 400, 401, 312, 0, 313, 314, 315, 316,
+	317, 318, 319, 320, 321, 322, 323, 402, 0, 324,
+	325, 0, 326, 515, 327, 328, 329, 330, 331, 0,
+	431, 403, 0, 0, 430, 332, 404, 333, 405, 754,
+	334, 335, 336, 337, 338, 339, 340, 0, 0, 341,
+	342, 343, 344, 345, 346, 0, 0, 347, 348, 349,
+	350, 351, 406, 407, 0, 352, 516, 353, 354, 355,
+	356, 0, 0, 357, 0, 0, 358, 359, 360, 361,
+	362, 363, 364, 365, 491, 758, 762, 0, 0, 763,
+	0, 764, 759, 0, 0, 0, 0, 0, 0, 0,
 	99, 100, 101, 102, 103, 104, 105, 106, 0, 107,
 	108, 109, 0, 0, 0, 0, 0, 0, 0, 110,
-	111, 0, 112, 113, 0, 114, 115, 116, 363, 364,
-	0, 365, 0, 366, 0, 117, 118, 119, 120, 121,
-	0, 0, 420, 122, 367, 368, 123, 0, 124, 125,
-	126, 127, 369, 0, 0, 0, 128, 129, 130, 131,
-	132, 1416, 0, 133, 134, 135, 0, 136, 137, 138,
-	139, 140, 141, 0, 0, 142, 143, 144, 0, 0,
-	0, 0, 0, 0, 0, 145, 146, 147, 148, 149,
-	370, 150, 151, 371, 372, 152, 0, 153, 0, 154,
-	155, 156, 157, 158, 0, 159, 160, 161, 0, 0,
-	162, 163, 164, 165, 166, 0, 167, 168, 169, 0,
-	170, 171, 172, 0, 173, 174, 175, 176, 373, 177,
-	178, 179, 374, 0, 180, 0, 181, 182, 375, 183,
-	0, 184, 0, 185, 0, 0, 0, 186, 187, 188,
-	0, 189, 190, 376, 0, 377, 191, 0, 192, 193,
-	194, 195, 196, 197, 198, 199, 200, 0, 201, 202,
-	203, 204, 205, 206, 0, 207, 0, 378, 208, 209,
-	210, 211, 379, 380, 0, 381, 0, 212, 0, 213,
-	0, 214, 215, 216, 217, 218, 0, 0, 219, 382,
-	0, 220, 0, 0, 221, 222, 421, 0, 0, 223,
-	224, 225, 226, 227, 228, 229, 230, 231, 232, 233,
-	234, 235, 236, 422, 383, 0, 384, 237, 238, 385,
-	0, 239, 240, 241, 0, 386, 242, 387, 243, 244,
-	245, 0, 246, 0, 0, 247, 248, 0, 0, 249,
-	388, 0, 250, 0, 389, 251, 252, 253, 254, 255,
-	256, 257, 0, 258, 259, 390, 260, 391, 263, 261,
-	262, 0, 264, 265, 266, 267, 268, 269, 270, 271,
-	392, 272, 273, 274, 275, 0, 276, 277, 278, 279,
-	280, 281, 282, 283, 284, 285, 286, 0, 287, 288,
-	0, 289, 290, 291, 393, 292, 293, 294, 295, 296,
-	297, 298, 299, 0, 300, 301, 302, 303, 423, 0,
-	304, 305, 394, 306, 307, 0, 308, 309, 395, 310,
-	0, 311, 312, 313, 314, 315, 316, 317, 318, 319,
-	320, 321, 396, 0, 322, 323, 0, 324, 0, 325,
-	326, 327, 328, 329, 0, 425, 397, 0, 0, 424,
-	330, 398, 331, 399, 0, 332, 333, 334, 335, 336,
-	337, 338, 0, 0, 339, 340, 341, 342, 343, 0,
-	0, 344, 345, 346, 347, 348, 400, 401, 0, 349,
-	0, 350, 351, 352, 353, 0, 0, 354, 0, 0,
-	355, 356, 357, 358, 359, 360, 361, 362, 0, 0,
-	0, 1413, 1414, 1415, 630, 1404, 1405, 1406, 1407, 1408,
-	1409, 1410, 1411, 1412, 0, 0, 0, 99, 100, 101,
-	102, 103, 104, 105, 106, 0, 107, 108, 109, 0,
-	0, 0, 0, 0, 0, 0, 110, 111, 0, 112,
-	113, 490, 114, 115, 116, 363, 364, 491, 365, 0,
-	366, 0, 117, 118, 119, 120, 121, 0, 650, 420,
-	122, 367, 368, 123, 0, 124, 125, 126, 127, 658,
-	0, 638, 0, 128, 129, 130, 131, 132, 0, 493,
-	133, 134, 135, 0, 136, 137, 138, 139, 140, 141,
-	0, 494, 142, 143, 144, 648, 639, 644, 649, 640,
-	641, 645, 145, 146, 147, 148, 149, 370, 150, 151,
-	371, 372, 152, 0, 153, 0, 154, 155, 156, 157,
-	158, 0, 159, 160, 161, 0, 0, 162, 163, 164,
-	165, 166, 0, 167, 168, 169, 0, 170, 171, 172,
-	0, 173, 174, 175, 176, 373, 177, 178, 179, 651,
-	0, 180, 0, 181, 182, 375, 183, 0, 184, 0,
-	185, 496, 0, 497, 186, 187, 188, 0, 189, 190,
-	659, 0, 377, 191, 0, 192, 193, 194, 195, 196,
-	197, 198, 199, 200, 0, 201, 202, 203, 204, 205,
-	206, 0, 207, 498, 378, 208, 209, 210, 211, 379,
-	380, 0, 381, 0, 212, 499, 213, 500, 214, 215,
-	216, 217, 218, 0, 0, 219, 660, 501, 220, 502,
-	0, 221, 222, 421, 642, 643, 223, 224, 225, 226,
-	227, 228, 229, 230, 231, 232, 233, 234, 235, 236,
-	422, 383, 503, 384, 237, 238, 385, 0, 239, 240,
-	241, 0, 657, 242, 387, 243, 244, 245, 0, 246,
-	0, 0, 247, 248, 0, 0, 249, 388, 504, 250,
-	505, 652, 251, 252, 253, 254, 255, 256, 257, 0,
-	258, 259, 653, 260, 391, 263, 261, 262, 0, 264,
-	265, 266, 267, 268, 269, 270, 271, 392, 272, 273,
-	274, 275, 0, 276, 277, 278, 279, 280, 281, 282,
-	283, 284, 285, 286, 0, 287, 288, 506, 289, 290,
-	291, 393, 292, 293, 294, 295, 296, 297, 298, 299,
-	0, 300, 301, 302, 303, 423, 646, 304, 305, 394,
-	306, 307, 507, 308, 309, 395, 310, 0, 311, 312,
-	313, 314, 315, 316, 317, 318, 319, 320, 321, 654,
-	0, 322, 323, 0, 324, 508, 325, 326, 327, 328,
-	329, 0, 425, 397, 0, 0, 424, 330, 655, 331,
-	656, 0, 332, 333, 334, 335, 336, 337, 338, 0,
-	0, 339, 340, 341, 342, 343, 647, 0, 344, 345,
-	346, 347, 348, 400, 401, 0, 349, 509, 350, 351,
-	352, 353, 96, 0, 354, 0, 0, 355, 356, 357,
-	358, 359, 360, 361, 362, 99, 100, 101, 102, 103,
-	104, 105, 106, 0, 107, 108, 109, 0, 0, 0,
-	0, 0, 0, 0, 110, 111, 0, 112, 113, 0,
-	114, 115, 116, 363, 364, 0, 365, 0, 366, 0,
-	117, 118, 119, 120, 121, 0, 0, 420, 122, 367,
-	368, 123, 0, 124, 125, 126, 127, 369, 0, 0,
-	0, 128, 129, 130, 131, 132, 0, 0, 133, 134,
-	135, 0, 136, 137, 138, 139, 140, 141, 0, 0,
-	142, 143, 144, 0, 0, 0, 0, 0, 0, 0,
-	145, 146, 147, 148, 149, 370, 150, 151, 371, 372,
-	152, 0, 153, 0, 154, 155, 156, 157, 158, 0,
-	159, 160, 161, 0, 0, 162, 163, 164, 165, 166,
-	0, 167, 168, 169, 0, 170, 171, 172, 0, 173,
-	174, 175, 176, 373, 177, 178, 179, 374, 0, 180,
-	0, 181, 182, 375, 183, 0, 184, 0, 185, 0,
-	0, 0, 186, 187, 188, 0, 189, 190, 376, 0,
-	377, 191, 0, 192, 193, 194, 195, 196, 197, 198,
-	199, 200, 0, 201, 202, 203, 204, 205, 206, 0,
-	207, 0, 378, 208, 209, 210, 211, 379, 380, 0,
-	381, 0, 212, 0, 213, 0, 214, 215, 216, 217,
-	218, 0, 0, 219, 382, 0, 220, 0, 0, 221,
-	222, 421, 0, 0, 223, 224, 225, 226, 227, 228,
-	229, 230, 231, 232, 233, 234, 235, 236, 422, 383,
-	0, 384, 237, 238, 385, 0, 239, 240, 241, 0,
-	386, 242, 387, 243, 244, 245, 0, 246, 0, 0,
-	247, 248, 0, 0, 249, 388, 0, 250, 0, 389,
-	251, 252, 253, 254, 255, 256, 257, 0, 258, 259,
-	390, 260, 391, 263, 261, 262, 0, 264, 265, 266,
-	267, 268, 269, 270, 271, 392, 272, 273, 274, 275,
-	0, 276, 277, 278, 279, 280, 281, 282, 283, 284,
-	285, 286, 0, 287, 288, 0, 289, 290, 291, 393,
-	292, 293, 294, 295, 296, 297, 298, 299, 53, 300,
-	301, 302, 303, 423, 0, 304, 305, 394, 306, 307,
-	0, 308, 309, 395, 310, 0, 311, 312, 313, 314,
-	315, 316, 317, 318, 319, 320, 321, 396, 0, 322,
-	323, 55, 324, 0, 325, 326, 327, 328, 329, 0,
-	425, 397, 0, 0, 424, 330, 398, 331, 399, 0,
-	332, 333, 334, 335, 336, 337, 338, 0, 0, 339,
-	340, 341, 342, 343, 0, 0, 344, 345, 346, 347,
-	348, 485, 401, 0, 349, 0, 350, 351, 352, 353,
-	0, 0, 354, 0, 51, 355, 356, 357, 358, 359,
-	360, 361, 362, 0, 0, 52, 0, 0, 0, 0,
-	0, 96, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 50, 99, 100, 101, 102, 103, 104,
-	105, 106, 0, 107, 108, 109, 0, 0, 0, 0,
-	0, 1441, 0, 110, 111, 0, 112, 113, 0, 114,
-	115, 116, 363, 364, 0, 365, 0, 366, 0, 117,
-	118, 119, 120, 121, 0, 0, 420, 122, 367, 368,
-	123, 0, 124, 125, 126, 127, 369, 0, 0, 0,
-	128, 129, 130, 131, 132, 0, 0, 133, 134, 135,
-	0, 136, 137, 138, 139, 140, 141, 0, 0, 142,
-	143, 144, 0, 0, 0, 0, 0, 0, 0, 145,
-	146, 147, 148, 149, 370, 150, 151, 371, 372, 152,
-	0, 153, 0, 154, 155, 156, 157, 158, 0, 159,
-	160, 161, 0, 0, 162, 163, 164, 165, 166, 0,
-	167, 168, 169, 0, 170, 171, 172, 0, 173, 174,
-	175, 176, 373, 177, 178, 179, 374, 0, 180, 0,
-	181, 182, 375, 183, 0, 184, 0, 185, 0, 0,
-	0, 186, 187, 188, 0, 189, 190, 376, 0, 377,
-	191, 0, 192, 193, 194, 195, 196, 197, 198, 199,
-	200, 0, 201, 202, 203, 204, 205, 206, 0, 207,
-	0, 378, 208, 209, 210, 211, 379, 380, 0, 381,
-	0, 212, 0, 213, 0, 214, 215, 216, 217, 218,
-	0, 0, 219, 382, 0, 220, 0, 0, 221, 222,
-	421, 0, 0, 223, 224, 225, 226, 227, 228, 229,
-	230, 231, 232, 233, 234, 235, 236, 422, 383, 0,
-	384, 237, 238, 385, 0, 239, 240, 241, 0, 386,
-	242, 387, 243, 244, 245, 0, 246, 0, 0, 247,
-	248, 0, 0, 249, 388, 0, 250, 0, 389, 251,
-	252, 253, 254, 255, 256, 257, 0, 258, 259, 390,
-	260, 391, 263, 261, 262, 0, 264, 265, 266, 267,
-	268, 269, 270, 271, 392, 272, 273, 274, 275, 0,
-	276, 277, 278, 279, 280, 281, 282, 283, 284, 285,
-	286, 0, 287, 288, 0, 289, 290, 291, 393, 292,
-	293, 294, 295, 296, 297, 298, 299, 0, 300, 301,
-	302, 303, 423, 0, 304, 305, 394, 306, 307, 0,
-	308, 309, 395, 310, 0, 311, 312, 313, 314, 315,
-	316, 317, 318, 319, 320, 321, 396, 0, 322, 323,
-	0, 324, 0, 325, 326, 327, 328, 329, 0, 425,
-	397, 0, 0, 424, 330, 398, 331, 399, 0, 332,
-	333, 334, 335, 336, 337, 338, 0, 0, 339, 340,
-	341, 342, 343, 0, 0, 344, 345, 346, 347, 348,
-	400, 401, 0, 349, 0, 350, 351, 352, 353, 96,
-	0, 354, 0, 0, 355, 356, 357, 358, 359, 360,
-	361, 362, 99, 100, 101, 102, 103, 104, 105, 106,
+	111, 0, 112, 113, 496, 114, 115, 116, 366, 367,
+	368, 497, 369, 0, 370, 0, 117, 118, 119, 120,
+	121, 122, 123, 0, 0, 426, 124, 371, 372, 125,
+	0, 126, 127, 128, 129, 373, 0, 498, 0, 130,
+	131, 132, 133, 134, 0, 499, 135, 136, 137, 0,
+	138, 139, 140, 141, 142, 143, 0, 500, 144, 145,
+	146, 0, 0, 0, 501, 0, 0, 0, 147, 148,
+	149, 150, 151, 374, 152, 153, 375, 376, 154, 750,
+	155, 0, 156, 157, 158, 159, 160, 0, 161, 162,
+	163, 0, 0, 164, 165, 166, 167, 168, 0, 169,
+	170, 171, 0, 172, 173, 174, 0, 175, 176, 177,
+	178, 377, 179, 180, 181, 378, 755, 182, 0, 183,
+	184, 379, 185, 0, 186, 0, 187, 502, 0, 503,
+	188, 189, 190, 0, 191, 192, 380, 0, 381, 193,
+	0, 194, 195, 196, 197, 198, 504, 199, 200, 201,
+	202, 0, 203, 204, 205, 206, 207, 208, 0, 209,
+	505, 382, 210, 211, 212, 213, 383, 384, 0, 385,
+	0, 214, 506, 215, 507, 216, 217, 218, 219, 220,
+	0, 0, 221, 386, 508, 222, 509, 0, 223, 224,
+	427, 0, 0, 225, 226, 227, 228, 229, 230, 231,
+	232, 233, 234, 235, 236, 237, 238, 428, 387, 510,
+	388, 239, 240, 389, 0, 241, 242, 243, 0, 390,
+	244, 391, 245, 246, 247, 0, 248, 756, 0, 249,
+	250, 0, 0, 251, 392, 511, 252, 512, 393, 253,
+	254, 255, 256, 257, 258, 259, 0, 260, 261, 394,
+	262, 395, 265, 263, 264, 0, 266, 267, 268, 269,
+	270, 271, 272, 273, 396, 274, 275, 276, 277, 0,
+	278, 279, 280, 281, 282, 283, 284, 285, 286, 287,
+	288, 0, 289, 290, 513, 291, 292, 293, 397, 294,
+	295, 296, 297, 298, 299, 300, 301, 0, 302, 303,
+	398, 304, 305, 429, 0, 306, 307, 399, 308, 309,
+	514, 310, 311, 400, 401, 312, 0, 313, 314, 315,
+	316, 317, 318, 319, 320, 321, 322, 323, 402, 0,
+	324, 325, 0, 326, 515, 327, 328, 329, 330, 331,
+	0, 431, 403, 0, 0, 430, 332, 404, 333, 405,
+	754, 334, 335, 336, 337, 338, 339, 340, 0, 0,
+	341, 342, 343, 344, 345, 346, 0, 0, 347, 348,
+	349, 350, 351, 406, 407, 0, 352, 516, 353, 354,
+	355, 356, 0, 0, 357, 0, 0, 358, 359, 360,
+	361, 362, 363, 364, 365, 491, 758, 762, 0, 0,
+	763, 0, 764, 759, 0, 0, 0, 0, 0, 0,
+	0, 99, 100, 101, 102, 103, 104, 105, 106, 0,
+	107, 108, 109, 0, 0, 0, 0, 0, 0, 0,
+	110, 111, 0, 112, 113, 496, 114, 115, 116, 366,
+	367, 368, 497, 369, 0, 370, 0, 117, 118, 119,
+	120, 121, 122, 123, 0, 0, 426, 124, 371, 372,
+	125, 0, 126, 127, 128, 129, 373, 0, 498, 0,
+	130, 131, 132, 133, 134, 0, 499, 135, 136, 137,
+	0, 138, 139, 140, 141, 142, 143, 0, 500, 144,
+	145, 146, 0, 0, 0, 501, 0, 0, 0, 147,
+	148, 149, 150, 151, 374, 152, 153, 375, 376, 154,
+	0, 155, 0, 156, 157, 158, 159, 160, 0, 161,
+	162, 163, 0, 0, 164, 165, 166, 167, 168, 0,
+	169, 170, 171, 0, 172, 173, 174, 0, 175, 176,
+	177, 178, 377, 179, 180, 181, 378, 755, 182, 0,
+	183, 184, 379, 185, 0, 186, 0, 187, 502, 0,
+	503, 188, 189, 190, 0, 191, 192, 380, 0, 381,
+	193, 0, 194, 195, 196, 197, 198, 504, 199, 200,
+	201, 202, 0, 203, 204, 205, 206, 207, 208, 0,
+	209, 505, 382, 210, 211, 212, 213, 383, 384, 0,
+	385, 0, 214, 506, 215, 507, 216, 217, 218, 219,
+	220, 0, 0, 221, 386, 508, 222, 509, 0, 223,
+	224, 427, 0, 0, 225, 226, 227, 228, 229, 230,
+	231, 232, 233, 234, 235, 236, 237, 238, 428, 387,
+	510, 388, 239, 240, 389, 0, 241, 242, 243, 0,
+	390, 244, 391, 245, 246, 247, 0, 248, 756, 0,
+	249, 250, 0, 0, 251, 392, 511, 252, 512, 393,
+	253, 254, 255, 256, 257, 258, 259, 0, 260, 261,
+	394, 262, 395, 265, 263, 264, 0, 266, 267, 268,
+	269, 270, 271, 272, 273, 396, 274, 275, 276, 277,
+	0, 278, 279, 280, 281, 282, 283, 284, 285, 286,
+	287, 288, 0, 289, 290, 513, 291, 292, 293, 397,
+	294, 295, 296, 297, 298, 299, 300, 301, 0, 302,
+	303, 398, 304, 305, 429, 0, 306, 307, 399, 308,
+	309, 514, 310, 311, 400, 401, 312, 0, 313, 314,
+	315, 316, 317, 318, 319, 320, 321, 322, 323, 402,
+	0, 324, 325, 0, 326, 515, 327, 328, 329, 330,
+	331, 0, 431, 403, 0, 0, 430, 332, 404, 333,
+	405, 754, 334, 335, 336, 337, 338, 339, 340, 0,
+	0, 341, 342, 343, 344, 345, 346, 0, 0, 347,
+	348, 349, 350, 351, 406, 407, 0, 352, 516, 353,
+	354, 355, 356, 0, 0, 357, 0, 0, 358, 359,
+	360, 361, 362, 363, 364, 365, 96, 0, 0, 0,
+	0, 0, 0, 764, 759, 1432, 1433, 1434, 0, 0,
+	0, 0, 99, 100, 101, 102, 103, 104, 105, 106,
 	0, 107, 108, 109, 0, 0, 0, 0, 0, 0,
-	0, 110, 111, 584, 112, 113, 0, 114, 115, 116,
-	363, 364, 0, 365, 0, 366, 0, 117, 118, 119,
-	120, 121, 0, 0, 420, 122, 367, 368, 123, 0,
-	124, 125, 126, 127, 369, 0, 0, 0, 128, 129,
-	130, 131, 132, 0, 0, 133, 134, 135, 0, 136,
-	137, 138, 139, 140, 141, 0, 0, 142, 143, 144,
-	0, 0, 0, 0, 0, 0, 0, 145, 146, 147,
-	148, 149, 370, 150, 151, 371, 372, 152, 0, 153,
-	0, 154, 155, 156, 157, 158, 0, 159, 160, 161,
-	0, 0, 162, 163, 164, 165, 166, 0, 167, 168,
-	169, 0, 170, 171, 172, 0, 173, 174, 175, 176,
-	373, 177, 178, 179, 374, 0, 180, 0, 181, 182,
-	375, 183, 0, 184, 0, 185, 0, 0, 0, 186,
-	187, 188, 0, 189, 190, 376, 0, 377, 191, 0,
-	192, 193, 194, 195, 196, 197, 198, 199, 200, 0,
-	201, 202, 203, 204, 205, 206, 0, 207, 0, 378,
-	208, 209, 210, 211, 379, 380, 0, 381, 0, 212,
-	0, 213, 0, 214, 215, 216, 217, 218, 0, 0,
-	219, 382, 0, 220, 0, 0, 221, 222, 421, 0,
-	0, 223, 224, 225, 226, 227, 228, 229, 230, 231,
-	232, 233, 234, 235, 236, 422, 383, 0, 384, 237,
-	238, 385, 0, 239, 240, 241, 0, 386, 242, 387,
-	243, 244, 245, 0, 246, 0, 0, 247, 248, 0,
-	0, 249, 388, 0, 250, 0, 389, 251, 252, 253,
-	254, 255, 256, 257, 0, 258, 259, 390, 260, 391,
-	263, 261, 262, 0, 264, 265, 266, 267, 268, 269,
-	270, 271, 392, 272, 273, 274, 275, 0, 276, 277,
-	278, 279, 280, 281, 282, 283, 284, 285, 286, 0,
-	287, 288, 0, 289, 290, 291, 393, 292, 293, 294,
-	295, 296, 297, 298, 299, 0, 300, 301, 302, 303,
-	423, 0, 304, 305, 394, 306, 307, 0, 308, 309,
-	395, 310, 0, 311, 312, 313, 314, 315, 316, 317,
-	318, 319, 320, 321, 396, 0, 322, 323, 0, 324,
-	0, 325, 326, 327, 328, 329, 0, 425, 397, 0,
-	0, 424, 330, 398, 331, 399, 0, 332, 333, 334,
-	335, 336, 337, 338, 0, 0, 339, 340, 341, 342,
-	343, 0, 0, 344, 345, 346, 347, 348, 400, 401,
-	0, 349, 0, 350, 351, 352, 353, 0, 0, 354,
-	96, 0, 355, 356, 357, 358, 359, 360, 361, 362,
-	0, 0, 0, 99, 100, 101, 102, 103, 104, 105,
+	0, 110, 111, 0, 112, 113, 0, 114, 115, 116,
+	366, 367, 368, 0, 369, 0, 370, 0, 117, 118,
+	119, 120, 121, 122, 123, 0, 0, 426, 124, 371,
+	372, 125, 0, 126, 127, 128, 129, 373, 0, 0,
+	0, 130, 131, 132, 133, 134, 1431, 0, 135, 136,
+	137, 0, 138, 139, 140, 141, 142, 143, 0, 0,
+	144, 145, 146, 0, 0, 0, 0, 0, 0, 0,
+	147, 148, 149, 150, 151, 374, 152, 153, 375, 376,
+	154, 0, 155, 0, 156, 157, 158, 159, 160, 0,
+	161, 162, 163, 0, 0, 164, 165, 166, 167, 168,
+	0, 169, 170, 171, 0, 172, 173, 174, 0, 175,
+	176, 177, 178, 377, 179, 180, 181, 378, 0, 182,
+	0, 183, 184, 379, 185, 0, 186, 0, 187, 0,
+	0, 0, 188, 189, 190, 0, 191, 192, 380, 0,
+	381, 193, 0, 194, 195, 196, 197, 198, 0, 199,
+	200, 201, 202, 0, 203, 204, 205, 206, 207, 208,
+	0, 209, 0, 382, 210, 211, 212, 213, 383, 384,
+	0, 385, 0, 214, 0, 215, 0, 216, 217, 218,
+	219, 220, 0, 0, 221, 386, 0, 222, 0, 0,
+	223, 224, 427, 0, 0, 225, 226, 227, 228, 229,
+	230, 231, 232, 233, 234, 235, 236, 237, 238, 428,
+	387, 0, 388, 239, 240, 389, 0, 241, 242, 243,
+	0, 390, 244, 391, 245, 246, 247, 0, 248, 0,
+	0, 249, 250, 0, 0, 251, 392, 0, 252, 0,
+	393, 253, 254, 255, 256, 257, 258, 259, 0, 260,
+	261, 394, 262, 395, 265, 263, 264, 0, 266, 267,
+	268, 269, 270, 271, 272, 273, 396, 274, 275, 276,
+	277, 0, 278, 279, 280, 281, 282, 283, 284, 285,
+	286, 287, 288, 0, 289, 290, 0, 291, 292, 293,
+	397, 294, 295, 296, 297, 298, 299, 300, 301, 0,
+	302, 303, 398, 304, 305, 429, 0, 306, 307, 399,
+	308, 309, 0, 310, 311, 400, 401, 312, 0, 313,
+	314, 315, 316, 317, 318, 319, 320, 321, 322, 323,
+	402, 0, 324, 325, 0, 326, 0, 327, 328, 329,
+	330, 331, 0, 431, 403, 0, 0, 430, 332, 404,
+	333, 405, 0, 334, 335, 336, 337, 338, 339, 340,
+	0, 0, 341, 342, 343, 344, 345, 346, 0, 0,
+	347, 348, 349, 350, 351, 406, 407, 0, 352, 0,
+	353, 354, 355, 356, 0, 0, 357, 0, 0, 358,
+	359, 360, 361, 362, 363, 364, 365, 633, 0, 0,
+	1428, 1429, 1430, 0, 1419, 1420, 1421, 1422, 1423, 1424,
+	1425, 1426, 1427, 99, 100, 101, 102, 103, 104, 105,
 	106, 0, 107, 108, 109, 0, 0, 0, 0, 0,
-	1035, 0, 110, 111, 0, 112, 113, 0, 114, 115,
-	116, 363, 364, 0, 365, 0, 366, 0, 117, 118,
-	119, 120, 121, 0, 0, 420, 122, 367, 368, 123,
-	0, 124, 125, 126, 127, 369, 0, 0, 0, 128,
-	129, 130, 131, 132, 0, 0, 133, 134, 135, 0,
-	136, 137, 138, 139, 140, 141, 0, 0, 142, 143,
-	144, 0, 0, 0, 0, 0, 0, 0, 145, 146,
-	147, 148, 149, 370, 150, 151, 371, 372, 152, 0,
-	153, 0, 154, 155, 156, 157, 158, 0, 159, 160,
-	161, 0, 0, 162, 163, 164, 165, 166, 0, 167,
-	168, 169, 0, 170, 171, 172, 0, 173, 174, 175,
-	176, 373, 177, 178, 179, 374, 0, 180, 0, 181,
-	182, 375, 183, 0, 184, 0, 185, 0, 0, 0,
-	186, 187, 188, 0, 189, 190, 376, 0, 377, 191,
-	0, 192, 193, 194, 195, 196, 197, 198, 199, 200,
-	0, 201, 202, 203, 204, 205, 206, 0, 207, 0,
-	378, 208, 209, 210, 211, 379, 380, 0, 381, 0,
-	212, 0, 213, 0, 214, 215, 216, 217, 218, 0,
-	0, 219, 382, 0, 220, 0, 0, 221, 222, 421,
-	0, 0, 223, 224, 225, 226, 227, 228, 229, 230,
-	231, 232, 233, 234, 235, 236, 422, 383, 0, 384,
-	237, 238, 385, 0, 239, 240, 241, 0, 386, 242,
-	387, 243, 244, 245, 0, 246, 0, 0, 247, 248,
-	0, 0, 249, 388, 0, 250, 0, 389, 251, 252,
-	253, 254, 255, 256, 257, 0, 258, 259, 390, 260,
-	391, 263, 261, 262, 0, 264, 265, 266, 267, 268,
-	269, 270, 271, 392, 272, 273, 274, 275, 0, 276,
-	277, 278, 279, 280, 281, 282, 283, 284, 285, 286,
-	0, 287, 288, 0, 289, 290, 291, 393, 292, 293,
-	294, 295, 296, 297, 298, 299, 0, 300, 301, 302,
-	303, 423, 0, 304, 305, 394, 306, 307, 0, 308,
-	309, 395, 310, 0, 311, 312, 313, 314, 315, 316,
-	317, 318, 319, 320, 321, 396, 0, 322, 323, 0,
-	324, 0, 325, 326, 327, 328, 329, 0, 425, 397,
-	0, 0, 424, 330, 398, 331, 399, 0, 332, 333,
-	334, 335, 336, 337, 338, 0, 0, 339, 340, 341,
-	342, 343, 0, 0, 344, 345, 346, 347, 348, 400,
-	401, 0, 349, 0, 350, 351, 352, 353, 0, 0,
-	354, 96, 0, 355, 356, 357, 358, 359, 360, 361,
-	362, 0, 0, 0, 99, 100, 101, 102, 103, 104,
-	105, 106, 0, 107, 108, 109, 0, 0, 0, 0,
-	0, 1716, 0, 110, 111, 0, 112, 113, 0, 114,
-	115, 116, 363, 364, 0, 365, 0, 366, 0, 117,
-	118, 119, 120, 121, 0, 0, 420, 122, 367, 368,
-	123, 0, 124, 125, 126, 127, 369, 0, 0, 0,
-	128, 129, 130, 131, 132, 0, 0, 133, 134, 135,
-	0, 136, 137, 138, 139, 140, 141, 0, 0, 142,
-	143, 144, 0, 0, 0, 0, 0, 0, 0, 145,
-	146, 147, 148, 149, 370, 150, 151, 371, 372, 152,
-	0, 153, 0, 154, 155, 156, 157, 158, 0, 159,
-	160, 161, 0, 0, 162, 163, 164, 165, 166, 0,
-	167, 168, 169, 0, 170, 171, 172, 0, 173, 174,
-	175, 176, 373, 177, 178, 179, 374, 0, 180, 0,
-	181, 182, 375, 183, 0, 184, 0, 185, 0, 0,
-	0, 186, 187, 188, 0, 189, 190, 376, 0, 377,
-	191, 0, 192, 193, 194, 195, 196, 197, 198, 199,
-	200, 0, 201, 202, 203, 204, 205, 206, 0, 207,
-	0, 378, 208, 209, 210, 211, 379, 380, 0, 381,
-	0, 212, 0, 213, 0, 214, 215, 216, 217, 218,
-	0, 0, 219, 382, 0, 220, 0, 0, 221, 222,
-	421, 0, 0, 223, 224, 225, 226, 227, 228, 229,
-	230, 231, 232, 233, 234, 235, 236, 422, 383, 0,
-	384, 237, 238, 385, 0, 239, 240, 241, 0, 386,
-	242, 387, 243, 244, 245, 0, 246, 0, 0, 247,
-	248, 0, 0, 249, 388, 0, 250, 0, 389, 251,
-	252, 253, 254, 255, 256, 257, 0, 258, 259, 390,
-	260, 391, 263, 261, 262, 0, 264, 265, 266, 267,
-	268, 269, 270, 271, 392, 272, 273, 274, 275, 0,
-	276, 277, 278, 279, 280, 281, 282, 283, 284, 285,
-	286, 0, 287, 288, 0, 289, 290, 291, 393, 292,
-	293, 294, 295, 296, 297, 298, 299, 0, 300, 301,
-	302, 303, 423, 0, 304, 305, 394, 306, 307, 0,
-	308, 309, 395, 310, 0, 311, 312, 313, 314, 315,
-	316, 317, 318, 319, 320, 321, 396, 0, 322, 323,
-	0, 324, 0, 325, 326, 327, 328, 329, 0, 425,
-	397, 0, 0, 424, 330, 398, 331, 399, 0, 332,
-	333, 334, 335, 336, 337, 338, 0, 0, 339, 340,
-	341, 342, 343, 0, 0, 344, 345, 346, 347, 348,
-	400, 401, 0, 349, 0, 350, 351, 352, 353, 0,
-	0, 354, 96, 0, 355, 356, 357, 358, 359, 360,
-	361, 362, 0, 0, 0, 99, 100, 101, 102, 103,
-	104, 105, 106, 0, 107, 108, 109, 0, 0, 0,
-	0, 0, 1661, 0, 110, 111, 0, 112, 113, 0,
-	114, 115, 116, 363, 364, 0, 365, 0, 366, 0,
-	117, 118, 119, 120, 121, 0, 0, 420, 122, 367,
-	368, 123, 0, 124, 125, 126, 127, 369, 0, 0,
-	0, 128, 129, 130, 131, 132, 0, 0, 133, 134,
-	135, 0, 136, 137, 138, 139, 140, 141, 0, 0,
-	142, 143, 144, 0, 0, 0, 0, 0, 0, 0,
-	145, 146, 147, 148, 149, 370, 150, 151, 371, 372,
-	152, 0, 153, 0, 154, 155, 156, 157, 158, 0,
-	159, 160, 161, 0, 0, 162, 163, 164, 165, 166,
-	0, 167, 168, 169, 0, 170, 171, 172, 0, 173,
-	174, 175, 176, 373, 177, 178, 179, 374, 0, 180,
-	0, 181, 182, 375, 183, 0, 184, 0, 185, 0,
-	0, 0, 186, 187, 188, 0, 189, 190, 376, 0,
-	377, 191, 0, 192, 193, 194, 195, 196, 197, 198,
-	199, 200, 0, 201, 202, 203, 204, 205, 206, 0,
-	207, 0, 378, 208, 209, 210, 211, 379, 380, 0,
-	381, 0, 212, 0, 213, 0, 214, 215, 216, 217,
-	218, 0, 0, 219, 382, 0, 220, 0, 0, 221,
-	222, 421, 0, 0, 223, 224, 225, 226, 227, 228,
-	229, 230, 231, 232, 233, 234, 235, 236, 422, 383,
-	0, 384, 237, 238, 385, 0, 239, 240, 241, 0,
-	386, 242, 387, 243, 244, 245, 0, 246, 0, 0,
-	247, 248, 0, 0, 249, 388, 0, 250, 0, 389,
-	251, 252, 253, 254, 255, 256, 257, 0, 258, 259,
-	390, 260, 391, 263, 261, 262, 0, 264, 265, 266,
-	267, 268, 269, 270, 271, 392, 272, 273, 274, 275,
-	0, 276, 277, 278, 279, 280, 281, 282, 283, 284,
-	285, 286, 0, 287, 288, 0, 289, 290, 291, 393,
-	292, 293, 294, 295, 296, 297, 298, 299, 0, 300,
-	301, 302, 303, 423, 0, 304, 305, 394, 306, 307,
-	0, 308, 309, 395, 310, 0, 311, 312, 313, 314,
-	315, 316, 317, 318, 319, 320, 321, 396, 0, 322,
-	323, 0, 324, 0, 325, 326, 327, 328, 329, 0,
-	425, 397, 0, 0, 424, 330, 398, 331, 399, 0,
-	332, 333, 334, 335, 336, 337, 338, 0, 0, 339,
-	340, 341, 342, 343, 0, 0, 344, 345, 346, 347,
-	348, 400, 401, 0, 349, 0, 350, 351, 352, 353,
-	0, 0, 354, 486, 0, 355, 356, 357, 358, 359,
-	360, 361, 362, 0, 0, 0, 99, 100, 101, 102,
+	0, 0, 110, 111, 0, 112, 113, 496, 114, 115,
+	116, 366, 367, 368, 497, 369, 0, 370, 0, 117,
+	118, 119, 120, 121, 122, 123, 0, 653, 426, 124,
+	371, 372, 125, 0, 126, 127, 128, 129, 661, 0,
+	641, 0, 130, 131, 132, 133, 134, 0, 499, 135,
+	136, 137, 0, 138, 139, 140, 141, 142, 143, 0,
+	500, 144, 145, 146, 651, 642, 647, 652, 643, 644,
+	648, 147, 148, 149, 150, 151, 374, 152, 153, 375,
+	376, 154, 0, 155, 0, 156, 157, 158, 159, 160,
+	0, 161, 162, 163, 0, 0, 164, 165, 166, 167,
+	168, 0, 169, 170, 171, 0, 172, 173, 174, 0,
+	175, 176, 177, 178, 377, 179, 180, 181, 654, 0,
+	182, 0, 183, 184, 379, 185, 0, 186, 0, 187,
+	502, 0, 503, 188, 189, 190, 0, 191, 192, 662,
+	0, 381, 193, 0, 194, 195, 196, 197, 198, 504,
+	199, 200, 201, 202, 0, 203, 204, 205, 206, 207,
+	208, 0, 209, 505, 382, 210, 211, 212, 213, 383,
+	384, 0, 385, 0, 214, 506, 215, 507, 216, 217,
+	218, 219, 220, 0, 0, 221, 663, 508, 222, 509,
+	0, 223, 224, 427, 645, 646, 225, 226, 227, 228,
+	229, 230, 231, 232, 233, 234, 235, 236, 237, 238,
+	428, 387, 510, 388, 239, 240, 389, 0, 241, 242,
+	243, 0, 660, 244, 391, 245, 246, 247, 0, 248,
+	0, 0, 249, 250, 0, 0, 251, 392, 511, 252,
+	512, 655, 253, 254, 255, 256, 257, 258, 259, 0,
+	260, 261, 656, 262, 395, 265, 263, 264, 0, 266,
+	267, 268, 269, 270, 271, 272, 273, 396, 274, 275,
+	276, 277, 0, 278, 279, 280, 281, 282, 283, 284,
+	285, 286, 287, 288, 0, 289, 290, 513, 291, 292,
+	293, 397, 294, 295, 296, 297, 298, 299, 300, 301,
+	0, 302, 303, 398, 304, 305, 429, 649, 306, 307,
+	399, 308, 309, 514, 310, 311, 400, 401, 312, 0,
+	313, 314, 315, 316, 317, 318, 319, 320, 321, 322,
+	323, 657, 0, 324, 325, 0, 326, 515, 327, 328,
+	329, 330, 331, 0, 431, 403, 0, 0, 430, 332,
+	658, 333, 659, 0, 334, 335, 336, 337, 338, 339,
+	340, 0, 0, 341, 342, 343, 344, 345, 346, 650,
+	0, 347, 348, 349, 350, 351, 406, 407, 0, 352,
+	516, 353, 354, 355, 356, 96, 0, 357, 0, 0,
+	358, 359, 360, 361, 362, 363, 364, 365, 0, 0,
+	0, 99, 100, 101, 102, 103, 104, 105, 106, 0,
+	107, 108, 109, 0, 0, 0, 0, 0, 0, 0,
+	110, 111, 0, 112, 113, 0, 114, 115, 116, 366,
+	367, 368, 0, 369, 0, 370, 0, 117, 118, 119,
+	120, 121, 122, 123, 0, 0, 426, 124, 371, 372,
+	125, 0, 126, 127, 128, 129, 373, 0, 0, 0,
+	130, 131, 132, 133, 134, 0, 0, 135, 136, 137,
+	0, 138, 139, 140, 141, 142, 143, 0, 0, 144,
+	145, 146, 0, 0, 0, 0, 0, 0, 0, 147,
+	148, 149, 150, 151, 374, 152, 153, 375, 376, 154,
+	0, 155, 0, 156, 157, 158, 159, 160, 0, 161,
+	162, 163, 0, 0, 164, 165, 166, 167, 168, 0,
+	169, 170, 171, 0, 172, 173, 174, 0, 175, 176,
+	177, 178, 377, 179, 180, 181, 378, 0, 182, 0,
+	183, 184, 379, 185, 0, 186, 0, 187, 0, 0,
+	0, 188, 189, 190, 0, 191, 192, 380, 0, 381,
+	193, 0, 194, 195, 196, 197, 198, 0, 199, 200,
+	201, 202, 0, 203, 204, 205, 206, 207, 208, 0,
+	209, 0, 382, 210, 211, 212, 213, 383, 384, 0,
+	385, 0, 214, 0, 215, 0, 216, 217, 218, 219,
+	220, 0, 0, 221, 386, 0, 222, 0, 0, 223,
+	224, 427, 0, 0, 225, 226, 227, 228, 229, 230,
+	231, 232, 233, 234, 235, 236, 237, 238, 428, 387,
+	0, 388, 239, 240, 389, 0, 241, 242, 243, 0,
+	390, 244, 391, 245, 246, 247, 0, 248, 0, 0,
+	249, 250, 0, 0, 251, 392, 0, 252, 0, 393,
+	253, 254, 255, 256, 257, 258, 259, 0, 260, 261,
+	394, 262, 395, 265, 263, 264, 0, 266, 267, 268,
+	269, 270, 271, 272, 273, 396, 274, 275, 276, 277,
+	0, 278, 279, 280, 281, 282, 283, 284, 285, 286,
+	287, 288, 0, 289, 290, 0, 291, 292, 293, 397,
+	294, 295, 296, 297, 298, 299, 300, 301, 53, 302,
+	303, 398, 304, 305, 429, 0, 306, 307, 399, 308,
+	309, 0, 310, 311, 400, 401, 312, 0, 313, 314,
+	315, 316, 317, 318, 319, 320, 321, 322, 323, 402,
+	0, 324, 325, 55, 326, 0, 327, 328, 329, 330,
+	331, 0, 431, 403, 0, 0, 430, 332, 404, 333,
+	405, 0, 334, 335, 336, 337, 338, 339, 340, 0,
+	0, 341, 342, 343, 344, 345, 346, 0, 0, 347,
+	348, 349, 350, 351, 495, 407, 0, 352, 0, 353,
+	354, 355, 356, 0, 96, 357, 0, 51, 358, 359,
+	360, 361, 362, 363, 364, 365, 0, 0, 52, 0,
+	99, 100, 101, 102, 103, 104, 105, 106, 0, 107,
+	108, 109, 0, 0, 0, 0, 50, 1456, 0, 110,
+	111, 0, 112, 113, 0, 114, 115, 116, 366, 367,
+	368, 0, 369, 0, 370, 0, 117, 118, 119, 120,
+	121, 122, 123, 0, 0, 426, 124, 371, 372, 125,
+	0, 126, 127, 128, 129, 373, 0, 0, 0, 130,
+	131, 132, 133, 134, 0, 0, 135, 136, 137, 0,
+	138, 139, 140, 141, 142, 143, 0, 0, 144, 145,
+	146, 0, 0, 0, 0, 0, 0, 0, 147, 148,
+	149, 150, 151, 374, 152, 153, 375, 376, 154, 0,
+	155, 0, 156, 157, 158, 159, 160, 0, 161, 162,
+	163, 0, 0, 164, 165, 166, 167, 168, 0, 169,
+	170, 171, 0, 172, 173, 174, 0, 175, 176, 177,
+	178, 377, 179, 180, 181, 378, 0, 182, 0, 183,
+	184, 379, 185, 0, 186, 0, 187, 0, 0, 0,
+	188, 189, 190, 0, 191, 192, 380, 0, 381, 193,
+	0, 194, 195, 196, 197, 198, 0, 199, 200, 201,
+	202, 0, 203, 204, 205, 206, 207, 208, 0, 209,
+	0, 382, 210, 211, 212, 213, 383, 384, 0, 385,
+	0, 214, 0, 215, 0, 216, 217, 218, 219, 220,
+	0, 0, 221, 386, 0, 222, 0, 0, 223, 224,
+	427, 0, 0, 225, 226, 227, 228, 229, 230, 231,
+	232, 233, 234, 235, 236, 237, 238, 428, 387, 0,
+	388, 239, 240, 389, 0, 241, 242, 243, 0, 390,
+	244, 391, 245, 246, 247, 0, 248, 0, 0, 249,
+	250, 0, 0, 251, 392, 0, 252, 0, 393, 253,
+	254, 255, 256, 257, 258, 259, 0, 260, 261, 394,
+	262, 395, 265, 263, 264, 0, 266, 267, 268, 269,
+	270, 271, 272, 273, 396, 274, 275, 276, 277, 0,
+	278, 279, 280, 281, 282, 283, 284, 285, 286, 287,
+	288, 0, 289, 290, 0, 291, 292, 293, 397, 294,
+	295, 296, 297, 298, 299, 300, 301, 0, 302, 303,
+	398, 304, 305, 429, 0, 306, 307, 399, 308, 309,
+	0, 310, 311, 400, 401, 312, 0, 313, 314, 315,
+	316, 317, 318, 319, 320, 321, 322, 323, 402, 0,
+	324, 325, 0, 326, 0, 327, 328, 329, 330, 331,
+	0, 431, 403, 0, 0, 430, 332, 404, 333, 405,
+	0, 334, 335, 336, 337, 338, 339, 340, 0, 0,
+	341, 342, 343, 344, 345, 346, 0, 0, 347, 348,
+	349, 350, 351, 406, 407, 0, 352, 0, 353, 354,
+	355, 356, 96, 0, 357, 0, 0, 358, 359, 360,
+	361, 362, 363, 364, 365, 0, 0, 0, 99, 100,
+	101, 102, 103, 104, 105, 106, 0, 107, 108, 109,
+	0, 0, 0, 0, 0, 0, 587, 110, 111, 0,
+	112, 113, 0, 114, 115, 116, 366, 367, 368, 0,
+	369, 0, 370, 0, 117, 118, 119, 120, 121, 122,
+	123, 0, 0, 426, 124, 371, 372, 125, 0, 126,
+	127, 128, 129, 373, 0, 0, 0, 130, 131, 132,
+	133, 134, 0, 0, 135, 136, 137, 0, 138, 139,
+	140, 141, 142, 143, 0, 0, 144, 145, 146, 0,
+	0, 0, 0, 0, 0, 0, 147, 148, 149, 150,
+	151, 374, 152, 153, 375, 376, 154, 0, 155, 0,
+	156, 157, 158, 159, 160, 0, 161, 162, 163, 0,
+	0, 164, 165, 166, 167, 168, 0, 169, 170, 171,
+	0, 172, 173, 174, 0, 175, 176, 177, 178, 377,
+	179, 180, 181, 378, 0, 182, 0, 183, 184, 379,
+	185, 0, 186, 0, 187, 0, 0, 0, 188, 189,
+	190, 0, 191, 192, 380, 0, 381, 193, 0, 194,
+	195, 196, 197, 198, 0, 199, 200, 201, 202, 0,
+	203, 204, 205, 206, 207, 208, 0, 209, 0, 382,
+	210, 211, 212, 213, 383, 384, 0, 385, 0, 214,
+	0, 215, 0, 216, 217, 218, 219, 220, 0, 0,
+	221, 386, 0, 222, 0, 0, 223, 224, 427, 0,
+	0, 225, 226, 227, 228, 229, 230, 231, 232, 233,
+	234, 235, 236, 237, 238, 428, 387, 0, 388, 239,
+	240, 389, 0, 241, 242, 243, 0, 390, 244, 391,
+	245, 246, 247, 0, 248, 0, 0, 249, 250, 0,
+	0, 251, 392, 0, 252, 0, 393, 253, 254, 255,
+	256, 257, 258, 259, 0, 260, 261, 394, 262, 395,
+	265, 263, 264, 0, 266, 267, 268, 269, 270, 271,
+	272, 273, 396, 274, 275, 276, 277, 0, 278, 279,
+	280, 281, 282, 283, 284, 285, 286, 287, 288, 0,
+	289, 290, 0, 291, 292, 293, 397, 294, 295, 296,
+	297, 298, 299, 300, 301, 0, 302, 303, 398, 304,
+	305, 429, 0, 306, 307, 399, 308, 309, 0, 310,
+	311, 400, 401, 312, 0, 313, 314, 315, 316, 317,
+	318, 319, 320, 321, 322, 323, 402, 0, 324, 325,
+	0, 326, 0, 327, 328, 329, 330, 331, 0, 431,
+	403, 0, 0, 430, 332, 404, 333, 405, 0, 334,
+	335, 336, 337, 338, 339, 340, 0, 0, 341, 342,
+	343, 344, 345, 346, 0, 0, 347, 348, 349, 350,
+	351, 406, 407, 0, 352, 0, 353, 354, 355, 356,
+	96, 0, 357, 0, 0, 358, 359, 360, 361, 362,
+	363, 364, 365, 0, 0, 0, 99, 100, 101, 102,
 	103, 104, 105, 106, 0, 107, 108, 109, 0, 0,
-	0, 0, 0, 690, 0, 110, 111, 0, 112, 113,
-	490, 114, 115, 116, 363, 364, 491, 365, 0, 366,
-	0, 117, 118, 119, 120, 121, 0, 0, 420, 122,
-	367, 368, 123, 0, 124, 125, 126, 127, 369, 0,
-	492, 0, 128, 129, 130, 131, 132, 0, 493, 133,
-	134, 135, 0, 136, 137, 138, 139, 140, 141, 0,
-	494, 142, 143, 144, 0, 0, 0, 495, 0, 0,
-	0, 145, 146, 147, 148, 149, 370, 150, 151, 371,
-	372, 152, 0, 153, 0, 154, 155, 156, 157, 158,
-	0, 159, 160, 161, 0, 0, 162, 163, 164, 165,
-	166, 0, 167, 168, 169, 0, 170, 171, 172, 0,
-	173, 174, 175, 176, 373, 177, 178, 179, 374, 0,
-	180, 0, 181, 182, 375, 183, 0, 184, 0, 185,
-	496, 0, 497, 186, 187, 188, 0, 189, 190, 376,
-	0, 377, 191, 0, 192, 193, 194, 195, 196, 197,
-	198, 199, 200, 0, 201, 202, 203, 204, 205, 206,
-	0, 207, 498, 378, 208, 209, 210, 211, 379, 380,
-	0, 381, 0, 212, 499, 213, 500, 214, 215, 216,
-	217, 218, 0, 0, 219, 382, 501, 220, 502, 0,
-	221, 222, 421, 0, 0, 223, 224, 225, 226, 227,
-	228, 229, 230, 231, 232, 233, 234, 235, 236, 422,
-	383, 503, 384, 237, 238, 385, 0, 239, 240, 241,
-	0, 386, 242, 387, 243, 244, 245, 0, 246, 0,
-	0, 247, 248, 0, 0, 249, 388, 504, 250, 505,
-	389, 251, 252, 253, 254, 255, 256, 257, 0, 258,
-	259, 390, 260, 391, 263, 261, 262, 0, 264, 265,
-	266, 267, 268, 269, 270, 271, 392, 272, 273, 274,
-	275, 0, 276, 277, 278, 279, 280, 281, 282, 283,
-	284, 285, 286, 0, 287, 288, 506, 289, 290, 291,
-	393, 292, 293, 294, 295, 296, 297, 298, 299, 0,
-	300, 301, 302, 303, 423, 0, 304, 305, 394, 306,
-	307, 507, 308, 309, 395, 310, 0, 311, 312, 313,
-	314, 315, 316, 317, 318, 319, 320, 321, 396, 0,
-	322, 323, 0, 324, 508, 325, 326, 327, 328, 329,
-	0, 425, 397, 0, 0, 424, 330, 398, 331, 399,
-	0, 332, 333, 334, 335, 336, 337, 338, 0, 0,
-	339, 340, 341, 342, 343, 0, 0, 344, 345, 346,
-	347, 348, 400, 401, 0, 349, 509, 350, 351, 352,
-	353, 96, 0, 354, 0, 0, 355, 356, 357, 358,
-	359, 360, 361, 362, 99, 100, 101, 102, 103, 104,
+	0, 0, 0, 1037, 0, 110, 111, 0, 112, 113,
+	0, 114, 115, 116, 366, 367, 368, 0, 369, 0,
+	370, 0, 117, 118, 119, 120, 121, 122, 123, 0,
+	0, 426, 124, 371, 372, 125, 0, 126, 127, 128,
+	129, 373, 0, 0, 0, 130, 131, 132, 133, 134,
+	0, 0, 135, 136, 137, 0, 138, 139, 140, 141,
+	142, 143, 0, 0, 144, 145, 146, 0, 0, 0,
+	0, 0, 0, 0, 147, 148, 149, 150, 151, 374,
+	152, 153, 375, 376, 154, 0, 155, 0, 156, 157,
+	158, 159, 160, 0, 161, 162, 163, 0, 0, 164,
+	165, 166, 167, 168, 0, 169, 170, 171, 0, 172,
+	173, 174, 0, 175, 176, 177, 178, 377, 179, 180,
+	181, 378, 0, 182, 0, 183, 184, 379, 185, 0,
+	186, 0, 187, 0, 0, 0, 188, 189, 190, 0,
+	191, 192, 380, 0, 381, 193, 0, 194, 195, 196,
+	197, 198, 0, 199, 200, 201, 202, 0, 203, 204,
+	205, 206, 207, 208, 0, 209, 0, 382, 210, 211,
+	212, 213, 383, 384, 0, 385, 0, 214, 0, 215,
+	0, 216, 217, 218, 219, 220, 0, 0, 221, 386,
+	0, 222, 0, 0, 223, 224, 427, 0, 0, 225,
+	226, 227, 228, 229, 230, 231, 232, 233, 234, 235,
+	236, 237, 238, 428, 387, 0, 388, 239, 240, 389,
+	0, 241, 242, 243, 0, 390, 244, 391, 245, 246,
+	247, 0, 248, 0, 0, 249, 250, 0, 0, 251,
+	392, 0, 252, 0, 393, 253, 254, 255, 256, 257,
+	258, 259, 0, 260, 261, 394, 262, 395, 265, 263,
+	264, 0, 266, 267, 268, 269, 270, 271, 272, 273,
+	396, 274, 275, 276, 277, 0, 278, 279, 280, 281,
+	282, 283, 284, 285, 286, 287, 288, 0, 289, 290,
+	0, 291, 292, 293, 397, 294, 295, 296, 297, 298,
+	299, 300, 301, 0, 302, 303, 398, 304, 305, 429,
+	0, 306, 307, 399, 308, 309, 0, 310, 311, 400,
+	401, 312, 0, 313, 314, 315, 316, 317, 318, 319,
+	320, 321, 322, 323, 402, 0, 324, 325, 0, 326,
+	0, 327, 328, 329, 330, 331, 0, 431, 403, 0,
+	0, 430, 332, 404, 333, 405, 0, 334, 335, 336,
+	337, 338, 339, 340, 0, 0, 341, 342, 343, 344,
+	345, 346, 0, 0, 347, 348, 349, 350, 351, 406,
+	407, 0, 352, 0, 353, 354, 355, 356, 96, 0,
+	357, 0, 0, 358, 359, 360, 361, 362, 363, 364,
+	365, 0, 0, 0, 99, 100, 101, 102, 103, 104,
 	105, 106, 0, 107, 108, 109, 0, 0, 0, 0,
-	0, 0, 0, 110, 111, 0, 112, 113, 0, 114,
-	115, 116, 363, 364, 0, 365, 0, 366, 0, 117,
-	118, 119, 120, 121, 0, 0, 420, 122, 367, 368,
-	123, 1062, 124, 125, 126, 127, 369, 0, 0, 0,
-	128, 129, 130, 131, 132, 0, 0, 133, 134, 135,
-	1060, 136, 137, 138, 139, 140, 141, 0, 0, 142,
-	143, 144, 0, 0, 0, 0, 0, 0, 0, 145,
-	146, 147, 148, 149, 370, 150, 151, 371, 372, 152,
-	0, 153, 0, 154, 155, 156, 157, 158, 0, 159,
-	160, 161, 0, 0, 162, 163, 164, 165, 166, 0,
-	167, 168, 169, 0, 170, 171, 172, 0, 1066, 174,
-	175, 176, 373, 177, 178, 179, 374, 0, 180, 0,
-	181, 182, 375, 183, 0, 184, 1067, 185, 0, 0,
-	0, 186, 187, 188, 0, 189, 190, 376, 0, 377,
-	191, 0, 192, 193, 194, 195, 196, 197, 198, 199,
-	200, 0, 201, 202, 1064, 204, 205, 206, 0, 207,
-	0, 378, 208, 209, 210, 211, 379, 380, 0, 381,
-	0, 212, 0, 213, 0, 214, 215, 216, 217, 218,
-	0, 0, 219, 382, 0, 220, 1389, 0, 221, 222,
-	421, 0, 0, 223, 224, 225, 226, 227, 228, 229,
-	230, 231, 232, 233, 234, 235, 236, 422, 383, 0,
-	384, 237, 238, 385, 0, 239, 240, 241, 0, 386,
-	242, 387, 243, 244, 245, 0, 246, 0, 0, 247,
-	248, 0, 0, 249, 388, 0, 250, 0, 389, 251,
-	252, 253, 254, 255, 256, 257, 0, 258, 259, 390,
-	260, 391, 263, 261, 262, 1065, 264, 265, 266, 267,
-	268, 269, 270, 271, 392, 272, 273, 274, 275, 0,
-	276, 277, 278, 279, 280, 281, 282, 283, 284, 285,
-	286, 0, 287, 288, 0, 289, 290, 291, 393, 292,
-	293, 294, 295, 296, 297, 298, 299, 0, 300, 301,
-	302, 303, 423, 0, 304, 305, 394, 306, 307, 0,
-	308, 309, 395, 310, 0, 311, 312, 313, 314, 315,
-	316, 317, 318, 319, 320, 321, 396, 0, 322, 323,
-	0, 324, 0, 325, 326, 327, 328, 329, 0, 425,
-	397, 0, 0, 424, 330, 398, 331, 399, 0, 332,
-	333, 334, 335, 336, 337, 338, 0, 1063, 339, 340,
-	341, 342, 343, 0, 0, 344, 345, 346, 347, 348,
-	400, 401, 0, 349, 0, 350, 351, 352, 353, 96,
-	0, 354, 0, 0, 355, 356, 357, 358, 359, 360,
-	361, 362, 99, 100, 101, 102, 103, 104, 105, 106,
-	0, 107, 108, 109, 0, 0, 0, 0, 0, 0,
+	0, 1733, 0, 110, 111, 0, 112, 113, 0, 114,
+	115, 116, 366, 367, 368, 0, 369, 0, 370, 0,
+	117, 118, 119, 120, 121, 122, 123, 0, 0, 426,
+	124, 371, 372, 125, 0, 126, 127, 128, 129, 373,
+	0, 0, 0, 130, 131, 132, 133, 134, 0, 0,
+	135, 136, 137, 0, 138, 139, 140, 141, 142, 143,
+	0, 0, 144, 145, 146, 0, 0, 0, 0, 0,
+	0, 0, 147, 148, 149, 150, 151, 374, 152, 153,
+	375, 376, 154, 0, 155, 0, 156, 157, 158, 159,
+	160, 0, 161, 162, 163, 0, 0, 164, 165, 166,
+	167, 168, 0, 169, 170, 171, 0, 172, 173, 174,
+	0, 175, 176, 177, 178, 377, 179, 180, 181, 378,
+	0, 182, 0, 183, 184, 379, 185, 0, 186, 0,
+	187, 0, 0, 0, 188, 189, 190, 0, 191, 192,
+	380, 0, 381, 193, 0, 194, 195, 196, 197, 198,
+	0, 199, 200, 201, 202, 0, 203, 204, 205, 206,
+	207, 208, 0, 209, 0, 382, 210, 211, 212, 213,
+	383, 384, 0, 385, 0, 214, 0, 215, 0, 216,
+	217, 218, 219, 220, 0, 0, 221, 386, 0, 222,
+	0, 0, 223, 224, 427, 0, 0, 225, 226, 227,
+	228, 229, 230, 231, 232, 233, 234, 235, 236, 237,
+	238, 428, 387, 0, 388, 239, 240, 389, 0, 241,
+	242, 243, 0, 390, 244, 391, 245, 246, 247, 0,
+	248, 0, 0, 249, 250, 0, 0, 251, 392, 0,
+	252, 0, 393, 253, 254, 255, 256, 257, 258, 259,
+	0, 260, 261, 394, 262, 395, 265, 263, 264, 0,
+	266, 267, 268, 269, 270, 271, 272, 273, 396, 274,
+	275, 276, 277, 0, 278, 279, 280, 281, 282, 283,
+	284, 285, 286, 287, 288, 0, 289, 290, 0, 291,
+	292, 293, 397, 294, 295, 296, 297, 298, 299, 300,
+	301, 0, 302, 303, 398, 304, 305, 429, 0, 306,
+	307, 399, 308, 309, 0, 310, 311, 400, 401, 312,
+	0, 313, 314, 315, 316, 317, 318, 319, 320, 321,
+	322, 323, 402, 0, 324, 325, 0, 326, 0, 327,
+	328, 329, 330, 331, 0, 431, 403, 0, 0, 430,
+	332, 404, 333, 405, 0, 334, 335, 336, 337, 338,
+	339, 340, 0, 0, 341, 342, 343, 344, 345, 346,
+	0, 0, 347, 348, 349, 350, 351, 406, 407, 0,
+	352, 0, 353, 354, 355, 356, 96, 0, 357, 0,
+	0, 358, 359, 360, 361, 362, 363, 364, 365, 0,
+	0, 0, 99, 100, 101, 102, 103, 104, 105, 106,
+	0, 107, 108, 109, 0, 0, 0, 0, 0, 1676,
 	0, 110, 111, 0, 112, 113, 0, 114, 115, 116,
-	363, 364, 0, 365, 0, 366, 0, 117, 118, 119,
-	120, 121, 0, 0, 420, 122, 367, 368, 123, 1062,
-	124, 125, 126, 127, 369, 0, 0, 1057, 128, 129,
-	130, 131, 132, 0, 0, 133, 134, 135, 1060, 136,
-	137, 138, 139, 140, 141, 0, 0, 142, 143, 144,
-	0, 0, 0, 0, 0, 0, 0, 145, 146, 147,
-	148, 149, 370, 150, 151, 371, 372, 152, 0, 153,
-	0, 154, 155, 156, 157, 158, 0, 159, 160, 161,
-	0, 0, 162, 163, 164, 165, 166, 0, 167, 168,
-	169, 0, 170, 171, 172, 0, 1066, 174, 175, 176,
-	373, 177, 178, 179, 374, 0, 180, 0, 181, 182,
-	375, 183, 0, 184, 1067, 185, 0, 0, 0, 186,
-	187, 188, 0, 189, 190, 376, 0, 377, 191, 0,
-	192, 193, 194, 195, 196, 197, 198, 199, 200, 0,
-	201, 202, 1064, 204, 205, 206, 0, 207, 0, 378,
-	208, 209, 210, 211, 379, 380, 0, 381, 0, 212,
-	0, 213, 0, 214, 215, 216, 217, 218, 0, 0,
-	219, 382, 0, 220, 0, 0, 221, 222, 421, 0,
-	0, 223, 224, 225, 226, 227, 228, 229, 230, 231,
-	232, 233, 234, 235, 236, 422, 383, 0, 384, 237,
-	238, 385, 0, 239, 240, 241, 0, 386, 242, 387,
-	243, 244, 245, 0, 246, 0, 0, 247, 248, 0,
-	0, 249, 388, 0, 250, 0, 389, 251, 252, 253,
-	254, 255, 256, 257, 0, 258, 259, 390, 260, 391,
-	263, 261, 262, 1065, 264, 265, 266, 267, 268, 269,
-	270, 271, 392, 272, 273, 274, 275, 0, 276, 277,
-	278, 279, 280, 281, 282, 283, 284, 285, 286, 0,
-	287, 288, 0, 289, 290, 291, 393, 292, 293, 294,
-	295, 296, 297, 298, 299, 0, 300, 301, 302, 303,
-	423, 0, 304, 305, 394, 306, 307, 0, 308, 309,
-	395, 310, 0, 311, 312, 313, 314, 315, 316, 317,
-	318, 319, 320, 321, 396, 0, 322, 323, 0, 324,
-	0, 325, 326, 327, 328, 329, 0, 425, 397, 0,
-	0, 424, 330, 398, 331, 399, 0, 332, 333, 334,
-	335, 336, 337, 338, 0, 1063, 339, 340, 341, 342,
-	343, 0, 0, 344, 345, 346, 347, 348, 400, 401,
-	0, 349, 0, 350, 351, 352, 353, 96, 0, 354,
-	0, 0, 355, 356, 357, 358, 359, 360, 361, 362,
+	366, 367, 368, 0, 369, 0, 370, 0, 117, 118,
+	119, 120, 121, 122, 123, 0, 0, 426, 124, 371,
+	372, 125, 0, 126, 127, 128, 129, 373, 0, 0,
+	0, 130, 131, 132, 133, 134, 0, 0, 135, 136,
+	137, 0, 138, 139, 140, 141, 142, 143, 0, 0,
+	144, 145, 146, 0, 0, 0, 0, 0, 0, 0,
+	147, 148, 149, 150, 151, 374, 152, 153, 375, 376,
+	154, 0, 155, 0, 156, 157, 158, 159, 160, 0,
+	161, 162, 163, 0, 0, 164, 165, 166, 167, 168,
+	0, 169, 170, 171, 0, 172, 173, 174, 0, 175,
+	176, 177, 178, 377, 179, 180, 181, 378, 0, 182,
+	0, 183, 184, 379, 185, 0, 186, 0, 187, 0,
+	0, 0, 188, 189, 190, 0, 191, 192, 380, 0,
+	381, 193, 0, 194, 195, 196, 197, 198, 0, 199,
+	200, 201, 202, 0, 203, 204, 205, 206, 207, 208,
+	0, 209, 0, 382, 210, 211, 212, 213, 383, 384,
+	0, 385, 0, 214, 0, 215, 0, 216, 217, 218,
+	219, 220, 0, 0, 221, 386, 0, 222, 0, 0,
+	223, 224, 427, 0, 0, 225, 226, 227, 228, 229,
+	230, 231, 232, 233, 234, 235, 236, 237, 238, 428,
+	387, 0, 388, 239, 240, 389, 0, 241, 242, 243,
+	0, 390, 244, 391, 245, 246, 247, 0, 248, 0,
+	0, 249, 250, 0, 0, 251, 392, 0, 252, 0,
+	393, 253, 254, 255, 256, 257, 258, 259, 0, 260,
+	261, 394, 262, 395, 265, 263, 264, 0, 266, 267,
+	268, 269, 270, 271, 272, 273, 396, 274, 275, 276,
+	277, 0, 278, 279, 280, 281, 282, 283, 284, 285,
+	286, 287, 288, 0, 289, 290, 0, 291, 292, 293,
+	397, 294, 295, 296, 297, 298, 299, 300, 301, 0,
+	302, 303, 398, 304, 305, 429, 0, 306, 307, 399,
+	308, 309, 0, 310, 311, 400, 401, 312, 0, 313,
+	314, 315, 316, 317, 318, 319, 320, 321, 322, 323,
+	402, 0, 324, 325, 0, 326, 0, 327, 328, 329,
+	330, 331, 0, 431, 403, 0, 0, 430, 332, 404,
+	333, 405, 0, 334, 335, 336, 337, 338, 339, 340,
+	0, 0, 341, 342, 343, 344, 345, 346, 0, 0,
+	347, 348, 349, 350, 351, 406, 407, 0, 352, 0,
+	353, 354, 355, 356, 491, 0, 357, 0, 0, 358,
+	359, 360, 361, 362, 363, 364, 365, 0, 0, 0,
 	99, 100, 101, 102, 103, 104, 105, 106, 0, 107,
-	108, 109, 0, 0, 0, 0, 0, 0, 0, 110,
-	111, 0, 112, 113, 0, 114, 115, 116, 363, 364,
-	0, 365, 0, 366, 0, 117, 118, 119, 120, 121,
-	0, 0, 420, 122, 367, 368, 123, 1062, 124, 125,
-	126, 127, 369, 0, 0, 0, 128, 129, 130, 131,
-	132, 0, 0, 133, 134, 135, 1060, 136, 137, 138,
-	139, 140, 141, 0, 0, 142, 143, 144, 0, 0,
-	0, 0, 0, 0, 0, 145, 146, 147, 148, 149,
-	370, 150, 151, 371, 372, 152, 0, 153, 0, 154,
-	155, 156, 157, 158, 0, 159, 160, 161, 0, 0,
-	162, 163, 164, 165, 166, 0, 167, 168, 169, 0,
-	170, 171, 172, 0, 1066, 174, 175, 176, 373, 177,
-	178, 179, 374, 0, 180, 0, 181, 182, 375, 183,
-	0, 184, 1067, 185, 0, 0, 0, 186, 187, 188,
-	0, 189, 190, 376, 0, 377, 191, 0, 192, 193,
-	194, 195, 196, 197, 198, 199, 200, 0, 201, 202,
-	1064, 204, 205, 206, 0, 207, 0, 378, 208, 209,
-	210, 211, 379, 380, 0, 381, 0, 212, 0, 213,
-	0, 214, 215, 216, 217, 218, 0, 0, 219, 382,
-	0, 220, 0, 0, 221, 222, 421, 0, 0, 223,
-	224, 225, 226, 227, 228, 229, 230, 231, 232, 233,
-	234, 235, 236, 422, 383, 0, 384, 237, 238, 385,
-	0, 239, 240, 241, 0, 386, 242, 387, 243, 244,
-	245, 0, 246, 0, 0, 247, 248, 0, 0, 249,
-	388, 0, 250, 0, 389, 251, 252, 253, 254, 255,
-	256, 257, 0, 258, 259, 390, 260, 391, 263, 261,
-	262, 1065, 264, 265, 266, 267, 268, 269, 270, 271,
-	392, 272, 273, 274, 275, 0, 276, 277, 278, 279,
-	280, 281, 282, 283, 284, 285, 286, 0, 287, 288,
-	0, 289, 290, 291, 393, 292, 293, 294, 295, 296,
-	297, 298, 299, 0, 300, 301, 302, 303, 423, 0,
-	304, 305, 394, 306, 307, 0, 308, 309, 395, 310,
-	0, 311, 312, 313, 314, 315, 316, 317, 318, 319,
-	320, 321, 396, 0, 322, 323, 0, 324, 0, 325,
-	326, 327, 328, 329, 0, 425, 397, 0, 0, 424,
-	330, 398, 331, 399, 0, 332, 333, 334, 335, 336,
-	337, 338, 0, 1063, 339, 340, 341, 342, 343, 0,
-	0, 344, 345, 346, 347, 348, 400, 401, 0, 349,
-	0, 350, 351, 352, 353, 96, 0, 354, 0, 0,
-	355, 356, 357, 358, 359, 360, 361, 362, 99, 100,
+	108, 109, 0, 0, 0, 0, 0, 693, 0, 110,
+	111, 0, 112, 113, 496, 114, 115, 116, 366, 367,
+	368, 497, 369, 0, 370, 0, 117, 118, 119, 120,
+	121, 122, 123, 0, 0, 426, 124, 371, 372, 125,
+	0, 126, 127, 128, 129, 373, 0, 498, 0, 130,
+	131, 132, 133, 134, 0, 499, 135, 136, 137, 0,
+	138, 139, 140, 141, 142, 143, 0, 500, 144, 145,
+	146, 0, 0, 0, 501, 0, 0, 0, 147, 148,
+	149, 150, 151, 374, 152, 153, 375, 376, 154, 0,
+	155, 0, 156, 157, 158, 159, 160, 0, 161, 162,
+	163, 0, 0, 164, 165, 166, 167, 168, 0, 169,
+	170, 171, 0, 172, 173, 174, 0, 175, 176, 177,
+	178, 377, 179, 180, 181, 378, 0, 182, 0, 183,
+	184, 379, 185, 0, 186, 0, 187, 502, 0, 503,
+	188, 189, 190, 0, 191, 192, 380, 0, 381, 193,
+	0, 194, 195, 196, 197, 198, 504, 199, 200, 201,
+	202, 0, 203, 204, 205, 206, 207, 208, 0, 209,
+	505, 382, 210, 211, 212, 213, 383, 384, 0, 385,
+	0, 214, 506, 215, 507, 216, 217, 218, 219, 220,
+	0, 0, 221, 386, 508, 222, 509, 0, 223, 224,
+	427, 0, 0, 225, 226, 227, 228, 229, 230, 231,
+	232, 233, 234, 235, 236, 237, 238, 428, 387, 510,
+	388, 239, 240, 389, 0, 241, 242, 243, 0, 390,
+	244, 391, 245, 246, 247, 0, 248, 0, 0, 249,
+	250, 0, 0, 251, 392, 511, 252, 512, 393, 253,
+	254, 255, 256, 257, 258, 259, 0, 260, 261, 394,
+	262, 395, 265, 263, 264, 0, 266, 267, 268, 269,
+	270, 271, 272, 273, 396, 274, 275, 276, 277, 0,
+	278, 279, 280, 281, 282, 283, 284, 285, 286, 287,
+	288, 0, 289, 290, 513, 291, 292, 293, 397, 294,
+	295, 296, 297, 298, 299, 300, 301, 0, 302, 303,
+	398, 304, 305, 429, 0, 306, 307, 399, 308, 309,
+	514, 310, 311, 400, 401, 312, 0, 313, 314, 315,
+	316, 317, 318, 319, 320, 321, 322, 323, 402, 0,
+	324, 325, 0, 326, 515, 327, 328, 329, 330, 331,
+	0, 431, 403, 0, 0, 430, 332, 404, 333, 405,
+	0, 334, 335, 336, 337, 338, 339, 340, 0, 0,
+	341, 342, 343, 344, 345, 346, 0, 0, 347, 348,
+	349, 350, 351, 406, 407, 0, 352, 516, 353, 354,
+	355, 356, 96, 0, 357, 0, 0, 358, 359, 360,
+	361, 362, 363, 364, 365, 0, 0, 0, 99, 100,
 	101, 102, 103, 104, 105, 106, 0, 107, 108, 109,
 	0, 0, 0, 0, 0, 0, 0, 110, 111, 0,
-	112, 113, 0, 114, 115, 116, 363, 364, 0, 365,
-	0, 366, 0, 117, 118, 119, 120, 121, 0, 0,
-	420, 122, 367, 368, 123, 0, 124, 125, 126, 127,
-	369, 0, 0, 0, 128, 129, 130, 131, 132, 0,
-	0, 133, 134, 135, 0, 136, 137, 138, 139, 140,
-	141, 0, 0, 142, 143, 144, 0, 0, 0, 0,
-	0, 0, 0, 145, 146, 147, 148, 149, 370, 150,
-	151, 371, 372, 152, 0, 153, 0, 154, 155, 156,
-	157, 158, 0, 159, 160, 161, 0, 0, 162, 163,
-	164, 165, 166, 0, 167, 168, 169, 0, 170, 171,
-	172, 0, 173, 174, 175, 176, 373, 177, 178, 179,
-	374, 0, 180, 0, 181, 182, 375, 183, 0, 184,
-	0, 185, 0, 0, 0, 186, 187, 188, 0, 189,
-	190, 376, 0, 377, 191, 0, 192, 193, 194, 195,
-	196, 197, 198, 199, 200, 0, 201, 202, 203, 204,
-	205, 206, 0, 207, 0, 378, 208, 209, 210, 211,
-	379, 380, 0, 381, 0, 212, 0, 213, 0, 214,
-	215, 216, 217, 218, 0, 0, 219, 382, 0, 220,
-	0, 0, 221, 222, 421, 0, 0, 223, 224, 225,
-	226, 227, 228, 229, 230, 231, 232, 233, 234, 235,
-	236, 422, 383, 0, 384, 237, 238, 385, 0, 239,
-	240, 241, 0, 386, 242, 387, 243, 244, 245, 0,
-	246, 0, 0, 247, 248, 0, 0, 249, 388, 0,
-	250, 0, 389, 251, 252, 253, 254, 255, 256, 257,
-	0, 258, 259, 390, 260, 391, 263, 261, 262, 0,
-	264, 265, 266, 267, 268, 269, 270, 271, 392, 272,
-	273, 274, 275, 0, 276, 277, 278, 279, 280, 281,
-	282, 283, 284, 285, 286, 0, 287, 288, 0, 289,
-	290, 291, 393, 292, 293, 294, 295, 296, 297, 298,
-	299, 0, 300, 301, 302, 303, 423, 0, 304, 305,
-	394, 306, 307, 0, 308, 309, 395, 310, 0, 311,
-	312, 313, 314, 315, 316, 317, 318, 319, 320, 321,
-	396, 0, 322, 323, 0, 324, 0, 325, 326, 327,
-	328, 329, 0, 425, 397, 0, 0, 424, 330, 398,
-	331, 399, 0, 332, 333, 334, 335, 336, 337, 338,
-	0, 0, 339, 340, 341, 342, 343, 0, 2027, 344,
-	345, 346, 347, 348, 400, 401, 0, 349, 0, 350,
-	351, 352, 353, 96, 0, 354, 0, 0, 355, 356,
-	357, 358, 359, 360, 361, 362, 99, 100, 101, 102,
+	112, 113, 0, 114, 115, 116, 366, 367, 368, 0,
+	369, 0, 370, 0, 117, 118, 119, 120, 121, 122,
+	123, 0, 0, 426, 124, 371, 372, 125, 1061, 126,
+	127, 128, 129, 373, 0, 0, 0, 130, 131, 132,
+	133, 134, 0, 0, 135, 136, 137, 1059, 138, 139,
+	140, 141, 142, 143, 0, 0, 144, 145, 146, 0,
+	0, 0, 0, 0, 0, 0, 147, 148, 149, 150,
+	151, 374, 152, 153, 375, 376, 154, 0, 155, 0,
+	156, 157, 158, 159, 160, 0, 161, 162, 163, 0,
+	0, 164, 165, 166, 167, 168, 0, 169, 170, 171,
+	0, 172, 173, 174, 0, 1065, 176, 177, 178, 377,
+	179, 180, 181, 378, 0, 182, 0, 183, 184, 379,
+	185, 0, 186, 1066, 187, 0, 0, 0, 188, 189,
+	190, 0, 191, 192, 380, 0, 381, 193, 0, 194,
+	195, 196, 197, 198, 0, 199, 200, 201, 202, 0,
+	203, 204, 1063, 206, 207, 208, 0, 209, 0, 382,
+	210, 211, 212, 213, 383, 384, 0, 385, 0, 214,
+	0, 215, 0, 216, 217, 218, 219, 220, 0, 0,
+	221, 386, 0, 222, 1404, 0, 223, 224, 427, 0,
+	0, 225, 226, 227, 228, 229, 230, 231, 232, 233,
+	234, 235, 236, 237, 238, 428, 387, 0, 388, 239,
+	240, 389, 0, 241, 242, 243, 0, 390, 244, 391,
+	245, 246, 247, 0, 248, 0, 0, 249, 250, 0,
+	0, 251, 392, 0, 252, 0, 393, 253, 254, 255,
+	256, 257, 258, 259, 0, 260, 261, 394, 262, 395,
+	265, 263, 264, 1064, 266, 267, 268, 269, 270, 271,
+	272, 273, 396, 274, 275, 276, 277, 0, 278, 279,
+	280, 281, 282, 283, 284, 285, 286, 287, 288, 0,
+	289, 290, 0, 291, 292, 293, 397, 294, 295, 296,
+	297, 298, 299, 300, 301, 0, 302, 303, 398, 304,
+	305, 429, 0, 306, 307, 399, 308, 309, 0, 310,
+	311, 400, 401, 312, 0, 313, 314, 315, 316, 317,
+	318, 319, 320, 321, 322, 323, 402, 0, 324, 325,
+	0, 326, 0, 327, 328, 329, 330, 331, 0, 431,
+	403, 0, 0, 430, 332, 404, 333, 405, 0, 334,
+	335, 336, 337, 338, 339, 340, 0, 1062, 341, 342,
+	343, 344, 345, 346, 0, 0, 347, 348, 349, 350,
+	351, 406, 407, 0, 352, 0, 353, 354, 355, 356,
+	96, 0, 357, 0, 0, 358, 359, 360, 361, 362,
+	363, 364, 365, 0, 0, 0, 99, 100, 101, 102,
 	103, 104, 105, 106, 0, 107, 108, 109, 0, 0,
-	0, 0, 0, 1441, 0, 110, 111, 0, 112, 113,
-	0, 114, 115, 116, 363, 364, 0, 365, 0, 366,
-	0, 117, 118, 119, 120, 121, 0, 0, 420, 122,
-	367, 368, 123, 0, 124, 125, 126, 127, 369, 0,
-	0, 0, 128, 129, 130, 131, 132, 0, 0, 133,
-	134, 135, 0, 136, 137, 138, 139, 140, 141, 0,
-	0, 142, 143, 144, 0, 0, 0, 0, 0, 0,
-	0, 145, 146, 147, 148, 149, 370, 150, 151, 371,
-	372, 152, 0, 153, 0, 154, 155, 156, 157, 158,
-	0, 159, 160, 161, 0, 0, 162, 163, 164, 165,
-	166, 0, 167, 168, 169, 0, 170, 171, 172, 0,
-	173, 174, 175, 176, 373, 177, 178, 179, 374, 0,
-	180, 0, 181, 182, 375, 183, 0, 184, 0, 185,
-	0, 0, 0, 186, 187, 188, 0, 189, 190, 376,
-	0, 377, 191, 0, 192, 193, 194, 195, 196, 197,
-	198, 199, 200, 0, 201, 202, 203, 204, 205, 206,
-	0, 207, 0, 378, 208, 209, 210, 211, 379, 380,
-	0, 381, 0, 212, 0, 213, 0, 214, 215, 216,
-	217, 218, 0, 0, 219, 382, 0, 220, 0, 0,
-	221, 222, 421, 0, 0, 223, 224, 225, 226, 227,
-	228, 229, 230, 231, 232, 233, 234, 235, 236, 422,
-	383, 0, 384, 237, 238, 385, 0, 239, 240, 241,
-	0, 386, 242, 387, 243, 244, 245, 0, 246, 0,
-	0, 247, 248, 0, 0, 249, 388, 0, 250, 0,
-	389, 251, 252, 253, 254, 255, 256, 257, 0, 258,
-	259, 390, 260, 391, 263, 261, 262, 0, 264, 265,
-	266, 267, 268, 269, 270, 271, 392, 272, 273, 274,
-	275, 0, 276, 277, 278, 279, 280, 281, 282, 283,
-	284, 285, 286, 0, 287, 288, 0, 289, 290, 291,
-	393, 292, 293, 294, 295, 296, 297, 298, 299, 0,
-	300, 301, 302, 303, 423, 0, 304, 305, 394, 306,
-	307, 0, 308, 309, 395, 310, 0, 311, 312, 313,
-	314, 315, 316, 317, 318, 319, 320, 321, 396, 0,
-	322, 323, 0, 324, 0, 325, 326, 327, 328, 329,
-	0, 425, 397, 0, 0, 424, 330, 398, 331, 399,
-	0, 332, 333, 334, 335, 336, 337, 338, 0, 0,
-	339, 340, 341, 342, 343, 0, 0, 344, 345, 346,
-	347, 348, 400, 401, 0, 349, 0, 350, 351, 352,
-	353, 96, 0, 354, 0, 0, 355, 356, 357, 358,
-	359, 360, 361, 362, 99, 100, 101, 102, 103, 104,
+	0, 0, 0, 0, 0, 110, 111, 0, 112, 113,
+	0, 114, 115, 116, 366, 367, 368, 0, 369, 0,
+	370, 0, 117, 118, 119, 120, 121, 122, 123, 0,
+	0, 426, 124, 371, 372, 125, 1061, 126, 127, 128,
+	129, 373, 0, 0, 1056, 130, 131, 132, 133, 134,
+	0, 0, 135, 136, 137, 1059, 138, 139, 140, 141,
+	142, 143, 0, 0, 144, 145, 146, 0, 0, 0,
+	0, 0, 0, 0, 147, 148, 149, 150, 151, 374,
+	152, 153, 375, 376, 154, 0, 155, 0, 156, 157,
+	158, 159, 160, 0, 161, 162, 163, 0, 0, 164,
+	165, 166, 167, 168, 0, 169, 170, 171, 0, 172,
+	173, 174, 0, 1065, 176, 177, 178, 377, 179, 180,
+	181, 378, 0, 182, 0, 183, 184, 379, 185, 0,
+	186, 1066, 187, 0, 0, 0, 188, 189, 190, 0,
+	191, 192, 380, 0, 381, 193, 0, 194, 195, 196,
+	197, 198, 0, 199, 200, 201, 202, 0, 203, 204,
+	1063, 206, 207, 208, 0, 209, 0, 382, 210, 211,
+	212, 213, 383, 384, 0, 385, 0, 214, 0, 215,
+	0, 216, 217, 218, 219, 220, 0, 0, 221, 386,
+	0, 222, 0, 0, 223, 224, 427, 0, 0, 225,
+	226, 227, 228, 229, 230, 231, 232, 233, 234, 235,
+	236, 237, 238, 428, 387, 0, 388, 239, 240, 389,
+	0, 241, 242, 243, 0, 390, 244, 391, 245, 246,
+	247, 0, 248, 0, 0, 249, 250, 0, 0, 251,
+	392, 0, 252, 0, 393, 253, 254, 255, 256, 257,
+	258, 259, 0, 260, 261, 394, 262, 395, 265, 263,
+	264, 1064, 266, 267, 268, 269, 270, 271, 272, 273,
+	396, 274, 275, 276, 277, 0, 278, 279, 280, 281,
+	282, 283, 284, 285, 286, 287, 288, 0, 289, 290,
+	0, 291, 292, 293, 397, 294, 295, 296, 297, 298,
+	299, 300, 301, 0, 302, 303, 398, 304, 305, 429,
+	0, 306, 307, 399, 308, 309, 0, 310, 311, 400,
+	401, 312, 0, 313, 314, 315, 316, 317, 318, 319,
+	320, 321, 322, 323, 402, 0, 324, 325, 0, 326,
+	0, 327, 328, 329, 330, 331, 0, 431, 403, 0,
+	0, 430, 332, 404, 333, 405, 0, 334, 335, 336,
+	337, 338, 339, 340, 0, 1062, 341, 342, 343, 344,
+	345, 346, 0, 0, 347, 348, 349, 350, 351, 406,
+	407, 0, 352, 0, 353, 354, 355, 356, 96, 0,
+	357, 0, 0, 358, 359, 360, 361, 362, 363, 364,
+	365, 0, 0, 0, 99, 100, 101, 102, 103, 104,
 	105, 106, 0, 107, 108, 109, 0, 0, 0, 0,
-	0, 1445, 0, 110, 111, 0, 112, 113, 0, 114,
-	115, 116, 363, 364, 0, 365, 0, 366, 0, 117,
-	118, 119, 120, 121, 0, 0, 420, 122, 367, 368,
-	123, 0, 124, 125, 126, 127, 369, 0, 0, 0,
-	128, 129, 130, 131, 132, 0, 0, 133, 134, 135,
-	0, 136, 137, 138, 139, 140, 141, 0, 0, 142,
-	143, 144, 0, 0, 0, 0, 0, 0, 0, 145,
-	146, 147, 148, 149, 370, 150, 151, 371, 372, 152,
-	0, 153, 0, 154, 155, 156, 157, 158, 0, 159,
-	160, 161, 0, 0, 162, 163, 164, 165, 166, 0,
-	167, 168, 169, 0, 170, 171, 172, 0, 173, 174,
-	175, 176, 373, 177, 178, 179, 374, 0, 180, 0,
-	181, 182, 375, 183, 0, 184, 0, 185, 0, 0,
-	0, 186, 187, 188, 0, 189, 190, 376, 0, 377,
-	191, 0, 192, 193, 194, 195, 196, 197, 198, 199,
-	200, 0, 201, 202, 203, 204, 205, 206, 0, 207,
-	0, 378, 208, 209, 210, 211, 379, 380, 0, 381,
-	0, 212, 0, 213, 0, 214, 215, 216, 217, 218,
-	0, 0, 219, 382, 0, 220, 0, 0, 221, 222,
-	421, 0, 0, 223, 224, 225, 226, 227, 228, 229,
-	230, 231, 232, 233, 234, 235, 236, 422, 383, 0,
-	384, 237, 238, 385, 0, 239, 240, 241, 0, 386,
-	242, 387, 243, 244, 245, 0, 246, 0, 0, 247,
-	248, 0, 0, 249, 388, 0, 250, 0, 389, 251,
-	252, 253, 254, 255, 256, 257, 0, 258, 259, 390,
-	260, 391, 263, 261, 262, 0, 264, 265, 266, 267,
-	268, 269, 270, 271, 392, 272, 273, 274, 275, 0,
-	276, 277, 278, 279, 280, 281, 282, 283, 284, 285,
-	286, 0, 287, 288, 0, 289, 290, 291, 393, 292,
-	293, 294, 295, 296, 297, 298, 299, 0, 300, 301,
-	302, 303, 423, 0, 304, 305, 394, 306, 307, 0,
-	308, 309, 395, 310, 0, 311, 312, 313, 314, 315,
-	316, 317, 318, 319, 320, 321, 396, 0, 322, 323,
-	0, 324, 0, 325, 326, 327, 328, 329, 0, 425,
-	397, 0, 0, 424, 330, 398, 331, 399, 0, 332,
-	333, 334, 335, 336, 337, 338, 0, 0, 339, 340,
-	341, 342, 343, 0, 0, 344, 345, 346, 347, 348,
-	400, 401, 0, 349, 0, 350, 351, 352, 353, 96,
-	0, 354, 0, 0, 355, 356, 357, 358, 359, 360,
-	361, 362, 99, 100, 101, 102, 103, 104, 105, 106,
+	0, 0, 0, 110, 111, 0, 112, 113, 0, 114,
+	115, 116, 366, 367, 368, 0, 369, 0, 370, 0,
+	117, 118, 119, 120, 121, 122, 123, 0, 0, 426,
+	124, 371, 372, 125, 1061, 126, 127, 128, 129, 373,
+	0, 0, 0, 130, 131, 132, 133, 134, 0, 0,
+	135, 136, 137, 1059, 138, 139, 140, 141, 142, 143,
+	0, 0, 144, 145, 146, 0, 0, 0, 0, 0,
+	0, 0, 147, 148, 149, 150, 151, 374, 152, 153,
+	375, 376, 154, 0, 155, 0, 156, 157, 158, 159,
+	160, 0, 161, 162, 163, 0, 0, 164, 165, 166,
+	167, 168, 0, 169, 170, 171, 0, 172, 173, 174,
+	0, 1065, 176, 177, 178, 377, 179, 180, 181, 378,
+	0, 182, 0, 183, 184, 379, 185, 0, 186, 1066,
+	187, 0, 0, 0, 188, 189, 190, 0, 191, 192,
+	380, 0, 381, 193, 0, 194, 195, 196, 197, 198,
+	0, 199, 200, 201, 202, 0, 203, 204, 1063, 206,
+	207, 208, 0, 209, 0, 382, 210, 211, 212, 213,
+	383, 384, 0, 385, 0, 214, 0, 215, 0, 216,
+	217, 218, 219, 220, 0, 0, 221, 386, 0, 222,
+	0, 0, 223, 224, 427, 0, 0, 225, 226, 227,
+	228, 229, 230, 231, 232, 233, 234, 235, 236, 237,
+	238, 428, 387, 0, 388, 239, 240, 389, 0, 241,
+	242, 243, 0, 390, 244, 391, 245, 246, 247, 0,
+	248, 0, 0, 249, 250, 0, 0, 251, 392, 0,
+	252, 0, 393, 253, 254, 255, 256, 257, 258, 259,
+	0, 260, 261, 394, 262, 395, 265, 263, 264, 1064,
+	266, 267, 268, 269, 270, 271, 272, 273, 396, 274,
+	275, 276, 277, 0, 278, 279, 280, 281, 282, 283,
+	284, 285, 286, 287, 288, 0, 289, 290, 0, 291,
+	292, 293, 397, 294, 295, 296, 297, 298, 299, 300,
+	301, 0, 302, 303, 398, 304, 305, 429, 0, 306,
+	307, 399, 308, 309, 0, 310, 311, 400, 401, 312,
+	0, 313, 314, 315, 316, 317, 318, 319, 320, 321,
+	322, 323, 402, 0, 324, 325, 0, 326, 0, 327,
+	328, 329, 330, 331, 0, 431, 403, 0, 0, 430,
+	332, 404, 333, 405, 0, 334, 335, 336, 337, 338,
+	339, 340, 0, 1062, 341, 342, 343, 344, 345, 346,
+	0, 0, 347, 348, 349, 350, 351, 406, 407, 0,
+	352, 0, 353, 354, 355, 356, 96, 0, 357, 0,
+	0, 358, 359, 360, 361, 362, 363, 364, 365, 0,
+	0, 0, 99, 100, 101, 102, 103, 104, 105, 106,
 	0, 107, 108, 109, 0, 0, 0, 0, 0, 0,
 	0, 110, 111, 0, 112, 113, 0, 114, 115, 116,
-	363, 364, 0, 365, 0, 366, 0, 117, 118, 119,
-	120, 121, 0, 0, 420, 122, 367, 368, 123, 0,
-	124, 125, 126, 127, 369, 0, 0, 0, 128, 129,
-	130, 131, 132, 0, 0, 133, 134, 135, 0, 136,
-	137, 138, 139, 140, 141, 0, 0, 142, 143, 144,
-	0, 0, 0, 0, 0, 0, 0, 145, 146, 147,
-	148, 149, 370, 150, 151, 371, 372, 152, 0, 153,
-	0, 154, 155, 156, 157, 158, 0, 159, 160, 161,
-	0, 0, 162, 163, 164, 165, 166, 0, 167, 168,
-	169, 0, 170, 171, 172, 0, 173, 174, 175, 176,
-	373, 177, 178, 179, 374, 0, 180, 0, 181, 182,
-	375, 183, 0, 184, 0, 185, 0, 0, 0, 186,
-	187, 188, 0, 189, 190, 376, 0, 377, 191, 0,
-	192, 193, 194, 195, 196, 197, 198, 199, 200, 0,
-	201, 202, 203, 204, 205, 206, 0, 207, 0, 378,
-	208, 209, 210, 211, 379, 380, 0, 381, 0, 212,
-	0, 213, 0, 214, 215, 216, 217, 218, 0, 0,
-	219, 382, 0, 220, 0, 0, 221, 222, 421, 0,
-	0, 223, 224, 225, 226, 227, 228, 229, 230, 231,
-	232, 233, 234, 235, 236, 422, 383, 0, 384, 237,
-	238, 385, 0, 239, 240, 241, 0, 386, 242, 387,
-	243, 244, 245, 0, 246, 0, 464, 247, 248, 0,
-	0, 249, 388, 0, 250, 0, 389, 251, 252, 253,
-	254, 255, 256, 257, 0, 258, 259, 390, 260, 391,
-	263, 261, 262, 0, 264, 265, 266, 267, 268, 269,
-	270, 271, 392, 272, 273, 274, 275, 0, 276, 277,
-	278, 279, 280, 281, 282, 283, 284, 285, 286, 0,
-	287, 288, 0, 289, 290, 291, 393, 292, 293, 294,
-	295, 296, 297, 298, 299, 0, 300, 301, 302, 303,
-	423, 0, 304, 305, 394, 306, 307, 0, 308, 309,
-	395, 310, 0, 311, 312, 313, 314, 315, 316, 317,
-	318, 319, 320, 321, 396, 0, 322, 323, 0, 324,
-	0, 325, 326, 327, 328, 329, 0, 425, 397, 0,
-	0, 424, 330, 398, 331, 399, 0, 332, 333, 334,
-	335, 336, 337, 338, 0, 0, 339, 340, 341, 342,
-	343, 0, 0, 344, 345, 346, 347, 348, 400, 401,
-	0, 349, 0, 350, 351, 352, 353, 96, 0, 354,
-	0, 0, 355, 356, 357, 358, 359, 360, 361, 362,
+	366, 367, 368, 0, 369, 0, 370, 0, 117, 118,
+	119, 120, 121, 122, 123, 0, 0, 426, 124, 371,
+	372, 125, 0, 126, 127, 128, 129, 373, 0, 0,
+	0, 130, 131, 132, 133, 134, 0, 0, 135, 136,
+	137, 0, 138, 139, 140, 141, 142, 143, 0, 0,
+	144, 145, 146, 0, 0, 0, 0, 0, 0, 0,
+	147, 148, 149, 150, 151, 374, 152, 153, 375, 376,
+	154, 0, 155, 0, 156, 157, 158, 159, 160, 0,
+	161, 162, 163, 0, 0, 164, 165, 166, 167, 168,
+	0, 169, 170, 171, 0, 172, 173, 174, 0, 175,
+	176, 177, 178, 377, 179, 180, 181, 378, 0, 182,
+	0, 183, 184, 379, 185, 0, 186, 0, 187, 0,
+	0, 0, 188, 189, 190, 0, 191, 192, 380, 0,
+	381, 193, 0, 194, 195, 196, 197, 198, 0, 199,
+	200, 201, 202, 0, 203, 204, 205, 206, 207, 208,
+	0, 209, 0, 382, 210, 211, 212, 213, 383, 384,
+	0, 385, 0, 214, 0, 215, 0, 216, 217, 218,
+	219, 220, 0, 0, 221, 386, 0, 222, 0, 0,
+	223, 224, 427, 0, 0, 225, 226, 227, 228, 229,
+	230, 231, 232, 233, 234, 235, 236, 237, 238, 428,
+	387, 0, 388, 239, 240, 389, 0, 241, 242, 243,
+	0, 390, 244, 391, 245, 246, 247, 0, 248, 0,
+	0, 249, 250, 0, 0, 251, 392, 0, 252, 0,
+	393, 253, 254, 255, 256, 257, 258, 259, 0, 260,
+	261, 394, 262, 395, 265, 263, 264, 0, 266, 267,
+	268, 269, 270, 271, 272, 273, 396, 274, 275, 276,
+	277, 0, 278, 279, 280, 281, 282, 283, 284, 285,
+	286, 287, 288, 0, 289, 290, 0, 291, 292, 293,
+	397, 294, 295, 296, 297, 298, 299, 300, 301, 0,
+	302, 303, 398, 304, 305, 429, 0, 306, 307, 399,
+	308, 309, 0, 310, 311, 400, 401, 312, 0, 313,
+	314, 315, 316, 317, 318, 319, 320, 321, 322, 323,
+	402, 0, 324, 325, 0, 326, 0, 327, 328, 329,
+	330, 331, 0, 431, 403, 0, 0, 430, 332, 404,
+	333, 405, 0, 334, 335, 336, 337, 338, 339, 340,
+	0, 0, 341, 342, 343, 344, 345, 346, 0, 2037,
+	347, 348, 349, 350, 351, 406, 407, 0, 352, 0,
+	353, 354, 355, 356, 96, 0, 357, 0, 0, 358,
+	359, 360, 361, 362, 363, 364, 365, 0, 0, 0,
 	99, 100, 101, 102, 103, 104, 105, 106, 0, 107,
-	108, 109, 0, 0, 0, 0, 0, 0, 0, 110,
-	111, 0, 112, 113, 0, 114, 115, 116, 363, 364,
-	0, 365, 0, 366, 0, 117, 118, 119, 120, 121,
-	0, 0, 420, 122, 367, 368, 123, 0, 124, 125,
-	126, 127, 369, 0, 0, 0, 128, 129, 130, 131,
-	132, 0, 0, 133, 134, 135, 0, 136, 137, 138,
-	139, 140, 141, 0, 0, 142, 143, 144, 0, 0,
-	0, 0, 0, 0, 0, 145, 146, 147, 732, 149,
-	370, 150, 151, 371, 372, 152, 0, 153, 0, 154,
-	155, 156, 157, 158, 0, 159, 160, 161, 0, 0,
-	162, 163, 164, 165, 166, 0, 167, 168, 169, 0,
-	170, 171, 172, 0, 173, 174, 175, 176, 373, 177,
-	178, 179, 374, 0, 180, 0, 181, 182, 375, 183,
-	0, 184, 0, 185, 0, 0, 0, 186, 187, 188,
-	0, 189, 190, 376, 0, 377, 191, 0, 192, 193,
-	194, 195, 196, 197, 198, 199, 200, 0, 201, 202,
-	203, 204, 205, 206, 0, 207, 0, 378, 208, 209,
-	210, 211, 379, 380, 0, 381, 0, 212, 0, 213,
-	0, 214, 215, 216, 217, 218, 0, 0, 219, 382,
-	0, 220, 0, 0, 221, 222, 421, 0, 0, 223,
-	224, 225, 226, 227, 228, 229, 230, 231, 232, 233,
-	234, 235, 236, 422, 383, 0, 384, 237, 238, 385,
-	0, 239, 240, 241, 0, 386, 242, 387, 243, 244,
-	245, 0, 246, 0, 0, 247, 248, 0, 0, 249,
-	388, 0, 250, 0, 389, 251, 252, 253, 254, 255,
-	256, 257, 0, 258, 259, 390, 260, 391, 263, 261,
-	262, 0, 264, 265, 266, 267, 268, 269, 270, 271,
-	392, 272, 273, 274, 275, 0, 276, 277, 278, 279,
-	280, 281, 282, 283, 284, 285, 286, 0, 287, 288,
-	0, 289, 290, 291, 393, 292, 293, 294, 295, 296,
-	297, 298, 299, 0, 300, 301, 302, 303, 423, 0,
-	304, 305, 394, 306, 307, 0, 308, 309, 395, 310,
-	0, 311, 312, 313, 314, 315, 316, 317, 318, 319,
-	320, 321, 396, 0, 322, 323, 731, 324, 0, 325,
-	326, 327, 328, 329, 0, 425, 397, 0, 0, 424,
-	330, 398, 331, 399, 0, 332, 333, 334, 335, 336,
-	337, 338, 0, 0, 339, 340, 341, 342, 343, 0,
-	0, 344, 345, 346, 347, 348, 400, 401, 0, 349,
-	0, 350, 351, 352, 353, 96, 0, 354, 0, 0,
-	355, 356, 357, 358, 359, 360, 361, 362, 99, 100,
+	108, 109, 0, 0, 0, 0, 0, 1456, 0, 110,
+	111, 0, 112, 113, 0, 114, 115, 116, 366, 367,
+	368, 0, 369, 0, 370, 0, 117, 118, 119, 120,
+	121, 122, 123, 0, 0, 426, 124, 371, 372, 125,
+	0, 126, 127, 128, 129, 373, 0, 0, 0, 130,
+	131, 132, 133, 134, 0, 0, 135, 136, 137, 0,
+	138, 139, 140, 141, 142, 143, 0, 0, 144, 145,
+	146, 0, 0, 0, 0, 0, 0, 0, 147, 148,
+	149, 150, 151, 374, 152, 153, 375, 376, 154, 0,
+	155, 0, 156, 157, 158, 159, 160, 0, 161, 162,
+	163, 0, 0, 164, 165, 166, 167, 168, 0, 169,
+	170, 171, 0, 172, 173, 174, 0, 175, 176, 177,
+	178, 377, 179, 180, 181, 378, 0, 182, 0, 183,
+	184, 379, 185, 0, 186, 0, 187, 0, 0, 0,
+	188, 189, 190, 0, 191, 192, 380, 0, 381, 193,
+	0, 194, 195, 196, 197, 198, 0, 199, 200, 201,
+	202, 0, 203, 204, 205, 206, 207, 208, 0, 209,
+	0, 382, 210, 211, 212, 213, 383, 384, 0, 385,
+	0, 214, 0, 215, 0, 216, 217, 218, 219, 220,
+	0, 0, 221, 386, 0, 222, 0, 0, 223, 224,
+	427, 0, 0, 225, 226, 227, 228, 229, 230, 231,
+	232, 233, 234, 235, 236, 237, 238, 428, 387, 0,
+	388, 239, 240, 389, 0, 241, 242, 243, 0, 390,
+	244, 391, 245, 246, 247, 0, 248, 0, 0, 249,
+	250, 0, 0, 251, 392, 0, 252, 0, 393, 253,
+	254, 255, 256, 257, 258, 259, 0, 260, 261, 394,
+	262, 395, 265, 263, 264, 0, 266, 267, 268, 269,
+	270, 271, 272, 273, 396, 274, 275, 276, 277, 0,
+	278, 279, 280, 281, 282, 283, 284, 285, 286, 287,
+	288, 0, 289, 290, 0, 291, 292, 293, 397, 294,
+	295, 296, 297, 298, 299, 300, 301, 0, 302, 303,
+	398, 304, 305, 429, 0, 306, 307, 399, 308, 309,
+	0, 310, 311, 400, 401, 312, 0, 313, 314, 315,
+	316, 317, 318, 319, 320, 321, 322, 323, 402, 0,
+	324, 325, 0, 326, 0, 327, 328, 329, 330, 331,
+	0, 431, 403, 0, 0, 430, 332, 404, 333, 405,
+	0, 334, 335, 336, 337, 338, 339, 340, 0, 0,
+	341, 342, 343, 344, 345, 346, 0, 0, 347, 348,
+	349, 350, 351, 406, 407, 0, 352, 0, 353, 354,
+	355, 356, 96, 0, 357, 0, 0, 358, 359, 360,
+	361, 362, 363, 364, 365, 0, 0, 0, 99, 100,
 	101, 102, 103, 104, 105, 106, 0, 107, 108, 109,
-	0, 0, 0, 0, 0, 0, 0, 110, 111, 0,
-	112, 113, 0, 114, 115, 116, 363, 364, 0, 365,
-	0, 366, 0, 117, 118, 119, 120, 121, 0, 0,
-	420, 122, 367, 368, 123, 0, 124, 125, 126, 127,
-	369, 0, 0, 0, 128, 129, 130, 131, 132, 0,
-	0, 133, 134, 135, 0, 136, 137, 138, 139, 140,
-	141, 0, 0, 142, 143, 144, 0, 0, 0, 0,
-	0, 0, 0, 145, 146, 147, 148, 149, 370, 150,
-	151, 371, 372, 152, 0, 153, 0, 154, 155, 156,
-	157, 158, 0, 159, 160, 161, 0, 0, 162, 163,
-	164, 165, 166, 0, 167, 168, 169, 0, 170, 171,
-	172, 0, 173, 174, 175, 176, 373, 177, 178, 179,
-	374, 0, 180, 0, 181, 182, 375, 183, 0, 184,
-	0, 185, 0, 0, 0, 186, 187, 188, 0, 189,
-	190, 376, 0, 377, 191, 0, 192, 193, 194, 195,
-	517, 197, 198, 199, 200, 0, 201, 202, 203, 204,
-	205, 206, 0, 207, 0, 378, 208, 209, 210, 211,
-	379, 380, 0, 381, 0, 212, 0, 213, 0, 214,
-	215, 216, 217, 218, 0, 0, 219, 382, 0, 220,
-	0, 0, 221, 222, 421, 0, 0, 223, 224, 225,
-	226, 227, 228, 229, 230, 231, 232, 233, 234, 235,
-	236, 422, 383, 0, 384, 237, 238, 385, 0, 239,
-	240, 241, 0, 386, 242, 387, 243, 244, 245, 0,
-	246, 0, 464, 247, 248, 0, 0, 249, 388, 0,
-	250, 0, 389, 251, 252, 253, 254, 255, 256, 257,
-	0, 258, 259, 390, 260, 391, 263, 261, 262, 0,
-	264, 265, 266, 267, 268, 269, 270, 271, 392, 272,
-	273, 274, 275, 0, 276, 277, 278, 279, 280, 281,
-	282, 283, 284, 285, 286, 0, 287, 288, 0, 289,
-	290, 291, 393, 292, 293, 294, 295, 296, 297, 298,
-	299, 0, 300, 301, 302, 303, 423, 0, 304, 305,
-	394, 306, 307, 0, 308, 309, 395, 310, 0, 311,
-	312, 313, 314, 315, 316, 317, 318, 319, 320, 321,
-	396, 0, 322, 323, 0, 324, 0, 325, 326, 327,
-	328, 329, 0, 425, 397, 0, 0, 424, 330, 398,
-	331, 399, 0, 332, 333, 334, 335, 336, 337, 338,
-	0, 0, 339, 340, 341, 342, 343, 0, 0, 344,
-	345, 346, 347, 348, 400, 401, 0, 349, 0, 350,
-	351, 352, 353, 96, 0, 354, 0, 0, 355, 356,
-	357, 358, 359, 360, 361, 362, 99, 100, 101, 102,
+	0, 0, 0, 0, 0, 1460, 0, 110, 111, 0,
+	112, 113, 0, 114, 115, 116, 366, 367, 368, 0,
+	369, 0, 370, 0, 117, 118, 119, 120, 121, 122,
+	123, 0, 0, 426, 124, 371, 372, 125, 0, 126,
+	127, 128, 129, 373, 0, 0, 0, 130, 131, 132,
+	133, 134, 0, 0, 135, 136, 137, 0, 138, 139,
+	140, 141, 142, 143, 0, 0, 144, 145, 146, 0,
+	0, 0, 0, 0, 0, 0, 147, 148, 149, 150,
+	151, 374, 152, 153, 375, 376, 154, 0, 155, 0,
+	156, 157, 158, 159, 160, 0, 161, 162, 163, 0,
+	0, 164, 165, 166, 167, 168, 0, 169, 170, 171,
+	0, 172, 173, 174, 0, 175, 176, 177, 178, 377,
+	179, 180, 181, 378, 0, 182, 0, 183, 184, 379,
+	185, 0, 186, 0, 187, 0, 0, 0, 188, 189,
+	190, 0, 191, 192, 380, 0, 381, 193, 0, 194,
+	195, 196, 197, 198, 0, 199, 200, 201, 202, 0,
+	203, 204, 205, 206, 207, 208, 0, 209, 0, 382,
+	210, 211, 212, 213, 383, 384, 0, 385, 0, 214,
+	0, 215, 0, 216, 217, 218, 219, 220, 0, 0,
+	221, 386, 0, 222, 0, 0, 223, 224, 427, 0,
+	0, 225, 226, 227, 228, 229, 230, 231, 232, 233,
+	234, 235, 236, 237, 238, 428, 387, 0, 388, 239,
+	240, 389, 0, 241, 242, 243, 0, 390, 244, 391,
+	245, 246, 247, 0, 248, 0, 0, 249, 250, 0,
+	0, 251, 392, 0, 252, 0, 393, 253, 254, 255,
+	256, 257, 258, 259, 0, 260, 261, 394, 262, 395,
+	265, 263, 264, 0, 266, 267, 268, 269, 270, 271,
+	272, 273, 396, 274, 275, 276, 277, 0, 278, 279,
+	280, 281, 282, 283, 284, 285, 286, 287, 288, 0,
+	289, 290, 0, 291, 292, 293, 397, 294, 295, 296,
+	297, 298, 299, 300, 301, 0, 302, 303, 398, 304,
+	305, 429, 0, 306, 307, 399, 308, 309, 0, 310,
+	311, 400, 401, 312, 0, 313, 314, 315, 316, 317,
+	318, 319, 320, 321, 322, 323, 402, 0, 324, 325,
+	0, 326, 0, 327, 328, 329, 330, 331, 0, 431,
+	403, 0, 0, 430, 332, 404, 333, 405, 0, 334,
+	335, 336, 337, 338, 339, 340, 0, 0, 341, 342,
+	343, 344, 345, 346, 0, 0, 347, 348, 349, 350,
+	351, 406, 407, 0, 352, 0, 353, 354, 355, 356,
+	96, 0, 357, 0, 0, 358, 359, 360, 361, 362,
+	363, 364, 365, 0, 0, 0, 99, 100, 101, 102,
 	103, 104, 105, 106, 0, 107, 108, 109, 0, 0,
 	0, 0, 0, 0, 0, 110, 111, 0, 112, 113,
-	0, 114, 115, 116, 363, 364, 0, 365, 0, 366,
-	0, 117, 118, 119, 120, 121, 0, 0, 420, 122,
-	367, 368, 123, 0, 124, 125, 126, 127, 369, 0,
-	0, 0, 128, 129, 130, 131, 132, 0, 0, 133,
-	134, 135, 0, 136, 137, 138, 139, 140, 141, 0,
-	0, 142, 143, 144, 0, 0, 0, 0, 0, 0,
-	0, 145, 146, 147, 148, 149, 370, 150, 151, 371,
-	372, 152, 0, 153, 0, 154, 155, 156, 157, 158,
-	0, 159, 160, 161, 0, 0, 162, 163, 164, 165,
-	166, 0, 167, 168, 169, 0, 170, 171, 172, 0,
-	173, 174, 175, 176, 373, 177, 178, 179, 374, 0,
-	180, 0, 181, 182, 375, 183, 0, 184, 0, 185,
-	0, 0, 0, 186, 187, 188, 0, 189, 190, 376,
-	0, 377, 191, 0, 192, 193, 194, 195, 196, 197,
-	198, 199, 200, 0, 201, 202, 203, 204, 205, 206,
-	0, 207, 0, 378, 208, 209, 210, 211, 379, 380,
-	0, 381, 0, 212, 0, 213, 0, 214, 215, 216,
-	217, 218, 0, 0, 219, 382, 0, 220, 0, 0,
-	221, 222, 421, 0, 0, 223, 224, 225, 226, 227,
-	228, 229, 230, 231, 232, 233, 234, 235, 236, 422,
-	383, 0, 384, 237, 238, 385, 0, 239, 240, 241,
-	0, 386, 242, 387, 243, 244, 245, 0, 246, 0,
-	0, 247, 248, 0, 0, 249, 388, 0, 250, 0,
-	389, 251, 252, 253, 254, 255, 256, 257, 0, 258,
-	259, 390, 260, 391, 263, 261, 262, 0, 264, 265,
-	266, 267, 268, 269, 270, 271, 392, 272, 273, 274,
-	275, 0, 276, 277, 278, 279, 280, 281, 282, 283,
-	284, 285, 286, 0, 287, 288, 0, 289, 290, 291,
-	393, 292, 293, 294, 295, 296, 297, 298, 299, 0,
-	300, 301, 302, 303, 423, 0, 304, 305, 394, 306,
-	307, 0, 308, 309, 395, 310, 0, 311, 312, 313,
-	314, 315, 316, 317, 318, 319, 320, 321, 396, 0,
-	322, 323, 0, 324, 0, 325, 326, 327, 328, 329,
-	0, 425, 397, 0, 0, 424, 330, 398, 331, 399,
-	0, 332, 333, 334, 335, 336, 337, 338, 0, 0,
-	339, 340, 341, 342, 343, 0, 0, 344, 345, 346,
-	347, 348, 400, 401, 0, 349, 0, 350, 351, 352,
-	353, 96, 0, 354, 0, 0, 355, 356, 357, 358,
-	359, 360, 361, 362, 99, 100, 101, 102, 103, 104,
+	0, 114, 115, 116, 366, 367, 368, 0, 369, 0,
+	370, 0, 117, 118, 119, 120, 121, 122, 123, 0,
+	0, 426, 124, 371, 372, 125, 0, 126, 127, 128,
+	129, 373, 0, 0, 0, 130, 131, 132, 133, 134,
+	0, 0, 135, 136, 137, 0, 138, 139, 140, 141,
+	142, 143, 0, 0, 144, 145, 146, 0, 0, 0,
+	0, 0, 0, 0, 147, 148, 149, 150, 151, 374,
+	152, 153, 375, 376, 154, 0, 155, 0, 156, 157,
+	158, 159, 160, 0, 161, 162, 163, 0, 0, 164,
+	165, 166, 167, 168, 0, 169, 170, 171, 0, 172,
+	173, 174, 0, 175, 176, 177, 178, 377, 179, 180,
+	181, 378, 0, 182, 0, 183, 184, 379, 185, 0,
+	186, 0, 187, 0, 0, 0, 188, 189, 190, 0,
+	191, 192, 380, 0, 381, 193, 0, 194, 195, 196,
+	197, 198, 0, 199, 200, 201, 202, 0, 203, 204,
+	205, 206, 207, 208, 0, 209, 0, 382, 210, 211,
+	212, 213, 383, 384, 0, 385, 0, 214, 0, 215,
+	0, 216, 217, 218, 219, 220, 0, 0, 221, 386,
+	0, 222, 0, 0, 223, 224, 427, 0, 0, 225,
+	226, 227, 228, 229, 230, 231, 232, 233, 234, 235,
+	236, 237, 238, 428, 387, 0, 388, 239, 240, 389,
+	0, 241, 242, 243, 0, 390, 244, 391, 245, 246,
+	247, 0, 248, 0, 470, 249, 250, 0, 0, 251,
+	392, 0, 252, 0, 393, 253, 254, 255, 256, 257,
+	258, 259, 0, 260, 261, 394, 262, 395, 265, 263,
+	264, 0, 266, 267, 268, 269, 270, 271, 272, 273,
+	396, 274, 275, 276, 277, 0, 278, 279, 280, 281,
+	282, 283, 284, 285, 286, 287, 288, 0, 289, 290,
+	0, 291, 292, 293, 397, 294, 295, 296, 297, 298,
+	299, 300, 301, 0, 302, 303, 398, 304, 305, 429,
+	0, 306, 307, 399, 308, 309, 0, 310, 311, 400,
+	401, 312, 0, 313, 314, 315, 316, 317, 318, 319,
+	320, 321, 322, 323, 402, 0, 324, 325, 0, 326,
+	0, 327, 328, 329, 330, 331, 0, 431, 403, 0,
+	0, 430, 332, 404, 333, 405, 0, 334, 335, 336,
+	337, 338, 339, 340, 0, 0, 341, 342, 343, 344,
+	345, 346, 0, 0, 347, 348, 349, 350, 351, 406,
+	407, 0, 352, 0, 353, 354, 355, 356, 96, 0,
+	357, 0, 0, 358, 359, 360, 361, 362, 363, 364,
+	365, 0, 0, 0, 99, 100, 101, 102, 103, 104,
 	105, 106, 0, 107, 108, 109, 0, 0, 0, 0,
 	0, 0, 0, 110, 111, 0, 112, 113, 0, 114,
-	115, 116, 363, 364, 0, 365, 0, 366, 0, 117,
-	118, 119, 120, 121, 0, 0, 420, 122, 367, 368,
-	123, 0, 124, 125, 126, 127, 369, 0, 0, 0,
-	128, 129, 130, 131, 132, 0, 0, 133, 134, 135,
-	0, 136, 137, 138, 139, 140, 141, 0, 0, 142,
-	143, 144, 0, 0, 0, 0, 0, 0, 0, 145,
-	146, 147, 148, 149, 370, 150, 151, 371, 372, 152,
-	0, 153, 0, 154, 155, 156, 157, 158, 0, 159,
-	160, 161, 0, 0, 162, 163, 164, 165, 166, 0,
-	167, 168, 169, 0, 170, 171, 172, 0, 173, 174,
-	175, 176, 373, 177, 178, 179, 374, 0, 180, 0,
-	181, 182, 375, 183, 0, 184, 0, 185, 0, 0,
-	0, 186, 187, 188, 0, 189, 190, 376, 0, 377,
-	191, 0, 192, 193, 194, 195, 1358, 197, 198, 199,
-	200, 0, 201, 202, 203, 204, 205, 206, 0, 207,
-	0, 378, 208, 209, 210, 211, 379, 380, 0, 381,
-	0, 212, 0, 213, 0, 214, 215, 216, 217, 218,
-	0, 0, 219, 382, 0, 220, 0, 0, 221, 222,
-	421, 0, 0, 223, 224, 225, 226, 227, 228, 229,
-	230, 231, 232, 233, 234, 235, 236, 422, 383, 0,
-	384, 237, 238, 385, 0, 239, 240, 241, 0, 386,
-	242, 387, 243, 244, 245, 0, 246, 0, 0, 247,
-	248, 0, 0, 249, 388, 0, 250, 0, 389, 251,
-	252, 253, 254, 255, 256, 257, 0, 258, 259, 390,
-	260, 391, 263, 261, 262, 0, 264, 265, 266, 267,
-	268, 269, 270, 271, 392, 272, 273, 274, 275, 0,
-	276, 277, 278, 279, 280, 281, 282, 283, 284, 285,
-	286, 0, 287, 288, 0, 289, 290, 291, 393, 292,
-	293, 294, 295, 296, 297, 298, 299, 0, 300, 301,
-	302, 303, 423, 0, 304, 305, 394, 306, 307, 0,
-	308, 309, 395, 310, 0, 311, 312, 313, 314, 315,
-	316, 317, 318, 319, 320, 321, 396, 0, 322, 323,
-	0, 324, 0, 325, 326, 327, 328, 329, 0, 425,
-	397, 0, 0, 424, 330, 398, 331, 399, 0, 332,
-	333, 334, 335, 336, 337, 338, 0, 0, 339, 340,
-	341, 342, 343, 0, 0, 344, 345, 346, 347, 348,
-	400, 401, 0, 349, 0, 350, 351, 352, 353, 96,
-	0, 354, 0, 0, 355, 356, 357, 358, 359, 360,
-	361, 362, 99, 100, 101, 102, 103, 104, 105, 106,
+	115, 116, 366, 367, 368, 0, 369, 0, 370, 0,
+	117, 118, 119, 120, 121, 122, 123, 0, 0, 426,
+	124, 371, 372, 125, 0, 126, 127, 128, 129, 373,
+	0, 0, 0, 130, 131, 132, 133, 134, 0, 0,
+	135, 136, 137, 0, 138, 139, 140, 141, 142, 143,
+	0, 0, 144, 145, 146, 0, 0, 0, 0, 0,
+	0, 0, 147, 148, 149, 722, 151, 374, 152, 153,
+	375, 376, 154, 0, 155, 0, 156, 157, 158, 159,
+	160, 0, 161, 162, 163, 0, 0, 164, 165, 166,
+	167, 168, 0, 169, 170, 171, 0, 172, 173, 174,
+	0, 175, 176, 177, 178, 377, 179, 180, 181, 378,
+	0, 182, 0, 183, 184, 379, 185, 0, 186, 0,
+	187, 0, 0, 0, 188, 189, 190, 0, 191, 192,
+	380, 0, 381, 193, 0, 194, 195, 196, 197, 198,
+	0, 199, 200, 201, 202, 0, 203, 204, 205, 206,
+	207, 208, 0, 209, 0, 382, 210, 211, 212, 213,
+	383, 384, 0, 385, 0, 214, 0, 215, 0, 216,
+	217, 218, 219, 220, 0, 0, 221, 386, 0, 222,
+	0, 0, 223, 224, 427, 0, 0, 225, 226, 227,
+	228, 229, 230, 231, 232, 233, 234, 235, 236, 237,
+	238, 428, 387, 0, 388, 239, 240, 389, 0, 241,
+	242, 243, 0, 390, 244, 391, 245, 246, 247, 0,
+	248, 0, 0, 249, 250, 0, 0, 251, 392, 0,
+	252, 0, 393, 253, 254, 255, 256, 257, 258, 259,
+	0, 260, 261, 394, 262, 395, 265, 263, 264, 0,
+	266, 267, 268, 269, 270, 271, 272, 273, 396, 274,
+	275, 276, 277, 0, 278, 279, 280, 281, 282, 283,
+	284, 285, 286, 287, 288, 0, 289, 290, 0, 291,
+	292, 293, 397, 294, 295, 296, 297, 298, 299, 300,
+	301, 0, 302, 303, 398, 304, 305, 429, 0, 306,
+	307, 399, 308, 309, 0, 310, 311, 400, 401, 312,
+	0, 313, 314, 315, 316, 317, 318, 319, 320, 321,
+	322, 323, 402, 0, 324, 325, 721, 326, 0, 327,
+	328, 329, 330, 331, 0, 431, 403, 0, 0, 430,
+	332, 404, 333, 405, 0, 334, 335, 336, 337, 338,
+	339, 340, 0, 0, 341, 342, 343, 344, 345, 346,
+	0, 0, 347, 348, 349, 350, 351, 406, 407, 0,
+	352, 0, 353, 354, 355, 356, 96, 0, 357, 0,
+	0, 358, 359, 360, 361, 362, 363, 364, 365, 0,
+	0, 0, 99, 100, 101, 102, 103, 104, 105, 106,
 	0, 107, 108, 109, 0, 0, 0, 0, 0, 0,
 	0, 110, 111, 0, 112, 113, 0, 114, 115, 116,
-	363, 364, 0, 365, 0, 366, 0, 117, 118, 119,
-	120, 121, 0, 0, 420, 122, 367, 368, 123, 0,
-	124, 125, 126, 127, 369, 0, 0, 0, 128, 129,
-	130, 131, 132, 0, 0, 133, 134, 135, 0, 136,
-	137, 138, 139, 140, 141, 0, 0, 142, 143, 144,
-	0, 0, 0, 0, 0, 0, 0, 145, 146, 147,
-	148, 149, 370, 150, 151, 371, 372, 152, 0, 153,
-	0, 154, 155, 156, 157, 158, 0, 159, 160, 161,
-	0, 0, 162, 163, 164, 165, 166, 0, 167, 168,
-	169, 0, 170, 171, 172, 0, 173, 174, 175, 176,
-	373, 177, 178, 179, 374, 0, 180, 0, 181, 182,
-	375, 183, 0, 184, 0, 185, 0, 0, 0, 186,
-	187, 188, 0, 189, 190, 376, 0, 377, 191, 0,
-	192, 193, 194, 195, 1356, 197, 198, 199, 200, 0,
-	201, 202, 203, 204, 205, 206, 0, 207, 0, 378,
-	208, 209, 210, 211, 379, 380, 0, 381, 0, 212,
-	0, 213, 0, 214, 215, 216, 217, 218, 0, 0,
-	219, 382, 0, 220, 0, 0, 221, 222, 421, 0,
-	0, 223, 224, 225, 226, 227, 228, 229, 230, 231,
-	232, 233, 234, 235, 236, 422, 383, 0, 384, 237,
-	238, 385, 0, 239, 240, 241, 0, 386, 242, 387,
-	243, 244, 245, 0, 246, 0, 0, 247, 248, 0,
-	0, 249, 388, 0, 250, 0, 389, 251, 252, 253,
-	254, 255, 256, 257, 0, 258, 259, 390, 260, 391,
-	263, 261, 262, 0, 264, 265, 266, 267, 268, 269,
-	270, 271, 392, 272, 273, 274, 275, 0, 276, 277,
-	278, 279, 280, 281, 282, 283, 284, 285, 286, 0,
-	287, 288, 0, 289, 290, 291, 393, 292, 293, 294,
-	295, 296, 297, 298, 299, 0, 300, 301, 302, 303,
-	423, 0, 304, 305, 394, 306, 307, 0, 308, 309,
-	395, 310, 0, 311, 312, 313, 314, 315, 316, 317,
-	318, 319, 320, 321, 396, 0, 322, 323, 0, 324,
-	0, 325, 326, 327, 328, 329, 0, 425, 397, 0,
-	0, 424, 330, 398, 331, 399, 0, 332, 333, 334,
-	335, 336, 337, 338, 0, 0, 339, 340, 341, 342,
-	343, 0, 0, 344, 345, 346, 347, 348, 400, 401,
-	0, 349, 0, 350, 351, 352, 353, 96, 0, 354,
-	0, 0, 355, 356, 357, 358, 359, 360, 361, 362,
+	366, 367, 368, 0, 369, 0, 370, 0, 117, 118,
+	119, 120, 121, 122, 123, 0, 0, 426, 124, 371,
+	372, 125, 0, 126, 127, 128, 129, 373, 0, 0,
+	0, 130, 131, 132, 133, 134, 0, 0, 135, 136,
+	137, 0, 138, 139, 140, 141, 142, 143, 0, 0,
+	144, 145, 146, 0, 0, 0, 0, 0, 0, 0,
+	147, 148, 149, 150, 151, 374, 152, 153, 375, 376,
+	154, 0, 155, 0, 156, 157, 158, 159, 160, 0,
+	161, 162, 163, 0, 0, 164, 165, 166, 167, 168,
+	0, 169, 170, 171, 0, 172, 173, 174, 0, 175,
+	176, 177, 178, 377, 179, 180, 181, 378, 0, 182,
+	0, 183, 184, 379, 185, 0, 186, 0, 187, 0,
+	0, 0, 188, 189, 190, 0, 191, 192, 380, 0,
+	381, 193, 0, 194, 195, 196, 197, 524, 0, 199,
+	200, 201, 202, 0, 203, 204, 205, 206, 207, 208,
+	0, 209, 0, 382, 210, 211, 212, 213, 383, 384,
+	0, 385, 0, 214, 0, 215, 0, 216, 217, 218,
+	219, 220, 0, 0, 221, 386, 0, 222, 0, 0,
+	223, 224, 427, 0, 0, 225, 226, 227, 228, 229,
+	230, 231, 232, 233, 234, 235, 236, 237, 238, 428,
+	387, 0, 388, 239, 240, 389, 0, 241, 242, 243,
+	0, 390, 244, 391, 245, 246, 247, 0, 248, 0,
+	470, 249, 250, 0, 0, 251, 392, 0, 252, 0,
+	393, 253, 254, 255, 256, 257, 258, 259, 0, 260,
+	261, 394, 262, 395, 265, 263, 264, 0, 266, 267,
+	268, 269, 270, 271, 272, 273, 396, 274, 275, 276,
+	277, 0, 278, 279, 280, 281, 282, 283, 284, 285,
+	286, 287, 288, 0, 289, 290, 0, 291, 292, 293,
+	397, 294, 295, 296, 297, 298, 299, 300, 301, 0,
+	302, 303, 398, 304, 305, 429, 0, 306, 307, 399,
+	308, 309, 0, 310, 311, 400, 401, 312, 0, 313,
+	314, 315, 316, 317, 318, 319, 320, 321, 322, 323,
+	402, 0, 324, 325, 0, 326, 0, 327, 328, 329,
+	330, 331, 0, 431, 403, 0, 0, 430, 332, 404,
+	333, 405, 0, 334, 335, 336, 337, 338, 339, 340,
+	0, 0, 341, 342, 343, 344, 345, 346, 0, 0,
+	347, 348, 349, 350, 351, 406, 407, 0, 352, 0,
+	353, 354, 355, 356, 96, 0, 357, 0, 0, 358,
+	359, 360, 361, 362, 363, 364, 365, 0, 0, 0,
 	99, 100, 101, 102, 103, 104, 105, 106, 0, 107,
 	108, 109, 0, 0, 0, 0, 0, 0, 0, 110,
-	111, 0, 112, 113, 0, 114, 115, 116, 363, 364,
-	0, 365, 0, 366, 0, 117, 118, 119, 120, 121,
-	0, 0, 420, 122, 367, 368, 123, 0, 124, 125,
-	126, 127, 369, 0, 0, 0, 128, 129, 130, 131,
-	132, 0, 0, 133, 134, 135, 0, 136, 137, 138,
-	139, 140, 141, 0, 0, 142, 143, 144, 0, 0,
-	0, 0, 0, 0, 0, 145, 146, 147, 148, 149,
-	370, 150, 151, 371, 372, 152, 0, 153, 0, 154,
-	155, 156, 157, 158, 0, 159, 160, 161, 0, 0,
-	162, 163, 164, 165, 166, 0, 167, 168, 169, 0,
-	170, 171, 172, 0, 173, 174, 175, 176, 373, 177,
-	178, 179, 374, 0, 180, 0, 181, 182, 375, 183,
-	0, 184, 0, 185, 0, 0, 0, 186, 187, 188,
-	0, 189, 190, 376, 0, 377, 191, 0, 192, 193,
-	194, 195, 1112, 197, 198, 199, 200, 0, 201, 202,
-	203, 204, 205, 206, 0, 207, 0, 378, 208, 209,
-	210, 211, 379, 380, 0, 381, 0, 212, 0, 213,
-	0, 214, 215, 216, 217, 218, 0, 0, 219, 382,
-	0, 220, 0, 0, 221, 222, 421, 0, 0, 223,
-	224, 225, 226, 227, 228, 229, 230, 231, 232, 233,
-	234, 235, 236, 422, 383, 0, 384, 237, 238, 385,
-	0, 239, 240, 241, 0, 386, 242, 387, 243, 244,
-	245, 0, 246, 0, 0, 247, 248, 0, 0, 249,
-	388, 0, 250, 0, 389, 251, 252, 253, 254, 255,
-	256, 257, 0, 258, 259, 390, 260, 391, 263, 261,
-	262, 0, 264, 265, 266, 267, 268, 269, 270, 271,
-	392, 272, 273, 274, 275, 0, 276, 277, 278, 279,
-	280, 281, 282, 283, 284, 285, 286, 0, 287, 288,
-	0, 289, 290, 291, 393, 292, 293, 294, 295, 296,
-	297, 298, 299, 0, 300, 301, 302, 303, 423, 0,
-	304, 305, 394, 306, 307, 0, 308, 309, 395, 310,
-	0, 311, 312, 313, 314, 315, 316, 317, 318, 319,
-	320, 321, 396, 0, 322, 323, 0, 324, 0, 325,
-	326, 327, 328, 329, 0, 425, 397, 0, 0, 424,
-	330, 398, 331, 399, 0, 332, 333, 334, 335, 336,
-	337, 338, 0, 0, 339, 340, 341, 342, 343, 0,
-	0, 344, 345, 346, 347, 348, 400, 401, 0, 349,
-	0, 350, 351, 352, 353, 96, 0, 354, 0, 0,
-	355, 356, 357, 358, 359, 360, 361, 362, 99, 100,
+	111, 0, 112, 113, 0, 114, 115, 116, 366, 367,
+	368, 0, 369, 0, 370, 0, 117, 118, 119, 120,
+	121, 122, 123, 0, 0, 426, 124, 371, 372, 125,
+	0, 126, 127, 128, 129, 373, 0, 0, 0, 130,
+	131, 132, 133, 134, 0, 0, 135, 136, 137, 0,
+	138, 139, 140, 141, 142, 143, 0, 0, 144, 145,
+	146, 0, 0, 0, 0, 0, 0, 0, 147, 148,
+	149, 150, 151, 374, 152, 153, 375, 376, 154, 0,
+	155, 0, 156, 157, 158, 159, 160, 0, 161, 162,
+	163, 0, 0, 164, 165, 166, 167, 168, 0, 169,
+	170, 171, 0, 172, 173, 174, 0, 175, 176, 177,
+	178, 377, 179, 180, 181, 378, 0, 182, 0, 183,
+	184, 379, 185, 0, 186, 0, 187, 0, 0, 0,
+	188, 189, 190, 0, 191, 192, 380, 0, 381, 193,
+	0, 194, 195, 196, 197, 198, 0, 199, 200, 201,
+	202, 0, 203, 204, 205, 206, 207, 208, 0, 209,
+	0, 382, 210, 211, 212, 213, 383, 384, 0, 385,
+	0, 214, 0, 215, 0, 216, 217, 218, 219, 220,
+	0, 0, 221, 386, 0, 222, 0, 0, 223, 224,
+	427, 0, 0, 225, 226, 227, 228, 229, 230, 231,
+	232, 233, 234, 235, 236, 237, 238, 428, 387, 0,
+	388, 239, 240, 389, 0, 241, 242, 243, 0, 390,
+	244, 391, 245, 246, 247, 0, 248, 0, 0, 249,
+	250, 0, 0, 251, 392, 0, 252, 0, 393, 253,
+	254, 255, 256, 257, 258, 259, 0, 260, 261, 394,
+	262, 395, 265, 263, 264, 0, 266, 267, 268, 269,
+	270, 271, 272, 273, 396, 274, 275, 276, 277, 0,
+	278, 279, 280, 281, 282, 283, 284, 285, 286, 287,
+	288, 0, 289, 290, 0, 291, 292, 293, 397, 294,
+	295, 296, 297, 298, 299, 300, 301, 0, 302, 303,
+	398, 304, 305, 429, 0, 306, 307, 399, 308, 309,
+	0, 310, 311, 400, 401, 312, 0, 313, 314, 315,
+	316, 317, 318, 319, 320, 321, 322, 323, 402, 0,
+	324, 325, 0, 326, 0, 327, 328, 329, 330, 331,
+	0, 431, 403, 0, 0, 430, 332, 404, 333, 405,
+	0, 334, 335, 336, 337, 338, 339, 340, 0, 0,
+	341, 342, 343, 344, 345, 346, 0, 0, 347, 348,
+	349, 350, 351, 406, 407, 0, 352, 0, 353, 354,
+	355, 356, 1833, 0, 357, 0, 0, 358, 359, 360,
+	361, 362, 363, 364, 365, 0, 0, 0, 99, 100,
 	101, 102, 103, 104, 105, 106, 0, 107, 108, 109,
 	0, 0, 0, 0, 0, 0, 0, 110, 111, 0,
-	112, 113, 0, 114, 115, 116, 363, 364, 0, 365,
-	0, 366, 0, 117, 118, 119, 120, 121, 0, 0,
-	92, 122, 367, 368, 123, 0, 124, 125, 126, 127,
-	369, 0, 0, 0, 128, 129, 130, 131, 132, 0,
-	0, 133, 134, 135, 0, 136, 137, 138, 139, 140,
-	141, 0, 0, 142, 143, 144, 0, 0, 0, 0,
-	0, 0, 0, 145, 146, 147, 148, 149, 370, 150,
-	151, 371, 372, 152, 0, 153, 0, 154, 155, 156,
-	157, 158, 0, 159, 160, 161, 0, 0, 162, 163,
-	164, 165, 166, 0, 167, 168, 169, 0, 170, 171,
-	172, 0, 173, 174, 175, 176, 373, 177, 178, 179,
-	374, 0, 180, 0, 181, 182, 375, 183, 0, 184,
-	0, 185, 0, 0, 0, 186, 187, 188, 0, 189,
-	190, 376, 0, 377, 191, 0, 192, 193, 194, 195,
-	196, 197, 198, 199, 200, 0, 201, 202, 203, 204,
-	205, 206, 0, 207, 0, 378, 208, 209, 210, 211,
-	379, 380, 0, 381, 0, 212, 0, 213, 0, 214,
-	215, 216, 217, 218, 0, 0, 219, 382, 0, 220,
-	0, 0, 221, 222, 421, 0, 0, 223, 224, 225,
-	226, 227, 228, 229, 230, 231, 232, 233, 234, 235,
-	236, 93, 383, 0, 384, 237, 238, 385, 0, 239,
-	240, 241, 0, 386, 242, 387, 243, 244, 245, 0,
-	246, 0, 0, 247, 248, 0, 0, 249, 388, 0,
-	250, 0, 389, 251, 252, 253, 254, 255, 256, 257,
-	0, 258, 259, 390, 260, 391, 263, 261, 262, 0,
-	264, 265, 266, 267, 268, 269, 270, 271, 392, 272,
-	273, 274, 275, 0, 276, 277, 278, 279, 280, 281,
-	282, 283, 284, 285, 286, 0, 287, 288, 0, 289,
-	290, 291, 393, 292, 293, 294, 295, 296, 297, 298,
-	299, 0, 300, 301, 302, 303, 520, 0, 304, 305,
-	394, 306, 307, 0, 308, 309, 395, 310, 0, 311,
-	312, 313, 314, 315, 316, 317, 318, 319, 320, 321,
-	396, 0, 322, 323, 0, 324, 0, 325, 326, 327,
-	328, 329, 0, 91, 397, 0, 0, 87, 330, 398,
-	331, 399, 0, 332, 333, 334, 335, 336, 337, 338,
-	0, 0, 339, 340, 341, 342, 343, 0, 0, 344,
-	345, 346, 347, 348, 400, 401, 0, 349, 0, 350,
-	351, 352, 353, 96, 0, 354, 0, 0, 355, 356,
-	357, 358, 359, 360, 361, 362, 99, 100, 101, 102,
+	112, 113, 496, 114, 115, 116, 0, 1174, 1170, 497,
+	1189, 1164, 1181, 0, 117, 1836, 1835, 120, 121, 122,
+	123, 0, 0, 426, 124, 1191, 1190, 125, 0, 126,
+	127, 128, 129, 0, 0, 498, 0, 130, 131, 132,
+	133, 134, 0, 499, 135, 136, 137, 0, 138, 139,
+	140, 141, 142, 143, 0, 500, 144, 145, 146, 0,
+	0, 0, 501, 0, 0, 0, 147, 148, 149, 150,
+	151, 1186, 152, 153, 1179, 1178, 154, 0, 155, 0,
+	156, 157, 158, 159, 160, 0, 161, 162, 163, 0,
+	0, 164, 165, 664, 167, 168, 0, 169, 170, 171,
+	0, 172, 173, 174, 0, 175, 176, 177, 178, 0,
+	179, 180, 181, 0, 0, 182, 0, 183, 184, 1176,
+	185, 0, 186, 0, 187, 502, 0, 503, 188, 189,
+	190, 0, 191, 192, 0, 0, 0, 193, 0, 194,
+	195, 196, 197, 198, 504, 199, 200, 201, 202, 0,
+	203, 204, 205, 206, 207, 208, 0, 209, 505, 0,
+	210, 211, 212, 213, 1171, 1172, 0, 775, 0, 214,
+	506, 215, 507, 216, 217, 218, 219, 220, 0, 0,
+	221, 0, 508, 222, 509, 0, 223, 224, 427, 0,
+	0, 225, 226, 227, 228, 229, 230, 231, 232, 233,
+	234, 235, 236, 237, 238, 428, 0, 510, 0, 239,
+	240, 0, 0, 241, 242, 243, 0, 0, 244, 1180,
+	245, 246, 247, 0, 248, 0, 0, 249, 250, 0,
+	0, 251, 0, 511, 252, 512, 0, 253, 254, 255,
+	256, 257, 258, 259, 0, 260, 261, 0, 262, 0,
+	265, 263, 264, 0, 266, 267, 268, 269, 270, 271,
+	272, 273, 1175, 274, 275, 276, 277, 0, 278, 279,
+	280, 281, 282, 283, 284, 285, 286, 287, 288, 0,
+	289, 290, 513, 291, 292, 293, 0, 294, 295, 296,
+	297, 298, 299, 300, 301, 0, 302, 303, 1168, 304,
+	305, 429, 0, 306, 307, 0, 308, 309, 514, 310,
+	311, 1173, 1169, 312, 0, 313, 314, 315, 316, 317,
+	318, 319, 320, 321, 322, 323, 0, 0, 324, 325,
+	0, 326, 515, 327, 328, 329, 330, 1837, 0, 1188,
+	1187, 0, 0, 430, 332, 0, 333, 0, 0, 334,
+	335, 336, 337, 338, 339, 340, 0, 0, 341, 342,
+	343, 344, 345, 346, 0, 0, 347, 348, 349, 350,
+	351, 0, 1192, 0, 352, 516, 353, 354, 355, 356,
+	96, 0, 357, 0, 0, 358, 359, 360, 361, 362,
+	363, 364, 365, 0, 0, 0, 99, 100, 101, 102,
 	103, 104, 105, 106, 0, 107, 108, 109, 0, 0,
 	0, 0, 0, 0, 0, 110, 111, 0, 112, 113,
-	0, 114, 115, 116, 363, 364, 0, 365, 0, 366,
-	0, 117, 118, 119, 120, 121, 0, 0, 420, 122,
-	367, 368, 123, 0, 124, 125, 126, 127, 369, 0,
-	0, 0, 128, 129, 130, 131, 132, 0, 0, 133,
-	134, 135, 0, 136, 137, 138, 139, 140, 141, 0,
-	0, 142, 143, 144, 0, 0, 0, 0, 0, 0,
-	0, 145, 146, 147, 148, 149, 370, 150, 151, 371,
-	372, 152, 0, 153, 0, 154, 155, 156, 157, 158,
-	0, 159, 160, 161, 0, 0, 162, 163, 164, 165,
-	166, 0, 167, 168, 169, 0, 170, 171, 172, 0,
-	173, 174, 175, 176, 373, 177, 178, 179, 374, 0,
-	180, 0, 181, 182, 375, 183, 0, 184, 0, 185,
-	0, 0, 0, 186, 187, 188, 0, 189, 190, 376,
-	0, 377, 191, 0, 192, 193, 194, 195, 1046, 197,
-	198, 199, 200, 0, 201, 202, 203, 204, 205, 206,
-	0, 207, 0, 378, 208, 209, 210, 211, 379, 380,
-	0, 381, 0, 212, 0, 213, 0, 214, 215, 216,
-	217, 218, 0, 0, 219, 382, 0, 220, 0, 0,
-	221, 222, 421, 0, 0, 223, 224, 225, 226, 227,
-	228, 229, 230, 231, 232, 233, 234, 235, 236, 422,
-	383, 0, 384, 237, 238, 385, 0, 239, 240, 241,
-	0, 386, 242, 387, 243, 244, 245, 0, 246, 0,
-	0, 247, 248, 0, 0, 249, 388, 0, 250, 0,
-	389, 251, 252, 253, 254, 255, 256, 257, 0, 258,
-	259, 390, 260, 391, 263, 261, 262, 0, 264, 265,
-	266, 267, 268, 269, 270, 271, 392, 272, 273, 274,
-	275, 0, 276, 277, 278, 279, 280, 281, 282, 283,
-	284, 285, 286, 0, 287, 288, 0, 289, 290, 291,
-	393, 292, 293, 294, 295, 296, 297, 298, 299, 0,
-	300, 301, 302, 303, 423, 0, 304, 305, 394, 306,
-	307, 0, 308, 309, 395, 310, 0, 311, 312, 313,
-	314, 315, 316, 317, 318, 319, 320, 321, 396, 0,
-	322, 323, 0, 324, 0, 325, 326, 327, 328, 329,
-	0, 425, 397, 0, 0, 424, 330, 398, 331, 399,
-	0, 332, 333, 334, 335, 336, 337, 338, 0, 0,
-	339, 340, 341, 342, 343, 0, 0, 344, 345, 346,
-	347, 348, 400, 401, 0, 349, 0, 350, 351, 352,
-	353, 96, 0, 354, 0, 0, 355, 356, 357, 358,
-	359, 360, 361, 362, 99, 100, 101, 102, 103, 104,
+	0, 114, 115, 116, 366, 367, 368, 0, 369, 0,
+	370, 0, 117, 118, 119, 120, 121, 122, 123, 0,
+	0, 426, 124, 371, 372, 125, 0, 126, 127, 128,
+	129, 373, 0, 0, 0, 130, 131, 132, 133, 134,
+	0, 0, 135, 136, 137, 0, 138, 139, 140, 141,
+	142, 143, 0, 0, 144, 145, 146, 0, 0, 0,
+	0, 0, 0, 0, 147, 148, 149, 150, 151, 374,
+	152, 153, 375, 376, 154, 0, 155, 0, 156, 157,
+	158, 159, 160, 0, 161, 162, 163, 0, 0, 164,
+	165, 166, 167, 168, 0, 169, 170, 171, 0, 172,
+	173, 174, 0, 175, 176, 177, 178, 377, 179, 180,
+	181, 378, 0, 182, 0, 183, 184, 379, 185, 0,
+	186, 0, 187, 0, 0, 0, 188, 189, 190, 0,
+	191, 192, 380, 0, 381, 193, 0, 194, 195, 196,
+	197, 1373, 0, 199, 200, 201, 202, 0, 203, 204,
+	205, 206, 207, 208, 0, 209, 0, 382, 210, 211,
+	212, 213, 383, 384, 0, 385, 0, 214, 0, 215,
+	0, 216, 217, 218, 219, 220, 0, 0, 221, 386,
+	0, 222, 0, 0, 223, 224, 427, 0, 0, 225,
+	226, 227, 228, 229, 230, 231, 232, 233, 234, 235,
+	236, 237, 238, 428, 387, 0, 388, 239, 240, 389,
+	0, 241, 242, 243, 0, 390, 244, 391, 245, 246,
+	247, 0, 248, 0, 0, 249, 250, 0, 0, 251,
+	392, 0, 252, 0, 393, 253, 254, 255, 256, 257,
+	258, 259, 0, 260, 261, 394, 262, 395, 265, 263,
+	264, 0, 266, 267, 268, 269, 270, 271, 272, 273,
+	396, 274, 275, 276, 277, 0, 278, 279, 280, 281,
+	282, 283, 284, 285, 286, 287, 288, 0, 289, 290,
+	0, 291, 292, 293, 397, 294, 295, 296, 297, 298,
+	299, 300, 301, 0, 302, 303, 398, 304, 305, 429,
+	0, 306, 307, 399, 308, 309, 0, 310, 311, 400,
+	401, 312, 0, 313, 314, 315, 316, 317, 318, 319,
+	320, 321, 322, 323, 402, 0, 324, 325, 0, 326,
+	0, 327, 328, 329, 330, 331, 0, 431, 403, 0,
+	0, 430, 332, 404, 333, 405, 0, 334, 335, 336,
+	337, 338, 339, 340, 0, 0, 341, 342, 343, 344,
+	345, 346, 0, 0, 347, 348, 349, 350, 351, 406,
+	407, 0, 352, 0, 353, 354, 355, 356, 96, 0,
+	357, 0, 0, 358, 359, 360, 361, 362, 363, 364,
+	365, 0, 0, 0, 99, 100, 101, 102, 103, 104,
 	105, 106, 0, 107, 108, 109, 0, 0, 0, 0,
 	0, 0, 0, 110, 111, 0, 112, 113, 0, 114,
-	115, 116, 363, 364, 0, 365, 0, 366, 0, 117,
-	118, 119, 120, 121, 0, 0, 420, 122, 367, 368,
-	123, 0, 124, 125, 126, 127, 369, 0, 0, 0,
-	128, 129, 130, 131, 132, 0, 0, 133, 134, 135,
-	0, 136, 137, 138, 139, 140, 141, 0, 0, 142,
-	143, 144, 0, 0, 0, 0, 0, 0, 0, 145,
-	146, 147, 148, 149, 370, 150, 151, 371, 372, 152,
-	0, 153, 0, 154, 155, 156, 157, 158, 0, 159,
-	160, 161, 0, 0, 162, 163, 164, 165, 166, 0,
-	167, 168, 169, 0, 170, 171, 172, 0, 173, 174,
-	175, 176, 373, 177, 178, 179, 374, 0, 180, 0,
-	181, 182, 375, 183, 0, 184, 0, 185, 0, 0,
-	0, 186, 187, 188, 0, 189, 190, 376, 0, 377,
-	191, 0, 192, 193, 194, 195, 877, 197, 198, 199,
-	200, 0, 201, 202, 203, 204, 205, 206, 0, 207,
-	0, 378, 208, 209, 210, 211, 379, 380, 0, 381,
-	0, 212, 0, 213, 0, 214, 215, 216, 217, 218,
-	0, 0, 219, 382, 0, 220, 0, 0, 221, 222,
-	421, 0, 0, 223, 224, 225, 226, 227, 228, 229,
-	230, 231, 232, 233, 234, 235, 236, 422, 383, 0,
-	384, 237, 238, 385, 0, 239, 240, 241, 0, 386,
-	242, 387, 243, 244, 245, 0, 246, 0, 0, 247,
-	248, 0, 0, 249, 388, 0, 250, 0, 389, 251,
-	252, 253, 254, 255, 256, 257, 0, 258, 259, 390,
-	260, 391, 263, 261, 262, 0, 264, 265, 266, 267,
-	268, 269, 270, 271, 392, 272, 273, 274, 275, 0,
-	276, 277, 278, 279, 280, 281, 282, 283, 284, 285,
-	286, 0, 287, 288, 0, 289, 290, 291, 393, 292,
-	293, 294, 295, 296, 297, 298, 299, 0, 300, 301,
-	302, 303, 423, 0, 304, 305, 394, 306, 307, 0,
-	308, 309, 395, 310, 0, 311, 312, 313, 314, 315,
-	316, 317, 318, 319, 320, 321, 396, 0, 322, 323,
-	0, 324, 0, 325, 326, 327, 328, 329, 0, 425,
-	397, 0, 0, 424, 330, 398, 331, 399, 0, 332,
-	333, 334, 335, 336, 337, 338, 0, 0, 339, 340,
-	341, 342, 343, 0, 0, 344, 345, 346, 347, 348,
-	400, 401, 0, 349, 0, 350, 351, 352, 353, 96,
-	0, 354, 0, 0, 355, 356, 357, 358, 359, 360,
-	361, 362, 99, 100, 101, 102, 103, 104, 105, 106,
+	115, 116, 366, 367, 368, 0, 369, 0, 370, 0,
+	117, 118, 119, 120, 121, 122, 123, 0, 0, 426,
+	124, 371, 372, 125, 0, 126, 127, 128, 129, 373,
+	0, 0, 0, 130, 131, 132, 133, 134, 0, 0,
+	135, 136, 137, 0, 138, 139, 140, 141, 142, 143,
+	0, 0, 144, 145, 146, 0, 0, 0, 0, 0,
+	0, 0, 147, 148, 149, 150, 151, 374, 152, 153,
+	375, 376, 154, 0, 155, 0, 156, 157, 158, 159,
+	160, 0, 161, 162, 163, 0, 0, 164, 165, 166,
+	167, 168, 0, 169, 170, 171, 0, 172, 173, 174,
+	0, 175, 176, 177, 178, 377, 179, 180, 181, 378,
+	0, 182, 0, 183, 184, 379, 185, 0, 186, 0,
+	187, 0, 0, 0, 188, 189, 190, 0, 191, 192,
+	380, 0, 381, 193, 0, 194, 195, 196, 197, 1371,
+	0, 199, 200, 201, 202, 0, 203, 204, 205, 206,
+	207, 208, 0, 209, 0, 382, 210, 211, 212, 213,
+	383, 384, 0, 385, 0, 214, 0, 215, 0, 216,
+	217, 218, 219, 220, 0, 0, 221, 386, 0, 222,
+	0, 0, 223, 224, 427, 0, 0, 225, 226, 227,
+	228, 229, 230, 231, 232, 233, 234, 235, 236, 237,
+	238, 428, 387, 0, 388, 239, 240, 389, 0, 241,
+	242, 243, 0, 390, 244, 391, 245, 246, 247, 0,
+	248, 0, 0, 249, 250, 0, 0, 251, 392, 0,
+	252, 0, 393, 253, 254, 255, 256, 257, 258, 259,
+	0, 260, 261, 394, 262, 395, 265, 263, 264, 0,
+	266, 267, 268, 269, 270, 271, 272, 273, 396, 274,
+	275, 276, 277, 0, 278, 279, 280, 281, 282, 283,
+	284, 285, 286, 287, 288, 0, 289, 290, 0, 291,
+	292, 293, 397, 294, 295, 296, 297, 298, 299, 300,
+	301, 0, 302, 303, 398, 304, 305, 429, 0, 306,
+	307, 399, 308, 309, 0, 310, 311, 400, 401, 312,
+	0, 313, 314, 315, 316, 317, 318, 319, 320, 321,
+	322, 323, 402, 0, 324, 325, 0, 326, 0, 327,
+	328, 329, 330, 331, 0, 431, 403, 0, 0, 430,
+	332, 404, 333, 405, 0, 334, 335, 336, 337, 338,
+	339, 340, 0, 0, 341, 342, 343, 344, 345, 346,
+	0, 0, 347, 348, 349, 350, 351, 406, 407, 0,
+	352, 0, 353, 354, 355, 356, 96, 0, 357, 0,
+	0, 358, 359, 360, 361, 362, 363, 364, 365, 0,
+	0, 0, 99, 100, 101, 102, 103, 104, 105, 106,
 	0, 107, 108, 109, 0, 0, 0, 0, 0, 0,
 	0, 110, 111, 0, 112, 113, 0, 114, 115, 116,
-	363, 364, 0, 365, 0, 366, 0, 117, 118, 119,
-	120, 121, 0, 0, 420, 122, 367, 368, 123, 0,
-	124, 125, 126, 127, 369, 0, 0, 0, 128, 129,
-	130, 131, 132, 0, 0, 133, 134, 135, 0, 136,
-	137, 138, 139, 140, 141, 0, 0, 142, 143, 144,
-	0, 0, 0, 0, 0, 0, 0, 145, 146, 147,
-	148, 149, 370, 150, 151, 371, 372, 152, 0, 153,
-	0, 154, 155, 156, 157, 158, 0, 159, 160, 161,
-	0, 0, 162, 163, 164, 165, 166, 0, 167, 168,
-	169, 0, 170, 171, 172, 0, 173, 174, 175, 176,
-	373, 177, 178, 179, 374, 0, 180, 0, 181, 182,
-	375, 183, 0, 184, 0, 185, 0, 0, 0, 186,
-	187, 188, 0, 189, 190, 376, 0, 377, 191, 0,
-	192, 193, 194, 195, 196, 197, 198, 199, 200, 0,
-	201, 202, 203, 204, 205, 206, 0, 207, 0, 378,
-	208, 209, 210, 211, 379, 380, 0, 381, 0, 212,
-	0, 213, 0, 214, 215, 216, 217, 218, 0, 0,
-	219, 382, 0, 220, 0, 0, 221, 222, 421, 0,
-	0, 223, 224, 225, 226, 227, 228, 229, 230, 231,
-	232, 233, 234, 235, 236, 422, 383, 0, 384, 237,
-	238, 385, 0, 239, 240, 241, 0, 386, 242, 387,
-	243, 244, 245, 0, 246, 0, 0, 247, 248, 0,
-	0, 249, 388, 0, 250, 0, 389, 251, 252, 253,
-	254, 255, 256, 257, 0, 258, 259, 390, 260, 391,
-	263, 261, 262, 0, 264, 265, 266, 267, 268, 269,
-	270, 271, 392, 272, 273, 274, 275, 0, 276, 277,
-	278, 279, 280, 281, 282, 283, 284, 285, 286, 0,
-	287, 288, 0, 289, 290, 291, 393, 292, 293, 870,
-	295, 296, 297, 298, 299, 0, 300, 301, 302, 303,
-	423, 0, 304, 305, 394, 306, 307, 0, 308, 309,
-	395, 310, 0, 311, 312, 313, 314, 315, 316, 317,
-	318, 319, 320, 321, 396, 0, 322, 323, 0, 324,
-	0, 325, 326, 327, 328, 329, 0, 425, 397, 0,
-	0, 424, 330, 398, 331, 399, 0, 332, 333, 334,
-	335, 336, 337, 338, 0, 0, 339, 340, 341, 342,
-	343, 0, 0, 344, 345, 346, 347, 348, 400, 401,
-	0, 349, 0, 350, 351, 352, 353, 96, 0, 354,
-	0, 0, 355, 356, 357, 358, 359, 360, 361, 362,
+	366, 367, 368, 0, 369, 0, 370, 0, 117, 118,
+	119, 120, 121, 122, 123, 0, 0, 426, 124, 371,
+	372, 125, 0, 126, 127, 128, 129, 373, 0, 0,
+	0, 130, 131, 132, 133, 134, 0, 0, 135, 136,
+	137, 0, 138, 139, 140, 141, 142, 143, 0, 0,
+	144, 145, 146, 0, 0, 0, 0, 0, 0, 0,
+	147, 148, 149, 150, 151, 374, 152, 153, 375, 376,
+	154, 0, 155, 0, 156, 157, 158, 159, 160, 0,
+	161, 162, 163, 0, 0, 164, 165, 166, 167, 168,
+	0, 169, 170, 171, 0, 172, 173, 174, 0, 175,
+	176, 177, 178, 377, 179, 180, 181, 378, 0, 182,
+	0, 183, 184, 379, 185, 0, 186, 0, 187, 0,
+	0, 0, 188, 189, 190, 0, 191, 192, 380, 0,
+	381, 193, 0, 194, 195, 196, 197, 1114, 0, 199,
+	200, 201, 202, 0, 203, 204, 205, 206, 207, 208,
+	0, 209, 0, 382, 210, 211, 212, 213, 383, 384,
+	0, 385, 0, 214, 0, 215, 0, 216, 217, 218,
+	219, 220, 0, 0, 221, 386, 0, 222, 0, 0,
+	223, 224, 427, 0, 0, 225, 226, 227, 228, 229,
+	230, 231, 232, 233, 234, 235, 236, 237, 238, 428,
+	387, 0, 388, 239, 240, 389, 0, 241, 242, 243,
+	0, 390, 244, 391, 245, 246, 247, 0, 248, 0,
+	0, 249, 250, 0, 0, 251, 392, 0, 252, 0,
+	393, 253, 254, 255, 256, 257, 258, 259, 0, 260,
+	261, 394, 262, 395, 265, 263, 264, 0, 266, 267,
+	268, 269, 270, 271, 272, 273, 396, 274, 275, 276,
+	277, 0, 278, 279, 280, 281, 282, 283, 284, 285,
+	286, 287, 288, 0, 289, 290, 0, 291, 292, 293,
+	397, 294, 295, 296, 297, 298, 299, 300, 301, 0,
+	302, 303, 398, 304, 305, 429, 0, 306, 307, 399,
+	308, 309, 0, 310, 311, 400, 401, 312, 0, 313,
+	314, 315, 316, 317, 318, 319, 320, 321, 322, 323,
+	402, 0, 324, 325, 0, 326, 0, 327, 328, 329,
+	330, 331, 0, 431, 403, 0, 0, 430, 332, 404,
+	333, 405, 0, 334, 335, 336, 337, 338, 339, 340,
+	0, 0, 341, 342, 343, 344, 345, 346, 0, 0,
+	347, 348, 349, 350, 351, 406, 407, 0, 352, 0,
+	353, 354, 355, 356, 96, 0, 357, 0, 0, 358,
+	359, 360, 361, 362, 363, 364, 365, 0, 0, 0,
 	99, 100, 101, 102, 103, 104, 105, 106, 0, 107,
-	108, 109, 0, 0, 0, 0, 0, 704, 0, 110,
-	111, 0, 112, 113, 0, 114, 115, 116, 363, 364,
-	0, 365, 0, 366, 0, 117, 118, 119, 120, 121,
-	0, 0, 420, 122, 367, 368, 123, 0, 124, 125,
-	126, 127, 369, 0, 0, 0, 128, 129, 130, 131,
-	132, 0, 0, 133, 134, 135, 0, 136, 137, 138,
-	139, 140, 141, 0, 0, 142, 143, 144, 0, 0,
-	0, 0, 0, 0, 0, 145, 146, 147, 148, 149,
-	370, 150, 151, 371, 372, 152, 0, 153, 0, 154,
-	155, 156, 157, 158, 0, 159, 160, 161, 0, 0,
-	162, 163, 164, 165, 166, 0, 167, 168, 169, 0,
-	170, 171, 172, 0, 173, 174, 175, 176, 373, 177,
-	178, 179, 374, 0, 180, 0, 181, 182, 375, 183,
-	0, 184, 0, 185, 0, 0, 0, 186, 187, 188,
-	0, 189, 190, 376, 0, 377, 191, 0, 192, 193,
-	194, 195, 196, 197, 198, 199, 200, 0, 201, 202,
-	203, 204, 205, 206, 0, 207, 0, 378, 208, 209,
-	210, 211, 379, 380, 0, 381, 0, 212, 0, 213,
-	0, 214, 215, 216, 217, 218, 0, 0, 219, 382,
-	0, 220, 0, 0, 221, 222, 421, 0, 0, 223,
-	224, 225, 226, 227, 228, 229, 230, 231, 232, 233,
-	234, 235, 236, 422, 383, 0, 384, 237, 238, 385,
-	0, 239, 240, 241, 0, 386, 242, 387, 243, 244,
-	245, 0, 246, 0, 0, 247, 248, 0, 0, 249,
-	388, 0, 250, 0, 389, 251, 252, 253, 254, 255,
-	256, 257, 0, 258, 259, 390, 260, 391, 263, 261,
-	262, 0, 264, 265, 266, 267, 268, 269, 270, 271,
-	392, 272, 273, 274, 275, 0, 276, 277, 278, 279,
-	280, 281, 282, 283, 284, 285, 286, 0, 287, 288,
-	0, 289, 290, 291, 393, 292, 293, 294, 295, 296,
-	297, 298, 299, 0, 300, 301, 302, 303, 423, 0,
-	0, 305, 394, 306, 307, 0, 308, 309, 395, 310,
-	0, 311, 312, 313, 314, 315, 316, 317, 318, 319,
-	320, 321, 396, 0, 322, 323, 0, 324, 0, 325,
-	326, 327, 328, 329, 0, 425, 397, 0, 0, 424,
-	330, 398, 331, 399, 0, 332, 333, 334, 335, 336,
-	337, 338, 0, 0, 339, 340, 341, 342, 343, 0,
-	0, 344, 345, 346, 347, 348, 400, 401, 0, 349,
-	0, 350, 351, 352, 353, 96, 0, 354, 0, 0,
-	355, 356, 357, 358, 359, 360, 361, 362, 99, 100,
+	108, 109, 0, 0, 0, 0, 0, 0, 0, 110,
+	111, 0, 112, 113, 0, 114, 115, 116, 366, 367,
+	368, 0, 369, 0, 370, 0, 117, 118, 119, 120,
+	121, 122, 123, 0, 0, 92, 124, 371, 372, 125,
+	0, 126, 127, 128, 129, 373, 0, 0, 0, 130,
+	131, 132, 133, 134, 0, 0, 135, 136, 137, 0,
+	138, 139, 140, 141, 142, 143, 0, 0, 144, 145,
+	146, 0, 0, 0, 0, 0, 0, 0, 147, 148,
+	149, 150, 151, 374, 152, 153, 375, 376, 154, 0,
+	155, 0, 156, 157, 158, 159, 160, 0, 161, 162,
+	163, 0, 0, 164, 165, 166, 167, 168, 0, 169,
+	170, 171, 0, 172, 173, 174, 0, 175, 176, 177,
+	178, 377, 179, 180, 181, 378, 0, 182, 0, 183,
+	184, 379, 185, 0, 186, 0, 187, 0, 0, 0,
+	188, 189, 190, 0, 191, 192, 380, 0, 381, 193,
+	0, 194, 195, 196, 197, 198, 0, 199, 200, 201,
+	202, 0, 203, 204, 205, 206, 207, 208, 0, 209,
+	0, 382, 210, 211, 212, 213, 383, 384, 0, 385,
+	0, 214, 0, 215, 0, 216, 217, 218, 219, 220,
+	0, 0, 221, 386, 0, 222, 0, 0, 223, 224,
+	427, 0, 0, 225, 226, 227, 228, 229, 230, 231,
+	232, 233, 234, 235, 236, 237, 238, 93, 387, 0,
+	388, 239, 240, 389, 0, 241, 242, 243, 0, 390,
+	244, 391, 245, 246, 247, 0, 248, 0, 0, 249,
+	250, 0, 0, 251, 392, 0, 252, 0, 393, 253,
+	254, 255, 256, 257, 258, 259, 0, 260, 261, 394,
+	262, 395, 265, 263, 264, 0, 266, 267, 268, 269,
+	270, 271, 272, 273, 396, 274, 275, 276, 277, 0,
+	278, 279, 280, 281, 282, 283, 284, 285, 286, 287,
+	288, 0, 289, 290, 0, 291, 292, 293, 397, 294,
+	295, 296, 297, 298, 299, 300, 301, 0, 302, 303,
+	398, 304, 305, 527, 0, 306, 307, 399, 308, 309,
+	0, 310, 311, 400, 401, 312, 0, 313, 314, 315,
+	316, 317, 318, 319, 320, 321, 322, 323, 402, 0,
+	324, 325, 0, 326, 0, 327, 328, 329, 330, 331,
+	0, 91, 403, 0, 0, 87, 332, 404, 333, 405,
+	0, 334, 335, 336, 337, 338, 339, 340, 0, 0,
+	341, 342, 343, 344, 345, 346, 0, 0, 347, 348,
+	349, 350, 351, 406, 407, 0, 352, 0, 353, 354,
+	355, 356, 96, 0, 357, 0, 0, 358, 359, 360,
+	361, 362, 363, 364, 365, 0, 0, 0, 99, 100,
 	101, 102, 103, 104, 105, 106, 0, 107, 108, 109,
 	0, 0, 0, 0, 0, 0, 0, 110, 111, 0,
-	112, 113, 0, 114, 115, 116, 363, 364, 0, 365,
-	0, 366, 0, 117, 118, 119, 120, 121, 0, 0,
-	420, 122, 367, 368, 123, 0, 124, 125, 126, 127,
-	369, 0, 0, 0, 128, 129, 130, 131, 132, 0,
-	0, 133, 134, 135, 0, 136, 137, 138, 139, 140,
-	141, 0, 0, 142, 143, 144, 0, 0, 0, 0,
-	0, 0, 0, 145, 146, 147, 148, 149, 370, 150,
-	151, 371, 372, 152, 0, 153, 0, 154, 155, 156,
-	157, 158, 0, 159, 160, 161, 0, 0, 162, 163,
-	164, 165, 166, 0, 167, 168, 169, 0, 170, 171,
-	172, 0, 173, 174, 175, 176, 373, 177, 178, 179,
-	374, 0, 180, 0, 181, 182, 375, 183, 0, 184,
-	0, 185, 0, 0, 0, 186, 187, 188, 0, 189,
-	190, 376, 0, 377, 191, 0, 192, 193, 194, 195,
-	559, 197, 198, 199, 200, 0, 201, 202, 203, 204,
-	205, 206, 0, 207, 0, 378, 208, 209, 210, 211,
-	379, 380, 0, 381, 0, 212, 0, 213, 0, 214,
-	215, 216, 217, 218, 0, 0, 219, 382, 0, 220,
-	0, 0, 221, 222, 421, 0, 0, 223, 224, 225,
-	226, 227, 228, 229, 230, 231, 232, 233, 234, 235,
-	236, 422, 383, 0, 384, 237, 238, 385, 0, 239,
-	240, 241, 0, 386, 242, 387, 243, 244, 245, 0,
-	246, 0, 0, 247, 248, 0, 0, 249, 388, 0,
-	250, 0, 389, 251, 252, 253, 254, 255, 256, 257,
-	0, 258, 259, 390, 260, 391, 263, 261, 262, 0,
-	264, 265, 266, 267, 268, 269, 270, 271, 392, 272,
-	273, 274, 275, 0, 276, 277, 278, 279, 280, 281,
-	282, 283, 284, 285, 286, 0, 287, 288, 0, 289,
-	290, 291, 393, 292, 293, 294, 295, 296, 297, 298,
-	299, 0, 300, 301, 302, 303, 423, 0, 304, 305,
-	394, 306, 307, 0, 308, 309, 395, 310, 0, 311,
-	312, 313, 314, 315, 316, 317, 318, 319, 320, 321,
-	396, 0, 322, 323, 0, 324, 0, 325, 326, 327,
-	328, 329, 0, 425, 397, 0, 0, 424, 330, 398,
-	331, 399, 0, 332, 333, 334, 335, 336, 337, 338,
-	0, 0, 339, 340, 341, 342, 343, 0, 0, 344,
-	345, 346, 347, 348, 400, 401, 0, 349, 0, 350,
-	351, 352, 353, 96, 0, 354, 0, 0, 355, 356,
-	357, 358, 359, 360, 361, 362, 99, 100, 101, 102,
+	112, 113, 0, 114, 115, 116, 366, 367, 368, 0,
+	369, 0, 370, 0, 117, 118, 119, 120, 121, 122,
+	123, 0, 0, 426, 124, 371, 372, 125, 0, 126,
+	127, 128, 129, 373, 0, 0, 0, 130, 131, 132,
+	133, 134, 0, 0, 135, 136, 137, 0, 138, 139,
+	140, 141, 142, 143, 0, 0, 144, 145, 146, 0,
+	0, 0, 0, 0, 0, 0, 147, 148, 149, 150,
+	151, 374, 152, 153, 375, 376, 154, 0, 155, 0,
+	156, 157, 158, 159, 160, 0, 161, 162, 163, 0,
+	0, 164, 165, 166, 167, 168, 0, 169, 170, 171,
+	0, 172, 173, 174, 0, 175, 176, 177, 178, 377,
+	179, 180, 181, 378, 0, 182, 0, 183, 184, 379,
+	185, 0, 186, 0, 187, 0, 0, 0, 188, 189,
+	190, 0, 191, 192, 380, 0, 381, 193, 0, 194,
+	195, 196, 197, 1045, 0, 199, 200, 201, 202, 0,
+	203, 204, 205, 206, 207, 208, 0, 209, 0, 382,
+	210, 211, 212, 213, 383, 384, 0, 385, 0, 214,
+	0, 215, 0, 216, 217, 218, 219, 220, 0, 0,
+	221, 386, 0, 222, 0, 0, 223, 224, 427, 0,
+	0, 225, 226, 227, 228, 229, 230, 231, 232, 233,
+	234, 235, 236, 237, 238, 428, 387, 0, 388, 239,
+	240, 389, 0, 241, 242, 243, 0, 390, 244, 391,
+	245, 246, 247, 0, 248, 0, 0, 249, 250, 0,
+	0, 251, 392, 0, 252, 0, 393, 253, 254, 255,
+	256, 257, 258, 259, 0, 260, 261, 394, 262, 395,
+	265, 263, 264, 0, 266, 267, 268, 269, 270, 271,
+	272, 273, 396, 274, 275, 276, 277, 0, 278, 279,
+	280, 281, 282, 283, 284, 285, 286, 287, 288, 0,
+	289, 290, 0, 291, 292, 293, 397, 294, 295, 296,
+	297, 298, 299, 300, 301, 0, 302, 303, 398, 304,
+	305, 429, 0, 306, 307, 399, 308, 309, 0, 310,
+	311, 400, 401, 312, 0, 313, 314, 315, 316, 317,
+	318, 319, 320, 321, 322, 323, 402, 0, 324, 325,
+	0, 326, 0, 327, 328, 329, 330, 331, 0, 431,
+	403, 0, 0, 430, 332, 404, 333, 405, 0, 334,
+	335, 336, 337, 338, 339, 340, 0, 0, 341, 342,
+	343, 344, 345, 346, 0, 0, 347, 348, 349, 350,
+	351, 406, 407, 0, 352, 0, 353, 354, 355, 356,
+	96, 0, 357, 0, 0, 358, 359, 360, 361, 362,
+	363, 364, 365, 0, 0, 0, 99, 100, 101, 102,
 	103, 104, 105, 106, 0, 107, 108, 109, 0, 0,
 	0, 0, 0, 0, 0, 110, 111, 0, 112, 113,
-	0, 114, 115, 116, 363, 364, 0, 365, 0, 366,
-	0, 117, 118, 119, 120, 121, 0, 0, 92, 122,
-	367, 368, 522, 0, 124, 125, 126, 127, 369, 0,
-	0, 0, 128, 129, 130, 131, 132, 0, 0, 133,
-	134, 135, 0, 136, 137, 138, 139, 140, 141, 0,
-	0, 142, 143, 144, 0, 0, 0, 0, 0, 0,
-	0, 145, 146, 147, 148, 149, 370, 150, 151, 371,
-	372, 152, 0, 153, 0, 154, 155, 156, 157, 158,
-	0, 159, 160, 161, 0, 0, 162, 163, 164, 165,
-	166, 0, 167, 168, 169, 0, 170, 171, 172, 0,
-	173, 174, 175, 176, 373, 177, 178, 179, 374, 0,
-	180, 0, 181, 182, 375, 183, 0, 184, 0, 185,
-	0, 0, 0, 186, 187, 188, 0, 189, 190, 376,
-	0, 377, 191, 0, 192, 193, 194, 195, 196, 197,
-	198, 199, 200, 0, 201, 202, 203, 204, 205, 206,
-	0, 207, 0, 378, 208, 209, 210, 211, 379, 380,
-	0, 381, 0, 212, 0, 213, 0, 214, 215, 216,
-	217, 218, 0, 0, 219, 382, 0, 220, 0, 0,
-	221, 222, 421, 0, 0, 223, 224, 225, 226, 227,
-	228, 229, 230, 231, 232, 233, 234, 235, 236, 93,
-	383, 0, 384, 237, 238, 385, 0, 239, 240, 241,
-	0, 386, 242, 387, 243, 244, 245, 0, 246, 0,
-	0, 247, 248, 0, 0, 249, 388, 0, 250, 0,
-	389, 251, 252, 253, 254, 255, 256, 257, 0, 258,
-	259, 390, 260, 391, 263, 261, 262, 0, 264, 265,
-	266, 267, 268, 269, 270, 271, 392, 272, 273, 274,
-	275, 0, 276, 277, 278, 279, 280, 281, 282, 283,
-	284, 285, 286, 0, 287, 288, 0, 289, 290, 291,
-	393, 292, 293, 294, 295, 296, 297, 298, 299, 0,
-	300, 301, 302, 303, 520, 0, 304, 305, 394, 306,
-	307, 0, 308, 309, 395, 310, 0, 311, 312, 313,
-	314, 315, 316, 317, 318, 319, 320, 321, 396, 0,
-	322, 323, 0, 324, 0, 325, 326, 327, 328, 329,
-	0, 91, 397, 0, 0, 87, 330, 398, 331, 399,
-	0, 332, 333, 334, 335, 336, 337, 338, 0, 0,
-	339, 340, 341, 342, 343, 0, 0, 344, 345, 346,
-	347, 348, 400, 401, 0, 349, 0, 350, 351, 352,
-	353, 96, 0, 354, 0, 0, 355, 356, 357, 358,
-	359, 360, 361, 362, 99, 100, 101, 102, 103, 104,
+	0, 114, 115, 116, 366, 367, 368, 0, 369, 0,
+	370, 0, 117, 118, 119, 120, 121, 122, 123, 0,
+	0, 426, 124, 371, 372, 125, 0, 126, 127, 128,
+	129, 373, 0, 0, 0, 130, 131, 132, 133, 134,
+	0, 0, 135, 136, 137, 0, 138, 139, 140, 141,
+	142, 143, 0, 0, 144, 145, 146, 0, 0, 0,
+	0, 0, 0, 0, 147, 148, 149, 150, 151, 374,
+	152, 153, 375, 376, 154, 0, 155, 0, 156, 157,
+	158, 159, 160, 0, 161, 162, 163, 0, 0, 164,
+	165, 166, 167, 168, 0, 169, 170, 171, 0, 172,
+	173, 174, 0, 175, 176, 177, 178, 377, 179, 180,
+	181, 378, 0, 182, 0, 183, 184, 379, 185, 0,
+	186, 0, 187, 0, 0, 0, 188, 189, 190, 0,
+	191, 192, 380, 0, 381, 193, 0, 194, 195, 196,
+	197, 873, 0, 199, 200, 201, 202, 0, 203, 204,
+	205, 206, 207, 208, 0, 209, 0, 382, 210, 211,
+	212, 213, 383, 384, 0, 385, 0, 214, 0, 215,
+	0, 216, 217, 218, 219, 220, 0, 0, 221, 386,
+	0, 222, 0, 0, 223, 224, 427, 0, 0, 225,
+	226, 227, 228, 229, 230, 231, 232, 233, 234, 235,
+	236, 237, 238, 428, 387, 0, 388, 239, 240, 389,
+	0, 241, 242, 243, 0, 390, 244, 391, 245, 246,
+	247, 0, 248, 0, 0, 249, 250, 0, 0, 251,
+	392, 0, 252, 0, 393, 253, 254, 255, 256, 257,
+	258, 259, 0, 260, 261, 394, 262, 395, 265, 263,
+	264, 0, 266, 267, 268, 269, 270, 271, 272, 273,
+	396, 274, 275, 276, 277, 0, 278, 279, 280, 281,
+	282, 283, 284, 285, 286, 287, 288, 0, 289, 290,
+	0, 291, 292, 293, 397, 294, 295, 296, 297, 298,
+	299, 300, 301, 0, 302, 303, 398, 304, 305, 429,
+	0, 306, 307, 399, 308, 309, 0, 310, 311, 400,
+	401, 312, 0, 313, 314, 315, 316, 317, 318, 319,
+	320, 321, 322, 323, 402, 0, 324, 325, 0, 326,
+	0, 327, 328, 329, 330, 331, 0, 431, 403, 0,
+	0, 430, 332, 404, 333, 405, 0, 334, 335, 336,
+	337, 338, 339, 340, 0, 0, 341, 342, 343, 344,
+	345, 346, 0, 0, 347, 348, 349, 350, 351, 406,
+	407, 0, 352, 0, 353, 354, 355, 356, 96, 0,
+	357, 0, 0, 358, 359, 360, 361, 362, 363, 364,
+	365, 0, 0, 0, 99, 100, 101, 102, 103, 104,
 	105, 106, 0, 107, 108, 109, 0, 0, 0, 0,
 	0, 0, 0, 110, 111, 0, 112, 113, 0, 114,
-	115, 116, 363, 364, 0, 365, 0, 366, 0, 117,
-	118, 119, 120, 121, 0, 0, 420, 122, 367, 368,
-	123, 0, 124, 125, 126, 127, 369, 0, 0, 0,
-	128, 129, 130, 131, 132, 0, 0, 133, 134, 135,
-	0, 136, 137, 138, 139, 140, 141, 0, 0, 142,
-	143, 144, 0, 0, 0, 0, 0, 0, 0, 145,
-	146, 147, 148, 149, 370, 150, 151, 371, 372, 152,
-	0, 153, 0, 154, 155, 156, 157, 158, 0, 159,
-	160, 161, 0, 0, 162, 163, 164, 165, 166, 0,
-	167, 168, 169, 0, 170, 171, 172, 0, 173, 174,
-	175, 176, 373, 177, 178, 179, 374, 0, 180, 0,
-	181, 182, 375, 183, 0, 184, 0, 185, 0, 0,
-	0, 186, 187, 188, 0, 189, 190, 376, 0, 377,
-	191, 0, 192, 193, 194, 195, 515, 197, 198, 199,
-	200, 0, 201, 202, 203, 204, 205, 206, 0, 207,
-	0, 378, 208, 209, 210, 211, 379, 380, 0, 381,
-	0, 212, 0, 213, 0, 214, 215, 216, 217, 218,
-	0, 0, 219, 382, 0, 220, 0, 0, 221, 222,
-	421, 0, 0, 223, 224, 225, 226, 227, 228, 229,
-	230, 231, 232, 233, 234, 235, 236, 422, 383, 0,
-	384, 237, 238, 385, 0, 239, 240, 241, 0, 386,
-	242, 387, 243, 244, 245, 0, 246, 0, 0, 247,
-	248, 0, 0, 249, 388, 0, 250, 0, 389, 251,
-	252, 253, 254, 255, 256, 257, 0, 258, 259, 390,
-	260, 391, 263, 261, 262, 0, 264, 265, 266, 267,
-	268, 269, 270, 271, 392, 272, 273, 274, 275, 0,
-	276, 277, 278, 279, 280, 281, 282, 283, 284, 285,
-	286, 0, 287, 288, 0, 289, 290, 291, 393, 292,
-	293, 294, 295, 296, 297, 298, 299, 0, 300, 301,
-	302, 303, 423, 0, 304, 305, 394, 306, 307, 0,
-	308, 309, 395, 310, 0, 311, 312, 313, 314, 315,
-	316, 317, 318, 319, 320, 321, 396, 0, 322, 323,
-	0, 324, 0, 325, 326, 327, 328, 329, 0, 425,
-	397, 0, 0, 424, 330, 398, 331, 399, 0, 332,
-	333, 334, 335, 336, 337, 338, 0, 0, 339, 340,
-	341, 342, 343, 0, 0, 344, 345, 346, 347, 348,
-	400, 401, 0, 349, 0, 350, 351, 352, 353, 96,
-	0, 354, 0, 0, 355, 356, 357, 358, 359, 360,
-	361, 362, 99, 100, 101, 102, 103, 104, 105, 106,
-	0, 107, 108, 109, 0, 0, 0, 0, 0, 0,
+	115, 116, 366, 367, 368, 0, 369, 0, 370, 0,
+	117, 118, 119, 120, 121, 122, 123, 0, 0, 426,
+	124, 371, 372, 125, 0, 126, 127, 128, 129, 373,
+	0, 0, 0, 130, 131, 132, 133, 134, 0, 0,
+	135, 136, 137, 0, 138, 139, 140, 141, 142, 143,
+	0, 0, 144, 145, 146, 0, 0, 0, 0, 0,
+	0, 0, 147, 148, 149, 150, 151, 374, 152, 153,
+	375, 376, 154, 0, 155, 0, 156, 157, 158, 159,
+	160, 0, 161, 162, 163, 0, 0, 164, 165, 166,
+	167, 168, 0, 169, 170, 171, 0, 172, 173, 174,
+	0, 175, 176, 177, 178, 377, 179, 180, 181, 378,
+	0, 182, 0, 183, 184, 379, 185, 0, 186, 0,
+	187, 0, 0, 0, 188, 189, 190, 0, 191, 192,
+	380, 0, 381, 193, 0, 194, 195, 196, 197, 198,
+	0, 199, 200, 201, 202, 0, 203, 204, 205, 206,
+	207, 208, 0, 209, 0, 382, 210, 211, 212, 213,
+	383, 384, 0, 385, 0, 214, 0, 215, 0, 216,
+	217, 218, 219, 220, 0, 0, 221, 386, 0, 222,
+	0, 0, 223, 224, 427, 0, 0, 225, 226, 227,
+	228, 229, 230, 231, 232, 233, 234, 235, 236, 237,
+	238, 428, 387, 0, 388, 239, 240, 389, 0, 241,
+	242, 243, 0, 390, 244, 391, 245, 246, 247, 0,
+	248, 0, 0, 249, 250, 0, 0, 251, 392, 0,
+	252, 0, 393, 253, 254, 255, 256, 257, 258, 259,
+	0, 260, 261, 394, 262, 395, 265, 263, 264, 0,
+	266, 267, 268, 269, 270, 271, 272, 273, 396, 274,
+	275, 276, 277, 0, 278, 279, 280, 281, 282, 283,
+	284, 285, 286, 287, 288, 0, 289, 290, 0, 291,
+	292, 293, 397, 294, 295, 866, 297, 298, 299, 300,
+	301, 0, 302, 303, 398, 304, 305, 429, 0, 306,
+	307, 399, 308, 309, 0, 310, 311, 400, 401, 312,
+	0, 313, 314, 315, 316, 317, 318, 319, 320, 321,
+	322, 323, 402, 0, 324, 325, 0, 326, 0, 327,
+	328, 329, 330, 331, 0, 431, 403, 0, 0, 430,
+	332, 404, 333, 405, 0, 334, 335, 336, 337, 338,
+	339, 340, 0, 0, 341, 342, 343, 344, 345, 346,
+	0, 0, 347, 348, 349, 350, 351, 406, 407, 0,
+	352, 0, 353, 354, 355, 356, 96, 0, 357, 0,
+	0, 358, 359, 360, 361, 362, 363, 364, 365, 0,
+	0, 0, 99, 100, 101, 102, 103, 104, 105, 106,
+	0, 107, 108, 109, 0, 0, 0, 0, 0, 708,
 	0, 110, 111, 0, 112, 113, 0, 114, 115, 116,
-	363, 364, 0, 365, 0, 366, 0, 117, 118, 119,
-	120, 121, 0, 0, 420, 122, 367, 368, 123, 0,
-	124, 125, 126, 127, 369, 0, 0, 0, 128, 129,
-	130, 131, 132, 0, 0, 133, 134, 135, 0, 136,
-	137, 138, 139, 140, 141, 0, 0, 142, 143, 144,
-	0, 0, 0, 0, 0, 0, 0, 145, 146, 147,
-	148, 149, 370, 150, 151, 371, 372, 152, 0, 153,
-	0, 154, 155, 156, 157, 158, 0, 159, 160, 161,
-	0, 0, 162, 163, 164, 165, 166, 0, 167, 168,
-	169, 0, 170, 171, 172, 0, 173, 174, 175, 176,
-	373, 177, 178, 179, 374, 0, 180, 0, 181, 182,
-	375, 183, 0, 184, 0, 185, 0, 0, 0, 186,
-	187, 188, 0, 189, 190, 376, 0, 377, 191, 0,
-	192, 193, 194, 195, 479, 197, 198, 199, 200, 0,
-	201, 202, 203, 204, 205, 206, 0, 207, 0, 378,
-	208, 209, 210, 211, 379, 380, 0, 381, 0, 212,
-	0, 213, 0, 214, 215, 216, 217, 218, 0, 0,
-	219, 382, 0, 220, 0, 0, 221, 222, 421, 0,
-	0, 223, 224, 225, 226, 227, 228, 229, 230, 231,
-	232, 233, 234, 235, 236, 422, 383, 0, 384, 237,
-	238, 385, 0, 239, 240, 241, 0, 386, 242, 387,
-	243, 244, 245, 0, 246, 0, 0, 247, 248, 0,
-	0, 249, 388, 0, 250, 0, 389, 251, 252, 253,
-	254, 255, 256, 257, 0, 258, 259, 390, 260, 391,
-	263, 261, 262, 0, 264, 265, 266, 267, 268, 269,
-	270, 271, 392, 272, 273, 274, 275, 0, 276, 277,
-	278, 279, 280, 281, 282, 283, 284, 285, 286, 0,
-	287, 288, 0, 289, 290, 291, 393, 292, 293, 294,
-	295, 296, 297, 298, 299, 0, 300, 301, 302, 303,
-	423, 0, 304, 305, 394, 306, 307, 0, 308, 309,
-	395, 310, 0, 311, 312, 313, 314, 315, 316, 317,
-	318, 319, 320, 321, 396, 0, 322, 323, 0, 324,
-	0, 325, 326, 327, 328, 329, 0, 425, 397, 0,
-	0, 424, 330, 398, 331, 399, 0, 332, 333, 334,
-	335, 336, 337, 338, 0, 0, 339, 340, 341, 342,
-	343, 0, 0, 344, 345, 346, 347, 348, 400, 401,
-	0, 349, 0, 350, 351, 352, 353, 96, 0, 354,
-	0, 0, 355, 356, 357, 358, 359, 360, 361, 362,
+	366, 367, 368, 0, 369, 0, 370, 0, 117, 118,
+	119, 120, 121, 122, 123, 0, 0, 426, 124, 371,
+	372, 125, 0, 126, 127, 128, 129, 373, 0, 0,
+	0, 130, 131, 132, 133, 134, 0, 0, 135, 136,
+	137, 0, 138, 139, 140, 141, 142, 143, 0, 0,
+	144, 145, 146, 0, 0, 0, 0, 0, 0, 0,
+	147, 148, 149, 150, 151, 374, 152, 153, 375, 376,
+	154, 0, 155, 0, 156, 157, 158, 159, 160, 0,
+	161, 162, 163, 0, 0, 164, 165, 166, 167, 168,
+	0, 169, 170, 171, 0, 172, 173, 174, 0, 175,
+	176, 177, 178, 377, 179, 180, 181, 378, 0, 182,
+	0, 183, 184, 379, 185, 0, 186, 0, 187, 0,
+	0, 0, 188, 189, 190, 0, 191, 192, 380, 0,
+	381, 193, 0, 194, 195, 196, 197, 198, 0, 199,
+	200, 201, 202, 0, 203, 204, 205, 206, 207, 208,
+	0, 209, 0, 382, 210, 211, 212, 213, 383, 384,
+	0, 385, 0, 214, 0, 215, 0, 216, 217, 218,
+	219, 220, 0, 0, 221, 386, 0, 222, 0, 0,
+	223, 224, 427, 0, 0, 225, 226, 227, 228, 229,
+	230, 231, 232, 233, 234, 235, 236, 237, 238, 428,
+	387, 0, 388, 239, 240, 389, 0, 241, 242, 243,
+	0, 390, 244, 391, 245, 246, 247, 0, 248, 0,
+	0, 249, 250, 0, 0, 251, 392, 0, 252, 0,
+	393, 253, 254, 255, 256, 257, 258, 259, 0, 260,
+	261, 394, 262, 395, 265, 263, 264, 0, 266, 267,
+	268, 269, 270, 271, 272, 273, 396, 274, 275, 276,
+	277, 0, 278, 279, 280, 281, 282, 283, 284, 285,
+	286, 287, 288, 0, 289, 290, 0, 291, 292, 293,
+	397, 294, 295, 296, 297, 298, 299, 300, 301, 0,
+	302, 303, 398, 304, 305, 429, 0, 0, 307, 399,
+	308, 309, 0, 310, 311, 400, 401, 312, 0, 313,
+	314, 315, 316, 317, 318, 319, 320, 321, 322, 323,
+	402, 0, 324, 325, 0, 326, 0, 327, 328, 329,
+	330, 331, 0, 431, 403, 0, 0, 430, 332, 404,
+	333, 405, 0, 334, 335, 336, 337, 338, 339, 340,
+	0, 0, 341, 342, 343, 344, 345, 346, 0, 0,
+	347, 348, 349, 350, 351, 406, 407, 0, 352, 0,
+	353, 354, 355, 356, 96, 0, 357, 0, 0, 358,
+	359, 360, 361, 362, 363, 364, 365, 0, 0, 0,
 	99, 100, 101, 102, 103, 104, 105, 106, 0, 107,
 	108, 109, 0, 0, 0, 0, 0, 0, 0, 110,
-	111, 0, 112, 113, 0, 114, 115, 116, 363, 364,
-	0, 365, 0, 366, 0, 117, 118, 119, 120, 121,
-	0, 0, 420, 122, 367, 368, 123, 0, 124, 125,
-	126, 127, 369, 0, 0, 0, 128, 129, 130, 131,
-	132, 0, 0, 133, 134, 135, 0, 136, 137, 138,
-	139, 140, 141, 0, 0, 142, 143, 144, 0, 0,
-	0, 0, 0, 0, 0, 145, 146, 147, 148, 149,
-	370, 150, 151, 371, 372, 152, 0, 153, 0, 154,
-	155, 156, 157, 158, 0, 159, 160, 161, 0, 0,
-	162, 163, 164, 165, 166, 0, 167, 168, 169, 0,
-	170, 171, 172, 0, 173, 174, 175, 176, 373, 177,
-	178, 179, 374, 0, 180, 0, 181, 182, 375, 183,
-	0, 184, 0, 185, 0, 0, 0, 186, 187, 188,
-	0, 189, 190, 376, 0, 377, 191, 0, 192, 193,
-	194, 195, 477, 197, 198, 199, 200, 0, 201, 202,
-	203, 204, 205, 206, 0, 207, 0, 378, 208, 209,
-	210, 211, 379, 380, 0, 381, 0, 212, 0, 213,
-	0, 214, 215, 216, 217, 218, 0, 0, 219, 382,
-	0, 220, 0, 0, 221, 222, 421, 0, 0, 223,
-	224, 225, 226, 227, 228, 229, 230, 231, 232, 233,
-	234, 235, 236, 422, 383, 0, 384, 237, 238, 385,
-	0, 239, 240, 241, 0, 386, 242, 387, 243, 244,
-	245, 0, 246, 0, 0, 247, 248, 0, 0, 249,
-	388, 0, 250, 0, 389, 251, 252, 253, 254, 255,
-	256, 257, 0, 258, 259, 390, 260, 391, 263, 261,
-	262, 0, 264, 265, 266, 267, 268, 269, 270, 271,
-	392, 272, 273, 274, 275, 0, 276, 277, 278, 279,
-	280, 281, 282, 283, 284, 285, 286, 0, 287, 288,
-	0, 289, 290, 291, 393, 292, 293, 294, 295, 296,
-	297, 298, 299, 0, 300, 301, 302, 303, 423, 0,
-	304, 305, 394, 306, 307, 0, 308, 309, 395, 310,
-	0, 311, 312, 313, 314, 315, 316, 317, 318, 319,
-	320, 321, 396, 0, 322, 323, 0, 324, 0, 325,
-	326, 327, 328, 329, 0, 425, 397, 0, 0, 424,
-	330, 398, 331, 399, 0, 332, 333, 334, 335, 336,
-	337, 338, 0, 0, 339, 340, 341, 342, 343, 0,
-	0, 344, 345, 346, 347, 348, 400, 401, 0, 349,
-	0, 350, 351, 352, 353, 96, 0, 354, 0, 0,
-	355, 356, 357, 358, 359, 360, 361, 362, 99, 100,
+	111, 0, 112, 113, 0, 114, 115, 116, 366, 367,
+	368, 0, 369, 0, 370, 0, 117, 118, 119, 120,
+	121, 122, 123, 0, 0, 426, 124, 371, 372, 125,
+	0, 126, 127, 128, 129, 373, 0, 0, 0, 130,
+	131, 132, 133, 134, 0, 0, 135, 136, 137, 0,
+	138, 139, 140, 141, 142, 143, 0, 0, 144, 145,
+	146, 0, 0, 0, 0, 0, 0, 0, 147, 148,
+	149, 150, 151, 374, 152, 153, 375, 376, 154, 0,
+	155, 0, 156, 157, 158, 159, 160, 0, 161, 162,
+	163, 0, 0, 164, 165, 166, 167, 168, 0, 169,
+	170, 171, 0, 172, 173, 174, 0, 175, 176, 177,
+	178, 377, 179, 180, 181, 378, 0, 182, 0, 183,
+	184, 379, 185, 0, 186, 0, 187, 0, 0, 0,
+	188, 189, 190, 0, 191, 192, 380, 0, 381, 193,
+	0, 194, 195, 196, 197, 562, 0, 199, 200, 201,
+	202, 0, 203, 204, 205, 206, 207, 208, 0, 209,
+	0, 382, 210, 211, 212, 213, 383, 384, 0, 385,
+	0, 214, 0, 215, 0, 216, 217, 218, 219, 220,
+	0, 0, 221, 386, 0, 222, 0, 0, 223, 224,
+	427, 0, 0, 225, 226, 227, 228, 229, 230, 231,
+	232, 233, 234, 235, 236, 237, 238, 428, 387, 0,
+	388, 239, 240, 389, 0, 241, 242, 243, 0, 390,
+	244, 391, 245, 246, 247, 0, 248, 0, 0, 249,
+	250, 0, 0, 251, 392, 0, 252, 0, 393, 253,
+	254, 255, 256, 257, 258, 259, 0, 260, 261, 394,
+	262, 395, 265, 263, 264, 0, 266, 267, 268, 269,
+	270, 271, 272, 273, 396, 274, 275, 276, 277, 0,
+	278, 279, 280, 281, 282, 283, 284, 285, 286, 287,
+	288, 0, 289, 290, 0, 291, 292, 293, 397, 294,
+	295, 296, 297, 298, 299, 300, 301, 0, 302, 303,
+	398, 304, 305, 429, 0, 306, 307, 399, 308, 309,
+	0, 310, 311, 400, 401, 312, 0, 313, 314, 315,
+	316, 317, 318, 319, 320, 321, 322, 323, 402, 0,
+	324, 325, 0, 326, 0, 327, 328, 329, 330, 331,
+	0, 431, 403, 0, 0, 430, 332, 404, 333, 405,
+	0, 334, 335, 336, 337, 338, 339, 340, 0, 0,
+	341, 342, 343, 344, 345, 346, 0, 0, 347, 348,
+	349, 350, 351, 406, 407, 0, 352, 0, 353, 354,
+	355, 356, 96, 0, 357, 0, 0, 358, 359, 360,
+	361, 362, 363, 364, 365, 0, 0, 0, 99, 100,
 	101, 102, 103, 104, 105, 106, 0, 107, 108, 109,
 	0, 0, 0, 0, 0, 0, 0, 110, 111, 0,
-	112, 113, 0, 114, 115, 116, 363, 364, 0, 365,
-	0, 366, 0, 117, 118, 119, 120, 121, 0, 0,
-	420, 122, 367, 368, 123, 0, 124, 125, 126, 127,
-	369, 0, 0, 0, 128, 129, 130, 131, 132, 0,
-	0, 133, 134, 135, 0, 136, 137, 138, 139, 140,
-	141, 0, 0, 142, 143, 144, 0, 0, 0, 0,
-	0, 0, 0, 145, 146, 147, 148, 149, 370, 150,
-	151, 371, 372, 152, 0, 153, 0, 154, 155, 156,
-	157, 158, 0, 159, 160, 161, 0, 0, 162, 163,
-	164, 165, 166, 0, 167, 168, 169, 0, 170, 171,
-	172, 0, 173, 174, 175, 176, 373, 177, 178, 179,
-	374, 0, 180, 0, 181, 182, 375, 183, 0, 184,
-	0, 185, 0, 0, 0, 186, 187, 188, 0, 189,
-	190, 376, 0, 377, 191, 0, 192, 193, 194, 195,
-	473, 197, 198, 199, 200, 0, 201, 202, 203, 204,
-	205, 206, 0, 207, 0, 378, 208, 209, 210, 211,
-	379, 380, 0, 381, 0, 212, 0, 213, 0, 214,
-	215, 216, 217, 218, 0, 0, 219, 382, 0, 220,
-	0, 0, 221, 222, 421, 0, 0, 223, 224, 225,
-	226, 227, 228, 229, 230, 231, 232, 233, 234, 235,
-	236, 422, 383, 0, 384, 237, 238, 385, 0, 239,
-	240, 241, 0, 386, 242, 387, 243, 244, 245, 0,
-	246, 0, 0, 247, 248, 0, 0, 249, 388, 0,
-	250, 0, 389, 251, 252, 253, 254, 255, 256, 257,
-	0, 258, 259, 390, 260, 391, 263, 261, 262, 0,
-	264, 265, 266, 267, 268, 269, 270, 271, 392, 272,
-	273, 274, 275, 0, 276, 277, 278, 279, 280, 281,
-	282, 283, 284, 285, 286, 0, 287, 288, 0, 289,
-	290, 291, 393, 292, 293, 294, 295, 296, 297, 298,
-	299, 0, 300, 301, 302, 303, 423, 0, 304, 305,
-	394, 306, 307, 0, 308, 309, 395, 310, 0, 311,
-	312, 313, 314, 315, 316, 317, 318, 319, 320, 321,
-	396, 0, 322, 323, 0, 324, 0, 325, 326, 327,
-	328, 329, 0, 425, 397, 0, 0, 424, 330, 398,
-	331, 399, 0, 332, 333, 334, 335, 336, 337, 338,
-	0, 0, 339, 340, 341, 342, 343, 0, 0, 344,
-	345, 346, 347, 348, 400, 401, 0, 349, 0, 350,
-	351, 352, 353, 96, 0, 354, 0, 0, 355, 356,
-	357, 358, 359, 360, 361, 362, 99, 100, 101, 102,
+	112, 113, 0, 114, 115, 116, 366, 367, 368, 0,
+	369, 0, 370, 0, 117, 118, 119, 120, 121, 122,
+	123, 0, 0, 92, 124, 371, 372, 529, 0, 126,
+	127, 128, 129, 373, 0, 0, 0, 130, 131, 132,
+	133, 134, 0, 0, 135, 136, 137, 0, 138, 139,
+	140, 141, 142, 143, 0, 0, 144, 145, 146, 0,
+	0, 0, 0, 0, 0, 0, 147, 148, 149, 150,
+	151, 374, 152, 153, 375, 376, 154, 0, 155, 0,
+	156, 157, 158, 159, 160, 0, 161, 162, 163, 0,
+	0, 164, 165, 166, 167, 168, 0, 169, 170, 171,
+	0, 172, 173, 174, 0, 175, 176, 177, 178, 377,
+	179, 180, 181, 378, 0, 182, 0, 183, 184, 379,
+	185, 0, 186, 0, 187, 0, 0, 0, 188, 189,
+	190, 0, 191, 192, 380, 0, 381, 193, 0, 194,
+	195, 196, 197, 198, 0, 199, 200, 201, 202, 0,
+	203, 204, 205, 206, 207, 208, 0, 209, 0, 382,
+	210, 211, 212, 213, 383, 384, 0, 385, 0, 214,
+	0, 215, 0, 216, 217, 218, 219, 220, 0, 0,
+	221, 386, 0, 222, 0, 0, 223, 224, 427, 0,
+	0, 225, 226, 227, 228, 229, 230, 231, 232, 233,
+	234, 235, 236, 237, 238, 93, 387, 0, 388, 239,
+	240, 389, 0, 241, 242, 243, 0, 390, 244, 391,
+	245, 246, 247, 0, 248, 0, 0, 249, 250, 0,
+	0, 251, 392, 0, 252, 0, 393, 253, 254, 255,
+	256, 257, 258, 259, 0, 260, 261, 394, 262, 395,
+	265, 263, 264, 0, 266, 267, 268, 269, 270, 271,
+	272, 273, 396, 274, 275, 276, 277, 0, 278, 279,
+	280, 281, 282, 283, 284, 285, 286, 287, 288, 0,
+	289, 290, 0, 291, 292, 293, 397, 294, 295, 296,
+	297, 298, 299, 300, 301, 0, 302, 303, 398, 304,
+	305, 527, 0, 306, 307, 399, 308, 309, 0, 310,
+	311, 400, 401, 312, 0, 313, 314, 315, 316, 317,
+	318, 319, 320, 321, 322, 323, 402, 0, 324, 325,
+	0, 326, 0, 327, 328, 329, 330, 331, 0, 91,
+	403, 0, 0, 87, 332, 404, 333, 405, 0, 334,
+	335, 336, 337, 338, 339, 340, 0, 0, 341, 342,
+	343, 344, 345, 346, 0, 0, 347, 348, 349, 350,
+	351, 406, 407, 0, 352, 0, 353, 354, 355, 356,
+	96, 0, 357, 0, 0, 358, 359, 360, 361, 362,
+	363, 364, 365, 0, 0, 0, 99, 100, 101, 102,
 	103, 104, 105, 106, 0, 107, 108, 109, 0, 0,
 	0, 0, 0, 0, 0, 110, 111, 0, 112, 113,
-	0, 114, 115, 116, 363, 364, 0, 365, 0, 366,
-	0, 117, 118, 119, 120, 121, 0, 0, 420, 122,
-	367, 368, 123, 0, 124, 125, 126, 127, 369, 0,
-	0, 0, 128, 129, 130, 131, 132, 0, 0, 133,
-	134, 135, 0, 136, 137, 138, 139, 140, 141, 0,
-	0, 142, 143, 144, 0, 0, 0, 0, 0, 0,
-	0, 145, 146, 147, 148, 149, 370, 150, 151, 371,
-	372, 152, 0, 153, 0, 154, 155, 156, 157, 158,
-	0, 159, 160, 161, 0, 0, 162, 163, 164, 165,
-	166, 0, 167, 168, 169, 0, 170, 171, 172, 0,
-	173, 174, 175, 176, 373, 177, 178, 179, 374, 0,
-	180, 0, 181, 182, 375, 183, 0, 184, 0, 185,
-	0, 0, 0, 186, 187, 188, 0, 189, 190, 376,
-	0, 377, 191, 0, 192, 193, 194, 195, 196, 197,
-	198, 199, 200, 0, 201, 202, 203, 204, 205, 206,
-	0, 207, 0, 378, 208, 209, 210, 211, 379, 380,
-	0, 381, 0, 212, 0, 213, 0, 214, 215, 216,
-	217, 218, 0, 0, 219, 382, 0, 220, 0, 0,
-	221, 222, 421, 0, 0, 223, 224, 225, 226, 227,
-	228, 229, 230, 231, 232, 233, 234, 235, 236, 422,
-	383, 0, 384, 237, 238, 385, 0, 239, 240, 241,
-	0, 386, 242, 387, 243, 244, 245, 0, 246, 0,
-	0, 247, 248, 0, 0, 249, 388, 0, 250, 0,
-	389, 251, 252, 253, 254, 255, 256, 257, 0, 258,
-	259, 390, 260, 391, 263, 261, 262, 0, 264, 265,
-	266, 267, 268, 269, 270, 271, 392, 272, 273, 453,
-	275, 0, 276, 277, 278, 279, 280, 281, 282, 283,
-	284, 285, 286, 0, 287, 288, 0, 289, 290, 291,
-	393, 292, 293, 294, 295, 296, 297, 298, 299, 0,
-	300, 301, 302, 303, 423, 0, 304, 305, 394, 306,
-	307, 0, 308, 309, 395, 310, 0, 311, 312, 313,
-	314, 315, 316, 317, 318, 319, 320, 321, 396, 0,
-	322, 323, 0, 324, 0, 325, 326, 327, 328, 329,
-	0, 425, 397, 0, 0, 424, 330, 398, 331, 399,
-	0, 332, 333, 334, 335, 336, 337, 338, 0, 0,
-	339, 340, 341, 342, 343, 0, 0, 344, 345, 346,
-	347, 348, 400, 401, 0, 349, 0, 350, 351, 352,
-	353, 96, 0, 354, 0, 0, 355, 356, 357, 358,
-	359, 360, 361, 362, 99, 100, 101, 102, 103, 104,
+	0, 114, 115, 116, 366, 367, 368, 0, 369, 0,
+	370, 0, 117, 118, 119, 120, 121, 122, 123, 0,
+	0, 426, 124, 371, 372, 125, 0, 126, 127, 128,
+	129, 373, 0, 0, 0, 130, 131, 132, 133, 134,
+	0, 0, 135, 136, 137, 0, 138, 139, 140, 141,
+	142, 143, 0, 0, 144, 145, 146, 0, 0, 0,
+	0, 0, 0, 0, 147, 148, 149, 150, 151, 374,
+	152, 153, 375, 376, 154, 0, 155, 0, 156, 157,
+	158, 159, 160, 0, 161, 162, 163, 0, 0, 164,
+	165, 166, 167, 168, 0, 169, 170, 171, 0, 172,
+	173, 174, 0, 175, 176, 177, 178, 377, 179, 180,
+	181, 378, 0, 182, 0, 183, 184, 379, 185, 0,
+	186, 0, 187, 0, 0, 0, 188, 189, 190, 0,
+	191, 192, 380, 0, 381, 193, 0, 194, 195, 196,
+	197, 522, 0, 199, 200, 201, 202, 0, 203, 204,
+	205, 206, 207, 208, 0, 209, 0, 382, 210, 211,
+	212, 213, 383, 384, 0, 385, 0, 214, 0, 215,
+	0, 216, 217, 218, 219, 220, 0, 0, 221, 386,
+	0, 222, 0, 0, 223, 224, 427, 0, 0, 225,
+	226, 227, 228, 229, 230, 231, 232, 233, 234, 235,
+	236, 237, 238, 428, 387, 0, 388, 239, 240, 389,
+	0, 241, 242, 243, 0, 390, 244, 391, 245, 246,
+	247, 0, 248, 0, 0, 249, 250, 0, 0, 251,
+	392, 0, 252, 0, 393, 253, 254, 255, 256, 257,
+	258, 259, 0, 260, 261, 394, 262, 395, 265, 263,
+	264, 0, 266, 267, 268, 269, 270, 271, 272, 273,
+	396, 274, 275, 276, 277, 0, 278, 279, 280, 281,
+	282, 283, 284, 285, 286, 287, 288, 0, 289, 290,
+	0, 291, 292, 293, 397, 294, 295, 296, 297, 298,
+	299, 300, 301, 0, 302, 303, 398, 304, 305, 429,
+	0, 306, 307, 399, 308, 309, 0, 310, 311, 400,
+	401, 312, 0, 313, 314, 315, 316, 317, 318, 319,
+	320, 321, 322, 323, 402, 0, 324, 325, 0, 326,
+	0, 327, 328, 329, 330, 331, 0, 431, 403, 0,
+	0, 430, 332, 404, 333, 405, 0, 334, 335, 336,
+	337, 338, 339, 340, 0, 0, 341, 342, 343, 344,
+	345, 346, 0, 0, 347, 348, 349, 350, 351, 406,
+	407, 0, 352, 0, 353, 354, 355, 356, 96, 0,
+	357, 0, 0, 358, 359, 360, 361, 362, 363, 364,
+	365, 0, 0, 0, 99, 100, 101, 102, 103, 104,
 	105, 106, 0, 107, 108, 109, 0, 0, 0, 0,
 	0, 0, 0, 110, 111, 0, 112, 113, 0, 114,
-	115, 116, 363, 364, 0, 365, 0, 366, 0, 117,
-	118, 119, 120, 121, 0, 0, 420, 122, 367, 368,
-	123, 0, 124, 125, 126, 127, 369, 0, 0, 0,
-	128, 129, 130, 131, 132, 0, 0, 133, 134, 135,
-	0, 136, 137, 138, 139, 140, 141, 0, 0, 142,
-	143, 144, 0, 0, 0, 0, 0, 0, 0, 145,
-	146, 147, 148, 149, 370, 150, 151, 371, 372, 152,
-	0, 153, 0, 154, 155, 156, 157, 158, 0, 159,
-	160, 161, 0, 0, 162, 163, 164, 165, 166, 0,
-	167, 168, 169, 0, 170, 171, 172, 0, 173, 174,
-	175, 176, 373, 177, 178, 179, 374, 0, 180, 0,
-	181, 182, 375, 183, 0, 184, 0, 185, 0, 0,
-	0, 186, 187, 188, 0, 189, 190, 376, 0, 377,
-	191, 0, 192, 193, 194, 195, 196, 197, 198, 199,
-	200, 0, 201, 202, 203, 204, 205, 206, 0, 207,
-	0, 378, 208, 209, 210, 211, 379, 380, 0, 381,
-	0, 212, 0, 213, 0, 214, 215, 216, 217, 218,
-	0, 0, 219, 382, 0, 220, 0, 0, 221, 222,
-	421, 0, 0, 223, 224, 225, 226, 227, 228, 229,
-	230, 231, 232, 233, 234, 235, 236, 422, 383, 0,
-	384, 237, 238, 385, 0, 239, 240, 241, 0, 386,
-	242, 387, 243, 244, 245, 0, 246, 0, 0, 247,
-	248, 0, 0, 249, 388, 0, 250, 0, 389, 251,
-	252, 253, 254, 255, 256, 257, 0, 258, 259, 390,
-	260, 391, 263, 261, 262, 0, 264, 265, 266, 267,
-	268, 269, 270, 271, 392, 272, 273, 274, 275, 0,
-	276, 277, 278, 279, 280, 281, 282, 283, 284, 285,
-	286, 0, 287, 288, 0, 289, 290, 291, 393, 292,
-	293, 426, 295, 296, 297, 298, 299, 0, 300, 301,
-	302, 303, 423, 0, 304, 305, 394, 306, 307, 0,
-	308, 309, 395, 310, 0, 311, 312, 313, 314, 315,
-	316, 317, 318, 319, 320, 321, 396, 0, 322, 323,
-	0, 324, 0, 325, 326, 327, 328, 329, 0, 425,
-	397, 0, 0, 424, 330, 398, 331, 399, 0, 332,
-	333, 334, 335, 336, 337, 338, 0, 0, 339, 340,
-	341, 342, 343, 0, 0, 344, 345, 346, 347, 348,
-	400, 401, 0, 349, 0, 350, 351, 352, 353, 96,
-	0, 354, 0, 0, 355, 356, 357, 358, 359, 360,
-	361, 362, 99, 100, 101, 102, 103, 104, 105, 106,
+	115, 116, 366, 367, 368, 0, 369, 0, 370, 0,
+	117, 118, 119, 120, 121, 122, 123, 0, 0, 426,
+	124, 371, 372, 125, 0, 126, 127, 128, 129, 373,
+	0, 0, 0, 130, 131, 132, 133, 134, 0, 0,
+	135, 136, 137, 0, 138, 139, 140, 141, 142, 143,
+	0, 0, 144, 145, 146, 0, 0, 0, 0, 0,
+	0, 0, 147, 148, 149, 150, 151, 374, 152, 153,
+	375, 376, 154, 0, 155, 0, 156, 157, 158, 159,
+	160, 0, 161, 162, 163, 0, 0, 164, 165, 166,
+	167, 168, 0, 169, 170, 171, 0, 172, 173, 174,
+	0, 175, 176, 177, 178, 377, 179, 180, 181, 378,
+	0, 182, 0, 183, 184, 379, 185, 0, 186, 0,
+	187, 0, 0, 0, 188, 189, 190, 0, 191, 192,
+	380, 0, 381, 193, 0, 194, 195, 196, 197, 486,
+	0, 199, 200, 201, 202, 0, 203, 204, 205, 206,
+	207, 208, 0, 209, 0, 382, 210, 211, 212, 213,
+	383, 384, 0, 385, 0, 214, 0, 215, 0, 216,
+	217, 218, 219, 220, 0, 0, 221, 386, 0, 222,
+	0, 0, 223, 224, 427, 0, 0, 225, 226, 227,
+	228, 229, 230, 231, 232, 233, 234, 235, 236, 237,
+	238, 428, 387, 0, 388, 239, 240, 389, 0, 241,
+	242, 243, 0, 390, 244, 391, 245, 246, 247, 0,
+	248, 0, 0, 249, 250, 0, 0, 251, 392, 0,
+	252, 0, 393, 253, 254, 255, 256, 257, 258, 259,
+	0, 260, 261, 394, 262, 395, 265, 263, 264, 0,
+	266, 267, 268, 269, 270, 271, 272, 273, 396, 274,
+	275, 276, 277, 0, 278, 279, 280, 281, 282, 283,
+	284, 285, 286, 287, 288, 0, 289, 290, 0, 291,
+	292, 293, 397, 294, 295, 296, 297, 298, 299, 300,
+	301, 0, 302, 303, 398, 304, 305, 429, 0, 306,
+	307, 399, 308, 309, 0, 310, 311, 400, 401, 312,
+	0, 313, 314, 315, 316, 317, 318, 319, 320, 321,
+	322, 323, 402, 0, 324, 325, 0, 326, 0, 327,
+	328, 329, 330, 331, 0, 431, 403, 0, 0, 430,
+	332, 404, 333, 405, 0, 334, 335, 336, 337, 338,
+	339, 340, 0, 0, 341, 342, 343, 344, 345, 346,
+	0, 0, 347, 348, 349, 350, 351, 406, 407, 0,
+	352, 0, 353, 354, 355, 356, 96, 0, 357, 0,
+	0, 358, 359, 360, 361, 362, 363, 364, 365, 0,
+	0, 0, 99, 100, 101, 102, 103, 104, 105, 106,
 	0, 107, 108, 109, 0, 0, 0, 0, 0, 0,
 	0, 110, 111, 0, 112, 113, 0, 114, 115, 116,
-	363, 364, 0, 365, 0, 366, 0, 117, 118, 119,
-	120, 121, 0, 0, 92, 122, 367, 368, 123, 0,
-	124, 125, 126, 127, 369, 0, 0, 0, 128, 129,
-	130, 131, 132, 0, 0, 133, 134, 135, 0, 136,
-	137, 138, 139, 140, 141, 0, 0, 142, 143, 144,
-	0, 0, 0, 0, 0, 0, 0, 145, 146, 147,
-	148, 149, 370, 150, 151, 371, 372, 152, 0, 153,
-	0, 154, 155, 156, 157, 158, 0, 159, 160, 161,
-	0, 0, 162, 163, 164, 165, 166, 0, 167, 168,
-	169, 0, 170, 171, 172, 0, 173, 174, 175, 176,
-	373, 177, 178, 179, 374, 0, 180, 0, 181, 182,
-	375, 183, 0, 184, 0, 185, 0, 0, 0, 186,
-	187, 188, 0, 189, 190, 376, 0, 377, 191, 0,
-	192, 193, 194, 195, 196, 197, 198, 199, 200, 0,
-	201, 202, 203, 204, 205, 206, 0, 207, 0, 378,
-	208, 209, 210, 211, 379, 380, 0, 381, 0, 212,
-	0, 213, 0, 214, 215, 216, 217, 218, 0, 0,
-	219, 382, 0, 220, 0, 0, 221, 222, 85, 0,
-	0, 223, 224, 225, 226, 227, 228, 229, 230, 231,
-	232, 233, 234, 235, 236, 93, 383, 0, 384, 237,
-	238, 385, 0, 239, 240, 241, 0, 386, 242, 387,
-	243, 244, 245, 0, 246, 0, 0, 247, 248, 0,
-	0, 249, 388, 0, 250, 0, 389, 251, 252, 253,
-	254, 255, 256, 257, 0, 258, 259, 390, 260, 391,
-	263, 261, 262, 0, 264, 265, 266, 267, 268, 269,
-	270, 271, 392, 272, 273, 274, 275, 0, 276, 277,
-	278, 279, 280, 281, 282, 283, 284, 285, 286, 0,
-	287, 288, 0, 289, 290, 291, 393, 292, 293, 294,
-	295, 296, 297, 298, 299, 0, 300, 301, 302, 303,
-	86, 0, 304, 305, 394, 306, 307, 0, 308, 309,
-	395, 310, 0, 311, 312, 313, 314, 315, 316, 317,
-	318, 319, 320, 321, 396, 0, 322, 323, 0, 324,
-	0, 325, 326, 327, 328, 329, 0, 91, 397, 0,
-	0, 87, 330, 398, 331, 399, 0, 332, 333, 334,
-	335, 336, 337, 338, 0, 0, 339, 340, 341, 342,
-	343, 0, 0, 344, 345, 346, 347, 348, 400, 401,
-	0, 349, 0, 350, 351, 352, 353, 1819, 0, 354,
-	0, 0, 355, 356, 357, 358, 359, 360, 361, 362,
+	366, 367, 368, 0, 369, 0, 370, 0, 117, 118,
+	119, 120, 121, 122, 123, 0, 0, 426, 124, 371,
+	372, 125, 0, 126, 127, 128, 129, 373, 0, 0,
+	0, 130, 131, 132, 133, 134, 0, 0, 135, 136,
+	137, 0, 138, 139, 140, 141, 142, 143, 0, 0,
+	144, 145, 146, 0, 0, 0, 0, 0, 0, 0,
+	147, 148, 149, 150, 151, 374, 152, 153, 375, 376,
+	154, 0, 155, 0, 156, 157, 158, 159, 160, 0,
+	161, 162, 163, 0, 0, 164, 165, 166, 167, 168,
+	0, 169, 170, 171, 0, 172, 173, 174, 0, 175,
+	176, 177, 178, 377, 179, 180, 181, 378, 0, 182,
+	0, 183, 184, 379, 185, 0, 186, 0, 187, 0,
+	0, 0, 188, 189, 190, 0, 191, 192, 380, 0,
+	381, 193, 0, 194, 195, 196, 197, 484, 0, 199,
+	200, 201, 202, 0, 203, 204, 205, 206, 207, 208,
+	0, 209, 0, 382, 210, 211, 212, 213, 383, 384,
+	0, 385, 0, 214, 0, 215, 0, 216, 217, 218,
+	219, 220, 0, 0, 221, 386, 0, 222, 0, 0,
+	223, 224, 427, 0, 0, 225, 226, 227, 228, 229,
+	230, 231, 232, 233, 234, 235, 236, 237, 238, 428,
+	387, 0, 388, 239, 240, 389, 0, 241, 242, 243,
+	0, 390, 244, 391, 245, 246, 247, 0, 248, 0,
+	0, 249, 250, 0, 0, 251, 392, 0, 252, 0,
+	393, 253, 254, 255, 256, 257, 258, 259, 0, 260,
+	261, 394, 262, 395, 265, 263, 264, 0, 266, 267,
+	268, 269, 270, 271, 272, 273, 396, 274, 275, 276,
+	277, 0, 278, 279, 280, 281, 282, 283, 284, 285,
+	286, 287, 288, 0, 289, 290, 0, 291, 292, 293,
+	397, 294, 295, 296, 297, 298, 299, 300, 301, 0,
+	302, 303, 398, 304, 305, 429, 0, 306, 307, 399,
+	308, 309, 0, 310, 311, 400, 401, 312, 0, 313,
+	314, 315, 316, 317, 318, 319, 320, 321, 322, 323,
+	402, 0, 324, 325, 0, 326, 0, 327, 328, 329,
+	330, 331, 0, 431, 403, 0, 0, 430, 332, 404,
+	333, 405, 0, 334, 335, 336, 337, 338, 339, 340,
+	0, 0, 341, 342, 343, 344, 345, 346, 0, 0,
+	347, 348, 349, 350, 351, 406, 407, 0, 352, 0,
+	353, 354, 355, 356, 96, 0, 357, 0, 0, 358,
+	359, 360, 361, 362, 363, 364, 365, 0, 0, 0,
 	99, 100, 101, 102, 103, 104, 105, 106, 0, 107,
 	108, 109, 0, 0, 0, 0, 0, 0, 0, 110,
-	111, 0, 112, 113, 490, 114, 115, 116, 0, 1167,
-	491, 1182, 1162, 1174, 0, 117, 118, 119, 120, 121,
-	0, 0, 420, 122, 1184, 1183, 123, 0, 124, 125,
-	126, 127, 0, 0, 492, 0, 128, 129, 130, 131,
-	132, 0, 493, 133, 134, 135, 0, 136, 137, 138,
-	139, 140, 141, 0, 494, 142, 143, 144, 0, 0,
-	0, 495, 0, 0, 0, 145, 146, 147, 148, 149,
-	1179, 150, 151, 1172, 1171, 152, 0, 153, 0, 154,
-	155, 156, 157, 158, 0, 159, 160, 161, 0, 0,
-	162, 163, 661, 165, 166, 0, 167, 168, 169, 0,
-	170, 171, 172, 0, 173, 174, 175, 176, 0, 177,
-	178, 179, 0, 0, 180, 0, 181, 182, 1169, 183,
-	0, 184, 0, 185, 496, 0, 497, 186, 187, 188,
-	0, 189, 190, 0, 0, 0, 191, 0, 192, 193,
-	194, 195, 196, 197, 198, 199, 200, 0, 201, 202,
-	203, 204, 205, 206, 0, 207, 498, 0, 208, 209,
-	210, 211, 1164, 1165, 0, 779, 0, 212, 499, 213,
-	500, 214, 215, 216, 217, 218, 0, 0, 219, 0,
-	501, 220, 502, 0, 221, 222, 421, 0, 0, 223,
-	224, 225, 226, 227, 228, 229, 230, 231, 232, 233,
-	234, 235, 236, 422, 0, 503, 0, 237, 238, 0,
-	0, 239, 240, 241, 0, 0, 242, 1173, 243, 244,
-	245, 0, 246, 0, 0, 247, 248, 0, 0, 249,
-	0, 504, 250, 505, 0, 251, 252, 253, 254, 255,
-	256, 257, 0, 258, 259, 0, 260, 0, 263, 261,
-	262, 0, 264, 265, 266, 267, 268, 269, 270, 271,
-	1168, 272, 273, 274, 275, 0, 276, 277, 278, 279,
-	280, 281, 282, 283, 284, 285, 286, 0, 287, 288,
-	506, 289, 290, 291, 0, 292, 293, 294, 295, 296,
-	297, 298, 299, 0, 300, 301, 302, 303, 423, 0,
-	304, 305, 0, 306, 307, 507, 308, 309, 1166, 310,
-	0, 311, 312, 313, 314, 315, 316, 317, 318, 319,
-	320, 321, 0, 0, 322, 323, 0, 324, 508, 325,
-	326, 327, 328, 1821, 0, 1181, 1180, 0, 0, 424,
-	330, 0, 331, 0, 0, 332, 333, 334, 335, 336,
-	337, 338, 0, 0, 339, 340, 341, 342, 343, 0,
-	0, 344, 345, 346, 347, 348, 0, 1185, 0, 349,
-	509, 350, 351, 352, 353, 96, 0, 354, 0, 0,
-	355, 356, 357, 358, 359, 360, 361, 362, 99, 100,
+	111, 0, 112, 113, 0, 114, 115, 116, 366, 367,
+	368, 0, 369, 0, 370, 0, 117, 118, 119, 120,
+	121, 122, 123, 0, 0, 426, 124, 371, 372, 125,
+	0, 126, 127, 128, 129, 373, 0, 0, 0, 130,
+	131, 132, 133, 134, 0, 0, 135, 136, 137, 0,
+	138, 139, 140, 141, 142, 143, 0, 0, 144, 145,
+	146, 0, 0, 0, 0, 0, 0, 0, 147, 148,
+	149, 150, 151, 374, 152, 153, 375, 376, 154, 0,
+	155, 0, 156, 157, 158, 159, 160, 0, 161, 162,
+	163, 0, 0, 164, 165, 166, 167, 168, 0, 169,
+	170, 171, 0, 172, 173, 174, 0, 175, 176, 177,
+	178, 377, 179, 180, 181, 378, 0, 182, 0, 183,
+	184, 379, 185, 0, 186, 0, 187, 0, 0, 0,
+	188, 189, 190, 0, 191, 192, 380, 0, 381, 193,
+	0, 194, 195, 196, 197, 481, 0, 199, 200, 201,
+	202, 0, 203, 204, 205, 206, 207, 208, 0, 209,
+	0, 382, 210, 211, 212, 213, 383, 384, 0, 385,
+	0, 214, 0, 215, 0, 216, 217, 218, 219, 220,
+	0, 0, 221, 386, 0, 222, 0, 0, 223, 224,
+	427, 0, 0, 225, 226, 227, 228, 229, 230, 231,
+	232, 233, 234, 235, 236, 237, 238, 428, 387, 0,
+	388, 239, 240, 389, 0, 241, 242, 243, 0, 390,
+	244, 391, 245, 246, 247, 0, 248, 0, 0, 249,
+	250, 0, 0, 251, 392, 0, 252, 0, 393, 253,
+	254, 255, 256, 257, 258, 259, 0, 260, 261, 394,
+	262, 395, 265, 263, 264, 0, 266, 267, 268, 269,
+	270, 271, 272, 273, 396, 274, 275, 276, 277, 0,
+	278, 279, 280, 281, 282, 283, 284, 285, 286, 287,
+	288, 0, 289, 290, 0, 291, 292, 293, 397, 294,
+	295, 296, 297, 298, 299, 300, 301, 0, 302, 303,
+	398, 304, 305, 429, 0, 306, 307, 399, 308, 309,
+	0, 310, 311, 400, 401, 312, 0, 313, 314, 315,
+	316, 317, 318, 319, 320, 321, 322, 323, 402, 0,
+	324, 325, 0, 326, 0, 327, 328, 329, 330, 331,
+	0, 431, 403, 0, 0, 430, 332, 404, 333, 405,
+	0, 334, 335, 336, 337, 338, 339, 340, 0, 0,
+	341, 342, 343, 344, 345, 346, 0, 0, 347, 348,
+	349, 350, 351, 406, 407, 0, 352, 0, 353, 354,
+	355, 356, 96, 0, 357, 0, 0, 358, 359, 360,
+	361, 362, 363, 364, 365, 0, 0, 0, 99, 100,
 	101, 102, 103, 104, 105, 106, 0, 107, 108, 109,
 	0, 0, 0, 0, 0, 0, 0, 110, 111, 0,
-	112, 113, 0, 114, 115, 116, 363, 364, 0, 365,
-	0, 366, 0, 117, 118, 119, 120, 121, 0, 0,
-	420, 122, 367, 368, 123, 0, 124, 125, 126, 127,
-	369, 0, 0, 0, 128, 129, 130, 131, 132, 0,
-	0, 133, 134, 135, 0, 136, 137, 138, 139, 140,
-	141, 0, 0, 142, 143, 144, 0, 0, 0, 0,
-	0, 0, 0, 145, 146, 147, 148, 149, 370, 150,
-	151, 371, 372, 152, 0, 153, 0, 154, 155, 156,
-	157, 158, 0, 159, 160, 161, 0, 0, 162, 163,
-	164, 165, 166, 0, 167, 168, 169, 0, 170, 171,
-	172, 0, 173, 174, 175, 176, 373, 177, 178, 179,
-	374, 0, 180, 0, 181, 182, 375, 183, 0, 184,
-	0, 185, 0, 0, 0, 186, 187, 188, 0, 189,
-	190, 376, 0, 377, 191, 0, 192, 193, 194, 195,
-	196, 197, 198, 199, 200, 0, 201, 202, 203, 204,
-	205, 206, 0, 207, 0, 378, 208, 209, 210, 211,
-	379, 380, 0, 381, 0, 212, 0, 213, 0, 214,
-	215, 216, 217, 218, 0, 0, 219, 382, 0, 220,
-	0, 0, 221, 222, 421, 0, 0, 223, 224, 225,
+	112, 113, 0, 114, 115, 116, 366, 367, 368, 0,
+	369, 0, 370, 0, 117, 118, 119, 120, 121, 122,
+	123, 0, 0, 426, 124, 371, 372, 125, 0, 126,
+	127, 128, 129, 373, 0, 0, 0, 130, 131, 132,
+	133, 134, 0, 0, 135, 136, 137, 0, 138, 139,
+	140, 141, 142, 143, 0, 0, 144, 145, 146, 0,
+	0, 0, 0, 0, 0, 0, 147, 148, 149, 150,
+	151, 374, 152, 153, 375, 376, 154, 0, 155, 0,
+	156, 157, 158, 159, 160, 0, 161, 162, 163, 0,
+	0, 164, 165, 166, 167, 168, 0, 169, 170, 171,
+	0, 172, 173, 174, 0, 175, 176, 177, 178, 377,
+	179, 180, 181, 378, 0, 182, 0, 183, 184, 379,
+	185, 0, 186, 0, 187, 0, 0, 0, 188, 189,
+	190, 0, 191, 192, 380, 0, 381, 193, 0, 194,
+	195, 196, 197, 198, 0, 199, 200, 201, 202, 0,
+	203, 204, 205, 206, 207, 208, 0, 209, 0, 382,
+	210, 211, 212, 213, 383, 384, 0, 385, 0, 214,
+	0, 215, 0, 216, 217, 218, 219, 220, 0, 0,
+	221, 386, 0, 222, 0, 0, 223, 224, 427, 0,
+	0, 225, 226, 227, 228, 229, 230, 231, 232, 233,
+	234, 235, 236, 237, 238, 428, 387, 0, 388, 239,
+	240, 389, 0, 241, 242, 243, 0, 390, 244, 391,
+	245, 246, 247, 0, 248, 0, 0, 249, 250, 0,
+	0, 251, 392, 0, 252, 0, 393, 253, 254, 255,
+	256, 257, 258, 259, 0, 260, 261, 394, 262, 395,
+	265, 263, 264, 0, 266, 267, 268, 269, 270, 271,
+	272, 273, 396, 274, 275, 459, 277, 0, 278, 279,
+	280, 281, 282, 283, 284, 285, 286, 287, 288, 0,
+	289, 290, 0, 291, 292, 293, 397, 294, 295, 296,
+	297, 298, 299, 300, 301, 0, 302, 303, 398, 304,
+	305, 429, 0, 306, 307, 399, 308, 309, 0, 310,
+	311, 400, 401, 312, 0, 313, 314, 315, 316, 317,
+	318, 319, 320, 321, 322, 323, 402, 0, 324, 325,
+	0, 326, 0, 327, 328, 329, 330, 331, 0, 431,
+	403, 0, 0, 430, 332, 404, 333, 405, 0, 334,
+	335, 336, 337, 338, 339, 340, 0, 0, 341, 342,
+	343, 344, 345, 346, 0, 0, 347, 348, 349, 350,
+	351, 406, 407, 0, 352, 0, 353, 354, 355, 356,
+	96, 0, 357, 0, 0, 358, 359, 360, 361, 362,
+	363, 364, 365, 0, 0, 0, 99, 100, 101, 102,
+	103, 104, 105, 106, 0, 107, 108, 109, 0, 0,
+	0, 0, 0, 0, 0, 110, 111, 0, 112, 113,
+	0, 114, 115, 116, 366, 367, 368, 0, 369, 0,
+	370, 0, 117, 118, 119, 120, 121, 122, 123, 0,
+	0, 426, 124, 371, 372, 125, 0, 126, 127, 128,
+	129, 373, 0, 0, 0, 130, 131, 132, 133, 134,
+	0, 0, 135, 136, 137, 0, 138, 139, 140, 141,
+	142, 143, 0, 0, 144, 145, 146, 0, 0, 0,
+	0, 0, 0, 0, 147, 148, 149, 150, 151, 374,
+	152, 153, 375, 376, 154, 0, 155, 0, 156, 157,
+	158, 159, 160, 0, 161, 162, 163, 0, 0, 164,
+	165, 166, 167, 168, 0, 169, 170, 171, 0, 172,
+	173, 174, 0, 175, 176, 177, 178, 377, 179, 180,
+	181, 378, 0, 182, 0, 183, 184, 379, 185, 0,
+	186, 0, 187, 0, 0, 0, 188, 189, 190, 0,
+	191, 192, 380, 0, 381, 193, 0, 194, 195, 196,
+	197, 198, 0, 199, 200, 201, 202, 0, 203, 204,
+	205, 206, 207, 208, 0, 209, 0, 382, 210, 211,
+	212, 213, 383, 384, 0, 385, 0, 214, 0, 215,
+	0, 216, 217, 218, 219, 220, 0, 0, 221, 386,
+	0, 222, 0, 0, 223, 224, 427, 0, 0, 225,
 	226, 227, 228, 229, 230, 231, 232, 233, 234, 235,
-	236, 422, 383, 0, 384, 237, 238, 385, 0, 239,
-	240, 241, 0, 386, 242, 387, 243, 244, 245, 0,
-	246, 0, 0, 247, 248, 0, 0, 249, 388, 0,
-	250, 0, 389, 251, 252, 253, 254, 0, 256, 257,
-	0, 258, 259, 390, 260, 391, 263, 261, 262, 0,
-	264, 265, 266, 267, 268, 269, 0, 271, 392, 272,
-	273, 274, 275, 0, 276, 277, 278, 279, 280, 281,
-	282, 283, 284, 285, 286, 0, 287, 288, 0, 289,
-	290, 291, 393, 0, 293, 294, 295, 296, 297, 298,
-	299, 0, 300, 301, 302, 303, 423, 0, 304, 305,
-	394, 306, 307, 0, 308, 309, 395, 310, 0, 311,
-	312, 313, 314, 315, 316, 317, 318, 319, 320, 321,
-	396, 0, 322, 323, 0, 324, 0, 325, 326, 327,
-	328, 329, 0, 425, 397, 0, 0, 424, 330, 398,
-	331, 399, 0, 332, 333, 334, 335, 336, 337, 338,
-	0, 0, 339, 340, 341, 342, 343, 0, 0, 344,
-	345, 346, 347, 348, 400, 401, 0, 349, 0, 350,
-	351, 352, 353, 0, 0, 354, 0, 0, 355, 356,
-	357, 358, 359, 360, 361, 362, 920, 0, 936, 937,
-	938, 0, 0, 0, 0, 1167, 0, 1182, 1162, 1174,
-	0, 0, 0, 0, 0, 920, 939, 936, 937, 938,
-	1184, 1183, 0, 0, 922, 0, 0, 0, 0, 0,
-	945, 0, 0, 0, 920, 939, 936, 937, 938, 0,
-	0, 0, 0, 922, 0, 0, 0, 0, 0, 945,
-	0, 0, 0, 0, 939, 921, 920, 0, 936, 937,
-	938, 0, 922, 935, 0, 0, 1179, 0, 945, 1172,
-	1171, 0, 0, 0, 921, 920, 939, 936, 937, 938,
-	0, 0, 935, 0, 922, 0, 0, 0, 1170, 0,
-	945, 0, 0, 921, 0, 939, 0, 0, 0, 0,
-	0, 935, 0, 922, 920, 0, 936, 937, 938, 945,
-	0, 0, 0, 0, 1169, 921, 0, 0, 0, 0,
-	0, 0, 0, 935, 939, 0, 0, 0, 0, 0,
-	0, 0, 922, 0, 921, 0, 0, 0, 945, 0,
-	0, 0, 935, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 1164, 1165,
-	0, 779, 0, 921, 0, 0, 0, 0, 0, 946,
-	0, 935, 0, 0, 920, 0, 936, 937, 938, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 946, 0,
-	944, 0, 0, 0, 939, 0, 0, 0, 0, 0,
-	0, 0, 922, 0, 941, 0, 0, 946, 945, 944,
-	0, 0, 0, 1173, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 941, 0, 0, 0, 0, 944, 946,
-	0, 0, 0, 921, 0, 0, 0, 0, 0, 0,
-	0, 935, 941, 0, 0, 0, 0, 0, 946, 940,
-	944, 0, 0, 0, 0, 0, 1168, 0, 0, 0,
-	0, 0, 0, 0, 941, 0, 0, 0, 940, 944,
-	0, 0, 0, 0, 0, 0, 0, 946, 0, 0,
-	0, 0, 0, 941, 0, 0, 0, 940, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 944, 0,
-	0, 0, 0, 0, 1166, 0, 0, 0, 0, 940,
-	0, 0, 941, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 943, 940, 1163,
-	0, 1181, 1180, 0, 0, 0, 0, 0, 0, 0,
-	0, 920, 0, 936, 937, 938, 943, 946, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 940, 0, 0,
-	0, 939, 0, 1185, 0, 943, 0, 0, 944, 922,
+	236, 237, 238, 428, 387, 0, 388, 239, 240, 389,
+	0, 241, 242, 243, 0, 390, 244, 391, 245, 246,
+	247, 0, 248, 0, 0, 249, 250, 0, 0, 251,
+	392, 0, 252, 0, 393, 253, 254, 255, 256, 257,
+	258, 259, 0, 260, 261, 394, 262, 395, 265, 263,
+	264, 0, 266, 267, 268, 269, 270, 271, 272, 273,
+	396, 274, 275, 276, 277, 0, 278, 279, 280, 281,
+	282, 283, 284, 285, 286, 287, 288, 0, 289, 290,
+	0, 291, 292, 293, 397, 294, 295, 432, 297, 298,
+	299, 300, 301, 0, 302, 303, 398, 304, 305, 429,
+	0, 306, 307, 399, 308, 309, 0, 310, 311, 400,
+	401, 312, 0, 313, 314, 315, 316, 317, 318, 319,
+	320, 321, 322, 323, 402, 0, 324, 325, 0, 326,
+	0, 327, 328, 329, 330, 331, 0, 431, 403, 0,
+	0, 430, 332, 404, 333, 405, 0, 334, 335, 336,
+	337, 338, 339, 340, 0, 0, 341, 342, 343, 344,
+	345, 346, 0, 0, 347, 348, 349, 350, 351, 406,
+	407, 0, 352, 0, 353, 354, 355, 356, 96, 0,
+	357, 0, 0, 358, 359, 360, 361, 362, 363, 364,
+	365, 0, 0, 0, 99, 100, 101, 102, 103, 104,
+	105, 106, 0, 107, 108, 109, 0, 0, 0, 0,
+	0, 0, 0, 110, 111, 0, 112, 113, 0, 114,
+	115, 116, 366, 367, 368, 0, 369, 0, 370, 0,
+	117, 118, 119, 120, 121, 122, 123, 0, 0, 92,
+	124, 371, 372, 125, 0, 126, 127, 128, 129, 373,
+	0, 0, 0, 130, 131, 132, 133, 134, 0, 0,
+	135, 136, 137, 0, 138, 139, 140, 141, 142, 143,
+	0, 0, 144, 145, 146, 0, 0, 0, 0, 0,
+	0, 0, 147, 148, 149, 150, 151, 374, 152, 153,
+	375, 376, 154, 0, 155, 0, 156, 157, 158, 159,
+	160, 0, 161, 162, 163, 0, 0, 164, 165, 166,
+	167, 168, 0, 169, 170, 171, 0, 172, 173, 174,
+	0, 175, 176, 177, 178, 377, 179, 180, 181, 378,
+	0, 182, 0, 183, 184, 379, 185, 0, 186, 0,
+	187, 0, 0, 0, 188, 189, 190, 0, 191, 192,
+	380, 0, 381, 193, 0, 194, 195, 196, 197, 198,
+	0, 199, 200, 201, 202, 0, 203, 204, 205, 206,
+	207, 208, 0, 209, 0, 382, 210, 211, 212, 213,
+	383, 384, 0, 385, 0, 214, 0, 215, 0, 216,
+	217, 218, 219, 220, 0, 0, 221, 386, 0, 222,
+	0, 0, 223, 224, 85, 0, 0, 225, 226, 227,
+	228, 229, 230, 231, 232, 233, 234, 235, 236, 237,
+	238, 93, 387, 0, 388, 239, 240, 389, 0, 241,
+	242, 243, 0, 390, 244, 391, 245, 246, 247, 0,
+	248, 0, 0, 249, 250, 0, 0, 251, 392, 0,
+	252, 0, 393, 253, 254, 255, 256, 257, 258, 259,
+	0, 260, 261, 394, 262, 395, 265, 263, 264, 0,
+	266, 267, 268, 269, 270, 271, 272, 273, 396, 274,
+	275, 276, 277, 0, 278, 279, 280, 281, 282, 283,
+	284, 285, 286, 287, 288, 0, 289, 290, 0, 291,
+	292, 293, 397, 294, 295, 296, 297, 298, 299, 300,
+	301, 0, 302, 303, 398, 304, 305, 86, 0, 306,
+	307, 399, 308, 309, 0, 310, 311, 400, 401, 312,
+	0, 313, 314, 315, 316, 317, 318, 319, 320, 321,
+	322, 323, 402, 0, 324, 325, 0, 326, 0, 327,
+	328, 329, 330, 331, 0, 91, 403, 0, 0, 87,
+	332, 404, 333, 405, 0, 334, 335, 336, 337, 338,
+	339, 340, 0, 0, 341, 342, 343, 344, 345, 346,
+	0, 0, 347, 348, 349, 350, 351, 406, 407, 0,
+	352, 0, 353, 354, 355, 356, 96, 0, 357, 0,
+	0, 358, 359, 360, 361, 362, 363, 364, 365, 0,
+	0, 0, 99, 100, 101, 102, 103, 104, 105, 106,
+	0, 107, 108, 109, 0, 0, 0, 0, 0, 0,
+	0, 110, 111, 0, 112, 113, 0, 114, 115, 116,
+	366, 367, 368, 0, 369, 0, 370, 0, 117, 118,
+	119, 120, 121, 122, 123, 0, 0, 426, 124, 371,
+	372, 125, 0, 126, 127, 128, 129, 373, 0, 0,
+	0, 130, 131, 132, 133, 134, 0, 0, 135, 136,
+	137, 0, 138, 139, 140, 141, 142, 143, 0, 0,
+	144, 145, 146, 0, 0, 0, 0, 0, 0, 0,
+	147, 148, 149, 150, 151, 374, 152, 153, 375, 376,
+	154, 0, 155, 0, 156, 157, 158, 159, 160, 0,
+	161, 162, 163, 0, 0, 164, 165, 166, 167, 168,
+	0, 169, 170, 171, 0, 172, 173, 174, 0, 175,
+	176, 177, 178, 377, 179, 180, 181, 378, 0, 182,
+	0, 183, 184, 379, 185, 0, 186, 0, 187, 0,
+	0, 0, 188, 189, 190, 0, 191, 192, 380, 0,
+	381, 193, 0, 194, 195, 196, 197, 198, 0, 199,
+	200, 201, 202, 0, 203, 204, 205, 206, 207, 208,
+	0, 209, 0, 382, 210, 211, 212, 213, 383, 384,
+	0, 385, 0, 214, 0, 215, 0, 216, 217, 218,
+	219, 220, 0, 0, 221, 386, 0, 222, 0, 0,
+	223, 224, 427, 0, 0, 225, 226, 227, 228, 229,
+	230, 231, 232, 233, 234, 235, 236, 237, 238, 428,
+	387, 0, 388, 239, 240, 389, 0, 241, 242, 243,
+	0, 390, 244, 391, 245, 246, 247, 0, 248, 0,
+	0, 249, 250, 0, 0, 251, 392, 0, 252, 0,
+	393, 253, 254, 255, 256, 0, 258, 259, 0, 260,
+	261, 394, 262, 395, 265, 263, 264, 0, 266, 267,
+	268, 269, 270, 271, 0, 273, 396, 274, 275, 276,
+	277, 0, 278, 279, 280, 281, 282, 283, 284, 285,
+	286, 287, 288, 0, 289, 290, 0, 291, 292, 293,
+	397, 0, 295, 296, 297, 298, 299, 300, 301, 0,
+	302, 303, 398, 304, 305, 429, 0, 306, 307, 399,
+	308, 309, 0, 310, 311, 400, 401, 312, 0, 313,
+	314, 315, 316, 317, 318, 319, 320, 321, 322, 323,
+	402, 0, 324, 325, 0, 326, 0, 327, 328, 329,
+	330, 331, 0, 431, 403, 0, 0, 430, 332, 404,
+	333, 405, 0, 334, 335, 336, 337, 338, 339, 340,
+	0, 0, 341, 342, 343, 344, 345, 346, 0, 0,
+	347, 348, 349, 350, 351, 406, 407, 0, 352, 0,
+	353, 354, 355, 356, 0, 0, 357, 0, 0, 358,
+	359, 360, 361, 362, 363, 364, 365, 916, 0, 932,
+	933, 934, 942, 943, 944, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 916,
+	935, 932, 933, 934, 942, 943, 944, 0, 918, 0,
+	0, 0, 0, 0, 946, 0, 0, 0, 0, 0,
+	0, 916, 935, 932, 933, 934, 942, 943, 944, 0,
+	918, 0, 0, 0, 0, 0, 946, 0, 0, 0,
+	0, 0, 917, 0, 935, 1545, 0, 1559, 1560, 1561,
+	931, 0, 918, 0, 0, 0, 0, 0, 946, 0,
+	0, 0, 0, 0, 917, 0, 0, 0, 1705, 0,
+	0, 0, 931, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 916, 917, 932, 933, 934,
+	942, 943, 944, 0, 931, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 916, 935, 932,
+	933, 934, 942, 943, 944, 0, 918, 0, 1558, 0,
+	0, 0, 946, 0, 0, 0, 0, 0, 0, 0,
+	935, 1545, 0, 1559, 1560, 1561, 0, 0, 918, 0,
+	0, 0, 0, 0, 946, 0, 0, 0, 0, 0,
+	917, 0, 939, 0, 1704, 0, 0, 947, 931, 0,
+	916, 0, 932, 933, 934, 942, 943, 944, 0, 0,
+	0, 0, 917, 0, 939, 0, 0, 0, 945, 947,
+	931, 0, 0, 935, 0, 0, 0, 0, 0, 0,
+	0, 918, 937, 0, 0, 0, 939, 946, 0, 0,
+	945, 947, 0, 0, 1558, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 937, 0, 0, 0, 0, 0,
+	0, 0, 945, 0, 0, 917, 0, 0, 0, 0,
+	0, 0, 0, 931, 0, 0, 937, 936, 0, 0,
+	0, 0, 0, 0, 0, 0, 1562, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 936,
+	939, 0, 0, 0, 0, 947, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 936, 939, 0, 0, 0, 945, 947, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	937, 0, 0, 0, 0, 0, 0, 0, 945, 0,
+	0, 0, 0, 0, 0, 0, 940, 0, 0, 0,
+	0, 0, 937, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 1562, 0, 0, 939, 0, 0, 940, 0,
+	947, 0, 0, 0, 0, 936, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	940, 945, 0, 0, 0, 0, 0, 936, 0, 0,
+	0, 0, 0, 0, 0, 937, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 938, 0, 0, 928, 929, 930, 0, 919, 920,
+	921, 922, 923, 925, 926, 924, 927, 0, 941, 0,
+	936, 0, 1799, 938, 940, 0, 928, 929, 930, 0,
+	919, 920, 921, 922, 923, 925, 926, 924, 927, 0,
+	941, 0, 0, 0, 1736, 938, 940, 0, 928, 929,
+	930, 0, 919, 920, 921, 922, 923, 925, 926, 924,
+	927, 0, 941, 0, 0, 0, 1711, 0, 0, 0,
+	0, 0, 1555, 1556, 1557, 0, 1546, 1547, 1548, 1549,
+	1550, 1552, 1553, 1551, 1554, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 940,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 938,
+	0, 0, 928, 929, 930, 0, 919, 920, 921, 922,
+	923, 925, 926, 924, 927, 0, 941, 0, 0, 0,
+	1655, 938, 0, 0, 928, 929, 930, 0, 919, 920,
+	921, 922, 923, 925, 926, 924, 927, 0, 941, 0,
+	0, 0, 1248, 0, 0, 0, 0, 0, 1555, 1556,
+	1557, 0, 1546, 1547, 1548, 1549, 1550, 1552, 1553, 1551,
+	1554, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 938, 0, 0, 928, 929, 930,
+	0, 919, 920, 921, 922, 923, 925, 926, 924, 927,
+	0, 941, 916, 1782, 932, 933, 934, 942, 943, 944,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 935, 0, 0, 0, 1136,
+	0, 0, 0, 918, 0, 0, 0, 0, 0, 946,
+	916, 0, 932, 933, 934, 942, 943, 944, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 935, 0, 0, 0, 917, 0, 0,
+	0, 918, 0, 0, 0, 931, 0, 946, 916, 0,
+	932, 933, 934, 942, 943, 944, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 935, 0, 0, 0, 917, 0, 0, 0, 918,
+	0, 0, 0, 931, 950, 946, 0, 0, 1137, 0,
+	0, 916, 0, 932, 933, 934, 942, 943, 944, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 917, 935, 0, 0, 949, 0, 0,
+	0, 931, 918, 0, 0, 0, 0, 0, 946, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 939, 0, 0,
+	0, 0, 947, 0, 0, 0, 917, 0, 0, 0,
+	0, 2161, 0, 0, 931, 916, 0, 932, 933, 934,
+	942, 943, 944, 945, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 939, 0, 937, 935, 0,
+	947, 0, 0, 0, 0, 0, 918, 0, 0, 0,
+	1573, 0, 946, 0, 0, 1572, 0, 0, 0, 0,
+	0, 945, 0, 916, 0, 932, 933, 934, 942, 943,
+	944, 0, 0, 939, 0, 937, 0, 0, 947, 0,
+	917, 0, 936, 0, 0, 0, 935, 0, 931, 0,
+	0, 0, 0, 0, 918, 0, 0, 0, 0, 945,
+	946, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 937, 0, 0, 939, 0, 0, 0,
+	936, 947, 0, 0, 0, 0, 0, 0, 917, 0,
+	0, 0, 0, 0, 0, 0, 931, 0, 0, 2160,
+	0, 0, 945, 0, 916, 0, 932, 933, 934, 942,
+	943, 944, 0, 0, 0, 0, 937, 0, 936, 0,
+	0, 940, 0, 0, 0, 0, 0, 935, 0, 0,
+	1574, 0, 0, 0, 0, 918, 0, 0, 0, 0,
+	0, 946, 0, 0, 0, 0, 0, 0, 0, 0,
+	939, 0, 0, 0, 0, 947, 0, 0, 0, 940,
+	0, 936, 0, 0, 0, 0, 0, 0, 0, 917,
+	0, 0, 0, 0, 0, 0, 945, 931, 1134, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	937, 0, 0, 0, 0, 0, 0, 940, 939, 0,
+	0, 0, 0, 947, 0, 0, 938, 0, 0, 928,
+	929, 930, 0, 919, 920, 921, 922, 923, 925, 926,
+	924, 927, 0, 941, 945, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 936, 454, 0, 937, 0,
+	940, 0, 0, 0, 938, 0, 0, 928, 929, 930,
+	0, 919, 920, 921, 922, 923, 925, 926, 924, 927,
+	0, 941, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 939,
+	0, 0, 938, 936, 947, 928, 929, 930, 0, 919,
+	920, 921, 922, 923, 925, 926, 924, 927, 0, 941,
 	0, 0, 0, 0, 0, 945, 0, 0, 0, 0,
-	0, 0, 941, 0, 0, 0, 0, 943, 0, 0,
+	0, 0, 0, 0, 940, 0, 0, 0, 0, 937,
+	0, 0, 0, 0, 0, 938, 0, 0, 928, 929,
+	930, 0, 919, 920, 921, 922, 923, 925, 926, 924,
+	927, 0, 941, 0, 0, 0, 0, 0, 0, 0,
+	916, 0, 932, 933, 934, 942, 943, 944, 0, 0,
+	0, 0, 940, 0, 936, 0, 0, 0, 0, 0,
+	0, 0, 0, 935, 0, 0, 0, 0, 0, 0,
+	0, 918, 0, 0, 0, 0, 0, 946, 0, 0,
+	0, 0, 1730, 0, 0, 0, 0, 0, 0, 938,
+	0, 0, 928, 929, 930, 0, 919, 920, 921, 922,
+	923, 925, 926, 924, 927, 917, 941, 0, 0, 0,
+	0, 0, 0, 931, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	921, 0, 0, 0, 0, 0, 943, 0, 935, 0,
-	942, 0, 0, 932, 933, 934, 0, 923, 924, 925,
-	926, 927, 929, 930, 928, 931, 0, 940, 0, 942,
-	0, 1916, 932, 933, 934, 943, 923, 924, 925, 926,
-	927, 929, 930, 928, 931, 0, 0, 0, 942, 0,
-	1892, 932, 933, 934, 0, 923, 924, 925, 926, 927,
-	929, 930, 928, 931, 0, 0, 0, 0, 0, 1783,
-	942, 0, 0, 932, 933, 934, 0, 923, 924, 925,
-	926, 927, 929, 930, 928, 931, 0, 0, 0, 942,
-	0, 1719, 932, 933, 934, 0, 923, 924, 925, 926,
-	927, 929, 930, 928, 931, 943, 0, 0, 0, 0,
-	1694, 0, 0, 0, 946, 0, 0, 0, 942, 920,
-	0, 932, 933, 934, 0, 923, 924, 925, 926, 927,
-	929, 930, 928, 931, 0, 944, 0, 0, 0, 1640,
-	0, 0, 0, 0, 0, 0, 0, 922, 920, 941,
-	936, 937, 938, 945, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 939, 0,
-	0, 0, 1134, 0, 0, 0, 922, 0, 921, 0,
-	0, 0, 945, 0, 0, 0, 935, 0, 942, 0,
-	0, 932, 933, 934, 940, 923, 924, 925, 926, 927,
-	929, 930, 928, 931, 0, 0, 0, 921, 920, 1235,
-	936, 937, 938, 0, 0, 935, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 939, 0,
-	0, 0, 0, 0, 0, 0, 922, 920, 0, 936,
-	937, 938, 945, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 949, 0, 0, 0, 0, 939, 1135, 920,
-	0, 936, 937, 938, 0, 922, 0, 921, 0, 0,
-	0, 945, 943, 0, 0, 935, 0, 0, 0, 939,
-	0, 0, 948, 0, 0, 0, 0, 922, 0, 0,
-	0, 0, 946, 945, 0, 0, 921, 0, 0, 0,
-	0, 0, 0, 0, 935, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 921, 0,
-	0, 946, 0, 0, 0, 0, 935, 941, 0, 0,
+	0, 0, 0, 940, 0, 0, 0, 938, 0, 0,
+	928, 929, 930, 0, 919, 920, 921, 922, 923, 925,
+	926, 924, 927, 0, 941, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 916,
+	0, 932, 933, 934, 942, 943, 944, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 935, 0, 0, 0, 0, 1579, 0, 0,
+	918, 0, 0, 0, 0, 0, 946, 0, 0, 0,
+	0, 0, 0, 0, 0, 939, 0, 0, 938, 0,
+	947, 928, 929, 930, 0, 919, 920, 921, 922, 923,
+	925, 926, 924, 927, 917, 941, 0, 0, 0, 0,
+	0, 945, 931, 0, 916, 0, 932, 933, 934, 942,
+	943, 944, 0, 0, 0, 937, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 935, 0, 0,
+	1538, 0, 0, 0, 0, 918, 0, 0, 0, 0,
+	0, 946, 916, 0, 932, 933, 934, 942, 943, 944,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	936, 0, 0, 0, 0, 935, 0, 0, 0, 917,
+	0, 0, 0, 918, 0, 0, 0, 931, 0, 946,
+	916, 0, 932, 933, 934, 942, 943, 944, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 935, 939, 0, 0, 917, 0, 947,
+	0, 918, 0, 0, 0, 931, 0, 946, 916, 0,
+	932, 933, 934, 942, 943, 944, 0, 0, 0, 0,
+	945, 0, 0, 0, 0, 0, 0, 0, 0, 940,
+	0, 0, 0, 0, 937, 917, 0, 0, 0, 918,
+	0, 0, 0, 931, 0, 946, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 939,
+	0, 0, 0, 917, 947, 0, 0, 0, 0, 936,
+	0, 931, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 1543, 945, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 939, 0, 937,
+	0, 0, 947, 0, 938, 0, 0, 928, 929, 930,
+	0, 919, 920, 921, 922, 923, 925, 926, 924, 927,
+	0, 941, 0, 945, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 939, 0, 937, 0, 0,
+	947, 0, 0, 0, 936, 0, 0, 0, 940, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 944, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 2161, 0, 942, 941, 0, 932, 933,
-	934, 0, 923, 924, 925, 926, 927, 929, 930, 928,
-	931, 0, 0, 0, 1765, 0, 0, 0, 0, 0,
-	0, 946, 0, 1556, 0, 0, 0, 920, 1555, 936,
-	937, 938, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 940, 944, 0, 0, 0, 0, 939, 0, 0,
-	946, 0, 0, 0, 0, 922, 941, 0, 0, 0,
 	0, 945, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 944, 946, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 941, 921, 0, 0, 0,
-	943, 0, 0, 944, 935, 0, 0, 0, 0, 0,
-	0, 940, 0, 0, 0, 0, 0, 941, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 943,
-	2160, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	940, 0, 0, 0, 0, 0, 920, 0, 936, 937,
-	938, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 940, 0, 0, 0, 939, 0, 0, 0,
-	0, 0, 0, 0, 922, 0, 0, 0, 0, 0,
-	945, 0, 0, 942, 1132, 0, 0, 0, 0, 943,
-	923, 924, 925, 926, 927, 929, 930, 928, 931, 0,
-	0, 0, 0, 0, 0, 921, 0, 0, 0, 0,
-	946, 0, 942, 935, 0, 932, 933, 934, 943, 923,
-	924, 925, 926, 927, 929, 930, 928, 931, 0, 0,
-	920, 944, 936, 937, 938, 0, 0, 0, 0, 0,
-	943, 0, 0, 0, 0, 941, 0, 0, 0, 0,
-	939, 0, 0, 0, 0, 0, 0, 0, 922, 0,
-	0, 0, 0, 0, 945, 0, 0, 0, 0, 0,
-	0, 0, 942, 0, 0, 932, 933, 934, 0, 923,
-	924, 925, 926, 927, 929, 930, 928, 931, 0, 921,
-	940, 448, 0, 0, 0, 0, 0, 935, 0, 0,
-	0, 942, 0, 0, 932, 933, 934, 0, 923, 924,
-	925, 926, 927, 929, 930, 928, 931, 0, 0, 946,
-	0, 0, 0, 942, 0, 0, 932, 933, 934, 0,
-	923, 924, 925, 926, 927, 929, 930, 928, 931, 920,
-	944, 936, 937, 938, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 941, 0, 0, 0, 0, 939,
-	0, 0, 1557, 0, 0, 0, 0, 922, 943, 0,
-	0, 0, 0, 945, 0, 0, 0, 0, 0, 0,
-	0, 1562, 920, 0, 936, 937, 938, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 921, 940,
-	0, 0, 939, 946, 0, 0, 935, 0, 0, 920,
-	922, 936, 937, 938, 0, 0, 945, 0, 0, 0,
-	0, 0, 0, 0, 944, 0, 0, 0, 0, 939,
-	0, 0, 1521, 0, 0, 0, 0, 922, 941, 0,
-	0, 921, 0, 945, 0, 0, 0, 0, 0, 935,
-	0, 942, 0, 0, 932, 933, 934, 0, 923, 924,
-	925, 926, 927, 929, 930, 928, 931, 920, 921, 936,
-	937, 938, 0, 0, 0, 0, 935, 943, 0, 0,
-	0, 0, 0, 940, 0, 0, 0, 939, 0, 0,
-	920, 0, 936, 937, 938, 922, 0, 0, 0, 0,
-	0, 945, 0, 0, 0, 0, 1713, 0, 0, 0,
-	939, 0, 946, 0, 0, 0, 0, 0, 922, 0,
-	0, 0, 0, 0, 945, 0, 921, 0, 920, 0,
-	936, 937, 938, 944, 935, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 941, 0, 921,
-	0, 0, 0, 0, 0, 946, 922, 935, 0, 0,
-	942, 943, 945, 932, 933, 934, 0, 923, 924, 925,
-	926, 927, 929, 930, 928, 931, 944, 0, 0, 0,
-	0, 0, 946, 0, 0, 0, 0, 921, 0, 0,
-	941, 0, 940, 0, 0, 935, 0, 0, 0, 0,
-	0, 0, 0, 944, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 941, 0, 0,
+	0, 0, 0, 939, 0, 937, 0, 0, 947, 0,
+	0, 0, 936, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 945,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 940, 0, 0, 0, 0,
-	946, 0, 0, 0, 942, 0, 0, 932, 933, 934,
-	1526, 923, 924, 925, 926, 927, 929, 930, 928, 931,
-	0, 944, 940, 946, 0, 0, 0, 0, 0, 0,
-	943, 0, 0, 0, 0, 941, 0, 0, 0, 0,
-	0, 0, 0, 0, 944, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 941, 0,
-	0, 946, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 943, 0, 0, 0, 0, 0, 0,
-	940, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 941, 0, 0, 0,
-	943, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 942, 0, 0, 932, 933, 934, 0,
-	923, 924, 925, 926, 927, 929, 930, 928, 931, 0,
+	0, 0, 0, 937, 0, 0, 1174, 1170, 0, 1189,
+	1164, 1181, 0, 940, 1166, 1165, 0, 0, 0, 0,
+	0, 0, 0, 938, 1191, 1190, 928, 929, 930, 0,
+	919, 920, 921, 922, 923, 925, 926, 924, 927, 0,
+	941, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 940, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	1186, 0, 0, 1179, 1178, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 940,
+	0, 0, 1177, 0, 0, 0, 0, 0, 938, 0,
+	0, 928, 929, 930, 0, 919, 920, 921, 922, 923,
+	925, 926, 924, 927, 0, 941, 0, 0, 1176, 0,
+	0, 0, 0, 0, 0, 0, 0, 940, 0, 0,
+	0, 0, 0, 0, 0, 0, 938, 0, 0, 928,
+	929, 930, 0, 919, 920, 921, 922, 923, 925, 926,
+	924, 927, 0, 941, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 1171, 1172, 0, 775, 0, 0, 0,
+	0, 0, 0, 0, 938, 0, 0, 928, 929, 930,
+	0, 919, 920, 921, 922, 923, 925, 926, 924, 927,
+	0, 941, 0, 0, 0, 1174, 1170, 0, 1189, 1164,
+	1181, 0, 0, 1166, 1165, 0, 0, 0, 0, 0,
+	0, 0, 938, 1191, 1190, 928, 929, 930, 1180, 919,
+	920, 921, 922, 923, 925, 926, 924, 927, 0, 941,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 1186,
+	0, 1175, 1179, 1178, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 1177, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 1168, 0, 0,
+	0, 0, 0, 0, 1158, 0, 0, 1176, 0, 0,
+	1173, 1169, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 1167, 0, 1188, 1187,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 1171, 1172, 0, 775, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 1192, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 1180, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 942, 0, 943, 932,
-	933, 934, 0, 923, 924, 925, 926, 927, 929, 930,
-	928, 931, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 943, 0, 942, 0, 0, 932, 933, 934, 0,
-	923, 924, 925, 926, 927, 929, 930, 928, 931, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 943,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	1175, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 942, 0, 0, 932, 933, 934, 0, 923, 924,
-	925, 926, 927, 929, 930, 928, 931, 0, 0, 0,
-	0, 0, 0, 0, 942, 0, 0, 932, 933, 934,
-	0, 923, 924, 925, 926, 927, 929, 930, 928, 931,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 1168, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 1173,
+	1169, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 942, 0, 0, 932, 933, 934, 0, 923,
-	924, 925, 926, 927, 929, 930, 928, 931,
+	0, 0, 0, 0, 0, 1167, 0, 1188, 1187, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	1192,
 }
 var sqlPact = [...]int{
 
-	240, -1000, -110, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	1046, -1000, -121, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, 539, 753, 77, 555,
+	725, 555, -1000, -1000, 33104, 726, 210, 210, 283, 320,
+	210, 306, 25580, 32686, 281, 379, 926, 4, -1000, 540,
+	-137, 32268, 25580, 1325, -125, 24326, 62, 1046, 1127, 992,
+	990, 24326, 31850, 31432, 31014, -1000, 76, 16313, -1000, -1000,
+	-1000, -1000, -1000, 626, 807, -1000, -126, -1000, -1000, -1000,
+	25580, 30596, 25162, 803, -1000, 27670, 30178, 977, -1000, -1000,
+	399, 160, 1496, 1354, -1000, -115, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, 554, 718, 147, 606,
-	691, 606, -1000, -1000, 31665, 1146, 241, 241, 310, 338,
-	241, 337, 24729, 31257, 308, 444, 910, 17, -1000, 594,
-	-142, 30849, 24729, 1363, -121, 23505, 128, 240, 1183, 994,
-	23505, 30441, 30033, 29625, -1000, -1000, 84, 15641, -1000, -1000,
-	-1000, -1000, -1000, 759, 805, -1000, -122, -1000, -1000, -1000,
-	24729, 29217, 24321, 797, -1000, 26361, 28809, 1026, -1000, -1000,
-	436, 188, 1546, 1395, -1000, -106, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
@@ -4806,352 +5075,352 @@ var sqlPact = [...]int{
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 1126, -1000,
+	797, 1125, 1124, 157, -1000, -1000, -1000, -1000, 977, 977,
+	-1000, 1489, -1000, 355, 1488, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, 25580, -1000, 1487, 24326, -1000, 372, 1038, 29760,
+	-1000, -1000, 418, 407, -1000, -1000, 540, -1000, -1000, -1000,
+	893, 1321, 1321, 1321, 1332, -47, -48, 4, -128, 25580,
+	-1000, 63, -128, 10662, 10662, -1000, 778, 62, -1000, 97,
+	20982, -117, -1000, 10222, -1000, 24326, 25580, 25580, 457, 29342,
+	115, 1180, -1000, -1000, 1171, -135, 1170, -1000, -139, -1000,
+	-1000, -1000, -1000, -1000, -1000, 62, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, 1180, -1000, 791, 1179, 1176, 187, -1000, -1000,
-	-1000, -1000, 1026, 1026, -1000, 1545, -1000, 387, 1544, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, 24729, -1000, 1536, 23505,
-	-1000, 443, 1028, 28401, -1000, -1000, 435, 424, -1000, -1000,
-	594, -1000, -1000, -1000, 1099, 1362, 1362, 1362, 1383, -11,
-	-18, 17, -123, 24729, -1000, 130, -123, 10126, 10126, -1000,
-	784, 128, -1000, 153, 20238, -112, -1000, 9696, -1000, 23505,
-	24729, 508, 27993, 1238, 113, -1000, -1000, 1237, -145, 1235,
-	-1000, -129, -1000, 16912, -1000, 128, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, 369, 24744, 294,
+	470, 2796, 1169, 2693, 1168, 24744, -1000, 1310, -1000, 1362,
+	-1000, 944, 17180, 16749, 1248, 601, -1000, -1000, -1000, -1000,
+	-109, 5390, 25580, 1143, 24744, 25580, -1000, 25580, -1000, -1000,
+	-1000, -1000, -1000, 28924, -1000, -1000, -1000, 88, -1000, 28506,
+	1279, -1000, 898, -1000, -1000, -1000, -1000, -1000, 893, 884,
+	1138, 1142, 498, 728, 1020, -1000, 11543, 1003, 12863, 820,
+	4, -1000, -1000, 4, 4, 12863, -1000, -1000, 25580, -128,
+	1367, 25580, 1123, -212, -1000, 34690, -1000, -1000, 12863, 12863,
+	12863, 12863, 12863, 608, 61, -1000, -1000, -1000, 7142, -1000,
+	-1000, -117, 61, -49, -1000, -1000, 57, 54, -117, -1000,
+	-1000, -1000, -1000, -1000, 51, 1483, 397, -1000, -1000, -1000,
+	12863, 169, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, 1140, -1000, 50, 46, 41, 40, -1000, -1000, -1000,
+	-1000, -1000, -1000, 37, 36, 33, 32, 31, 29, 28,
+	27, 26, 24, 19, 580, -1000, 234, -1000, 234, 234,
+	-1000, 9, 9, 10, -1000, -1000, 9, -1000, -1000, -84,
+	-83, -1000, -1000, -1000, -1000, -1000, -1000, 337, 56, 17,
+	-1000, -1000, -1000, 25580, -117, -1000, 4959, 12863, -145, -1000,
+	35381, -1000, 217, 84, 1364, 84, 19728, -1000, 25580, -1000,
+	25580, -1000, -1000, 25580, 25580, 25580, 76, 21400, 28088, 351,
+	-140, 25580, 25580, -1000, -1000, -1000, 327, 27670, -146, 325,
+	-1000, 22236, 1292, 1272, 1265, 138, 25580, 1027, 25580, 818,
+	-44, 25580, -146, 675, 24326, 1122, 1362, 279, 809, -131,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 1417,
+	-1000, -1000, -1000, -1000, 1408, -131, -1000, -1000, -1000, -1000,
+	-1000, 367, -1000, -1000, -1000, -1000, 5390, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	403, 23913, 325, 574, 3313, 1234, 2996, 1232, 23913, -1000,
-	1364, -1000, 1409, 52, -1000, 942, 371, -1000, 1278, 16491,
-	16070, 1303, 934, -1000, -1000, -1000, -1000, -104, 4974, 24729,
-	1204, 23913, 24729, -1000, 24729, -1000, -1000, 52, -1000, -1000,
-	27585, -1000, -1000, -1000, 86, -1000, 27177, 1332, -1000, 869,
-	-1000, -1000, -1000, -1000, -1000, 1099, 1120, 1192, 1203, 592,
-	806, 1054, -1000, 10987, 1055, 12277, 827, 17, -1000, -1000,
-	17, 17, 12277, -1000, -1000, 24729, -123, 1411, 24729, 1169,
-	-219, -1000, 33558, -1000, -1000, 12277, 12277, 12277, 12277, 12277,
-	707, 127, -1000, -1000, -1000, 6686, -1000, -1000, -112, 127,
-	-14, -1000, -1000, 126, 124, -112, -1000, -1000, -1000, -1000,
-	-1000, 121, 1534, 416, -1000, -1000, -1000, 12277, 220, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 1195, -1000,
-	108, 104, 103, 96, -1000, -1000, -1000, -1000, -1000, -1000,
-	93, 89, 87, 85, 83, 82, 79, 77, 73, 71,
-	69, 662, -1000, 247, -1000, 247, 247, -1000, 39, 39,
-	40, -1000, -1000, 39, -1000, -1000, -67, -60, -1000, -1000,
-	-1000, -1000, -1000, -1000, 363, 54, 62, -1000, -1000, -1000,
-	24729, -112, -1000, 4553, 12277, -134, -1000, 34116, -1000, 244,
-	152, 1410, 19005, -1000, 24729, 24729, -1000, 24729, -1000, -1000,
-	24729, 24729, 84, 20649, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, 1466, -1000, -1000, -1000, -1000, 1461, 26769, 376,
-	-151, 24729, 24729, -1000, -1000, -1000, 370, 26361, -179, 367,
-	-1000, 21465, 1335, 1327, 1323, 116, 24729, 1023, 24729, 819,
-	-66, 24729, -179, 907, 23505, 1163, 1409, 307, 1026, -1000,
-	794, -1000, -1000, -1000, -125, -1000, -1000, -1000, -1000, -125,
-	-1000, -1000, -1000, -1000, -1000, 415, -1000, -1000, -1000, -1000,
-	4974, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, 25580, -1000, -1000, -1000, 25580, -1000, 784, 24326,
+	1065, 1059, 129, 875, 1360, 27252, -1000, 1167, 884, 1138,
+	-1000, -1000, -1000, -1000, -1000, 551, 690, -1000, 976, -1000,
+	421, -1000, -1000, -1000, 35381, -1000, 338, -1000, -1000, 35381,
+	588, 561, 25580, 997, -1000, 997, -132, -1000, 34571, -1000,
+	15, -147, -1000, 217, 15477, 10662, 35637, 25580, 322, 12863,
+	12863, 12863, 12863, 12863, 12863, 12863, 12863, 12863, 12863, 12863,
+	12863, 12863, 12863, 12863, 12863, 12863, 12863, 12863, 889, 12863,
+	323, 12863, 12863, 12863, 12863, 1075, 732, 8, 795, 5390,
+	-1000, -1000, 544, 544, 544, 35457, 35457, 82, -1000, -117,
+	-127, 34076, -134, -117, -1000, -1000, -1000, 9342, 12863, 8902,
+	-117, 6261, -1000, 712, 1471, 224, 35381, 1137, 1079, 14,
+	1468, 1461, 1460, 1453, 12863, 13743, 12863, 14183, 12863, 12863,
+	7582, 12863, 12863, 12863, 12863, -1000, 13, -1000, -1000, -1000,
+	-1000, 1446, -1000, -1000, 1438, -1000, 1432, -1000, 312, 308,
+	1429, -1000, 1427, -1000, 455, 217, 12863, -55, -1000, -1000,
+	-1000, 2982, 10222, -1000, 504, 12863, 774, -1000, 18891, 235,
+	25580, 504, 48, -1000, -1000, -1000, 132, 25580, -1000, -1000,
+	115, -1000, -135, -1000, -1000, 864, -39, 25580, 25580, -140,
+	-136, 25580, -1000, 2615, 25580, -1000, 25580, -1000, 35637, 25580,
+	-1000, 12, -5, -16, 972, 246, 962, 25580, 25580, -1000,
+	26834, 26416, 25580, -1000, -1000, -1000, 5390, -1000, 25580, -1000,
+	-1000, -1000, 2155, 25580, 25580, 25580, 2084, 25580, 977, -1000,
+	605, 572, -1000, -1000, 17611, -1000, -1000, -1000, 712, 1424,
+	-1000, -168, -1000, -1000, -1000, -1000, -1000, -1000, 21818, 25580,
+	175, 1166, -137, 773, 859, -1000, 25580, -1000, -1000, -1000,
+	255, -1000, 588, -1000, 12863, 1423, -1000, -1000, -1000, -1000,
+	-1000, 932, -140, 12863, 2463, 142, -1000, -1000, -137, 25580,
+	-1000, 1078, -144, 1229, 23490, 23908, 15895, 23490, -1000, 15059,
+	136, 1119, -1000, -1000, 11, -1000, -1000, 1372, 35826, -1000,
+	-1000, -1000, -1000, 53, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, 10, 580, 9, 9,
+	9, -1000, -1000, -1000, -1000, -1000, -1000, -83, -84, 234,
+	234, 234, -1000, -1000, 154, 186, 186, 310, 310, 310,
+	548, 548, 548, 548, 232, 232, 232, 462, 232, 232,
+	232, 35457, 35419, 4073, 12863, 12863, 319, 727, 8, 4018,
+	12863, 462, 462, 462, 462, -1000, 1115, -1000, -1000, -1000,
+	1118, 7, -1000, 14183, 14183, -1000, -1000, -1000, 7142, 6,
+	-1000, -1000, -1000, -1000, -1000, 2, 12863, -1000, -1000, 12863,
+	-149, -234, -1000, 35381, -1000, -152, -153, -1000, -1000, -89,
+	12863, 12863, 12863, -56, -1000, 318, -1000, 316, 313, 309,
+	-1000, 0, -57, 511, -1000, 12863, 620, -2, -3, 12863,
+	-58, -59, -60, -62, 35343, -63, 1108, -222, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -65, 35268, -66, 2204,
+	-1000, 14183, 14183, 14183, 7142, -4, -67, 34647, -222, 34893,
+	11103, 11103, 11103, -69, 35139, 12863, -222, 3184, -157, -158,
+	-163, 1422, -164, -71, -72, 147, 146, -73, -74, 25580,
+	1078, -169, -1000, -1000, 12863, -1000, -1000, 10662, 35381, 504,
+	1271, -171, -1000, -1000, -1000, 217, 19728, 10222, -173, -1000,
+	1164, 25580, -168, -1000, -1000, -1000, -1000, -1000, -1000, 1133,
+	12863, 25580, -1000, 1022, 25580, -40, -8, 25580, -9, 681,
+	-1000, 1163, 784, 1162, 784, -1000, -177, -1000, -118, -1000,
+	-146, 307, -1000, 290, 289, -146, 609, -168, -1000, -1000,
+	-1000, -1000, -1000, -1000, -75, 25580, -76, -178, -182, 35637,
+	-1000, -1000, -1000, -1000, 25580, -30, 696, -44, 25580, -1000,
+	25580, 1159, -1000, -1000, 677, 34054, -1000, -1000, 142, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, 24729, -1000, -1000, -1000,
-	24729, -1000, 936, 23505, 1040, 1035, 163, 865, 1407, 25953,
-	-1000, 1230, 1120, 1192, -1000, -1000, -1000, -1000, -1000, 739,
-	742, -1000, 968, -1000, 469, -1000, -1000, -1000, 34116, -1000,
-	356, -1000, -1000, 34116, 664, 585, 24729, 992, -1000, 992,
-	-128, -1000, 33447, -1000, 61, -135, -1000, 244, 14408, 10126,
-	3692, 24729, 418, 12277, 12277, 12277, 12277, 12277, 12277, 12277,
-	12277, 12277, 12277, 12277, 12277, 12277, 12277, 12277, 12277, 12277,
-	12277, 12277, 859, 353, 1157, 804, 38, 851, 4974, -1000,
-	-1000, 1453, 1453, 1453, 3444, 3444, 102, -1000, -112, -124,
-	33073, -131, -112, -1000, -1000, -1000, 8836, 12277, 8406, -112,
-	5825, -1000, 511, 1522, 251, 34116, 1209, 1065, 60, 1521,
-	1520, 1519, 1513, 12277, 645, 12277, 13137, 12277, 12277, 7116,
-	12277, 12277, 12277, 12277, -1000, 59, -1000, -1000, -1000, -1000,
-	1508, -1000, -1000, 1504, -1000, 1503, -1000, 412, 393, 1502,
-	-1000, 1498, -1000, 506, 244, 12277, -30, -1000, -1000, -1000,
-	2680, 9696, -1000, 607, 12277, 781, -1000, 18168, 265, 24729,
-	8, -1000, -1000, -1000, 169, 24729, -1000, 113, -1000, -1000,
-	-145, -1000, -1000, -1000, -1000, -1000, 864, -9, 24729, 24729,
-	-151, -149, 24729, -1000, 2320, 24729, -1000, 24729, -1000, 3692,
-	24729, -1000, 57, 13, 10, 967, 272, 965, 24729, 24729,
-	-1000, 25545, 25137, 24729, -1000, -1000, -1000, 4974, -1000, 24729,
-	-1000, -1000, -1000, 1061, 24729, 24729, 24729, 848, 24729, 1026,
-	-1000, -1000, 602, 646, -1000, 16912, 511, 1475, -1000, -185,
-	-1000, -1000, -1000, -1000, -1000, -1000, 21057, 24729, 223, 1229,
-	-142, 780, 863, -1000, 24729, -1000, -1000, -1000, 278, -1000,
-	664, -1000, 12277, 1474, -1000, -1000, -1000, -1000, -1000, 920,
-	-151, 12277, 2206, 174, -1000, -1000, -142, 24729, -1000, 1052,
-	-178, 1330, 22689, 23097, 15230, 22689, -1000, 13997, 167, 1161,
-	-1000, -1000, 51, -1000, -1000, 1422, 32869, -1000, -1000, -1000,
-	-1000, 68, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 40,
-	662, 39, 39, 39, -1000, -1000, -1000, -1000, -1000, -1000,
-	-60, -67, 247, 247, 247, -1000, -1000, 183, 379, 379,
-	497, 497, 497, 1420, 1420, 1420, 1420, 33418, 33418, 33418,
-	394, 33418, 33418, 33418, 3444, 34139, 3372, 12277, 349, 803,
-	38, 12277, -1000, 921, -1000, -1000, -1000, 1139, 36, -1000,
-	13137, 13137, -1000, -1000, -1000, 6686, 35, -1000, -1000, -1000,
-	-1000, -1000, 34, 12277, -1000, -1000, 12277, -136, -248, -1000,
-	34116, -1000, -139, -140, -1000, -1000, -85, 12277, 12277, 12277,
-	-31, -1000, 348, -1000, 347, 346, 345, -1000, 33, -33,
-	533, -1000, 12277, 724, 24, 23, 12277, -34, -38, -40,
-	-42, 34058, -43, 1133, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -44, 34031, -45, 339, -1000, 13137, 13137, 13137,
-	6686, 22, -47, 33536, -263, 33988, 10557, 10557, 10557, -49,
-	33879, 12277, -263, 2969, -152, -153, -154, 1472, -158, -51,
-	-52, 181, 177, -53, -54, 24729, 1052, -159, -1000, -1000,
-	12277, -1000, -1000, 10126, 34116, 607, 1338, -160, -1000, -1000,
-	244, 19005, 9696, -166, -1000, 1228, 24729, -185, -1000, -1000,
-	-1000, -1000, -1000, -1000, 999, 12277, 24729, -1000, 1027, 24729,
-	-36, 21, 24729, 18, 798, -1000, 1225, 936, 1220, 936,
-	-1000, -168, -1000, -101, -1000, -179, 344, -1000, 343, 342,
-	-179, 671, -185, 52, -1000, -1000, -1000, -1000, -1000, -55,
-	24729, -57, -170, -195, 3692, -1000, -1000, -1000, -1000, 24729,
-	-5, 772, -66, 24729, 224, 24729, 1219, -1000, -1000, 741,
-	33003, -1000, -1000, 174, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, 1008, -77, -1000, -1000,
+	-1000, -1000, -1000, 1070, 1331, 15477, 983, 979, 15477, 1012,
+	622, 622, 622, -1000, -1000, -1000, 25580, -10, -1000, -1000,
+	20564, -11, 23908, 23490, -117, -1000, 19310, -78, 1229, -1000,
+	627, -12, 6261, 100, 109, 1371, -1000, 1418, 12863, 12863,
+	3918, 2532, 12863, 14183, 14183, -1000, 12863, 1795, -1000, -1000,
+	-1000, -1000, 1104, -13, -1000, 12863, 35637, 34100, 34014, -186,
+	-1000, 7142, 8462, -188, -117, 33990, 12863, -1000, -1000, 108,
+	-1000, -1000, -79, 9782, -1000, 34764, -64, -64, -1000, 917,
+	624, 587, 473, 1416, 1479, 1195, -1000, 12863, 34812, -1000,
+	20146, 204, 667, 33968, -1000, -1000, -1000, -1000, 35637, -1000,
+	12863, -1000, 1095, 12863, -1000, 35637, 14183, 14183, 14183, 14183,
+	14183, 14183, 14183, 14183, 14183, 14183, 14183, 14183, 14183, 14183,
+	14183, 14183, 975, 14183, 1404, 1404, 1404, -200, 8022, -1000,
+	1097, 1095, 12863, 12863, 35637, -81, -87, -88, -1000, 12863,
+	-222, 12863, -1000, -1000, -1000, -90, -1000, 1415, -1000, 149,
+	-1000, -1000, 149, 1312, -1000, 1070, -1000, 34129, -212, -1000,
+	-14, -137, 504, -1000, -1000, 131, 25580, 865, -1000, -16,
+	246, 33946, -187, 25580, -191, 25580, -1000, 25580, -1000, 25580,
+	-1000, 1024, 165, -44, 269, 25580, -1000, 25580, -1000, -1000,
+	5390, 4528, 5390, 25580, 25580, 25580, 25580, 25580, 25580, 1478,
+	-1000, 1010, -1000, 21818, -1000, 175, 22654, -1000, 1285, -1000,
+	102, 1357, 246, 25580, -1000, -1000, -1000, -1000, -1000, -1000,
+	197, 12863, 13303, 1229, 15477, 15477, 985, 978, 15477, -1000,
+	-1000, -1000, -1000, -17, 25580, -21, 25580, 25580, -1000, -1000,
+	-117, 23490, -1000, 18473, -89, 428, 1414, 100, 99, -92,
+	545, 533, 12863, 12863, 482, 3846, 3833, 533, 12863, 12863,
+	35637, 3453, -192, -1000, 12863, 12863, -1000, 4038, -193, -1000,
+	12863, -1000, 35381, -1000, 1475, 12863, -93, -94, -95, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -96, -1000, -1000, 35381,
+	12863, -1000, -1000, 33522, 12863, -97, -1000, -98, 35381, 1097,
+	35381, -1000, 443, 443, 568, 568, 568, 1404, 1404, 1404,
+	1404, 524, 524, 524, 689, 524, 524, 524, 1093, 1026,
+	-23, -1000, 3934, 12863, -198, -1000, -1000, -1000, 35381, 35381,
+	-99, -1000, -1000, -1000, -222, 3999, -1000, -100, -1000, -1000,
+	-1000, 197, -1000, 1209, 25580, -1000, -1000, -24, -1000, 25580,
+	-1000, 25580, 844, -1000, -1000, 958, -25, 14183, 25580, -1000,
+	-1000, -1000, -1000, -199, -203, -1000, 190, 1298, -204, 836,
+	-1000, 834, 590, -1000, 25580, 12863, -1000, -1000, 35637, 784,
+	784, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -115,
+	25998, 1408, 1417, -1000, -1000, -1000, -1000, -1000, 124, -1000,
+	-1000, -1000, -1000, 288, 286, -1000, 175, -26, -1000, 1092,
+	696, -205, -1000, -1000, -1000, 176, 874, 21818, 25580, -137,
+	-27, 754, -1000, 25580, 35381, -213, -1000, 35381, -1000, 5821,
+	-1000, 985, -1000, -28, 12863, 15477, -1000, 25580, -215, 25580,
+	-225, -1000, 35637, -226, 35637, -1000, -230, -1000, 1356, -101,
+	-1000, 75, 68, 1407, -1000, 533, 533, 12863, 12863, 12863,
+	533, 3453, -242, -1000, 35637, 533, 533, -1000, -1000, 3797,
+	-1000, 34764, -1000, -1000, -1000, -1000, 35381, 559, -1000, 3753,
+	-1000, -1000, -1000, 14183, 1091, -29, -1000, 35637, 3528, -1000,
+	-1000, -1000, -1000, -1000, 400, -244, -1000, -1000, -1000, 6702,
+	796, -1000, -1000, -1000, 12863, 3934, -39, -1000, 200, 25580,
+	18042, 23072, 25580, 546, -1000, -1000, -1000, -1000, 35381, 1295,
+	-1000, -1000, -111, -115, 4528, 25580, 25580, 696, 25580, 870,
+	870, -1000, -1000, 22654, 676, -1000, 491, 480, -105, -30,
+	-1000, 25580, 25580, -214, -1000, 1353, 13303, -1000, -1000, 25580,
+	35381, -1000, -246, -1000, -250, -1000, 25580, 1295, -1000, 136,
+	18473, -1000, -31, -1000, -1000, -1000, 66, 533, 533, 533,
+	-1000, -1000, -1000, -106, 667, 1329, -1000, 3147, 14183, 35637,
+	-255, -1000, 442, -1000, 25580, -1000, -1000, 3476, 924, -1000,
+	-33, -1000, -1000, -1000, -119, 1318, -1000, 25580, -1000, 25580,
+	247, 25580, 268, -114, -1000, -1000, -1000, -1000, -257, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, 1063, -58, -1000, -1000, -1000, -1000, -1000, 1047, 1380,
-	14408, 983, 976, 14408, 788, 729, 729, 729, -1000, -1000,
-	-1000, 24729, 16, -1000, -1000, 19827, 15, 23097, 22689, -112,
-	-1000, 18597, -59, 1330, -1000, 732, 14, 5825, 140, 142,
-	1414, -1000, 1471, 12277, 12277, 2636, 12277, 13137, 13137, -1000,
-	2304, -1000, -1000, -1000, -1000, 1129, 11, -1000, 12277, 3692,
-	3539, 2941, -174, -1000, 6686, 7976, -207, -112, 32974, 12277,
-	-1000, -1000, 141, -1000, -1000, -62, 9266, -1000, 33686, -83,
-	-83, -1000, 895, 710, 652, 526, 1470, 1533, 1249, -1000,
-	12277, 33795, -1000, 19416, 249, 734, 32955, -1000, -1000, -1000,
-	-1000, 3692, -1000, 12277, -1000, 1127, 12277, -1000, 3692, 13137,
-	13137, 13137, 13137, 13137, 13137, 13137, 13137, 13137, 13137, 13137,
-	13137, 13137, 13137, 13137, 13137, 1024, 13137, 1452, 1452, 1452,
-	-215, 7546, -1000, 1189, 1127, 12277, 12277, 3692, -63, -64,
-	-65, -1000, 12277, -263, 12277, -1000, -1000, -1000, -69, -1000,
-	1467, -1000, 175, -1000, -1000, 175, 1366, -1000, 1047, -1000,
-	33240, -219, -1000, 115, -142, 607, -1000, -1000, 166, 24729,
-	1067, -1000, 10, 272, 32933, -180, 24729, -186, 24729, -1000,
-	14819, -1000, 24729, -1000, 990, 164, -66, 298, 24729, -1000,
-	24729, -1000, -1000, 4974, 4126, 4974, 24729, 24729, 24729, 24729,
-	24729, 24729, 1530, -1000, 1012, -1000, 21057, -1000, 223, 21873,
-	-1000, 1344, -1000, 107, 1406, -1000, 1038, 272, 24729, -1000,
-	-1000, -1000, -1000, -1000, -1000, 237, 12277, 12707, 1330, 14408,
-	14408, 1003, 954, 14408, -1000, -1000, -1000, -1000, 7, 24729,
-	6, 24729, 24729, -1000, -1000, -112, 22689, -1000, 17760, -85,
-	441, 1463, 140, 133, -72, 1412, 1921, 12277, 262, 2848,
-	461, 12277, 12277, 3692, 34177, -187, -1000, 12277, 12277, -1000,
-	32914, -193, -1000, 12277, -1000, 34116, -1000, 1529, 12277, -73,
-	-74, -75, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -77,
-	-1000, -1000, 34116, 12277, -1000, -1000, 32481, 12277, -78, -1000,
-	-84, 34116, 1189, 34116, -1000, 413, 413, 569, 569, 569,
-	1452, 1452, 1452, 1452, 1373, 1373, 1373, 1066, 1373, 1373,
-	1373, 1078, 997, 3, -1000, 2502, 12277, -194, -1000, -1000,
-	-1000, 34116, 34116, -87, -1000, -1000, -1000, -263, 32895, -1000,
-	-89, -1000, -1000, -1000, 237, -1000, 1260, 14819, 1320, -1000,
-	-1000, 1, -1000, 24729, -1000, 24729, 850, -1000, -1000, 946,
-	0, 13137, 24729, -1000, -1000, -1000, -1000, -198, -199, -1000,
-	230, 212, 1354, 12277, -200, 840, -1000, 833, 579, -1000,
-	24729, 12277, -1000, -1000, 3692, 936, 936, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, -106, 32073, 1461, 1466, -1000,
-	-1000, -1000, 160, -1000, -1000, -1000, -1000, 336, 316, -1000,
-	223, -1, -1000, 1105, 772, -205, -1000, -1000, -1000, 228,
-	1000, 21057, 24729, -142, -1000, 1291, -2, 779, -1000, 24729,
-	34116, -221, -1000, 34116, -1000, 5395, -1000, 1003, -1000, -3,
-	12277, 14408, -1000, 24729, -206, 24729, -210, -1000, 3692, -211,
-	3692, -1000, -223, -1000, 1405, -90, -1000, 55, 53, 1460,
-	-1000, 1921, 12277, 12277, 12277, 1921, 34177, -224, -1000, 3692,
-	1921, 1921, -1000, -1000, 3681, -1000, 33686, -1000, -1000, -1000,
-	-1000, 34116, 699, -1000, 3644, -1000, -1000, -1000, 13137, 1064,
-	-4, -1000, 3692, 3481, -1000, -1000, -1000, -1000, -1000, 478,
-	-234, -1000, 24729, -1000, -1000, 6256, 953, -1000, -1000, -1000,
-	12277, 2502, -9, -1000, 248, 14819, 17333, 22281, 24729, 22281,
-	3406, 628, -1000, -1000, -1000, -1000, 34116, 1355, -1000, -1000,
-	-103, -106, 4126, 24729, 24729, 772, 24729, 1081, 1081, -1000,
-	-1000, 21873, 737, -1000, 584, 555, -91, -5, 224, -1000,
-	14819, 24729, -235, -1000, 1404, 12707, -1000, -1000, 24729, 34116,
-	-1000, -238, -1000, -239, -1000, 24729, 1355, -1000, 167, 17760,
-	-1000, -6, -1000, -1000, -1000, 9, 1921, 1921, 1921, -1000,
-	-1000, -1000, -97, 734, 1377, -1000, 480, 13137, 3692, -244,
-	-1000, 499, -1000, 14819, 244, -1000, -1000, 3167, 908, -1000,
-	-7, -1000, -1000, -1000, -108, 1356, -1000, 24729, -1000, 1356,
-	1354, 24729, 273, 24729, 294, -111, -1000, -1000, -1000, -1000,
-	-246, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, 1012, 223, -1000, -251, 272, 24729, -8,
-	-1000, -252, -1000, -1000, -1000, -1000, -1000, -1000, 24729, -1000,
-	-1000, 679, 12277, 480, -256, -1000, 19005, -1000, -1000, 884,
-	766, 649, 12277, 17333, 174, -1000, 174, 22281, -9, -1000,
-	12277, -1000, -1000, 293, -1000, 1067, 223, 772, 222, -13,
-	-1000, -1000, -1000, -262, -109, 11847, 11847, -263, -1000, -236,
-	-1000, 1019, -1000, 774, 773, 557, -1000, -1000, -1000, 3148,
-	-1000, -1000, -1000, 1356, 908, 34116, -1000, 772, -1000, 244,
-	-66, 14819, -1000, -1000, -1000, -1000, 13567, 838, 534, 33507,
-	-1000, -1000, -1000, -1000, 1274, -1000, 276, 868, 868, -1000,
-	174, 766, -1000, -1000, -1000, -271, 1427, -1000, -1000, -1000,
-	-1000, -1000, -1000, 1442, -1000, -1000, 1006, -1000, -1000, -1000,
-	222, 11417, -1000, -1000, -1000, 244, -1000, -1000,
+	-1000, 1010, 175, -263, 246, 25580, -35, -1000, -264, -1000,
+	-1000, -1000, -1000, -1000, -1000, 25580, -1000, -1000, 701, 12863,
+	3147, -268, -1000, 19728, -1000, 901, 682, 752, 12863, 18042,
+	142, -1000, -39, -1000, 12863, -1000, -1000, 267, -1000, 865,
+	175, 696, 172, -43, -1000, -1000, -1000, -269, -107, 12423,
+	12423, -222, -1000, -237, -1000, 1014, -1000, 744, 739, 532,
+	-1000, -1000, -1000, 3287, -1000, -1000, 924, 35381, -1000, 696,
+	-1000, -1000, -44, 25580, -1000, -1000, -1000, -1000, 14623, 799,
+	483, 34609, -1000, -1000, -1000, -1000, 1222, -1000, 253, 1055,
+	1055, -1000, 682, -1000, -1000, -277, 1376, -1000, -1000, -1000,
+	-1000, -1000, -1000, 1390, -1000, -1000, 943, -1000, -1000, 172,
+	11983, -1000, -1000, -1000, -1000, -1000,
 }
 var sqlPgo = [...]int{
 
-	0, 1833, 1830, 1433, 1829, 1827, 1826, 1825, 1823, 1821,
-	1820, 1819, 1466, 133, 1818, 1817, 157, 1816, 131, 1815,
-	1804, 1799, 57, 1798, 1797, 1796, 1794, 127, 68, 2245,
-	181, 158, 1793, 1792, 1791, 7, 5, 137, 28, 147,
-	54, 139, 99, 38, 1789, 21, 0, 1788, 1787, 85,
-	19, 29, 1178, 1784, 14, 1779, 1778, 66, 1775, 24,
-	42, 1773, 9, 4, 26, 1772, 1771, 11, 90, 45,
-	1770, 10, 525, 1769, 94, 138, 1765, 89, 91, 156,
-	17, 342, 88, 106, 1759, 16, 104, 1758, 71, 112,
-	1756, 59, 1753, 109, 171, 141, 654, 37, 79, 1750,
-	1747, 33, 1746, 84, 1741, 1740, 1422, 13, 1737, 159,
-	169, 145, 1733, 1731, 334, 96, 51, 97, 1730, 1726,
-	1723, 1722, 118, 114, 108, 1716, 98, 80, 1714, 1711,
-	102, 1710, 1707, 152, 160, 1704, 1702, 123, 1698, 1695,
-	1693, 1682, 1668, 146, 1666, 1661, 67, 130, 105, 46,
-	39, 1658, 73, 47, 8, 72, 30, 1010, 988, 1657,
-	113, 77, 121, 92, 1654, 32, 1652, 1651, 31, 1650,
-	48, 65, 1646, 143, 1645, 1640, 1638, 1637, 1636, 110,
-	1632, 1631, 101, 49, 78, 100, 95, 122, 40, 55,
-	117, 148, 165, 142, 1626, 1625, 1610, 151, 1607, 780,
-	70, 1605, 1286, 124, 1604, 1602, 1600, 1187, 1174, 1098,
-	811, 1598, 1596, 1097, 199, 1594, 1593, 93, 120, 107,
-	1589, 75, 161, 162, 1588, 164, 76, 60, 149, 1583,
-	129, 1582, 1699, 150, 111, 74, 41, 1581, 1580, 36,
-	25, 1579, 50, 3, 6, 18, 12, 1, 103, 34,
-	22, 1578, 27, 144, 125, 1577, 166, 1575, 1574, 69,
-	1573, 1571, 23, 1570, 20, 1569, 15, 2, 1562, 173,
-	1561, 135, 1559, 1460, 1558, 163, 1556, 1525, 116,
+	0, 1735, 1732, 1382, 1731, 1728, 1727, 1726, 1725, 1724,
+	1723, 1722, 1402, 131, 1721, 1719, 160, 1718, 126, 1716,
+	1708, 1707, 57, 1705, 1697, 1695, 1692, 123, 61, 2636,
+	194, 171, 1689, 1681, 1676, 27, 15, 135, 134, 31,
+	73, 133, 1674, 26, 797, 1672, 1671, 94, 1669, 1668,
+	92, 166, 20, 1666, 1663, 54, 1661, 24, 39, 1658,
+	6, 3, 16, 1657, 1656, 12, 89, 28, 1651, 4,
+	125, 1650, 91, 146, 1649, 49, 105, 158, 14, 19,
+	47, 111, 1648, 17, 107, 1646, 93, 114, 1645, 55,
+	1643, 104, 164, 143, 647, 18, 90, 1642, 1627, 34,
+	1625, 79, 1624, 1623, 1376, 11, 1621, 157, 162, 144,
+	1619, 1617, 511, 97, 50, 101, 1615, 1613, 1612, 1608,
+	108, 121, 88, 1606, 98, 69, 1605, 1604, 99, 1603,
+	152, 156, 1602, 1601, 128, 1600, 145, 1598, 1597, 1596,
+	153, 1594, 1593, 76, 137, 106, 41, 38, 1592, 80,
+	45, 9, 75, 1581, 746, 765, 1591, 113, 84, 122,
+	85, 1590, 29, 1589, 1587, 36, 1586, 46, 32, 1585,
+	142, 1583, 1582, 1579, 1578, 1577, 110, 1576, 1575, 103,
+	51, 67, 100, 96, 119, 37, 78, 117, 149, 165,
+	147, 1574, 155, 1573, 813, 59, 1572, 1476, 118, 1571,
+	1570, 1569, 1308, 1214, 1127, 525, 1568, 1566, 994, 736,
+	1565, 1562, 71, 120, 95, 1561, 60, 1560, 161, 1557,
+	178, 77, 72, 150, 1556, 130, 1555, 0, 109, 70,
+	74, 40, 1554, 1552, 42, 22, 1548, 48, 2, 10,
+	7, 5, 8, 102, 33, 25, 1547, 30, 151, 140,
+	1537, 185, 1535, 1533, 66, 1528, 1526, 23, 1523, 21,
+	1521, 13, 1, 1518, 159, 1517, 136, 1510, 1412, 1509,
+	163, 1507, 1458, 116,
 }
 var sqlR1 = [...]int{
 
 	0, 1, 2, 2, 3, 3, 3, 3, 3, 3,
 	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
-	4, 4, 4, 5, 5, 6, 6, 7, 7, 39,
-	39, 37, 37, 37, 37, 37, 37, 37, 37, 37,
+	4, 4, 4, 5, 5, 6, 6, 7, 7, 38,
+	38, 37, 37, 37, 37, 37, 37, 37, 37, 37,
 	37, 37, 37, 37, 37, 37, 37, 37, 37, 37,
 	37, 37, 37, 37, 37, 37, 33, 33, 40, 40,
-	40, 38, 38, 34, 34, 62, 63, 63, 92, 92,
-	152, 152, 152, 152, 8, 8, 8, 8, 13, 14,
-	14, 14, 14, 14, 14, 132, 79, 79, 78, 78,
-	82, 82, 15, 15, 15, 16, 16, 16, 16, 16,
-	198, 198, 197, 195, 196, 196, 196, 17, 21, 269,
-	269, 269, 273, 273, 274, 274, 275, 275, 271, 271,
-	23, 23, 23, 143, 143, 143, 142, 142, 142, 142,
-	144, 144, 144, 144, 144, 225, 223, 223, 230, 230,
-	55, 55, 55, 55, 222, 222, 222, 222, 231, 231,
-	231, 231, 231, 231, 231, 56, 56, 56, 229, 229,
-	145, 24, 24, 24, 24, 24, 24, 24, 224, 224,
-	270, 270, 272, 272, 11, 11, 11, 11, 106, 106,
-	106, 106, 106, 106, 106, 106, 57, 57, 60, 60,
-	58, 58, 61, 61, 146, 146, 146, 149, 149, 147,
-	151, 240, 240, 241, 241, 241, 242, 242, 242, 242,
-	242, 242, 242, 237, 238, 238, 238, 239, 239, 239,
-	239, 239, 239, 236, 236, 148, 148, 148, 148, 148,
-	148, 148, 148, 220, 220, 67, 67, 245, 245, 245,
-	245, 169, 169, 170, 164, 164, 243, 243, 243, 243,
-	243, 246, 244, 247, 247, 247, 247, 247, 59, 59,
-	64, 64, 107, 107, 107, 107, 248, 12, 12, 103,
-	43, 43, 43, 183, 183, 183, 153, 153, 153, 153,
-	153, 26, 105, 105, 105, 10, 10, 128, 128, 129,
-	129, 45, 45, 71, 71, 188, 188, 188, 51, 51,
-	50, 50, 50, 35, 35, 35, 36, 36, 36, 104,
-	104, 104, 80, 80, 20, 20, 20, 20, 20, 20,
-	20, 20, 20, 130, 130, 131, 131, 25, 25, 25,
+	40, 39, 39, 34, 34, 60, 61, 61, 90, 90,
+	149, 149, 149, 149, 8, 8, 8, 8, 13, 14,
+	14, 14, 14, 14, 14, 77, 77, 76, 76, 80,
+	80, 15, 15, 15, 16, 16, 16, 16, 16, 193,
+	193, 192, 17, 21, 264, 264, 264, 268, 268, 269,
+	269, 270, 270, 266, 266, 23, 23, 23, 140, 140,
+	140, 139, 139, 139, 139, 141, 141, 141, 141, 141,
+	220, 218, 218, 225, 225, 53, 53, 53, 53, 53,
+	217, 217, 217, 217, 226, 226, 226, 226, 226, 226,
+	226, 54, 54, 54, 224, 224, 142, 24, 24, 24,
+	24, 24, 24, 24, 219, 219, 265, 265, 267, 267,
+	11, 11, 11, 11, 104, 104, 104, 104, 104, 104,
+	104, 104, 55, 55, 58, 58, 56, 56, 59, 59,
+	143, 143, 143, 146, 146, 144, 148, 235, 235, 236,
+	236, 236, 237, 237, 237, 237, 237, 237, 237, 232,
+	233, 233, 233, 234, 234, 234, 234, 234, 234, 231,
+	231, 145, 145, 145, 145, 145, 145, 145, 145, 215,
+	215, 65, 65, 242, 242, 242, 242, 166, 166, 167,
+	161, 161, 238, 238, 238, 238, 238, 240, 239, 241,
+	241, 241, 241, 241, 57, 57, 62, 62, 105, 105,
+	105, 105, 243, 12, 12, 101, 180, 180, 180, 150,
+	150, 150, 150, 150, 26, 103, 103, 103, 10, 10,
+	126, 126, 127, 127, 43, 43, 69, 69, 185, 49,
+	49, 48, 48, 48, 35, 35, 35, 36, 36, 36,
+	102, 102, 102, 78, 78, 20, 20, 20, 20, 20,
+	20, 20, 20, 20, 128, 128, 129, 129, 25, 25,
 	25, 25, 25, 25, 25, 25, 25, 25, 25, 25,
-	25, 277, 277, 277, 42, 42, 42, 42, 42, 41,
-	41, 41, 96, 96, 9, 9, 18, 53, 53, 139,
-	139, 139, 141, 141, 141, 140, 140, 140, 98, 98,
-	27, 85, 85, 86, 86, 194, 87, 87, 22, 22,
-	29, 29, 28, 28, 28, 28, 28, 28, 28, 28,
-	30, 30, 31, 31, 31, 31, 31, 31, 31, 254,
-	254, 254, 256, 256, 253, 19, 19, 19, 19, 255,
-	255, 276, 276, 114, 114, 114, 65, 65, 66, 66,
-	69, 69, 68, 70, 70, 187, 187, 94, 94, 94,
-	94, 95, 95, 133, 133, 134, 134, 135, 135, 136,
-	136, 136, 137, 137, 138, 138, 93, 93, 100, 100,
-	101, 101, 102, 161, 161, 110, 110, 111, 111, 112,
-	112, 109, 44, 44, 44, 108, 108, 108, 108, 113,
-	113, 32, 32, 75, 75, 76, 76, 189, 189, 189,
-	189, 189, 189, 189, 190, 190, 190, 190, 190, 190,
-	184, 184, 184, 184, 185, 185, 186, 186, 186, 186,
-	186, 117, 117, 117, 117, 115, 115, 116, 116, 191,
-	191, 191, 191, 99, 99, 192, 192, 192, 162, 162,
-	165, 166, 166, 167, 167, 168, 168, 154, 154, 97,
-	97, 150, 199, 199, 199, 199, 199, 199, 74, 74,
-	74, 200, 200, 200, 200, 200, 200, 200, 200, 201,
-	201, 201, 201, 203, 203, 203, 202, 202, 202, 202,
-	202, 202, 202, 202, 202, 202, 202, 204, 204, 211,
-	211, 212, 212, 213, 214, 205, 205, 206, 206, 207,
-	208, 215, 215, 215, 218, 218, 217, 217, 209, 209,
-	209, 209, 209, 210, 219, 219, 219, 123, 123, 123,
-	123, 123, 123, 123, 123, 123, 123, 123, 123, 123,
-	123, 124, 124, 156, 156, 156, 156, 156, 156, 156,
-	156, 156, 156, 156, 156, 156, 156, 156, 156, 156,
-	156, 156, 156, 156, 156, 156, 156, 156, 156, 156,
-	156, 156, 156, 156, 156, 156, 156, 156, 156, 156,
-	156, 156, 156, 156, 156, 156, 156, 156, 156, 156,
-	156, 156, 156, 156, 156, 156, 156, 156, 156, 156,
-	156, 156, 157, 157, 157, 157, 157, 157, 157, 157,
-	157, 157, 157, 157, 157, 157, 157, 157, 157, 157,
-	157, 157, 157, 157, 157, 157, 157, 157, 157, 158,
-	158, 158, 158, 158, 158, 158, 158, 158, 158, 158,
-	158, 158, 158, 249, 249, 249, 249, 249, 249, 249,
-	251, 251, 252, 252, 250, 250, 250, 250, 250, 250,
-	250, 250, 250, 250, 250, 250, 250, 250, 250, 250,
-	250, 250, 250, 250, 250, 250, 250, 250, 250, 250,
-	250, 250, 250, 250, 257, 257, 258, 258, 259, 259,
-	260, 260, 262, 263, 263, 263, 264, 268, 268, 261,
-	261, 265, 265, 265, 266, 266, 267, 267, 267, 267,
-	267, 173, 173, 173, 174, 174, 175, 181, 181, 181,
-	54, 54, 54, 54, 54, 54, 54, 54, 54, 54,
-	54, 54, 54, 54, 54, 54, 48, 48, 48, 81,
-	81, 171, 171, 163, 163, 163, 172, 172, 118, 118,
-	216, 216, 216, 216, 216, 216, 216, 216, 119, 119,
-	125, 120, 120, 121, 121, 121, 121, 121, 121, 126,
-	127, 122, 122, 122, 160, 160, 176, 180, 180, 179,
-	178, 178, 177, 177, 155, 155, 155, 155, 88, 88,
-	89, 89, 278, 278, 182, 182, 90, 90, 91, 84,
-	84, 83, 83, 193, 193, 193, 193, 77, 77, 52,
-	52, 72, 72, 73, 73, 49, 49, 159, 159, 159,
-	159, 159, 159, 159, 159, 159, 159, 159, 159, 221,
-	221, 221, 46, 46, 46, 47, 47, 227, 227, 227,
-	228, 228, 228, 228, 226, 226, 226, 226, 226, 232,
-	232, 232, 232, 232, 232, 232, 232, 232, 232, 232,
-	232, 232, 232, 232, 232, 232, 232, 232, 232, 232,
-	232, 232, 232, 232, 232, 232, 232, 232, 232, 232,
-	232, 232, 232, 232, 232, 232, 232, 232, 232, 232,
-	232, 232, 232, 232, 232, 232, 232, 232, 232, 232,
-	232, 232, 232, 232, 232, 232, 232, 232, 232, 232,
-	232, 232, 232, 232, 232, 232, 232, 232, 232, 232,
-	232, 232, 232, 232, 232, 232, 232, 232, 232, 232,
-	232, 232, 232, 232, 232, 232, 232, 232, 232, 232,
-	232, 232, 232, 232, 232, 232, 232, 232, 232, 232,
-	232, 232, 232, 232, 232, 232, 232, 232, 232, 232,
-	232, 232, 232, 232, 232, 232, 232, 232, 232, 232,
-	232, 232, 232, 232, 232, 232, 232, 232, 232, 232,
-	232, 232, 232, 232, 232, 232, 232, 232, 232, 232,
-	232, 232, 232, 232, 232, 232, 232, 232, 232, 232,
-	232, 232, 232, 232, 232, 232, 232, 232, 232, 232,
-	232, 232, 232, 232, 232, 232, 232, 232, 232, 232,
-	232, 232, 232, 232, 232, 232, 232, 232, 232, 232,
-	232, 232, 232, 232, 232, 232, 232, 232, 232, 232,
-	232, 232, 232, 232, 232, 232, 232, 232, 232, 232,
-	232, 232, 232, 232, 232, 232, 232, 232, 232, 232,
-	232, 232, 232, 232, 232, 232, 232, 232, 232, 232,
-	232, 232, 232, 232, 232, 232, 232, 232, 232, 232,
-	232, 232, 232, 232, 232, 232, 232, 232, 232, 232,
-	232, 232, 232, 232, 232, 232, 232, 232, 232, 232,
-	232, 232, 232, 232, 232, 232, 232, 232, 232, 232,
-	232, 232, 232, 232, 232, 232, 232, 232, 234, 234,
-	234, 234, 234, 234, 234, 234, 234, 234, 234, 234,
-	234, 234, 234, 234, 234, 234, 234, 234, 234, 234,
-	234, 234, 234, 234, 234, 234, 234, 234, 234, 234,
-	234, 234, 234, 234, 234, 234, 234, 234, 233, 233,
-	233, 233, 233, 233, 233, 233, 233, 233, 233, 233,
-	233, 233, 233, 233, 233, 233, 233, 233, 235, 235,
-	235, 235, 235, 235, 235, 235, 235, 235, 235, 235,
-	235, 235, 235, 235, 235, 235, 235, 235, 235, 235,
-	235, 235, 235, 235, 235, 235, 235, 235, 235, 235,
-	235, 235, 235, 235, 235, 235, 235, 235, 235, 235,
-	235, 235, 235, 235, 235, 235, 235, 235, 235, 235,
-	235, 235, 235, 235, 235, 235, 235, 235, 235, 235,
-	235, 235, 235, 235, 235, 235, 235, 235, 235, 235,
-	235, 235, 235, 235, 235,
+	25, 25, 272, 272, 272, 41, 94, 94, 9, 9,
+	18, 18, 51, 51, 136, 136, 136, 138, 138, 138,
+	137, 137, 96, 96, 27, 83, 83, 84, 84, 191,
+	85, 85, 22, 22, 29, 29, 28, 28, 28, 28,
+	28, 28, 28, 28, 30, 30, 31, 31, 31, 31,
+	31, 31, 31, 249, 249, 249, 251, 251, 248, 19,
+	19, 19, 19, 250, 250, 271, 271, 112, 112, 112,
+	63, 63, 64, 64, 67, 67, 66, 68, 68, 184,
+	184, 92, 92, 92, 92, 93, 93, 130, 130, 131,
+	131, 132, 132, 133, 133, 133, 134, 134, 135, 135,
+	91, 91, 98, 98, 99, 99, 100, 158, 158, 108,
+	108, 109, 109, 110, 110, 107, 42, 42, 42, 106,
+	106, 106, 106, 111, 111, 32, 32, 73, 73, 74,
+	74, 186, 186, 186, 186, 186, 186, 186, 187, 187,
+	187, 187, 187, 187, 181, 181, 181, 181, 182, 182,
+	183, 183, 183, 183, 183, 115, 115, 115, 115, 113,
+	113, 114, 114, 188, 188, 188, 188, 97, 97, 189,
+	189, 189, 159, 159, 162, 163, 163, 164, 164, 165,
+	165, 151, 151, 95, 95, 147, 194, 194, 194, 194,
+	194, 194, 72, 72, 72, 195, 195, 195, 195, 195,
+	195, 195, 195, 195, 195, 195, 195, 195, 196, 196,
+	196, 196, 198, 198, 198, 197, 197, 197, 197, 197,
+	197, 197, 197, 197, 197, 197, 199, 199, 206, 206,
+	207, 207, 208, 209, 200, 200, 201, 201, 202, 203,
+	210, 210, 210, 213, 213, 212, 212, 204, 204, 204,
+	204, 204, 205, 214, 214, 214, 121, 121, 121, 121,
+	121, 121, 121, 121, 121, 121, 121, 121, 121, 121,
+	122, 122, 153, 153, 153, 153, 153, 153, 153, 153,
+	153, 153, 153, 153, 153, 153, 153, 153, 153, 153,
+	153, 153, 153, 153, 153, 153, 153, 153, 153, 153,
+	153, 153, 153, 153, 153, 153, 153, 153, 153, 153,
+	153, 153, 153, 153, 153, 153, 153, 153, 153, 153,
+	153, 153, 153, 153, 153, 153, 153, 153, 153, 153,
+	153, 153, 153, 153, 153, 153, 153, 153, 153, 154,
+	154, 154, 154, 154, 154, 154, 154, 154, 154, 154,
+	154, 154, 154, 154, 154, 154, 154, 154, 154, 154,
+	154, 154, 154, 154, 154, 154, 155, 155, 155, 155,
+	155, 155, 155, 155, 155, 155, 155, 155, 155, 155,
+	244, 244, 244, 244, 244, 244, 244, 246, 246, 247,
+	247, 245, 245, 245, 245, 245, 245, 245, 245, 245,
+	245, 245, 245, 245, 245, 245, 245, 245, 245, 245,
+	245, 245, 245, 245, 245, 245, 245, 245, 245, 245,
+	245, 252, 252, 253, 253, 254, 254, 255, 255, 257,
+	258, 258, 258, 259, 263, 263, 256, 256, 260, 260,
+	260, 261, 261, 262, 262, 262, 262, 262, 170, 170,
+	170, 171, 171, 172, 178, 178, 178, 52, 52, 52,
+	52, 52, 52, 52, 52, 52, 52, 52, 52, 52,
+	52, 52, 52, 46, 46, 46, 46, 46, 79, 79,
+	168, 168, 160, 160, 160, 169, 169, 116, 116, 116,
+	211, 211, 211, 211, 211, 211, 211, 211, 118, 118,
+	123, 117, 117, 119, 119, 119, 119, 119, 119, 124,
+	125, 120, 120, 120, 157, 157, 173, 177, 177, 176,
+	175, 175, 174, 174, 152, 152, 152, 152, 86, 86,
+	87, 87, 273, 273, 179, 179, 88, 88, 89, 82,
+	82, 81, 81, 190, 190, 190, 190, 75, 75, 50,
+	50, 70, 70, 71, 71, 47, 47, 156, 156, 156,
+	156, 156, 156, 156, 156, 156, 156, 156, 156, 216,
+	216, 216, 44, 44, 44, 45, 45, 222, 222, 222,
+	223, 223, 223, 223, 221, 221, 221, 221, 221, 227,
+	227, 227, 227, 227, 227, 227, 227, 227, 227, 227,
+	227, 227, 227, 227, 227, 227, 227, 227, 227, 227,
+	227, 227, 227, 227, 227, 227, 227, 227, 227, 227,
+	227, 227, 227, 227, 227, 227, 227, 227, 227, 227,
+	227, 227, 227, 227, 227, 227, 227, 227, 227, 227,
+	227, 227, 227, 227, 227, 227, 227, 227, 227, 227,
+	227, 227, 227, 227, 227, 227, 227, 227, 227, 227,
+	227, 227, 227, 227, 227, 227, 227, 227, 227, 227,
+	227, 227, 227, 227, 227, 227, 227, 227, 227, 227,
+	227, 227, 227, 227, 227, 227, 227, 227, 227, 227,
+	227, 227, 227, 227, 227, 227, 227, 227, 227, 227,
+	227, 227, 227, 227, 227, 227, 227, 227, 227, 227,
+	227, 227, 227, 227, 227, 227, 227, 227, 227, 227,
+	227, 227, 227, 227, 227, 227, 227, 227, 227, 227,
+	227, 227, 227, 227, 227, 227, 227, 227, 227, 227,
+	227, 227, 227, 227, 227, 227, 227, 227, 227, 227,
+	227, 227, 227, 227, 227, 227, 227, 227, 227, 227,
+	227, 227, 227, 227, 227, 227, 227, 227, 227, 227,
+	227, 227, 227, 227, 227, 227, 227, 227, 227, 227,
+	227, 227, 227, 227, 227, 227, 227, 227, 227, 227,
+	227, 227, 227, 227, 227, 227, 227, 227, 227, 227,
+	227, 227, 227, 227, 227, 227, 227, 227, 227, 227,
+	227, 227, 227, 227, 227, 227, 227, 227, 227, 227,
+	227, 227, 227, 227, 227, 227, 227, 227, 227, 227,
+	227, 227, 227, 227, 227, 227, 227, 227, 227, 227,
+	227, 227, 227, 227, 227, 227, 227, 227, 227, 227,
+	227, 227, 227, 227, 227, 227, 227, 227, 227, 227,
+	227, 229, 229, 229, 229, 229, 229, 229, 229, 229,
+	229, 229, 229, 229, 229, 229, 229, 229, 229, 229,
+	229, 229, 229, 229, 229, 229, 229, 229, 229, 229,
+	229, 229, 229, 229, 229, 229, 229, 229, 229, 229,
+	229, 229, 229, 229, 228, 228, 228, 228, 228, 228,
+	228, 228, 228, 228, 228, 228, 228, 228, 228, 228,
+	228, 228, 228, 228, 228, 230, 230, 230, 230, 230,
+	230, 230, 230, 230, 230, 230, 230, 230, 230, 230,
+	230, 230, 230, 230, 230, 230, 230, 230, 230, 230,
+	230, 230, 230, 230, 230, 230, 230, 230, 230, 230,
+	230, 230, 230, 230, 230, 230, 230, 230, 230, 230,
+	230, 230, 230, 230, 230, 230, 230, 230, 230, 230,
+	230, 230, 230, 230, 230, 230, 230, 230, 230, 230,
+	230, 230, 230, 230, 230, 230, 230, 230, 230, 230,
+	230, 230,
 }
 var sqlR2 = [...]int{
 
@@ -5162,81 +5431,81 @@ var sqlR2 = [...]int{
 	6, 4, 8, 2, 3, 3, 6, 4, 2, 2,
 	2, 3, 2, 2, 2, 2, 3, 2, 1, 1,
 	0, 2, 0, 2, 0, 3, 2, 0, 1, 3,
-	3, 1, 5, 3, 1, 1, 1, 1, 6, 6,
-	4, 3, 5, 3, 5, 1, 1, 3, 1, 2,
-	2, 3, 2, 3, 5, 1, 1, 1, 1, 1,
-	1, 3, 2, 1, 1, 1, 0, 6, 6, 1,
-	2, 2, 1, 1, 1, 3, 1, 1, 1, 3,
-	2, 3, 3, 2, 5, 1, 3, 3, 3, 3,
-	1, 3, 3, 2, 2, 1, 1, 3, 1, 1,
-	2, 2, 2, 1, 1, 1, 1, 1, 1, 1,
-	3, 5, 1, 1, 1, 1, 1, 0, 1, 1,
-	2, 4, 2, 4, 4, 3, 3, 2, 2, 0,
-	2, 0, 2, 0, 10, 13, 9, 12, 1, 1,
-	2, 2, 2, 2, 1, 0, 1, 0, 3, 0,
-	1, 3, 1, 3, 1, 1, 1, 1, 1, 3,
-	4, 2, 0, 3, 1, 2, 2, 1, 1, 2,
-	5, 2, 5, 3, 3, 3, 0, 1, 1, 1,
-	1, 1, 1, 3, 1, 4, 4, 2, 4, 5,
-	3, 6, 10, 2, 0, 3, 0, 2, 2, 2,
-	0, 1, 3, 3, 4, 0, 1, 1, 2, 2,
-	0, 3, 3, 2, 1, 1, 2, 2, 4, 0,
-	2, 0, 3, 4, 4, 0, 3, 7, 10, 4,
-	2, 3, 0, 1, 2, 1, 1, 1, 1, 1,
-	1, 5, 2, 2, 0, 13, 16, 1, 0, 1,
-	0, 2, 0, 1, 3, 5, 5, 7, 2, 0,
-	1, 2, 0, 1, 1, 0, 2, 2, 0, 1,
-	4, 5, 1, 3, 6, 6, 8, 6, 8, 8,
-	10, 8, 10, 1, 0, 2, 0, 2, 3, 3,
-	2, 2, 2, 2, 3, 2, 5, 4, 3, 3,
-	3, 1, 1, 0, 3, 2, 2, 1, 2, 1,
-	3, 2, 1, 0, 3, 6, 7, 1, 3, 1,
-	4, 2, 8, 5, 0, 4, 3, 0, 2, 0,
-	8, 1, 3, 1, 1, 3, 5, 5, 1, 1,
-	3, 3, 1, 2, 4, 4, 2, 3, 5, 5,
-	1, 1, 8, 8, 1, 2, 4, 4, 4, 2,
-	2, 3, 1, 3, 6, 1, 1, 1, 1, 1,
-	0, 1, 0, 1, 1, 0, 1, 5, 1, 0,
-	1, 0, 3, 1, 3, 4, 3, 2, 2, 1,
-	1, 1, 0, 2, 5, 2, 3, 1, 1, 1,
-	3, 0, 1, 1, 1, 1, 3, 0, 1, 3,
-	1, 1, 2, 2, 0, 1, 3, 1, 0, 1,
-	2, 3, 1, 2, 0, 2, 4, 2, 3, 2,
-	0, 2, 3, 2, 0, 1, 3, 2, 2, 3,
-	2, 3, 1, 4, 3, 4, 5, 4, 5, 4,
-	5, 2, 4, 1, 1, 0, 1, 4, 5, 4,
-	0, 2, 2, 2, 1, 1, 0, 4, 2, 1,
-	2, 2, 4, 1, 3, 1, 2, 3, 2, 6,
-	2, 1, 3, 4, 0, 2, 0, 2, 0, 1,
-	3, 3, 2, 3, 5, 6, 2, 3, 3, 4,
-	0, 1, 1, 1, 1, 2, 4, 1, 1, 1,
-	1, 1, 1, 3, 5, 0, 1, 1, 1, 1,
-	1, 2, 2, 2, 2, 2, 1, 3, 0, 1,
-	1, 1, 1, 5, 2, 1, 1, 1, 1, 5,
-	2, 2, 2, 1, 1, 0, 3, 0, 1, 5,
-	2, 5, 2, 1, 3, 3, 0, 1, 1, 1,
-	1, 1, 1, 3, 3, 3, 3, 3, 3, 3,
-	0, 1, 4, 1, 3, 3, 5, 2, 2, 2,
+	3, 1, 5, 3, 1, 1, 1, 1, 6, 4,
+	6, 3, 5, 3, 5, 1, 3, 1, 2, 2,
+	3, 2, 3, 5, 1, 1, 1, 1, 1, 1,
+	3, 1, 6, 6, 1, 2, 2, 1, 1, 1,
+	3, 1, 1, 1, 3, 2, 3, 3, 2, 5,
+	1, 3, 3, 3, 3, 1, 3, 3, 2, 2,
+	1, 1, 3, 1, 1, 2, 2, 2, 1, 1,
+	1, 1, 1, 1, 1, 1, 3, 5, 1, 1,
+	1, 1, 1, 0, 1, 1, 2, 4, 2, 4,
+	4, 3, 3, 2, 2, 0, 2, 0, 2, 0,
+	10, 13, 9, 12, 1, 1, 2, 2, 2, 2,
+	1, 0, 1, 0, 3, 0, 1, 3, 1, 3,
+	1, 1, 1, 1, 1, 3, 4, 2, 0, 3,
+	1, 2, 2, 1, 1, 2, 5, 2, 5, 3,
+	3, 3, 0, 1, 1, 1, 1, 1, 1, 3,
+	1, 4, 4, 2, 4, 5, 3, 6, 10, 2,
+	0, 3, 0, 2, 2, 2, 0, 1, 3, 3,
+	4, 0, 1, 1, 2, 2, 0, 3, 3, 2,
+	1, 1, 2, 2, 4, 0, 2, 0, 3, 4,
+	4, 0, 3, 6, 9, 4, 1, 2, 1, 1,
+	1, 1, 1, 1, 5, 2, 2, 0, 12, 15,
+	1, 0, 1, 0, 2, 0, 1, 3, 5, 2,
+	0, 1, 2, 0, 1, 1, 0, 2, 2, 0,
+	1, 4, 5, 1, 3, 6, 6, 8, 6, 8,
+	8, 10, 8, 10, 1, 0, 2, 0, 2, 3,
+	3, 2, 2, 2, 2, 3, 2, 5, 4, 3,
+	3, 3, 1, 1, 0, 3, 1, 0, 3, 6,
+	7, 6, 1, 3, 1, 4, 2, 8, 5, 0,
+	3, 0, 2, 0, 8, 1, 3, 1, 1, 3,
+	5, 5, 1, 1, 3, 3, 1, 2, 4, 4,
+	2, 3, 5, 5, 1, 1, 8, 8, 1, 2,
+	4, 4, 4, 2, 2, 3, 1, 3, 6, 1,
+	1, 1, 1, 1, 0, 1, 0, 1, 1, 0,
+	1, 5, 1, 0, 1, 0, 3, 1, 3, 4,
+	3, 2, 2, 1, 1, 1, 0, 2, 5, 2,
+	3, 1, 1, 1, 3, 0, 1, 1, 1, 1,
+	3, 0, 1, 3, 1, 1, 2, 2, 0, 1,
+	3, 1, 0, 1, 2, 3, 1, 2, 0, 2,
+	4, 2, 3, 2, 0, 2, 3, 2, 0, 1,
+	3, 2, 2, 3, 2, 3, 1, 4, 3, 4,
+	5, 4, 5, 4, 5, 2, 4, 1, 1, 0,
+	1, 4, 5, 4, 0, 2, 2, 2, 1, 1,
+	0, 4, 2, 1, 2, 2, 4, 1, 3, 1,
+	2, 3, 2, 6, 2, 1, 3, 4, 0, 2,
+	0, 2, 0, 1, 3, 3, 2, 3, 5, 6,
+	2, 3, 3, 4, 0, 1, 1, 1, 1, 2,
+	4, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 3, 5, 0, 1, 1, 1, 1, 1,
+	2, 2, 2, 2, 2, 1, 3, 0, 1, 1,
+	1, 1, 5, 2, 1, 1, 1, 1, 5, 2,
+	2, 2, 1, 1, 0, 3, 0, 1, 5, 2,
+	5, 2, 1, 3, 3, 0, 1, 1, 1, 1,
+	1, 1, 3, 3, 3, 3, 3, 3, 3, 0,
+	1, 4, 1, 3, 3, 5, 2, 2, 2, 3,
 	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
-	3, 3, 3, 3, 3, 3, 3, 3, 2, 2,
-	3, 5, 4, 6, 4, 6, 5, 7, 3, 4,
-	3, 3, 4, 3, 4, 3, 4, 5, 6, 6,
-	7, 6, 7, 6, 7, 3, 4, 4, 6, 2,
-	3, 4, 1, 3, 2, 2, 2, 3, 3, 3,
+	3, 3, 3, 3, 3, 3, 3, 2, 2, 3,
+	5, 4, 6, 3, 5, 4, 6, 4, 6, 5,
+	7, 3, 3, 3, 3, 3, 4, 3, 3, 4,
+	3, 4, 3, 4, 5, 6, 6, 7, 6, 7,
+	6, 7, 3, 4, 4, 6, 2, 3, 4, 1,
+	3, 2, 2, 2, 3, 3, 3, 3, 3, 3,
 	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
-	3, 3, 3, 5, 6, 6, 7, 3, 4, 1,
-	1, 2, 4, 1, 1, 1, 2, 2, 2, 2,
-	1, 1, 4, 3, 5, 6, 8, 6, 6, 4,
-	4, 1, 1, 1, 5, 1, 1, 4, 1, 4,
-	1, 4, 1, 4, 1, 1, 1, 1, 1, 1,
-	6, 4, 4, 4, 4, 6, 5, 5, 5, 4,
-	6, 4, 4, 4, 5, 0, 5, 0, 2, 0,
-	1, 3, 3, 2, 2, 0, 6, 1, 0, 3,
-	0, 2, 2, 0, 1, 4, 2, 2, 2, 2,
-	2, 4, 3, 5, 4, 3, 5, 1, 1, 1,
+	5, 6, 6, 7, 3, 4, 1, 1, 2, 4,
+	1, 1, 1, 2, 2, 2, 2, 1, 1, 4,
+	3, 5, 6, 8, 6, 6, 4, 4, 1, 1,
+	1, 5, 1, 1, 4, 1, 4, 1, 4, 1,
+	4, 1, 1, 1, 1, 1, 1, 6, 4, 4,
+	4, 4, 6, 5, 5, 5, 4, 6, 4, 4,
+	4, 5, 0, 5, 0, 2, 0, 1, 3, 3,
+	2, 2, 0, 6, 1, 0, 3, 0, 2, 2,
+	0, 1, 4, 2, 2, 2, 2, 2, 4, 3,
+	5, 4, 3, 5, 1, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
-	1, 1, 1, 1, 1, 1, 1, 1, 2, 1,
-	3, 1, 3, 3, 3, 2, 1, 3, 3, 0,
+	1, 1, 1, 1, 1, 2, 1, 2, 1, 3,
+	1, 3, 3, 3, 2, 1, 3, 3, 1, 0,
 	1, 1, 1, 1, 1, 1, 1, 1, 4, 3,
 	2, 3, 0, 3, 3, 2, 2, 1, 0, 2,
 	2, 3, 2, 1, 1, 3, 5, 1, 2, 4,
@@ -5287,244 +5556,245 @@ var sqlR2 = [...]int{
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
-	1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1,
 }
 var sqlChk = [...]int{
 
 	-1000, -1, -2, -3, -4, -8, -13, -14, -15, -17,
 	-18, -20, -21, -22, -23, -24, -25, -26, -27, -5,
-	-6, -7, -9, -10, -11, -12, -255, 130, 146, 167,
-	27, 316, -28, -29, 337, 341, 17, 44, 351, 76,
-	136, 319, 324, 306, 286, 382, 90, -254, -31, -30,
-	445, 416, 427, 330, -32, 363, 403, 448, 118, 197,
-	395, -132, 105, 363, 187, -16, 407, 445, -22, -18,
-	-27, -13, -12, 90, -273, 25, -274, -275, 296, 421,
-	105, 187, 363, -273, -143, 223, 335, 376, -144, -142,
-	-225, 372, 59, 240, -78, -46, 4, -232, -234, 17,
-	18, 19, 20, 21, 22, 23, 24, 26, 27, 28,
-	36, 37, 39, 40, 42, 43, 44, 52, 53, 54,
-	55, 56, 60, 63, 65, 66, 67, 68, 73, 74,
-	75, 76, 77, 80, 81, 82, 84, 85, 86, 87,
-	88, 89, 92, 93, 94, 102, 103, 104, 105, 106,
-	108, 109, 112, 114, 116, 117, 118, 119, 120, 122,
-	123, 124, 127, 128, 129, 130, 131, 133, 134, 135,
-	137, 138, 139, 141, 142, 143, 144, 146, 147, 148,
-	151, 153, 154, 156, 158, 160, 164, 165, 166, 168,
-	169, 173, 175, 176, 177, 178, 179, 180, 181, 182,
-	183, 185, 186, 187, 188, 189, 190, 192, 195, 196,
-	197, 198, 204, 206, 208, 209, 210, 211, 212, 215,
-	218, 221, 222, 226, 227, 228, 229, 230, 231, 232,
-	233, 234, 235, 236, 237, 238, 239, 244, 245, 248,
-	249, 250, 253, 255, 256, 257, 259, 262, 263, 266,
-	269, 272, 273, 274, 275, 276, 277, 278, 280, 281,
-	283, 286, 287, 285, 289, 290, 291, 292, 293, 294,
-	295, 296, 298, 299, 300, 301, 303, 304, 305, 306,
-	307, 308, 309, 310, 311, 312, 313, 315, 316, 318,
-	319, 320, 322, 323, 324, 325, 326, 327, 328, 329,
-	331, 332, 333, 334, 337, 338, 340, 341, 343, 344,
-	346, 348, 349, 350, 351, 352, 353, 354, 355, 356,
-	357, 358, 361, 362, 364, 366, 367, 368, 369, 370,
-	377, 379, 382, 383, 384, 385, 386, 387, 388, 391,
-	392, 393, 394, 395, 398, 399, 400, 401, 402, 406,
-	408, 409, 410, 411, 414, 417, 418, 419, 420, 421,
-	422, 423, 424, 45, 46, 48, 50, 61, 62, 69,
-	107, 110, 111, 145, 149, 155, 170, 172, 194, 199,
-	200, 202, 216, 241, 243, 246, 252, 254, 267, 271,
-	282, 284, 297, 321, 339, 345, 359, 373, 378, 380,
-	403, 404, 73, 106, 169, 187, 364, 372, 25, -277,
-	419, 376, -277, 376, -277, 287, -277, -277, 287, -46,
-	59, 223, 240, 335, 376, 372, 324, -46, 376, -276,
-	363, -106, -128, 105, 369, 367, 223, 166, 393, 390,
-	-30, -31, -29, -68, -69, 389, 201, 140, 265, -28,
-	-29, -254, -256, 300, -253, -46, -256, -66, -65, 25,
-	125, 449, -191, -52, 261, -46, -91, 445, -3, 162,
-	203, -192, -191, 179, -79, -78, -46, 179, -79, 179,
-	-16, -198, -197, -195, -228, 403, 4, -232, -234, -233,
-	41, 47, 71, 79, 91, 98, 161, 163, 193, 205,
-	207, 217, 219, 242, 268, 270, 317, 342, 365, 407,
-	-106, 260, 449, -46, -52, 179, -191, 179, 260, -143,
-	335, -143, 63, -41, -42, 206, 296, 115, 247, 374,
-	430, 162, 424, 6, -56, 6, 113, -82, 447, 162,
-	-270, 260, 162, -224, 162, 424, -96, -41, -96, 6,
-	374, 6, -46, 6, -99, -191, 363, 187, -46, 179,
-	369, 367, 369, 367, -68, -69, -110, -94, -112, 157,
-	-133, -134, -109, 220, 152, 258, -108, -114, 25, 125,
-	-114, -114, 52, 446, 446, 449, -256, -73, 445, -84,
-	-83, -193, -156, 434, -158, 432, 433, 442, 247, 425,
-	-173, 390, -52, -159, 10, 445, -176, -251, -29, 145,
-	33, -174, -175, 172, 321, -46, 9, 5, 6, 7,
-	8, -49, -201, -210, 381, 150, 251, 57, -249, -250,
-	4, -232, -227, -202, -212, -206, -209, 202, 71, 96,
-	99, 100, 224, 225, 97, 101, 336, 396, 95, 98,
-	58, 149, 271, 282, 359, 378, 380, 252, 69, 170,
-	216, 129, 46, 48, 50, 61, 62, 107, 110, 111,
-	155, 199, 200, 254, 297, 345, 372, 373, 404, -233,
-	-213, -214, -207, -208, -215, -83, 260, -91, 434, -52,
-	445, -88, -155, 447, 443, -90, -182, -156, 113, -192,
-	-53, -52, 337, -46, 34, 145, -40, 449, 55, 313,
-	145, 145, 446, 449, -196, -222, -183, 381, 150, 260,
-	-229, 5, 433, -221, -228, 6, 9, 432, 363, -269,
-	-77, 363, 105, -52, -275, -145, 307, 337, -39, 307,
-	-37, 21, 27, 130, 400, 337, 189, 245, 256, 247,
-	311, 145, -39, 307, 145, -269, 63, 34, 449, -42,
-	218, 261, 421, 115, -223, 113, -230, -222, -183, -223,
-	113, 94, -231, 6, 4, -210, -183, 113, 223, 202,
-	447, -226, 4, -232, -234, -233, -235, 25, 29, 30,
-	31, 32, 33, 34, 35, 38, 51, 57, 58, 64,
-	70, 72, 83, 90, 95, 96, 97, 99, 100, 101,
-	113, 115, 121, 125, 126, 132, 136, 140, 150, 152,
-	157, 159, 162, 167, 171, 174, 184, 191, 201, 203,
-	213, 214, 220, 224, 225, 247, 251, 258, 260, 261,
-	264, 265, 279, 288, 302, 314, 330, 336, 347, 360,
-	363, 371, 374, 375, 381, 389, 390, 396, 397, 405,
-	412, 413, 415, 416, -225, -272, 157, -269, -225, -225,
-	324, -46, -105, 449, 86, 312, -78, 179, -103, -129,
-	79, 247, -110, -94, -95, -94, -111, -110, -109, 157,
-	296, 395, 245, 340, 208, -134, -133, -135, -156, 25,
-	-138, 154, 244, -156, -158, -113, 256, -30, -30, -30,
-	-70, -187, -156, -253, 34, -72, -46, -75, 162, 449,
-	11, 70, 39, 432, 433, 434, 435, 436, 439, 437,
-	438, 440, 428, 429, 430, 78, 13, 14, 15, 31,
-	264, 219, 425, 342, 205, 45, 184, -48, 34, 4,
-	-54, -156, -156, -156, -156, -156, 270, -29, -89, -29,
-	-156, -81, -88, -29, -29, -163, 443, 445, 445, -88,
-	445, 6, 6, 445, -177, -156, -257, 417, 157, 445,
-	445, 445, 445, 445, 445, 445, 445, 445, 445, 445,
-	445, 445, 445, 445, 284, -218, 406, -218, -218, -203,
-	445, -203, -204, 445, -203, 445, -219, 427, 418, 445,
-	-219, 445, -217, 62, -75, 445, -52, -155, -226, 434,
-	-156, 449, 446, -154, 413, -139, -22, 445, 113, 34,
-	-85, -86, -194, -87, -52, 445, -46, -79, -78, -46,
-	-79, -16, -197, 5, 9, 9, 179, -78, 374, 449,
-	-77, -72, 374, -143, 449, 374, -147, 72, -236, -46,
-	83, -148, 64, 390, 187, 288, 141, 159, -130, 83,
-	72, -130, 83, 83, 229, 393, -62, 445, -52, 189,
-	-78, 256, -62, -52, 374, -130, 83, -191, 162, 376,
-	-42, -55, 296, 308, 333, 449, 6, 445, -226, -271,
-	-46, -46, -40, -191, 178, 178, 445, 256, -67, 247,
-	34, -47, 179, -46, 145, -95, -111, 261, 208, 340,
-	-136, -221, 445, 433, -137, 321, 322, -44, 250, 344,
-	-77, 449, 397, -35, 35, 121, 445, 449, 446, -154,
-	-76, -189, -191, -162, 213, -29, -190, 445, -252, 322,
-	-249, -250, -49, -193, -199, -200, 339, -202, -211, -205,
-	-209, -210, 49, 370, 199, 200, 345, 46, 297, 155,
-	129, 111, 110, 254, 50, -213, -214, -207, -208, 107,
-	373, 372, 48, 62, 61, 404, -78, 372, -156, -156,
-	-156, -156, -156, -156, -156, -156, -156, -156, -156, -156,
-	-156, -156, -156, -156, -156, -156, -156, 219, 342, 45,
-	184, 374, 251, 247, 381, 150, 391, 125, 256, 127,
-	-278, 360, 38, -160, -29, 445, -181, 32, 347, 25,
-	-226, -173, 321, 445, -155, 446, 449, -81, -172, 444,
-	-156, -163, -81, -81, 446, 446, -81, 405, 25, 125,
-	434, -123, 422, 237, 108, 177, 234, -124, 328, 9,
-	-180, -179, 412, -258, 153, 171, 445, 9, 9, 9,
-	9, -156, -118, -216, 4, 422, 237, 108, 177, 234,
-	328, 6, -119, -156, -120, -157, -158, 432, 433, 442,
-	445, 321, -121, -156, -81, -156, 51, 214, 375, -122,
-	-156, 162, -81, -156, -81, -81, -81, 445, 9, 9,
-	9, 372, 372, 9, 9, 337, -154, -81, 446, 444,
-	450, -182, -98, 314, -156, -141, 260, -77, 403, -46,
-	-75, 449, 430, -77, -40, 247, 445, -271, -52, -46,
-	-37, -46, -147, -199, -46, 445, 445, -248, 397, 445,
-	208, -45, 397, 208, -46, -46, 179, -46, 179, -46,
-	-46, -92, -152, -226, -52, -39, 307, -46, -46, -46,
-	-39, 307, -271, -41, 387, 77, 296, -230, -123, 9,
-	449, -57, -72, -58, -46, -146, -147, -237, -236, 219,
-	-78, -64, 416, 145, -22, 260, 247, -46, 395, -137,
-	-156, 228, -187, -54, 432, 433, 434, 435, 436, 437,
-	438, 439, 440, 428, 429, 430, 78, 13, 14, 15,
-	-36, 426, -19, -22, -18, -27, -13, -46, -93, 171,
-	449, 91, -117, 207, 242, 163, 217, 317, 193, -185,
-	-184, 34, -46, -186, -184, 34, -46, -162, -29, -46,
-	-185, -29, -190, -189, -168, 427, 162, 445, -74, 33,
-	-200, -123, 445, 424, 138, -156, 374, -278, 360, -160,
-	-156, 251, 381, 150, 391, 125, 256, 127, 162, 445,
-	-157, -157, -81, -29, 445, 445, -81, -89, -156, 449,
-	444, 444, 449, 446, 446, -69, 449, -68, -156, -81,
-	-81, 446, 374, 374, 374, 374, 445, 446, -178, -179,
-	132, -156, -263, 269, 445, 445, -156, 446, 446, 446,
-	446, 34, 446, 162, 446, -125, 279, 446, 11, 432,
-	433, 434, 435, 436, 439, 437, 438, 440, 428, 429,
-	430, 78, 13, 14, 15, 205, 184, -157, -157, -157,
-	-81, 445, 446, -126, -127, 162, 157, 34, -122, -122,
-	-122, 446, 162, -81, 449, 446, 446, 446, 9, 446,
-	449, 446, 446, 424, 424, 446, 446, -46, -93, 446,
-	-156, -83, -98, 81, 446, -154, -86, -182, 446, 145,
-	-240, -148, 187, 141, -156, -72, 187, -72, 445, -248,
-	445, -46, 445, -33, 130, 337, 311, -131, 145, -40,
-	145, -40, 446, 449, 430, 447, 374, 374, 374, 374,
-	-130, 83, 446, -46, 446, 446, 449, -52, -60, 445,
-	-107, 260, -62, -78, -103, -43, 416, -52, 145, 261,
-	446, -36, 154, 212, 446, -161, 174, 52, -189, 207,
-	207, -189, -117, 207, -115, 268, -115, -115, -46, 445,
-	-46, 445, 445, -186, -185, -88, 446, 266, 445, -81,
-	443, 443, -74, 33, 9, -156, -156, 138, -156, -157,
-	-157, 138, 162, 445, -156, -171, -199, 31, 31, 446,
-	-156, -81, 446, 449, 446, -156, -163, 446, 405, -69,
-	-69, -69, 237, 177, 234, -124, 234, -124, -124, 9,
-	6, 136, -156, 371, -264, -46, 445, 413, -68, 446,
-	-199, -156, -126, -156, -199, -157, -157, -157, -157, -157,
-	-157, -157, -157, -157, -157, -157, -157, -157, -157, -157,
-	-157, 125, 247, 256, 127, -157, 449, -81, 446, -127,
-	-126, -156, -156, -199, 446, 446, 446, -81, -156, 446,
-	9, -219, -219, -217, -161, 444, -140, 445, 260, -22,
-	-98, 430, -241, 83, -242, 70, 247, 251, 390, 288,
-	64, 113, 302, 446, 446, -46, 446, -72, -169, -170,
-	-188, -46, -252, 445, -72, 247, 113, 247, 353, -62,
-	356, 113, 104, -62, 384, -46, -46, -152, -153, -104,
-	-235, -54, -183, 6, -199, -227, 339, 432, 433, 4,
-	-232, 370, -226, -46, -46, -46, -46, -46, -46, 6,
-	-59, 190, -146, -238, -64, -61, -149, -151, -236, -46,
-	76, 445, 256, 34, 104, 245, -45, -46, -259, 415,
-	-156, -100, -101, -156, -102, 445, -189, -189, -116, 397,
-	260, 207, -189, 445, -72, 445, -97, -150, -46, -97,
-	-46, -184, -166, -165, -252, -69, 444, 9, 9, 443,
-	446, -156, 138, 31, 31, -156, -156, -171, 446, 449,
-	-156, -156, 446, 446, -156, 6, -156, 446, 446, 446,
-	446, -156, -268, -46, -156, 446, 446, -127, 162, 125,
-	256, 127, 445, -156, 446, 446, 446, 446, -259, 126,
-	-71, -188, 83, -91, -29, 445, -46, -78, 251, 208,
-	445, -157, -52, 446, 446, 449, 416, -51, 70, -51,
-	-156, 446, 251, 251, -221, -46, -156, -199, -40, -40,
-	-82, -227, 430, 374, 374, -64, 445, 185, 142, -107,
-	446, 449, 416, 130, 118, 285, -57, -78, -22, 104,
-	445, 260, -260, -262, -46, 449, 446, -116, 445, -156,
-	-189, -72, 446, -97, 446, 449, -199, 446, 446, 449,
-	-167, 34, 446, 444, 444, 9, -156, -156, -156, 446,
-	-199, 446, -69, -261, 276, 446, -157, 162, 445, -171,
-	446, 395, 248, 449, 446, -46, -242, -156, -67, -164,
-	413, -170, -80, -54, -46, -50, -78, 397, -78, -50,
-	446, 302, -38, 70, 436, -82, -153, -46, -46, -107,
-	-77, -239, 114, 84, 188, 356, 75, 25, -239, -149,
-	263, 322, 322, 446, -60, -43, -71, -52, 449, 34,
-	-101, -72, 446, 446, -150, -38, -168, -165, 445, 444,
-	446, -69, 52, -157, -171, 446, 337, -188, -154, 446,
-	-245, 231, 445, 447, -35, -78, -35, -51, -52, -34,
-	397, -78, 384, 436, 446, -240, -59, -64, 446, -45,
-	-262, -264, 446, -97, -265, 295, 322, -81, 446, -85,
-	-220, 245, -243, -246, -244, 260, 163, 275, 343, -156,
-	-80, -36, -36, -50, -67, -156, 384, -64, -107, -63,
-	416, 445, 446, 446, -266, -267, 45, 386, 94, -156,
-	-266, -154, 189, -244, 260, -246, 260, 395, 118, 446,
-	-35, -245, -107, -154, -62, -71, -267, 283, 156, 321,
-	283, 156, -247, 245, 313, 55, 337, -247, -36, -243,
-	446, 31, 20, 251, 113, -63, -267, -154,
+	-6, -7, -9, -10, -11, -12, -250, 136, 152, 173,
+	30, 323, -28, -29, 345, 349, 20, 47, 360, 82,
+	142, 326, 331, 313, 293, 391, 96, -249, -31, -30,
+	455, 426, 437, 337, -32, 372, 413, 458, 124, 204,
+	405, 404, 194, 111, 372, -16, 417, 455, -22, -18,
+	-27, -13, -12, 96, -268, 28, -269, -270, 303, 431,
+	111, 194, 372, -268, -140, 230, 343, 385, -141, -139,
+	-220, 381, 65, 247, -76, -44, 4, -227, -229, 20,
+	21, 22, 23, 24, 25, 26, 27, 29, 30, 31,
+	39, 40, 42, 43, 45, 46, 47, 56, 57, 58,
+	59, 60, 61, 62, 66, 69, 71, 72, 73, 74,
+	79, 80, 81, 82, 83, 86, 87, 88, 90, 91,
+	92, 93, 94, 95, 98, 99, 100, 108, 109, 110,
+	111, 112, 114, 115, 118, 120, 122, 123, 124, 125,
+	126, 128, 129, 130, 133, 134, 135, 136, 137, 139,
+	140, 141, 143, 144, 145, 147, 148, 149, 150, 152,
+	153, 154, 157, 159, 160, 162, 164, 166, 170, 171,
+	172, 174, 175, 179, 181, 182, 183, 184, 185, 187,
+	188, 189, 190, 192, 193, 194, 195, 196, 197, 199,
+	202, 203, 204, 205, 211, 213, 215, 216, 217, 218,
+	219, 222, 225, 228, 229, 233, 234, 235, 236, 237,
+	238, 239, 240, 241, 242, 243, 244, 245, 246, 251,
+	252, 255, 256, 257, 260, 262, 263, 264, 266, 269,
+	270, 273, 276, 279, 280, 281, 282, 283, 284, 285,
+	287, 288, 290, 293, 294, 292, 296, 297, 298, 299,
+	300, 301, 302, 303, 305, 306, 307, 308, 310, 311,
+	312, 313, 314, 315, 316, 317, 318, 319, 320, 322,
+	323, 325, 326, 327, 329, 330, 331, 332, 333, 334,
+	335, 336, 338, 339, 341, 342, 345, 346, 348, 349,
+	351, 352, 355, 357, 358, 359, 360, 361, 362, 363,
+	364, 365, 366, 367, 370, 371, 373, 375, 376, 377,
+	378, 379, 386, 388, 391, 392, 393, 394, 395, 396,
+	397, 400, 401, 402, 403, 404, 405, 408, 409, 410,
+	411, 412, 416, 418, 419, 420, 421, 424, 427, 428,
+	429, 430, 431, 432, 433, 434, 48, 49, 50, 52,
+	54, 67, 68, 75, 113, 116, 117, 151, 155, 161,
+	176, 178, 201, 206, 207, 209, 223, 248, 250, 253,
+	259, 261, 274, 278, 289, 291, 304, 328, 340, 347,
+	353, 354, 368, 382, 387, 389, 413, 414, 79, 112,
+	175, 194, 373, 381, 28, -272, 429, 385, -272, 385,
+	-272, 294, -272, -272, 294, -44, 65, 230, 247, 343,
+	385, 381, 331, -44, 385, -271, 372, -104, -126, 111,
+	378, 376, 230, 172, 402, 399, -30, -31, -29, -66,
+	-67, 398, 208, 146, 272, -28, -29, -249, -251, 307,
+	-248, -44, -251, -64, -63, 28, 131, 459, -188, -50,
+	268, -44, -89, 455, -3, 168, 210, 210, -189, -188,
+	-77, 185, -76, -44, 185, -77, 185, -16, -193, -192,
+	-223, 4, -227, -229, -228, 413, 44, 51, 77, 85,
+	97, 104, 167, 169, 186, 200, 212, 214, 224, 226,
+	249, 275, 277, 324, 350, 374, 417, -104, 267, 459,
+	-44, -50, 185, -188, 185, 267, -140, 343, -140, 69,
+	-41, 213, 383, 440, 168, 434, 6, -54, 6, 119,
+	-80, 457, 168, -265, 267, 168, -219, 168, 434, -94,
+	-41, -94, 6, 383, 6, -44, 6, -97, -188, 372,
+	194, -44, 185, 378, 376, 378, 376, -66, -67, -108,
+	-92, -110, 163, -130, -131, -107, 227, 158, 265, -106,
+	-112, 28, 131, -112, -112, 56, 456, 456, 459, -251,
+	-71, 455, -82, -81, -190, -153, 444, -155, 442, 443,
+	452, 254, 435, -170, 399, -50, -156, 10, 455, -173,
+	-246, -29, 151, 36, -171, -172, 178, 328, -44, 9,
+	5, 6, 7, 8, -47, -196, -205, 390, 156, 258,
+	63, -244, -245, 4, -227, -222, -197, -207, -201, -204,
+	209, 77, 102, 105, 106, 231, 232, 103, 107, 344,
+	406, 101, 104, 64, 155, 278, 289, 368, 387, 389,
+	259, 75, 176, 223, 135, 49, 52, 54, 67, 68,
+	113, 116, 117, 161, 206, 207, 261, 304, 353, 381,
+	382, 414, -228, -208, -209, -202, -203, -210, -81, 267,
+	-89, 444, -50, 455, -86, -152, 457, 453, -88, -179,
+	-153, 119, -189, -51, -50, -51, 345, -44, 37, -40,
+	459, 61, 320, 151, 151, 151, 456, 459, 372, -264,
+	-75, 372, 111, -50, -270, -142, 314, 345, -38, 314,
+	-37, 24, 30, 136, 410, 345, 196, 252, 263, 254,
+	318, 151, -38, 314, 151, -264, 69, 37, 225, -218,
+	119, -225, -217, -180, 390, 156, 267, -224, 5, 443,
+	-216, -223, 6, 9, 442, -218, 119, 100, -226, 6,
+	4, -205, -180, 119, 230, 209, 457, -221, 4, -227,
+	-229, -228, -230, 28, 32, 33, 34, 35, 36, 37,
+	38, 41, 55, 63, 64, 70, 76, 78, 89, 96,
+	101, 102, 103, 105, 106, 107, 119, 121, 127, 131,
+	132, 138, 142, 146, 156, 158, 163, 165, 168, 173,
+	177, 180, 191, 198, 208, 210, 220, 221, 227, 231,
+	232, 254, 258, 265, 267, 268, 271, 272, 286, 295,
+	309, 321, 337, 344, 356, 369, 372, 380, 383, 384,
+	390, 398, 399, 406, 407, 415, 422, 423, 425, 426,
+	-220, -267, 163, -264, -220, -220, 331, -44, -103, 459,
+	92, 319, -76, 185, -101, -127, 85, 254, -108, -92,
+	-93, -92, -109, -108, -107, 163, 303, 404, 252, 348,
+	215, -131, -130, -132, -153, 28, -135, 160, 251, -153,
+	-155, -111, 263, -30, -30, -30, -68, -184, -153, -248,
+	37, -70, -44, -73, 168, 459, 11, 76, 42, 442,
+	443, 444, 445, 446, 449, 447, 448, 450, 438, 439,
+	440, 84, 13, 14, 15, 34, 271, 226, 435, 186,
+	350, 452, 16, 17, 18, 212, 48, 191, -46, 37,
+	4, -52, -153, -153, -153, -153, -153, 277, -29, -87,
+	-29, -153, -79, -86, -29, -29, -160, 453, 455, 455,
+	-86, 455, 6, 6, 455, -174, -153, -252, 427, 163,
+	455, 455, 455, 455, 455, 455, 455, 455, 455, 455,
+	455, 455, 455, 455, 455, 291, -213, 416, -213, -213,
+	-198, 455, -198, -199, 455, -198, 455, -214, 437, 428,
+	455, -214, 455, -212, 68, -73, 455, -50, -152, -221,
+	444, -153, 459, 456, -151, 423, -136, -22, 455, 119,
+	37, -136, -83, -84, -191, -85, -50, 455, -44, -76,
+	-77, -44, -77, -16, -192, 185, -76, 383, 459, -75,
+	-70, 383, -140, 459, 383, -144, 78, -231, -44, 89,
+	-145, 70, 399, 194, 295, 147, 165, -128, 89, 78,
+	-128, 89, 89, 236, 402, -60, 455, -50, 196, -76,
+	263, -60, -50, 383, -128, 89, -188, 168, 385, -53,
+	303, 315, 355, 341, 459, 5, 9, 9, 6, 455,
+	-221, -266, -44, -44, -40, -188, 184, 184, 455, 263,
+	-65, 254, 37, -45, 185, -44, 151, -93, -109, 268,
+	215, 348, -133, -216, 455, 443, -134, 328, 329, -42,
+	257, 352, -75, 459, 407, -35, 38, 127, 455, 459,
+	456, -151, -74, -186, -188, -159, 220, -29, -187, 455,
+	-247, 329, -244, -245, -47, -190, -194, -195, 347, -197,
+	-206, -200, -204, -205, 53, 58, 57, 379, 340, 354,
+	50, 206, 207, 353, 49, 304, 161, 135, 117, 116,
+	261, 54, -208, -209, -202, -203, 113, 382, 381, 52,
+	68, 67, 414, -76, 381, -153, -153, -153, -153, -153,
+	-153, -153, -153, -153, -153, -153, -153, -153, -153, -153,
+	-153, -153, -153, -153, 226, 186, 350, 48, 191, -153,
+	383, -153, -153, -153, -153, 258, 254, 390, 156, 400,
+	131, 263, 133, -273, 369, 41, -157, -29, 455, -178,
+	35, 356, 28, -221, -170, 328, 455, -152, 456, 459,
+	-79, -169, 454, -153, -160, -79, -79, 456, 456, -79,
+	415, 28, 131, 444, -121, 432, 244, 114, 183, 241,
+	-122, 335, 9, -177, -176, 422, -253, 159, 177, 455,
+	9, 9, 9, 9, -153, -116, -211, -79, 4, 432,
+	244, 114, 183, 241, 335, 6, -118, -153, -117, -154,
+	-155, 442, 443, 452, 455, 328, -119, -153, -79, -153,
+	55, 221, 384, -120, -153, 168, -79, -153, -79, -79,
+	-79, 455, 9, 9, 9, 381, 381, 9, 9, 345,
+	-151, -79, 456, 454, 460, -179, -96, 321, -153, -138,
+	267, -75, 413, -44, -96, -73, 459, 440, -75, -40,
+	254, 455, -266, -50, -44, -37, -44, -144, -194, -44,
+	455, 455, -243, 407, 455, 215, -43, 407, 215, -44,
+	-44, 185, -44, 185, -44, -44, -90, -149, -221, -50,
+	-38, 314, -50, -44, -44, -38, 314, -266, -41, 396,
+	83, 303, -225, -121, 9, 459, -55, -70, -56, -44,
+	-143, -144, -232, -231, 226, -76, -62, 426, 151, -22,
+	267, 254, -44, 404, -134, -153, 235, -184, -52, 442,
+	443, 444, 445, 446, 447, 448, 449, 450, 438, 439,
+	440, 84, 13, 14, 15, -36, 436, -19, -22, -18,
+	-27, -13, -44, -91, 177, 459, 97, -115, 214, 249,
+	169, 224, 324, 200, -182, -181, 37, -44, -183, -181,
+	37, -44, -159, -29, -44, -182, -29, -187, -186, -165,
+	437, 168, 455, -72, 36, -195, -121, 455, 434, 144,
+	-153, -153, 383, -273, 369, -157, 144, -153, 258, 390,
+	156, 400, 131, 263, 133, 168, 455, -154, -154, -79,
+	-29, 455, 455, -79, -87, -153, 459, 454, 454, 459,
+	456, 456, -67, 459, -66, -153, -79, -79, 456, 383,
+	383, 383, 383, 455, 456, -175, -176, 138, -153, -258,
+	276, 455, 455, -153, 456, 456, 456, 456, 37, 456,
+	168, 456, -123, 286, 456, 11, 442, 443, 444, 445,
+	446, 449, 447, 448, 450, 438, 439, 440, 84, 13,
+	14, 15, 212, 191, -154, -154, -154, -79, 455, 456,
+	-124, -125, 168, 163, 37, -120, -120, -120, 456, 168,
+	-79, 459, 456, 456, 456, 9, 456, 459, 456, 456,
+	434, 434, 456, 456, -44, -91, 456, -153, -81, -96,
+	87, 456, -151, -84, -179, 456, 151, -235, -145, 194,
+	147, -153, -70, 194, -70, 455, -243, 455, -44, 455,
+	-33, 136, 345, 318, -129, 151, -40, 151, -40, 456,
+	459, 440, 457, 383, 383, 383, 383, -128, 89, 456,
+	-44, 456, 456, 459, -50, -58, 455, -105, 267, -60,
+	-76, -101, -50, 151, 268, 456, -36, 160, 219, 456,
+	-158, 180, 56, -186, 214, 214, -186, -115, 214, -113,
+	275, -113, -113, -44, 455, -44, 455, 455, -183, -182,
+	-86, 456, 273, 455, -79, 453, 453, -72, 36, 9,
+	-153, -153, 144, 144, -153, -154, -154, -153, 144, 168,
+	455, -153, -168, -194, 34, 34, 456, -153, -79, 456,
+	459, 456, -153, -160, 456, 415, -67, -67, -67, 244,
+	183, 241, -122, 241, -122, -122, 9, 6, 142, -153,
+	380, -259, -44, 455, 423, -66, 456, -194, -153, -124,
+	-153, -194, -154, -154, -154, -154, -154, -154, -154, -154,
+	-154, -154, -154, -154, -154, -154, -154, -154, 131, 254,
+	263, 133, -154, 459, -79, 456, -125, -124, -153, -153,
+	-194, 456, 456, 456, -79, -153, 456, 9, -214, -214,
+	-212, -158, 454, -137, 455, -22, -96, 440, -236, 89,
+	-237, 76, 254, 258, 399, 295, 70, 119, 309, 456,
+	456, -44, 456, -70, -166, -167, -185, -44, -70, 254,
+	119, 254, 362, -60, 365, 119, 110, -60, 393, -44,
+	-44, -149, -150, -102, -230, -52, -180, 6, -194, -222,
+	347, 442, 443, 4, -227, 58, 57, 379, -221, -44,
+	-44, -44, -50, -44, -44, 6, -57, 197, -143, -233,
+	-62, -59, -146, -148, -231, -44, 82, 455, 263, 37,
+	-43, -44, -254, 425, -153, -98, -99, -153, -100, 455,
+	-186, -186, -114, 407, 267, 214, -186, 455, -70, 455,
+	-95, -147, -44, -95, -44, -181, -163, -162, -247, -67,
+	454, 9, 9, 453, 456, -153, -153, 144, 34, 34,
+	-153, -153, -168, 456, 459, -153, -153, 456, 456, -153,
+	6, -153, 456, 456, 456, 456, -153, -263, -44, -153,
+	456, 456, -125, 168, 131, 263, 133, 455, -153, 456,
+	456, 456, 456, -254, 132, -69, -185, -89, -29, 455,
+	-44, -76, 258, 215, 455, -154, -50, 456, 456, 459,
+	426, -49, 76, 456, 258, 258, -216, -44, -153, -194,
+	-40, -40, -80, -222, 440, 383, 383, -62, 455, 192,
+	148, -105, 456, 459, 426, 136, 124, 292, -55, -76,
+	-22, 455, 267, -255, -257, -44, 459, 456, -114, 455,
+	-153, -186, -70, 456, -95, 456, 459, -194, 456, 456,
+	459, -164, 37, 456, 454, 454, 9, -153, -153, -153,
+	456, -194, 456, -67, -256, 283, 456, -154, 168, 455,
+	-168, 456, 404, 255, 459, 456, -237, -153, -65, -161,
+	423, -167, -78, -52, -44, -48, -76, 407, -76, 309,
+	-39, 76, 446, -80, -150, -44, -44, -105, -75, -234,
+	120, 90, 195, 365, 81, 28, -234, -146, 270, 329,
+	329, 456, -58, -69, -50, 459, 37, -99, -70, 456,
+	456, -147, -39, -165, -162, 455, 454, 456, -67, 56,
+	-154, -168, 456, 345, -185, 456, -242, 238, 455, 457,
+	-35, -76, -50, -34, 407, -76, 393, 446, 456, -235,
+	-57, -62, 456, -43, -257, -259, 456, -95, -260, 302,
+	329, -79, 456, -83, -215, 252, -238, -240, -239, 267,
+	169, 282, 351, -153, -78, -36, -65, -153, 393, -62,
+	-105, -61, 426, 455, 456, 456, -261, -262, 48, 395,
+	100, -153, -261, -151, 196, -239, 267, -240, 267, 404,
+	124, 456, -242, -105, -60, -69, -262, 290, 162, 328,
+	290, 162, -241, 252, 320, 61, 345, -241, -238, 456,
+	34, 23, 258, 119, -61, -262,
 }
 var sqlDef = [...]int{
 
 	-2, -2, 1, 3, 4, 5, 6, 7, 8, 9,
 	10, 11, 12, 13, 14, 15, 16, 17, 18, 20,
-	21, 22, 74, 75, 76, 77, 0, 0, 410, 0,
-	0, 0, 378, -2, 0, 0, 343, 343, 0, 343,
-	343, 343, 0, 0, 0, 412, -2, 409, -2, 421,
-	0, 0, 0, 419, 394, 0, 0, -2, 0, 0,
-	0, 0, 0, 0, 85, 92, 410, 0, 95, 96,
-	97, 98, 99, 185, 0, 112, 113, 114, 116, 117,
-	0, 0, 0, 0, 120, 1044, 1127, 1154, 125, 130,
-	0, 1222, 942, -2, 135, 88, 902, 903, 904, 919,
+	21, 22, 74, 75, 76, 77, 0, 0, 394, 0,
+	0, 0, 362, -2, 0, 0, 334, 334, 0, 334,
+	334, 334, 0, 0, 0, 396, -2, 393, -2, 405,
+	0, 0, 0, 403, 378, 0, 0, -2, 0, 0,
+	0, 0, 0, 0, 0, 91, 394, 0, 94, 95,
+	96, 97, 98, 181, 0, 107, 108, 109, 111, 112,
+	0, 0, 0, 0, 115, 1046, 1129, 1156, 120, 125,
+	0, 1228, 944, -2, 130, 87, 902, 903, 904, 919,
 	920, 921, 922, 923, 924, 925, 926, 927, 928, 929,
 	930, 931, 932, 933, 934, 935, 936, 937, 938, 939,
-	940, 941, 943, 944, 945, 946, 947, 948, 949, 950,
+	940, 941, 942, 943, 945, 946, 947, 948, 949, 950,
 	951, 952, 953, 954, 955, 956, 957, 958, 959, 960,
 	961, 962, 963, 964, 965, 966, 967, 968, 969, 970,
 	971, 972, 973, 974, 975, 976, 977, 978, 979, 980,
@@ -5534,218 +5804,218 @@ var sqlDef = [...]int{
 	1011, 1012, 1013, 1014, 1015, 1016, 1017, 1018, 1019, 1020,
 	1021, 1022, 1023, 1024, 1025, 1026, 1027, 1028, 1029, 1030,
 	1031, 1032, 1033, 1034, 1035, 1036, 1037, 1038, 1039, 1040,
-	1041, 1042, 1043, 1045, 1046, 1047, 1048, 1049, 1050, 1051,
-	1052, 1053, 1054, 1055, 1056, 1057, 1058, 1060, 1061, 1062,
+	1041, 1042, 1043, 1044, 1045, 1047, 1048, 1049, 1050, 1051,
+	1052, 1053, 1054, 1055, 1056, 1057, 1058, 1059, 1060, 1062,
 	1063, 1064, 1065, 1066, 1067, 1068, 1069, 1070, 1071, 1072,
 	1073, 1074, 1075, 1076, 1077, 1078, 1079, 1080, 1081, 1082,
 	1083, 1084, 1085, 1086, 1087, 1088, 1089, 1090, 1091, 1092,
 	1093, 1094, 1095, 1096, 1097, 1098, 1099, 1100, 1101, 1102,
 	1103, 1104, 1105, 1106, 1107, 1108, 1109, 1110, 1111, 1112,
 	1113, 1114, 1115, 1116, 1117, 1118, 1119, 1120, 1121, 1122,
-	1123, 1124, 1125, 1126, 1128, 1129, 1130, 1131, 1132, 1133,
+	1123, 1124, 1125, 1126, 1127, 1128, 1130, 1131, 1132, 1133,
 	1134, 1135, 1136, 1137, 1138, 1139, 1140, 1141, 1142, 1143,
 	1144, 1145, 1146, 1147, 1148, 1149, 1150, 1151, 1152, 1153,
-	1155, 1156, 1157, 1158, 1159, 1160, 1161, 1162, 1163, 1164,
+	1154, 1155, 1157, 1158, 1159, 1160, 1161, 1162, 1163, 1164,
 	1165, 1166, 1167, 1168, 1169, 1170, 1171, 1172, 1173, 1174,
 	1175, 1176, 1177, 1178, 1179, 1180, 1181, 1182, 1183, 1184,
 	1185, 1186, 1187, 1188, 1189, 1190, 1191, 1192, 1193, 1194,
 	1195, 1196, 1197, 1198, 1199, 1200, 1201, 1202, 1203, 1204,
 	1205, 1206, 1207, 1208, 1209, 1210, 1211, 1212, 1213, 1214,
-	1215, 1216, 1217, 1218, 1219, 1220, 1221, 1223, 1224, 1225,
-	1226, 1227, 0, 162, 171, 0, 169, 0, 167, 327,
-	341, 342, 353, 353, 330, 0, 331, 332, 0, 333,
-	942, 1044, 1059, 1127, 1154, 1222, 1117, 335, 0, 0,
-	411, 0, 0, 0, 178, 179, 0, 0, 184, 287,
-	-2, 390, 391, -2, 0, 415, 415, 415, 0, 0,
-	391, 0, 399, 1097, 402, 884, 400, 870, 0, 418,
-	416, 0, 395, 509, 0, 879, 471, 0, 2, 0,
-	0, 0, 515, 1017, 60, 86, 81, 1017, 83, 1017,
-	93, 0, 100, 106, 103, 1226, 910, 911, 912, 913,
-	1228, 1229, 1230, 1231, 1232, 1233, 1234, 1235, 1236, 1237,
+	1215, 1216, 1217, 1218, 1219, 1220, 1221, 1222, 1223, 1224,
+	1225, 1226, 1227, 1229, 1230, 1231, 1232, 1233, 0, 158,
+	167, 0, 165, 0, 163, 318, 332, 333, 337, 337,
+	321, 0, 322, 323, 0, 324, 944, 1046, 1061, 1129,
+	1156, 1228, 1119, 326, 0, 0, 395, 0, 0, 0,
+	174, 175, 0, 0, 180, 280, -2, 374, 375, -2,
+	0, 399, 399, 399, 0, 0, 375, 0, 383, 1099,
+	386, 884, 384, 870, 0, 402, 400, 0, 379, 493,
+	0, 879, 455, 0, 2, 0, 0, 0, 0, 499,
+	60, 1019, 85, 81, 1019, 83, 1019, 92, 0, 99,
+	101, 910, 911, 912, 913, 1232, 1234, 1235, 1236, 1237,
 	1238, 1239, 1240, 1241, 1242, 1243, 1244, 1245, 1246, 1247,
-	0, 0, 0, 23, 0, 1017, 0, 1017, 0, 121,
-	1127, 122, 944, 123, 349, 0, 0, 347, 0, 0,
-	0, 0, 0, 133, 134, 155, 156, 89, 0, 0,
-	173, 0, 0, 165, 0, 166, 328, 352, 329, 339,
-	0, 340, 334, 338, 284, 513, 0, 290, 354, 1017,
-	180, 181, 182, 183, -2, 0, 432, 458, 455, 0,
-	429, 430, 459, 0, 0, 0, 470, 0, 413, 414,
-	0, 0, 0, 380, 381, 0, 401, 0, 0, 474,
-	869, 871, 875, 876, 613, 0, 0, 0, 0, 0,
-	0, 0, 699, 700, 860, 0, 703, 704, 705, 1198,
-	0, 710, 711, 1202, 1218, 879, 887, 888, 889, 890,
-	891, 0, 0, 0, 896, 897, 898, 853, 755, 721,
-	-2, -2, 885, 549, 550, 551, 552, -2, 1230, 725,
-	726, 728, 730, 732, 734, 735, 736, 737, 738, -2,
-	0, 1199, 1214, 1215, 1221, 1224, 1225, 1211, 1194, 1201,
-	1207, 985, -2, -2, -2, -2, -2, -2, -2, -2,
-	-2, -2, -2, -2, -2, -2, -2, -2, -2, 909,
-	571, 572, 577, 578, 587, 474, 0, 472, 510, 511,
-	0, 880, 858, 0, 0, 0, 866, 864, 865, 528,
-	0, 357, 0, 516, 0, 0, 80, 0, 58, 59,
-	0, 0, 410, 0, 102, 104, 105, 144, 145, 146,
-	147, 273, 0, 275, 158, 159, 899, 0, 0, 0,
-	109, 0, 969, 877, 115, 24, 0, 0, 25, 0,
-	29, 0, 324, 324, 0, 0, 0, 0, 0, 0,
-	0, 0, 27, 324, 0, 0, 0, 0, 0, 351,
-	0, 345, 346, 348, 126, 128, 136, 138, 139, 127,
-	129, 131, 132, 148, 149, 0, 152, 153, 154, 593,
-	0, 90, 914, 915, 916, 917, 918, 1248, 1249, 1250,
-	1251, 1252, 1253, 1254, 1255, 1256, 1257, 1258, 1259, 1260,
-	1261, 1262, 1263, 1264, 1265, 1266, 1267, 1268, 1269, 1270,
-	1271, 1272, 1273, 1274, 1275, 1276, 1277, 1278, 1279, 1280,
-	1281, 1282, 1283, 1284, 1285, 1286, 1287, 1288, 1289, 1290,
-	1291, 1292, 1293, 1294, 1295, 1296, 1297, 1298, 1299, 1300,
-	1301, 1302, 1303, 1304, 1305, 1306, 1307, 1308, 1309, 1310,
-	1311, 1312, 1313, 1314, 1315, 1316, 1317, 1318, 1319, 1320,
-	1321, 1322, 1323, 1324, 161, 163, 0, 170, 164, 168,
-	1117, 337, 60, 0, 0, 0, 236, 1017, 0, 906,
-	289, 0, 432, 458, 384, 431, 385, 457, 460, 0,
-	0, 465, 0, 467, 0, 427, 428, 433, 437, 438,
-	441, 444, 445, 435, 613, 464, 0, 396, 397, 398,
-	422, 423, 305, 403, 0, 0, 881, 528, 0, 0,
-	0, 0, 0, 790, 791, 792, 793, 794, 797, 795,
-	796, 798, 799, 800, 801, 802, 803, 804, 805, 0,
-	0, 807, 0, 0, 0, 863, 0, 0, 0, 874,
-	806, 617, 618, 619, 638, 639, 0, 669, 701, -2,
-	809, 0, 706, 707, 708, 709, 0, 0, 0, -2,
-	0, 893, 610, 0, 0, 852, 757, 0, 0, 0,
-	0, 0, 0, 0, 819, 0, 832, 838, 0, 0,
-	0, 0, 0, 0, 562, 574, 584, 582, 581, 564,
-	0, 563, 561, 0, 565, 0, 592, 0, 0, 0,
-	590, 0, 580, 0, 528, 0, 0, 859, 854, 855,
-	0, 0, 868, 369, 0, 364, 359, 0, 0, 0,
-	474, 371, 373, 374, 0, 0, 517, 60, 87, 82,
-	84, 94, 101, 274, 901, 900, 1017, 236, 0, 0,
-	110, 111, 0, 160, 0, 0, 31, 0, 43, 0,
-	0, 224, 0, 0, 1024, 0, -2, 0, 0, 0,
-	323, 0, 0, 0, 48, 49, 54, 0, 50, 0,
-	52, 53, 55, 0, 0, 0, 0, 0, 0, 0,
-	350, 344, 0, 0, 143, 0, 610, 0, 91, 172,
-	118, 336, 281, 514, 282, 283, 187, 0, 261, 0,
-	0, 0, 1017, 905, 0, 388, 389, 456, 0, 468,
-	0, 439, 0, 0, 436, 442, 443, 461, 462, 0,
-	469, 0, 0, 308, 303, 304, 410, 0, 883, 447,
-	473, 475, 495, 500, 0, 495, 482, 0, 526, 1115,
-	722, 723, 0, 872, 614, 540, 0, 541, 542, 543,
-	544, 610, 547, 548, 556, 557, 558, 559, 560, 568,
-	0, 555, 555, 555, 566, 569, 570, 575, 576, 588,
-	596, 596, 585, 585, 585, 583, 615, 0, 620, 621,
-	622, 623, 624, 625, 626, 627, 628, -2, -2, -2,
-	632, -2, -2, -2, 636, 637, -2, 808, 0, 863,
-	0, 0, 648, 0, 651, 653, 655, 0, 0, 670,
-	0, 0, 862, 665, 844, 0, 0, 787, 788, 789,
-	873, 650, 0, 0, 861, 860, 0, 0, 0, 815,
-	809, 816, 0, 0, -2, 713, 421, 0, 0, 0,
-	0, 894, 597, 598, 599, 600, 601, 602, 611, 0,
-	851, 847, 0, 765, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 820, 821, 822, 823, 824, 825,
-	826, 827, 0, 0, 0, 0, 672, 0, 0, 0,
-	0, 1218, 0, 809, 837, 0, 0, 0, 0, 0,
-	809, 0, 843, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 447, 0, 512, 856,
-	0, 867, 78, 0, 527, 369, 0, 0, 361, 358,
-	528, 0, 0, 0, 79, 0, 0, 107, 878, 314,
-	30, 317, 32, 202, 0, 0, 0, 227, 0, 0,
-	0, 0, 0, 0, 326, 44, 1017, 60, 1017, 60,
-	45, 0, 68, 71, 51, 26, 0, 315, 0, 0,
-	28, 324, 108, 124, 140, 141, 142, 137, 150, 0,
-	0, 0, 0, 186, 881, 190, 194, 195, 196, 0,
-	189, 265, 0, 0, 272, 0, 0, 355, 466, 0,
-	0, 463, 424, 308, 790, 791, 792, 793, 794, 795,
-	796, 797, 798, 799, 800, 801, 802, 803, 804, 805,
-	426, 0, 0, 405, 406, 407, 408, 882, 454, 0,
-	0, 0, 0, 0, 0, 506, 506, 506, 504, 477,
-	494, 0, 493, 478, 496, 0, 493, 500, 495, 0,
-	480, -2, 482, 0, 518, 0, 0, 0, 532, 536,
-	540, 545, 0, 0, 0, -2, 0, 0, 0, 666,
-	-2, 649, 652, 654, 656, 0, 0, 671, 0, 0,
-	0, 0, 0, 667, 0, 0, 0, 702, 810, 0,
-	813, 814, 0, 712, -2, 0, 0, 420, 421, 421,
-	421, 719, 0, 0, 0, 0, 0, 0, 0, 848,
-	0, 0, 720, 0, 0, 0, 0, 727, 729, 731,
-	733, 0, 741, 0, 742, 0, 0, 743, 0, 0,
+	1248, 1249, 1250, 1251, 1252, 1253, 1254, 0, 0, 0,
+	23, 0, 1019, 0, 1019, 0, 116, 1129, 117, 946,
+	118, 0, 0, 0, 0, 0, 128, 129, 151, 152,
+	88, 0, 0, 169, 0, 0, 161, 0, 162, 319,
+	336, 320, 330, 0, 331, 325, 329, 277, 497, 0,
+	283, 338, 1019, 176, 177, 178, 179, -2, 0, 416,
+	442, 439, 0, 413, 414, 443, 0, 0, 0, 454,
+	0, 397, 398, 0, 0, 0, 364, 365, 0, 385,
+	0, 0, 458, 869, 871, 875, 876, 602, 0, 0,
+	0, 0, 0, 0, 0, 696, 697, 860, 0, 700,
+	701, 702, 1202, 0, 707, 708, 1206, 1222, 879, 887,
+	888, 889, 890, 891, 0, 0, 0, 896, 897, 898,
+	853, 752, 718, -2, -2, 885, 538, 539, 540, 541,
+	-2, 1236, 722, 723, 725, 727, 729, 731, 732, 733,
+	734, 735, -2, 0, 1203, 1218, 1219, 1227, 1230, 1231,
+	1215, 1198, 1205, 1211, 987, -2, -2, -2, -2, -2,
+	-2, -2, -2, -2, -2, -2, -2, -2, -2, -2,
+	-2, -2, 909, 560, 561, 566, 567, 576, 458, 0,
+	456, 494, 495, 0, 880, 858, 0, 0, 0, 866,
+	864, 865, 512, 0, 342, 0, 0, 500, 0, 79,
+	0, 58, 59, 0, 0, 0, 394, 0, 0, 0,
+	104, 0, 971, 877, 110, 24, 0, 0, 25, 0,
+	29, 0, 315, 315, 0, 0, 0, 0, 0, 0,
+	0, 0, 27, 315, 0, 0, 0, 0, 0, 121,
+	123, 131, 133, 134, 140, 141, 142, 143, 266, 0,
+	268, 154, 155, 899, 0, 122, 124, 126, 127, 144,
+	145, 0, 148, 149, 150, 582, 0, 89, 914, 915,
+	916, 917, 918, 1255, 1256, 1257, 1258, 1259, 1260, 1261,
+	1262, 1263, 1264, 1265, 1266, 1267, 1268, 1269, 1270, 1271,
+	1272, 1273, 1274, 1275, 1276, 1277, 1278, 1279, 1280, 1281,
+	1282, 1283, 1284, 1285, 1286, 1287, 1288, 1289, 1290, 1291,
+	1292, 1293, 1294, 1295, 1296, 1297, 1298, 1299, 1300, 1301,
+	1302, 1303, 1304, 1305, 1306, 1307, 1308, 1309, 1310, 1311,
+	1312, 1313, 1314, 1315, 1316, 1317, 1318, 1319, 1320, 1321,
+	1322, 1323, 1324, 1325, 1326, 1327, 1328, 1329, 1330, 1331,
+	157, 159, 0, 166, 160, 164, 1119, 328, 60, 0,
+	0, 0, 232, 1019, 0, 906, 282, 0, 416, 442,
+	368, 415, 369, 441, 444, 0, 0, 449, 0, 451,
+	0, 411, 412, 417, 421, 422, 425, 428, 429, 419,
+	602, 448, 0, 380, 381, 382, 406, 407, 296, 387,
+	0, 0, 881, 512, 0, 0, 0, 0, 0, 787,
+	788, 789, 790, 791, 794, 792, 793, 795, 796, 797,
+	798, 799, 800, 801, 802, 0, 0, 804, 0, 806,
+	0, 0, 0, 0, 0, 0, 863, 0, 0, 0,
+	874, 803, 606, 607, 608, 627, 628, 0, 666, 698,
+	-2, 808, 0, 703, 704, 705, 706, 0, 0, 0,
+	-2, 0, 893, 599, 0, 0, 852, 754, 0, 0,
+	0, 0, 0, 0, 0, 819, 0, 832, 838, 0,
+	0, 0, 0, 0, 0, 551, 563, 573, 571, 570,
+	553, 0, 552, 550, 0, 554, 0, 581, 0, 0,
+	0, 579, 0, 569, 0, 512, 0, 0, 859, 854,
+	855, 0, 0, 868, 353, 0, 349, 344, 0, 0,
+	0, 353, 458, 355, 357, 358, 0, 0, 501, 86,
+	60, 82, 84, 93, 100, 1019, 232, 0, 0, 105,
+	106, 0, 156, 0, 0, 31, 0, 43, 0, 0,
+	220, 0, 0, 1026, 0, -2, 0, 0, 0, 314,
+	0, 0, 0, 48, 49, 54, 0, 50, 0, 52,
+	53, 55, 0, 0, 0, 0, 0, 0, 0, 335,
+	0, 0, 138, 139, 0, 267, 901, 900, 599, 0,
+	90, 168, 113, 327, 274, 498, 275, 276, 183, 0,
+	257, 0, 0, 0, 1019, 905, 0, 372, 373, 440,
+	0, 452, 0, 423, 0, 0, 420, 426, 427, 445,
+	446, 0, 453, 0, 0, 299, 294, 295, 394, 0,
+	883, 431, 457, 459, 479, 484, 0, 479, 466, 0,
+	510, 1117, 719, 720, 0, 872, 603, 524, 0, 525,
+	526, 527, 528, 599, 531, 532, 533, 534, 535, 536,
+	537, 545, 546, 547, 548, 549, 557, 0, 544, 544,
+	544, 555, 558, 559, 564, 565, 577, 585, 585, 574,
+	574, 574, 572, 604, 0, 609, 610, 611, 612, 613,
+	614, 615, 616, 617, -2, -2, -2, 621, -2, -2,
+	-2, 625, 626, -2, 805, 807, 0, 863, 0, -2,
+	0, 641, 642, 643, 644, 645, 0, 648, 650, 652,
+	0, 0, 667, 0, 0, 862, 662, 844, 0, 0,
+	784, 785, 786, 873, 647, 0, 0, 861, 860, 0,
+	0, 0, 814, 808, 815, 0, 0, -2, 710, 405,
+	0, 0, 0, 0, 894, 586, 587, 588, 589, 590,
+	591, 600, 0, 851, 847, 0, 762, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 818, -2, -2,
+	-2, -2, -2, -2, -2, -2, 0, 0, 0, 0,
+	669, 0, 0, 0, 0, 1222, 0, 808, 837, 0,
+	0, 0, 0, 0, 808, 0, 843, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	431, 0, 496, 856, 0, 867, 78, 0, 511, 353,
+	0, 0, 346, 343, 341, 512, 0, 0, 0, 80,
+	0, 0, 102, 878, 305, 30, 308, 32, 198, 0,
+	0, 0, 223, 0, 0, 0, 0, 0, 0, 317,
+	44, 1019, 60, 1019, 60, 45, 0, 68, 71, 51,
+	26, 0, 306, 0, 0, 28, 315, 103, 119, 135,
+	136, 137, 132, 146, 0, 0, 0, 0, 182, 881,
+	186, 190, 191, 192, 0, 185, 261, 0, 0, 263,
+	0, 0, 339, 450, 0, 0, 447, 408, 299, 787,
+	788, 789, 790, 791, 792, 793, 794, 795, 796, 797,
+	798, 799, 800, 801, 802, 410, 0, 0, 389, 390,
+	391, 392, 882, 438, 0, 0, 0, 0, 0, 0,
+	490, 490, 490, 488, 461, 478, 0, 477, 462, 480,
+	0, 477, 484, 479, 0, 464, -2, 466, 0, 502,
+	0, 0, 0, 516, 520, 524, 529, 0, 0, 0,
+	-2, -2, 0, 0, 0, 663, 0, -2, 646, 649,
+	651, 653, 0, 0, 668, 0, 0, 0, 0, 0,
+	664, 0, 0, 0, 699, 809, 0, 812, 813, 0,
+	709, -2, 0, 0, 404, 405, 405, 405, 716, 0,
+	0, 0, 0, 0, 0, 0, 848, 0, 0, 717,
+	0, 0, 0, 0, 724, 726, 728, 730, 0, 738,
+	0, 739, 0, 0, 740, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 674, 675, 676,
-	0, 0, 744, 835, 836, 0, 0, 0, 0, 0,
-	0, 749, 0, 842, 0, 751, 752, 753, 0, 553,
-	0, 567, 596, 594, 595, 596, 587, 586, 454, 417,
-	0, 368, 356, 367, 0, 369, 372, 375, 0, 0,
-	199, 223, 0, 292, 0, 0, 0, 0, 0, 230,
-	0, 291, 0, 33, 0, 0, 0, 0, 0, 41,
-	0, 47, 65, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 119, 259, 235, 0, 216, 261, 0,
-	269, 0, 260, 236, 0, 267, 0, 292, 0, 434,
-	440, 425, 306, 307, 404, 759, 0, 0, 476, 0,
-	0, 0, 0, 0, 501, 505, 502, 503, 491, 0,
-	491, 0, 0, 479, 481, 886, 484, 525, 0, 421,
-	0, 0, 533, 537, 0, 616, -2, 0, -2, 0,
-	0, 0, 0, 0, -2, 0, 811, 0, 0, 845,
-	0, 0, 782, 0, -2, 810, 817, 714, 0, 0,
-	0, 0, 603, 604, 605, 606, 607, 608, 609, 0,
-	895, 846, 850, 0, 763, 764, 768, 0, 0, 724,
-	0, 818, 829, 830, 673, 677, 678, 679, 680, 681,
-	682, 683, 684, 685, -2, -2, -2, 689, -2, -2,
-	-2, 0, 0, 0, 697, 831, 0, 0, 785, 833,
-	834, 839, 840, 0, 746, 747, 748, 841, 0, 573,
-	0, 591, 589, 579, 759, 857, 0, 0, 0, 360,
-	370, 0, 201, 0, 204, 0, 0, 207, 208, 0,
-	0, 0, 0, 225, 226, 266, 228, 0, 0, 241,
-	0, 299, 299, 0, 0, 0, 57, 0, 0, 37,
-	0, 0, 325, 38, 0, 60, 60, 69, 70, 276,
-	277, 278, 279, 280, 309, 0, 0, 790, 791, 907,
-	908, -2, 73, 318, 319, 321, 316, 0, 0, 151,
-	261, 0, 191, 213, 265, 0, 192, 197, 198, 0,
-	0, 187, 0, 0, 270, 0, 0, 0, 392, 0,
-	453, 446, 448, 450, 451, 0, 485, 0, 487, 0,
-	0, 0, 489, 0, 0, 0, 0, 529, 0, 0,
-	881, 483, 0, 521, 524, 0, 538, 0, 0, 0,
-	546, -2, 0, 0, 0, -2, -2, 0, 659, 0,
-	-2, -2, 668, 781, 810, 892, 421, 715, 717, 718,
-	612, 849, 770, 767, 0, 754, 740, 828, 0, 0,
-	0, 698, 0, 810, 784, 745, 750, 554, 393, 0,
-	0, 293, 0, 376, 377, 0, 0, 205, 206, 209,
-	0, 211, 236, 229, 245, 0, 0, 302, 0, 302,
-	0, 0, 34, 35, 36, 39, 56, 62, 40, 46,
-	0, 0, 0, 0, 0, 265, 0, 0, 0, 176,
-	188, 0, 0, 262, 0, 0, 0, 189, 272, 271,
-	0, 0, 758, 760, 0, 0, 452, 486, 0, 508,
-	488, 0, 492, 0, 497, 0, 62, 499, 526, 0,
-	520, 0, 714, 539, 534, 0, -2, -2, -2, 660,
-	812, 783, 0, 421, 0, 756, -2, 0, 0, 0,
-	786, 0, 363, 0, 528, 366, 203, 0, 240, 231,
-	0, 242, 243, 312, 0, 305, 300, 0, 298, 305,
-	299, 0, 64, 0, 0, 0, 72, 320, 322, 174,
-	0, 214, 217, 218, 219, 220, 221, 222, 215, 193,
-	202, 263, 264, 259, 261, 268, 0, 292, 0, 0,
-	449, 0, 490, 498, 530, 531, 519, 522, 0, 535,
-	716, 773, 0, -2, 0, 695, 0, 294, 365, 234,
-	250, 0, 0, 0, 308, 301, 308, 302, 236, 42,
-	0, 61, 310, 0, 258, 200, 261, 265, 67, 0,
-	761, 762, 507, 0, 0, 0, 0, 769, 696, 528,
-	210, 0, 212, 246, 247, 0, 237, 238, 239, 0,
-	313, 295, 296, 305, 240, 63, 311, 265, 177, 528,
-	0, 0, 523, 766, 771, 774, -2, 1161, 965, 0,
-	772, 362, 233, 248, 0, 249, 0, 0, 0, 244,
-	308, 250, 175, 285, 66, 0, 0, 776, 777, 778,
-	779, 780, 251, 0, 254, 255, 0, 252, 297, 232,
-	67, 0, 253, 256, 257, 528, 775, 286,
+	0, 0, 0, 0, 671, 672, 673, 0, 0, 741,
+	835, 836, 0, 0, 0, 0, 0, 0, 746, 0,
+	842, 0, 748, 749, 750, 0, 542, 0, 556, 585,
+	583, 584, 585, 576, 575, 438, 401, 0, 352, 340,
+	351, 0, 353, 356, 359, 0, 0, 195, 219, 0,
+	285, 0, 0, 0, 0, 0, 226, 0, 284, 0,
+	33, 0, 0, 0, 0, 0, 41, 0, 47, 65,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	114, 255, 231, 0, 212, 257, 0, 265, 0, 256,
+	232, 0, 285, 0, 418, 424, 409, 297, 298, 388,
+	756, 0, 0, 460, 0, 0, 0, 0, 0, 485,
+	489, 486, 487, 475, 0, 475, 0, 0, 463, 465,
+	886, 468, 509, 0, 405, 0, 0, 517, 521, 0,
+	605, -2, 0, 0, -2, 0, 0, -2, 0, 0,
+	0, -2, 0, 810, 0, 0, 845, 0, 0, 779,
+	0, -2, 809, 816, 711, 0, 0, 0, 0, 592,
+	593, 594, 595, 596, 597, 598, 0, 895, 846, 850,
+	0, 760, 761, 765, 0, 0, 721, 0, 817, 829,
+	830, 670, 674, 675, 676, 677, 678, 679, 680, 681,
+	682, -2, -2, -2, 686, -2, -2, -2, 0, 0,
+	0, 694, 831, 0, 0, 782, 833, 834, 839, 840,
+	0, 743, 744, 745, 841, 0, 562, 0, 580, 578,
+	568, 756, 857, 0, 0, 345, 354, 0, 197, 0,
+	200, 0, 0, 203, 204, 0, 0, 0, 0, 221,
+	222, 262, 224, 0, 0, 237, 0, 290, 0, 0,
+	57, 0, 0, 37, 0, 0, 316, 38, 0, 60,
+	60, 69, 70, 269, 270, 271, 272, 273, 300, 0,
+	0, 787, 788, 907, 908, -2, -2, -2, 73, 309,
+	310, 312, 307, 0, 0, 147, 257, 0, 187, 209,
+	261, 0, 188, 193, 194, 0, 0, 183, 0, 0,
+	0, 0, 376, 0, 437, 430, 432, 434, 435, 0,
+	469, 0, 471, 0, 0, 0, 473, 0, 0, 0,
+	0, 513, 0, 0, 881, 467, 0, 505, 508, 0,
+	522, 0, 0, 0, 530, -2, -2, 0, 0, 0,
+	-2, -2, 0, 656, 0, -2, -2, 665, 778, 809,
+	892, 405, 712, 714, 715, 601, 849, 767, 764, 0,
+	751, 737, 828, 0, 0, 0, 695, 0, 809, 781,
+	742, 747, 543, 377, 0, 0, 286, 360, 361, 0,
+	0, 201, 202, 205, 0, 207, 232, 225, 241, 0,
+	0, 293, 0, 0, 34, 35, 36, 39, 56, 62,
+	40, 46, 0, 0, 0, 0, 0, 261, 0, 0,
+	0, 172, 184, 0, 0, 258, 0, 0, 0, 185,
+	264, 0, 0, 755, 757, 0, 0, 436, 470, 0,
+	492, 472, 0, 476, 0, 481, 0, 62, 483, 510,
+	0, 504, 0, 711, 523, 518, 0, -2, -2, -2,
+	657, 811, 780, 0, 405, 0, 753, -2, 0, 0,
+	0, 783, 0, 348, 0, 350, 199, 0, 236, 227,
+	0, 238, 239, 303, 0, 296, 291, 0, 289, 0,
+	64, 0, 0, 0, 72, 311, 313, 170, 0, 210,
+	213, 214, 215, 216, 217, 218, 211, 189, 198, 259,
+	260, 255, 257, 0, 285, 0, 0, 433, 0, 474,
+	482, 514, 515, 503, 506, 0, 519, 713, 770, 0,
+	-2, 0, 692, 0, 287, 230, 246, 0, 0, 0,
+	299, 292, 232, 42, 0, 61, 301, 0, 254, 196,
+	257, 261, 67, 0, 758, 759, 491, 0, 0, 0,
+	0, 766, 693, 512, 206, 0, 208, 242, 243, 0,
+	233, 234, 235, 0, 304, 288, 236, 63, 302, 261,
+	173, 278, 0, 0, 507, 763, 768, 771, -2, 1163,
+	967, 0, 769, 347, 229, 244, 0, 245, 0, 0,
+	0, 240, 246, 171, 66, 0, 0, 773, 774, 775,
+	776, 777, 247, 0, 250, 251, 0, 248, 228, 67,
+	0, 249, 252, 253, 279, 772,
 }
 var sqlTok1 = [...]int{
 
 	1, 3, 3, 3, 3, 3, 3, 3, 3, 3,
 	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
 	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
-	3, 3, 3, 3, 3, 440, 3, 436, 437, 3,
-	445, 446, 434, 432, 449, 433, 447, 435, 3, 3,
-	3, 3, 3, 3, 3, 3, 3, 3, 450, 448,
-	428, 430, 429, 3, 3, 3, 3, 3, 3, 3,
+	3, 3, 3, 3, 3, 450, 3, 446, 447, 3,
+	455, 456, 444, 442, 459, 443, 457, 445, 3, 3,
+	3, 3, 3, 3, 3, 3, 3, 3, 460, 458,
+	438, 440, 439, 3, 3, 3, 3, 3, 3, 3,
 	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
 	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
-	3, 443, 3, 444, 439, 3, 3, 3, 3, 3,
+	3, 453, 3, 454, 449, 3, 3, 3, 3, 3,
 	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
 	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
-	3, 3, 3, 3, 438, 3, 442,
+	3, 3, 3, 3, 448, 3, 452,
 }
 var sqlTok2 = [...]int{
 
@@ -5811,7 +6081,9 @@ var sqlTok3 = [...]int{
 	57755, 413, 57756, 414, 57757, 415, 57758, 416, 57759, 417,
 	57760, 418, 57761, 419, 57762, 420, 57763, 421, 57764, 422,
 	57765, 423, 57766, 424, 57767, 425, 57768, 426, 57769, 427,
-	57770, 431, 57771, 441, 0,
+	57770, 428, 57771, 429, 57772, 430, 57773, 431, 57774, 432,
+	57775, 433, 57776, 434, 57777, 435, 57778, 436, 57779, 437,
+	57780, 441, 57781, 451, 0,
 }
 
 var sqlErrorMessages = [...]struct {
@@ -6155,13 +6427,13 @@ sqldefault:
 
 	case 1:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:469
+		//line sql.y:489
 		{
 			sqllex.(*scanner).stmts = sqlDollar[1].stmts
 		}
 	case 2:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:475
+		//line sql.y:495
 		{
 			if sqlDollar[3].stmt != nil {
 				sqlVAL.stmts = append(sqlDollar[1].stmts, sqlDollar[3].stmt)
@@ -6169,7 +6441,7 @@ sqldefault:
 		}
 	case 3:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:481
+		//line sql.y:501
 		{
 			if sqlDollar[1].stmt != nil {
 				sqlVAL.stmts = []Statement{sqlDollar[1].stmt}
@@ -6179,1869 +6451,1887 @@ sqldefault:
 		}
 	case 19:
 		sqlDollar = sqlS[sqlpt-0 : sqlpt+1]
-		//line sql.y:506
+		//line sql.y:526
 		{
 			sqlVAL.stmt = nil
 		}
 	case 23:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:519
+		//line sql.y:539
 		{
 			sqlVAL.stmt = nil
 		}
 	case 24:
 		sqlDollar = sqlS[sqlpt-4 : sqlpt+1]
-		//line sql.y:523
+		//line sql.y:543
 		{
 			sqlVAL.stmt = nil
 		}
 	case 25:
 		sqlDollar = sqlS[sqlpt-4 : sqlpt+1]
-		//line sql.y:529
+		//line sql.y:549
 		{
 			sqlVAL.stmt = nil
 		}
 	case 26:
 		sqlDollar = sqlS[sqlpt-6 : sqlpt+1]
-		//line sql.y:533
+		//line sql.y:553
 		{
 			sqlVAL.stmt = nil
 		}
 	case 27:
 		sqlDollar = sqlS[sqlpt-4 : sqlpt+1]
-		//line sql.y:539
+		//line sql.y:559
 		{
-			sqlVAL.stmt = nil
+			sqlVAL.stmt = &AlterTable{Table: sqlDollar[3].qname, IfExists: false, Cmds: sqlDollar[4].alterTableCmds}
 		}
 	case 28:
 		sqlDollar = sqlS[sqlpt-6 : sqlpt+1]
-		//line sql.y:543
+		//line sql.y:563
 		{
-			sqlVAL.stmt = nil
+			sqlVAL.stmt = &AlterTable{Table: sqlDollar[5].qname, IfExists: true, Cmds: sqlDollar[6].alterTableCmds}
+		}
+	case 29:
+		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
+		//line sql.y:595
+		{
+			sqlVAL.alterTableCmds = AlterTableCmds{sqlDollar[1].alterTableCmd}
+		}
+	case 30:
+		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
+		//line sql.y:599
+		{
+			sqlVAL.alterTableCmds = append(sqlDollar[1].alterTableCmds, sqlDollar[3].alterTableCmd)
 		}
 	case 31:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:579
+		//line sql.y:606
 		{
+			sqlVAL.alterTableCmd = &AlterTableAddColumn{ColumnKeyword: false, ColumnDef: sqlDollar[2].tblDef.(*ColumnTableDef)}
 		}
 	case 32:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:581
+		//line sql.y:611
 		{
+			sqlVAL.alterTableCmd = &AlterTableAddColumn{ColumnKeyword: true, ColumnDef: sqlDollar[3].tblDef.(*ColumnTableDef)}
 		}
 	case 33:
 		sqlDollar = sqlS[sqlpt-4 : sqlpt+1]
-		//line sql.y:583
+		//line sql.y:615
 		{
 		}
 	case 34:
 		sqlDollar = sqlS[sqlpt-6 : sqlpt+1]
-		//line sql.y:585
+		//line sql.y:617
 		{
 		}
 	case 35:
 		sqlDollar = sqlS[sqlpt-6 : sqlpt+1]
-		//line sql.y:587
+		//line sql.y:619
 		{
 		}
 	case 36:
 		sqlDollar = sqlS[sqlpt-6 : sqlpt+1]
-		//line sql.y:589
+		//line sql.y:621
 		{
 		}
 	case 37:
 		sqlDollar = sqlS[sqlpt-5 : sqlpt+1]
-		//line sql.y:591
+		//line sql.y:623
 		{
 		}
 	case 38:
 		sqlDollar = sqlS[sqlpt-5 : sqlpt+1]
-		//line sql.y:593
+		//line sql.y:625
 		{
 		}
 	case 39:
 		sqlDollar = sqlS[sqlpt-6 : sqlpt+1]
-		//line sql.y:595
+		//line sql.y:627
 		{
 		}
 	case 40:
 		sqlDollar = sqlS[sqlpt-6 : sqlpt+1]
-		//line sql.y:597
+		//line sql.y:630
 		{
+			sqlVAL.alterTableCmd = &AlterTableDropColumn{ColumnKeyword: sqlDollar[2].boolVal, IfExists: true, Column: Name(sqlDollar[5].str)}
 		}
 	case 41:
 		sqlDollar = sqlS[sqlpt-4 : sqlpt+1]
-		//line sql.y:599
+		//line sql.y:635
 		{
+			sqlVAL.alterTableCmd = &AlterTableDropColumn{ColumnKeyword: sqlDollar[2].boolVal, IfExists: false, Column: Name(sqlDollar[3].str)}
 		}
 	case 42:
 		sqlDollar = sqlS[sqlpt-8 : sqlpt+1]
-		//line sql.y:602
+		//line sql.y:640
 		{
 		}
 	case 43:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:604
+		//line sql.y:642
 		{
 		}
 	case 44:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:606
+		//line sql.y:644
 		{
 		}
 	case 45:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:608
+		//line sql.y:646
 		{
 		}
 	case 46:
 		sqlDollar = sqlS[sqlpt-6 : sqlpt+1]
-		//line sql.y:610
+		//line sql.y:648
 		{
 		}
 	case 47:
 		sqlDollar = sqlS[sqlpt-4 : sqlpt+1]
-		//line sql.y:612
+		//line sql.y:650
 		{
 		}
 	case 48:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:614
+		//line sql.y:652
 		{
 		}
 	case 49:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:616
+		//line sql.y:654
 		{
 		}
 	case 50:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:618
+		//line sql.y:656
 		{
 		}
 	case 51:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:620
+		//line sql.y:658
 		{
 		}
 	case 52:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:622
+		//line sql.y:660
 		{
 		}
 	case 53:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:624
+		//line sql.y:662
 		{
 		}
 	case 54:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:626
+		//line sql.y:664
 		{
 		}
 	case 55:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:628
+		//line sql.y:666
 		{
 		}
 	case 56:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:631
+		//line sql.y:669
 		{
 		}
 	case 57:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:632
+		//line sql.y:670
 		{
 		}
 	case 58:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:635
+		//line sql.y:673
 		{
 		}
 	case 59:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:636
+		//line sql.y:674
 		{
 		}
 	case 60:
 		sqlDollar = sqlS[sqlpt-0 : sqlpt+1]
-		//line sql.y:637
+		//line sql.y:675
 		{
 		}
 	case 61:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:640
+		//line sql.y:678
 		{
 		}
 	case 62:
 		sqlDollar = sqlS[sqlpt-0 : sqlpt+1]
-		//line sql.y:641
+		//line sql.y:679
 		{
 		}
 	case 63:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:644
+		//line sql.y:682
 		{
 		}
 	case 64:
 		sqlDollar = sqlS[sqlpt-0 : sqlpt+1]
-		//line sql.y:645
+		//line sql.y:683
 		{
 		}
 	case 65:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:649
+		//line sql.y:687
 		{
 			sqlVAL.empty = sqlDollar[2].empty
 		}
 	case 66:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:654
+		//line sql.y:692
 		{
 		}
 	case 67:
 		sqlDollar = sqlS[sqlpt-0 : sqlpt+1]
-		//line sql.y:655
+		//line sql.y:693
 		{
 		}
 	case 68:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:658
+		//line sql.y:696
 		{
 		}
 	case 69:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:659
+		//line sql.y:697
 		{
 		}
 	case 70:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:663
+		//line sql.y:701
 		{
 		}
 	case 71:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:664
+		//line sql.y:702
 		{
 		}
 	case 72:
 		sqlDollar = sqlS[sqlpt-5 : sqlpt+1]
-		//line sql.y:665
+		//line sql.y:703
 		{
 		}
 	case 73:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:666
+		//line sql.y:704
 		{
 		}
 	case 78:
 		sqlDollar = sqlS[sqlpt-6 : sqlpt+1]
-		//line sql.y:681
+		//line sql.y:719
 		{
-			sqlVAL.stmt = &Delete{Table: sqlDollar[4].tblExpr, Where: newWhere(astWhere, sqlDollar[5].expr)}
+			sqlVAL.stmt = &Delete{Table: sqlDollar[4].tblExpr, Where: newWhere(astWhere, sqlDollar[5].expr), Returning: sqlDollar[6].selExprs}
 		}
 	case 79:
-		sqlDollar = sqlS[sqlpt-6 : sqlpt+1]
-		//line sql.y:688
+		sqlDollar = sqlS[sqlpt-4 : sqlpt+1]
+		//line sql.y:727
 		{
-			sqlVAL.stmt = nil
+			sqlVAL.stmt = &DropIndex{Names: sqlDollar[3].qnames, IfExists: false}
 		}
 	case 80:
-		sqlDollar = sqlS[sqlpt-4 : sqlpt+1]
-		//line sql.y:692
+		sqlDollar = sqlS[sqlpt-6 : sqlpt+1]
+		//line sql.y:731
 		{
-			sqlVAL.stmt = nil
+			sqlVAL.stmt = &DropIndex{Names: sqlDollar[5].qnames, IfExists: true}
 		}
 	case 81:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:696
+		//line sql.y:735
 		{
 			sqlVAL.stmt = &DropDatabase{Name: Name(sqlDollar[3].str), IfExists: false}
 		}
 	case 82:
 		sqlDollar = sqlS[sqlpt-5 : sqlpt+1]
-		//line sql.y:700
+		//line sql.y:739
 		{
 			sqlVAL.stmt = &DropDatabase{Name: Name(sqlDollar[5].str), IfExists: true}
 		}
 	case 83:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:704
+		//line sql.y:743
 		{
 			sqlVAL.stmt = &DropTable{Names: sqlDollar[3].qnames, IfExists: false}
 		}
 	case 84:
 		sqlDollar = sqlS[sqlpt-5 : sqlpt+1]
-		//line sql.y:708
+		//line sql.y:747
 		{
 			sqlVAL.stmt = &DropTable{Names: sqlDollar[5].qnames, IfExists: true}
 		}
 	case 85:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:713
-		{
-		}
-	case 86:
-		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:719
+		//line sql.y:753
 		{
 			sqlVAL.qnames = QualifiedNames{sqlDollar[1].qname}
 		}
-	case 87:
+	case 86:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:723
+		//line sql.y:757
 		{
 			sqlVAL.qnames = append(sqlDollar[1].qnames, sqlDollar[3].qname)
 		}
-	case 88:
+	case 87:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:729
+		//line sql.y:763
 		{
 			sqlVAL.qname = &QualifiedName{Base: Name(sqlDollar[1].str)}
 		}
-	case 89:
+	case 88:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:733
+		//line sql.y:767
 		{
 			sqlVAL.qname = &QualifiedName{Base: Name(sqlDollar[1].str), Indirect: sqlDollar[2].indirect}
 		}
-	case 90:
+	case 89:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:739
+		//line sql.y:773
 		{
 			sqlVAL.indirect = Indirection{NameIndirection(sqlDollar[2].str)}
 		}
-	case 91:
+	case 90:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:743
+		//line sql.y:777
 		{
 			sqlVAL.indirect = append(sqlDollar[1].indirect, NameIndirection(sqlDollar[3].str))
 		}
-	case 92:
+	case 91:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:751
+		//line sql.y:785
 		{
-			sqlVAL.stmt = nil
+			sqlVAL.stmt = &Explain{Statement: sqlDollar[2].stmt}
 		}
-	case 93:
+	case 92:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:755
+		//line sql.y:789
 		{
-			sqlVAL.stmt = nil
+			sqlVAL.stmt = &Explain{Options: []string{"VERBOSE"}, Statement: sqlDollar[3].stmt}
 		}
-	case 94:
+	case 93:
 		sqlDollar = sqlS[sqlpt-5 : sqlpt+1]
-		//line sql.y:759
-		{
-			sqlVAL.stmt = nil
-		}
-	case 103:
-		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:779
-		{
-		}
-	case 104:
-		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:782
+		//line sql.y:793
 		{
+			sqlVAL.stmt = &Explain{Options: sqlDollar[3].strs, Statement: sqlDollar[5].stmt}
 		}
-	case 105:
+	case 99:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:783
+		//line sql.y:806
 		{
+			sqlVAL.strs = []string{sqlDollar[1].str}
 		}
-	case 106:
-		sqlDollar = sqlS[sqlpt-0 : sqlpt+1]
-		//line sql.y:784
+	case 100:
+		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
+		//line sql.y:810
 		{
+			sqlVAL.strs = append(sqlDollar[1].strs, sqlDollar[3].str)
 		}
-	case 107:
+	case 102:
 		sqlDollar = sqlS[sqlpt-6 : sqlpt+1]
-		//line sql.y:822
+		//line sql.y:859
 		{
 			sqlVAL.stmt = &Grant{Privileges: sqlDollar[2].privilegeList, Grantees: NameList(sqlDollar[6].strs), Targets: sqlDollar[4].targetList}
 		}
-	case 108:
+	case 103:
 		sqlDollar = sqlS[sqlpt-6 : sqlpt+1]
-		//line sql.y:829
+		//line sql.y:866
 		{
 			sqlVAL.stmt = &Revoke{Privileges: sqlDollar[2].privilegeList, Grantees: NameList(sqlDollar[6].strs), Targets: sqlDollar[4].targetList}
 		}
-	case 109:
+	case 104:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:836
+		//line sql.y:873
 		{
 			sqlVAL.targetList = TargetList{Tables: QualifiedNames(sqlDollar[1].qnames)}
 		}
-	case 110:
+	case 105:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:840
+		//line sql.y:877
 		{
 			// TODO(marc): this is postgres' grammar, but do we really need
 			// both "x" and "TABLE X"?
 			sqlVAL.targetList = TargetList{Tables: QualifiedNames(sqlDollar[2].qnames)}
 		}
-	case 111:
+	case 106:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:846
+		//line sql.y:883
 		{
 			sqlVAL.targetList = TargetList{Databases: NameList(sqlDollar[2].strs)}
 		}
-	case 112:
+	case 107:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:852
+		//line sql.y:889
 		{
 			sqlVAL.privilegeList = []PrivilegeType{PrivilegeAll}
 		}
-	case 113:
+	case 108:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:855
+		//line sql.y:892
 		{
 		}
-	case 114:
+	case 109:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:859
+		//line sql.y:896
 		{
 			sqlVAL.privilegeList = []PrivilegeType{sqlDollar[1].privilegeType}
 		}
-	case 115:
+	case 110:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:863
+		//line sql.y:900
 		{
 			sqlVAL.privilegeList = append(sqlDollar[1].privilegeList, sqlDollar[3].privilegeType)
 		}
-	case 116:
+	case 111:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:869
+		//line sql.y:906
 		{
 			sqlVAL.privilegeType = PrivilegeRead
 		}
-	case 117:
+	case 112:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:873
+		//line sql.y:910
 		{
 			sqlVAL.privilegeType = PrivilegeWrite
 		}
-	case 118:
+	case 113:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:881
+		//line sql.y:918
 		{
 			sqlVAL.strs = []string{sqlDollar[1].str}
 		}
-	case 119:
+	case 114:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:885
+		//line sql.y:922
 		{
 			sqlVAL.strs = append(sqlDollar[1].strs, sqlDollar[3].str)
 		}
-	case 120:
+	case 115:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:893
+		//line sql.y:930
 		{
 			sqlVAL.stmt = sqlDollar[2].stmt
 		}
-	case 121:
+	case 116:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:897
+		//line sql.y:934
 		{
 			sqlVAL.stmt = sqlDollar[3].stmt
 		}
-	case 122:
+	case 117:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:901
+		//line sql.y:938
 		{
 			sqlVAL.stmt = sqlDollar[3].stmt
 		}
-	case 123:
+	case 118:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:906
+		//line sql.y:944
 		{
+			sqlVAL.stmt = &SetTransaction{Isolation: sqlDollar[2].isoLevel}
 		}
-	case 124:
+	case 119:
 		sqlDollar = sqlS[sqlpt-5 : sqlpt+1]
-		//line sql.y:907
+		//line sql.y:947
 		{
 		}
-	case 125:
+	case 120:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:908
+		//line sql.y:948
 		{
 		}
-	case 126:
+	case 121:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:912
+		//line sql.y:952
 		{
 			sqlVAL.stmt = &Set{Name: sqlDollar[1].qname, Values: sqlDollar[3].exprs}
 		}
-	case 127:
+	case 122:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:916
+		//line sql.y:956
 		{
 			sqlVAL.stmt = &Set{Name: sqlDollar[1].qname, Values: sqlDollar[3].exprs}
 		}
-	case 128:
+	case 123:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:920
+		//line sql.y:960
 		{
 			sqlVAL.stmt = &Set{Name: sqlDollar[1].qname}
 		}
-	case 129:
+	case 124:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:924
+		//line sql.y:964
 		{
 			sqlVAL.stmt = &Set{Name: sqlDollar[1].qname}
 		}
-	case 131:
+	case 126:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:931
+		//line sql.y:971
 		{
 		}
-	case 132:
+	case 127:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:933
+		//line sql.y:973
 		{
 		}
-	case 133:
+	case 128:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:934
+		//line sql.y:974
 		{
 		}
-	case 134:
+	case 129:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:936
+		//line sql.y:976
 		{
 		}
-	case 136:
+	case 131:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:943
+		//line sql.y:983
 		{
 			sqlVAL.exprs = []Expr{sqlDollar[1].expr}
 		}
-	case 137:
+	case 132:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:947
+		//line sql.y:987
 		{
 			sqlVAL.exprs = append(sqlDollar[1].exprs, sqlDollar[3].expr)
 		}
-	case 140:
+	case 135:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:956
+		//line sql.y:999
 		{
+			sqlVAL.isoLevel = SnapshotIsolation
 		}
-	case 141:
+	case 136:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:957
+		//line sql.y:1003
 		{
+			sqlVAL.isoLevel = SnapshotIsolation
 		}
-	case 142:
+	case 137:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:958
+		//line sql.y:1007
 		{
+			sqlVAL.isoLevel = SnapshotIsolation
 		}
-	case 143:
+	case 138:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:959
+		//line sql.y:1011
 		{
+			sqlVAL.isoLevel = SnapshotIsolation
 		}
-	case 144:
+	case 139:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:963
+		//line sql.y:1015
+		{
+			sqlVAL.isoLevel = SerializableIsolation
+		}
+	case 140:
+		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
+		//line sql.y:1021
 		{
 			sqlVAL.expr = BoolVal(true)
 		}
-	case 145:
+	case 141:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:967
+		//line sql.y:1025
 		{
 			sqlVAL.expr = BoolVal(false)
 		}
-	case 146:
+	case 142:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:971
+		//line sql.y:1029
 		{
 			sqlVAL.expr = StrVal(sqlDollar[1].str)
 		}
-	case 148:
+	case 144:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:987
+		//line sql.y:1045
 		{
 		}
-	case 149:
+	case 145:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:988
+		//line sql.y:1046
 		{
 		}
-	case 150:
+	case 146:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:989
+		//line sql.y:1047
 		{
 		}
-	case 151:
+	case 147:
 		sqlDollar = sqlS[sqlpt-5 : sqlpt+1]
-		//line sql.y:990
+		//line sql.y:1048
 		{
 		}
-	case 152:
+	case 148:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:991
+		//line sql.y:1049
 		{
 		}
-	case 153:
+	case 149:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:992
+		//line sql.y:1050
 		{
 		}
-	case 154:
+	case 150:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:993
+		//line sql.y:1051
 		{
 		}
-	case 155:
+	case 151:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:996
+		//line sql.y:1054
 		{
 		}
-	case 156:
+	case 152:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:997
+		//line sql.y:1055
 		{
 		}
-	case 157:
+	case 153:
 		sqlDollar = sqlS[sqlpt-0 : sqlpt+1]
-		//line sql.y:998
+		//line sql.y:1056
 		{
 		}
-	case 158:
+	case 154:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:1002
+		//line sql.y:1060
 		{
 			sqlVAL.expr = StrVal(sqlDollar[1].str)
 		}
-	case 159:
+	case 155:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:1006
+		//line sql.y:1064
 		{
 			sqlVAL.expr = StrVal(sqlDollar[1].str)
 		}
-	case 160:
+	case 156:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:1011
+		//line sql.y:1069
 		{
 		}
-	case 161:
+	case 157:
 		sqlDollar = sqlS[sqlpt-4 : sqlpt+1]
-		//line sql.y:1015
+		//line sql.y:1073
 		{
 			sqlVAL.stmt = &ShowColumns{Table: sqlDollar[4].qname}
 		}
-	case 162:
+	case 158:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:1019
+		//line sql.y:1077
 		{
 			sqlVAL.stmt = &ShowDatabases{}
 		}
-	case 163:
+	case 159:
 		sqlDollar = sqlS[sqlpt-4 : sqlpt+1]
-		//line sql.y:1023
+		//line sql.y:1081
 		{
 			sqlVAL.stmt = &ShowGrants{Targets: sqlDollar[3].targetListPtr, Grantees: sqlDollar[4].strs}
 		}
-	case 164:
+	case 160:
 		sqlDollar = sqlS[sqlpt-4 : sqlpt+1]
-		//line sql.y:1027
+		//line sql.y:1085
 		{
 			sqlVAL.stmt = &ShowIndex{Table: sqlDollar[4].qname}
 		}
-	case 165:
+	case 161:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:1031
+		//line sql.y:1089
 		{
 			sqlVAL.stmt = &ShowTables{Name: sqlDollar[3].qname}
 		}
-	case 166:
+	case 162:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:1035
+		//line sql.y:1093
 		{
 			sqlVAL.stmt = nil
 		}
-	case 167:
+	case 163:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:1039
+		//line sql.y:1097
 		{
 			sqlVAL.stmt = nil
 		}
-	case 168:
+	case 164:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:1045
+		//line sql.y:1103
 		{
 			sqlVAL.qname = sqlDollar[2].qname
 		}
-	case 169:
+	case 165:
 		sqlDollar = sqlS[sqlpt-0 : sqlpt+1]
-		//line sql.y:1049
+		//line sql.y:1107
 		{
 			sqlVAL.qname = nil
 		}
-	case 170:
+	case 166:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:1055
+		//line sql.y:1113
 		{
 			tmp := sqlDollar[2].targetList
 			sqlVAL.targetListPtr = &tmp
 		}
-	case 171:
+	case 167:
 		sqlDollar = sqlS[sqlpt-0 : sqlpt+1]
-		//line sql.y:1060
+		//line sql.y:1118
 		{
 			sqlVAL.targetListPtr = nil
 		}
-	case 172:
+	case 168:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:1066
+		//line sql.y:1124
 		{
 			sqlVAL.strs = sqlDollar[2].strs
 		}
-	case 173:
+	case 169:
 		sqlDollar = sqlS[sqlpt-0 : sqlpt+1]
-		//line sql.y:1070
+		//line sql.y:1128
 		{
 			sqlVAL.strs = nil
 		}
-	case 174:
+	case 170:
 		sqlDollar = sqlS[sqlpt-10 : sqlpt+1]
-		//line sql.y:1078
+		//line sql.y:1136
 		{
 			sqlVAL.stmt = &CreateTable{Table: sqlDollar[4].qname, IfNotExists: false, Defs: sqlDollar[6].tblDefs}
 		}
-	case 175:
+	case 171:
 		sqlDollar = sqlS[sqlpt-13 : sqlpt+1]
-		//line sql.y:1083
+		//line sql.y:1141
 		{
 			sqlVAL.stmt = &CreateTable{Table: sqlDollar[7].qname, IfNotExists: true, Defs: sqlDollar[9].tblDefs}
 		}
-	case 176:
+	case 172:
 		sqlDollar = sqlS[sqlpt-9 : sqlpt+1]
-		//line sql.y:1088
+		//line sql.y:1146
 		{
 			sqlVAL.stmt = nil
 		}
-	case 177:
+	case 173:
 		sqlDollar = sqlS[sqlpt-12 : sqlpt+1]
-		//line sql.y:1093
+		//line sql.y:1151
 		{
 			sqlVAL.stmt = nil
 		}
-	case 178:
+	case 174:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:1107
+		//line sql.y:1165
 		{
 		}
-	case 179:
+	case 175:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:1108
+		//line sql.y:1166
 		{
 		}
-	case 180:
+	case 176:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:1109
+		//line sql.y:1167
 		{
 		}
-	case 181:
+	case 177:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:1110
+		//line sql.y:1168
 		{
 		}
-	case 182:
+	case 178:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:1111
+		//line sql.y:1169
 		{
 		}
-	case 183:
+	case 179:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:1112
+		//line sql.y:1170
 		{
 		}
-	case 184:
+	case 180:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:1113
+		//line sql.y:1171
 		{
 		}
-	case 185:
+	case 181:
 		sqlDollar = sqlS[sqlpt-0 : sqlpt+1]
-		//line sql.y:1114
+		//line sql.y:1172
 		{
 		}
-	case 187:
+	case 183:
 		sqlDollar = sqlS[sqlpt-0 : sqlpt+1]
-		//line sql.y:1119
+		//line sql.y:1177
 		{
 			sqlVAL.tblDefs = nil
 		}
-	case 188:
+	case 184:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:1125
+		//line sql.y:1183
 		{
 			sqlVAL.empty = sqlDollar[2].empty
 		}
-	case 189:
+	case 185:
 		sqlDollar = sqlS[sqlpt-0 : sqlpt+1]
-		//line sql.y:1128
+		//line sql.y:1186
 		{
 		}
-	case 190:
+	case 186:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:1132
+		//line sql.y:1190
 		{
 			sqlVAL.tblDefs = TableDefs{sqlDollar[1].tblDef}
 		}
-	case 191:
+	case 187:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:1136
+		//line sql.y:1194
 		{
 			sqlVAL.tblDefs = append(sqlDollar[1].tblDefs, sqlDollar[3].tblDef)
 		}
-	case 192:
+	case 188:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:1141
+		//line sql.y:1199
 		{
 		}
-	case 193:
+	case 189:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:1142
+		//line sql.y:1200
 		{
 		}
-	case 195:
+	case 191:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:1146
+		//line sql.y:1204
 		{
 		}
-	case 197:
+	case 193:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:1150
+		//line sql.y:1208
 		{
 		}
-	case 198:
+	case 194:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:1151
+		//line sql.y:1209
 		{
 		}
-	case 199:
+	case 195:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:1155
+		//line sql.y:1213
 		{
 			sqlVAL.tblDef = newColumnTableDef(Name(sqlDollar[1].str), sqlDollar[2].colType, sqlDollar[3].colConstraints)
 		}
-	case 200:
+	case 196:
 		sqlDollar = sqlS[sqlpt-4 : sqlpt+1]
-		//line sql.y:1160
+		//line sql.y:1218
 		{
 		}
-	case 201:
+	case 197:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:1164
+		//line sql.y:1222
 		{
 			sqlVAL.colConstraints = append(sqlDollar[1].colConstraints, sqlDollar[2].colConstraint)
 		}
-	case 202:
+	case 198:
 		sqlDollar = sqlS[sqlpt-0 : sqlpt+1]
-		//line sql.y:1168
+		//line sql.y:1226
 		{
 			sqlVAL.colConstraints = nil
 		}
-	case 203:
+	case 199:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:1174
+		//line sql.y:1232
 		{
 			// TODO(pmattis): Handle constraint name.
 			sqlVAL.colConstraint = sqlDollar[3].colConstraint
 		}
-	case 205:
+	case 201:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:1179
+		//line sql.y:1237
 		{
 		}
-	case 206:
+	case 202:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:1195
+		//line sql.y:1253
 		{
 			sqlVAL.colConstraint = NotNullConstraint{}
 		}
-	case 207:
+	case 203:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:1199
+		//line sql.y:1257
 		{
 			sqlVAL.colConstraint = NullConstraint{}
 		}
-	case 208:
+	case 204:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:1203
+		//line sql.y:1261
 		{
 			sqlVAL.colConstraint = UniqueConstraint{}
 		}
-	case 209:
+	case 205:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:1207
+		//line sql.y:1265
 		{
 			sqlVAL.colConstraint = PrimaryKeyConstraint{}
 		}
-	case 210:
+	case 206:
 		sqlDollar = sqlS[sqlpt-5 : sqlpt+1]
-		//line sql.y:1210
+		//line sql.y:1269
 		{
+			sqlVAL.colConstraint = CheckConstraint{Expr: sqlDollar[3].expr}
 		}
-	case 211:
+	case 207:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:1211
+		//line sql.y:1273
 		{
+			sqlVAL.colConstraint = DefaultConstraint{Expr: sqlDollar[2].expr}
 		}
-	case 212:
+	case 208:
 		sqlDollar = sqlS[sqlpt-5 : sqlpt+1]
-		//line sql.y:1212
+		//line sql.y:1277
 		{
+			sqlVAL.colConstraint = &ReferencesConstraint{Table: sqlDollar[2].qname, Columns: NameList(sqlDollar[3].strs), Actions: sqlDollar[5].refActions}
 		}
-	case 213:
+	case 209:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:1215
+		//line sql.y:1282
 		{
 		}
-	case 214:
+	case 210:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:1218
+		//line sql.y:1285
 		{
 		}
-	case 215:
+	case 211:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:1219
+		//line sql.y:1286
 		{
 		}
-	case 216:
+	case 212:
 		sqlDollar = sqlS[sqlpt-0 : sqlpt+1]
-		//line sql.y:1220
+		//line sql.y:1287
 		{
 		}
-	case 217:
+	case 213:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:1223
+		//line sql.y:1290
 		{
 		}
-	case 218:
+	case 214:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:1224
+		//line sql.y:1291
 		{
 		}
-	case 219:
+	case 215:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:1225
+		//line sql.y:1292
 		{
 		}
-	case 220:
+	case 216:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:1226
+		//line sql.y:1293
 		{
 		}
-	case 221:
+	case 217:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:1227
+		//line sql.y:1294
 		{
 		}
-	case 222:
+	case 218:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:1228
+		//line sql.y:1295
 		{
 		}
-	case 223:
+	case 219:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:1235
+		//line sql.y:1302
 		{
 			sqlVAL.tblDef = sqlDollar[3].tblDef
-			if i, ok := sqlVAL.tblDef.(*IndexTableDef); ok {
-				i.Name = Name(sqlDollar[2].str)
+			switch t := sqlVAL.tblDef.(type) {
+			case *IndexTableDef:
+				t.Name = Name(sqlDollar[2].str)
+			case *CheckConstraintTableDef:
+				t.Name = Name(sqlDollar[2].str)
+			case *ForeignKeyTableDef:
+				t.Name = Name(sqlDollar[2].str)
 			}
 		}
-	case 224:
+	case 220:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:1242
+		//line sql.y:1314
 		{
 			sqlVAL.tblDef = sqlDollar[1].tblDef
 		}
-	case 225:
+	case 221:
 		sqlDollar = sqlS[sqlpt-4 : sqlpt+1]
-		//line sql.y:1247
+		//line sql.y:1320
 		{
+			sqlVAL.tblDef = &CheckConstraintTableDef{Expr: sqlDollar[3].expr}
 		}
-	case 226:
+	case 222:
 		sqlDollar = sqlS[sqlpt-4 : sqlpt+1]
-		//line sql.y:1249
+		//line sql.y:1324
 		{
 			sqlVAL.tblDef = &IndexTableDef{Unique: true, Columns: NameList(sqlDollar[3].strs)}
 		}
-	case 227:
+	case 223:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:1252
+		//line sql.y:1327
 		{
 		}
-	case 228:
+	case 224:
 		sqlDollar = sqlS[sqlpt-4 : sqlpt+1]
-		//line sql.y:1254
+		//line sql.y:1329
 		{
 			sqlVAL.tblDef = &IndexTableDef{Columns: NameList(sqlDollar[3].strs)}
 		}
-	case 229:
+	case 225:
 		sqlDollar = sqlS[sqlpt-5 : sqlpt+1]
-		//line sql.y:1258
+		//line sql.y:1333
 		{
 			sqlVAL.tblDef = &IndexTableDef{PrimaryKey: true, Unique: true, Columns: NameList(sqlDollar[4].strs)}
 		}
-	case 230:
+	case 226:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:1261
+		//line sql.y:1336
 		{
 		}
-	case 231:
+	case 227:
 		sqlDollar = sqlS[sqlpt-6 : sqlpt+1]
-		//line sql.y:1263
+		//line sql.y:1338
 		{
 		}
-	case 232:
+	case 228:
 		sqlDollar = sqlS[sqlpt-10 : sqlpt+1]
-		//line sql.y:1265
+		//line sql.y:1341
 		{
+			sqlVAL.tblDef = &ForeignKeyTableDef{
+				FromCols: NameList(sqlDollar[4].strs),
+				Table:    sqlDollar[7].qname,
+				ToCols:   NameList(sqlDollar[8].strs),
+				Actions:  sqlDollar[10].refActions,
+			}
 		}
-	case 233:
+	case 229:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:1268
+		//line sql.y:1351
 		{
 		}
-	case 234:
+	case 230:
 		sqlDollar = sqlS[sqlpt-0 : sqlpt+1]
-		//line sql.y:1269
+		//line sql.y:1352
 		{
 		}
-	case 235:
+	case 231:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:1273
+		//line sql.y:1356
 		{
 			sqlVAL.strs = sqlDollar[2].strs
 		}
-	case 236:
+	case 232:
 		sqlDollar = sqlS[sqlpt-0 : sqlpt+1]
-		//line sql.y:1277
+		//line sql.y:1360
 		{
 			sqlVAL.strs = nil
 		}
-	case 237:
+	case 233:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:1282
+		//line sql.y:1365
 		{
 		}
-	case 238:
+	case 234:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:1283
+		//line sql.y:1366
 		{
 		}
-	case 239:
+	case 235:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:1284
+		//line sql.y:1367
 		{
 		}
-	case 240:
+	case 236:
 		sqlDollar = sqlS[sqlpt-0 : sqlpt+1]
-		//line sql.y:1285
+		//line sql.y:1368
 		{
 		}
-	case 241:
+	case 237:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:1288
+		//line sql.y:1371
 		{
 		}
-	case 242:
+	case 238:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:1289
+		//line sql.y:1372
 		{
 		}
-	case 243:
+	case 239:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:1292
+		//line sql.y:1375
 		{
 		}
-	case 244:
+	case 240:
 		sqlDollar = sqlS[sqlpt-4 : sqlpt+1]
-		//line sql.y:1295
+		//line sql.y:1378
 		{
 		}
-	case 245:
+	case 241:
 		sqlDollar = sqlS[sqlpt-0 : sqlpt+1]
-		//line sql.y:1296
+		//line sql.y:1379
 		{
 		}
-	case 246:
+	case 242:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:1303
+		//line sql.y:1387
 		{
+			sqlVAL.refActions = ReferenceActions{Update: sqlDollar[1].refAction}
 		}
-	case 247:
+	case 243:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:1304
+		//line sql.y:1391
 		{
+			sqlVAL.refActions = ReferenceActions{Delete: sqlDollar[1].refAction}
 		}
-	case 248:
+	case 244:
+		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
+		//line sql.y:1395
+		{
+			sqlVAL.refActions = ReferenceActions{Delete: sqlDollar[2].refAction, Update: sqlDollar[1].refAction}
+		}
+	case 245:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:1305
+		//line sql.y:1399
+		{
+			sqlVAL.refActions = ReferenceActions{Delete: sqlDollar[1].refAction, Update: sqlDollar[2].refAction}
+		}
+	case 246:
+		sqlDollar = sqlS[sqlpt-0 : sqlpt+1]
+		//line sql.y:1403
+		{
+			sqlVAL.refActions = ReferenceActions{}
+		}
+	case 247:
+		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
+		//line sql.y:1409
+		{
+			sqlVAL.refAction = sqlDollar[3].refAction
+		}
+	case 248:
+		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
+		//line sql.y:1415
 		{
+			sqlVAL.refAction = sqlDollar[3].refAction
 		}
 	case 249:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:1306
+		//line sql.y:1421
 		{
+			sqlVAL.refAction = NoAction
 		}
 	case 250:
-		sqlDollar = sqlS[sqlpt-0 : sqlpt+1]
-		//line sql.y:1307
+		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
+		//line sql.y:1425
 		{
+			sqlVAL.refAction = Restrict
 		}
 	case 251:
-		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:1310
+		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
+		//line sql.y:1429
 		{
+			sqlVAL.refAction = Cascade
 		}
 	case 252:
-		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:1313
+		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
+		//line sql.y:1433
 		{
+			sqlVAL.refAction = SetNull
 		}
 	case 253:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:1316
+		//line sql.y:1437
 		{
+			sqlVAL.refAction = SetDefault
 		}
 	case 254:
-		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:1317
+		sqlDollar = sqlS[sqlpt-4 : sqlpt+1]
+		//line sql.y:1442
 		{
 		}
 	case 255:
-		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:1318
+		sqlDollar = sqlS[sqlpt-0 : sqlpt+1]
+		//line sql.y:1443
 		{
 		}
 	case 256:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:1319
+		//line sql.y:1446
 		{
 		}
 	case 257:
-		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:1320
+		sqlDollar = sqlS[sqlpt-0 : sqlpt+1]
+		//line sql.y:1447
 		{
 		}
 	case 258:
-		sqlDollar = sqlS[sqlpt-4 : sqlpt+1]
-		//line sql.y:1323
+		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
+		//line sql.y:1450
 		{
 		}
 	case 259:
-		sqlDollar = sqlS[sqlpt-0 : sqlpt+1]
-		//line sql.y:1324
+		sqlDollar = sqlS[sqlpt-4 : sqlpt+1]
+		//line sql.y:1451
 		{
 		}
 	case 260:
-		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:1327
+		sqlDollar = sqlS[sqlpt-4 : sqlpt+1]
+		//line sql.y:1452
 		{
 		}
 	case 261:
 		sqlDollar = sqlS[sqlpt-0 : sqlpt+1]
-		//line sql.y:1328
+		//line sql.y:1453
 		{
 		}
 	case 262:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:1331
+		//line sql.y:1456
 		{
 		}
 	case 263:
-		sqlDollar = sqlS[sqlpt-4 : sqlpt+1]
-		//line sql.y:1332
+		sqlDollar = sqlS[sqlpt-6 : sqlpt+1]
+		//line sql.y:1463
 		{
+			sqlVAL.stmt = sqlDollar[4].stmt
+			sqlVAL.stmt.(*CreateTable).AsSource = sqlDollar[6].stmt.(SelectStatement)
 		}
 	case 264:
-		sqlDollar = sqlS[sqlpt-4 : sqlpt+1]
-		//line sql.y:1333
+		sqlDollar = sqlS[sqlpt-9 : sqlpt+1]
+		//line sql.y:1468
 		{
+			sqlVAL.stmt = sqlDollar[7].stmt
+			sqlVAL.stmt.(*CreateTable).IfNotExists = true
+			sqlVAL.stmt.(*CreateTable).AsSource = sqlDollar[9].stmt.(SelectStatement)
 		}
 	case 265:
-		sqlDollar = sqlS[sqlpt-0 : sqlpt+1]
-		//line sql.y:1334
+		sqlDollar = sqlS[sqlpt-4 : sqlpt+1]
+		//line sql.y:1476
 		{
+			sqlVAL.stmt = &CreateTable{Table: sqlDollar[1].qname, AsColumnNames: NameList(sqlDollar[2].strs)}
 		}
 	case 266:
-		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:1337
+		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
+		//line sql.y:1487
 		{
+			sqlVAL.expr = NumVal(sqlDollar[1].str)
 		}
 	case 267:
-		sqlDollar = sqlS[sqlpt-7 : sqlpt+1]
-		//line sql.y:1342
+		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
+		//line sql.y:1491
 		{
-			sqlVAL.stmt = nil
+			sqlVAL.expr = NumVal("-" + sqlDollar[2].str)
 		}
 	case 268:
-		sqlDollar = sqlS[sqlpt-10 : sqlpt+1]
-		//line sql.y:1346
+		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
+		//line sql.y:1495
 		{
-			sqlVAL.stmt = nil
+			sqlVAL.expr = IntVal(sqlDollar[1].ival)
 		}
 	case 269:
-		sqlDollar = sqlS[sqlpt-4 : sqlpt+1]
-		//line sql.y:1351
+		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
+		//line sql.y:1501
 		{
 		}
 	case 270:
-		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:1354
+		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
+		//line sql.y:1502
 		{
 		}
 	case 271:
-		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:1355
+		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
+		//line sql.y:1503
 		{
 		}
 	case 272:
-		sqlDollar = sqlS[sqlpt-0 : sqlpt+1]
-		//line sql.y:1356
+		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
+		//line sql.y:1504
 		{
 		}
 	case 273:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:1360
+		//line sql.y:1505
 		{
-			sqlVAL.expr = NumVal(sqlDollar[1].str)
 		}
 	case 274:
-		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:1364
+		sqlDollar = sqlS[sqlpt-5 : sqlpt+1]
+		//line sql.y:1510
 		{
-			sqlVAL.expr = NumVal("-" + sqlDollar[2].str)
+			sqlVAL.stmt = &Truncate{Tables: sqlDollar[3].qnames}
 		}
 	case 275:
-		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:1368
+		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
+		//line sql.y:1515
 		{
-			sqlVAL.expr = IntVal(sqlDollar[1].ival)
 		}
 	case 276:
-		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:1374
+		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
+		//line sql.y:1516
 		{
 		}
 	case 277:
-		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:1375
+		sqlDollar = sqlS[sqlpt-0 : sqlpt+1]
+		//line sql.y:1517
 		{
 		}
 	case 278:
-		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:1376
+		sqlDollar = sqlS[sqlpt-12 : sqlpt+1]
+		//line sql.y:1525
 		{
+			sqlVAL.stmt = &CreateIndex{Name: Name(sqlDollar[5].str), Table: sqlDollar[7].qname, Unique: sqlDollar[2].boolVal, Columns: NameList(sqlDollar[10].strs)}
 		}
 	case 279:
-		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:1377
+		sqlDollar = sqlS[sqlpt-15 : sqlpt+1]
+		//line sql.y:1531
 		{
+			sqlVAL.stmt = &CreateIndex{Name: Name(sqlDollar[8].str), Table: sqlDollar[10].qname, Unique: sqlDollar[2].boolVal, IfNotExists: true, Columns: NameList(sqlDollar[13].strs)}
 		}
 	case 280:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:1378
+		//line sql.y:1537
 		{
+			sqlVAL.boolVal = true
 		}
 	case 281:
-		sqlDollar = sqlS[sqlpt-5 : sqlpt+1]
-		//line sql.y:1383
+		sqlDollar = sqlS[sqlpt-0 : sqlpt+1]
+		//line sql.y:1541
 		{
-			sqlVAL.stmt = &Truncate{Tables: sqlDollar[3].qnames}
+			sqlVAL.boolVal = false
 		}
 	case 282:
-		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:1388
+		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
+		//line sql.y:1546
 		{
 		}
 	case 283:
-		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:1389
+		sqlDollar = sqlS[sqlpt-0 : sqlpt+1]
+		//line sql.y:1547
 		{
 		}
 	case 284:
-		sqlDollar = sqlS[sqlpt-0 : sqlpt+1]
-		//line sql.y:1390
+		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
+		//line sql.y:1550
 		{
 		}
 	case 285:
-		sqlDollar = sqlS[sqlpt-13 : sqlpt+1]
-		//line sql.y:1397
+		sqlDollar = sqlS[sqlpt-0 : sqlpt+1]
+		//line sql.y:1551
 		{
-			sqlVAL.stmt = nil
 		}
 	case 286:
-		sqlDollar = sqlS[sqlpt-16 : sqlpt+1]
-		//line sql.y:1403
+		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
+		//line sql.y:1555
 		{
-			sqlVAL.stmt = nil
+			sqlVAL.strs = []string{sqlDollar[1].str}
 		}
 	case 287:
-		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:1408
+		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
+		//line sql.y:1559
 		{
+			sqlVAL.strs = append(sqlDollar[1].strs, sqlDollar[3].str)
 		}
 	case 288:
-		sqlDollar = sqlS[sqlpt-0 : sqlpt+1]
-		//line sql.y:1409
+		sqlDollar = sqlS[sqlpt-5 : sqlpt+1]
+		//line sql.y:1569
 		{
+			sqlVAL.str = sqlDollar[1].str
 		}
 	case 289:
-		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:1412
+		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
+		//line sql.y:1578
 		{
 		}
 	case 290:
 		sqlDollar = sqlS[sqlpt-0 : sqlpt+1]
-		//line sql.y:1413
+		//line sql.y:1579
 		{
 		}
 	case 291:
-		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:1416
+		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
+		//line sql.y:1582
 		{
 		}
 	case 292:
-		sqlDollar = sqlS[sqlpt-0 : sqlpt+1]
-		//line sql.y:1417
+		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
+		//line sql.y:1583
 		{
 		}
 	case 293:
-		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:1420
+		sqlDollar = sqlS[sqlpt-0 : sqlpt+1]
+		//line sql.y:1584
 		{
 		}
 	case 294:
-		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:1421
+		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
+		//line sql.y:1588
 		{
+			sqlVAL.dir = Ascending
 		}
 	case 295:
-		sqlDollar = sqlS[sqlpt-5 : sqlpt+1]
-		//line sql.y:1428
+		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
+		//line sql.y:1592
 		{
+			sqlVAL.dir = Descending
 		}
 	case 296:
-		sqlDollar = sqlS[sqlpt-5 : sqlpt+1]
-		//line sql.y:1430
+		sqlDollar = sqlS[sqlpt-0 : sqlpt+1]
+		//line sql.y:1596
 		{
+			sqlVAL.dir = DefaultDirection
 		}
 	case 297:
-		sqlDollar = sqlS[sqlpt-7 : sqlpt+1]
-		//line sql.y:1432
+		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
+		//line sql.y:1601
 		{
 		}
 	case 298:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:1435
+		//line sql.y:1602
 		{
 		}
 	case 299:
 		sqlDollar = sqlS[sqlpt-0 : sqlpt+1]
-		//line sql.y:1436
+		//line sql.y:1603
 		{
 		}
 	case 300:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:1439
+		//line sql.y:1609
 		{
 		}
 	case 301:
-		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:1440
+		sqlDollar = sqlS[sqlpt-4 : sqlpt+1]
+		//line sql.y:1610
 		{
 		}
 	case 302:
-		sqlDollar = sqlS[sqlpt-0 : sqlpt+1]
-		//line sql.y:1441
+		sqlDollar = sqlS[sqlpt-5 : sqlpt+1]
+		//line sql.y:1611
 		{
 		}
 	case 303:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:1444
+		//line sql.y:1614
 		{
 		}
 	case 304:
-		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:1445
-		{
-		}
-	case 305:
-		sqlDollar = sqlS[sqlpt-0 : sqlpt+1]
-		//line sql.y:1446
-		{
-		}
-	case 306:
-		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:1449
-		{
-		}
-	case 307:
-		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:1450
-		{
-		}
-	case 308:
-		sqlDollar = sqlS[sqlpt-0 : sqlpt+1]
-		//line sql.y:1451
-		{
-		}
-	case 309:
-		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:1457
-		{
-		}
-	case 310:
-		sqlDollar = sqlS[sqlpt-4 : sqlpt+1]
-		//line sql.y:1458
-		{
-		}
-	case 311:
-		sqlDollar = sqlS[sqlpt-5 : sqlpt+1]
-		//line sql.y:1459
-		{
-		}
-	case 312:
-		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:1462
-		{
-		}
-	case 313:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:1463
+		//line sql.y:1615
 		{
 		}
-	case 314:
+	case 305:
 		sqlDollar = sqlS[sqlpt-6 : sqlpt+1]
-		//line sql.y:1468
+		//line sql.y:1620
 		{
-			sqlVAL.stmt = nil
+			sqlVAL.stmt = &RenameDatabase{Name: Name(sqlDollar[3].str), NewName: Name(sqlDollar[6].str)}
 		}
-	case 315:
+	case 306:
 		sqlDollar = sqlS[sqlpt-6 : sqlpt+1]
-		//line sql.y:1476
+		//line sql.y:1628
 		{
-			sqlVAL.stmt = nil
+			sqlVAL.stmt = &RenameTable{Name: sqlDollar[3].qname, NewName: sqlDollar[6].qname, IfExists: false}
 		}
-	case 316:
+	case 307:
 		sqlDollar = sqlS[sqlpt-8 : sqlpt+1]
-		//line sql.y:1480
+		//line sql.y:1632
 		{
-			sqlVAL.stmt = nil
+			sqlVAL.stmt = &RenameTable{Name: sqlDollar[5].qname, NewName: sqlDollar[8].qname, IfExists: true}
 		}
-	case 317:
+	case 308:
 		sqlDollar = sqlS[sqlpt-6 : sqlpt+1]
-		//line sql.y:1492
+		//line sql.y:1644
 		{
 			sqlVAL.stmt = nil
 		}
-	case 318:
+	case 309:
 		sqlDollar = sqlS[sqlpt-8 : sqlpt+1]
-		//line sql.y:1496
+		//line sql.y:1648
 		{
 			sqlVAL.stmt = nil
 		}
-	case 319:
+	case 310:
 		sqlDollar = sqlS[sqlpt-8 : sqlpt+1]
-		//line sql.y:1500
+		//line sql.y:1652
 		{
-			sqlVAL.stmt = nil
+			sqlVAL.stmt = &RenameColumn{Table: sqlDollar[3].qname, Name: Name(sqlDollar[6].str), NewName: Name(sqlDollar[8].str), IfExists: false}
 		}
-	case 320:
+	case 311:
 		sqlDollar = sqlS[sqlpt-10 : sqlpt+1]
-		//line sql.y:1504
+		//line sql.y:1656
 		{
-			sqlVAL.stmt = nil
+			sqlVAL.stmt = &RenameColumn{Table: sqlDollar[5].qname, Name: Name(sqlDollar[8].str), NewName: Name(sqlDollar[10].str), IfExists: true}
 		}
-	case 321:
+	case 312:
 		sqlDollar = sqlS[sqlpt-8 : sqlpt+1]
-		//line sql.y:1508
+		//line sql.y:1660
 		{
 			sqlVAL.stmt = nil
 		}
-	case 322:
+	case 313:
 		sqlDollar = sqlS[sqlpt-10 : sqlpt+1]
-		//line sql.y:1512
+		//line sql.y:1664
 		{
 			sqlVAL.stmt = nil
 		}
-	case 323:
+	case 314:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:1517
+		//line sql.y:1670
 		{
+			sqlVAL.boolVal = true
 		}
-	case 324:
+	case 315:
 		sqlDollar = sqlS[sqlpt-0 : sqlpt+1]
-		//line sql.y:1518
+		//line sql.y:1674
 		{
+			sqlVAL.boolVal = false
 		}
-	case 325:
+	case 316:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:1521
+		//line sql.y:1679
 		{
 		}
-	case 326:
+	case 317:
 		sqlDollar = sqlS[sqlpt-0 : sqlpt+1]
-		//line sql.y:1522
+		//line sql.y:1680
 		{
 		}
-	case 327:
+	case 318:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:1527
+		//line sql.y:1685
 		{
-			sqlVAL.stmt = nil
+			sqlVAL.stmt = &RollbackTransaction{}
 		}
-	case 328:
+	case 319:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:1531
+		//line sql.y:1689
 		{
-			sqlVAL.stmt = nil
+			sqlVAL.stmt = &BeginTransaction{Isolation: sqlDollar[3].isoLevel}
 		}
-	case 329:
+	case 320:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:1535
+		//line sql.y:1693
 		{
-			sqlVAL.stmt = nil
+			sqlVAL.stmt = &BeginTransaction{Isolation: sqlDollar[3].isoLevel}
 		}
-	case 330:
+	case 321:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:1539
+		//line sql.y:1697
 		{
-			sqlVAL.stmt = nil
+			sqlVAL.stmt = &CommitTransaction{}
 		}
-	case 331:
+	case 322:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:1543
+		//line sql.y:1701
 		{
-			sqlVAL.stmt = nil
+			sqlVAL.stmt = &CommitTransaction{}
 		}
-	case 332:
+	case 323:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:1547
+		//line sql.y:1705
 		{
-			sqlVAL.stmt = nil
+			sqlVAL.stmt = &RollbackTransaction{}
 		}
-	case 333:
+	case 324:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:1551
+		//line sql.y:1709
 		{
 			sqlVAL.stmt = nil
 		}
-	case 334:
+	case 325:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:1555
+		//line sql.y:1713
 		{
 			sqlVAL.stmt = nil
 		}
-	case 335:
+	case 326:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:1559
+		//line sql.y:1717
 		{
 			sqlVAL.stmt = nil
 		}
-	case 336:
+	case 327:
 		sqlDollar = sqlS[sqlpt-5 : sqlpt+1]
-		//line sql.y:1563
+		//line sql.y:1721
 		{
 			sqlVAL.stmt = nil
 		}
-	case 337:
+	case 328:
 		sqlDollar = sqlS[sqlpt-4 : sqlpt+1]
-		//line sql.y:1567
+		//line sql.y:1725
 		{
 			sqlVAL.stmt = nil
 		}
-	case 338:
+	case 329:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:1571
+		//line sql.y:1729
 		{
 			sqlVAL.stmt = nil
 		}
-	case 339:
+	case 330:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:1575
+		//line sql.y:1733
 		{
 			sqlVAL.stmt = nil
 		}
-	case 340:
+	case 331:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:1579
+		//line sql.y:1737
 		{
 			sqlVAL.stmt = nil
 		}
-	case 341:
+	case 332:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:1584
+		//line sql.y:1742
 		{
 		}
-	case 342:
+	case 333:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:1585
+		//line sql.y:1743
 		{
 		}
-	case 343:
+	case 334:
 		sqlDollar = sqlS[sqlpt-0 : sqlpt+1]
-		//line sql.y:1586
-		{
-		}
-	case 344:
-		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:1589
-		{
-		}
-	case 345:
-		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:1590
-		{
-		}
-	case 346:
-		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:1591
-		{
-		}
-	case 347:
-		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:1592
-		{
-		}
-	case 348:
-		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:1593
-		{
-		}
-	case 349:
-		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:1597
+		//line sql.y:1744
 		{
 		}
-	case 350:
+	case 335:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:1598
-		{
-		}
-	case 351:
-		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:1599
+		//line sql.y:1757
 		{
+			sqlVAL.isoLevel = sqlDollar[3].isoLevel
 		}
-	case 352:
-		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:1602
-		{
-		}
-	case 353:
+	case 337:
 		sqlDollar = sqlS[sqlpt-0 : sqlpt+1]
-		//line sql.y:1603
+		//line sql.y:1764
 		{
+			sqlVAL.isoLevel = UnspecifiedIsolation
 		}
-	case 354:
+	case 338:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:1637
+		//line sql.y:1800
 		{
 			sqlVAL.stmt = &CreateDatabase{Name: Name(sqlDollar[3].str)}
 		}
-	case 355:
+	case 339:
 		sqlDollar = sqlS[sqlpt-6 : sqlpt+1]
-		//line sql.y:1641
+		//line sql.y:1804
 		{
 			sqlVAL.stmt = &CreateDatabase{IfNotExists: true, Name: Name(sqlDollar[6].str)}
 		}
-	case 356:
+	case 340:
 		sqlDollar = sqlS[sqlpt-7 : sqlpt+1]
-		//line sql.y:1648
+		//line sql.y:1811
 		{
 			sqlVAL.stmt = sqlDollar[5].stmt
 			sqlVAL.stmt.(*Insert).Table = sqlDollar[4].qname
+			sqlVAL.stmt.(*Insert).OnConflict = sqlDollar[6].onConflict
+			sqlVAL.stmt.(*Insert).Returning = sqlDollar[7].selExprs
 		}
-	case 359:
+	case 341:
+		sqlDollar = sqlS[sqlpt-6 : sqlpt+1]
+		//line sql.y:1818
+		{
+			sqlVAL.stmt = sqlDollar[5].stmt
+			sqlVAL.stmt.(*Insert).Table = sqlDollar[4].qname
+			sqlVAL.stmt.(*Insert).Upsert = true
+			sqlVAL.stmt.(*Insert).Returning = sqlDollar[6].selExprs
+		}
+	case 344:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:1664
+		//line sql.y:1836
 		{
 			sqlVAL.stmt = &Insert{Rows: sqlDollar[1].stmt.(SelectStatement)}
 		}
-	case 360:
+	case 345:
 		sqlDollar = sqlS[sqlpt-4 : sqlpt+1]
-		//line sql.y:1668
+		//line sql.y:1840
 		{
 			sqlVAL.stmt = &Insert{Columns: sqlDollar[2].qnames, Rows: sqlDollar[4].stmt.(SelectStatement)}
 		}
-	case 361:
+	case 346:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:1672
+		//line sql.y:1844
 		{
 			sqlVAL.stmt = &Insert{}
 		}
-	case 362:
+	case 347:
 		sqlDollar = sqlS[sqlpt-8 : sqlpt+1]
-		//line sql.y:1677
+		//line sql.y:1850
 		{
+			sqlVAL.onConflict = &OnConflict{Columns: NameList(sqlDollar[3].strs), Exprs: sqlDollar[7].updateExprs, Where: newWhere(astWhere, sqlDollar[8].expr)}
 		}
-	case 363:
+	case 348:
 		sqlDollar = sqlS[sqlpt-5 : sqlpt+1]
-		//line sql.y:1678
+		//line sql.y:1854
 		{
+			sqlVAL.onConflict = &OnConflict{Columns: NameList(sqlDollar[3].strs), DoNothing: true}
 		}
-	case 364:
-		sqlDollar = sqlS[sqlpt-0 : sqlpt+1]
-		//line sql.y:1679
-		{
-		}
-	case 365:
-		sqlDollar = sqlS[sqlpt-4 : sqlpt+1]
-		//line sql.y:1682
+	case 349:
+		sqlDollar = sqlS[sqlpt-0 : sqlpt+1]
+		//line sql.y:1858
 		{
+			sqlVAL.onConflict = nil
 		}
-	case 366:
+	case 350:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:1683
+		//line sql.y:1866
 		{
+			sqlVAL.strs = sqlDollar[2].strs
 		}
-	case 367:
+	case 351:
 		sqlDollar = sqlS[sqlpt-0 : sqlpt+1]
-		//line sql.y:1684
+		//line sql.y:1870
 		{
+			sqlVAL.strs = nil
 		}
-	case 368:
+	case 352:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:1687
+		//line sql.y:1876
 		{
+			sqlVAL.selExprs = sqlDollar[2].selExprs
 		}
-	case 369:
+	case 353:
 		sqlDollar = sqlS[sqlpt-0 : sqlpt+1]
-		//line sql.y:1688
+		//line sql.y:1880
 		{
+			sqlVAL.selExprs = nil
 		}
-	case 370:
+	case 354:
 		sqlDollar = sqlS[sqlpt-8 : sqlpt+1]
-		//line sql.y:1696
+		//line sql.y:1890
 		{
-			sqlVAL.stmt = &Update{Table: sqlDollar[3].tblExpr, Exprs: sqlDollar[5].updateExprs, Where: newWhere(astWhere, sqlDollar[7].expr)}
+			sqlVAL.stmt = &Update{Table: sqlDollar[3].tblExpr, Exprs: sqlDollar[5].updateExprs, Where: newWhere(astWhere, sqlDollar[7].expr), Returning: sqlDollar[8].selExprs}
 		}
-	case 371:
+	case 355:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:1702
+		//line sql.y:1896
 		{
 			sqlVAL.updateExprs = UpdateExprs{sqlDollar[1].updateExpr}
 		}
-	case 372:
+	case 356:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:1706
+		//line sql.y:1900
 		{
 			sqlVAL.updateExprs = append(sqlDollar[1].updateExprs, sqlDollar[3].updateExpr)
 		}
-	case 374:
+	case 358:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:1712
+		//line sql.y:1906
 		{
 		}
-	case 375:
+	case 359:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:1716
+		//line sql.y:1910
 		{
 			sqlVAL.updateExpr = &UpdateExpr{Name: sqlDollar[1].qname, Expr: sqlDollar[3].expr}
 		}
-	case 376:
+	case 360:
 		sqlDollar = sqlS[sqlpt-5 : sqlpt+1]
-		//line sql.y:1727
+		//line sql.y:1921
 		{
 		}
-	case 377:
+	case 361:
 		sqlDollar = sqlS[sqlpt-5 : sqlpt+1]
-		//line sql.y:1728
+		//line sql.y:1922
 		{
 		}
-	case 380:
+	case 364:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:1773
+		//line sql.y:1967
 		{
 			sqlVAL.stmt = &ParenSelect{Select: sqlDollar[2].stmt.(SelectStatement)}
 		}
-	case 381:
+	case 365:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:1777
+		//line sql.y:1971
 		{
 			sqlVAL.stmt = &ParenSelect{Select: sqlDollar[2].stmt.(SelectStatement)}
 		}
-	case 383:
+	case 367:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:1793
+		//line sql.y:1987
 		{
 			sqlVAL.stmt = sqlDollar[1].stmt
+			if err := setOrderByLimit(sqlVAL.stmt, sqlDollar[2].orderBy, nil); err != nil {
+				sqllex.Error(err.Error())
+				return 1
+			}
 		}
-	case 384:
+	case 368:
 		sqlDollar = sqlS[sqlpt-4 : sqlpt+1]
-		//line sql.y:1797
+		//line sql.y:1995
 		{
 			sqlVAL.stmt = sqlDollar[1].stmt
-			if s, ok := sqlVAL.stmt.(*Select); ok {
-				s.Limit = sqlDollar[4].limit
+			if err := setOrderByLimit(sqlVAL.stmt, sqlDollar[2].orderBy, sqlDollar[4].limit); err != nil {
+				sqllex.Error(err.Error())
+				return 1
 			}
 		}
-	case 385:
+	case 369:
 		sqlDollar = sqlS[sqlpt-4 : sqlpt+1]
-		//line sql.y:1804
+		//line sql.y:2003
 		{
 			sqlVAL.stmt = sqlDollar[1].stmt
-			if s, ok := sqlVAL.stmt.(*Select); ok {
-				s.Limit = sqlDollar[3].limit
+			if err := setOrderByLimit(sqlVAL.stmt, sqlDollar[2].orderBy, sqlDollar[3].limit); err != nil {
+				sqllex.Error(err.Error())
+				return 1
 			}
 		}
-	case 386:
+	case 370:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:1811
+		//line sql.y:2011
 		{
 			sqlVAL.stmt = sqlDollar[2].stmt
 		}
-	case 387:
+	case 371:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:1815
+		//line sql.y:2015
 		{
 			sqlVAL.stmt = sqlDollar[2].stmt
+			if err := setOrderByLimit(sqlVAL.stmt, sqlDollar[3].orderBy, nil); err != nil {
+				sqllex.Error(err.Error())
+				return 1
+			}
 		}
-	case 388:
+	case 372:
 		sqlDollar = sqlS[sqlpt-5 : sqlpt+1]
-		//line sql.y:1819
+		//line sql.y:2023
 		{
 			sqlVAL.stmt = sqlDollar[2].stmt
-			if s, ok := sqlVAL.stmt.(*Select); ok {
-				s.Limit = sqlDollar[5].limit
+			if err := setOrderByLimit(sqlVAL.stmt, sqlDollar[3].orderBy, sqlDollar[5].limit); err != nil {
+				sqllex.Error(err.Error())
+				return 1
 			}
 		}
-	case 389:
+	case 373:
 		sqlDollar = sqlS[sqlpt-5 : sqlpt+1]
-		//line sql.y:1826
+		//line sql.y:2031
 		{
 			sqlVAL.stmt = sqlDollar[2].stmt
-			if s, ok := sqlVAL.stmt.(*Select); ok {
-				s.Limit = sqlDollar[4].limit
+			if err := setOrderByLimit(sqlVAL.stmt, sqlDollar[3].orderBy, sqlDollar[4].limit); err != nil {
+				sqllex.Error(err.Error())
+				return 1
 			}
 		}
-	case 392:
+	case 376:
 		sqlDollar = sqlS[sqlpt-8 : sqlpt+1]
-		//line sql.y:1863
+		//line sql.y:2069
 		{
 			sqlVAL.stmt = &Select{
-				Exprs:  sqlDollar[3].selExprs,
-				From:   sqlDollar[4].tblExprs,
-				Where:  newWhere(astWhere, sqlDollar[5].expr),
-				Having: newWhere(astHaving, sqlDollar[7].expr),
+				Exprs:   sqlDollar[3].selExprs,
+				From:    sqlDollar[4].tblExprs,
+				Where:   newWhere(astWhere, sqlDollar[5].expr),
+				GroupBy: sqlDollar[6].groupBy,
+				Having:  newWhere(astHaving, sqlDollar[7].expr),
 			}
 		}
-	case 393:
+	case 377:
 		sqlDollar = sqlS[sqlpt-8 : sqlpt+1]
-		//line sql.y:1874
+		//line sql.y:2081
 		{
 			// TODO(pmattis): Support DISTINCT ON?
 			sqlVAL.stmt = &Select{
@@ -8049,12 +8339,13 @@ sqldefault:
 				Exprs:    sqlDollar[3].selExprs,
 				From:     sqlDollar[4].tblExprs,
 				Where:    newWhere(astWhere, sqlDollar[5].expr),
+				GroupBy:  sqlDollar[6].groupBy,
 				Having:   newWhere(astHaving, sqlDollar[7].expr),
 			}
 		}
-	case 395:
+	case 379:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:1886
+		//line sql.y:2094
 		{
 			sqlVAL.stmt = &Select{
 				Exprs:       SelectExprs{&StarExpr{}},
@@ -8062,162 +8353,167 @@ sqldefault:
 				tableSelect: true,
 			}
 		}
-	case 396:
+	case 380:
 		sqlDollar = sqlS[sqlpt-4 : sqlpt+1]
-		//line sql.y:1894
+		//line sql.y:2102
 		{
-			// TODO(pmattis): Support all/distinct
 			sqlVAL.stmt = &Union{
 				Type:  astUnion,
 				Left:  sqlDollar[1].stmt.(SelectStatement),
 				Right: sqlDollar[4].stmt.(SelectStatement),
+				All:   sqlDollar[3].boolVal,
 			}
 		}
-	case 397:
+	case 381:
 		sqlDollar = sqlS[sqlpt-4 : sqlpt+1]
-		//line sql.y:1903
+		//line sql.y:2111
 		{
-			// TODO(pmattis): Support all/distinct
 			sqlVAL.stmt = &Union{
 				Type:  astIntersect,
 				Left:  sqlDollar[1].stmt.(SelectStatement),
 				Right: sqlDollar[4].stmt.(SelectStatement),
+				All:   sqlDollar[3].boolVal,
 			}
 		}
-	case 398:
+	case 382:
 		sqlDollar = sqlS[sqlpt-4 : sqlpt+1]
-		//line sql.y:1912
+		//line sql.y:2120
 		{
-			// TODO(pmattis): Support all/distinct
 			sqlVAL.stmt = &Union{
 				Type:  astExcept,
 				Left:  sqlDollar[1].stmt.(SelectStatement),
 				Right: sqlDollar[4].stmt.(SelectStatement),
+				All:   sqlDollar[3].boolVal,
 			}
 		}
-	case 399:
+	case 383:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:1930
+		//line sql.y:2138
 		{
 		}
-	case 400:
+	case 384:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:1931
+		//line sql.y:2139
 		{
 		}
-	case 401:
+	case 385:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:1932
+		//line sql.y:2140
 		{
 		}
-	case 402:
+	case 386:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:1935
+		//line sql.y:2143
 		{
 		}
-	case 403:
+	case 387:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:1936
+		//line sql.y:2144
 		{
 		}
-	case 404:
+	case 388:
 		sqlDollar = sqlS[sqlpt-6 : sqlpt+1]
-		//line sql.y:1939
+		//line sql.y:2147
 		{
 		}
-	case 409:
+	case 393:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:1948
+		//line sql.y:2156
 		{
 		}
-	case 410:
+	case 394:
 		sqlDollar = sqlS[sqlpt-0 : sqlpt+1]
-		//line sql.y:1949
+		//line sql.y:2157
 		{
 		}
-	case 411:
+	case 395:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:1952
+		//line sql.y:2160
 		{
 		}
-	case 412:
+	case 396:
 		sqlDollar = sqlS[sqlpt-0 : sqlpt+1]
-		//line sql.y:1953
+		//line sql.y:2161
 		{
 		}
-	case 413:
+	case 397:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:1956
+		//line sql.y:2165
 		{
+			sqlVAL.boolVal = true
 		}
-	case 414:
+	case 398:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:1957
+		//line sql.y:2169
 		{
+			sqlVAL.boolVal = false
 		}
-	case 415:
+	case 399:
 		sqlDollar = sqlS[sqlpt-0 : sqlpt+1]
-		//line sql.y:1958
+		//line sql.y:2173
 		{
+			sqlVAL.boolVal = false
 		}
-	case 416:
+	case 400:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:1963
+		//line sql.y:2180
 		{
 		}
-	case 417:
+	case 401:
 		sqlDollar = sqlS[sqlpt-5 : sqlpt+1]
-		//line sql.y:1964
+		//line sql.y:2181
 		{
 		}
-	case 418:
+	case 402:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:1967
+		//line sql.y:2184
 		{
 		}
-	case 419:
+	case 403:
 		sqlDollar = sqlS[sqlpt-0 : sqlpt+1]
-		//line sql.y:1968
-		{
-		}
-	case 420:
-		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:1971
+		//line sql.y:2185
 		{
 		}
-	case 421:
+	case 405:
 		sqlDollar = sqlS[sqlpt-0 : sqlpt+1]
-		//line sql.y:1972
+		//line sql.y:2190
 		{
+			sqlVAL.orderBy = nil
 		}
-	case 422:
+	case 406:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:1975
+		//line sql.y:2196
 		{
+			sqlVAL.orderBy = sqlDollar[3].orderBy
 		}
-	case 423:
+	case 407:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:1978
+		//line sql.y:2202
 		{
+			sqlVAL.orderBy = OrderBy{sqlDollar[1].order}
 		}
-	case 424:
+	case 408:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:1979
+		//line sql.y:2206
 		{
+			sqlVAL.orderBy = append(sqlDollar[1].orderBy, sqlDollar[3].order)
 		}
-	case 425:
+	case 409:
 		sqlDollar = sqlS[sqlpt-4 : sqlpt+1]
-		//line sql.y:1982
+		//line sql.y:2212
 		{
+			sqllex.Error("unimplemented: ORDER BY USING")
+			return 1
 		}
-	case 426:
+	case 410:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:1983
+		//line sql.y:2217
 		{
+			sqlVAL.order = &Order{Expr: sqlDollar[1].expr, Direction: sqlDollar[2].dir}
 		}
-	case 427:
+	case 411:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:1987
+		//line sql.y:2223
 		{
 			if sqlDollar[1].limit == nil {
 				sqlVAL.limit = sqlDollar[2].limit
@@ -8226,24 +8522,24 @@ sqldefault:
 				sqlVAL.limit.Offset = sqlDollar[2].limit.Offset
 			}
 		}
-	case 428:
+	case 412:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:1996
+		//line sql.y:2232
 		{
 			sqlVAL.limit = sqlDollar[1].limit
 			if sqlDollar[2].limit != nil {
 				sqlVAL.limit.Count = sqlDollar[2].limit.Count
 			}
 		}
-	case 432:
+	case 416:
 		sqlDollar = sqlS[sqlpt-0 : sqlpt+1]
-		//line sql.y:2008
+		//line sql.y:2244
 		{
 			sqlVAL.limit = nil
 		}
-	case 433:
+	case 417:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:2014
+		//line sql.y:2250
 		{
 			if sqlDollar[2].expr == nil {
 				sqlVAL.limit = nil
@@ -8251,2422 +8547,2521 @@ sqldefault:
 				sqlVAL.limit = &Limit{Count: sqlDollar[2].expr}
 			}
 		}
-	case 434:
+	case 418:
 		sqlDollar = sqlS[sqlpt-5 : sqlpt+1]
-		//line sql.y:2022
+		//line sql.y:2258
 		{
 		}
-	case 435:
+	case 419:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:2026
+		//line sql.y:2262
 		{
 			sqlVAL.limit = &Limit{Offset: sqlDollar[2].expr}
 		}
-	case 436:
+	case 420:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:2033
+		//line sql.y:2269
 		{
 			sqlVAL.limit = &Limit{Offset: sqlDollar[2].expr}
 		}
-	case 438:
+	case 422:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:2040
+		//line sql.y:2276
 		{
 			sqlVAL.expr = nil
 		}
-	case 439:
+	case 423:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:2048
+		//line sql.y:2284
 		{
 		}
-	case 440:
+	case 424:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:2049
+		//line sql.y:2285
 		{
 		}
-	case 441:
+	case 425:
 		sqlDollar = sqlS[sqlpt-0 : sqlpt+1]
-		//line sql.y:2050
+		//line sql.y:2286
 		{
 		}
-	case 442:
+	case 426:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:2054
+		//line sql.y:2290
 		{
 		}
-	case 443:
+	case 427:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:2055
+		//line sql.y:2291
 		{
 		}
-	case 444:
+	case 428:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:2058
+		//line sql.y:2294
 		{
 		}
-	case 445:
+	case 429:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:2059
+		//line sql.y:2295
 		{
 		}
-	case 446:
+	case 430:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:2080
+		//line sql.y:2317
 		{
+			sqlVAL.groupBy = GroupBy(sqlDollar[3].exprs)
 		}
-	case 447:
+	case 431:
 		sqlDollar = sqlS[sqlpt-0 : sqlpt+1]
-		//line sql.y:2081
+		//line sql.y:2321
 		{
+			sqlVAL.groupBy = nil
 		}
-	case 448:
+	case 432:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:2084
+		//line sql.y:2327
 		{
+			sqlVAL.exprs = Exprs{sqlDollar[1].expr}
 		}
-	case 449:
+	case 433:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:2085
-		{
-		}
-	case 450:
-		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:2088
+		//line sql.y:2331
 		{
+			sqlVAL.exprs = append(sqlDollar[1].exprs, sqlDollar[3].expr)
 		}
-	case 451:
+	case 435:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:2089
+		//line sql.y:2338
 		{
+			sqllex.Error("unimplemented: empty grouping set")
+			return 1
 		}
-	case 452:
+	case 436:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:2092
+		//line sql.y:2344
 		{
 		}
-	case 453:
+	case 437:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:2096
+		//line sql.y:2348
 		{
 			sqlVAL.expr = sqlDollar[2].expr
 		}
-	case 454:
+	case 438:
 		sqlDollar = sqlS[sqlpt-0 : sqlpt+1]
-		//line sql.y:2100
+		//line sql.y:2352
 		{
 			sqlVAL.expr = nil
 		}
-	case 455:
+	case 439:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:2105
+		//line sql.y:2357
 		{
 		}
-	case 456:
+	case 440:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:2106
+		//line sql.y:2358
 		{
 		}
-	case 457:
+	case 441:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:2109
+		//line sql.y:2361
 		{
 		}
-	case 458:
+	case 442:
 		sqlDollar = sqlS[sqlpt-0 : sqlpt+1]
-		//line sql.y:2110
+		//line sql.y:2362
 		{
 		}
-	case 459:
+	case 443:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:2113
+		//line sql.y:2365
 		{
 		}
-	case 460:
+	case 444:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:2114
+		//line sql.y:2366
 		{
 		}
-	case 461:
+	case 445:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:2117
+		//line sql.y:2369
 		{
 		}
-	case 462:
+	case 446:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:2120
+		//line sql.y:2372
 		{
 		}
-	case 463:
+	case 447:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:2121
+		//line sql.y:2373
 		{
 		}
-	case 464:
+	case 448:
 		sqlDollar = sqlS[sqlpt-0 : sqlpt+1]
-		//line sql.y:2122
+		//line sql.y:2374
 		{
 		}
-	case 465:
+	case 449:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:2125
+		//line sql.y:2377
 		{
 		}
-	case 466:
+	case 450:
 		sqlDollar = sqlS[sqlpt-4 : sqlpt+1]
-		//line sql.y:2126
+		//line sql.y:2378
 		{
 		}
-	case 467:
+	case 451:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:2127
+		//line sql.y:2379
 		{
 		}
-	case 468:
+	case 452:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:2128
+		//line sql.y:2380
 		{
 		}
-	case 469:
+	case 453:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:2131
+		//line sql.y:2383
 		{
 		}
-	case 470:
+	case 454:
 		sqlDollar = sqlS[sqlpt-0 : sqlpt+1]
-		//line sql.y:2132
+		//line sql.y:2384
 		{
 		}
-	case 471:
+	case 455:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:2136
+		//line sql.y:2388
 		{
 			sqlVAL.stmt = Values{Tuple(sqlDollar[2].exprs)}
 		}
-	case 472:
+	case 456:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:2140
+		//line sql.y:2392
 		{
 			sqlVAL.stmt = append(sqlDollar[1].stmt.(Values), Tuple(sqlDollar[3].exprs))
 		}
-	case 473:
+	case 457:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:2150
+		//line sql.y:2402
 		{
 			sqlVAL.tblExprs = sqlDollar[2].tblExprs
 		}
-	case 474:
+	case 458:
 		sqlDollar = sqlS[sqlpt-0 : sqlpt+1]
-		//line sql.y:2154
+		//line sql.y:2406
 		{
 			sqlVAL.tblExprs = nil
 		}
-	case 475:
+	case 459:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:2160
+		//line sql.y:2412
 		{
 			sqlVAL.tblExprs = TableExprs{sqlDollar[1].tblExpr}
 		}
-	case 476:
+	case 460:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:2164
+		//line sql.y:2416
 		{
 			sqlVAL.tblExprs = append(sqlDollar[1].tblExprs, sqlDollar[3].tblExpr)
 		}
-	case 477:
+	case 461:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:2171
+		//line sql.y:2423
 		{
 			sqlVAL.tblExpr = &AliasedTableExpr{Expr: sqlDollar[1].qname, As: Name(sqlDollar[2].str)}
 		}
-	case 478:
+	case 462:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:2174
+		//line sql.y:2426
 		{
 		}
-	case 479:
+	case 463:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:2175
+		//line sql.y:2427
 		{
 		}
-	case 480:
+	case 464:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:2177
+		//line sql.y:2429
 		{
 			sqlVAL.tblExpr = &AliasedTableExpr{Expr: &Subquery{Select: sqlDollar[1].stmt.(SelectStatement)}, As: Name(sqlDollar[2].str)}
 		}
-	case 481:
+	case 465:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:2180
+		//line sql.y:2432
 		{
 		}
-	case 483:
+	case 467:
 		sqlDollar = sqlS[sqlpt-4 : sqlpt+1]
-		//line sql.y:2182
+		//line sql.y:2434
 		{
 		}
-	case 484:
+	case 468:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:2200
+		//line sql.y:2452
 		{
 			sqlVAL.tblExpr = &ParenTableExpr{Expr: sqlDollar[2].tblExpr}
 		}
-	case 485:
+	case 469:
 		sqlDollar = sqlS[sqlpt-4 : sqlpt+1]
-		//line sql.y:2204
+		//line sql.y:2456
 		{
 			sqlVAL.tblExpr = &JoinTableExpr{Join: astCrossJoin, Left: sqlDollar[1].tblExpr, Right: sqlDollar[4].tblExpr}
 		}
-	case 486:
+	case 470:
 		sqlDollar = sqlS[sqlpt-5 : sqlpt+1]
-		//line sql.y:2208
+		//line sql.y:2460
 		{
 			sqlVAL.tblExpr = &JoinTableExpr{Join: sqlDollar[2].str, Left: sqlDollar[1].tblExpr, Right: sqlDollar[4].tblExpr, Cond: sqlDollar[5].joinCond}
 		}
-	case 487:
+	case 471:
 		sqlDollar = sqlS[sqlpt-4 : sqlpt+1]
-		//line sql.y:2212
+		//line sql.y:2464
 		{
 			sqlVAL.tblExpr = &JoinTableExpr{Join: astJoin, Left: sqlDollar[1].tblExpr, Right: sqlDollar[3].tblExpr, Cond: sqlDollar[4].joinCond}
 		}
-	case 488:
+	case 472:
 		sqlDollar = sqlS[sqlpt-5 : sqlpt+1]
-		//line sql.y:2216
+		//line sql.y:2468
 		{
 			sqlVAL.tblExpr = &JoinTableExpr{Join: astNaturalJoin, Left: sqlDollar[1].tblExpr, Right: sqlDollar[5].tblExpr}
 		}
-	case 489:
+	case 473:
 		sqlDollar = sqlS[sqlpt-4 : sqlpt+1]
-		//line sql.y:2220
+		//line sql.y:2472
 		{
 			sqlVAL.tblExpr = &JoinTableExpr{Join: astNaturalJoin, Left: sqlDollar[1].tblExpr, Right: sqlDollar[4].tblExpr}
 		}
-	case 490:
+	case 474:
 		sqlDollar = sqlS[sqlpt-5 : sqlpt+1]
-		//line sql.y:2225
+		//line sql.y:2477
 		{
 		}
-	case 491:
+	case 475:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:2227
+		//line sql.y:2479
 		{
 			sqlVAL.str = sqlDollar[2].str
 		}
-	case 492:
+	case 476:
 		sqlDollar = sqlS[sqlpt-4 : sqlpt+1]
-		//line sql.y:2230
+		//line sql.y:2482
 		{
 		}
-	case 493:
+	case 477:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:2232
+		//line sql.y:2484
 		{
 			sqlVAL.str = sqlDollar[1].str
 		}
-	case 495:
+	case 479:
 		sqlDollar = sqlS[sqlpt-0 : sqlpt+1]
-		//line sql.y:2239
+		//line sql.y:2491
 		{
 			sqlVAL.str = ""
 		}
-	case 496:
+	case 480:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:2246
+		//line sql.y:2498
 		{
 		}
-	case 497:
+	case 481:
 		sqlDollar = sqlS[sqlpt-4 : sqlpt+1]
-		//line sql.y:2247
+		//line sql.y:2499
 		{
 		}
-	case 498:
+	case 482:
 		sqlDollar = sqlS[sqlpt-5 : sqlpt+1]
-		//line sql.y:2248
+		//line sql.y:2500
 		{
 		}
-	case 499:
+	case 483:
 		sqlDollar = sqlS[sqlpt-4 : sqlpt+1]
-		//line sql.y:2249
+		//line sql.y:2501
 		{
 		}
-	case 500:
+	case 484:
 		sqlDollar = sqlS[sqlpt-0 : sqlpt+1]
-		//line sql.y:2250
+		//line sql.y:2502
 		{
 		}
-	case 501:
+	case 485:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:2254
+		//line sql.y:2506
 		{
 			sqlVAL.str = astFullJoin
 		}
-	case 502:
+	case 486:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:2258
+		//line sql.y:2510
 		{
 			sqlVAL.str = astLeftJoin
 		}
-	case 503:
+	case 487:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:2262
+		//line sql.y:2514
 		{
 			sqlVAL.str = astRightJoin
 		}
-	case 504:
+	case 488:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:2266
+		//line sql.y:2518
 		{
 			sqlVAL.str = astInnerJoin
 		}
-	case 505:
+	case 489:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:2272
+		//line sql.y:2524
 		{
 		}
-	case 506:
+	case 490:
 		sqlDollar = sqlS[sqlpt-0 : sqlpt+1]
-		//line sql.y:2273
+		//line sql.y:2525
 		{
 		}
-	case 507:
+	case 491:
 		sqlDollar = sqlS[sqlpt-4 : sqlpt+1]
-		//line sql.y:2284
+		//line sql.y:2536
 		{
 			sqlVAL.joinCond = &UsingJoinCond{Cols: NameList(sqlDollar[3].strs)}
 		}
-	case 508:
+	case 492:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:2288
+		//line sql.y:2540
 		{
 			sqlVAL.joinCond = &OnJoinCond{Expr: sqlDollar[2].expr}
 		}
-	case 509:
+	case 493:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:2294
+		//line sql.y:2546
 		{
 			sqlVAL.qname = sqlDollar[1].qname
 		}
-	case 510:
+	case 494:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:2298
+		//line sql.y:2550
 		{
 			// TODO(pmattis): Handle the "*".
 			sqlVAL.qname = sqlDollar[1].qname
 		}
-	case 511:
+	case 495:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:2303
+		//line sql.y:2555
 		{
 			// TODO(pmattis): Support ONLY.
 			sqlVAL.qname = sqlDollar[2].qname
 		}
-	case 512:
+	case 496:
 		sqlDollar = sqlS[sqlpt-4 : sqlpt+1]
-		//line sql.y:2308
+		//line sql.y:2560
 		{
 			// TODO(pmattis): Support ONLY.
 			sqlVAL.qname = sqlDollar[3].qname
 		}
-	case 513:
+	case 497:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:2315
+		//line sql.y:2567
 		{
 			sqlVAL.qnames = QualifiedNames{sqlDollar[1].qname}
 		}
-	case 514:
+	case 498:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:2319
+		//line sql.y:2571
 		{
 			sqlVAL.qnames = append(sqlDollar[1].qnames, sqlDollar[3].qname)
 		}
-	case 515:
+	case 499:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:2332
+		//line sql.y:2584
 		{
 			sqlVAL.tblExpr = &AliasedTableExpr{Expr: sqlDollar[1].qname}
 		}
-	case 516:
+	case 500:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:2336
+		//line sql.y:2588
 		{
 			sqlVAL.tblExpr = &AliasedTableExpr{Expr: sqlDollar[1].qname, As: Name(sqlDollar[2].str)}
 		}
-	case 517:
+	case 501:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:2340
+		//line sql.y:2592
 		{
 			sqlVAL.tblExpr = &AliasedTableExpr{Expr: sqlDollar[1].qname, As: Name(sqlDollar[3].str)}
 		}
-	case 518:
+	case 502:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:2354
+		//line sql.y:2606
 		{
 		}
-	case 519:
+	case 503:
 		sqlDollar = sqlS[sqlpt-6 : sqlpt+1]
-		//line sql.y:2355
+		//line sql.y:2607
 		{
 		}
-	case 520:
+	case 504:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:2358
+		//line sql.y:2610
 		{
 		}
-	case 521:
+	case 505:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:2361
+		//line sql.y:2613
 		{
 		}
-	case 522:
+	case 506:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:2362
+		//line sql.y:2614
 		{
 		}
-	case 523:
+	case 507:
 		sqlDollar = sqlS[sqlpt-4 : sqlpt+1]
-		//line sql.y:2365
+		//line sql.y:2617
 		{
 		}
-	case 524:
+	case 508:
 		sqlDollar = sqlS[sqlpt-0 : sqlpt+1]
-		//line sql.y:2366
+		//line sql.y:2618
 		{
 		}
-	case 525:
+	case 509:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:2369
+		//line sql.y:2621
 		{
 		}
-	case 526:
+	case 510:
 		sqlDollar = sqlS[sqlpt-0 : sqlpt+1]
-		//line sql.y:2370
+		//line sql.y:2622
 		{
 		}
-	case 527:
+	case 511:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:2374
+		//line sql.y:2626
 		{
 			sqlVAL.expr = sqlDollar[2].expr
 		}
-	case 528:
+	case 512:
 		sqlDollar = sqlS[sqlpt-0 : sqlpt+1]
-		//line sql.y:2378
+		//line sql.y:2630
 		{
 			sqlVAL.expr = nil
 		}
-	case 529:
+	case 513:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:2383
+		//line sql.y:2635
 		{
 		}
-	case 530:
+	case 514:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:2384
+		//line sql.y:2636
 		{
 		}
-	case 531:
+	case 515:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:2387
+		//line sql.y:2639
 		{
 		}
-	case 532:
+	case 516:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:2397
+		//line sql.y:2649
 		{
 			sqlVAL.colType = sqlDollar[1].colType
 		}
-	case 533:
+	case 517:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:2400
+		//line sql.y:2652
 		{
 		}
-	case 534:
+	case 518:
 		sqlDollar = sqlS[sqlpt-5 : sqlpt+1]
-		//line sql.y:2402
+		//line sql.y:2654
 		{
 		}
-	case 535:
+	case 519:
 		sqlDollar = sqlS[sqlpt-6 : sqlpt+1]
-		//line sql.y:2403
+		//line sql.y:2655
 		{
 		}
-	case 536:
+	case 520:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:2404
+		//line sql.y:2656
 		{
 		}
-	case 537:
+	case 521:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:2405
+		//line sql.y:2657
 		{
 		}
-	case 538:
+	case 522:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:2408
+		//line sql.y:2660
 		{
 		}
-	case 539:
+	case 523:
 		sqlDollar = sqlS[sqlpt-4 : sqlpt+1]
-		//line sql.y:2409
+		//line sql.y:2661
 		{
 		}
-	case 540:
+	case 524:
 		sqlDollar = sqlS[sqlpt-0 : sqlpt+1]
-		//line sql.y:2410
+		//line sql.y:2662
 		{
 		}
-	case 545:
+	case 529:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:2417
+		//line sql.y:2670
 		{
+			// TODO: Support the fields of the interval?
+			sqlVAL.colType = sqlDollar[1].colType
 		}
-	case 546:
+	case 530:
 		sqlDollar = sqlS[sqlpt-4 : sqlpt+1]
-		//line sql.y:2418
+		//line sql.y:2675
 		{
+			sqlVAL.colType = sqlDollar[1].colType
 		}
-	case 547:
+	case 531:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:2420
+		//line sql.y:2679
 		{
 			sqlVAL.colType = &BlobType{}
 		}
-	case 548:
+	case 532:
+		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
+		//line sql.y:2683
+		{
+			sqlVAL.colType = &BlobType{Name: astBytes}
+		}
+	case 533:
+		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
+		//line sql.y:2687
+		{
+			sqlVAL.colType = &BlobType{Name: astBytea}
+		}
+	case 534:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:2424
+		//line sql.y:2691
 		{
 			sqlVAL.colType = &TextType{}
 		}
-	case 553:
+	case 535:
+		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
+		//line sql.y:2695
+		{
+			sqlVAL.colType = &SerialType{Name: astSerial}
+		}
+	case 536:
+		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
+		//line sql.y:2699
+		{
+			sqlVAL.colType = &SerialType{Name: astSmallSerial}
+		}
+	case 537:
+		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
+		//line sql.y:2703
+		{
+			sqlVAL.colType = &SerialType{Name: astBigSerial}
+		}
+	case 542:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:2445
+		//line sql.y:2724
 		{
 			sqlVAL.colType = &DecimalType{Prec: sqlDollar[2].ival}
 		}
-	case 554:
+	case 543:
 		sqlDollar = sqlS[sqlpt-5 : sqlpt+1]
-		//line sql.y:2449
+		//line sql.y:2728
 		{
 			sqlVAL.colType = &DecimalType{Prec: sqlDollar[2].ival, Scale: sqlDollar[4].ival}
 		}
-	case 555:
+	case 544:
 		sqlDollar = sqlS[sqlpt-0 : sqlpt+1]
-		//line sql.y:2453
+		//line sql.y:2732
 		{
 			sqlVAL.colType = &DecimalType{}
 		}
-	case 556:
+	case 545:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:2460
+		//line sql.y:2739
 		{
 			sqlVAL.colType = &IntType{Name: astInt}
 		}
-	case 557:
+	case 546:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:2464
+		//line sql.y:2743
 		{
 			sqlVAL.colType = &IntType{Name: astInteger}
 		}
-	case 558:
+	case 547:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:2468
+		//line sql.y:2747
 		{
 			sqlVAL.colType = &IntType{Name: astSmallInt}
 		}
-	case 559:
+	case 548:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:2472
+		//line sql.y:2751
 		{
 			sqlVAL.colType = &IntType{Name: astBigInt}
 		}
-	case 560:
+	case 549:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:2476
+		//line sql.y:2755
 		{
 			sqlVAL.colType = &FloatType{Name: astReal}
 		}
-	case 561:
+	case 550:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:2480
+		//line sql.y:2759
 		{
 			sqlVAL.colType = &FloatType{Name: astFloat, Prec: sqlDollar[2].ival}
 		}
-	case 562:
+	case 551:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:2484
+		//line sql.y:2763
 		{
 			sqlVAL.colType = &FloatType{Name: astDouble}
 		}
-	case 563:
+	case 552:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:2488
+		//line sql.y:2767
 		{
 			sqlVAL.colType = sqlDollar[2].colType
 			sqlVAL.colType.(*DecimalType).Name = astDecimal
 		}
-	case 564:
+	case 553:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:2493
+		//line sql.y:2772
 		{
 			sqlVAL.colType = sqlDollar[2].colType
 			sqlVAL.colType.(*DecimalType).Name = astDecimal
 		}
-	case 565:
+	case 554:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:2498
+		//line sql.y:2777
 		{
 			sqlVAL.colType = sqlDollar[2].colType
 			sqlVAL.colType.(*DecimalType).Name = astNumeric
 		}
-	case 566:
+	case 555:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:2503
+		//line sql.y:2782
 		{
 			sqlVAL.colType = &BoolType{}
 		}
-	case 567:
+	case 556:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:2509
+		//line sql.y:2788
 		{
 			sqlVAL.ival = sqlDollar[2].ival
 		}
-	case 568:
+	case 557:
 		sqlDollar = sqlS[sqlpt-0 : sqlpt+1]
-		//line sql.y:2513
+		//line sql.y:2792
 		{
 			sqlVAL.ival = 0
 		}
-	case 573:
+	case 562:
 		sqlDollar = sqlS[sqlpt-5 : sqlpt+1]
-		//line sql.y:2531
+		//line sql.y:2810
 		{
 			sqlVAL.colType = &BitType{N: sqlDollar[4].ival}
 		}
-	case 574:
+	case 563:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:2537
+		//line sql.y:2816
 		{
 			sqlVAL.colType = &BitType{}
 		}
-	case 579:
+	case 568:
 		sqlDollar = sqlS[sqlpt-5 : sqlpt+1]
-		//line sql.y:2553
+		//line sql.y:2832
 		{
 			sqlVAL.colType = sqlDollar[1].colType
 			sqlVAL.colType.(*CharType).N = sqlDollar[3].ival
 		}
-	case 580:
+	case 569:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:2560
+		//line sql.y:2839
 		{
 			sqlVAL.colType = sqlDollar[1].colType
 		}
-	case 581:
+	case 570:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:2566
+		//line sql.y:2845
 		{
 			sqlVAL.colType = &CharType{Name: astChar}
 		}
-	case 582:
+	case 571:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:2570
+		//line sql.y:2849
 		{
 			sqlVAL.colType = &CharType{Name: astChar}
 		}
-	case 583:
+	case 572:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:2574
+		//line sql.y:2853
 		{
 			sqlVAL.colType = &CharType{Name: astVarChar}
 		}
-	case 584:
+	case 573:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:2579
+		//line sql.y:2858
 		{
 		}
-	case 585:
+	case 574:
 		sqlDollar = sqlS[sqlpt-0 : sqlpt+1]
-		//line sql.y:2580
+		//line sql.y:2859
 		{
 		}
-	case 586:
+	case 575:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:2583
+		//line sql.y:2862
 		{
 		}
-	case 587:
+	case 576:
 		sqlDollar = sqlS[sqlpt-0 : sqlpt+1]
-		//line sql.y:2584
+		//line sql.y:2863
 		{
 		}
-	case 588:
+	case 577:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:2589
+		//line sql.y:2868
 		{
 			sqlVAL.colType = &DateType{}
 		}
-	case 589:
+	case 578:
 		sqlDollar = sqlS[sqlpt-5 : sqlpt+1]
-		//line sql.y:2593
+		//line sql.y:2872
 		{
 			sqlVAL.colType = &TimestampType{}
 		}
-	case 590:
+	case 579:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:2597
+		//line sql.y:2876
 		{
 			sqlVAL.colType = &TimestampType{}
 		}
-	case 591:
+	case 580:
 		sqlDollar = sqlS[sqlpt-5 : sqlpt+1]
-		//line sql.y:2601
+		//line sql.y:2880
 		{
 			sqlVAL.colType = &TimeType{}
 		}
-	case 592:
+	case 581:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:2605
+		//line sql.y:2884
 		{
 			sqlVAL.colType = &TimeType{}
 		}
-	case 593:
+	case 582:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:2610
+		//line sql.y:2890
 		{
+			sqlVAL.colType = &IntervalType{}
 		}
-	case 594:
+	case 583:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:2613
+		//line sql.y:2895
 		{
 		}
-	case 595:
+	case 584:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:2614
+		//line sql.y:2896
 		{
 		}
-	case 596:
+	case 585:
 		sqlDollar = sqlS[sqlpt-0 : sqlpt+1]
-		//line sql.y:2615
+		//line sql.y:2897
 		{
 		}
-	case 597:
+	case 586:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:2618
+		//line sql.y:2900
 		{
 		}
-	case 598:
+	case 587:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:2619
+		//line sql.y:2901
 		{
 		}
-	case 599:
+	case 588:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:2620
+		//line sql.y:2902
 		{
 		}
-	case 600:
+	case 589:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:2621
+		//line sql.y:2903
 		{
 		}
-	case 601:
+	case 590:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:2622
+		//line sql.y:2904
 		{
 		}
-	case 602:
+	case 591:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:2623
+		//line sql.y:2905
 		{
 		}
-	case 603:
+	case 592:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:2624
+		//line sql.y:2906
 		{
 		}
-	case 604:
+	case 593:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:2625
+		//line sql.y:2907
 		{
 		}
-	case 605:
+	case 594:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:2626
+		//line sql.y:2908
 		{
 		}
-	case 606:
+	case 595:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:2627
+		//line sql.y:2909
 		{
 		}
-	case 607:
+	case 596:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:2628
+		//line sql.y:2910
 		{
 		}
-	case 608:
+	case 597:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:2629
+		//line sql.y:2911
 		{
 		}
-	case 609:
+	case 598:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:2630
+		//line sql.y:2912
 		{
 		}
-	case 610:
+	case 599:
 		sqlDollar = sqlS[sqlpt-0 : sqlpt+1]
-		//line sql.y:2631
+		//line sql.y:2913
 		{
 		}
-	case 611:
+	case 600:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:2634
+		//line sql.y:2916
 		{
 		}
-	case 612:
+	case 601:
 		sqlDollar = sqlS[sqlpt-4 : sqlpt+1]
-		//line sql.y:2635
+		//line sql.y:2917
 		{
 		}
-	case 614:
+	case 603:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:2659
+		//line sql.y:2941
 		{
 			sqlVAL.expr = &CastExpr{Expr: sqlDollar[1].expr, Type: sqlDollar[3].colType}
 		}
-	case 615:
+	case 604:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:2662
+		//line sql.y:2944
 		{
 		}
-	case 616:
+	case 605:
 		sqlDollar = sqlS[sqlpt-5 : sqlpt+1]
-		//line sql.y:2663
+		//line sql.y:2945
 		{
 		}
-	case 617:
+	case 606:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:2672
+		//line sql.y:2954
 		{
 			sqlVAL.expr = &UnaryExpr{Operator: UnaryPlus, Expr: sqlDollar[2].expr}
 		}
-	case 618:
+	case 607:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:2676
+		//line sql.y:2958
 		{
 			sqlVAL.expr = &UnaryExpr{Operator: UnaryMinus, Expr: sqlDollar[2].expr}
 		}
-	case 619:
+	case 608:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:2680
+		//line sql.y:2962
 		{
 			sqlVAL.expr = &UnaryExpr{Operator: UnaryComplement, Expr: sqlDollar[2].expr}
 		}
-	case 620:
+	case 609:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:2684
+		//line sql.y:2966
 		{
 			sqlVAL.expr = &BinaryExpr{Operator: Plus, Left: sqlDollar[1].expr, Right: sqlDollar[3].expr}
 		}
-	case 621:
+	case 610:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:2688
+		//line sql.y:2970
 		{
 			sqlVAL.expr = &BinaryExpr{Operator: Minus, Left: sqlDollar[1].expr, Right: sqlDollar[3].expr}
 		}
-	case 622:
+	case 611:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:2692
+		//line sql.y:2974
 		{
 			sqlVAL.expr = &BinaryExpr{Operator: Mult, Left: sqlDollar[1].expr, Right: sqlDollar[3].expr}
 		}
-	case 623:
+	case 612:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:2696
+		//line sql.y:2978
 		{
 			sqlVAL.expr = &BinaryExpr{Operator: Div, Left: sqlDollar[1].expr, Right: sqlDollar[3].expr}
 		}
-	case 624:
+	case 613:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:2700
+		//line sql.y:2982
 		{
 			sqlVAL.expr = &BinaryExpr{Operator: Mod, Left: sqlDollar[1].expr, Right: sqlDollar[3].expr}
 		}
-	case 625:
+	case 614:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:2704
+		//line sql.y:2986
 		{
 			sqlVAL.expr = &BinaryExpr{Operator: Exp, Left: sqlDollar[1].expr, Right: sqlDollar[3].expr}
 		}
-	case 626:
+	case 615:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:2708
+		//line sql.y:2990
 		{
 			sqlVAL.expr = &BinaryExpr{Operator: Bitand, Left: sqlDollar[1].expr, Right: sqlDollar[3].expr}
 		}
-	case 627:
+	case 616:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:2712
+		//line sql.y:2994
 		{
 			sqlVAL.expr = &BinaryExpr{Operator: Bitor, Left: sqlDollar[1].expr, Right: sqlDollar[3].expr}
 		}
-	case 628:
+	case 617:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:2716
+		//line sql.y:2998
 		{
 			sqlVAL.expr = &BinaryExpr{Operator: Bitxor, Left: sqlDollar[1].expr, Right: sqlDollar[3].expr}
 		}
-	case 629:
+	case 618:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:2720
+		//line sql.y:3002
 		{
 			sqlVAL.expr = &ComparisonExpr{Operator: LT, Left: sqlDollar[1].expr, Right: sqlDollar[3].expr}
 		}
-	case 630:
+	case 619:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:2724
+		//line sql.y:3006
 		{
 			sqlVAL.expr = &ComparisonExpr{Operator: GT, Left: sqlDollar[1].expr, Right: sqlDollar[3].expr}
 		}
-	case 631:
+	case 620:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:2728
+		//line sql.y:3010
 		{
 			sqlVAL.expr = &ComparisonExpr{Operator: EQ, Left: sqlDollar[1].expr, Right: sqlDollar[3].expr}
 		}
-	case 632:
+	case 621:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:2732
+		//line sql.y:3014
 		{
 			sqlVAL.expr = &BinaryExpr{Operator: Concat, Left: sqlDollar[1].expr, Right: sqlDollar[3].expr}
 		}
-	case 633:
+	case 622:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:2736
+		//line sql.y:3018
 		{
 			sqlVAL.expr = &ComparisonExpr{Operator: LE, Left: sqlDollar[1].expr, Right: sqlDollar[3].expr}
 		}
-	case 634:
+	case 623:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:2740
+		//line sql.y:3022
 		{
 			sqlVAL.expr = &ComparisonExpr{Operator: GE, Left: sqlDollar[1].expr, Right: sqlDollar[3].expr}
 		}
-	case 635:
+	case 624:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:2744
+		//line sql.y:3026
 		{
 			sqlVAL.expr = &ComparisonExpr{Operator: NE, Left: sqlDollar[1].expr, Right: sqlDollar[3].expr}
 		}
-	case 636:
+	case 625:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:2748
+		//line sql.y:3030
 		{
 			sqlVAL.expr = &AndExpr{Left: sqlDollar[1].expr, Right: sqlDollar[3].expr}
 		}
-	case 637:
+	case 626:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:2752
+		//line sql.y:3034
 		{
 			sqlVAL.expr = &OrExpr{Left: sqlDollar[1].expr, Right: sqlDollar[3].expr}
 		}
-	case 638:
+	case 627:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:2756
+		//line sql.y:3038
 		{
 			sqlVAL.expr = &NotExpr{Expr: sqlDollar[2].expr}
 		}
-	case 639:
+	case 628:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:2760
+		//line sql.y:3042
 		{
 			sqlVAL.expr = &NotExpr{Expr: sqlDollar[2].expr}
 		}
-	case 640:
+	case 629:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:2764
+		//line sql.y:3046
 		{
 			sqlVAL.expr = &ComparisonExpr{Operator: Like, Left: sqlDollar[1].expr, Right: sqlDollar[3].expr}
 		}
-	case 641:
+	case 630:
 		sqlDollar = sqlS[sqlpt-5 : sqlpt+1]
-		//line sql.y:2768
+		//line sql.y:3050
 		{
-			sqlVAL.expr = &ComparisonExpr{Operator: Like, Left: sqlDollar[1].expr, Right: sqlDollar[3].expr}
+			sqlVAL.expr = &ComparisonExpr{Operator: Like, Left: sqlDollar[1].expr, Right: sqlDollar[3].expr, Escape: sqlDollar[5].expr}
 		}
-	case 642:
+	case 631:
 		sqlDollar = sqlS[sqlpt-4 : sqlpt+1]
-		//line sql.y:2772
+		//line sql.y:3054
 		{
 			sqlVAL.expr = &ComparisonExpr{Operator: NotLike, Left: sqlDollar[1].expr, Right: sqlDollar[4].expr}
 		}
-	case 643:
+	case 632:
 		sqlDollar = sqlS[sqlpt-6 : sqlpt+1]
-		//line sql.y:2776
+		//line sql.y:3058
 		{
-			sqlVAL.expr = &ComparisonExpr{Operator: NotLike, Left: sqlDollar[1].expr, Right: sqlDollar[4].expr}
+			sqlVAL.expr = &ComparisonExpr{Operator: NotLike, Left: sqlDollar[1].expr, Right: sqlDollar[4].expr, Escape: sqlDollar[6].expr}
 		}
-	case 644:
+	case 633:
+		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
+		//line sql.y:3062
+		{
+			sqlVAL.expr = &ComparisonExpr{Operator: ILike, Left: sqlDollar[1].expr, Right: sqlDollar[3].expr}
+		}
+	case 634:
+		sqlDollar = sqlS[sqlpt-5 : sqlpt+1]
+		//line sql.y:3066
+		{
+			sqlVAL.expr = &ComparisonExpr{Operator: ILike, Left: sqlDollar[1].expr, Right: sqlDollar[3].expr, Escape: sqlDollar[5].expr}
+		}
+	case 635:
 		sqlDollar = sqlS[sqlpt-4 : sqlpt+1]
-		//line sql.y:2779
+		//line sql.y:3070
 		{
+			sqlVAL.expr = &ComparisonExpr{Operator: NotILike, Left: sqlDollar[1].expr, Right: sqlDollar[4].expr}
 		}
-	case 645:
+	case 636:
 		sqlDollar = sqlS[sqlpt-6 : sqlpt+1]
-		//line sql.y:2780
+		//line sql.y:3074
 		{
+			sqlVAL.expr = &ComparisonExpr{Operator: NotILike, Left: sqlDollar[1].expr, Right: sqlDollar[4].expr, Escape: sqlDollar[6].expr}
 		}
-	case 646:
+	case 637:
+		sqlDollar = sqlS[sqlpt-4 : sqlpt+1]
+		//line sql.y:3078
+		{
+			sqlVAL.expr = &ComparisonExpr{Operator: SimilarTo, Left: sqlDollar[1].expr, Right: sqlDollar[4].expr}
+		}
+	case 638:
+		sqlDollar = sqlS[sqlpt-6 : sqlpt+1]
+		//line sql.y:3082
+		{
+			sqlVAL.expr = &ComparisonExpr{Operator: SimilarTo, Left: sqlDollar[1].expr, Right: sqlDollar[4].expr, Escape: sqlDollar[6].expr}
+		}
+	case 639:
 		sqlDollar = sqlS[sqlpt-5 : sqlpt+1]
-		//line sql.y:2781
+		//line sql.y:3086
 		{
+			sqlVAL.expr = &ComparisonExpr{Operator: NotSimilarTo, Left: sqlDollar[1].expr, Right: sqlDollar[5].expr}
 		}
-	case 647:
+	case 640:
 		sqlDollar = sqlS[sqlpt-7 : sqlpt+1]
-		//line sql.y:2782
+		//line sql.y:3090
 		{
+			sqlVAL.expr = &ComparisonExpr{Operator: NotSimilarTo, Left: sqlDollar[1].expr, Right: sqlDollar[5].expr, Escape: sqlDollar[7].expr}
 		}
-	case 648:
+	case 641:
+		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
+		//line sql.y:3094
+		{
+			sqlVAL.expr = &ComparisonExpr{Operator: RegMatch, Left: sqlDollar[1].expr, Right: sqlDollar[3].expr}
+		}
+	case 642:
+		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
+		//line sql.y:3098
+		{
+			sqlVAL.expr = &ComparisonExpr{Operator: NotRegMatch, Left: sqlDollar[1].expr, Right: sqlDollar[3].expr}
+		}
+	case 643:
+		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
+		//line sql.y:3102
+		{
+			sqlVAL.expr = &ComparisonExpr{Operator: RegIMatch, Left: sqlDollar[1].expr, Right: sqlDollar[3].expr}
+		}
+	case 644:
+		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
+		//line sql.y:3106
+		{
+			sqlVAL.expr = &ComparisonExpr{Operator: NotRegIMatch, Left: sqlDollar[1].expr, Right: sqlDollar[3].expr}
+		}
+	case 645:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:2784
+		//line sql.y:3110
 		{
 			sqlVAL.expr = &NullCheck{Expr: sqlDollar[1].expr}
 		}
-	case 649:
+	case 646:
 		sqlDollar = sqlS[sqlpt-4 : sqlpt+1]
-		//line sql.y:2788
+		//line sql.y:3114
 		{
 			sqlVAL.expr = &NullCheck{Not: true, Expr: sqlDollar[1].expr}
 		}
-	case 650:
+	case 647:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:2791
+		//line sql.y:3117
 		{
 		}
-	case 651:
+	case 648:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:2792
+		//line sql.y:3118
 		{
 		}
-	case 652:
+	case 649:
 		sqlDollar = sqlS[sqlpt-4 : sqlpt+1]
-		//line sql.y:2793
+		//line sql.y:3119
 		{
 		}
-	case 653:
+	case 650:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:2794
+		//line sql.y:3120
 		{
 		}
-	case 654:
+	case 651:
 		sqlDollar = sqlS[sqlpt-4 : sqlpt+1]
-		//line sql.y:2795
+		//line sql.y:3121
 		{
 		}
-	case 655:
+	case 652:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:2796
+		//line sql.y:3122
 		{
 		}
-	case 656:
+	case 653:
 		sqlDollar = sqlS[sqlpt-4 : sqlpt+1]
-		//line sql.y:2797
+		//line sql.y:3123
 		{
 		}
-	case 657:
+	case 654:
 		sqlDollar = sqlS[sqlpt-5 : sqlpt+1]
-		//line sql.y:2798
+		//line sql.y:3124
 		{
 		}
-	case 658:
+	case 655:
 		sqlDollar = sqlS[sqlpt-6 : sqlpt+1]
-		//line sql.y:2799
+		//line sql.y:3125
 		{
 		}
-	case 659:
+	case 656:
 		sqlDollar = sqlS[sqlpt-6 : sqlpt+1]
-		//line sql.y:2800
+		//line sql.y:3126
 		{
 		}
-	case 660:
+	case 657:
 		sqlDollar = sqlS[sqlpt-7 : sqlpt+1]
-		//line sql.y:2801
+		//line sql.y:3127
 		{
 		}
-	case 661:
+	case 658:
 		sqlDollar = sqlS[sqlpt-6 : sqlpt+1]
-		//line sql.y:2803
+		//line sql.y:3129
 		{
 			sqlVAL.expr = &RangeCond{Left: sqlDollar[1].expr, From: sqlDollar[4].expr, To: sqlDollar[6].expr}
 		}
-	case 662:
+	case 659:
 		sqlDollar = sqlS[sqlpt-7 : sqlpt+1]
-		//line sql.y:2807
+		//line sql.y:3133
 		{
 			sqlVAL.expr = &RangeCond{Not: true, Left: sqlDollar[1].expr, From: sqlDollar[5].expr, To: sqlDollar[7].expr}
 		}
-	case 663:
+	case 660:
 		sqlDollar = sqlS[sqlpt-6 : sqlpt+1]
-		//line sql.y:2811
+		//line sql.y:3137
 		{
 			sqlVAL.expr = &RangeCond{Left: sqlDollar[1].expr, From: sqlDollar[4].expr, To: sqlDollar[6].expr}
 		}
-	case 664:
+	case 661:
 		sqlDollar = sqlS[sqlpt-7 : sqlpt+1]
-		//line sql.y:2815
+		//line sql.y:3141
 		{
 			sqlVAL.expr = &RangeCond{Not: true, Left: sqlDollar[1].expr, From: sqlDollar[5].expr, To: sqlDollar[7].expr}
 		}
-	case 665:
+	case 662:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:2819
+		//line sql.y:3145
 		{
 			sqlVAL.expr = &ComparisonExpr{Operator: In, Left: sqlDollar[1].expr, Right: sqlDollar[3].expr}
 		}
-	case 666:
+	case 663:
 		sqlDollar = sqlS[sqlpt-4 : sqlpt+1]
-		//line sql.y:2823
+		//line sql.y:3149
 		{
 			sqlVAL.expr = &ComparisonExpr{Operator: NotIn, Left: sqlDollar[1].expr, Right: sqlDollar[4].expr}
 		}
-	case 667:
+	case 664:
 		sqlDollar = sqlS[sqlpt-4 : sqlpt+1]
-		//line sql.y:2826
+		//line sql.y:3152
 		{
 		}
-	case 668:
+	case 665:
 		sqlDollar = sqlS[sqlpt-6 : sqlpt+1]
-		//line sql.y:2827
+		//line sql.y:3153
 		{
 		}
-	case 669:
+	case 666:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:2828
+		//line sql.y:3154
 		{
 		}
-	case 670:
+	case 667:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:2829
+		//line sql.y:3155
 		{
 		}
-	case 671:
+	case 668:
 		sqlDollar = sqlS[sqlpt-4 : sqlpt+1]
-		//line sql.y:2830
+		//line sql.y:3156
 		{
 		}
-	case 673:
+	case 670:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:2842
+		//line sql.y:3168
 		{
 			sqlVAL.expr = &CastExpr{Expr: sqlDollar[1].expr, Type: sqlDollar[3].colType}
 		}
-	case 674:
+	case 671:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:2846
+		//line sql.y:3172
 		{
 			sqlVAL.expr = &UnaryExpr{Operator: UnaryPlus, Expr: sqlDollar[2].expr}
 		}
-	case 675:
+	case 672:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:2850
+		//line sql.y:3176
 		{
 			sqlVAL.expr = &UnaryExpr{Operator: UnaryMinus, Expr: sqlDollar[2].expr}
 		}
-	case 676:
+	case 673:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:2854
+		//line sql.y:3180
 		{
 			sqlVAL.expr = &UnaryExpr{Operator: UnaryComplement, Expr: sqlDollar[2].expr}
 		}
-	case 677:
+	case 674:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:2858
+		//line sql.y:3184
 		{
 			sqlVAL.expr = &BinaryExpr{Operator: Plus, Left: sqlDollar[1].expr, Right: sqlDollar[3].expr}
 		}
-	case 678:
+	case 675:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:2862
+		//line sql.y:3188
 		{
 			sqlVAL.expr = &BinaryExpr{Operator: Minus, Left: sqlDollar[1].expr, Right: sqlDollar[3].expr}
 		}
-	case 679:
+	case 676:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:2866
+		//line sql.y:3192
 		{
 			sqlVAL.expr = &BinaryExpr{Operator: Mult, Left: sqlDollar[1].expr, Right: sqlDollar[3].expr}
 		}
-	case 680:
+	case 677:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:2870
+		//line sql.y:3196
 		{
 			sqlVAL.expr = &BinaryExpr{Operator: Div, Left: sqlDollar[1].expr, Right: sqlDollar[3].expr}
 		}
-	case 681:
+	case 678:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:2874
+		//line sql.y:3200
 		{
 			sqlVAL.expr = &BinaryExpr{Operator: Mod, Left: sqlDollar[1].expr, Right: sqlDollar[3].expr}
 		}
-	case 682:
+	case 679:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:2878
+		//line sql.y:3204
 		{
 			sqlVAL.expr = &BinaryExpr{Operator: Exp, Left: sqlDollar[1].expr, Right: sqlDollar[3].expr}
 		}
-	case 683:
+	case 680:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:2882
+		//line sql.y:3208
 		{
 			sqlVAL.expr = &BinaryExpr{Operator: Bitand, Left: sqlDollar[1].expr, Right: sqlDollar[3].expr}
 		}
-	case 684:
+	case 681:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:2886
+		//line sql.y:3212
 		{
 			sqlVAL.expr = &BinaryExpr{Operator: Bitor, Left: sqlDollar[1].expr, Right: sqlDollar[3].expr}
 		}
-	case 685:
+	case 682:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:2890
+		//line sql.y:3216
 		{
 			sqlVAL.expr = &BinaryExpr{Operator: Bitxor, Left: sqlDollar[1].expr, Right: sqlDollar[3].expr}
 		}
-	case 686:
+	case 683:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:2894
+		//line sql.y:3220
 		{
 			sqlVAL.expr = &ComparisonExpr{Operator: LT, Left: sqlDollar[1].expr, Right: sqlDollar[3].expr}
 		}
-	case 687:
+	case 684:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:2898
+		//line sql.y:3224
 		{
 			sqlVAL.expr = &ComparisonExpr{Operator: GT, Left: sqlDollar[1].expr, Right: sqlDollar[3].expr}
 		}
-	case 688:
+	case 685:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:2902
+		//line sql.y:3228
 		{
 			sqlVAL.expr = &ComparisonExpr{Operator: EQ, Left: sqlDollar[1].expr, Right: sqlDollar[3].expr}
 		}
-	case 689:
+	case 686:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:2906
+		//line sql.y:3232
 		{
 			sqlVAL.expr = &BinaryExpr{Operator: Concat, Left: sqlDollar[1].expr, Right: sqlDollar[3].expr}
 		}
-	case 690:
+	case 687:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:2910
+		//line sql.y:3236
 		{
 			sqlVAL.expr = &ComparisonExpr{Operator: LE, Left: sqlDollar[1].expr, Right: sqlDollar[3].expr}
 		}
-	case 691:
+	case 688:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:2914
+		//line sql.y:3240
 		{
 			sqlVAL.expr = &ComparisonExpr{Operator: GE, Left: sqlDollar[1].expr, Right: sqlDollar[3].expr}
 		}
-	case 692:
+	case 689:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:2918
+		//line sql.y:3244
 		{
 			sqlVAL.expr = &ComparisonExpr{Operator: NE, Left: sqlDollar[1].expr, Right: sqlDollar[3].expr}
 		}
-	case 693:
+	case 690:
 		sqlDollar = sqlS[sqlpt-5 : sqlpt+1]
-		//line sql.y:2921
+		//line sql.y:3247
 		{
 		}
-	case 694:
+	case 691:
 		sqlDollar = sqlS[sqlpt-6 : sqlpt+1]
-		//line sql.y:2922
+		//line sql.y:3248
 		{
 		}
-	case 695:
+	case 692:
 		sqlDollar = sqlS[sqlpt-6 : sqlpt+1]
-		//line sql.y:2923
+		//line sql.y:3249
 		{
 		}
-	case 696:
+	case 693:
 		sqlDollar = sqlS[sqlpt-7 : sqlpt+1]
-		//line sql.y:2924
+		//line sql.y:3250
 		{
 		}
-	case 697:
+	case 694:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:2925
+		//line sql.y:3251
 		{
 		}
-	case 698:
+	case 695:
 		sqlDollar = sqlS[sqlpt-4 : sqlpt+1]
-		//line sql.y:2926
+		//line sql.y:3252
 		{
 		}
-	case 699:
+	case 696:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:2936
+		//line sql.y:3262
 		{
 			sqlVAL.expr = sqlDollar[1].qname
 		}
-	case 701:
+	case 698:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:2941
+		//line sql.y:3267
 		{
 			sqlVAL.expr = ValArg(sqlDollar[1].ival)
 		}
-	case 702:
+	case 699:
 		sqlDollar = sqlS[sqlpt-4 : sqlpt+1]
-		//line sql.y:2945
+		//line sql.y:3271
 		{
 			sqlVAL.expr = &ParenExpr{Expr: sqlDollar[2].expr}
 		}
-	case 705:
+	case 702:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:2951
+		//line sql.y:3277
 		{
 			sqlVAL.expr = &Subquery{Select: sqlDollar[1].stmt.(SelectStatement)}
 		}
-	case 706:
+	case 703:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:2955
+		//line sql.y:3281
 		{
 			sqlVAL.expr = &Subquery{Select: sqlDollar[1].stmt.(SelectStatement)}
 		}
-	case 707:
+	case 704:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:2959
+		//line sql.y:3285
 		{
 			sqlVAL.expr = &ExistsExpr{Subquery: &Subquery{Select: sqlDollar[2].stmt.(SelectStatement)}}
 		}
-	case 708:
+	case 705:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:2962
+		//line sql.y:3288
 		{
 		}
-	case 709:
+	case 706:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:2963
+		//line sql.y:3289
 		{
 		}
-	case 710:
+	case 707:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:2965
+		//line sql.y:3291
 		{
 			sqlVAL.expr = sqlDollar[1].expr
 		}
-	case 711:
+	case 708:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:2969
+		//line sql.y:3295
 		{
 			sqlVAL.expr = sqlDollar[1].expr
 		}
-	case 712:
+	case 709:
 		sqlDollar = sqlS[sqlpt-4 : sqlpt+1]
-		//line sql.y:2972
+		//line sql.y:3298
 		{
 		}
-	case 713:
+	case 710:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:2976
+		//line sql.y:3302
 		{
 			sqlVAL.expr = &FuncExpr{Name: sqlDollar[1].qname}
 		}
-	case 714:
+	case 711:
 		sqlDollar = sqlS[sqlpt-5 : sqlpt+1]
-		//line sql.y:2980
+		//line sql.y:3306
 		{
 			// TODO(pmattis): Support opt_sort_clause or remove it?
 			sqlVAL.expr = &FuncExpr{Name: sqlDollar[1].qname, Exprs: sqlDollar[3].exprs}
 		}
-	case 715:
+	case 712:
 		sqlDollar = sqlS[sqlpt-6 : sqlpt+1]
-		//line sql.y:2985
+		//line sql.y:3311
 		{
 			panic("TODO(pmattis): unimplemented)")
 		}
-	case 716:
+	case 713:
 		sqlDollar = sqlS[sqlpt-8 : sqlpt+1]
-		//line sql.y:2989
+		//line sql.y:3315
 		{
 			panic("TODO(pmattis): unimplemented)")
 		}
-	case 717:
+	case 714:
 		sqlDollar = sqlS[sqlpt-6 : sqlpt+1]
-		//line sql.y:2993
+		//line sql.y:3319
 		{
 			panic("TODO(pmattis): unimplemented)")
 		}
-	case 718:
+	case 715:
 		sqlDollar = sqlS[sqlpt-6 : sqlpt+1]
-		//line sql.y:2997
+		//line sql.y:3323
 		{
 			// TODO(pmattis): Support opt_sort_clause or remove it?
 			sqlVAL.expr = &FuncExpr{Name: sqlDollar[1].qname, Distinct: true, Exprs: sqlDollar[4].exprs}
 		}
-	case 719:
+	case 716:
 		sqlDollar = sqlS[sqlpt-4 : sqlpt+1]
-		//line sql.y:3002
+		//line sql.y:3328
 		{
 			sqlVAL.expr = &FuncExpr{Name: sqlDollar[1].qname, Exprs: Exprs{&StarExpr{}}}
 		}
-	case 720:
+	case 717:
 		sqlDollar = sqlS[sqlpt-4 : sqlpt+1]
-		//line sql.y:3015
+		//line sql.y:3341
 		{
 			// TODO(pmattis): Support within_group_clause, filter_clause and
 			// over_clause?
 			sqlVAL.expr = sqlDollar[1].expr
 		}
-	case 721:
+	case 718:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:3021
+		//line sql.y:3347
 		{
 			sqlVAL.expr = sqlDollar[1].expr
 		}
+	case 719:
+		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
+		//line sql.y:3356
+		{
+		}
+	case 720:
+		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
+		//line sql.y:3357
+		{
+		}
+	case 721:
+		sqlDollar = sqlS[sqlpt-5 : sqlpt+1]
+		//line sql.y:3361
+		{
+		}
 	case 722:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:3030
+		//line sql.y:3363
 		{
+			sqlVAL.expr = &CastExpr{Expr: nowFuncExpr(), Type: &DateType{}}
 		}
 	case 723:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:3031
+		//line sql.y:3367
 		{
+			sqlVAL.expr = &CastExpr{Expr: nowFuncExpr(), Type: &TimeType{}}
 		}
 	case 724:
-		sqlDollar = sqlS[sqlpt-5 : sqlpt+1]
-		//line sql.y:3035
+		sqlDollar = sqlS[sqlpt-4 : sqlpt+1]
+		//line sql.y:3371
 		{
+			sqlVAL.expr = &CastExpr{Expr: nowFuncExpr(), Type: &TimeType{}}
 		}
 	case 725:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:3036
+		//line sql.y:3375
 		{
+			sqlVAL.expr = nowFuncExpr()
 		}
 	case 726:
-		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:3037
+		sqlDollar = sqlS[sqlpt-4 : sqlpt+1]
+		//line sql.y:3379
 		{
+			sqlVAL.expr = nowFuncExpr()
 		}
 	case 727:
-		sqlDollar = sqlS[sqlpt-4 : sqlpt+1]
-		//line sql.y:3038
+		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
+		//line sql.y:3383
 		{
+			sqlVAL.expr = &CastExpr{Expr: nowFuncExpr(), Type: &TimeType{}}
 		}
 	case 728:
-		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:3039
+		sqlDollar = sqlS[sqlpt-4 : sqlpt+1]
+		//line sql.y:3387
 		{
+			sqlVAL.expr = &CastExpr{Expr: nowFuncExpr(), Type: &TimeType{}}
 		}
 	case 729:
-		sqlDollar = sqlS[sqlpt-4 : sqlpt+1]
-		//line sql.y:3040
+		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
+		//line sql.y:3391
 		{
+			sqlVAL.expr = nowFuncExpr()
 		}
 	case 730:
-		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:3041
+		sqlDollar = sqlS[sqlpt-4 : sqlpt+1]
+		//line sql.y:3395
 		{
+			sqlVAL.expr = nowFuncExpr()
 		}
 	case 731:
-		sqlDollar = sqlS[sqlpt-4 : sqlpt+1]
-		//line sql.y:3042
+		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
+		//line sql.y:3398
 		{
 		}
 	case 732:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:3043
+		//line sql.y:3399
 		{
 		}
 	case 733:
-		sqlDollar = sqlS[sqlpt-4 : sqlpt+1]
-		//line sql.y:3044
+		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
+		//line sql.y:3400
 		{
 		}
 	case 734:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:3045
+		//line sql.y:3401
 		{
 		}
 	case 735:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:3046
+		//line sql.y:3402
 		{
 		}
 	case 736:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:3047
+		//line sql.y:3403
 		{
 		}
 	case 737:
-		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:3048
+		sqlDollar = sqlS[sqlpt-6 : sqlpt+1]
+		//line sql.y:3405
 		{
+			sqlVAL.expr = &CastExpr{Expr: sqlDollar[3].expr, Type: sqlDollar[5].colType}
 		}
 	case 738:
-		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:3049
+		sqlDollar = sqlS[sqlpt-4 : sqlpt+1]
+		//line sql.y:3409
 		{
+			sqlVAL.expr = &FuncExpr{Name: &QualifiedName{Base: Name("extract")}, Exprs: sqlDollar[3].exprs}
 		}
 	case 739:
-		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:3050
+		sqlDollar = sqlS[sqlpt-4 : sqlpt+1]
+		//line sql.y:3412
 		{
 		}
 	case 740:
-		sqlDollar = sqlS[sqlpt-6 : sqlpt+1]
-		//line sql.y:3052
+		sqlDollar = sqlS[sqlpt-4 : sqlpt+1]
+		//line sql.y:3414
 		{
-			sqlVAL.expr = &CastExpr{Expr: sqlDollar[3].expr, Type: sqlDollar[5].colType}
+			sqlVAL.expr = &FuncExpr{Name: &QualifiedName{Base: Name("strpos")}, Exprs: sqlDollar[3].exprs}
 		}
 	case 741:
 		sqlDollar = sqlS[sqlpt-4 : sqlpt+1]
-		//line sql.y:3055
+		//line sql.y:3418
 		{
+			sqlVAL.expr = &FuncExpr{Name: &QualifiedName{Base: Name("substring")}, Exprs: sqlDollar[3].exprs}
 		}
 	case 742:
-		sqlDollar = sqlS[sqlpt-4 : sqlpt+1]
-		//line sql.y:3056
+		sqlDollar = sqlS[sqlpt-6 : sqlpt+1]
+		//line sql.y:3421
 		{
 		}
 	case 743:
-		sqlDollar = sqlS[sqlpt-4 : sqlpt+1]
-		//line sql.y:3057
+		sqlDollar = sqlS[sqlpt-5 : sqlpt+1]
+		//line sql.y:3423
 		{
+			sqlVAL.expr = &FuncExpr{Name: &QualifiedName{Base: Name("btrim")}, Exprs: sqlDollar[4].exprs}
 		}
 	case 744:
-		sqlDollar = sqlS[sqlpt-4 : sqlpt+1]
-		//line sql.y:3058
+		sqlDollar = sqlS[sqlpt-5 : sqlpt+1]
+		//line sql.y:3427
 		{
+			sqlVAL.expr = &FuncExpr{Name: &QualifiedName{Base: Name("ltrim")}, Exprs: sqlDollar[4].exprs}
 		}
 	case 745:
-		sqlDollar = sqlS[sqlpt-6 : sqlpt+1]
-		//line sql.y:3059
+		sqlDollar = sqlS[sqlpt-5 : sqlpt+1]
+		//line sql.y:3431
 		{
+			sqlVAL.expr = &FuncExpr{Name: &QualifiedName{Base: Name("rtrim")}, Exprs: sqlDollar[4].exprs}
 		}
 	case 746:
-		sqlDollar = sqlS[sqlpt-5 : sqlpt+1]
-		//line sql.y:3060
+		sqlDollar = sqlS[sqlpt-4 : sqlpt+1]
+		//line sql.y:3435
 		{
+			sqlVAL.expr = &FuncExpr{Name: &QualifiedName{Base: Name("btrim")}, Exprs: sqlDollar[3].exprs}
 		}
 	case 747:
-		sqlDollar = sqlS[sqlpt-5 : sqlpt+1]
-		//line sql.y:3061
+		sqlDollar = sqlS[sqlpt-6 : sqlpt+1]
+		//line sql.y:3439
 		{
+			sqlVAL.expr = &FuncExpr{Name: &QualifiedName{Base: Name("nullif")}, Exprs: Exprs{sqlDollar[3].expr, sqlDollar[5].expr}}
 		}
 	case 748:
-		sqlDollar = sqlS[sqlpt-5 : sqlpt+1]
-		//line sql.y:3062
+		sqlDollar = sqlS[sqlpt-4 : sqlpt+1]
+		//line sql.y:3443
 		{
+			sqlVAL.expr = &FuncExpr{Name: &QualifiedName{Base: Name("coalesce")}, Exprs: sqlDollar[3].exprs}
 		}
 	case 749:
 		sqlDollar = sqlS[sqlpt-4 : sqlpt+1]
-		//line sql.y:3063
+		//line sql.y:3447
 		{
+			sqlVAL.expr = &FuncExpr{Name: &QualifiedName{Base: Name("greatest")}, Exprs: sqlDollar[3].exprs}
 		}
 	case 750:
-		sqlDollar = sqlS[sqlpt-6 : sqlpt+1]
-		//line sql.y:3064
+		sqlDollar = sqlS[sqlpt-4 : sqlpt+1]
+		//line sql.y:3451
 		{
+			sqlVAL.expr = &FuncExpr{Name: &QualifiedName{Base: Name("least")}, Exprs: sqlDollar[3].exprs}
 		}
 	case 751:
-		sqlDollar = sqlS[sqlpt-4 : sqlpt+1]
-		//line sql.y:3065
+		sqlDollar = sqlS[sqlpt-5 : sqlpt+1]
+		//line sql.y:3457
 		{
 		}
 	case 752:
-		sqlDollar = sqlS[sqlpt-4 : sqlpt+1]
-		//line sql.y:3066
+		sqlDollar = sqlS[sqlpt-0 : sqlpt+1]
+		//line sql.y:3458
 		{
 		}
 	case 753:
-		sqlDollar = sqlS[sqlpt-4 : sqlpt+1]
-		//line sql.y:3067
+		sqlDollar = sqlS[sqlpt-5 : sqlpt+1]
+		//line sql.y:3461
 		{
 		}
 	case 754:
-		sqlDollar = sqlS[sqlpt-5 : sqlpt+1]
-		//line sql.y:3071
+		sqlDollar = sqlS[sqlpt-0 : sqlpt+1]
+		//line sql.y:3462
 		{
 		}
 	case 755:
-		sqlDollar = sqlS[sqlpt-0 : sqlpt+1]
-		//line sql.y:3072
+		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
+		//line sql.y:3466
 		{
 		}
 	case 756:
-		sqlDollar = sqlS[sqlpt-5 : sqlpt+1]
-		//line sql.y:3075
+		sqlDollar = sqlS[sqlpt-0 : sqlpt+1]
+		//line sql.y:3467
 		{
 		}
 	case 757:
-		sqlDollar = sqlS[sqlpt-0 : sqlpt+1]
-		//line sql.y:3076
+		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
+		//line sql.y:3470
 		{
 		}
 	case 758:
-		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:3080
+		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
+		//line sql.y:3471
 		{
 		}
 	case 759:
-		sqlDollar = sqlS[sqlpt-0 : sqlpt+1]
-		//line sql.y:3081
+		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
+		//line sql.y:3474
 		{
 		}
 	case 760:
-		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:3084
+		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
+		//line sql.y:3477
 		{
 		}
 	case 761:
-		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:3085
+		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
+		//line sql.y:3478
 		{
 		}
 	case 762:
-		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:3088
+		sqlDollar = sqlS[sqlpt-0 : sqlpt+1]
+		//line sql.y:3479
 		{
 		}
 	case 763:
-		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:3091
+		sqlDollar = sqlS[sqlpt-6 : sqlpt+1]
+		//line sql.y:3483
 		{
 		}
 	case 764:
-		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:3092
+		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
+		//line sql.y:3494
 		{
 		}
 	case 765:
 		sqlDollar = sqlS[sqlpt-0 : sqlpt+1]
-		//line sql.y:3093
+		//line sql.y:3495
 		{
 		}
 	case 766:
-		sqlDollar = sqlS[sqlpt-6 : sqlpt+1]
-		//line sql.y:3097
+		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
+		//line sql.y:3498
 		{
 		}
 	case 767:
-		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:3108
+		sqlDollar = sqlS[sqlpt-0 : sqlpt+1]
+		//line sql.y:3499
 		{
 		}
 	case 768:
-		sqlDollar = sqlS[sqlpt-0 : sqlpt+1]
-		//line sql.y:3109
+		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
+		//line sql.y:3507
 		{
 		}
 	case 769:
-		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:3112
+		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
+		//line sql.y:3508
 		{
 		}
 	case 770:
 		sqlDollar = sqlS[sqlpt-0 : sqlpt+1]
-		//line sql.y:3113
+		//line sql.y:3509
 		{
 		}
 	case 771:
-		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:3121
+		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
+		//line sql.y:3512
 		{
 		}
 	case 772:
-		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:3122
+		sqlDollar = sqlS[sqlpt-4 : sqlpt+1]
+		//line sql.y:3513
 		{
 		}
 	case 773:
-		sqlDollar = sqlS[sqlpt-0 : sqlpt+1]
-		//line sql.y:3123
+		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
+		//line sql.y:3519
 		{
 		}
 	case 774:
-		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:3126
+		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
+		//line sql.y:3520
 		{
 		}
 	case 775:
-		sqlDollar = sqlS[sqlpt-4 : sqlpt+1]
-		//line sql.y:3127
+		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
+		//line sql.y:3521
 		{
 		}
 	case 776:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:3133
+		//line sql.y:3522
 		{
 		}
 	case 777:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:3134
+		//line sql.y:3523
 		{
 		}
 	case 778:
-		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:3135
+		sqlDollar = sqlS[sqlpt-4 : sqlpt+1]
+		//line sql.y:3534
 		{
+			sqlVAL.expr = Row(sqlDollar[3].exprs)
 		}
 	case 779:
-		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:3136
+		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
+		//line sql.y:3538
 		{
+			sqlVAL.expr = Row(nil)
 		}
 	case 780:
-		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:3137
+		sqlDollar = sqlS[sqlpt-5 : sqlpt+1]
+		//line sql.y:3542
 		{
+			sqlVAL.expr = Tuple(append(sqlDollar[2].exprs, sqlDollar[4].expr))
 		}
 	case 781:
 		sqlDollar = sqlS[sqlpt-4 : sqlpt+1]
-		//line sql.y:3148
+		//line sql.y:3548
 		{
 			sqlVAL.expr = Row(sqlDollar[3].exprs)
 		}
 	case 782:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:3152
+		//line sql.y:3552
 		{
 			sqlVAL.expr = Row(nil)
 		}
 	case 783:
 		sqlDollar = sqlS[sqlpt-5 : sqlpt+1]
-		//line sql.y:3156
+		//line sql.y:3558
 		{
 			sqlVAL.expr = Tuple(append(sqlDollar[2].exprs, sqlDollar[4].expr))
 		}
 	case 784:
-		sqlDollar = sqlS[sqlpt-4 : sqlpt+1]
-		//line sql.y:3162
+		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
+		//line sql.y:3563
 		{
-			sqlVAL.expr = Row(sqlDollar[3].exprs)
 		}
 	case 785:
-		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:3166
+		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
+		//line sql.y:3564
 		{
-			sqlVAL.expr = Row(nil)
 		}
 	case 786:
-		sqlDollar = sqlS[sqlpt-5 : sqlpt+1]
-		//line sql.y:3172
+		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
+		//line sql.y:3565
 		{
-			sqlVAL.expr = Tuple(append(sqlDollar[2].exprs, sqlDollar[4].expr))
 		}
 	case 787:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:3177
+		//line sql.y:3568
 		{
 		}
 	case 788:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:3178
+		//line sql.y:3569
 		{
 		}
 	case 789:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:3179
+		//line sql.y:3570
 		{
 		}
 	case 790:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:3182
+		//line sql.y:3571
 		{
 		}
 	case 791:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:3183
+		//line sql.y:3572
 		{
 		}
 	case 792:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:3184
+		//line sql.y:3573
 		{
 		}
 	case 793:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:3185
+		//line sql.y:3574
 		{
 		}
 	case 794:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:3186
+		//line sql.y:3575
 		{
 		}
 	case 795:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:3187
+		//line sql.y:3576
 		{
 		}
 	case 796:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:3188
+		//line sql.y:3577
 		{
 		}
 	case 797:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:3189
+		//line sql.y:3578
 		{
 		}
 	case 798:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:3190
+		//line sql.y:3579
 		{
 		}
 	case 799:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:3191
+		//line sql.y:3580
 		{
 		}
 	case 800:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:3192
+		//line sql.y:3581
 		{
 		}
 	case 801:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:3193
+		//line sql.y:3582
 		{
 		}
 	case 802:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:3194
+		//line sql.y:3583
 		{
 		}
 	case 803:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:3195
+		//line sql.y:3586
 		{
 		}
 	case 804:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:3196
+		//line sql.y:3587
 		{
 		}
 	case 805:
-		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:3197
+		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
+		//line sql.y:3588
 		{
 		}
 	case 806:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:3200
+		//line sql.y:3589
 		{
 		}
 	case 807:
-		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:3201
-		{
-		}
-	case 808:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:3202
+		//line sql.y:3590
 		{
 		}
-	case 809:
+	case 808:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:3213
+		//line sql.y:3601
 		{
 			sqlVAL.exprs = Exprs{sqlDollar[1].expr}
 		}
-	case 810:
+	case 809:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:3217
+		//line sql.y:3605
 		{
 			sqlVAL.exprs = append(sqlDollar[1].exprs, sqlDollar[3].expr)
 		}
-	case 811:
+	case 810:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:3222
+		//line sql.y:3610
+		{
+		}
+	case 811:
+		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
+		//line sql.y:3611
 		{
 		}
 	case 812:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:3223
+		//line sql.y:3614
 		{
 		}
 	case 813:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:3226
+		//line sql.y:3615
 		{
 		}
 	case 814:
-		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:3227
+		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
+		//line sql.y:3616
 		{
 		}
 	case 815:
-		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:3228
+		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
+		//line sql.y:3619
 		{
 		}
 	case 816:
-		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:3231
+		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
+		//line sql.y:3620
 		{
 		}
 	case 817:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:3232
+		//line sql.y:3624
 		{
+			sqlVAL.exprs = Exprs{StrVal(sqlDollar[1].str), sqlDollar[3].expr}
 		}
 	case 818:
-		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:3235
+		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
+		//line sql.y:3628
 		{
+			sqlVAL.exprs = sqlDollar[1].exprs
 		}
 	case 819:
 		sqlDollar = sqlS[sqlpt-0 : sqlpt+1]
-		//line sql.y:3236
-		{
-		}
-	case 820:
-		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:3241
-		{
-		}
-	case 821:
-		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:3242
-		{
-		}
-	case 822:
-		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:3243
-		{
-		}
-	case 823:
-		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:3244
-		{
-		}
-	case 824:
-		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:3245
-		{
-		}
-	case 825:
-		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:3246
-		{
-		}
-	case 826:
-		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:3247
-		{
-		}
-	case 827:
-		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:3248
+		//line sql.y:3632
 		{
+			sqlVAL.exprs = nil
 		}
 	case 828:
 		sqlDollar = sqlS[sqlpt-4 : sqlpt+1]
-		//line sql.y:3256
+		//line sql.y:3654
 		{
 		}
 	case 829:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:3257
+		//line sql.y:3655
 		{
 		}
 	case 830:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:3260
+		//line sql.y:3658
 		{
 		}
 	case 831:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:3264
+		//line sql.y:3663
 		{
+			sqlVAL.exprs = Exprs{sqlDollar[3].expr, sqlDollar[1].expr}
 		}
 	case 832:
 		sqlDollar = sqlS[sqlpt-0 : sqlpt+1]
-		//line sql.y:3265
+		//line sql.y:3667
 		{
+			sqlVAL.exprs = nil
 		}
 	case 833:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:3279
+		//line sql.y:3684
 		{
+			sqlVAL.exprs = Exprs{sqlDollar[1].expr, sqlDollar[2].expr, sqlDollar[3].expr}
 		}
 	case 834:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:3280
+		//line sql.y:3688
 		{
+			sqlVAL.exprs = Exprs{sqlDollar[1].expr, sqlDollar[3].expr, sqlDollar[2].expr}
 		}
 	case 835:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:3281
+		//line sql.y:3692
 		{
+			sqlVAL.exprs = Exprs{sqlDollar[1].expr, sqlDollar[2].expr}
 		}
 	case 836:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:3282
+		//line sql.y:3696
 		{
+			sqlVAL.exprs = Exprs{sqlDollar[1].expr, IntVal(1), sqlDollar[2].expr}
 		}
 	case 837:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:3283
+		//line sql.y:3700
 		{
+			sqlVAL.exprs = sqlDollar[1].exprs
 		}
 	case 838:
 		sqlDollar = sqlS[sqlpt-0 : sqlpt+1]
-		//line sql.y:3284
+		//line sql.y:3704
 		{
+			sqlVAL.exprs = nil
 		}
 	case 839:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:3287
+		//line sql.y:3710
 		{
+			sqlVAL.expr = sqlDollar[2].expr
 		}
 	case 840:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:3290
+		//line sql.y:3716
 		{
+			sqlVAL.expr = sqlDollar[2].expr
 		}
 	case 841:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:3293
+		//line sql.y:3722
 		{
+			sqlVAL.exprs = append(sqlDollar[3].exprs, sqlDollar[1].expr)
 		}
 	case 842:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:3294
+		//line sql.y:3726
 		{
+			sqlVAL.exprs = sqlDollar[2].exprs
 		}
 	case 843:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:3295
+		//line sql.y:3730
 		{
+			sqlVAL.exprs = sqlDollar[1].exprs
 		}
 	case 844:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:3299
+		//line sql.y:3736
 		{
 			sqlVAL.expr = &Subquery{Select: sqlDollar[1].stmt.(SelectStatement)}
 		}
 	case 845:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:3303
+		//line sql.y:3740
 		{
 			sqlVAL.expr = Tuple(sqlDollar[2].exprs)
 		}
 	case 846:
 		sqlDollar = sqlS[sqlpt-5 : sqlpt+1]
-		//line sql.y:3314
+		//line sql.y:3751
 		{
 			sqlVAL.expr = &CaseExpr{Expr: sqlDollar[2].expr, Whens: sqlDollar[3].whens, Else: sqlDollar[4].expr}
 		}
 	case 847:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:3321
+		//line sql.y:3758
 		{
 			sqlVAL.whens = []*When{sqlDollar[1].when}
 		}
 	case 848:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:3325
+		//line sql.y:3762
 		{
 			sqlVAL.whens = append(sqlDollar[1].whens, sqlDollar[2].when)
 		}
 	case 849:
 		sqlDollar = sqlS[sqlpt-4 : sqlpt+1]
-		//line sql.y:3331
+		//line sql.y:3768
 		{
 			sqlVAL.when = &When{Cond: sqlDollar[2].expr, Val: sqlDollar[4].expr}
 		}
 	case 850:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:3337
+		//line sql.y:3774
 		{
 			sqlVAL.expr = sqlDollar[2].expr
 		}
 	case 851:
 		sqlDollar = sqlS[sqlpt-0 : sqlpt+1]
-		//line sql.y:3341
+		//line sql.y:3778
 		{
 			sqlVAL.expr = nil
 		}
 	case 853:
 		sqlDollar = sqlS[sqlpt-0 : sqlpt+1]
-		//line sql.y:3348
+		//line sql.y:3785
 		{
 			sqlVAL.expr = nil
 		}
 	case 854:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:3354
+		//line sql.y:3791
 		{
 			sqlVAL.indirectElem = NameIndirection(sqlDollar[2].str)
 		}
 	case 855:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:3358
+		//line sql.y:3795
 		{
 			sqlVAL.indirectElem = StarIndirection{}
 		}
 	case 856:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:3362
+		//line sql.y:3799
 		{
 			sqlVAL.indirectElem = &ArrayIndirection{Begin: sqlDollar[2].expr}
 		}
 	case 857:
 		sqlDollar = sqlS[sqlpt-5 : sqlpt+1]
-		//line sql.y:3366
+		//line sql.y:3803
 		{
 			sqlVAL.indirectElem = &ArrayIndirection{Begin: sqlDollar[2].expr, End: sqlDollar[4].expr}
 		}
 	case 858:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:3372
+		//line sql.y:3809
 		{
 			sqlVAL.indirect = Indirection{sqlDollar[1].indirectElem}
 		}
 	case 859:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:3376
+		//line sql.y:3813
 		{
 			sqlVAL.indirect = append(sqlDollar[1].indirect, sqlDollar[2].indirectElem)
 		}
 	case 860:
 		sqlDollar = sqlS[sqlpt-0 : sqlpt+1]
-		//line sql.y:3382
+		//line sql.y:3819
 		{
 			sqlVAL.indirect = nil
 		}
 	case 861:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:3386
+		//line sql.y:3823
 		{
 			sqlVAL.indirect = append(sqlDollar[1].indirect, sqlDollar[2].indirectElem)
 		}
 	case 862:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:3391
+		//line sql.y:3828
 		{
 		}
 	case 863:
 		sqlDollar = sqlS[sqlpt-0 : sqlpt+1]
-		//line sql.y:3392
+		//line sql.y:3829
 		{
 		}
 	case 865:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:3401
+		//line sql.y:3838
 		{
 			sqlVAL.expr = nil
 		}
 	case 866:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:3407
+		//line sql.y:3844
 		{
 			sqlVAL.exprs = []Expr{sqlDollar[1].expr}
 		}
 	case 867:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:3411
+		//line sql.y:3848
 		{
 			sqlVAL.exprs = append(sqlDollar[1].exprs, sqlDollar[3].expr)
 		}
 	case 868:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:3420
+		//line sql.y:3857
 		{
 			sqlVAL.exprs = sqlDollar[2].exprs
 		}
 	case 870:
 		sqlDollar = sqlS[sqlpt-0 : sqlpt+1]
-		//line sql.y:3428
+		//line sql.y:3865
 		{
 			sqlVAL.selExprs = nil
 		}
 	case 871:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:3434
+		//line sql.y:3871
 		{
 			sqlVAL.selExprs = SelectExprs{sqlDollar[1].selExpr}
 		}
 	case 872:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:3438
+		//line sql.y:3875
 		{
 			sqlVAL.selExprs = append(sqlDollar[1].selExprs, sqlDollar[3].selExpr)
 		}
 	case 873:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:3444
+		//line sql.y:3881
 		{
 			sqlVAL.selExpr = &NonStarExpr{Expr: sqlDollar[1].expr, As: Name(sqlDollar[3].str)}
 		}
 	case 874:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:3453
+		//line sql.y:3890
 		{
 			sqlVAL.selExpr = &NonStarExpr{Expr: sqlDollar[1].expr, As: Name(sqlDollar[2].str)}
 		}
 	case 875:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:3457
+		//line sql.y:3894
 		{
 			sqlVAL.selExpr = &NonStarExpr{Expr: sqlDollar[1].expr}
 		}
 	case 876:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:3461
+		//line sql.y:3898
 		{
 			sqlVAL.selExpr = &StarExpr{}
 		}
 	case 877:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:3469
+		//line sql.y:3906
 		{
 			sqlVAL.qnames = QualifiedNames{sqlDollar[1].qname}
 		}
 	case 878:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:3473
+		//line sql.y:3910
 		{
 			sqlVAL.qnames = append(sqlDollar[1].qnames, sqlDollar[3].qname)
 		}
 	case 879:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:3484
+		//line sql.y:3921
 		{
 			sqlVAL.qname = &QualifiedName{Base: Name(sqlDollar[1].str)}
 		}
 	case 880:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:3488
+		//line sql.y:3925
 		{
 			sqlVAL.qname = &QualifiedName{Base: Name(sqlDollar[1].str), Indirect: sqlDollar[2].indirect}
 		}
 	case 881:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:3494
+		//line sql.y:3931
 		{
 			sqlVAL.strs = []string{sqlDollar[1].str}
 		}
 	case 882:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:3498
+		//line sql.y:3935
 		{
 			sqlVAL.strs = append(sqlDollar[1].strs, sqlDollar[3].str)
 		}
 	case 883:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:3504
+		//line sql.y:3941
 		{
 			sqlVAL.strs = sqlDollar[2].strs
 		}
 	case 884:
 		sqlDollar = sqlS[sqlpt-0 : sqlpt+1]
-		//line sql.y:3507
+		//line sql.y:3944
 		{
 		}
 	case 885:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:3517
+		//line sql.y:3954
 		{
 			sqlVAL.qname = &QualifiedName{Base: Name(sqlDollar[1].str)}
 		}
 	case 886:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:3521
+		//line sql.y:3958
 		{
 			sqlVAL.qname = &QualifiedName{Base: Name(sqlDollar[1].str), Indirect: sqlDollar[2].indirect}
 		}
 	case 887:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:3528
+		//line sql.y:3965
 		{
 			sqlVAL.expr = IntVal(sqlDollar[1].ival)
 		}
 	case 888:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:3532
+		//line sql.y:3969
 		{
 			sqlVAL.expr = NumVal(sqlDollar[1].str)
 		}
 	case 889:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:3536
+		//line sql.y:3973
 		{
 			// TODO(pmattis): string literal
 			sqlVAL.expr = StrVal(sqlDollar[1].str)
 		}
 	case 890:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:3541
+		//line sql.y:3978
 		{
-			// TODO(pmattis): bit literal.
-			sqlVAL.expr = StrVal(sqlDollar[1].str)
+			sqlVAL.expr = BytesVal(sqlDollar[1].str)
 		}
 	case 891:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:3546
+		//line sql.y:3982
 		{
-			// TODO(pmattis): hex literal.
-			sqlVAL.expr = StrVal(sqlDollar[1].str)
+			sqlVAL.expr = BytesVal(sqlDollar[1].str)
 		}
 	case 892:
 		sqlDollar = sqlS[sqlpt-6 : sqlpt+1]
-		//line sql.y:3550
+		//line sql.y:3985
 		{
 		}
 	case 893:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:3551
+		//line sql.y:3987
 		{
+			sqlVAL.expr = &CastExpr{Expr: StrVal(sqlDollar[2].str), Type: sqlDollar[1].colType}
 		}
 	case 894:
 		sqlDollar = sqlS[sqlpt-3 : sqlpt+1]
-		//line sql.y:3552
+		//line sql.y:3991
 		{
+			sqlVAL.expr = &CastExpr{Expr: StrVal(sqlDollar[2].str), Type: sqlDollar[1].colType}
 		}
 	case 895:
 		sqlDollar = sqlS[sqlpt-5 : sqlpt+1]
-		//line sql.y:3553
+		//line sql.y:3995
 		{
+			sqlVAL.expr = &CastExpr{Expr: StrVal(sqlDollar[5].str), Type: sqlDollar[1].colType}
 		}
 	case 896:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:3555
+		//line sql.y:3999
 		{
 			sqlVAL.expr = BoolVal(true)
 		}
 	case 897:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:3559
+		//line sql.y:4003
 		{
 			sqlVAL.expr = BoolVal(false)
 		}
 	case 898:
 		sqlDollar = sqlS[sqlpt-1 : sqlpt+1]
-		//line sql.y:3563
+		//line sql.y:4007
 		{
 			sqlVAL.expr = NullVal{}
 		}
 	case 900:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:3570
+		//line sql.y:4014
 		{
 			sqlVAL.ival = +sqlDollar[2].ival
 		}
 	case 901:
 		sqlDollar = sqlS[sqlpt-2 : sqlpt+1]
-		//line sql.y:3574
+		//line sql.y:4018
 		{
 			sqlVAL.ival = -sqlDollar[2].ival
 		}
 	case 906:
 		sqlDollar = sqlS[sqlpt-0 : sqlpt+1]
-		//line sql.y:3601
+		//line sql.y:4045
 		{
 			sqlVAL.str = ""
 		}
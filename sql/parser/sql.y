@@ -27,6 +27,7 @@ package parser
   pos            int
   empty          struct{}
   ival           int
+  boolVal        bool
   str            string
   strs           []string
   qname          *QualifiedName
@@ -40,6 +41,11 @@ package parser
   colConstraint  ColumnConstraint
   colConstraints []ColumnConstraint
   colType        ColumnType
+  alterTableCmd  AlterTableCmd
+  alterTableCmds AlterTableCmds
+  isoLevel       IsolationLevel
+  refAction      ReferenceAction
+  refActions     ReferenceActions
   expr           Expr
   exprs          Exprs
   selExpr        SelectExpr
@@ -51,7 +57,12 @@ package parser
   whens          []*When
   updateExpr     *UpdateExpr
   updateExprs    []*UpdateExpr
+  onConflict     *OnConflict
   limit          *Limit
+  groupBy        GroupBy
+  orderBy        OrderBy
+  order          *Order
+  dir            Direction
   targetList     TargetList
   targetListPtr  *TargetList
   privilegeType  PrivilegeType
@@ -94,17 +105,18 @@ package parser
 %type <stmt> simple_select values_clause
 
 %type <empty> alter_column_default alter_using
-%type <empty> opt_asc_desc opt_nulls_order
+%type <dir> opt_asc_desc
+%type <empty> opt_nulls_order
 
-%type <empty> alter_table_cmd opt_collate_clause
-%type <empty> alter_table_cmds
+%type <alterTableCmd> alter_table_cmd
+%type <alterTableCmds> alter_table_cmds
+%type <empty> opt_collate_clause
 
 %type <empty> opt_drop_behavior
 
-%type <empty> transaction_mode_list
-%type <empty> transaction_mode_item
+%type <isoLevel> transaction_mode_list
 
-%type <empty> opt_with_data
+// %type <empty> opt_with_data
 %type <empty> opt_nowait_or_skip
 
 // %type <empty> opt_schema_name
@@ -124,7 +136,8 @@ package parser
 
 %type <empty> math_op
 
-%type <empty> iso_level opt_encoding
+%type <isoLevel> iso_level
+%type <empty> opt_encoding
 
 %type <tblDefs> opt_table_elem_list table_elem_list 
 %type <empty> opt_inherit
@@ -132,7 +145,8 @@ package parser
 %type <empty> reloptions opt_reloptions
 %type <empty> opt_with distinct_clause opt_all_clause
 %type <strs> opt_column_list
-%type <empty> sort_clause opt_sort_clause sortby_list index_params
+%type <orderBy> sort_clause opt_sort_clause sortby_list
+%type <strs> index_params
 %type <strs> name_list opt_name_list
 %type <empty> opt_array_bounds
 %type <tblExprs> from_clause from_list
@@ -147,17 +161,19 @@ package parser
 %type <updateExpr> set_clause multiple_set_clause
 %type <indirect> indirection opt_indirection
 %type <exprs> ctext_expr_list ctext_row
-%type <empty> reloption_list group_clause
+%type <empty> reloption_list
+%type <groupBy> group_clause
 %type <limit> select_limit opt_select_limit
-%type <empty> transaction_mode_list_or_empty
+%type <isoLevel> transaction_mode_list_or_empty
 %type <empty> table_func_elem_list
-%type <empty> returning_clause
+%type <selExprs> returning_clause
 %type <qnames> relation_expr_list
 
-%type <empty> group_by_list
-%type <empty> group_by_item empty_grouping_set
+%type <exprs> group_by_list
+%type <expr> group_by_item
+%type <empty> empty_grouping_set
 
-%type <empty> create_as_target
+%type <stmt> create_as_target
 
 %type <empty> func_type
 
@@ -169,21 +185,24 @@ package parser
 %type <empty> for_locking_item
 %type <empty> for_locking_clause opt_for_locking_clause for_locking_items
 %type <empty> locked_rels_list
-%type <empty> all_or_distinct
+%type <boolVal> all_or_distinct
 
 %type <empty> join_outer
 %type <joinCond> join_qual
 %type <str> join_type
 
-%type <empty> extract_list overlay_list position_list
-%type <empty> substr_list trim_list
+%type <exprs> extract_list position_list
+%type <empty> overlay_list
+%type <exprs> substr_list trim_list
 %type <empty> opt_interval interval_second
-%type <empty> overlay_placing substr_from substr_for
+%type <empty> overlay_placing
+%type <expr>  substr_from substr_for
 
-%type <empty> opt_unique opt_concurrently
+%type <boolVal> opt_unique
+%type <empty> opt_concurrently
 
-%type <empty> opt_column opt_set_data
-%type <empty> drop_type
+%type <boolVal> opt_column
+%type <empty> opt_set_data
 
 %type <limit> limit_clause offset_clause 
 %type <expr>  select_limit_value
@@ -191,8 +210,8 @@ package parser
 %type <empty> row_or_rows first_or_next
 
 %type <stmt>  insert_rest
-%type <empty> opt_conf_expr
-%type <empty> opt_on_conflict
+%type <strs> opt_conf_expr
+%type <onConflict> opt_on_conflict
 
 %type <stmt>  generic_set set_rest set_rest_more
 %type <empty> set_reset_clause
@@ -222,8 +241,8 @@ package parser
 %type <expr> numeric_only
 %type <str> alias_clause opt_alias_clause
 %type <empty> func_alias_clause
-%type <empty> sortby
-%type <empty> index_elem
+%type <order> sortby
+%type <str> index_elem
 %type <tblExpr> table_ref
 %type <tblExpr> joined_table
 %type <qname> relation_expr
@@ -231,10 +250,8 @@ package parser
 %type <selExpr> target_elem
 %type <updateExpr> single_set_clause
 
-%type <empty> explain_option_name
-%type <empty> explain_option_arg
-%type <empty> explain_option_elem
-%type <empty> explain_option_list
+%type <str> explain_option_name
+%type <strs> explain_option_list
 
 %type <colType> typename simple_typename const_typename
 %type <colType> numeric opt_numeric_modifiers
@@ -244,7 +261,7 @@ package parser
 %type <colType> const_datetime const_interval
 %type <colType> bit const_bit bit_with_length bit_without_length
 %type <colType> character_base
-%type <empty> extract_arg
+%type <str>   extract_arg
 %type <empty> opt_charset
 %type <empty> opt_varying opt_timezone opt_no_inherit
 
@@ -266,7 +283,9 @@ package parser
 %type <empty> table_like_option_list table_like_option
 %type <colConstraints> col_qual_list
 %type <colConstraint> col_constraint col_constraint_elem
-%type <empty> key_actions key_delete key_match key_update key_action
+%type <refActions> key_actions
+%type <refAction> key_delete key_update key_action
+%type <empty> key_match
 %type <empty> existing_index
 
 // %type <empty> opt_check_option
@@ -301,6 +320,7 @@ package parser
 %token <ival>  ICONST PARAM
 %token <str>   TYPECAST DOT_DOT
 %token <str>   LESS_EQUALS GREATER_EQUALS NOT_EQUALS
+%token <str>   NOT_REGMATCH REGIMATCH NOT_REGIMATCH
 %token <str>   ERROR
 
 // If you want to make any keyword changes, update the keyword table in
@@ -313,8 +333,8 @@ package parser
 %token <str>   AGGREGATE ALL ALSO ALTER ALWAYS ANALYSE ANALYZE AND ANY ARRAY AS ASC
 %token <str>   ASSERTION ASSIGNMENT ASYMMETRIC AT ATTRIBUTE AUTHORIZATION
 
-%token <str>   BACKWARD BEFORE BEGIN BETWEEN BIGINT BINARY BIT
-%token <str>   BLOB BOOLEAN BOTH BY
+%token <str>   BACKWARD BEFORE BEGIN BETWEEN BIGINT BIGSERIAL BINARY BIT
+%token <str>   BLOB BOOLEAN BOTH BY BYTEA BYTES
 
 %token <str>   CACHE CALLED CASCADE CASCADED CASE CAST CATALOG CHAIN CHAR
 %token <str>   CHARACTER CHARACTERISTICS CHECK CHECKPOINT CLASS CLOSE
@@ -339,7 +359,7 @@ package parser
 
 %token <str>   HANDLER HAVING HEADER HOLD HOUR
 
-%token <str>   IDENTITY IF IMMEDIATE IMMUTABLE IMPLICIT IMPORT IN
+%token <str>   IDENTITY IF ILIKE IMMEDIATE IMMUTABLE IMPLICIT IMPORT IN
 %token <str>   INCLUDING INCREMENT INDEX INDEXES INHERIT INHERITS INITIALLY INLINE
 %token <str>   INNER INOUT INPUT INSENSITIVE INSERT INSTEAD INT INTEGER
 %token <str>   INTERSECT INTERVAL INTO INVOKER IS ISOLATION
@@ -373,8 +393,8 @@ package parser
 %token <str>   ROW ROWS RULE
 
 %token <str>   SAVEPOINT SCHEMA SCROLL SEARCH SECOND SECURITY SELECT SEQUENCE SEQUENCES
-%token <str>   SERIALIZABLE SERVER SESSION SESSION_USER SET SETS SETOF SHARE SHOW
-%token <str>   SIMILAR SIMPLE SKIP SMALLINT SNAPSHOT SOME SQL STABLE STANDALONE START
+%token <str>   SERIAL SERIALIZABLE SERVER SESSION SESSION_USER SET SETS SETOF SHARE SHOW
+%token <str>   SIMILAR SIMPLE SKIP SMALLINT SMALLSERIAL SNAPSHOT SOME SQL STABLE STANDALONE START
 %token <str>   STATEMENT STATISTICS STDIN STDOUT STORAGE STRICT STRIP SUBSTRING
 %token <str>   SYMMETRIC SYSID SYSTEM
 
@@ -383,7 +403,7 @@ package parser
 %token <str>   TRUNCATE TRUSTED TYPE TYPES
 
 %token <str>   UNBOUNDED UNCOMMITTED UNENCRYPTED UNION UNIQUE UNKNOWN UNLISTEN UNLOGGED
-%token <str>   UNTIL UPDATE USER USING
+%token <str>   UNTIL UPDATE UPSERT USER USING
 
 %token <str>   VACUUM VALID VALIDATE VALIDATOR VALUE VALUES VARCHAR VARIADIC VARYING
 %token <str>   VERBOSE VERSION VIEW VIEWS VOLATILE
@@ -413,8 +433,8 @@ package parser
 %right     NOT
 %nonassoc  IS                  // IS sets precedence for IS NULL, etc
 %nonassoc  '<' '>' '=' LESS_EQUALS GREATER_EQUALS NOT_EQUALS
-%nonassoc  BETWEEN IN LIKE SIMILAR NOT_LA
-%nonassoc  ESCAPE              // ESCAPE must be just above LIKE/SIMILAR
+%nonassoc  BETWEEN IN LIKE ILIKE SIMILAR NOT_LA
+%nonassoc  ESCAPE              // ESCAPE must be just above LIKE/ILIKE/SIMILAR
 %nonassoc  OVERLAPS
 %left      POSTFIXOP           // dummy for postfix OP rules
 // To support target_elem without AS, we must give IDENT an explicit priority
@@ -442,7 +462,7 @@ package parser
 // funny behavior of UNBOUNDED on the SQL standard, though.
 %nonassoc  UNBOUNDED         // ideally should have same precedence as IDENT
 %nonassoc  IDENT NULL PARTITION RANGE ROWS PRECEDING FOLLOWING CUBE ROLLUP
-%left      CONCAT       // multi-character ops
+%left      CONCAT NOT_REGMATCH REGIMATCH NOT_REGIMATCH // multi-character ops
 %left      '+' '-'
 %left      '*' '/' '%'
 %left      '&' '|' '^' '#'
@@ -537,11 +557,11 @@ alter_index_stmt:
 alter_table_stmt:
   ALTER TABLE relation_expr alter_table_cmds
   {
-    $$ = nil
+    $$ = &AlterTable{Table: $3, IfExists: false, Cmds: $4}
   }
 | ALTER TABLE IF EXISTS relation_expr alter_table_cmds
   {
-    $$ = nil
+    $$ = &AlterTable{Table: $5, IfExists: true, Cmds: $6}
   }
 // | ALTER TABLE relation_expr SET SCHEMA name
 //   {
@@ -572,13 +592,25 @@ alter_table_stmt:
 
 alter_table_cmds:
   alter_table_cmd
+  {
+    $$ = AlterTableCmds{$1}
+  }
 | alter_table_cmds ',' alter_table_cmd
+  {
+    $$ = append($1, $3)
+  }
 
 alter_table_cmd:
   // ALTER TABLE <name> ADD <coldef>
-  ADD column_def {}
+  ADD column_def
+  {
+    $$ = &AlterTableAddColumn{ColumnKeyword: false, ColumnDef: $2.(*ColumnTableDef)}
+  }
   // ALTER TABLE <name> ADD COLUMN <coldef>
-| ADD COLUMN column_def {}
+| ADD COLUMN column_def
+  {
+    $$ = &AlterTableAddColumn{ColumnKeyword: true, ColumnDef: $3.(*ColumnTableDef)}
+  }
   // ALTER TABLE <name> ALTER [COLUMN] <colname> {SET DEFAULT <expr>|DROP DEFAULT}
 | ALTER opt_column name alter_column_default {}
   // ALTER TABLE <name> ALTER [COLUMN] <colname> DROP NOT NULL
@@ -594,9 +626,15 @@ alter_table_cmd:
   // ALTER TABLE <name> ALTER [COLUMN] <colname> SET STORAGE <storagemode>
 | ALTER opt_column name SET STORAGE name {}
   // ALTER TABLE <name> DROP [COLUMN] IF EXISTS <colname> [RESTRICT|CASCADE]
-| DROP opt_column IF EXISTS name opt_drop_behavior {}
+| DROP opt_column IF EXISTS name opt_drop_behavior
+  {
+    $$ = &AlterTableDropColumn{ColumnKeyword: $2, IfExists: true, Column: Name($5)}
+  }
   // ALTER TABLE <name> DROP [COLUMN] <colname> [RESTRICT|CASCADE]
-| DROP opt_column name opt_drop_behavior {}
+| DROP opt_column name opt_drop_behavior
+  {
+    $$ = &AlterTableDropColumn{ColumnKeyword: $2, IfExists: false, Column: Name($3)}
+  }
   // ALTER TABLE <name> ALTER [COLUMN] <colname> [SET DATA] TYPE <typename>
   //     [ USING <expression> ]
 | ALTER opt_column name opt_set_data TYPE typename opt_collate_clause alter_using {}
@@ -679,18 +717,19 @@ delete_stmt:
   opt_with_clause DELETE FROM relation_expr_opt_alias
     where_clause returning_clause
   {
-    $$ = &Delete{Table: $4, Where: newWhere(astWhere, $5)}
+    $$ = &Delete{Table: $4, Where: newWhere(astWhere, $5), Returning: $6}
   }
 
 // DROP itemtype [ IF EXISTS ] itemname [, itemname ...] [ RESTRICT | CASCADE ]
+// TODO: Support DROP SCHEMA and DROP VIEW.
 drop_stmt:
-  DROP drop_type IF EXISTS any_name_list opt_drop_behavior
+  DROP INDEX any_name_list opt_drop_behavior
   {
-    $$ = nil
+    $$ = &DropIndex{Names: $3, IfExists: false}
   }
-| DROP drop_type any_name_list opt_drop_behavior
+| DROP INDEX IF EXISTS any_name_list opt_drop_behavior
   {
-    $$ = nil
+    $$ = &DropIndex{Names: $5, IfExists: true}
   }
 | DROP DATABASE name
   {
@@ -709,11 +748,6 @@ drop_stmt:
     $$ = &DropTable{Names: $5, IfExists: true}
   }
 
-drop_type:
-  INDEX {}
-// | SCHEMA {}
-// | VIEW {}
-
 any_name_list:
   any_name
   {
@@ -749,15 +783,15 @@ attrs:
 explain_stmt:
   EXPLAIN explainable_stmt
   {
-    $$ = nil
+    $$ = &Explain{Statement: $2}
   }
 | EXPLAIN VERBOSE explainable_stmt
   {
-    $$ = nil
+    $$ = &Explain{Options: []string{"VERBOSE"}, Statement: $3}
   }
 | EXPLAIN '(' explain_option_list ')' explainable_stmt
   {
-    $$ = nil
+    $$ = &Explain{Options: $3, Statement: $5}
   }
 
 explainable_stmt:
@@ -768,20 +802,23 @@ explainable_stmt:
 | create_table_as_stmt
 
 explain_option_list:
-  explain_option_elem
-| explain_option_list ',' explain_option_elem
-
-explain_option_elem:
-  explain_option_name explain_option_arg
+  explain_option_name
+  {
+    $$ = []string{$1}
+  }
+| explain_option_list ',' explain_option_name
+  {
+    $$ = append($1, $3)
+  }
 
 explain_option_name:
   non_reserved_word
-  {}
 
-explain_option_arg:
-  opt_boolean_or_string {}
-| numeric_only {}
-| /* EMPTY */ {}
+// TODO: Support explain option arguments.
+// explain_option_arg:
+//   opt_boolean_or_string {}
+// | numeric_only {}
+// | /* EMPTY */ {}
 
 // create_schema_stmt:
 //   CREATE SCHEMA opt_schema_name AUTHORIZATION role_spec opt_schema_elem_list
@@ -903,7 +940,10 @@ set_stmt:
   }
 
 set_rest:
-  TRANSACTION transaction_mode_list {}
+  TRANSACTION transaction_mode_list
+  {
+    $$ = &SetTransaction{Isolation: $2}
+  }
 | SESSION CHARACTERISTICS AS TRANSACTION transaction_mode_list {}
 | set_rest_more {}
 
@@ -952,11 +992,29 @@ var_value:
   opt_boolean_or_string
 | numeric_only
 
+// The isolation levels weaker than SNAPSHOT are upgraded to SNAPSHOT, in the
+// same way that postgres upgrades READ UNCOMMITTED to READ COMMITTED.
 iso_level:
-  READ UNCOMMITTED {}
-| READ COMMITTED {}
-| REPEATABLE READ {}
-| SERIALIZABLE {}
+  READ UNCOMMITTED
+  {
+    $$ = SnapshotIsolation
+  }
+| READ COMMITTED
+  {
+    $$ = SnapshotIsolation
+  }
+| REPEATABLE READ
+  {
+    $$ = SnapshotIsolation
+  }
+| SNAPSHOT
+  {
+    $$ = SnapshotIsolation
+  }
+| SERIALIZABLE
+  {
+    $$ = SerializableIsolation
+  }
 
 opt_boolean_or_string:
   TRUE
@@ -1207,9 +1265,18 @@ col_constraint_elem:
   {
     $$ = PrimaryKeyConstraint{}
   }
-| CHECK '(' a_expr ')' opt_no_inherit {}
-| DEFAULT b_expr {}
-| REFERENCES qualified_name opt_column_list key_match key_actions {}
+| CHECK '(' a_expr ')' opt_no_inherit
+  {
+    $$ = CheckConstraint{Expr: $3}
+  }
+| DEFAULT b_expr
+  {
+    $$ = DefaultConstraint{Expr: $2}
+  }
+| REFERENCES qualified_name opt_column_list key_match key_actions
+  {
+    $$ = &ReferencesConstraint{Table: $2, Columns: NameList($3), Actions: $5}
+  }
 
 table_like_clause:
   LIKE qualified_name table_like_option_list {}
@@ -1234,8 +1301,13 @@ table_constraint:
   CONSTRAINT name constraint_elem
   {
     $$ = $3
-    if i, ok := $$.(*IndexTableDef); ok {
-      i.Name = Name($2)
+    switch t := $$.(type) {
+    case *IndexTableDef:
+      t.Name = Name($2)
+    case *CheckConstraintTableDef:
+      t.Name = Name($2)
+    case *ForeignKeyTableDef:
+      t.Name = Name($2)
     }
   }
 | constraint_elem
@@ -1244,7 +1316,10 @@ table_constraint:
   }
 
 constraint_elem:
-  CHECK '(' a_expr ')' {}
+  CHECK '(' a_expr ')'
+  {
+    $$ = &CheckConstraintTableDef{Expr: $3}
+  }
 | UNIQUE '(' name_list ')'
   {
     $$ = &IndexTableDef{Unique: true, Columns: NameList($3)}
@@ -1262,7 +1337,15 @@ constraint_elem:
 | EXCLUDE access_method_clause '(' exclusion_constraint_list ')'
     exclusion_where_clause {}
 | FOREIGN KEY '(' name_list ')' REFERENCES qualified_name
-    opt_column_list key_match key_actions {}
+    opt_column_list key_match key_actions
+  {
+    $$ = &ForeignKeyTableDef{
+      FromCols: NameList($4),
+      Table:    $7,
+      ToCols:   NameList($8),
+      Actions:  $10,
+    }
+  }
 
 opt_no_inherit:
   NO INHERIT {}
@@ -1300,24 +1383,60 @@ exclusion_where_clause:
 // production. update is in the left 8 bits, delete in the right. Note that
 // NOACTION is the default.
 key_actions:
-  key_update {}
-| key_delete {}
-| key_update key_delete {}
-| key_delete key_update {}
-| /* EMPTY */ {}
+  key_update
+  {
+    $$ = ReferenceActions{Update: $1}
+  }
+| key_delete
+  {
+    $$ = ReferenceActions{Delete: $1}
+  }
+| key_update key_delete
+  {
+    $$ = ReferenceActions{Delete: $2, Update: $1}
+  }
+| key_delete key_update
+  {
+    $$ = ReferenceActions{Delete: $1, Update: $2}
+  }
+| /* EMPTY */
+  {
+    $$ = ReferenceActions{}
+  }
 
 key_update:
-  ON UPDATE key_action {}
+  ON UPDATE key_action
+  {
+    $$ = $3
+  }
 
 key_delete:
-  ON DELETE key_action {}
+  ON DELETE key_action
+  {
+    $$ = $3
+  }
 
 key_action:
-  NO ACTION {}
-| RESTRICT {}
-| CASCADE {}
-| SET NULL {}
-| SET DEFAULT {}
+  NO ACTION
+  {
+    $$ = NoAction
+  }
+| RESTRICT
+  {
+    $$ = Restrict
+  }
+| CASCADE
+  {
+    $$ = Cascade
+  }
+| SET NULL
+  {
+    $$ = SetNull
+  }
+| SET DEFAULT
+  {
+    $$ = SetDefault
+  }
 
 opt_inherit:
   INHERITS '(' qualified_name_list ')' {}
@@ -1337,23 +1456,31 @@ existing_index:
   USING INDEX name {}
 
 // CREATE TABLE relname AS select_stmt [ WITH [NO] DATA ]
+//
+// TODO: Support WITH [NO] DATA.
 create_table_as_stmt:
-  CREATE opt_temp TABLE create_as_target AS select_stmt opt_with_data
+  CREATE opt_temp TABLE create_as_target AS select_stmt
   {
-    $$ = nil
+    $$ = $4
+    $$.(*CreateTable).AsSource = $6.(SelectStatement)
   }
-| CREATE opt_temp TABLE IF NOT EXISTS create_as_target AS select_stmt opt_with_data
+| CREATE opt_temp TABLE IF NOT EXISTS create_as_target AS select_stmt
   {
-    $$ = nil
+    $$ = $7
+    $$.(*CreateTable).IfNotExists = true
+    $$.(*CreateTable).AsSource = $9.(SelectStatement)
   }
 
 create_as_target:
-  any_name opt_column_list opt_with on_commit_option {}
+  any_name opt_column_list opt_with on_commit_option
+  {
+    $$ = &CreateTable{Table: $1, AsColumnNames: NameList($2)}
+  }
 
-opt_with_data:
-  WITH DATA {}
-| WITH NO DATA {}
-| /* EMPTY */ {}
+// opt_with_data:
+//   WITH DATA {}
+// | WITH NO DATA {}
+// | /* EMPTY */ {}
 
 numeric_only:
   FCONST
@@ -1390,23 +1517,30 @@ opt_restart_seqs:
 | /* EMPTY */ {}
 
 // CREATE INDEX
+// TODO: Support partial indexes (CREATE INDEX ... WHERE).
 create_index_stmt:
   CREATE opt_unique INDEX opt_concurrently opt_name
     ON qualified_name access_method_clause '(' index_params ')'
-    opt_reloptions where_clause
+    opt_reloptions
   {
-    $$ = nil
+    $$ = &CreateIndex{Name: Name($5), Table: $7, Unique: $2, Columns: NameList($10)}
   }
 | CREATE opt_unique INDEX opt_concurrently IF NOT EXISTS name
     ON qualified_name access_method_clause '(' index_params ')'
-    opt_reloptions where_clause
+    opt_reloptions
   {
-    $$ = nil
+    $$ = &CreateIndex{Name: Name($8), Table: $10, Unique: $2, IfNotExists: true, Columns: NameList($13)}
   }
 
 opt_unique:
-  UNIQUE {}
-| /* EMPTY */ {}
+  UNIQUE
+  {
+    $$ = true
+  }
+| /* EMPTY */
+  {
+    $$ = false
+  }
 
 opt_concurrently:
   CONCURRENTLY {}
@@ -1417,19 +1551,28 @@ access_method_clause:
 | /* EMPTY */ {}
 
 index_params:
-  index_elem {}
-| index_params ',' index_elem {}
+  index_elem
+  {
+    $$ = []string{$1}
+  }
+| index_params ',' index_elem
+  {
+    $$ = append($1, $3)
+  }
 
 // Index attributes can be either simple column references, or arbitrary
 // expressions in parens. For backwards-compatibility reasons, we allow an
 // expression that's just a function call to be written without parens.
+// TODO: Support expression indexes and index column directions.
 index_elem:
   name opt_collate opt_class opt_asc_desc opt_nulls_order
-  {}
-| func_expr_windowless opt_collate opt_class opt_asc_desc opt_nulls_order
-  {}
-| '(' a_expr ')' opt_collate opt_class opt_asc_desc opt_nulls_order
-  {}
+  {
+    $$ = $1
+  }
+// | func_expr_windowless opt_collate opt_class opt_asc_desc opt_nulls_order
+//   {}
+// | '(' a_expr ')' opt_collate opt_class opt_asc_desc opt_nulls_order
+//   {}
 
 opt_collate:
   COLLATE any_name {}
@@ -1441,9 +1584,18 @@ opt_class:
 | /* EMPTY */ {}
 
 opt_asc_desc:
-  ASC {}
-| DESC {}
-| /* EMPTY */ {}
+  ASC
+  {
+    $$ = Ascending
+  }
+| DESC
+  {
+    $$ = Descending
+  }
+| /* EMPTY */
+  {
+    $$ = DefaultDirection
+  }
 
 opt_nulls_order:
   NULLS_LA FIRST {}
@@ -1466,19 +1618,19 @@ any_operator:
 rename_stmt:
   ALTER DATABASE name RENAME TO name
   {
-    $$ = nil
+    $$ = &RenameDatabase{Name: Name($3), NewName: Name($6)}
   }
 // | ALTER SCHEMA name RENAME TO name
 //   {
 //     $$ = nil
 //   }
-| ALTER TABLE relation_expr RENAME TO name
+| ALTER TABLE relation_expr RENAME TO qualified_name
   {
-    $$ = nil
+    $$ = &RenameTable{Name: $3, NewName: $6, IfExists: false}
   }
-| ALTER TABLE IF EXISTS relation_expr RENAME TO name
+| ALTER TABLE IF EXISTS relation_expr RENAME TO qualified_name
   {
-    $$ = nil
+    $$ = &RenameTable{Name: $5, NewName: $8, IfExists: true}
   }
 // | ALTER VIEW qualified_name RENAME TO name
 //   {
@@ -1498,11 +1650,11 @@ rename_stmt:
   }
 | ALTER TABLE relation_expr RENAME opt_column name TO name
   {
-    $$ = nil
+    $$ = &RenameColumn{Table: $3, Name: Name($6), NewName: Name($8), IfExists: false}
   }
 | ALTER TABLE IF EXISTS relation_expr RENAME opt_column name TO name
   {
-    $$ = nil
+    $$ = &RenameColumn{Table: $5, Name: Name($8), NewName: Name($10), IfExists: true}
   }
 | ALTER TABLE relation_expr RENAME CONSTRAINT name TO name
   {
@@ -1514,8 +1666,14 @@ rename_stmt:
   }
 
 opt_column:
-  COLUMN {}
-| /* EMPTY */ {}
+  COLUMN
+  {
+    $$ = true
+  }
+| /* EMPTY */
+  {
+    $$ = false
+  }
 
 opt_set_data:
   SET DATA {}
@@ -1525,27 +1683,27 @@ opt_set_data:
 transaction_stmt:
   ABORT opt_transaction
   {
-    $$ = nil
+    $$ = &RollbackTransaction{}
   }
 | BEGIN opt_transaction transaction_mode_list_or_empty
   {
-    $$ = nil
+    $$ = &BeginTransaction{Isolation: $3}
   }
 | START TRANSACTION transaction_mode_list_or_empty
   {
-    $$ = nil
+    $$ = &BeginTransaction{Isolation: $3}
   }
 | COMMIT opt_transaction
   {
-    $$ = nil
+    $$ = &CommitTransaction{}
   }
 | END opt_transaction
   {
-    $$ = nil
+    $$ = &CommitTransaction{}
   }
 | ROLLBACK opt_transaction
   {
-    $$ = nil
+    $$ = &RollbackTransaction{}
   }
 | SAVEPOINT name
   {
@@ -1585,22 +1743,27 @@ opt_transaction:
 | TRANSACTION {}
 | /* EMPTY */ {}
 
-transaction_mode_item:
-  ISOLATION LEVEL iso_level {}
-| READ ONLY {}
-| READ WRITE {}
-| DEFERRABLE {}
-| NOT DEFERRABLE {}
+// TODO: Support READ ONLY, READ WRITE and [NOT] DEFERRABLE, and
+// lists of transaction modes.
+// transaction_mode_item:
+//   ISOLATION LEVEL iso_level {}
+// | READ ONLY {}
+// | READ WRITE {}
+// | DEFERRABLE {}
+// | NOT DEFERRABLE {}
 
-// Syntax with commas is SQL-spec, without commas is Postgres historical.
 transaction_mode_list:
-  transaction_mode_item {}
-| transaction_mode_list ',' transaction_mode_item {}
-| transaction_mode_list transaction_mode_item {}
+  ISOLATION LEVEL iso_level
+  {
+    $$ = $3
+  }
 
 transaction_mode_list_or_empty:
-  transaction_mode_list {}
-| /* EMPTY */ {}
+  transaction_mode_list
+| /* EMPTY */
+  {
+    $$ = UnspecifiedIsolation
+  }
 
 // CREATE [ OR REPLACE ] [ TEMP ] VIEW <viewname> '('target-list ')'
 //     AS <query> [ WITH [ CASCADED | LOCAL ] CHECK OPTION ]
@@ -1648,6 +1811,15 @@ insert_stmt:
   {
     $$ = $5
     $$.(*Insert).Table = $4
+    $$.(*Insert).OnConflict = $6
+    $$.(*Insert).Returning = $7
+  }
+| opt_with_clause UPSERT INTO insert_target insert_rest returning_clause
+  {
+    $$ = $5
+    $$.(*Insert).Table = $4
+    $$.(*Insert).Upsert = true
+    $$.(*Insert).Returning = $6
   }
 
 // Can't easily make AS optional here, because VALUES in insert_rest would have
@@ -1674,18 +1846,40 @@ insert_rest:
   }
 
 opt_on_conflict:
-  ON CONFLICT opt_conf_expr DO UPDATE SET set_clause_list where_clause {}
-| ON CONFLICT opt_conf_expr DO NOTHING {}
-| /* EMPTY */ {}
+  ON CONFLICT opt_conf_expr DO UPDATE SET set_clause_list where_clause
+  {
+    $$ = &OnConflict{Columns: NameList($3), Exprs: $7, Where: newWhere(astWhere, $8)}
+  }
+| ON CONFLICT opt_conf_expr DO NOTHING
+  {
+    $$ = &OnConflict{Columns: NameList($3), DoNothing: true}
+  }
+| /* EMPTY */
+  {
+    $$ = nil
+  }
 
+// TODO: Support the WHERE clause which selects a partial index and
+// ON CONSTRAINT name.
 opt_conf_expr:
-  '(' index_params ')' where_clause {}
-| ON CONSTRAINT name {}
-| /* EMPTY */ {}
+  '(' index_params ')'
+  {
+    $$ = $2
+  }
+| /* EMPTY */
+  {
+    $$ = nil
+  }
 
 returning_clause:
-  RETURNING target_list {}
-| /* EMPTY */ {}
+  RETURNING target_list
+  {
+    $$ = $2
+  }
+| /* EMPTY */
+  {
+    $$ = nil
+  }
 
 update_stmt:
   opt_with_clause UPDATE relation_expr_opt_alias
@@ -1694,7 +1888,7 @@ update_stmt:
     where_clause
     returning_clause
   {
-    $$ = &Update{Table: $3, Exprs: $5, Where: newWhere(astWhere, $7)}
+    $$ = &Update{Table: $3, Exprs: $5, Where: newWhere(astWhere, $7), Returning: $8}
   }
 
 set_clause_list:
@@ -1792,19 +1986,25 @@ select_no_parens:
 | select_clause sort_clause
   {
     $$ = $1
+    if err := setOrderByLimit($$, $2, nil); err != nil {
+      sqllex.Error(err.Error())
+      return 1
+    }
   }
 | select_clause opt_sort_clause for_locking_clause opt_select_limit
   {
     $$ = $1
-    if s, ok := $$.(*Select); ok {
-      s.Limit = $4
+    if err := setOrderByLimit($$, $2, $4); err != nil {
+      sqllex.Error(err.Error())
+      return 1
     }
   }
 | select_clause opt_sort_clause select_limit opt_for_locking_clause
   {
     $$ = $1
-    if s, ok := $$.(*Select); ok {
-      s.Limit = $3
+    if err := setOrderByLimit($$, $2, $3); err != nil {
+      sqllex.Error(err.Error())
+      return 1
     }
   }
 | with_clause select_clause
@@ -1814,19 +2014,25 @@ select_no_parens:
 | with_clause select_clause sort_clause
   {
     $$ = $2
+    if err := setOrderByLimit($$, $3, nil); err != nil {
+      sqllex.Error(err.Error())
+      return 1
+    }
   }
 | with_clause select_clause opt_sort_clause for_locking_clause opt_select_limit
   {
     $$ = $2
-    if s, ok := $$.(*Select); ok {
-      s.Limit = $5
+    if err := setOrderByLimit($$, $3, $5); err != nil {
+      sqllex.Error(err.Error())
+      return 1
     }
   }
 | with_clause select_clause opt_sort_clause select_limit opt_for_locking_clause
   {
     $$ = $2
-    if s, ok := $$.(*Select); ok {
-      s.Limit = $4
+    if err := setOrderByLimit($$, $3, $4); err != nil {
+      sqllex.Error(err.Error())
+      return 1
     }
   }
 
@@ -1862,10 +2068,11 @@ simple_select:
     group_clause having_clause window_clause
   {
     $$ = &Select{
-      Exprs:  $3,
-      From:   $4,
-      Where:  newWhere(astWhere, $5),
-      Having: newWhere(astHaving, $7),
+      Exprs:   $3,
+      From:    $4,
+      Where:   newWhere(astWhere, $5),
+      GroupBy: $6,
+      Having:  newWhere(astHaving, $7),
     }
   }
 | SELECT distinct_clause target_list
@@ -1878,6 +2085,7 @@ simple_select:
       Exprs:    $3,
       From:     $4,
       Where:    newWhere(astWhere, $5),
+      GroupBy:  $6,
       Having:   newWhere(astHaving, $7),
     }
   }
@@ -1892,29 +2100,29 @@ simple_select:
   }
 | select_clause UNION all_or_distinct select_clause
   {
-    // TODO(pmattis): Support all/distinct
     $$ = &Union{
       Type:  astUnion,
       Left:  $1.(SelectStatement),
       Right: $4.(SelectStatement),
+      All:   $3,
     }
   }
 | select_clause INTERSECT all_or_distinct select_clause
   {
-    // TODO(pmattis): Support all/distinct
     $$ = &Union{
       Type:  astIntersect,
       Left:  $1.(SelectStatement),
       Right: $4.(SelectStatement),
+      All:   $3,
     }
   }
 | select_clause EXCEPT all_or_distinct select_clause
   {
-    // TODO(pmattis): Support all/distinct
     $$ = &Union{
       Type:  astExcept,
       Left:  $1.(SelectStatement),
       Right: $4.(SelectStatement),
+      All:   $3,
     }
   }
 
@@ -1953,9 +2161,18 @@ opt_table:
 | /* EMPTY */ {}
 
 all_or_distinct:
-  ALL {}
-| DISTINCT {}
-| /* EMPTY */ {}
+  ALL
+  {
+    $$ = true
+  }
+| DISTINCT
+  {
+    $$ = false
+  }
+| /* EMPTY */
+  {
+    $$ = false
+  }
 
 // We use (NIL) as a placeholder to indicate that all target expressions should
 // be placed in the DISTINCT list during parsetree analysis.
@@ -1968,19 +2185,38 @@ opt_all_clause:
 | /* EMPTY */ {}
 
 opt_sort_clause:
-  sort_clause {}
-| /* EMPTY */ {}
+  sort_clause
+| /* EMPTY */
+  {
+    $$ = nil
+  }
 
 sort_clause:
-  ORDER BY sortby_list {}
+  ORDER BY sortby_list
+  {
+    $$ = $3
+  }
 
 sortby_list:
-  sortby {}
-| sortby_list ',' sortby {}
+  sortby
+  {
+    $$ = OrderBy{$1}
+  }
+| sortby_list ',' sortby
+  {
+    $$ = append($1, $3)
+  }
 
 sortby:
-  a_expr USING math_op opt_nulls_order {}
-| a_expr opt_asc_desc opt_nulls_order {}
+  a_expr USING math_op opt_nulls_order
+  {
+    sqllex.Error("unimplemented: ORDER BY USING")
+    return 1
+  }
+| a_expr opt_asc_desc opt_nulls_order
+  {
+    $$ = &Order{Expr: $1, Direction: $2}
+  }
 
 select_limit:
   limit_clause offset_clause
@@ -2077,16 +2313,32 @@ first_or_next:
 // Each item in the group_clause list is either an expression tree or a
 // GroupingSet node of some type.
 group_clause:
-  GROUP BY group_by_list {}
-| /* EMPTY */ {}
+  GROUP BY group_by_list
+  {
+    $$ = GroupBy($3)
+  }
+| /* EMPTY */
+  {
+    $$ = nil
+  }
 
 group_by_list:
-  group_by_item {}
-| group_by_list ',' group_by_item {}
+  group_by_item
+  {
+    $$ = Exprs{$1}
+  }
+| group_by_list ',' group_by_item
+  {
+    $$ = append($1, $3)
+  }
 
 group_by_item:
-  a_expr {}
-| empty_grouping_set {}
+  a_expr
+| empty_grouping_set
+  {
+    sqllex.Error("unimplemented: empty grouping set")
+    return 1
+  }
 
 empty_grouping_set:
   '(' ')' {}
@@ -2414,16 +2666,43 @@ simple_typename:
 | bit
 | character
 | const_datetime
-| const_interval opt_interval {}
-| const_interval '(' ICONST ')' {}
+| const_interval opt_interval
+  {
+    // TODO: Support the fields of the interval?
+    $$ = $1
+  }
+| const_interval '(' ICONST ')'
+  {
+    $$ = $1
+  }
 | BLOB
   {
     $$ = &BlobType{}
   }
+| BYTES
+  {
+    $$ = &BlobType{Name: astBytes}
+  }
+| BYTEA
+  {
+    $$ = &BlobType{Name: astBytea}
+  }
 | TEXT
   {
     $$ = &TextType{}
   }
+| SERIAL
+  {
+    $$ = &SerialType{Name: astSerial}
+  }
+| SMALLSERIAL
+  {
+    $$ = &SerialType{Name: astSmallSerial}
+  }
+| BIGSERIAL
+  {
+    $$ = &SerialType{Name: astBigSerial}
+  }
 
 // We have a separate const_typename to allow defaulting fixed-length types
 // such as CHAR() and BIT() to an unspecified length. SQL9x requires that these
@@ -2607,7 +2886,10 @@ const_datetime:
   }
 
 const_interval:
-  INTERVAL {}
+  INTERVAL
+  {
+    $$ = &IntervalType{}
+  }
 
 opt_timezone:
   WITH_LA TIME ZONE {}
@@ -2766,7 +3048,7 @@ a_expr:
   }
 | a_expr LIKE a_expr ESCAPE a_expr %prec LIKE
   {
-    $$ = &ComparisonExpr{Operator: Like, Left: $1, Right: $3}
+    $$ = &ComparisonExpr{Operator: Like, Left: $1, Right: $3, Escape: $5}
   }
 | a_expr NOT_LA LIKE a_expr %prec NOT_LA
   {
@@ -2774,12 +3056,56 @@ a_expr:
   }
 | a_expr NOT_LA LIKE a_expr ESCAPE a_expr %prec NOT_LA
   {
-    $$ = &ComparisonExpr{Operator: NotLike, Left: $1, Right: $4}
+    $$ = &ComparisonExpr{Operator: NotLike, Left: $1, Right: $4, Escape: $6}
+  }
+| a_expr ILIKE a_expr
+  {
+    $$ = &ComparisonExpr{Operator: ILike, Left: $1, Right: $3}
+  }
+| a_expr ILIKE a_expr ESCAPE a_expr %prec ILIKE
+  {
+    $$ = &ComparisonExpr{Operator: ILike, Left: $1, Right: $3, Escape: $5}
+  }
+| a_expr NOT_LA ILIKE a_expr %prec NOT_LA
+  {
+    $$ = &ComparisonExpr{Operator: NotILike, Left: $1, Right: $4}
+  }
+| a_expr NOT_LA ILIKE a_expr ESCAPE a_expr %prec NOT_LA
+  {
+    $$ = &ComparisonExpr{Operator: NotILike, Left: $1, Right: $4, Escape: $6}
+  }
+| a_expr SIMILAR TO a_expr %prec SIMILAR
+  {
+    $$ = &ComparisonExpr{Operator: SimilarTo, Left: $1, Right: $4}
+  }
+| a_expr SIMILAR TO a_expr ESCAPE a_expr %prec SIMILAR
+  {
+    $$ = &ComparisonExpr{Operator: SimilarTo, Left: $1, Right: $4, Escape: $6}
+  }
+| a_expr NOT_LA SIMILAR TO a_expr %prec NOT_LA
+  {
+    $$ = &ComparisonExpr{Operator: NotSimilarTo, Left: $1, Right: $5}
+  }
+| a_expr NOT_LA SIMILAR TO a_expr ESCAPE a_expr %prec NOT_LA
+  {
+    $$ = &ComparisonExpr{Operator: NotSimilarTo, Left: $1, Right: $5, Escape: $7}
+  }
+| a_expr '~' a_expr %prec CONCAT
+  {
+    $$ = &ComparisonExpr{Operator: RegMatch, Left: $1, Right: $3}
+  }
+| a_expr NOT_REGMATCH a_expr
+  {
+    $$ = &ComparisonExpr{Operator: NotRegMatch, Left: $1, Right: $3}
+  }
+| a_expr REGIMATCH a_expr
+  {
+    $$ = &ComparisonExpr{Operator: RegIMatch, Left: $1, Right: $3}
+  }
+| a_expr NOT_REGIMATCH a_expr
+  {
+    $$ = &ComparisonExpr{Operator: NotRegIMatch, Left: $1, Right: $3}
   }
-| a_expr SIMILAR TO a_expr %prec SIMILAR {}
-| a_expr SIMILAR TO a_expr ESCAPE a_expr %prec SIMILAR {}
-| a_expr NOT_LA SIMILAR TO a_expr %prec NOT_LA {}
-| a_expr NOT_LA SIMILAR TO a_expr ESCAPE a_expr %prec NOT_LA {}
 | a_expr IS NULL %prec IS
   {
     $$ = &NullCheck{Expr: $1}
@@ -3033,15 +3359,42 @@ func_expr_windowless:
 // Special expressions that are considered to be functions.
 func_expr_common_subexpr:
   COLLATION FOR '(' a_expr ')' {}
-| CURRENT_DATE {}
-| CURRENT_TIME {}
-| CURRENT_TIME '(' ICONST ')' {}
-| CURRENT_TIMESTAMP {}
-| CURRENT_TIMESTAMP '(' ICONST ')' {}
-| LOCALTIME {}
-| LOCALTIME '(' ICONST ')' {}
-| LOCALTIMESTAMP {}
-| LOCALTIMESTAMP '(' ICONST ')' {}
+| CURRENT_DATE
+  {
+    $$ = &CastExpr{Expr: nowFuncExpr(), Type: &DateType{}}
+  }
+| CURRENT_TIME
+  {
+    $$ = &CastExpr{Expr: nowFuncExpr(), Type: &TimeType{}}
+  }
+| CURRENT_TIME '(' ICONST ')'
+  {
+    $$ = &CastExpr{Expr: nowFuncExpr(), Type: &TimeType{}}
+  }
+| CURRENT_TIMESTAMP
+  {
+    $$ = nowFuncExpr()
+  }
+| CURRENT_TIMESTAMP '(' ICONST ')'
+  {
+    $$ = nowFuncExpr()
+  }
+| LOCALTIME
+  {
+    $$ = &CastExpr{Expr: nowFuncExpr(), Type: &TimeType{}}
+  }
+| LOCALTIME '(' ICONST ')'
+  {
+    $$ = &CastExpr{Expr: nowFuncExpr(), Type: &TimeType{}}
+  }
+| LOCALTIMESTAMP
+  {
+    $$ = nowFuncExpr()
+  }
+| LOCALTIMESTAMP '(' ICONST ')'
+  {
+    $$ = nowFuncExpr()
+  }
 | CURRENT_ROLE {}
 | CURRENT_USER {}
 | SESSION_USER {}
@@ -3052,19 +3405,52 @@ func_expr_common_subexpr:
   {
     $$ = &CastExpr{Expr: $3, Type: $5}
   }
-| EXTRACT '(' extract_list ')' {}
+| EXTRACT '(' extract_list ')'
+  {
+    $$ = &FuncExpr{Name: &QualifiedName{Base: Name("extract")}, Exprs: $3}
+  }
 | OVERLAY '(' overlay_list ')' {}
-| POSITION '(' position_list ')' {}
-| SUBSTRING '(' substr_list ')' {}
+| POSITION '(' position_list ')'
+  {
+    $$ = &FuncExpr{Name: &QualifiedName{Base: Name("strpos")}, Exprs: $3}
+  }
+| SUBSTRING '(' substr_list ')'
+  {
+    $$ = &FuncExpr{Name: &QualifiedName{Base: Name("substring")}, Exprs: $3}
+  }
 | TREAT '(' a_expr AS typename ')' {}
-| TRIM '(' BOTH trim_list ')' {}
-| TRIM '(' LEADING trim_list ')' {}
-| TRIM '(' TRAILING trim_list ')' {}
-| TRIM '(' trim_list ')' {}
-| NULLIF '(' a_expr ',' a_expr ')' {}
-| COALESCE '(' expr_list ')' {}
-| GREATEST '(' expr_list ')' {}
-| LEAST '(' expr_list ')' {}
+| TRIM '(' BOTH trim_list ')'
+  {
+    $$ = &FuncExpr{Name: &QualifiedName{Base: Name("btrim")}, Exprs: $4}
+  }
+| TRIM '(' LEADING trim_list ')'
+  {
+    $$ = &FuncExpr{Name: &QualifiedName{Base: Name("ltrim")}, Exprs: $4}
+  }
+| TRIM '(' TRAILING trim_list ')'
+  {
+    $$ = &FuncExpr{Name: &QualifiedName{Base: Name("rtrim")}, Exprs: $4}
+  }
+| TRIM '(' trim_list ')'
+  {
+    $$ = &FuncExpr{Name: &QualifiedName{Base: Name("btrim")}, Exprs: $3}
+  }
+| NULLIF '(' a_expr ',' a_expr ')'
+  {
+    $$ = &FuncExpr{Name: &QualifiedName{Base: Name("nullif")}, Exprs: Exprs{$3, $5}}
+  }
+| COALESCE '(' expr_list ')'
+  {
+    $$ = &FuncExpr{Name: &QualifiedName{Base: Name("coalesce")}, Exprs: $3}
+  }
+| GREATEST '(' expr_list ')'
+  {
+    $$ = &FuncExpr{Name: &QualifiedName{Base: Name("greatest")}, Exprs: $3}
+  }
+| LEAST '(' expr_list ')'
+  {
+    $$ = &FuncExpr{Name: &QualifiedName{Base: Name("least")}, Exprs: $3}
+  }
 
 // Aggregate decoration clauses
 within_group_clause:
@@ -3200,6 +3586,8 @@ subquery_op:
   math_op {}
 | LIKE {}
 | NOT_LA LIKE {}
+| ILIKE {}
+| NOT_LA ILIKE {}
   // cannot put SIMILAR TO here, because SIMILAR TO is a hack.
   // the regular expression is preprocessed by a function (similar_escape),
   // and the ~ operator for posix regular expressions is used.
@@ -3232,20 +3620,30 @@ array_expr_list:
 | array_expr_list ',' array_expr {}
 
 extract_list:
-  extract_arg FROM a_expr {}
-| /* EMPTY */ {}
+  extract_arg FROM a_expr
+  {
+    $$ = Exprs{StrVal($1), $3}
+  }
+| expr_list
+  {
+    $$ = $1
+  }
+| /* EMPTY */
+  {
+    $$ = nil
+  }
 
 // Allow delimited string SCONST in extract_arg as an SQL extension.
 // - thomas 2001-04-12
 extract_arg:
-  IDENT {}
-| YEAR {}
-| MONTH {}
-| DAY {}
-| HOUR {}
-| MINUTE {}
-| SECOND {}
-| SCONST {}
+  IDENT
+| YEAR
+| MONTH
+| DAY
+| HOUR
+| MINUTE
+| SECOND
+| SCONST
 
 // OVERLAY() arguments
 // SQL99 defines the OVERLAY() function:
@@ -3261,8 +3659,14 @@ overlay_placing:
 
 // position_list uses b_expr not a_expr to avoid conflict with general IN
 position_list:
-  b_expr IN b_expr {}
-| /* EMPTY */ {}
+  b_expr IN b_expr
+  {
+    $$ = Exprs{$3, $1}
+  }
+| /* EMPTY */
+  {
+    $$ = nil
+  }
 
 // SUBSTRING() arguments
 // SQL9x defines a specific syntax for arguments to SUBSTRING():
@@ -3276,23 +3680,56 @@ position_list:
 // here, and convert the SQL9x style to the generic list for further
 // processing. - thomas 2000-11-28
 substr_list:
-  a_expr substr_from substr_for {}
-| a_expr substr_for substr_from {}
-| a_expr substr_from {}
-| a_expr substr_for {}
-| expr_list {}
-| /* EMPTY */ {}
+  a_expr substr_from substr_for
+  {
+    $$ = Exprs{$1, $2, $3}
+  }
+| a_expr substr_for substr_from
+  {
+    $$ = Exprs{$1, $3, $2}
+  }
+| a_expr substr_from
+  {
+    $$ = Exprs{$1, $2}
+  }
+| a_expr substr_for
+  {
+    $$ = Exprs{$1, IntVal(1), $2}
+  }
+| expr_list
+  {
+    $$ = $1
+  }
+| /* EMPTY */
+  {
+    $$ = nil
+  }
 
 substr_from:
-  FROM a_expr {}
+  FROM a_expr
+  {
+    $$ = $2
+  }
 
 substr_for:
-  FOR a_expr {}
+  FOR a_expr
+  {
+    $$ = $2
+  }
 
 trim_list:
-  a_expr FROM expr_list {}
-| FROM expr_list {}
-| expr_list {}
+  a_expr FROM expr_list
+  {
+    $$ = append($3, $1)
+  }
+| FROM expr_list
+  {
+    $$ = $2
+  }
+| expr_list
+  {
+    $$ = $1
+  }
 
 in_expr:
   select_with_parens
@@ -3539,18 +3976,25 @@ a_expr_const:
   }
 | BCONST
   {
-    // TODO(pmattis): bit literal.
-    $$ = StrVal($1)
+    $$ = BytesVal($1)
   }
 | XCONST
   {
-    // TODO(pmattis): hex literal.
-    $$ = StrVal($1)
+    $$ = BytesVal($1)
   }
 | func_name '(' expr_list opt_sort_clause ')' SCONST {}
-| const_typename SCONST {}
-| const_interval SCONST opt_interval {}
-| const_interval '(' ICONST ')' SCONST {}
+| const_typename SCONST
+  {
+    $$ = &CastExpr{Expr: StrVal($2), Type: $1}
+  }
+| const_interval SCONST opt_interval
+  {
+    $$ = &CastExpr{Expr: StrVal($2), Type: $1}
+  }
+| const_interval '(' ICONST ')' SCONST
+  {
+    $$ = &CastExpr{Expr: StrVal($5), Type: $1}
+  }
 | TRUE
   {
     $$ = BoolVal(true)
@@ -3656,6 +4100,8 @@ unreserved_keyword:
 | BEFORE
 | BEGIN
 | BY
+| BYTEA
+| BYTES
 | CACHE
 | CALLED
 | CASCADE
@@ -3887,6 +4333,7 @@ unreserved_keyword:
 | UNLOGGED
 | UNTIL
 | UPDATE
+| UPSERT
 | VACUUM
 | VALID
 | VALIDATE
@@ -3919,6 +4366,7 @@ unreserved_keyword:
 col_name_keyword:
   BETWEEN
 | BIGINT
+| BIGSERIAL
 | BIT
 | BOOLEAN
 | CHAR
@@ -3948,8 +4396,10 @@ col_name_keyword:
 | PRECISION
 | REAL
 | ROW
+| SERIAL
 | SETOF
 | SMALLINT
+| SMALLSERIAL
 | SUBSTRING
 | TIME
 | TIMESTAMP
@@ -3976,6 +4426,7 @@ type_func_name_keyword:
 | CURRENT_SCHEMA
 | FREEZE
 | FULL
+| ILIKE
 | INNER
 | IS
 | JOIN
@@ -23,17 +23,22 @@ import (
 )
 
 const (
-	astInt      = "INT"
-	astInteger  = "INTEGER"
-	astSmallInt = "SMALLINT"
-	astBigInt   = "BIGINT"
-	astReal     = "REAL"
-	astDouble   = "DOUBLE PRECISION"
-	astFloat    = "FLOAT"
-	astDecimal  = "DECIMAL"
-	astNumeric  = "NUMERIC"
-	astChar     = "CHAR"
-	astVarChar  = "VARCHAR"
+	astInt         = "INT"
+	astInteger     = "INTEGER"
+	astSmallInt    = "SMALLINT"
+	astBigInt      = "BIGINT"
+	astSerial      = "SERIAL"
+	astSmallSerial = "SMALLSERIAL"
+	astBigSerial   = "BIGSERIAL"
+	astReal        = "REAL"
+	astDouble      = "DOUBLE PRECISION"
+	astFloat       = "FLOAT"
+	astDecimal     = "DECIMAL"
+	astNumeric     = "NUMERIC"
+	astChar        = "CHAR"
+	astVarChar     = "VARCHAR"
+	astBytes       = "BYTES"
+	astBytea       = "BYTEA"
 )
 
 // ColumnType represents a type in a column definition.
@@ -45,11 +50,13 @@ type ColumnType interface {
 func (*BitType) columnType()       {}
 func (*BoolType) columnType()      {}
 func (*IntType) columnType()       {}
+func (*SerialType) columnType()    {}
 func (*FloatType) columnType()     {}
 func (*DecimalType) columnType()   {}
 func (*DateType) columnType()      {}
 func (*TimeType) columnType()      {}
 func (*TimestampType) columnType() {}
+func (*IntervalType) columnType()  {}
 func (*CharType) columnType()      {}
 func (*TextType) columnType()      {}
 func (*BlobType) columnType()      {}
@@ -90,6 +97,15 @@ func (node *IntType) String() string {
 	return buf.String()
 }
 
+// SerialType represents a SERIAL, SMALLSERIAL or BIGSERIAL type.
+type SerialType struct {
+	Name string
+}
+
+func (node *SerialType) String() string {
+	return node.Name
+}
+
 // FloatType represents a REAL, DOUBLE or FLOAT type.
 type FloatType struct {
 	Name string
@@ -149,6 +165,14 @@ func (node *TimestampType) String() string {
 	return "TIMESTAMP"
 }
 
+// IntervalType represents an INTERVAL type.
+type IntervalType struct {
+}
+
+func (node *IntervalType) String() string {
+	return "INTERVAL"
+}
+
 // CharType represents a CHAR or VARCHAR type.
 type CharType struct {
 	Name string
@@ -173,11 +197,14 @@ func (node *TextType) String() string {
 	return "TEXT"
 }
 
-// BlobType represents a BLOB type.
+// BlobType represents a BLOB, BYTES or BYTEA type.
 type BlobType struct {
 	Name string
 }
 
 func (node *BlobType) String() string {
+	if node.Name != "" {
+		return node.Name
+	}
 	return "BLOB"
 }
@@ -50,6 +50,8 @@ func TestParseColumnType(t *testing.T) {
 		{"CHAR(11)", &CharType{Name: astChar, N: 11}},
 		{"TEXT", &TextType{}},
 		{"BLOB", &BlobType{}},
+		{"BYTES", &BlobType{Name: astBytes}},
+		{"BYTEA", &BlobType{Name: astBytea}},
 	}
 	for i, d := range testData {
 		sql := fmt.Sprintf("CREATE TABLE a (b %s)", d.str)
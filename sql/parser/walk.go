@@ -50,6 +50,9 @@ func WalkExpr(v Visitor, expr Expr) Expr {
 	case *ComparisonExpr:
 		t.Left = WalkExpr(v, t.Left)
 		t.Right = WalkExpr(v, t.Right)
+		if t.Escape != nil {
+			t.Escape = WalkExpr(v, t.Escape)
+		}
 
 	case *RangeCond:
 		t.Left = WalkExpr(v, t.Left)
@@ -132,6 +135,14 @@ func WalkExpr(v Visitor, expr Expr) Expr {
 	case *CastExpr:
 		t.Expr = WalkExpr(v, t.Expr)
 
+	case *DReference:
+		// Terminal node: nothing to do.
+
+	case *DSubquery:
+		for i := range t.Args {
+			t.Args[i] = WalkExpr(v, t.Args[i])
+		}
+
 	default:
 		panic(fmt.Sprintf("walk: unsupported expression type: %T", expr))
 	}
@@ -181,21 +192,30 @@ func FillArgs(stmt Statement, args Args) error {
 // by WalkExpr.
 func WalkStmt(v Visitor, stmt Statement) {
 	switch stmt := stmt.(type) {
+	case *CreateTable:
+		if stmt.AsSource != nil {
+			WalkStmt(v, stmt.AsSource)
+		}
 	case *Delete:
 		if stmt.Where != nil {
 			stmt.Where.Expr = WalkExpr(v, stmt.Where.Expr)
 		}
+		walkSelectExprs(v, stmt.Returning)
 	case *Insert:
 		WalkStmt(v, stmt.Rows)
+		if stmt.OnConflict != nil {
+			for i, expr := range stmt.OnConflict.Exprs {
+				stmt.OnConflict.Exprs[i].Expr = WalkExpr(v, expr.Expr)
+			}
+			if stmt.OnConflict.Where != nil {
+				stmt.OnConflict.Where.Expr = WalkExpr(v, stmt.OnConflict.Where.Expr)
+			}
+		}
+		walkSelectExprs(v, stmt.Returning)
 	case *ParenSelect:
 		WalkStmt(v, stmt.Select)
 	case *Select:
-		for _, expr := range stmt.Exprs {
-			switch expr := expr.(type) {
-			case *NonStarExpr:
-				expr.Expr = WalkExpr(v, expr.Expr)
-			}
-		}
+		walkSelectExprs(v, stmt.Exprs)
 		if stmt.Where != nil {
 			stmt.Where.Expr = WalkExpr(v, stmt.Where.Expr)
 		}
@@ -205,17 +225,11 @@ func WalkStmt(v Visitor, stmt Statement) {
 		if stmt.Having != nil {
 			stmt.Having.Expr = WalkExpr(v, stmt.Having.Expr)
 		}
-		for i, expr := range stmt.OrderBy {
-			stmt.OrderBy[i].Expr = WalkExpr(v, expr.Expr)
-		}
-		if stmt.Limit != nil {
-			if stmt.Limit.Offset != nil {
-				stmt.Limit.Offset = WalkExpr(v, stmt.Limit.Offset)
-			}
-			if stmt.Limit.Count != nil {
-				stmt.Limit.Count = WalkExpr(v, stmt.Limit.Count)
-			}
-		}
+		walkOrderByLimit(v, stmt.OrderBy, stmt.Limit)
+	case *Union:
+		WalkStmt(v, stmt.Left)
+		WalkStmt(v, stmt.Right)
+		walkOrderByLimit(v, stmt.OrderBy, stmt.Limit)
 	case *Update:
 		for i, expr := range stmt.Exprs {
 			stmt.Exprs[i].Expr = WalkExpr(v, expr.Expr)
@@ -223,9 +237,33 @@ func WalkStmt(v Visitor, stmt Statement) {
 		if stmt.Where != nil {
 			stmt.Where.Expr = WalkExpr(v, stmt.Where.Expr)
 		}
+		walkSelectExprs(v, stmt.Returning)
 	case Values:
 		for i, tuple := range stmt {
 			stmt[i] = WalkExpr(v, tuple).(Tuple)
 		}
 	}
 }
+
+func walkSelectExprs(v Visitor, exprs SelectExprs) {
+	for _, expr := range exprs {
+		switch expr := expr.(type) {
+		case *NonStarExpr:
+			expr.Expr = WalkExpr(v, expr.Expr)
+		}
+	}
+}
+
+func walkOrderByLimit(v Visitor, orderBy OrderBy, limit *Limit) {
+	for i, expr := range orderBy {
+		orderBy[i].Expr = WalkExpr(v, expr.Expr)
+	}
+	if limit != nil {
+		if limit.Offset != nil {
+			limit.Offset = WalkExpr(v, limit.Offset)
+		}
+		if limit.Count != nil {
+			limit.Count = WalkExpr(v, limit.Count)
+		}
+	}
+}
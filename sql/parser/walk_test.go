@@ -135,6 +135,9 @@ func TestWalkStmt(t *testing.T) {
 		{`SELECT $1, $2 FROM db.table WHERE c in ($3, 2 * $4) GROUP BY $1 HAVING COUNT($5) > $6`,
 			`SELECT 'a', 'b' FROM db.table WHERE c in (1.1, 2 * 6.5) GROUP BY 'a' HAVING COUNT('d') > 6`,
 			mapArgs{1: DString(`a`), 2: DString(`b`), 3: DFloat(1.1), 4: DFloat(6.5), 5: DString('d'), 6: DInt(6)}},
+		{`SELECT $1 FROM db.t UNION SELECT v FROM db.u WHERE k = $2 ORDER BY $3 LIMIT $4`,
+			`SELECT 'a' FROM db.t UNION SELECT v FROM db.u WHERE k = 'b' ORDER BY 1 LIMIT 2`,
+			mapArgs{1: DString(`a`), 2: DString(`b`), 3: DInt(1), 4: DInt(2)}},
 		{`UPDATE db.table SET v = $3 WHERE k IN ($1, $2)`,
 			`UPDATE db.table SET v = 2 WHERE k IN ('a', 'b')`,
 			mapArgs{1: DString(`a`), 2: DString(`b`), 3: DInt(2)}},
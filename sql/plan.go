@@ -29,9 +29,32 @@ import (
 // planner is the centerpiece of SQL statement execution combining session
 // state and database state with the logic for SQL execution.
 type planner struct {
-	db      *client.DB
+	db *client.DB
+	// The transaction within which the statement is run. It is either the
+	// explicit transaction opened by BEGIN or an implicit transaction for the
+	// statement.
+	txn *client.Txn
+	// Whether txn is the implicit transaction of the statements of a request,
+	// which commits once the statements have run.
+	implicitTxn bool
+	// The indexes added by the statements of the implicit transaction, which
+	// are backfilled once the transaction commits.
+	newIndexes []newIndex
+	// The allocator of the values of SERIAL columns, which is shared by all of
+	// the planners of the server.
+	serials *serialAllocator
 	session Session
 	user    string
+	// The context in which the expressions of the statement are evaluated.
+	evalCtx parser.EvalContext
+	// The scopes of the queries enclosing the subquery being planned, innermost
+	// last.
+	outer []*outerScope
+	// Whether the statement is planned for EXPLAIN, in which case its
+	// subqueries are planned but not run.
+	explaining bool
+	// The plans of the subqueries of the explained statement.
+	subqueryPlans []subqueryPlan
 }
 
 // makePlan creates the query plan for a single SQL statement. The returned
@@ -40,34 +63,62 @@ type planner struct {
 func (p *planner) makePlan(stmt parser.Statement) (planNode, error) {
 	// TODO(pmattis): It is somewhat premature to expand subqueries here as we
 	// should make sure the statement is otherwise valid first. But it is
-	// correct.
-	if err := p.expandSubqueries(stmt); err != nil {
-		return nil, err
+	// correct. The subqueries of a SELECT are expanded once the tables of its
+	// FROM clause are known so that they can refer to the columns of those
+	// tables.
+	switch stmt.(type) {
+	case *parser.Select, *parser.ParenSelect:
+	default:
+		if err := p.expandSubqueries(stmt, nil); err != nil {
+			return nil, err
+		}
 	}
 
 	switch n := stmt.(type) {
+	case *parser.AlterTable:
+		return p.AlterTable(n)
+	case *parser.BeginTransaction:
+		return p.BeginTransaction(n)
+	case *parser.CommitTransaction:
+		return p.CommitTransaction(n)
 	case *parser.CreateDatabase:
 		return p.CreateDatabase(n)
+	case *parser.CreateIndex:
+		return p.CreateIndex(n)
 	case *parser.CreateTable:
 		return p.CreateTable(n)
 	case *parser.Delete:
 		return p.Delete(n)
 	case *parser.DropDatabase:
 		return p.DropDatabase(n)
+	case *parser.DropIndex:
+		return p.DropIndex(n)
 	case *parser.DropTable:
 		return p.DropTable(n)
+	case *parser.Explain:
+		return p.Explain(n)
 	case *parser.Grant:
 		return p.Grant(n)
 	case *parser.Insert:
 		return p.Insert(n)
 	case *parser.ParenSelect:
 		return p.makePlan(n.Select)
+	case *parser.RenameColumn:
+		return p.RenameColumn(n)
+	case *parser.RenameDatabase:
+		return p.RenameDatabase(n)
+	case *parser.RenameTable:
+		return p.RenameTable(n)
 	case *parser.Revoke:
 		return p.Revoke(n)
+	case *parser.RollbackTransaction:
+		return p.RollbackTransaction(n)
 	case *parser.Select:
 		return p.Select(n)
 	case *parser.Set:
 		return p.Set(n)
+	case *parser.SetTransaction:
+		return p.SetTransaction(n)
 	case *parser.ShowColumns:
 		return p.ShowColumns(n)
 	case *parser.ShowDatabases:
@@ -80,6 +131,8 @@ func (p *planner) makePlan(stmt parser.Statement) (planNode, error) {
 		return p.ShowTables(n)
 	case *parser.Truncate:
 		return p.Truncate(n)
+	case *parser.Union:
+		return p.Union(n)
 	case *parser.Update:
 		return p.Update(n)
 	case parser.Values:
@@ -128,9 +181,129 @@ type planNode interface {
 	Next() bool
 	// Err returns the error, if any, encountered during iteration.
 	Err() error
+	// ExplainPlan returns a name and description and a list of child nodes.
+	// Verbose descriptions include the filter and render expressions.
+	ExplainPlan(verbose bool) (name, description string, children []planNode)
 }
 
 var _ planNode = &scanNode{}
 var _ planNode = &valuesNode{}
 
 // TODO(pmattis): orderByNode, groupByNode, joinNode.
+
+// planColumnTypes returns samples of the types of the columns of a plan, as
+// determined from its expressions without retrieving any rows. A column whose
+// type cannot be determined, such as one which is always NULL, has the
+// sample DNull. The rows shared with the expressions of the plan, such as
+// the joined row, are filled with samples as well.
+func planColumnTypes(plan planNode) (parser.DTuple, error) {
+	switch n := plan.(type) {
+	case *scanNode:
+		env := valMap{}
+		if n.source != nil {
+			types, err := planColumnTypes(n.source)
+			if err != nil {
+				return nil, err
+			}
+			if n.vals != nil {
+				for i, col := range n.source.Columns() {
+					env[col] = types[i]
+				}
+			}
+		} else {
+			for _, col := range n.desc.Columns {
+				env[col.Name] = columnTypeSample(col.Type)
+			}
+		}
+		return typeCheckExprs(n.evalCtx, n.render, env)
+
+	case *indexJoinNode:
+		return planColumnTypes(n.table)
+
+	case *joinNode:
+		left, err := planColumnTypes(n.left)
+		if err != nil {
+			return nil, err
+		}
+		right, err := planColumnTypes(n.right)
+		if err != nil {
+			return nil, err
+		}
+		copy(n.leftColumns(), left)
+		copy(n.rightColumns(), right)
+		return append(parser.DTuple(nil), n.Values()...), nil
+
+	case *groupNode:
+		types, err := planColumnTypes(n.plan)
+		if err != nil {
+			return nil, err
+		}
+		copy(n.key, types[:n.numGroups])
+		for i, f := range n.funcs {
+			agg := f.create()
+			f.value = parser.DNull
+			// An error accumulating the sample, such as the sum of strings, makes
+			// the type of the result unknown.
+			if err := agg.add(types[n.numGroups+i]); err == nil {
+				if f.value, err = agg.result(); err != nil {
+					f.value = parser.DNull
+				}
+			}
+		}
+		return typeCheckExprs(n.evalCtx, n.render, nil)
+
+	case *sortNode:
+		types, err := planColumnTypes(n.plan)
+		if err != nil {
+			return nil, err
+		}
+		return types[:len(n.columns)], nil
+
+	case *limitNode:
+		return planColumnTypes(n.planNode)
+
+	case *unionNode:
+		left, err := planColumnTypes(n.left)
+		if err != nil {
+			return nil, err
+		}
+		right, err := planColumnTypes(n.right)
+		if err != nil {
+			return nil, err
+		}
+		for i := range left {
+			if left[i] == parser.DNull && i < len(right) {
+				left[i] = right[i]
+			}
+		}
+		return left, nil
+
+	case *valuesNode:
+		// The values are those of the statement, so the type of a column is that
+		// of its first non-NULL value.
+		types := make(parser.DTuple, len(n.columns))
+		for i := range types {
+			types[i] = parser.DNull
+			for _, row := range n.rows {
+				if row[i] != parser.DNull {
+					types[i] = row[i]
+					break
+				}
+			}
+		}
+		return types, nil
+	}
+	return nil, util.Errorf("unsupported plan: %T", plan)
+}
+
+// typeCheckExprs returns samples of the types of the expressions.
+func typeCheckExprs(ctx parser.EvalContext, exprs []parser.Expr, env parser.Env) (parser.DTuple, error) {
+	types := make(parser.DTuple, len(exprs))
+	for i, e := range exprs {
+		var err error
+		if types[i], err = parser.TypeCheckExpr(ctx, e, env); err != nil {
+			return nil, err
+		}
+	}
+	return types, nil
+}
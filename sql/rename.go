@@ -0,0 +1,246 @@
+// Copyright 2015 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License. See the AUTHORS file
+// for names of contributors.
+
+package sql
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/cockroachdb/cockroach/client"
+	"github.com/cockroachdb/cockroach/proto"
+	"github.com/cockroachdb/cockroach/security"
+	"github.com/cockroachdb/cockroach/sql/parser"
+	"github.com/cockroachdb/cockroach/structured"
+)
+
+// RenameDatabase renames the database.
+// Privileges: "root" user.
+//   Notes: postgres requires superuser, db owner, or "CREATEDB".
+//          mysql >= 5.1.23 does not allow database renames.
+func (p *planner) RenameDatabase(n *parser.RenameDatabase) (planNode, error) {
+	if n.Name == "" || n.NewName == "" {
+		return nil, errEmptyDatabaseName
+	}
+
+	if p.user != security.RootUser {
+		return nil, fmt.Errorf("only %s is allowed to rename databases", security.RootUser)
+	}
+
+	if n.Name == n.NewName {
+		// Noop.
+		return &valuesNode{}, nil
+	}
+
+	oldKey := databaseKey{string(n.Name)}
+	newKey := databaseKey{string(n.NewName)}
+	gr, err := p.txn.Get(oldKey.Key())
+	if err != nil {
+		return nil, err
+	}
+	if !gr.Exists() {
+		return nil, fmt.Errorf("database %q does not exist", oldKey.Name())
+	}
+	descKey := gr.ValueBytes()
+	desc := structured.DatabaseDescriptor{}
+	if err := p.txn.GetProto(descKey, &desc); err != nil {
+		return nil, err
+	}
+	desc.Name = n.NewName.String()
+	if err := desc.Validate(); err != nil {
+		return nil, err
+	}
+
+	b := &client.Batch{}
+	b.CPut(newKey.Key(), descKey, nil)
+	b.Put(descKey, &desc)
+	b.Del(oldKey.Key())
+
+	// The names of the table descriptors are qualified with the name of the
+	// database.
+	prefix := structured.MakeNameMetadataKey(desc.ID, "")
+	kvs, err := p.txn.Scan(prefix, prefix.PrefixEnd(), 0)
+	if err != nil {
+		return nil, err
+	}
+	for _, kv := range kvs {
+		tbDesc := structured.TableDescriptor{}
+		if err := p.txn.GetProto(kv.ValueBytes(), &tbDesc); err != nil {
+			return nil, err
+		}
+		tbName := &parser.QualifiedName{
+			Base:     n.NewName,
+			Indirect: parser.Indirection{parser.NameIndirection(bytes.TrimPrefix(kv.Key, prefix))},
+		}
+		tbDesc.Name = tbName.String()
+		b.Put(kv.ValueBytes(), &tbDesc)
+	}
+
+	if err := p.txn.Run(b); err != nil {
+		if _, ok := err.(*proto.ConditionFailedError); ok {
+			return nil, fmt.Errorf("database %q already exists", newKey.Name())
+		}
+		return nil, err
+	}
+
+	if p.session.Database == string(n.Name) {
+		p.session.Database = string(n.NewName)
+	}
+	return &valuesNode{}, nil
+}
+
+// RenameTable renames the table, possibly moving it to another database.
+// Privileges: WRITE on table and WRITE on the target database.
+//   Notes: postgres requires the table owner.
+//          mysql requires ALTER, DROP on the original table, and CREATE, INSERT
+//          on the new table (and does not copy privileges over).
+func (p *planner) RenameTable(n *parser.RenameTable) (planNode, error) {
+	if err := p.normalizeTableName(n.Name); err != nil {
+		return nil, err
+	}
+	if err := p.normalizeTableName(n.NewName); err != nil {
+		return nil, err
+	}
+
+	dbDesc, err := p.getDatabaseDesc(n.Name.Database())
+	if err != nil {
+		return nil, err
+	}
+	targetDbDesc := dbDesc
+	if n.NewName.Database() != n.Name.Database() {
+		if targetDbDesc, err = p.getDatabaseDesc(n.NewName.Database()); err != nil {
+			return nil, err
+		}
+	}
+	if !targetDbDesc.HasPrivilege(p.user, parser.PrivilegeWrite) {
+		return nil, fmt.Errorf("user %s does not have %s privilege on database %s",
+			p.user, parser.PrivilegeWrite, targetDbDesc.Name)
+	}
+
+	oldKey := tableKey{dbDesc.ID, n.Name.Table()}
+	newKey := tableKey{targetDbDesc.ID, n.NewName.Table()}
+	if oldKey == newKey {
+		// Noop.
+		return &valuesNode{}, nil
+	}
+
+	desc, err := lookupTableDescInTxn(p.txn, oldKey)
+	if err != nil {
+		return nil, err
+	}
+	if desc == nil {
+		if n.IfExists {
+			// Noop.
+			return &valuesNode{}, nil
+		}
+		return nil, fmt.Errorf("table %q does not exist", oldKey.Name())
+	}
+
+	if !desc.HasPrivilege(p.user, parser.PrivilegeWrite) {
+		return nil, fmt.Errorf("user %s does not have %s privilege on table %s",
+			p.user, parser.PrivilegeWrite, desc.Name)
+	}
+
+	desc.Name = n.NewName.String()
+	if err := desc.Validate(); err != nil {
+		return nil, err
+	}
+
+	descKey := structured.MakeDescMetadataKey(desc.ID)
+	b := &client.Batch{}
+	b.CPut(newKey.Key(), descKey, nil)
+	b.Put(descKey, desc)
+	b.Del(oldKey.Key())
+	if err := p.txn.Run(b); err != nil {
+		if _, ok := err.(*proto.ConditionFailedError); ok {
+			return nil, fmt.Errorf("table %q already exists", newKey.Name())
+		}
+		return nil, err
+	}
+	return &valuesNode{}, nil
+}
+
+// RenameColumn renames the column. Rows are encoded using column IDs, so
+// only the descriptor needs to be updated.
+// Privileges: WRITE on table.
+//   Notes: postgres requires the table owner.
+//          mysql requires ALTER, CREATE, INSERT on the table.
+func (p *planner) RenameColumn(n *parser.RenameColumn) (planNode, error) {
+	if err := p.normalizeTableName(n.Table); err != nil {
+		return nil, err
+	}
+
+	dbDesc, err := p.getDatabaseDesc(n.Table.Database())
+	if err != nil {
+		return nil, err
+	}
+
+	tbKey := tableKey{dbDesc.ID, n.Table.Table()}
+	desc, err := lookupTableDescInTxn(p.txn, tbKey)
+	if err != nil {
+		return nil, err
+	}
+	if desc == nil {
+		if n.IfExists {
+			// Noop.
+			return &valuesNode{}, nil
+		}
+		return nil, fmt.Errorf("table %q does not exist", tbKey.Name())
+	}
+
+	if !desc.HasPrivilege(p.user, parser.PrivilegeWrite) {
+		return nil, fmt.Errorf("user %s does not have %s privilege on table %s",
+			p.user, parser.PrivilegeWrite, desc.Name)
+	}
+
+	col, err := desc.FindColumnByName(string(n.Name))
+	if err != nil {
+		return nil, err
+	}
+	if n.Name == n.NewName {
+		// Noop.
+		return &valuesNode{}, nil
+	}
+	if _, err := desc.FindColumnByName(string(n.NewName)); err == nil {
+		return nil, fmt.Errorf("column %q already exists", n.NewName)
+	}
+	col.Name = string(n.NewName)
+
+	// The CHECK constraints refer to the column by name as well.
+	if err := renameColumnInChecks(desc, string(n.Name), col.Name); err != nil {
+		return nil, err
+	}
+
+	// The indexes refer to the column by name as well.
+	indexes := []*structured.IndexDescriptor{&desc.PrimaryIndex}
+	for i := range desc.Indexes {
+		indexes = append(indexes, &desc.Indexes[i])
+	}
+	for _, index := range indexes {
+		for i, id := range index.ColumnIDs {
+			if id == col.ID {
+				index.ColumnNames[i] = col.Name
+			}
+		}
+	}
+	if err := desc.Validate(); err != nil {
+		return nil, err
+	}
+
+	if err := p.txn.Put(structured.MakeDescMetadataKey(desc.ID), desc); err != nil {
+		return nil, err
+	}
+	return &valuesNode{}, nil
+}
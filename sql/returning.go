@@ -0,0 +1,115 @@
+// Copyright 2015 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License. See the AUTHORS file
+// for names of contributors.
+
+package sql
+
+import (
+	"fmt"
+
+	"github.com/cockroachdb/cockroach/sql/parser"
+	"github.com/cockroachdb/cockroach/structured"
+)
+
+// returningHelper evaluates the RETURNING expressions of an INSERT, UPDATE or
+// DELETE statement for each row the statement affects. A nil returningHelper
+// is used when there is no RETURNING clause and returns no rows.
+type returningHelper struct {
+	desc    *structured.TableDescriptor
+	alias   string
+	exprs   []parser.Expr
+	columns []string
+	rows    []parser.DTuple
+	evalCtx parser.EvalContext
+}
+
+// makeReturningHelper returns a returningHelper for the RETURNING expressions
+// of a statement which writes to the table, or nil if there are none. The
+// expressions refer to the columns of the table, either unqualified or
+// qualified by the alias of the table.
+func (p *planner) makeReturningHelper(desc *structured.TableDescriptor, alias string,
+	r parser.SelectExprs) (*returningHelper, error) {
+	if r == nil {
+		return nil, nil
+	}
+
+	rh := &returningHelper{desc: desc, alias: alias, evalCtx: p.evalCtx}
+	for _, e := range r {
+		switch t := e.(type) {
+		case *parser.StarExpr:
+			if t.TableName != nil && t.TableName.String() != alias {
+				return nil, fmt.Errorf("table \"%s\" not found", t.TableName)
+			}
+			for _, col := range desc.VisibleColumns() {
+				rh.columns = append(rh.columns, col.Name)
+				rh.exprs = append(rh.exprs, &parser.QualifiedName{Base: parser.Name(col.Name)})
+			}
+		case *parser.NonStarExpr:
+			rh.exprs = append(rh.exprs, t.Expr)
+			if t.As != "" {
+				rh.columns = append(rh.columns, string(t.As))
+			} else {
+				rh.columns = append(rh.columns, t.Expr.String())
+			}
+		}
+	}
+
+	// Verify that the expressions only refer to columns of the table, which
+	// would otherwise only be detected if a row is affected.
+	env := makeRowEnv(desc, alias, nil, nil)
+	for _, expr := range rh.exprs {
+		for _, ref := range columnRefs(expr) {
+			if _, ok := env[ref]; !ok {
+				return nil, fmt.Errorf("column \"%s\" not found", ref)
+			}
+		}
+	}
+	return rh, nil
+}
+
+// append evaluates the expressions for an affected row, whose values are
+// indexed by colIDtoRowIndex, and adds the result to the returned rows.
+func (rh *returningHelper) append(colIDtoRowIndex map[structured.ID]int, values parser.DTuple) error {
+	if rh == nil {
+		return nil
+	}
+	env := makeRowEnv(rh.desc, rh.alias, colIDtoRowIndex, values)
+	row := make(parser.DTuple, len(rh.exprs))
+	for i, expr := range rh.exprs {
+		var err error
+		if row[i], err = parser.EvalExpr(rh.evalCtx, expr, env); err != nil {
+			return err
+		}
+	}
+	rh.rows = append(rh.rows, row)
+	return nil
+}
+
+// getResults returns a plan which returns the rows added by append.
+func (rh *returningHelper) getResults() planNode {
+	if rh == nil {
+		return &valuesNode{}
+	}
+	return &valuesNode{columns: rh.columns, rows: rh.rows}
+}
+
+// tableAlias returns the name by which the columns of the table of an UPDATE
+// or DELETE statement are qualified.
+func tableAlias(n parser.TableExpr) string {
+	ate := n.(*parser.AliasedTableExpr)
+	if ate.As != "" {
+		return string(ate.As)
+	}
+	return ate.Expr.(*parser.QualifiedName).Table()
+}
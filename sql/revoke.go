@@ -53,7 +53,7 @@ func (p *planner) Revoke(n *parser.Revoke) (planNode, error) {
 	// TODO(marc): do this inside a transaction. This will be needed
 	// when modifying multiple descriptors in the same op.
 	descKey := structured.MakeDescMetadataKey(descriptor.GetID())
-	if err := p.db.Put(descKey, descriptor); err != nil {
+	if err := p.txn.Put(descKey, descriptor); err != nil {
 		return nil, err
 	}
 
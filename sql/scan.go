@@ -21,29 +21,61 @@ import (
 	"bytes"
 	"fmt"
 	"math"
+	"time"
 
 	"github.com/cockroachdb/cockroach/client"
 	"github.com/cockroachdb/cockroach/proto"
 	"github.com/cockroachdb/cockroach/sql/parser"
 	"github.com/cockroachdb/cockroach/structured"
+	"github.com/cockroachdb/cockroach/util/decimal"
 	"github.com/cockroachdb/cockroach/util/encoding"
 	"github.com/cockroachdb/cockroach/util/log"
 )
 
+// scanBatchSize is the maximum number of key/value pairs retrieved by a single
+// KV scan. Larger spans are retrieved in multiple batches.
+var scanBatchSize int64 = 1000
+
+// A span is a contiguous range of keys, [start, end), to scan.
+type span struct {
+	start proto.Key // inclusive key
+	end   proto.Key // exclusive key
+}
+
+// kvScanner is implemented by both client.DB and client.Txn, allowing a
+// scanNode to read a table either outside of or within a transaction.
+type kvScanner interface {
+	Scan(begin, end interface{}, maxRows int64) ([]client.KeyValue, error)
+}
+
 // A scanNode handles scanning over the key/value pairs for a table and
-// reconstructing them into rows.
+// reconstructing them into rows. Alternatively, the rows can be retrieved from
+// a source plan, such as a join, in which case the filter and render
+// expressions refer to the values of the source rows through DReferences, or
+// a subquery in the FROM clause, in which case vals holds the values of the
+// source rows.
 type scanNode struct {
-	db         *client.DB
-	desc       *structured.TableDescriptor
-	columns    []string
-	err        error
-	primaryKey []byte            // the primary key of the current row
-	kvs        []client.KeyValue // the raw key/value pairs
-	kvIndex    int               // current index into the key/value pairs
-	vals       valMap            // the values in the current row
-	row        parser.DTuple     // the rendered row
-	filter     parser.Expr       // filtering expression for rows
-	render     []parser.Expr     // rendering expressions for rows
+	db               kvScanner
+	source           planNode
+	desc             *structured.TableDescriptor
+	index            *structured.IndexDescriptor
+	isSecondaryIndex bool
+	spans            []span // the spans to scan; the whole index if empty
+	exactPrefix      int    // the number of leading index columns with a single value
+	maxRows          int64  // the maximum number of rows needed, or 0 if unknown
+	columns          []string
+	evalCtx          parser.EvalContext
+	err              error
+	initialized      bool
+	spanIndex        int               // the span currently being scanned
+	resumeKey        proto.Key         // where to resume scanning the current span
+	indexKey         []byte            // the index key of the current row
+	kvs              []client.KeyValue // the current batch of raw key/value pairs
+	kvIndex          int               // current index into the key/value pairs
+	vals             valMap            // the values in the current row
+	row              parser.DTuple     // the rendered row
+	filter           parser.Expr       // filtering expression for rows
+	render           []parser.Expr     // rendering expressions for rows
 }
 
 func (n *scanNode) Columns() []string {
@@ -59,24 +91,12 @@ func (n *scanNode) Next() bool {
 		return false
 	}
 
-	if n.kvs == nil {
-		// Initialize our key/values.
-		if n.desc == nil {
-			// No table to read from, pretend there is a single empty row.
-			n.kvs = []client.KeyValue{}
-			n.primaryKey = []byte{}
-		} else {
-			// Retrieve all of the keys that start with our index key prefix.
-			startKey := proto.Key(structured.MakeIndexKeyPrefix(n.desc.ID, n.desc.PrimaryIndex.ID))
-			endKey := startKey.PrefixEnd()
-			// TODO(pmattis): Currently we retrieve all of the key/value pairs for
-			// the table. We could enhance this code so that it retrieves the
-			// key/value pairs in chunks.
-			n.kvs, n.err = n.db.Scan(startKey, endKey, 0)
-			if n.err != nil {
-				return false
-			}
-		}
+	if n.source != nil {
+		return n.nextSourceRow()
+	}
+
+	if !n.initialized {
+		n.initScan()
 	}
 
 	// All of the columns for a particular row will be grouped together. We loop
@@ -86,15 +106,15 @@ func (n *scanNode) Next() bool {
 	// column name. When the index key changes we output a row containing the
 	// current values.
 	for {
-		var kv client.KeyValue
-		if n.kvIndex < len(n.kvs) {
-			kv = n.kvs[n.kvIndex]
+		kv, ok := n.nextKV()
+		if n.err != nil {
+			return false
 		}
 
-		if n.primaryKey != nil &&
-			(n.kvIndex == len(n.kvs) || !bytes.HasPrefix(kv.Key, n.primaryKey)) {
+		if n.indexKey != nil &&
+			(n.isSecondaryIndex || !ok || !bytes.HasPrefix(kv.Key, n.indexKey)) {
 			// The current key belongs to a new row. Output the current row.
-			n.primaryKey = nil
+			n.indexKey = nil
 			var output bool
 			output, n.err = n.filterRow()
 			if n.err != nil {
@@ -108,53 +128,252 @@ func (n *scanNode) Next() bool {
 			}
 		}
 
-		if n.kvIndex == len(n.kvs) {
+		if !ok {
 			return false
 		}
 
-		if n.primaryKey == nil {
+		if n.indexKey == nil {
 			// This is the first key for the row, reset our vals map.
 			n.vals = valMap{}
 		}
 
 		var remaining []byte
-		remaining, n.err = decodeIndexKey(n.desc, n.desc.PrimaryIndex, n.vals, kv.Key)
+		remaining, n.err = decodeIndexKey(n.desc, *n.index, n.vals, kv.Key)
 		if n.err != nil {
 			return false
 		}
-		n.primaryKey = []byte(kv.Key[:len(kv.Key)-len(remaining)])
+		n.indexKey = []byte(kv.Key[:len(kv.Key)-len(remaining)])
+
+		if n.isSecondaryIndex {
+			// Each key/value pair in a secondary index corresponds to a single row
+			// and contains the primary key columns either in the key suffix or, for
+			// unique indexes, in the value. See encodeSecondaryIndexes.
+			if len(remaining) == 0 {
+				remaining = kv.ValueBytes()
+			}
+			if _, n.err = decodeKeyVals(n.desc, n.desc.PrimaryIndex.ColumnIDs, n.vals, remaining); n.err != nil {
+				return false
+			}
+		} else {
+			// TODO(pmattis): We should avoid looking up the column name by column ID
+			// on every key. One possibility is that we could rewrite col-name
+			// references in expressions to refer to <table-id, col-id> tuples.
+			_, colID := encoding.DecodeUvarint(remaining)
+			var col *structured.ColumnDescriptor
+			col, n.err = n.desc.FindColumnByID(structured.ID(colID))
+			if n.err != nil {
+				return false
+			}
+			if n.vals[col.Name], n.err = unmarshalValue(*col, kv); n.err != nil {
+				return false
+			}
 
-		// TODO(pmattis): We should avoid looking up the column name by column ID
-		// on every key. One possibility is that we could rewrite col-name
-		// references in expressions to refer to <table-id, col-id> tuples.
-		_, colID := encoding.DecodeUvarint(remaining)
-		var col *structured.ColumnDescriptor
-		col, n.err = n.desc.FindColumnByID(structured.ID(colID))
+			if log.V(2) {
+				log.Infof("Scan %q -> %v", kv.Key, n.vals[col.Name])
+			}
+		}
+
+		n.kvIndex++
+	}
+}
+
+func (n *scanNode) Err() error {
+	return n.err
+}
+
+func (n *scanNode) ExplainPlan(verbose bool) (name, description string, children []planNode) {
+	var filter, render string
+	if verbose {
+		filter = explainExprs("filter", n.filter)
+		render = explainExprs("render", n.render...)
+	}
+	if n.source != nil {
+		return "render", explainParts(render, filter), []planNode{n.source}
+	}
+	if n.desc == nil {
+		return "empty", explainParts(render), nil
+	}
+	var limit string
+	if n.maxRows > 0 {
+		limit = fmt.Sprintf("max rows: %d", n.maxRows)
+	}
+	description = fmt.Sprintf("%s@%s %s", n.desc.Name, n.index.Name,
+		prettySpans(n.desc, n.index, n.spans))
+	return "scan", explainParts(description, limit, render, filter), nil
+}
+
+// nextSourceRow advances to the next row of the source plan which passes the
+// filter.
+func (n *scanNode) nextSourceRow() bool {
+	for n.source.Next() {
+		if n.vals != nil {
+			// The columns of a subquery in the FROM clause are referenced by
+			// name.
+			values := n.source.Values()
+			for i, col := range n.source.Columns() {
+				n.vals[col] = values[i]
+			}
+		}
+		var output bool
+		output, n.err = n.filterRow()
 		if n.err != nil {
 			return false
 		}
-		n.vals[col.Name] = unmarshalValue(*col, kv)
+		if output {
+			if n.err = n.renderRow(); n.err != nil {
+				return false
+			}
+			return true
+		}
+	}
+	n.err = n.source.Err()
+	return false
+}
+
+// keyColumns returns the names of the columns which determine the order in
+// which rows are retrieved by the scan and whether those columns uniquely
+// identify a row.
+func (n *scanNode) keyColumns() ([]string, bool, error) {
+	ids := n.index.ColumnIDs
+	unique := n.index.Unique
+	if n.isSecondaryIndex && !unique {
+		// The keys of a non-unique secondary index are suffixed with the primary
+		// key columns.
+		ids = append(append([]structured.ID(nil), ids...), n.desc.PrimaryIndex.ColumnIDs...)
+		unique = true
+	} else if n.isSecondaryIndex {
+		// Rows with NULL values in a unique index are not necessarily unique.
+		unique = false
+	}
+	names := make([]string, 0, len(ids))
+	for _, id := range ids {
+		col, err := n.desc.FindColumnByID(id)
+		if err != nil {
+			return nil, false, err
+		}
+		names = append(names, col.Name)
+	}
+	return names, unique, nil
+}
+
+// initScan prepares the scan of the spans. If no spans were specified the
+// entire index is scanned.
+func (n *scanNode) initScan() {
+	n.initialized = true
+	if n.desc == nil {
+		// No table to read from, pretend there is a single empty row.
+		n.indexKey = []byte{}
+		return
+	}
 
+	if len(n.spans) == 0 {
+		// Scan all of the keys that start with our index key prefix.
+		start := proto.Key(structured.MakeIndexKeyPrefix(n.desc.ID, n.index.ID))
+		n.spans = append(n.spans, span{start: start, end: start.PrefixEnd()})
+	}
+}
+
+// nextKV returns the next key/value pair of the scan, retrieving the next
+// batch of key/value pairs when the current batch is exhausted. Returns false
+// when there are no more key/value pairs or an error occurred.
+func (n *scanNode) nextKV() (client.KeyValue, bool) {
+	for n.kvIndex >= len(n.kvs) {
+		if !n.fetchKVs() {
+			return client.KeyValue{}, false
+		}
+	}
+	return n.kvs[n.kvIndex], true
+}
+
+// fetchKVs retrieves the next batch of at most batchSize() key/value pairs
+// from the spans. A span which contains more key/value pairs is retrieved in
+// multiple batches, each resuming after the last key of the previous batch.
+// Note that the key/value pairs for a row may be split across batches.
+func (n *scanNode) fetchKVs() bool {
+	for n.spanIndex < len(n.spans) {
+		s := n.spans[n.spanIndex]
+		start := s.start
+		if n.resumeKey != nil {
+			start = n.resumeKey
+		}
 		if log.V(2) {
-			log.Infof("Scan %q -> %v", kv.Key, n.vals[col.Name])
+			log.Infof("Scan %q - %q", start, s.end)
+		}
+		batchSize := n.batchSize()
+		kvs, err := n.db.Scan(start, s.end, batchSize)
+		if err != nil {
+			n.err = err
+			return false
+		}
+		if int64(len(kvs)) < batchSize {
+			// The span is exhausted.
+			n.spanIndex++
+			n.resumeKey = nil
+		} else {
+			n.resumeKey = proto.Key(kvs[len(kvs)-1].Key).Next()
+		}
+		if len(kvs) > 0 {
+			n.kvs = kvs
+			n.kvIndex = 0
+			return true
 		}
+	}
+	return false
+}
 
-		n.kvIndex++
+// batchSize returns the maximum number of key/value pairs to retrieve in a
+// single KV scan. If the number of rows needed is known the batch is sized to
+// hold those rows plus the first key/value pair of the following row, which
+// is needed to determine where the last row ends.
+func (n *scanNode) batchSize() int64 {
+	if n.maxRows <= 0 {
+		return scanBatchSize
+	}
+	// A secondary index has a single key/value pair per row. A row in the
+	// primary index has at most one key/value pair per column.
+	kvsPerRow := int64(1)
+	if !n.isSecondaryIndex {
+		kvsPerRow = int64(len(n.desc.Columns))
 	}
+	if n.maxRows >= scanBatchSize/kvsPerRow {
+		return scanBatchSize
+	}
+	return n.maxRows*kvsPerRow + 1
 }
 
-func (n *scanNode) Err() error {
-	return n.err
+// resetScan discards the state of the scan so that the next call to Next()
+// scans the current spans.
+func (n *scanNode) resetScan() {
+	n.initialized = false
+	n.spanIndex = 0
+	n.resumeKey = nil
+	n.kvs = nil
+	n.kvIndex = 0
+	n.indexKey = nil
 }
 
 func (n *scanNode) filterRow() (bool, error) {
+	if n.desc != nil && !n.isSecondaryIndex {
+		for _, col := range n.desc.Columns {
+			if _, ok := n.vals[col.Name]; ok {
+				continue
+			}
+			if col.Nullable {
+				n.vals[col.Name] = parser.DNull
+			}
+		}
+	}
+
 	if n.filter == nil {
 		return true, nil
 	}
-	d, err := parser.EvalExpr(n.filter, n.vals)
+	d, err := parser.EvalExpr(n.evalCtx, n.filter, n.vals)
 	if err != nil {
 		return false, err
 	}
+	if d == parser.DNull {
+		return false, nil
+	}
 	v, ok := d.(parser.DBool)
 	if !ok {
 		return false, fmt.Errorf("WHERE clause did not evaluate to a boolean")
@@ -166,19 +385,9 @@ func (n *scanNode) renderRow() error {
 	if n.row == nil {
 		n.row = make([]parser.Datum, len(n.render))
 	}
-	if n.desc != nil {
-		for _, col := range n.desc.Columns {
-			if _, ok := n.vals[col.Name]; ok {
-				continue
-			}
-			if col.Nullable {
-				n.vals[col.Name] = parser.DNull
-			}
-		}
-	}
 	for i, e := range n.render {
 		var err error
-		n.row[i], err = parser.EvalExpr(e, n.vals)
+		n.row[i], err = parser.EvalExpr(n.evalCtx, e, n.vals)
 		if err != nil {
 			return err
 		}
@@ -186,19 +395,35 @@ func (n *scanNode) renderRow() error {
 	return nil
 }
 
-func unmarshalValue(col structured.ColumnDescriptor, kv client.KeyValue) parser.Datum {
+func unmarshalValue(col structured.ColumnDescriptor, kv client.KeyValue) (parser.Datum, error) {
 	if kv.Exists() {
 		switch col.Type.Kind {
 		case structured.ColumnType_BIT, structured.ColumnType_INT:
-			return parser.DInt(kv.ValueInt())
+			return parser.DInt(kv.ValueInt()), nil
 		case structured.ColumnType_FLOAT:
-			return parser.DFloat(math.Float64frombits(uint64(kv.ValueInt())))
-		case structured.ColumnType_CHAR, structured.ColumnType_TEXT,
-			structured.ColumnType_BLOB:
-			return parser.DString(kv.ValueBytes())
+			return parser.DFloat(math.Float64frombits(uint64(kv.ValueInt()))), nil
+		case structured.ColumnType_DECIMAL:
+			d, err := decimal.Parse(string(kv.ValueBytes()))
+			if err != nil {
+				return nil, err
+			}
+			return parser.DDecimal{Decimal: d}, nil
+		case structured.ColumnType_CHAR, structured.ColumnType_TEXT:
+			return parser.DString(kv.ValueBytes()), nil
+		case structured.ColumnType_BLOB:
+			return parser.DBytes(kv.ValueBytes()), nil
+		case structured.ColumnType_DATE:
+			return daysToDate(kv.ValueInt()), nil
+		case structured.ColumnType_TIME:
+			return parser.DTime{Duration: time.Duration(kv.ValueInt())}, nil
+		case structured.ColumnType_TIMESTAMP:
+			_, t := encoding.DecodeTime(kv.ValueBytes())
+			return parser.DTimestamp{Time: t}, nil
+		case structured.ColumnType_INTERVAL:
+			return parser.DInterval{Duration: time.Duration(kv.ValueInt())}, nil
 		}
 	}
-	return parser.DNull
+	return parser.DNull, nil
 }
 
 type valMap map[string]parser.Datum
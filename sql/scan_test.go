@@ -0,0 +1,112 @@
+// Copyright 2015 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License. See the AUTHORS file
+// for names of contributors.
+
+package sql_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/cockroachdb/cockroach/sql"
+	"github.com/cockroachdb/cockroach/util/leaktest"
+)
+
+// TestScanBatches verifies that scans which retrieve their key/value pairs in
+// multiple batches reconstruct rows which span batches correctly.
+func TestScanBatches(t *testing.T) {
+	defer leaktest.AfterTest(t)
+	s, sqlDB, _ := setup(t)
+	defer cleanup(s, sqlDB)
+
+	// With 3 columns per row, a batch size of 4 splits most rows across
+	// batches.
+	defer sql.SetScanBatchSize(4)()
+
+	if _, err := sqlDB.Exec(`CREATE DATABASE t`); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := sqlDB.Exec(`CREATE TABLE t.kv (
+  k INT PRIMARY KEY,
+  v INT,
+  w CHAR,
+  CONSTRAINT v INDEX (v)
+)`); err != nil {
+		t.Fatal(err)
+	}
+
+	const numRows = 20
+	for i := 0; i < numRows; i++ {
+		if _, err := sqlDB.Exec(`INSERT INTO t.kv VALUES ($1, $2, $3)`,
+			i, i%4, fmt.Sprintf("w%d", i)); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	countRows := func(query string, args ...interface{}) int {
+		rows, err := sqlDB.Query(query, args...)
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer rows.Close()
+		n := 0
+		for rows.Next() {
+			var k, v int
+			var w string
+			if err := rows.Scan(&k, &v, &w); err != nil {
+				t.Fatal(err)
+			}
+			if v != k%4 || w != fmt.Sprintf("w%d", k) {
+				t.Fatalf("%s: unexpected row %d %d %s", query, k, v, w)
+			}
+			n++
+		}
+		if err := rows.Err(); err != nil {
+			t.Fatal(err)
+		}
+		return n
+	}
+
+	testData := []struct {
+		query    string
+		expected int
+	}{
+		{`SELECT * FROM t.kv`, numRows},
+		{`SELECT * FROM t.kv WHERE k >= 5 AND k < 15`, 10},
+		{`SELECT * FROM t.kv WHERE v = 1`, numRows / 4},
+		{`SELECT * FROM t.kv WHERE w > 'w1'`, numRows - 2},
+		{`SELECT * FROM t.kv LIMIT 7`, 7},
+		{`SELECT * FROM t.kv LIMIT 3 OFFSET 15`, 3},
+		{`SELECT * FROM t.kv OFFSET 18`, numRows - 18},
+	}
+	for _, d := range testData {
+		if n := countRows(d.query); n != d.expected {
+			t.Errorf("%s: expected %d rows, but found %d", d.query, d.expected, n)
+		}
+	}
+
+	if _, err := sqlDB.Exec(`UPDATE t.kv SET w = 'x' WHERE v = 2`); err != nil {
+		t.Fatal(err)
+	}
+	if n := countRows(`SELECT * FROM t.kv WHERE w != 'x'`); n != numRows-numRows/4 {
+		t.Errorf("expected %d rows, but found %d", numRows-numRows/4, n)
+	}
+
+	if _, err := sqlDB.Exec(`DELETE FROM t.kv WHERE w = 'x'`); err != nil {
+		t.Fatal(err)
+	}
+	if n := countRows(`SELECT * FROM t.kv`); n != numRows-numRows/4 {
+		t.Errorf("expected %d rows, but found %d", numRows-numRows/4, n)
+	}
+}
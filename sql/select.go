@@ -21,35 +21,20 @@ import (
 	"fmt"
 
 	"github.com/cockroachdb/cockroach/sql/parser"
-	"github.com/cockroachdb/cockroach/structured"
-	"github.com/cockroachdb/cockroach/util"
 )
 
-// Select selects rows from a single table.
-// Privileges: READ on table
+// Select selects rows from the tables of the FROM clause, joining them if
+// there are more than one.
+// Privileges: READ on tables
 //   Notes: postgres requires SELECT. Also requires UPDATE on "FOR UPDATE".
 //          mysql requires SELECT.
 func (p *planner) Select(n *parser.Select) (planNode, error) {
-	var desc *structured.TableDescriptor
-
-	switch len(n.From) {
-	case 0:
-		// desc remains nil.
-
-	case 1:
-		var err error
-		desc, err = p.getAliasedTableDesc(n.From[0])
-		if err != nil {
-			return nil, err
-		}
-
-		if !desc.HasPrivilege(p.user, parser.PrivilegeRead) {
-			return nil, fmt.Errorf("user %s does not have %s privilege on table %s",
-				p.user, parser.PrivilegeRead, desc.Name)
-		}
-
-	default:
-		return nil, util.Errorf("TODO(pmattis): unsupported FROM: %s", n.From)
+	from, err := p.makeFrom(n.From)
+	if err != nil {
+		return nil, err
+	}
+	if err := p.expandSubqueries(n, &from.scope); err != nil {
+		return nil, err
 	}
 
 	// Loop over the select expressions and expand them into the expressions
@@ -60,82 +45,55 @@ func (p *planner) Select(n *parser.Select) (planNode, error) {
 	for _, e := range n.Exprs {
 		switch t := e.(type) {
 		case *parser.StarExpr:
-			if desc == nil {
+			if from.plan == nil {
 				return nil, fmt.Errorf("* with no tables specified is not valid")
 			}
-			for _, col := range desc.Columns {
-				columns = append(columns, col.Name)
-				exprs = append(exprs, &parser.QualifiedName{Base: parser.Name(col.Name)})
-			}
+			starColumns, starExprs := from.expandStar()
+			columns = append(columns, starColumns...)
+			exprs = append(exprs, starExprs...)
 		case *parser.NonStarExpr:
 			exprs = append(exprs, t.Expr)
 			if t.As != "" {
 				columns = append(columns, string(t.As))
 			} else {
-				// TODO(pmattis): Should verify at this point that any referenced
-				// columns are represented in the tables being selected from.
 				columns = append(columns, t.Expr.String())
 			}
 		}
 	}
 
-	s := &scanNode{
-		db:      p.db,
-		desc:    desc,
-		columns: columns,
-		render:  exprs,
+	// A single table is scanned directly. Multiple tables are scanned by the
+	// joins which are the source of the rows.
+	s, ok := from.plan.(*scanNode)
+	if !ok {
+		s = &scanNode{
+			db:     p.txn,
+			source: from.plan,
+		}
 	}
+	s.columns = columns
+	s.render = exprs
+	s.evalCtx = p.evalCtx
 	if n.Where != nil {
 		s.filter = n.Where.Expr
 	}
-	return s, nil
-}
-
-type subqueryVisitor struct {
-	*planner
-	err error
-}
 
-var _ parser.Visitor = &subqueryVisitor{}
-
-func (v *subqueryVisitor) Visit(expr parser.Expr) parser.Expr {
-	if v.err != nil {
-		return expr
+	sort, err := p.orderBy(n, s)
+	if err != nil {
+		return nil, err
 	}
-	subquery, ok := expr.(*parser.Subquery)
-	if !ok {
-		return expr
+	if err := from.resolve(n, s); err != nil {
+		return nil, err
 	}
-	var plan planNode
-	if plan, v.err = v.makePlan(subquery.Select); v.err != nil {
-		return expr
+	group, err := p.groupBy(n, s, len(columns))
+	if err != nil {
+		return nil, err
 	}
-	var rows parser.DTuple
-	for plan.Next() {
-		values := plan.Values()
-		switch len(values) {
-		case 1:
-			// TODO(pmattis): This seems hokey, but if we don't do this then the
-			// subquery expands to a tuple of tuples instead of a tuple of values and
-			// an expression like "k IN (SELECT foo FROM bar)" will fail because
-			// we're comparing a single value against a tuple. Perhaps comparison of
-			// a single value against a tuple should succeed if the tuple is one
-			// element in length.
-			rows = append(rows, values[0])
-		default:
-			// The result from plan.Values() is only valid until the next call to
-			// plan.Next(), so make a copy.
-			valuesCopy := make(parser.DTuple, len(values))
-			copy(valuesCopy, values)
-			rows = append(rows, valuesCopy)
-		}
+	plan, err := p.selectIndex(s)
+	if err != nil {
+		return nil, err
 	}
-	v.err = plan.Err()
-	return rows
-}
-
-func (p *planner) expandSubqueries(stmt parser.Statement) error {
-	v := subqueryVisitor{planner: p}
-	parser.WalkStmt(&v, stmt)
-	return v.err
+	if plan, err = sort.wrap(group.wrap(plan)); err != nil {
+		return nil, err
+	}
+	return p.limit(n.Limit, plan)
 }
@@ -0,0 +1,97 @@
+// Copyright 2015 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License. See the AUTHORS file
+// for names of contributors.
+
+package sql
+
+import (
+	"sync"
+
+	"github.com/cockroachdb/cockroach/client"
+	"github.com/cockroachdb/cockroach/proto"
+)
+
+// serialBlockSize is the number of values of a SERIAL column which are
+// allocated at once.
+var serialBlockSize int64 = 100
+
+// A serialAllocator allocates the values of SERIAL columns. The values of a
+// column are allocated in blocks by incrementing the sequence key of the
+// column and are handed out in increasing order, so that the key is written
+// only once per block instead of once per inserted row. The sequence is
+// incremented outside of the transaction of the INSERT so that concurrent
+// inserts don't conflict on the key. The values handed out by different
+// servers interleave by blocks, and values which are allocated but never
+// inserted, as by an aborted transaction, are skipped.
+type serialAllocator struct {
+	db        *client.DB
+	blockSize int64
+
+	mu sync.Mutex
+	// cond is signaled when the allocation of a block completes.
+	cond   *sync.Cond
+	blocks map[string]*serialBlock
+}
+
+// serialBlock holds the values next, ..., end-1 which remain of the block
+// allocated for a sequence. While allocating is set, a new block is being
+// allocated for the sequence and the other callers wait for it.
+type serialBlock struct {
+	next, end  int64
+	allocating bool
+}
+
+func newSerialAllocator(db *client.DB, blockSize int64) *serialAllocator {
+	a := &serialAllocator{
+		db:        db,
+		blockSize: blockSize,
+		blocks:    map[string]*serialBlock{},
+	}
+	a.cond = sync.NewCond(&a.mu)
+	return a
+}
+
+// allocate returns the next value of the sequence with the given key. The
+// mutex is not held while a block is allocated, so the allocations of other
+// sequences are not blocked by the increment of the sequence key.
+func (a *serialAllocator) allocate(key proto.Key) (int64, error) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	b, ok := a.blocks[string(key)]
+	if !ok {
+		b = &serialBlock{}
+		a.blocks[string(key)] = b
+	}
+	for b.next == b.end {
+		if b.allocating {
+			a.cond.Wait()
+			continue
+		}
+		b.allocating = true
+		a.mu.Unlock()
+		kv, err := a.db.Inc(key, a.blockSize)
+		a.mu.Lock()
+		b.allocating = false
+		a.cond.Broadcast()
+		if err != nil {
+			return 0, err
+		}
+		b.end = kv.ValueInt() + 1
+		b.next = b.end - a.blockSize
+	}
+	v := b.next
+	b.next++
+	return v, nil
+}
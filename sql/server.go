@@ -24,6 +24,7 @@ import (
 	"io/ioutil"
 	"net/http"
 	"strings"
+	"time"
 
 	"github.com/cockroachdb/cockroach/base"
 	"github.com/cockroachdb/cockroach/client"
@@ -32,6 +33,7 @@ import (
 	"github.com/cockroachdb/cockroach/sql/driver"
 	"github.com/cockroachdb/cockroach/sql/parser"
 	"github.com/cockroachdb/cockroach/util"
+	"github.com/cockroachdb/cockroach/util/decimal"
 
 	gogoproto "github.com/gogo/protobuf/proto"
 )
@@ -43,16 +45,30 @@ var (
 	errEmptyDatabaseName = errors.New("empty database name")
 )
 
+// testingExecStmtHook, if set, is called before each statement of an implicit
+// transaction is executed. An error returned by the hook aborts the attempt
+// to run the transaction.
+var testingExecStmtHook func(stmt parser.Statement) error
+
 // A Server provides an HTTP server endpoint serving the SQL API.
 // It accepts either JSON or serialized protobuf content types.
 type Server struct {
 	context *base.Context
 	db      *client.DB
+	serials *serialAllocator
+	nodeID  proto.NodeID
 }
 
 // NewServer allocates and returns a new Server.
 func NewServer(ctx *base.Context, db *client.DB) *Server {
-	return &Server{context: ctx, db: db}
+	return &Server{context: ctx, db: db, serials: newSerialAllocator(db, serialBlockSize)}
+}
+
+// SetNodeID sets the ID of the node serving the SQL API, which makes the
+// values generated by unique_rowid() unique across the cluster. It must be
+// called before the server handles any request.
+func (s *Server) SetNodeID(nodeID proto.NodeID) {
+	s.nodeID = nodeID
 }
 
 // ServeHTTP serves the SQL API by treating the request URL path
@@ -141,9 +157,19 @@ func (p parameters) Arg(i int) (parser.Datum, bool) {
 	case *float64:
 		return parser.DFloat(*t), true
 	case []byte:
-		return parser.DString(t), true
+		return parser.DBytes(t), true
 	case *string:
 		return parser.DString(*t), true
+	case *driver.Datum_Timestamp:
+		return parser.DTimestamp{Time: t.GoTime()}, true
+	case *driver.Datum_Decimal:
+		d, err := decimal.Parse(t.Value)
+		if err != nil {
+			// Leave a malformed decimal as a string so that it is rejected
+			// by type checking like any other mistyped argument.
+			return parser.DString(t.Value), true
+		}
+		return parser.DDecimal{Decimal: d}, true
 	default:
 		panic(fmt.Sprintf("Incorrect type %T", t))
 	}
@@ -157,7 +183,8 @@ func (s *Server) exec(req driver.Request) (driver.Response, error) {
 	// Pick up current session state.
 	// The request user is validated in ServeHTTP. Even in insecure mode,
 	// it is guaranteed not to be empty.
-	planner := planner{db: s.db, user: req.GetUser()}
+	planner := planner{db: s.db, serials: s.serials, user: req.GetUser()}
+	planner.evalCtx.NodeID = s.nodeID
 	if req.Session != nil {
 		// TODO(tschottdorf) will have to validate the Session information (for
 		// instance, whether access to the stored database is permitted).
@@ -165,55 +192,204 @@ func (s *Server) exec(req driver.Request) (driver.Response, error) {
 			return resp, err
 		}
 	}
-	stmts, err := parser.Parse(req.Sql)
+	// Resume the transaction, if any, which was left open by a previous
+	// request.
+	if req.Txn != nil {
+		txnProto := &proto.Transaction{}
+		if err := gogoproto.Unmarshal(req.Txn, txnProto); err != nil {
+			return resp, err
+		}
+		planner.txn = client.NewTxn(*s.db, txnProto)
+	}
+
+	var err error
+	resp.Results, err = s.execStmts(&planner, req)
+	if err != nil && planner.txn != nil {
+		planner.abortTransaction()
+	}
+
+	// Return the state of the open transaction, if any, so that it is resumed
+	// by the next request.
+	if planner.txn != nil {
+		var txnErr error
+		if resp.Txn, txnErr = gogoproto.Marshal(planner.txn.Proto()); txnErr != nil {
+			return resp, txnErr
+		}
+	}
 	if err != nil {
 		return resp, err
 	}
-	for _, stmt := range stmts {
-		// Bind all the placeholder variables in the stmt to actual values.
-		if err := parser.FillArgs(stmt, parameters(req.Params)); err != nil {
-			return resp, err
+
+	// Update session state.
+	resp.Session, err = gogoproto.Marshal(&planner.session)
+	return resp, err
+}
+
+// execStmts executes the statements of the request and returns their results.
+// Outside of an explicit transaction, consecutive statements are executed
+// atomically within an implicit transaction which is retried on retryable
+// errors.
+func (s *Server) execStmts(planner *planner, req driver.Request) ([]driver.Result, error) {
+	stmts, err := parseStmts(req)
+	if err != nil {
+		return nil, err
+	}
+
+	var results []driver.Result
+	for i := 0; i < len(stmts); {
+		if isTransactionStmt(stmts[i]) {
+			// The transaction statements manage the explicit transaction.
+			result, err := s.execStmt(planner, stmts[i])
+			if err != nil {
+				return results, err
+			}
+			results = append(results, result)
+			i++
+			continue
 		}
-		var plan planNode
-		if plan, err = planner.makePlan(stmt); err != nil {
-			return resp, err
+
+		if planner.txn != nil {
+			if planner.txn.Proto().Status == proto.ABORTED {
+				return results, errTransactionAborted
+			}
+			result, err := s.execStmt(planner, stmts[i])
+			if err != nil {
+				return results, err
+			}
+			results = append(results, result)
+			i++
+			continue
 		}
 
-		result := driver.Result{
-			Columns: plan.Columns(),
+		// Run the statements up to the next transaction statement in an
+		// implicit transaction. A retry starts over from the session state
+		// which preceded the first attempt.
+		n := 1
+		for i+n < len(stmts) && !isTransactionStmt(stmts[i+n]) {
+			n++
 		}
-		for plan.Next() {
-			values := plan.Values()
-			row := driver.Result_Row{}
-			row.Values = make([]driver.Datum, 0, len(values))
-			for _, val := range values {
-				if val == parser.DNull {
-					row.Values = append(row.Values, driver.Datum{})
-				} else {
-					switch vt := val.(type) {
-					case parser.DBool:
-						row.Values = append(row.Values, driver.Datum{BoolVal: (*bool)(&vt)})
-					case parser.DInt:
-						row.Values = append(row.Values, driver.Datum{IntVal: (*int64)(&vt)})
-					case parser.DFloat:
-						row.Values = append(row.Values, driver.Datum{FloatVal: (*float64)(&vt)})
-					case parser.DString:
-						row.Values = append(row.Values, driver.Datum{StringVal: (*string)(&vt)})
-					default:
-						return resp, util.Errorf("unsupported datum: %T", val)
+		session := planner.session
+		var txnResults []driver.Result
+		retry := false
+		err := s.db.Txn(func(txn *client.Txn) error {
+			planner.txn = txn
+			planner.implicitTxn = true
+			planner.session = session
+			planner.newIndexes = planner.newIndexes[:0]
+			txnResults = txnResults[:0]
+			txnStmts := stmts[i : i+n]
+			if retry {
+				// Planning modifies the statements, for instance by replacing
+				// subqueries with their values, so a retry starts over from
+				// freshly parsed statements.
+				fresh, err := parseStmts(req)
+				if err != nil {
+					return err
+				}
+				txnStmts = fresh[i : i+n]
+			}
+			retry = true
+			for _, stmt := range txnStmts {
+				if testingExecStmtHook != nil {
+					if err := testingExecStmtHook(stmt); err != nil {
+						return err
 					}
 				}
+				result, err := s.execStmt(planner, stmt)
+				if err != nil {
+					return err
+				}
+				txnResults = append(txnResults, result)
 			}
-			result.Rows = append(result.Rows, row)
+			return nil
+		})
+		planner.txn = nil
+		planner.implicitTxn = false
+		if err == nil {
+			err = planner.backfillNewIndexes()
 		}
-		if err := plan.Err(); err != nil {
-			return resp, err
+		if err != nil {
+			return results, err
+		}
+		results = append(results, txnResults...)
+		i += n
+	}
+	return results, nil
+}
+
+// parseStmts parses the statements of the request and binds their
+// placeholders to the parameters of the request.
+func parseStmts(req driver.Request) ([]parser.Statement, error) {
+	stmts, err := parser.Parse(req.Sql)
+	if err != nil {
+		return nil, err
+	}
+	for _, stmt := range stmts {
+		if err := parser.FillArgs(stmt, parameters(req.Params)); err != nil {
+			return nil, err
 		}
+	}
+	return stmts, nil
+}
 
-		resp.Results = append(resp.Results, result)
+func isTransactionStmt(stmt parser.Statement) bool {
+	switch stmt.(type) {
+	case *parser.BeginTransaction, *parser.CommitTransaction,
+		*parser.RollbackTransaction, *parser.SetTransaction:
+		return true
 	}
+	return false
+}
 
-	// Update session state.
-	resp.Session, err = gogoproto.Marshal(&planner.session)
-	return resp, err
+// execStmt plans and executes a single statement, collecting its rows.
+func (s *Server) execStmt(planner *planner, stmt parser.Statement) (driver.Result, error) {
+	var result driver.Result
+	planner.evalCtx.StmtTimestamp = time.Now()
+	plan, err := planner.makePlan(stmt)
+	if err != nil {
+		return result, err
+	}
+
+	result.Columns = plan.Columns()
+	for plan.Next() {
+		values := plan.Values()
+		row := driver.Result_Row{}
+		row.Values = make([]driver.Datum, 0, len(values))
+		for _, val := range values {
+			if val == parser.DNull {
+				row.Values = append(row.Values, driver.Datum{})
+			} else {
+				switch vt := val.(type) {
+				case parser.DBool:
+					row.Values = append(row.Values, driver.Datum{BoolVal: (*bool)(&vt)})
+				case parser.DInt:
+					row.Values = append(row.Values, driver.Datum{IntVal: (*int64)(&vt)})
+				case parser.DFloat:
+					row.Values = append(row.Values, driver.Datum{FloatVal: (*float64)(&vt)})
+				case parser.DString:
+					row.Values = append(row.Values, driver.Datum{StringVal: (*string)(&vt)})
+				case parser.DBytes:
+					row.Values = append(row.Values, driver.Datum{BytesVal: []byte(vt)})
+				case parser.DDate:
+					row.Values = append(row.Values, driver.Datum{TimeVal: driver.NewTimestamp(vt.Time)})
+				case parser.DTime:
+					// A time of day is sent as the time on the zero date.
+					t := time.Time{}.Add(vt.Duration)
+					row.Values = append(row.Values, driver.Datum{TimeVal: driver.NewTimestamp(t)})
+				case parser.DTimestamp:
+					row.Values = append(row.Values, driver.Datum{TimeVal: driver.NewTimestamp(vt.Time)})
+				case parser.DInterval:
+					s := vt.String()
+					row.Values = append(row.Values, driver.Datum{StringVal: &s})
+				case parser.DDecimal:
+					d := &driver.Datum_Decimal{Value: vt.String()}
+					row.Values = append(row.Values, driver.Datum{DecimalVal: d})
+				default:
+					return result, util.Errorf("unsupported datum: %T", val)
+				}
+			}
+		}
+		result.Rows = append(result.Rows, row)
+	}
+	return result, plan.Err()
 }
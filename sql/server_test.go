@@ -0,0 +1,70 @@
+// Copyright 2015 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License. See the AUTHORS file
+// for names of contributors.
+
+package sql_test
+
+import (
+	"testing"
+
+	"github.com/cockroachdb/cockroach/proto"
+	"github.com/cockroachdb/cockroach/sql"
+	"github.com/cockroachdb/cockroach/util/leaktest"
+)
+
+// TestImplicitTxnRetryReparses verifies that a retried implicit transaction
+// runs its statements as they were written rather than as modified by the
+// failed attempt, which replaced subqueries with their values.
+func TestImplicitTxnRetryReparses(t *testing.T) {
+	defer leaktest.AfterTest(t)
+	s, sqlDB, _ := setup(t)
+	defer cleanup(s, sqlDB)
+
+	if _, err := sqlDB.Exec(`
+CREATE DATABASE t;
+CREATE TABLE t.kv (k INT PRIMARY KEY, v INT);
+INSERT INTO t.kv VALUES (1, 1), (2, 2);
+`); err != nil {
+		t.Fatal(err)
+	}
+
+	var stmts []string
+	defer sql.SetExecStmtHook(func(stmt string) error {
+		stmts = append(stmts, stmt)
+		if len(stmts) == 2 {
+			// Fail the first attempt once its first statement has run.
+			return &proto.TransactionRetryError{}
+		}
+		return nil
+	})()
+
+	var k int
+	if err := sqlDB.QueryRow(`
+SELECT k FROM t.kv WHERE v = (SELECT max(v) FROM t.kv);
+SELECT k FROM t.kv WHERE v = (SELECT max(v) FROM t.kv);
+`).Scan(&k); err != nil {
+		t.Fatal(err)
+	} else if k != 2 {
+		t.Errorf("expected 2, but found %d", k)
+	}
+	if len(stmts) != 4 {
+		t.Fatalf("expected 2 attempts of 2 statements, but found %q", stmts)
+	}
+	const expected = `SELECT k FROM t.kv WHERE v = (SELECT max(v) FROM t.kv)`
+	for _, i := range []int{0, 2} {
+		if stmts[i] != expected {
+			t.Errorf("%d: expected %s, but found %s", i, expected, stmts[i])
+		}
+	}
+}
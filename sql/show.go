@@ -34,12 +34,26 @@ func (p *planner) ShowColumns(n *parser.ShowColumns) (planNode, error) {
 	if err != nil {
 		return nil, err
 	}
-	v := &valuesNode{columns: []string{"Field", "Type", "Null"}}
-	for i, col := range desc.Columns {
+	v := &valuesNode{columns: []string{"Field", "Type", "Null", "Default", "Check"}}
+	for _, col := range desc.VisibleColumns() {
+		var defaultExpr parser.Datum = parser.DNull
+		if col.DefaultExpr != nil {
+			defaultExpr = parser.DString(*col.DefaultExpr)
+		}
+		var check parser.Datum = parser.DNull
+		exprs, err := checkExprsForColumn(desc, col.Name)
+		if err != nil {
+			return nil, err
+		}
+		if exprs != "" {
+			check = parser.DString(exprs)
+		}
 		v.rows = append(v.rows, []parser.Datum{
-			parser.DString(desc.Columns[i].Name),
+			parser.DString(col.Name),
 			parser.DString(col.Type.SQLString()),
-			parser.DBool(desc.Columns[i].Nullable),
+			parser.DBool(col.Nullable),
+			defaultExpr,
+			check,
 		})
 	}
 	return v, nil
@@ -51,7 +65,7 @@ func (p *planner) ShowColumns(n *parser.ShowColumns) (planNode, error) {
 //          mysql has a "SHOW DATABASES" permission, but we have no system-level permissions.
 func (p *planner) ShowDatabases(n *parser.ShowDatabases) (planNode, error) {
 	prefix := structured.MakeNameMetadataKey(structured.RootNamespaceID, "")
-	sr, err := p.db.Scan(prefix, prefix.PrefixEnd(), 0)
+	sr, err := p.txn.Scan(prefix, prefix.PrefixEnd(), 0)
 	if err != nil {
 		return nil, err
 	}
@@ -0,0 +1,268 @@
+// Copyright 2015 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License. See the AUTHORS file
+// for names of contributors.
+
+package sql
+
+import (
+	"bytes"
+	"fmt"
+	"sort"
+
+	"github.com/cockroachdb/cockroach/sql/parser"
+)
+
+// columnOrderInfo describes a column used to order rows.
+type columnOrderInfo struct {
+	colIdx    int // the index of the column within a row
+	direction parser.Direction
+	// The name of the table column rendered at colIdx, or "" if the rendered
+	// expression is not a plain column reference.
+	colName string
+}
+
+// orderBy constructs a sortNode for the ORDER BY clause of the SELECT. An
+// ORDER BY expression can refer to a column of the result by its ordinal or
+// its name. Any other expression is added to the render expressions of the
+// scan as a column which is stripped by the sortNode. Returns nil if there is
+// no ORDER BY clause.
+func (p *planner) orderBy(n *parser.Select, s *scanNode) (*sortNode, error) {
+	if len(n.OrderBy) == 0 {
+		return nil, nil
+	}
+
+	numOriginalCols := len(s.columns)
+	var ordering []columnOrderInfo
+
+	for _, o := range n.OrderBy {
+		index := -1
+
+		switch t := o.Expr.(type) {
+		case parser.IntVal:
+			// ORDER BY 1 refers to the first column of the result.
+			if t < 1 || int(t) > numOriginalCols {
+				return nil, fmt.Errorf("invalid ORDER BY index: %d not in range [1, %d]",
+					t, numOriginalCols)
+			}
+			index = int(t) - 1
+
+		case *parser.QualifiedName:
+			// ORDER BY can refer to a column of the result by its name, which takes
+			// precedence over the columns of the table.
+			for i, col := range s.columns[:numOriginalCols] {
+				if col == t.String() {
+					index = i
+					break
+				}
+			}
+		}
+
+		if index == -1 {
+			// The expression is not part of the result. Add it as an extra render
+			// target.
+			index = len(s.columns)
+			s.columns = append(s.columns, o.Expr.String())
+			s.render = append(s.render, o.Expr)
+		}
+
+		info := columnOrderInfo{colIdx: index, direction: o.Direction}
+		if qname, ok := s.render[index].(*parser.QualifiedName); ok && s.desc != nil {
+			if _, err := s.desc.FindColumnByName(qname.String()); err == nil {
+				info.colName = qname.String()
+			}
+		}
+		ordering = append(ordering, info)
+	}
+
+	return &sortNode{
+		columns:  s.columns[:numOriginalCols],
+		ordering: ordering,
+	}, nil
+}
+
+// wrap configures the sortNode to return the rows of plan in order. If the
+// plan already retrieves the rows in the requested order the sort is
+// elided. A nil sortNode returns the plan unchanged.
+func (n *sortNode) wrap(plan planNode) (planNode, error) {
+	if n == nil {
+		return plan, nil
+	}
+	n.plan = plan
+
+	var s *scanNode
+	switch t := plan.(type) {
+	case *scanNode:
+		if t.source == nil {
+			s = t
+		}
+	case *indexJoinNode:
+		s = t.index
+	case *valuesNode:
+		// A valuesNode is only used here for an empty result.
+		return n, nil
+	}
+	if s == nil {
+		n.needSort = true
+		return n, nil
+	}
+	if s.desc == nil {
+		// There is only a single row.
+		return n, nil
+	}
+
+	keyCols, unique, err := s.keyColumns()
+	if err != nil {
+		return nil, err
+	}
+	n.needSort = !orderingMatchesKey(n.ordering, keyCols, s.exactPrefix, unique)
+	return n, nil
+}
+
+// orderingMatchesKey returns true if rows ordered by the key columns are also
+// ordered by the specified ordering. The first exactPrefix key columns have a
+// single value and can be skipped. If the key columns are unique, any ordering
+// following the key columns is satisfied.
+func orderingMatchesKey(ordering []columnOrderInfo, keyCols []string, exactPrefix int, unique bool) bool {
+	j := 0
+	for _, o := range ordering {
+		if j == len(keyCols) && unique {
+			return true
+		}
+		if o.colName == "" || o.direction == parser.Descending {
+			// TODO: Use reverse scans for descending orderings.
+			return false
+		}
+		for j < exactPrefix && j < len(keyCols) && keyCols[j] != o.colName {
+			j++
+		}
+		if j == len(keyCols) || keyCols[j] != o.colName {
+			return false
+		}
+		j++
+	}
+	return true
+}
+
+// A sortNode sorts the rows of its source plan by the ordering columns. The
+// source plan may render extra columns needed only for sorting which are
+// stripped from the rows returned by the sortNode.
+type sortNode struct {
+	plan     planNode
+	columns  []string
+	ordering []columnOrderInfo
+	needSort bool
+	sorted   bool
+	rows     []parser.DTuple
+	nextRow  int
+	err      error
+}
+
+func (n *sortNode) Columns() []string {
+	return n.columns
+}
+
+func (n *sortNode) ExplainPlan(_ bool) (name, description string, children []planNode) {
+	var buf bytes.Buffer
+	for i, o := range n.ordering {
+		if i > 0 {
+			_, _ = buf.WriteString(",")
+		}
+		prefix := '+'
+		if o.direction == parser.Descending {
+			prefix = '-'
+		}
+		if o.colIdx < len(n.columns) {
+			fmt.Fprintf(&buf, "%c%s", prefix, n.columns[o.colIdx])
+		} else {
+			// The column is only used for sorting and is stripped by the sort.
+			fmt.Fprintf(&buf, "%c@%d", prefix, o.colIdx+1)
+		}
+	}
+	name = "sort"
+	if !n.needSort {
+		name = "nosort"
+	}
+	return name, buf.String(), []planNode{n.plan}
+}
+
+func (n *sortNode) Values() parser.DTuple {
+	var row parser.DTuple
+	if n.needSort {
+		row = n.rows[n.nextRow-1]
+	} else {
+		row = n.plan.Values()
+	}
+	return row[:len(n.columns)]
+}
+
+func (n *sortNode) Next() bool {
+	if n.err != nil {
+		return false
+	}
+	if !n.needSort {
+		return n.plan.Next()
+	}
+
+	if !n.sorted {
+		n.sorted = true
+		for n.plan.Next() {
+			// The result from plan.Values() is only valid until the next call to
+			// plan.Next(), so make a copy.
+			values := n.plan.Values()
+			row := make(parser.DTuple, len(values))
+			copy(row, values)
+			n.rows = append(n.rows, row)
+		}
+		if n.err = n.plan.Err(); n.err != nil {
+			return false
+		}
+		sort.Stable(n)
+	}
+
+	if n.nextRow >= len(n.rows) {
+		return false
+	}
+	n.nextRow++
+	return true
+}
+
+func (n *sortNode) Err() error {
+	if n.err != nil {
+		return n.err
+	}
+	return n.plan.Err()
+}
+
+func (n *sortNode) Len() int {
+	return len(n.rows)
+}
+
+func (n *sortNode) Less(i, j int) bool {
+	ra, rb := n.rows[i], n.rows[j]
+	for _, o := range n.ordering {
+		c := ra[o.colIdx].Compare(rb[o.colIdx])
+		if c == 0 {
+			continue
+		}
+		if o.direction == parser.Descending {
+			return c > 0
+		}
+		return c < 0
+	}
+	return false
+}
+
+func (n *sortNode) Swap(i, j int) {
+	n.rows[i], n.rows[j] = n.rows[j], n.rows[i]
+}
@@ -0,0 +1,95 @@
+// Copyright 2015 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License. See the AUTHORS file
+// for names of contributors.
+
+package sql
+
+import (
+	"testing"
+
+	"github.com/cockroachdb/cockroach/sql/parser"
+	"github.com/cockroachdb/cockroach/structured"
+	"github.com/cockroachdb/cockroach/util/leaktest"
+)
+
+// makeTestScan parses the SELECT statement and constructs a scan of the
+// table with the render expressions and filter of the statement.
+func makeTestScan(t *testing.T, desc *structured.TableDescriptor, sql string) (*parser.Select, *scanNode) {
+	stmts, err := parser.Parse(sql)
+	if err != nil {
+		t.Fatalf("%s: %v", sql, err)
+	}
+	sel := stmts[0].(*parser.Select)
+	s := &scanNode{desc: desc, index: &desc.PrimaryIndex}
+	for _, e := range sel.Exprs {
+		expr := e.(*parser.NonStarExpr)
+		s.columns = append(s.columns, expr.Expr.String())
+		if expr.As != "" {
+			s.columns[len(s.columns)-1] = string(expr.As)
+		}
+		s.render = append(s.render, expr.Expr)
+	}
+	if sel.Where != nil {
+		s.filter = sel.Where.Expr
+	}
+	return sel, s
+}
+
+func TestSortElision(t *testing.T) {
+	defer leaktest.AfterTest(t)
+
+	desc := makeTestTableDesc(t, `a INT PRIMARY KEY, b INT, c INT,
+CONSTRAINT bc INDEX (b, c)`)
+
+	testData := []struct {
+		sql      string
+		needSort bool
+	}{
+		{`SELECT a FROM test ORDER BY a`, false},
+		{`SELECT a FROM test ORDER BY a, b`, false},
+		{`SELECT a FROM test ORDER BY 1`, false},
+		{`SELECT b FROM test ORDER BY a`, false},
+		{`SELECT a AS b FROM test ORDER BY b`, false},
+		{`SELECT a FROM test ORDER BY a DESC`, true},
+		{`SELECT a FROM test ORDER BY b`, true},
+		{`SELECT a FROM test ORDER BY a + 1`, true},
+		{`SELECT a FROM test WHERE a > 1 ORDER BY a`, false},
+		{`SELECT a FROM test WHERE a IN (1, 3) ORDER BY a`, false},
+		{`SELECT a FROM test WHERE b = 1 ORDER BY b`, false},
+		{`SELECT a FROM test WHERE b = 1 ORDER BY c`, false},
+		{`SELECT a FROM test WHERE b = 1 ORDER BY c, a`, false},
+		{`SELECT a FROM test WHERE b = 1 ORDER BY a`, true},
+		{`SELECT a FROM test WHERE b IN (1, 3) ORDER BY c`, true},
+		{`SELECT a FROM test WHERE b IN (1, 3) ORDER BY b, c`, false},
+		{`SELECT a FROM test WHERE b > 1 ORDER BY c`, true},
+	}
+	for _, d := range testData {
+		sel, s := makeTestScan(t, desc, d.sql)
+		p := &planner{}
+		sort, err := p.orderBy(sel, s)
+		if err != nil {
+			t.Fatalf("%s: %v", d.sql, err)
+		}
+		plan, err := p.selectIndex(s)
+		if err != nil {
+			t.Fatalf("%s: %v", d.sql, err)
+		}
+		if _, err := sort.wrap(plan); err != nil {
+			t.Fatalf("%s: %v", d.sql, err)
+		}
+		if sort.needSort != d.needSort {
+			t.Errorf("%s: expected needSort=%t, but found %t", d.sql, d.needSort, sort.needSort)
+		}
+	}
+}
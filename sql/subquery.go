@@ -0,0 +1,306 @@
+// Copyright 2015 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License. See the AUTHORS file
+// for names of contributors.
+
+package sql
+
+import (
+	"fmt"
+
+	"github.com/cockroachdb/cockroach/sql/parser"
+	"github.com/cockroachdb/cockroach/structured"
+	"github.com/cockroachdb/cockroach/util"
+)
+
+// An outerScope holds the columns of an enclosing query which are referenced
+// by a correlated subquery. While the subquery is first planned the values
+// of the columns are unknown. The subquery is then planned again for each
+// row of the enclosing query with the values bound.
+type outerScope struct {
+	scope *fromScope
+	names []*parser.QualifiedName // the referenced columns
+	vals  parser.DTuple           // the values of the referenced columns
+	bound bool
+}
+
+// reference returns the expression which replaces a reference to a column of
+// the enclosing query.
+func (o *outerScope) reference(t *fromTable, col *structured.ColumnDescriptor) (parser.Expr, error) {
+	name := qualifiedColumnName(t, col)
+	for i := range o.names {
+		if o.names[i].String() == name.String() {
+			return &parser.DReference{Expr: name, Datum: &o.vals[i]}, nil
+		}
+	}
+	if o.bound {
+		return nil, util.Errorf("unexpected reference to column %s of the enclosing query", name)
+	}
+	o.names = append(o.names, name)
+	o.vals = append(o.vals, parser.DNull)
+	return &parser.DReference{Expr: name, Datum: &o.vals[len(o.vals)-1]}, nil
+}
+
+// numOuterRefs returns the number of columns of the outermost depth
+// enclosing queries which are referenced.
+func (p *planner) numOuterRefs(depth int) int {
+	var n int
+	for _, o := range p.outer[:depth] {
+		n += len(o.names)
+	}
+	return n
+}
+
+// outerRefVisitor replaces references to the columns of the enclosing
+// queries with references to the values of those columns.
+type outerRefVisitor struct {
+	*planner
+	scope *fromScope
+	err   error
+}
+
+var _ parser.Visitor = &outerRefVisitor{}
+
+func (v *outerRefVisitor) Visit(expr parser.Expr) parser.Expr {
+	if v.err != nil {
+		return expr
+	}
+	qname, ok := expr.(*parser.QualifiedName)
+	if !ok {
+		return expr
+	}
+	if _, _, _, err := v.scope.findColumn(qname); err == nil {
+		return expr
+	}
+	for i := len(v.outer) - 1; i >= 0; i-- {
+		o := v.outer[i]
+		t, col, _, err := o.scope.findColumn(qname)
+		if err != nil {
+			continue
+		}
+		var ref parser.Expr
+		if ref, v.err = o.reference(t, col); v.err != nil {
+			return expr
+		}
+		return ref
+	}
+	// The reference is left to be reported by name resolution.
+	return expr
+}
+
+// subqueryKind determines the value a subquery evaluates to.
+type subqueryKind int
+
+const (
+	// A scalar subquery evaluates to the single value or row it returns, or
+	// NULL if it returns no rows.
+	subqueryScalar subqueryKind = iota
+	// The subquery of an IN expression evaluates to the tuple of returned
+	// values or rows.
+	subqueryIn
+	// The subquery of an EXISTS expression evaluates to whether it returns any
+	// rows.
+	subqueryExists
+)
+
+// A subquery is a subquery which refers to the columns of an enclosing query.
+// Planning modifies the statement and so each evaluation plans the subquery
+// from its text. The results are cached by the values of the referenced
+// columns.
+type subquery struct {
+	p     *planner
+	kind  subqueryKind
+	sql   string
+	outer *outerScope
+	cache map[string]parser.Datum
+}
+
+func (s *subquery) eval(args parser.DTuple) (parser.Datum, error) {
+	key := args.String()
+	if d, ok := s.cache[key]; ok {
+		return d, nil
+	}
+	stmts, err := parser.Parse(s.sql)
+	if err != nil {
+		return parser.DNull, err
+	}
+	outer := &outerScope{scope: s.outer.scope, names: s.outer.names, vals: args, bound: true}
+	s.p.outer = append(s.p.outer, outer)
+	defer func() { s.p.outer = s.p.outer[:len(s.p.outer)-1] }()
+
+	plan, err := s.p.makePlan(stmts[0])
+	if err != nil {
+		return parser.DNull, err
+	}
+	d, err := evalSubquery(plan, s.kind)
+	if err != nil {
+		return parser.DNull, err
+	}
+	s.cache[key] = d
+	return d, nil
+}
+
+// evalSubquery retrieves the rows of the plan of a subquery and returns the
+// value the subquery evaluates to.
+func evalSubquery(plan planNode, kind subqueryKind) (parser.Datum, error) {
+	switch kind {
+	case subqueryExists:
+		d := parser.DBool(plan.Next())
+		return d, plan.Err()
+
+	case subqueryScalar:
+		if !plan.Next() {
+			return parser.DNull, plan.Err()
+		}
+		d := subqueryRow(plan.Values())
+		if plan.Next() {
+			return parser.DNull, fmt.Errorf("more than one row returned by a subquery used as an expression")
+		}
+		return d, plan.Err()
+	}
+
+	var rows parser.DTuple
+	for plan.Next() {
+		rows = append(rows, subqueryRow(plan.Values()))
+	}
+	return rows, plan.Err()
+}
+
+// subqueryRow returns the value of a row returned by a subquery: the single
+// value of the row or a copy of the row.
+func subqueryRow(values parser.DTuple) parser.Datum {
+	if len(values) == 1 {
+		// TODO(pmattis): This seems hokey, but if we don't do this then the
+		// subquery expands to a tuple of tuples instead of a tuple of values and
+		// an expression like "k IN (SELECT foo FROM bar)" will fail because
+		// we're comparing a single value against a tuple. Perhaps comparison of
+		// a single value against a tuple should succeed if the tuple is one
+		// element in length.
+		return values[0]
+	}
+	// The result from plan.Values() is only valid until the next call to
+	// plan.Next(), so make a copy.
+	valuesCopy := make(parser.DTuple, len(values))
+	copy(valuesCopy, values)
+	return valuesCopy
+}
+
+// subqueryVisitor replaces subqueries with their values. A subquery which
+// refers to the columns of the query in scope is replaced by a DSubquery
+// which is evaluated for each row.
+type subqueryVisitor struct {
+	*planner
+	scope *fromScope // the scope of the enclosing query, or nil
+	err   error
+}
+
+var _ parser.Visitor = &subqueryVisitor{}
+
+func (v *subqueryVisitor) Visit(expr parser.Expr) parser.Expr {
+	if v.err != nil {
+		return expr
+	}
+	switch t := expr.(type) {
+	case *parser.ComparisonExpr:
+		if t.Operator == parser.In || t.Operator == parser.NotIn {
+			if subquery, ok := t.Right.(*parser.Subquery); ok {
+				t.Right, v.err = v.expand(subquery, subquery.Select, subqueryIn)
+			}
+		}
+	case *parser.Subquery:
+		expr, v.err = v.expand(t, t.Select, subqueryScalar)
+	case *parser.ExistsExpr:
+		expr, v.err = v.expand(t, t.Subquery.Select, subqueryExists)
+	}
+	return expr
+}
+
+func (v *subqueryVisitor) expand(expr parser.Expr, stmt parser.SelectStatement,
+	kind subqueryKind) (parser.Expr, error) {
+	if v.explaining {
+		return v.explainSubquery(expr, stmt)
+	}
+	s := &subquery{p: v.planner, kind: kind, cache: map[string]parser.Datum{}}
+	if v.scope != nil {
+		// Planning the subquery modifies the statement, so save its text first.
+		s.sql = stmt.String()
+		s.outer = &outerScope{scope: v.scope}
+		v.outer = append(v.outer, s.outer)
+		defer func() { v.outer = v.outer[:len(v.outer)-1] }()
+	}
+	depth := len(v.outer)
+	refs := v.numOuterRefs(depth)
+
+	plan, err := v.makePlan(stmt)
+	if err != nil {
+		return expr, err
+	}
+	if s.outer == nil || v.numOuterRefs(depth) == refs {
+		return evalSubquery(plan, kind)
+	}
+	// The subquery refers to the columns of an enclosing query. If it only
+	// refers to the columns of queries enclosing the query in scope, the query
+	// in scope is itself correlated and is planned again once the values of
+	// those columns are known.
+	args := make(parser.Exprs, len(s.outer.names))
+	for i, name := range s.outer.names {
+		args[i] = &parser.QualifiedName{Base: name.Base, Indirect: name.Indirect}
+	}
+	return &parser.DSubquery{Expr: expr, Args: args, Eval: s.eval}, nil
+}
+
+// A subqueryPlan is the plan of a subquery of an explained statement.
+type subqueryPlan struct {
+	expr string
+	plan planNode
+}
+
+// explainSubquery plans a subquery of an explained statement without running
+// it, as EXPLAIN must not read the tables. The subquery is replaced by a
+// DSubquery which fails if the statement is planned in a way that needs its
+// value, for instance for a LIMIT.
+func (v *subqueryVisitor) explainSubquery(expr parser.Expr, stmt parser.SelectStatement) (parser.Expr, error) {
+	// Planning the subquery modifies the statement, so format it first.
+	name := expr.String()
+	if v.scope != nil {
+		// The references to the columns of the enclosing query are left
+		// unbound.
+		o := &outerScope{scope: v.scope}
+		v.outer = append(v.outer, o)
+		defer func() { v.outer = v.outer[:len(v.outer)-1] }()
+	}
+	plan, err := v.makePlan(stmt)
+	if err != nil {
+		return expr, err
+	}
+	v.subqueryPlans = append(v.subqueryPlans, subqueryPlan{expr: name, plan: plan})
+	return &parser.DSubquery{Expr: expr, Eval: func(parser.DTuple) (parser.Datum, error) {
+		return parser.DNull, fmt.Errorf("the value of subquery %s is needed to plan the statement", name)
+	}}, nil
+}
+
+// expandSubqueries replaces the subqueries of a statement with their values.
+// For a SELECT, scope is the scope of the tables of the FROM clause. The
+// references to the columns of enclosing queries are resolved first.
+func (p *planner) expandSubqueries(stmt parser.Statement, scope *fromScope) error {
+	if scope != nil && len(p.outer) > 0 {
+		v := outerRefVisitor{planner: p, scope: scope}
+		parser.WalkStmt(&v, stmt)
+		if v.err != nil {
+			return v.err
+		}
+	}
+	v := subqueryVisitor{planner: p, scope: scope}
+	parser.WalkStmt(&v, stmt)
+	return v.err
+}
@@ -20,12 +20,15 @@ package sql
 import (
 	"bytes"
 	"fmt"
+	"time"
 
+	"github.com/cockroachdb/cockroach/client"
 	"github.com/cockroachdb/cockroach/keys"
 	"github.com/cockroachdb/cockroach/proto"
 	"github.com/cockroachdb/cockroach/sql/parser"
 	"github.com/cockroachdb/cockroach/structured"
 	"github.com/cockroachdb/cockroach/util"
+	"github.com/cockroachdb/cockroach/util/decimal"
 	"github.com/cockroachdb/cockroach/util/encoding"
 )
 
@@ -43,45 +46,74 @@ func (tk tableKey) Name() string {
 	return tk.name
 }
 
+// makeColumnDesc creates the descriptor for a column definition. The ID of
+// the column is left unset.
+func makeColumnDesc(d *parser.ColumnTableDef) structured.ColumnDescriptor {
+	col := structured.ColumnDescriptor{
+		Name:     string(d.Name),
+		Nullable: (d.Nullable != parser.NotNull),
+	}
+	switch t := d.Type.(type) {
+	case *parser.BitType:
+		col.Type.Kind = structured.ColumnType_BIT
+		col.Type.Width = int32(t.N)
+	case *parser.IntType:
+		col.Type.Kind = structured.ColumnType_INT
+		col.Type.Width = int32(t.N)
+	case *parser.SerialType:
+		// Like in postgres, a SERIAL column is implicitly NOT NULL.
+		col.Type.Kind = structured.ColumnType_INT
+		col.Nullable = false
+		col.Serial = true
+	case *parser.FloatType:
+		col.Type.Kind = structured.ColumnType_FLOAT
+		col.Type.Precision = int32(t.Prec)
+	case *parser.DecimalType:
+		col.Type.Kind = structured.ColumnType_DECIMAL
+		col.Type.Width = int32(t.Scale)
+		col.Type.Precision = int32(t.Prec)
+	case *parser.DateType:
+		col.Type.Kind = structured.ColumnType_DATE
+	case *parser.TimeType:
+		col.Type.Kind = structured.ColumnType_TIME
+	case *parser.TimestampType:
+		col.Type.Kind = structured.ColumnType_TIMESTAMP
+	case *parser.IntervalType:
+		col.Type.Kind = structured.ColumnType_INTERVAL
+	case *parser.CharType:
+		col.Type.Kind = structured.ColumnType_CHAR
+		col.Type.Width = int32(t.N)
+	case *parser.TextType:
+		col.Type.Kind = structured.ColumnType_TEXT
+	case *parser.BlobType:
+		col.Type.Kind = structured.ColumnType_BLOB
+	}
+	if d.DefaultExpr != nil {
+		s := d.DefaultExpr.String()
+		col.DefaultExpr = &s
+	}
+	return col
+}
+
 func makeTableDesc(p *parser.CreateTable) (structured.TableDescriptor, error) {
 	desc := structured.TableDescriptor{}
 	desc.Name = p.Table.String()
+	// The CHECK constraints are added once all of the columns they can refer
+	// to are known.
+	var checks []*parser.CheckConstraintTableDef
+	var checkCols []*structured.ColumnDescriptor
 	for _, def := range p.Defs {
 		switch d := def.(type) {
 		case *parser.ColumnTableDef:
-			col := structured.ColumnDescriptor{
-				Name:     string(d.Name),
-				Nullable: (d.Nullable != parser.NotNull),
-			}
-			switch t := d.Type.(type) {
-			case *parser.BitType:
-				col.Type.Kind = structured.ColumnType_BIT
-				col.Type.Width = int32(t.N)
-			case *parser.IntType:
-				col.Type.Kind = structured.ColumnType_INT
-				col.Type.Width = int32(t.N)
-			case *parser.FloatType:
-				col.Type.Kind = structured.ColumnType_FLOAT
-				col.Type.Precision = int32(t.Prec)
-			case *parser.DecimalType:
-				col.Type.Kind = structured.ColumnType_DECIMAL
-				col.Type.Width = int32(t.Scale)
-				col.Type.Precision = int32(t.Prec)
-			case *parser.DateType:
-				col.Type.Kind = structured.ColumnType_DATE
-			case *parser.TimeType:
-				col.Type.Kind = structured.ColumnType_TIME
-			case *parser.TimestampType:
-				col.Type.Kind = structured.ColumnType_TIMESTAMP
-			case *parser.CharType:
-				col.Type.Kind = structured.ColumnType_CHAR
-				col.Type.Width = int32(t.N)
-			case *parser.TextType:
-				col.Type.Kind = structured.ColumnType_TEXT
-			case *parser.BlobType:
-				col.Type.Kind = structured.ColumnType_BLOB
+			col := makeColumnDesc(d)
+			if err := validateDefaultExpr(col, d.DefaultExpr); err != nil {
+				return desc, err
 			}
 			desc.Columns = append(desc.Columns, col)
+			for _, expr := range d.CheckExprs {
+				checks = append(checks, &parser.CheckConstraintTableDef{Expr: expr})
+				checkCols = append(checkCols, &col)
+			}
 
 			// Create any associated index.
 			if d.PrimaryKey || d.Unique {
@@ -111,13 +143,178 @@ func makeTableDesc(p *parser.CreateTable) (structured.TableDescriptor, error) {
 			} else {
 				desc.Indexes = append(desc.Indexes, index)
 			}
+		case *parser.CheckConstraintTableDef:
+			checks = append(checks, d)
+			checkCols = append(checkCols, nil)
+		case *parser.ForeignKeyTableDef:
+			// The foreign keys are added by the planner, which looks up the
+			// referenced tables.
 		default:
 			return desc, fmt.Errorf("unsupported table def: %T", def)
 		}
 	}
+	if len(desc.PrimaryIndex.ColumnNames) == 0 {
+		addRowIDColumn(&desc)
+	}
+	for i, check := range checks {
+		if err := addCheckConstraint(&desc, p.Table.Table(), string(check.Name), check.Expr, checkCols[i]); err != nil {
+			return desc, err
+		}
+	}
 	return desc, nil
 }
 
+// rowIDColumnName is the name of the hidden column which is the primary key
+// of a table created without one.
+const rowIDColumnName = "rowid"
+
+// addRowIDColumn adds the hidden rowid column to the descriptor of a table
+// without a primary key and makes it the primary key. The values of the
+// column are generated by unique_rowid(), so the rows are roughly ordered by
+// the time they were inserted.
+func addRowIDColumn(desc *structured.TableDescriptor) {
+	defaultExpr := "unique_rowid()"
+	desc.Columns = append(desc.Columns, structured.ColumnDescriptor{
+		Name:        rowIDColumnName,
+		Type:        structured.ColumnType{Kind: structured.ColumnType_INT},
+		DefaultExpr: &defaultExpr,
+		Hidden:      true,
+	})
+	desc.PrimaryIndex = structured.IndexDescriptor{
+		Name:        structured.PrimaryKeyIndexName,
+		Unique:      true,
+		ColumnNames: []string{rowIDColumnName},
+	}
+}
+
+// validateDefaultExpr verifies that the DEFAULT expression of a column, if
+// any, does not refer to any columns and that its value can be stored in the
+// column. Expressions such as random() are evaluated once more per row when
+// the default is used.
+func validateDefaultExpr(col structured.ColumnDescriptor, expr parser.Expr) error {
+	if expr == nil {
+		return nil
+	}
+	if col.Serial {
+		return fmt.Errorf("multiple default values specified for column %q", col.Name)
+	}
+	if refs := columnRefs(expr); len(refs) > 0 {
+		return fmt.Errorf("cannot use column reference in DEFAULT expression: %s", refs[0])
+	}
+	// The value is only used to check its type, so the context doesn't matter.
+	d, err := parser.EvalExpr(parser.EvalContext{}, expr, valMap{})
+	if err != nil {
+		return err
+	}
+	_, err = prepareVal(col, d)
+	return err
+}
+
+// makeTableDescAs creates the descriptor of the table of a CREATE TABLE ...
+// AS statement. The columns of the table are those of the rows of the plan
+// and their types are those of the expressions computing them, so the table
+// is the same whatever rows the plan returns. A column whose type cannot be
+// determined, such as a NULL literal, has type TEXT. The primary key of the
+// table is the hidden rowid column.
+func makeTableDescAs(p *parser.CreateTable, rows planNode) (structured.TableDescriptor, error) {
+	desc := structured.TableDescriptor{}
+	desc.Name = p.Table.String()
+
+	names := rows.Columns()
+	if p.AsColumnNames != nil {
+		if len(p.AsColumnNames) != len(names) {
+			return desc, fmt.Errorf("CREATE TABLE AS specifies %d column names, but the query returns %d columns",
+				len(p.AsColumnNames), len(names))
+		}
+		names = []string(p.AsColumnNames)
+	}
+
+	types, err := planColumnTypes(rows)
+	if err != nil {
+		return desc, err
+	}
+	for i, name := range names {
+		col := structured.ColumnDescriptor{Name: name, Nullable: true}
+		if types[i] == parser.DNull {
+			col.Type.Kind = structured.ColumnType_TEXT
+		} else if col.Type, err = datumColumnType(types[i]); err != nil {
+			return desc, err
+		}
+		desc.Columns = append(desc.Columns, col)
+	}
+	addRowIDColumn(&desc)
+	return desc, nil
+}
+
+// datumColumnType returns the type of a column which holds the datum.
+func datumColumnType(d parser.Datum) (structured.ColumnType, error) {
+	var typ structured.ColumnType
+	switch d.(type) {
+	case parser.DBool:
+		typ.Kind = structured.ColumnType_BIT
+	case parser.DInt:
+		typ.Kind = structured.ColumnType_INT
+	case parser.DFloat:
+		typ.Kind = structured.ColumnType_FLOAT
+	case parser.DDecimal:
+		typ.Kind = structured.ColumnType_DECIMAL
+	case parser.DString:
+		typ.Kind = structured.ColumnType_TEXT
+	case parser.DBytes:
+		typ.Kind = structured.ColumnType_BLOB
+	case parser.DDate:
+		typ.Kind = structured.ColumnType_DATE
+	case parser.DTime:
+		typ.Kind = structured.ColumnType_TIME
+	case parser.DTimestamp:
+		typ.Kind = structured.ColumnType_TIMESTAMP
+	case parser.DInterval:
+		typ.Kind = structured.ColumnType_INTERVAL
+	default:
+		return typ, fmt.Errorf("unsupported column type: %s", d.Type())
+	}
+	return typ, nil
+}
+
+// columnTypeSample returns a sample value of the type of a column, as
+// returned by a scan of the column.
+func columnTypeSample(typ structured.ColumnType) parser.Datum {
+	switch typ.Kind {
+	case structured.ColumnType_BIT, structured.ColumnType_INT:
+		return parser.DInt(1)
+	case structured.ColumnType_FLOAT:
+		return parser.DFloat(1)
+	case structured.ColumnType_DECIMAL:
+		return parser.DDecimal{Decimal: decimal.New(1, 0)}
+	case structured.ColumnType_CHAR, structured.ColumnType_TEXT:
+		return parser.DString("")
+	case structured.ColumnType_BLOB:
+		return parser.DBytes("")
+	case structured.ColumnType_DATE:
+		return daysToDate(0)
+	case structured.ColumnType_TIME:
+		return parser.DTime{}
+	case structured.ColumnType_TIMESTAMP:
+		return parser.DTimestamp{Time: time.Unix(0, 0).UTC()}
+	case structured.ColumnType_INTERVAL:
+		return parser.DInterval{}
+	}
+	return parser.DNull
+}
+
+// allColumnsSelectExprs returns the select expressions for all of the columns
+// of the table, including the hidden columns which are not returned by *.
+func allColumnsSelectExprs(desc *structured.TableDescriptor) parser.SelectExprs {
+	exprs := make(parser.SelectExprs, len(desc.Columns))
+	for i, col := range desc.Columns {
+		exprs[i] = &parser.NonStarExpr{
+			Expr: &parser.QualifiedName{Base: parser.Name(col.Name)},
+			As:   parser.Name(col.Name),
+		}
+	}
+	return exprs
+}
+
 func (p *planner) getTableDesc(qname *parser.QualifiedName) (
 	*structured.TableDescriptor, error) {
 	if err := p.normalizeTableName(qname); err != nil {
@@ -135,9 +332,56 @@ func (p *planner) getTableDesc(qname *parser.QualifiedName) (
 	return &desc, nil
 }
 
+// getTableDescInTxn looks up the descriptor for the table within the
+// transaction and validates it.
+func getTableDescInTxn(txn *client.Txn, tbKey tableKey) (*structured.TableDescriptor, error) {
+	desc, err := lookupTableDescInTxn(txn, tbKey)
+	if err != nil {
+		return nil, err
+	}
+	if desc == nil {
+		return nil, fmt.Errorf("table %q does not exist", tbKey.Name())
+	}
+	return desc, nil
+}
+
+// getTableDescByID looks up the descriptor for the table with the given ID
+// within the transaction and validates it.
+func getTableDescByID(txn *client.Txn, id structured.ID) (*structured.TableDescriptor, error) {
+	desc := &structured.TableDescriptor{}
+	if err := txn.GetProto(structured.MakeDescMetadataKey(id), desc); err != nil {
+		return nil, err
+	}
+	if err := desc.Validate(); err != nil {
+		return nil, err
+	}
+	return desc, nil
+}
+
+// lookupTableDescInTxn is like getTableDescInTxn, but returns a nil
+// descriptor if the table does not exist.
+func lookupTableDescInTxn(txn *client.Txn, tbKey tableKey) (*structured.TableDescriptor, error) {
+	gr, err := txn.Get(tbKey.Key())
+	if err != nil {
+		return nil, err
+	}
+	if !gr.Exists() {
+		return nil, nil
+	}
+
+	desc := structured.TableDescriptor{}
+	if err := txn.GetProto(gr.ValueBytes(), &desc); err != nil {
+		return nil, err
+	}
+	if err := desc.Validate(); err != nil {
+		return nil, err
+	}
+	return &desc, nil
+}
+
 func (p *planner) getTableNames(dbDesc *structured.DatabaseDescriptor) (parser.QualifiedNames, error) {
 	prefix := structured.MakeNameMetadataKey(dbDesc.ID, "")
-	sr, err := p.db.Scan(prefix, prefix.PrefixEnd(), 0)
+	sr, err := p.txn.Scan(prefix, prefix.PrefixEnd(), 0)
 	if err != nil {
 		return nil, err
 	}
@@ -196,12 +440,66 @@ func encodeTableKey(b []byte, val parser.Datum) ([]byte, error) {
 		return encoding.EncodeVarint(b, int64(t)), nil
 	case parser.DFloat:
 		return encoding.EncodeNumericFloat(b, float64(t)), nil
+	case parser.DDecimal:
+		return encoding.EncodeDecimal(b, t.Decimal), nil
 	case parser.DString:
 		return encoding.EncodeBytes(b, []byte(t)), nil
+	case parser.DBytes:
+		return encoding.EncodeBytes(b, []byte(t)), nil
+	case parser.DDate:
+		return encoding.EncodeVarint(b, dateToDays(t)), nil
+	case parser.DTime:
+		return encoding.EncodeVarint(b, int64(t.Duration)), nil
+	case parser.DTimestamp:
+		return encoding.EncodeTime(b, t.Time), nil
+	case parser.DInterval:
+		return encoding.EncodeVarint(b, int64(t.Duration)), nil
 	}
 	return nil, fmt.Errorf("unable to encode table key: %T", val)
 }
 
+// encodeDatumKey appends the encoding of the datum to b for use as a map key
+// identifying distinct values. Unlike encodeTableKey, the encoding includes
+// the type of the datum so that values of different types, such as the int 1
+// and the float 1 or NULL and the empty string, do not collide.
+func encodeDatumKey(b []byte, val parser.Datum) ([]byte, error) {
+	b = encoding.EncodeBytes(b, []byte(val.Type()))
+	if t, ok := val.(parser.DTuple); ok {
+		b = encoding.EncodeUvarint(b, uint64(len(t)))
+		for _, v := range t {
+			var err error
+			if b, err = encodeDatumKey(b, v); err != nil {
+				return nil, err
+			}
+		}
+		return b, nil
+	}
+	return encodeTableKey(b, val)
+}
+
+// limitDecimal rounds the decimal to the scale of the DECIMAL column,
+// returning an error if it does not fit the precision of the column.
+func limitDecimal(col structured.ColumnDescriptor, d parser.DDecimal) (parser.Datum, error) {
+	v, err := parser.LimitDecimal(d, int(col.Type.Precision), int(col.Type.Width))
+	if err != nil {
+		return nil, fmt.Errorf("%s: column %q", err, col.Name)
+	}
+	return v, nil
+}
+
+const secondsInDay = 24 * 60 * 60
+
+// dateToDays returns the number of days since the Unix epoch for the date.
+// DATE values are stored and encoded in keys using this representation.
+func dateToDays(d parser.DDate) int64 {
+	return d.Unix() / secondsInDay
+}
+
+// daysToDate is the inverse of dateToDays.
+func daysToDate(days int64) parser.DDate {
+	return parser.DDate{Time: time.Unix(days*secondsInDay, 0).UTC()}
+}
+
 func decodeIndexKey(desc *structured.TableDescriptor,
 	index structured.IndexDescriptor, vals map[string]parser.Datum, key []byte) ([]byte, error) {
 	if !bytes.HasPrefix(key, keys.TableDataPrefix) {
@@ -221,11 +519,34 @@ func decodeIndexKey(desc *structured.TableDescriptor,
 		return nil, fmt.Errorf("%s: unexpected index ID: %d != %d", desc.Name, index.ID, indexID)
 	}
 
-	for _, id := range index.ColumnIDs {
+	return decodeKeyVals(desc, index.ColumnIDs, vals, key)
+}
+
+// nullKeyEncoding is the encoding of a NULL value within a key. See
+// encodeTableKey.
+var nullKeyEncoding = encoding.EncodeBytes(nil, nil)
+
+// decodeKeyVals decodes the values for the specified columns from the key,
+// storing them in vals, and returns the remainder of the key.
+func decodeKeyVals(desc *structured.TableDescriptor, columnIDs []structured.ID,
+	vals map[string]parser.Datum, key []byte) ([]byte, error) {
+	for _, id := range columnIDs {
 		col, err := desc.FindColumnByID(id)
 		if err != nil {
 			return nil, err
 		}
+		switch col.Type.Kind {
+		case structured.ColumnType_CHAR, structured.ColumnType_TEXT,
+			structured.ColumnType_BLOB:
+			// TODO(tamird,pmattis): NULL and the empty string share an encoding.
+		default:
+			if bytes.HasPrefix(key, nullKeyEncoding) {
+				key = key[len(nullKeyEncoding):]
+				vals[col.Name] = parser.DNull
+				continue
+			}
+		}
+
 		switch col.Type.Kind {
 		case structured.ColumnType_BIT, structured.ColumnType_INT:
 			var i int64
@@ -235,11 +556,38 @@ func decodeIndexKey(desc *structured.TableDescriptor,
 			var f float64
 			key, f = encoding.DecodeNumericFloat(key)
 			vals[col.Name] = parser.DFloat(f)
-		case structured.ColumnType_CHAR, structured.ColumnType_TEXT,
-			structured.ColumnType_BLOB:
+		case structured.ColumnType_DECIMAL:
+			var d decimal.Decimal
+			key, d = encoding.DecodeDecimal(key)
+			if col.Type.Precision > 0 {
+				// The key does not hold the trailing zeros of the value.
+				d = d.Round(col.Type.Width)
+			}
+			vals[col.Name] = parser.DDecimal{Decimal: d}
+		case structured.ColumnType_CHAR, structured.ColumnType_TEXT:
 			var r []byte
 			key, r = encoding.DecodeBytes(key, nil)
 			vals[col.Name] = parser.DString(r)
+		case structured.ColumnType_BLOB:
+			var r []byte
+			key, r = encoding.DecodeBytes(key, nil)
+			vals[col.Name] = parser.DBytes(r)
+		case structured.ColumnType_DATE:
+			var d int64
+			key, d = encoding.DecodeVarint(key)
+			vals[col.Name] = daysToDate(d)
+		case structured.ColumnType_TIME:
+			var d int64
+			key, d = encoding.DecodeVarint(key)
+			vals[col.Name] = parser.DTime{Duration: time.Duration(d)}
+		case structured.ColumnType_TIMESTAMP:
+			var t time.Time
+			key, t = encoding.DecodeTime(key)
+			vals[col.Name] = parser.DTimestamp{Time: t}
+		case structured.ColumnType_INTERVAL:
+			var d int64
+			key, d = encoding.DecodeVarint(key)
+			vals[col.Name] = parser.DInterval{Duration: time.Duration(d)}
 		default:
 			return nil, util.Errorf("TODO(pmattis): decoded index key: %s", col.Type.Kind)
 		}
@@ -278,9 +626,9 @@ func encodeSecondaryIndexes(tableID structured.ID, indexes []structured.IndexDes
 	return secondaryIndexEntries, nil
 }
 
-// TODO(tamird): make this not panic. Not critical, since a panic here
-// will just tank a single goroutine on the server and be silently
-// swallowed.
+// prepareVal converts the value to the representation stored in the KV
+// cell of the column, returning an error if its type doesn't match the type
+// of the column.
 func prepareVal(col structured.ColumnDescriptor, val parser.Expr) (interface{}, error) {
 	if val == parser.DNull {
 		return nil, nil
@@ -288,17 +636,58 @@ func prepareVal(col structured.ColumnDescriptor, val parser.Expr) (interface{},
 
 	switch col.Type.Kind {
 	case structured.ColumnType_BIT:
-		return bool(val.(parser.DBool)), nil
+		if v, ok := val.(parser.DBool); ok {
+			return bool(v), nil
+		}
 	case structured.ColumnType_INT:
-		return int64(val.(parser.DInt)), nil
+		if v, ok := val.(parser.DInt); ok {
+			return int64(v), nil
+		}
 	case structured.ColumnType_FLOAT:
-		return float64(val.(parser.DFloat)), nil
-	// case structured.ColumnType_DECIMAL:
-	// case structured.ColumnType_DATE:
-	// case structured.ColumnType_TIME:
-	// case structured.ColumnType_TIMESTAMP:
-	case structured.ColumnType_CHAR, structured.ColumnType_TEXT, structured.ColumnType_BLOB:
-		return string(val.(parser.DString)), nil
-	}
-	return nil, fmt.Errorf("Unsupported type: %T", val)
+		if v, ok := val.(parser.DFloat); ok {
+			return float64(v), nil
+		}
+	case structured.ColumnType_DECIMAL:
+		if v, ok := val.(parser.DDecimal); ok {
+			d, err := limitDecimal(col, v)
+			if err != nil {
+				return nil, err
+			}
+			return d.String(), nil
+		}
+	case structured.ColumnType_DATE:
+		if v, ok := val.(parser.DDate); ok {
+			return dateToDays(v), nil
+		}
+	case structured.ColumnType_TIME:
+		if v, ok := val.(parser.DTime); ok {
+			return int64(v.Duration), nil
+		}
+	case structured.ColumnType_TIMESTAMP:
+		if v, ok := val.(parser.DTimestamp); ok {
+			return encoding.EncodeTime(nil, v.Time), nil
+		}
+	case structured.ColumnType_INTERVAL:
+		if v, ok := val.(parser.DInterval); ok {
+			return int64(v.Duration), nil
+		}
+	case structured.ColumnType_CHAR, structured.ColumnType_TEXT:
+		if v, ok := val.(parser.DString); ok {
+			return string(v), nil
+		}
+	case structured.ColumnType_BLOB:
+		// A string literal is also accepted as the bytes it contains.
+		switch v := val.(type) {
+		case parser.DBytes:
+			return []byte(v), nil
+		case parser.DString:
+			return []byte(v), nil
+		}
+	default:
+		return nil, fmt.Errorf("unsupported column type: %s", col.Type.Kind)
+	}
+	if d, ok := val.(parser.Datum); ok {
+		return nil, fmt.Errorf("value type %s doesn't match type %s of column %q", d.Type(), col.Type.Kind, col.Name)
+	}
+	return nil, fmt.Errorf("value %s doesn't match type %s of column %q", val, col.Type.Kind, col.Name)
 }
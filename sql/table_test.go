@@ -198,6 +198,77 @@ func TestMakeTableDescIndexes(t *testing.T) {
 	}
 }
 
+func TestMakeTableDescChecks(t *testing.T) {
+	defer leaktest.AfterTest(t)
+
+	testData := []struct {
+		sql    string
+		checks []structured.CheckConstraint
+	}{
+		{
+			"a INT PRIMARY KEY CHECK (a > 0)",
+			[]structured.CheckConstraint{{Name: "test_a_check", Expr: "a > 0"}},
+		},
+		{
+			"a INT PRIMARY KEY, b INT, CHECK (a < b), CHECK (b < 10)",
+			[]structured.CheckConstraint{
+				{Name: "test_check", Expr: "a < b"},
+				{Name: "test_check1", Expr: "b < 10"},
+			},
+		},
+		{
+			"a INT PRIMARY KEY, CONSTRAINT foo CHECK (a <> 1), b INT CHECK (b IS NOT NULL)",
+			[]structured.CheckConstraint{
+				{Name: "foo", Expr: "a != 1"},
+				{Name: "test_b_check", Expr: "b IS NOT NULL"},
+			},
+		},
+	}
+	for i, d := range testData {
+		stmt, err := parser.Parse("CREATE TABLE test (" + d.sql + ")")
+		if err != nil {
+			t.Fatalf("%d: %v", i, err)
+		}
+		schema, err := makeTableDesc(stmt[0].(*parser.CreateTable))
+		if err != nil {
+			t.Fatalf("%d: %v", i, err)
+		}
+		if !reflect.DeepEqual(d.checks, schema.Checks) {
+			t.Fatalf("%d: expected %+v, but got %+v", i, d.checks, schema.Checks)
+		}
+	}
+}
+
+func TestCheckExprsForColumn(t *testing.T) {
+	defer leaktest.AfterTest(t)
+	stmt, err := parser.Parse("CREATE TABLE test (a INT PRIMARY KEY CHECK (a > 0), b INT, CHECK (a < b))")
+	if err != nil {
+		t.Fatal(err)
+	}
+	desc, err := makeTableDesc(stmt[0].(*parser.CreateTable))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	testData := []struct {
+		col      string
+		expected string
+	}{
+		{"a", "a > 0 AND a < b"},
+		{"b", "a < b"},
+		{"c", ""},
+	}
+	for i, d := range testData {
+		exprs, err := checkExprsForColumn(&desc, d.col)
+		if err != nil {
+			t.Fatalf("%d: %v", i, err)
+		}
+		if d.expected != exprs {
+			t.Fatalf("%d: expected %q, but got %q", i, d.expected, exprs)
+		}
+	}
+}
+
 func TestPrimaryKeyUnspecified(t *testing.T) {
 	defer leaktest.AfterTest(t)
 	stmt, err := parser.Parse("CREATE TABLE test (a INT, b INT, CONSTRAINT c UNIQUE (b))")
@@ -208,7 +279,19 @@ func TestPrimaryKeyUnspecified(t *testing.T) {
 	if err != nil {
 		t.Fatal(err)
 	}
-	if err := desc.AllocateIDs(); err != structured.ErrMissingPrimaryKey {
+	if err := desc.AllocateIDs(); err != nil {
 		t.Fatal(err)
 	}
+	// A table without a primary key gets the hidden rowid column as its
+	// primary key.
+	col := desc.Columns[len(desc.Columns)-1]
+	if col.Name != "rowid" || !col.Hidden || col.Nullable || col.GetDefaultExpr() != "unique_rowid()" {
+		t.Fatalf("unexpected rowid column: %+v", col)
+	}
+	if !reflect.DeepEqual(desc.PrimaryIndex.ColumnIDs, []structured.ID{col.ID}) {
+		t.Fatalf("unexpected primary key: %+v", desc.PrimaryIndex)
+	}
+	if cols := desc.VisibleColumns(); len(cols) != 2 {
+		t.Fatalf("expected 2 visible columns, but found %+v", cols)
+	}
 }
@@ -34,6 +34,8 @@ import (
 func (p *planner) Truncate(n *parser.Truncate) (planNode, error) {
 	b := client.Batch{}
 
+	var tables []*structured.TableDescriptor
+	truncated := map[structured.ID]struct{}{}
 	for _, tableQualifiedName := range n.Tables {
 		tableDesc, err := p.getTableDesc(tableQualifiedName)
 		if err != nil {
@@ -44,22 +46,39 @@ func (p *planner) Truncate(n *parser.Truncate) (planNode, error) {
 			return nil, fmt.Errorf("user %s does not have %s privilege on table %s",
 				p.user, parser.PrivilegeWrite, tableDesc.Name)
 		}
+		tables = append(tables, tableDesc)
+		truncated[tableDesc.ID] = struct{}{}
+	}
 
-		tablePrefix := structured.MakeTablePrefix(tableDesc.ID)
-
-		// Delete rows and indexes starting with the table's prefix.
-		tableStartKey := proto.Key(tablePrefix)
-		tableEndKey := tableStartKey.PrefixEnd()
-		if log.V(2) {
-			log.Infof("DelRange %q - %q", tableStartKey, tableEndKey)
+	for _, tableDesc := range tables {
+		// A table referenced by foreign keys can only be truncated together with
+		// the tables referencing it.
+		for _, id := range tableDesc.ReferencedBy {
+			if _, ok := truncated[id]; !ok {
+				return nil, fmt.Errorf("cannot truncate table %q referenced in a foreign key constraint", tableDesc.Name)
+			}
 		}
-		b.DelRange(tableStartKey, tableEndKey)
+		truncateTable(&b, tableDesc)
 	}
 
-	if err := p.db.Run(&b); err != nil {
+	if err := p.txn.Run(&b); err != nil {
 		return nil, err
 	}
 
 	// TODO(tamird/pmattis): return the number of affected rows
 	return &valuesNode{}, nil
 }
+
+// truncateTable adds the deletion of the rows and index entries of the table
+// to the batch.
+func truncateTable(b *client.Batch, tableDesc *structured.TableDescriptor) {
+	tablePrefix := structured.MakeTablePrefix(tableDesc.ID)
+
+	// Delete rows and indexes starting with the table's prefix.
+	tableStartKey := proto.Key(tablePrefix)
+	tableEndKey := tableStartKey.PrefixEnd()
+	if log.V(2) {
+		log.Infof("DelRange %q - %q", tableStartKey, tableEndKey)
+	}
+	b.DelRange(tableStartKey, tableEndKey)
+}
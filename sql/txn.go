@@ -0,0 +1,138 @@
+// Copyright 2015 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License. See the AUTHORS file
+// for names of contributors.
+
+package sql
+
+import (
+	"errors"
+
+	"github.com/cockroachdb/cockroach/client"
+	"github.com/cockroachdb/cockroach/proto"
+	"github.com/cockroachdb/cockroach/sql/parser"
+	"github.com/cockroachdb/cockroach/util"
+	"github.com/cockroachdb/cockroach/util/log"
+)
+
+var (
+	errTransactionInProgress   = errors.New("there is already a transaction in progress")
+	errNoTransactionInProgress = errors.New("there is no transaction in progress")
+	errTransactionAborted      = errors.New("current transaction is aborted, commands ignored until end of transaction block")
+	errCommitAborted           = errors.New("cannot commit an aborted transaction, it has been rolled back")
+)
+
+// BeginTransaction starts a new transaction. The statements of subsequent
+// requests are run within the transaction until it is ended by COMMIT or
+// ROLLBACK.
+// Privileges: None.
+func (p *planner) BeginTransaction(n *parser.BeginTransaction) (planNode, error) {
+	if p.txn != nil {
+		return nil, errTransactionInProgress
+	}
+	txn := client.NewTxn(*p.db, nil)
+	if err := setIsolationLevel(txn, n.Isolation); err != nil {
+		return nil, err
+	}
+	p.txn = txn
+	return &valuesNode{}, nil
+}
+
+// CommitTransaction commits the current transaction.
+// Privileges: None.
+func (p *planner) CommitTransaction(n *parser.CommitTransaction) (planNode, error) {
+	if p.txn == nil {
+		return nil, errNoTransactionInProgress
+	}
+	txn := p.txn
+	p.txn = nil
+	if txn.Proto().Status == proto.ABORTED {
+		return nil, errCommitAborted
+	}
+	if err := txn.Commit(&client.Batch{}); err != nil {
+		if rbErr := txn.Rollback(); rbErr != nil {
+			log.Errorf("failure aborting transaction: %s; abort caused by: %s", rbErr, err)
+		}
+		return nil, err
+	}
+	return &valuesNode{}, nil
+}
+
+// RollbackTransaction aborts the current transaction, discarding its writes.
+// Privileges: None.
+func (p *planner) RollbackTransaction(n *parser.RollbackTransaction) (planNode, error) {
+	if p.txn == nil {
+		return nil, errNoTransactionInProgress
+	}
+	txn := p.txn
+	p.txn = nil
+	if txn.Proto().Status == proto.ABORTED {
+		// The transaction was rolled back when it was aborted.
+		return &valuesNode{}, nil
+	}
+	if err := txn.Rollback(); err != nil {
+		return nil, err
+	}
+	return &valuesNode{}, nil
+}
+
+// SetTransaction sets the isolation level of the current transaction.
+// Privileges: None.
+func (p *planner) SetTransaction(n *parser.SetTransaction) (planNode, error) {
+	if p.txn == nil {
+		return nil, errNoTransactionInProgress
+	}
+	if err := setIsolationLevel(p.txn, n.Isolation); err != nil {
+		return nil, err
+	}
+	return &valuesNode{}, nil
+}
+
+// abortTransaction rolls back the current transaction after one of its
+// statements failed. The transaction is left open in an aborted state, in
+// which every statement other than COMMIT and ROLLBACK fails, so that the
+// client notices the failure.
+func (p *planner) abortTransaction() {
+	if p.txn.Proto().Status == proto.ABORTED {
+		return
+	}
+	if err := p.txn.Rollback(); err != nil {
+		log.Errorf("failure aborting transaction: %s", err)
+	}
+	txnProto := p.txn.Proto()
+	txnProto.Status = proto.ABORTED
+	// The writes of the transaction have been cleaned up.
+	txnProto.Writing = false
+	p.txn = client.NewTxn(*p.db, txnProto)
+}
+
+func setIsolationLevel(txn *client.Txn, level parser.IsolationLevel) error {
+	txnProto := txn.Proto()
+	if len(txnProto.ID) != 0 {
+		// The transaction has already performed operations.
+		return util.Errorf("SET TRANSACTION ISOLATION LEVEL must be called before any query")
+	}
+	switch level {
+	case parser.UnspecifiedIsolation:
+	case parser.SnapshotIsolation:
+		txn.SetSnapshotIsolation()
+	case parser.SerializableIsolation:
+		if txnProto.Isolation != proto.SERIALIZABLE {
+			return util.Errorf("unsupported change of isolation level from %s to %s",
+				txnProto.Isolation, level)
+		}
+	default:
+		return util.Errorf("unknown isolation level: %s", level)
+	}
+	return nil
+}
@@ -0,0 +1,281 @@
+// Copyright 2015 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License. See the AUTHORS file
+// for names of contributors.
+
+package sql
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/cockroachdb/cockroach/sql/parser"
+)
+
+// Union constructs a planNode from a UNION, INTERSECT or EXCEPT expression.
+// Both sides must produce the same number of columns and the values of
+// corresponding columns must be of the same type.
+// Privileges: READ on the tables of both sides.
+func (p *planner) Union(n *parser.Union) (planNode, error) {
+	switch n.Type {
+	case "UNION", "INTERSECT", "EXCEPT":
+	default:
+		return nil, fmt.Errorf("unknown set operation: %s", n.Type)
+	}
+
+	left, err := p.makePlan(n.Left)
+	if err != nil {
+		return nil, err
+	}
+	right, err := p.makePlan(n.Right)
+	if err != nil {
+		return nil, err
+	}
+	leftCols, rightCols := left.Columns(), right.Columns()
+	if len(leftCols) != len(rightCols) {
+		return nil, fmt.Errorf("each %s query must have the same number of columns: %d vs %d",
+			n.Type, len(leftCols), len(rightCols))
+	}
+	var plan planNode = &unionNode{
+		typ:        n.Type,
+		all:        n.All,
+		left:       left,
+		right:      right,
+		leftTypes:  make([]string, len(leftCols)),
+		rightTypes: make([]string, len(rightCols)),
+		counts:     map[string]int{},
+	}
+	sort, err := p.unionOrderBy(n, leftCols)
+	if err != nil {
+		return nil, err
+	}
+	if plan, err = sort.wrap(plan); err != nil {
+		return nil, err
+	}
+	return p.limit(n.Limit, plan)
+}
+
+// unionOrderBy returns the sortNode for the ORDER BY clause of a set
+// operation, or nil if there is none. The clause can only refer to the
+// result columns, by position or by name.
+func (p *planner) unionOrderBy(n *parser.Union, columns []string) (*sortNode, error) {
+	if len(n.OrderBy) == 0 {
+		return nil, nil
+	}
+	ordering := make([]columnOrderInfo, 0, len(n.OrderBy))
+	for _, o := range n.OrderBy {
+		index := -1
+		switch t := o.Expr.(type) {
+		case parser.IntVal:
+			if t < 1 || int(t) > len(columns) {
+				return nil, fmt.Errorf("invalid ORDER BY index: %d not in range [1, %d]",
+					t, len(columns))
+			}
+			index = int(t) - 1
+		case *parser.QualifiedName:
+			if len(t.Indirect) == 0 {
+				for i, col := range columns {
+					if col == string(t.Base) {
+						index = i
+						break
+					}
+				}
+			}
+		}
+		if index == -1 {
+			return nil, fmt.Errorf("invalid %s ORDER BY clause: %s is not a result column",
+				n.Type, o.Expr)
+		}
+		ordering = append(ordering, columnOrderInfo{colIdx: index, direction: o.Direction})
+	}
+	return &sortNode{columns: columns, ordering: ordering}, nil
+}
+
+// A unionNode combines the rows of two plans. UNION returns the rows of the
+// left plan followed by the rows of the right plan. INTERSECT and EXCEPT
+// first read all of the rows of the right plan and then return the rows of
+// the left plan which do (INTERSECT) or do not (EXCEPT) appear on the right.
+// Unless ALL is specified, duplicate rows are removed from the result.
+type unionNode struct {
+	typ         string
+	all         bool
+	left, right planNode
+	// The type of the first non-NULL value seen in each column of the left and
+	// right plans. The types of the two sides must match.
+	leftTypes, rightTypes []string
+	// For UNION, the rows that have already been returned. For INTERSECT and
+	// EXCEPT, the number of times each row occurs on the right side, adjusted
+	// as rows from the left side are returned.
+	counts      map[string]int
+	initialized bool
+	leftDone    bool
+	row         parser.DTuple
+	err         error
+}
+
+func (n *unionNode) ExplainPlan(_ bool) (name, description string, children []planNode) {
+	if n.all {
+		description = "ALL"
+	}
+	return strings.ToLower(n.typ), description, []planNode{n.left, n.right}
+}
+
+func (n *unionNode) Columns() []string {
+	return n.left.Columns()
+}
+
+func (n *unionNode) Values() parser.DTuple {
+	return n.row
+}
+
+func (n *unionNode) Next() bool {
+	if n.err != nil {
+		return false
+	}
+	if !n.initialized {
+		n.initialized = true
+		if n.typ != "UNION" && !n.readRight() {
+			return false
+		}
+	}
+	if n.typ == "UNION" {
+		return n.nextUnion()
+	}
+	return n.nextIntersectOrExcept()
+}
+
+func (n *unionNode) Err() error {
+	return n.err
+}
+
+// readRight counts the occurrences of each row of the right plan.
+func (n *unionNode) readRight() bool {
+	for n.right.Next() {
+		row := n.right.Values()
+		if n.err = n.checkTypes(row, n.rightTypes); n.err != nil {
+			return false
+		}
+		key, err := encodeDatumKey(nil, row)
+		if err != nil {
+			n.err = err
+			return false
+		}
+		n.counts[string(key)]++
+	}
+	n.err = n.right.Err()
+	return n.err == nil
+}
+
+func (n *unionNode) nextUnion() bool {
+	for {
+		src := n.right
+		if !n.leftDone {
+			src = n.left
+		}
+		if !src.Next() {
+			if n.err = src.Err(); n.err != nil {
+				return false
+			}
+			if src == n.left {
+				n.leftDone = true
+				continue
+			}
+			return false
+		}
+		n.row = src.Values()
+		types := n.leftTypes
+		if src == n.right {
+			types = n.rightTypes
+		}
+		if n.err = n.checkTypes(n.row, types); n.err != nil {
+			return false
+		}
+		if n.all {
+			return true
+		}
+		encoded, err := encodeDatumKey(nil, n.row)
+		if err != nil {
+			n.err = err
+			return false
+		}
+		key := string(encoded)
+		if n.counts[key] > 0 {
+			continue
+		}
+		n.counts[key] = 1
+		return true
+	}
+}
+
+func (n *unionNode) nextIntersectOrExcept() bool {
+	for n.left.Next() {
+		n.row = n.left.Values()
+		if n.err = n.checkTypes(n.row, n.leftTypes); n.err != nil {
+			return false
+		}
+		encoded, err := encodeDatumKey(nil, n.row)
+		if err != nil {
+			n.err = err
+			return false
+		}
+		key := string(encoded)
+		count := n.counts[key]
+		if n.typ == "INTERSECT" {
+			if count == 0 {
+				continue
+			}
+			// Each row on the right matches a single row on the left for
+			// INTERSECT ALL. Otherwise the row is only returned once.
+			if n.all {
+				n.counts[key]--
+			} else {
+				n.counts[key] = 0
+			}
+			return true
+		}
+		// EXCEPT.
+		if n.all {
+			// Each row on the right cancels out a single row on the left.
+			if count > 0 {
+				n.counts[key]--
+				continue
+			}
+			return true
+		}
+		if count > 0 {
+			// The row either appears on the right or has already been returned.
+			continue
+		}
+		n.counts[key] = 1
+		return true
+	}
+	n.err = n.left.Err()
+	return false
+}
+
+// checkTypes records the types of the values in row, a row from the side
+// whose column types are types, and verifies that they match the types seen
+// on the other side. NULL values match any type.
+// TODO: Check the types during planning once expressions are typed.
+func (n *unionNode) checkTypes(row parser.DTuple, types []string) error {
+	for i, d := range row {
+		if d == parser.DNull || types[i] != "" {
+			continue
+		}
+		types[i] = d.Type()
+		if l, r := n.leftTypes[i], n.rightTypes[i]; l != "" && r != "" && l != r {
+			return fmt.Errorf("%s types %s and %s cannot be matched", n.typ, l, r)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,61 @@
+// Copyright 2015 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License. See the AUTHORS file
+// for names of contributors.
+
+package sql_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/cockroachdb/cockroach/util/leaktest"
+)
+
+// TestUnionPlaceholders verifies that placeholders are filled in both
+// branches of a UNION as well as in its ORDER BY and LIMIT clauses.
+func TestUnionPlaceholders(t *testing.T) {
+	defer leaktest.AfterTest(t)
+	s, sqlDB, _ := setup(t)
+	defer cleanup(s, sqlDB)
+
+	if _, err := sqlDB.Exec(`
+CREATE DATABASE t;
+CREATE TABLE t.kv (k INT PRIMARY KEY, v INT);
+INSERT INTO t.kv VALUES (1, 10), (2, 20), (3, 30);
+`); err != nil {
+		t.Fatal(err)
+	}
+
+	rows, err := sqlDB.Query(`
+SELECT v FROM t.kv WHERE k = $1 UNION SELECT v FROM t.kv WHERE k > $2 ORDER BY 1 DESC LIMIT $3`,
+		1, 1, 2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer rows.Close()
+	var vals []int
+	for rows.Next() {
+		var v int
+		if err := rows.Scan(&v); err != nil {
+			t.Fatal(err)
+		}
+		vals = append(vals, v)
+	}
+	if err := rows.Err(); err != nil {
+		t.Fatal(err)
+	}
+	if expected := []int{30, 20}; !reflect.DeepEqual(expected, vals) {
+		t.Fatalf("expected %v, but found %v", expected, vals)
+	}
+}
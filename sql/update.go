@@ -22,7 +22,6 @@ import (
 	"fmt"
 
 	"github.com/cockroachdb/cockroach/client"
-	"github.com/cockroachdb/cockroach/proto"
 	"github.com/cockroachdb/cockroach/sql/parser"
 	"github.com/cockroachdb/cockroach/structured"
 	"github.com/cockroachdb/cockroach/util/log"
@@ -68,9 +67,7 @@ func (p *planner) Update(n *parser.Update) (planNode, error) {
 	// Query the rows that need updating.
 	// TODO(vivek): Avoid going through Select.
 	row, err := p.Select(&parser.Select{
-		Exprs: parser.SelectExprs{
-			&parser.StarExpr{TableName: &parser.QualifiedName{Base: parser.Name(tableDesc.Name)}},
-		},
+		Exprs: allColumnsSelectExprs(tableDesc),
 		From:  parser.TableExprs{n.Table},
 		Where: n.Where,
 	})
@@ -89,13 +86,10 @@ func (p *planner) Update(n *parser.Update) (planNode, error) {
 		colIDtoRowIndex[c.ID] = i
 	}
 
-	primaryIndex := tableDesc.PrimaryIndex
-	primaryIndexKeyPrefix := structured.MakeIndexKeyPrefix(tableDesc.ID, primaryIndex.ID)
-
 	// Evaluate all the column value expressions.
 	vals := make([]parser.Datum, 0, 10)
 	for _, expr := range n.Exprs {
-		val, err := parser.EvalExpr(expr.Expr, nil)
+		val, err := parser.EvalExpr(p.evalCtx, expr.Expr, nil)
 		if err != nil {
 			return nil, err
 		}
@@ -103,79 +97,134 @@ func (p *planner) Update(n *parser.Update) (planNode, error) {
 	}
 
 	// Secondary indexes needing updating.
-	var indexes []structured.IndexDescriptor
-	for _, index := range tableDesc.Indexes {
-		for _, id := range index.ColumnIDs {
-			if _, ok := colIDSet[id]; ok {
-				indexes = append(indexes, index)
-				break
-			}
-		}
+	indexes := indexesContaining(tableDesc, cols)
+
+	rh, err := p.makeReturningHelper(tableDesc, tableAlias(n.Table), n.Returning)
+	if err != nil {
+		return nil, err
 	}
+	checks, err := makeCheckHelper(tableDesc, p.evalCtx)
+	if err != nil {
+		return nil, err
+	}
+	fk := makeFKHelper(p.txn, p.evalCtx)
 
 	// Update all the rows.
 	b := client.Batch{}
 	for row.Next() {
-		if err := row.Err(); err != nil {
-			return nil, err
-		}
 		rowVals := row.Values()
-		primaryIndexKeySuffix, _, err := encodeIndexKey(primaryIndex.ColumnIDs, colIDtoRowIndex, rowVals, nil)
-		if err != nil {
+		if err := updateRow(&b, tableDesc, indexes, colIDtoRowIndex, rowVals, cols, vals, checks, fk); err != nil {
 			return nil, err
 		}
-		primaryIndexKey := bytes.Join([][]byte{primaryIndexKeyPrefix, primaryIndexKeySuffix}, nil)
-		// Compute the current secondary index key:value pairs for this row.
-		secondaryIndexEntries, err := encodeSecondaryIndexes(tableDesc.ID, indexes, colIDtoRowIndex, rowVals, primaryIndexKeySuffix)
-		if err != nil {
+		if err := fk.checkReferences(tableDesc, colIDtoRowIndex, rowVals, colIDSet); err != nil {
 			return nil, err
 		}
-		// Compute the new secondary index key:value pairs for this row.
-		//
-		// Update the row values.
-		for i, col := range cols {
-			rowVals[colIDtoRowIndex[col.ID]] = vals[i]
-		}
-		newSecondaryIndexEntries, err := encodeSecondaryIndexes(tableDesc.ID, indexes, colIDtoRowIndex, rowVals, primaryIndexKeySuffix)
-		if err != nil {
+		// The row values have been updated with the new values.
+		if err := rh.append(colIDtoRowIndex, rowVals); err != nil {
 			return nil, err
 		}
-		// Update secondary indexes
-		for i, newSecondaryIndexEntry := range newSecondaryIndexEntries {
-			secondaryIndexEntry := secondaryIndexEntries[i]
-			if !bytes.Equal(newSecondaryIndexEntry.key, secondaryIndexEntry.key) {
-				if log.V(2) {
-					log.Infof("CPut %q -> %v", newSecondaryIndexEntry.key, newSecondaryIndexEntry.value)
-				}
-				b.CPut(newSecondaryIndexEntry.key, newSecondaryIndexEntry.value, nil)
-				if log.V(2) {
-					log.Infof("Del %q", secondaryIndexEntry.key)
-				}
-				b.Del(secondaryIndexEntry.key)
-			}
+	}
+	if err := row.Err(); err != nil {
+		return nil, err
+	}
+
+	if err := p.txn.Run(&b); err != nil {
+		return nil, convertBatchError(err)
+	}
+	if err := fk.verifyPending(); err != nil {
+		return nil, err
+	}
+
+	// TODO(tamird/pmattis): return the number of affected rows.
+	return rh.getResults(), nil
+}
+
+// indexesContaining returns the secondary indexes of the table which contain
+// any of the columns.
+func indexesContaining(desc *structured.TableDescriptor, cols []structured.ColumnDescriptor) []structured.IndexDescriptor {
+	colIDSet := map[structured.ID]struct{}{}
+	for _, col := range cols {
+		colIDSet[col.ID] = struct{}{}
+	}
+	var indexes []structured.IndexDescriptor
+	for _, index := range desc.Indexes {
+		if containsAnyColumn(index.ColumnIDs, colIDSet) {
+			indexes = append(indexes, index)
 		}
+	}
+	return indexes
+}
 
-		// add the new values
-		for i, val := range vals {
-			key := structured.MakeColumnKey(cols[i].ID, primaryIndexKey)
+// updateRow adds the writes which set the columns cols of a row to vals to
+// the batch. rowVals holds the current values of the row, indexed by
+// colIDtoRowIndex, and is updated in place. indexes are the secondary indexes
+// containing any of the updated columns, the entries of which are rewritten.
+// The updated row is verified to satisfy the constraints of the table, other
+// than its foreign keys which are verified by the caller, and the actions of
+// the foreign keys referencing the row are taken.
+func updateRow(b *client.Batch, tableDesc *structured.TableDescriptor, indexes []structured.IndexDescriptor,
+	colIDtoRowIndex map[structured.ID]int, rowVals parser.DTuple,
+	cols []structured.ColumnDescriptor, vals parser.DTuple, checks *checkHelper, fk *fkHelper) error {
+	primaryIndex := tableDesc.PrimaryIndex
+	primaryIndexKeyPrefix := structured.MakeIndexKeyPrefix(tableDesc.ID, primaryIndex.ID)
+	primaryIndexKeySuffix, _, err := encodeIndexKey(primaryIndex.ColumnIDs, colIDtoRowIndex, rowVals, nil)
+	if err != nil {
+		return err
+	}
+	primaryIndexKey := bytes.Join([][]byte{primaryIndexKeyPrefix, primaryIndexKeySuffix}, nil)
+	// Compute the current secondary index key:value pairs for this row.
+	secondaryIndexEntries, err := encodeSecondaryIndexes(tableDesc.ID, indexes, colIDtoRowIndex, rowVals, primaryIndexKeySuffix)
+	if err != nil {
+		return err
+	}
+	// Compute the new secondary index key:value pairs for this row.
+	//
+	// Update the row values.
+	var oldVals parser.DTuple
+	if len(tableDesc.ReferencedBy) > 0 {
+		oldVals = append(oldVals, rowVals...)
+	}
+	for i, col := range cols {
+		rowVals[colIDtoRowIndex[col.ID]] = vals[i]
+	}
+	if err := checks.checkRow(colIDtoRowIndex, rowVals); err != nil {
+		return err
+	}
+	if oldVals != nil {
+		if err := fk.handleReferences(b, tableDesc, colIDtoRowIndex, oldVals, rowVals); err != nil {
+			return err
+		}
+	}
+	newSecondaryIndexEntries, err := encodeSecondaryIndexes(tableDesc.ID, indexes, colIDtoRowIndex, rowVals, primaryIndexKeySuffix)
+	if err != nil {
+		return err
+	}
+	// Update secondary indexes
+	for i, newSecondaryIndexEntry := range newSecondaryIndexEntries {
+		secondaryIndexEntry := secondaryIndexEntries[i]
+		if !bytes.Equal(newSecondaryIndexEntry.key, secondaryIndexEntry.key) {
 			if log.V(2) {
-				log.Infof("Put %q -> %v", key, val)
+				log.Infof("CPut %q -> %v", newSecondaryIndexEntry.key, newSecondaryIndexEntry.value)
 			}
-			v, err := prepareVal(cols[i], val)
-			if err != nil {
-				return nil, err
+			b.CPut(newSecondaryIndexEntry.key, newSecondaryIndexEntry.value, nil)
+			if log.V(2) {
+				log.Infof("Del %q", secondaryIndexEntry.key)
 			}
-			b.Put(key, v)
+			b.Del(secondaryIndexEntry.key)
 		}
 	}
 
-	if err := p.db.Run(&b); err != nil {
-		if tErr, ok := err.(*proto.ConditionFailedError); ok {
-			return nil, fmt.Errorf("duplicate key value %q violates unique constraint %s", tErr.ActualValue.Bytes, "TODO(tamird)")
+	// add the new values
+	for i, val := range vals {
+		key := structured.MakeColumnKey(cols[i].ID, primaryIndexKey)
+		if log.V(2) {
+			log.Infof("Put %q -> %v", key, val)
 		}
-		return nil, err
+		v, err := prepareVal(cols[i], val)
+		if err != nil {
+			return err
+		}
+		b.Put(key, v)
 	}
-
-	// TODO(tamird/pmattis): return the number of affected rows.
-	return &valuesNode{}, nil
+	return nil
 }
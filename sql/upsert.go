@@ -0,0 +1,306 @@
+// Copyright 2015 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License. See the AUTHORS file
+// for names of contributors.
+
+package sql
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/cockroachdb/cockroach/client"
+	"github.com/cockroachdb/cockroach/proto"
+	"github.com/cockroachdb/cockroach/sql/parser"
+	"github.com/cockroachdb/cockroach/structured"
+)
+
+// conflictAction describes how an INSERT resolves a conflict between an
+// inserted row and an existing row of the table.
+type conflictAction struct {
+	// The unique indexes in which a conflicting row is looked up.
+	arbiters  []structured.IndexDescriptor
+	doNothing bool
+	// The columns set by DO UPDATE, the expressions for their values and the
+	// secondary indexes containing any of them.
+	cols    []structured.ColumnDescriptor
+	exprs   []parser.Expr
+	indexes []structured.IndexDescriptor
+	where   parser.Expr
+}
+
+// makeConflictAction creates the conflictAction for the ON CONFLICT clause of
+// an INSERT or for an UPSERT. An UPSERT replaces the inserted columns of the
+// row with the same primary key, which is equivalent to:
+//
+//   INSERT ... ON CONFLICT (<primary key>) DO UPDATE SET c = excluded.c, ...
+func (p *planner) makeConflictAction(tableDesc *structured.TableDescriptor,
+	insertCols []structured.ColumnDescriptor, n *parser.Insert) (*conflictAction, error) {
+	var updateExprs parser.UpdateExprs
+	action := &conflictAction{}
+	if n.Upsert {
+		action.arbiters = []structured.IndexDescriptor{tableDesc.PrimaryIndex}
+		for _, col := range insertCols {
+			if indexContainsColumn(tableDesc.PrimaryIndex, col.ID) {
+				continue
+			}
+			updateExprs = append(updateExprs, &parser.UpdateExpr{
+				Name: &parser.QualifiedName{Base: parser.Name(col.Name)},
+				Expr: excludedName(col.Name),
+			})
+		}
+	} else {
+		var err error
+		if action.arbiters, err = conflictArbiters(tableDesc, n.OnConflict.Columns); err != nil {
+			return nil, err
+		}
+		action.doNothing = n.OnConflict.DoNothing
+		if !action.doNothing && len(n.OnConflict.Columns) == 0 {
+			return nil, fmt.Errorf("ON CONFLICT DO UPDATE requires the conflicting columns")
+		}
+		updateExprs = n.OnConflict.Exprs
+		if n.OnConflict.Where != nil {
+			action.where = n.OnConflict.Where.Expr
+		}
+	}
+
+	var names parser.QualifiedNames
+	for _, expr := range updateExprs {
+		names = append(names, expr.Name)
+		action.exprs = append(action.exprs, expr.Expr)
+	}
+	if len(names) == 0 {
+		return action, nil
+	}
+	var err error
+	if action.cols, err = p.processColumns(tableDesc, names); err != nil {
+		return nil, err
+	}
+
+	// Don't allow updating any column that is part of the primary key.
+	colIDSet := map[structured.ID]struct{}{}
+	for _, col := range action.cols {
+		if indexContainsColumn(tableDesc.PrimaryIndex, col.ID) {
+			return nil, fmt.Errorf("primary key column %q cannot be updated", col.Name)
+		}
+		colIDSet[col.ID] = struct{}{}
+	}
+	for _, index := range tableDesc.Indexes {
+		for _, id := range index.ColumnIDs {
+			if _, ok := colIDSet[id]; ok {
+				action.indexes = append(action.indexes, index)
+				break
+			}
+		}
+	}
+	return action, nil
+}
+
+// conflictArbiters returns the unique indexes matching the columns of an ON
+// CONFLICT clause, or all of the unique indexes if no columns are specified.
+func conflictArbiters(tableDesc *structured.TableDescriptor, columns parser.NameList) ([]structured.IndexDescriptor, error) {
+	var arbiters []structured.IndexDescriptor
+	for _, index := range append([]structured.IndexDescriptor{tableDesc.PrimaryIndex}, tableDesc.Indexes...) {
+		if !index.Unique || index.WriteOnly {
+			continue
+		}
+		if len(columns) == 0 {
+			arbiters = append(arbiters, index)
+			continue
+		}
+		if sameColumnNames(index.ColumnNames, columns) {
+			return []structured.IndexDescriptor{index}, nil
+		}
+	}
+	if len(arbiters) == 0 {
+		return nil, fmt.Errorf("there is no unique index matching the ON CONFLICT columns (%s)", columns)
+	}
+	return arbiters, nil
+}
+
+// sameColumnNames returns true if the lists contain the same set of names.
+func sameColumnNames(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	set := make(map[string]struct{}, len(a))
+	for _, name := range a {
+		set[name] = struct{}{}
+	}
+	for _, name := range b {
+		if _, ok := set[name]; !ok {
+			return false
+		}
+	}
+	return true
+}
+
+func indexContainsColumn(index structured.IndexDescriptor, colID structured.ID) bool {
+	for _, id := range index.ColumnIDs {
+		if id == colID {
+			return true
+		}
+	}
+	return false
+}
+
+// excludedName returns the name which refers to the value of the column of
+// the row proposed for insertion in the expressions of ON CONFLICT DO UPDATE.
+func excludedName(col string) *parser.QualifiedName {
+	return &parser.QualifiedName{
+		Base:     "excluded",
+		Indirect: parser.Indirection{parser.NameIndirection(col)},
+	}
+}
+
+// insertOnConflict inserts a row unless it conflicts with an existing row of
+// the table, in which case the conflict is resolved by the action. The writes
+// are run before returning so that the conflicts with the following rows of
+// the statement are detected. The inserted or updated row, if any, is added to
+// rh, which may be nil.
+func (p *planner) insertOnConflict(b *client.Batch, ih *insertHelper, values parser.DTuple,
+	action *conflictAction, rh *returningHelper) error {
+	tableDesc, cols, colIDtoRowIndex := ih.desc, ih.cols, ih.colIDtoRowIndex
+	existing, err := p.findConflictingRow(tableDesc, colIDtoRowIndex, values, action.arbiters)
+	if err != nil {
+		return err
+	}
+
+	switch {
+	case existing == nil:
+		if err := ih.insertRow(b, values); err != nil {
+			return err
+		}
+		if err := rh.append(colIDtoRowIndex, values); err != nil {
+			return err
+		}
+	case action.doNothing:
+		return nil
+	default:
+		// The expressions can refer to the columns of the existing row and, as
+		// excluded.<column>, to the values proposed for insertion.
+		env := valMap{}
+		for i, col := range tableDesc.Columns {
+			env[col.Name] = existing[i]
+		}
+		for _, col := range tableDesc.Columns {
+			env[excludedName(col.Name).String()] = parser.DNull
+		}
+		for i, col := range cols {
+			env[excludedName(col.Name).String()] = values[i]
+		}
+		if action.where != nil {
+			d, err := parser.EvalExpr(p.evalCtx, action.where, env)
+			if err != nil {
+				return err
+			}
+			if v, ok := d.(parser.DBool); !ok || !bool(v) {
+				return nil
+			}
+		}
+		vals := make(parser.DTuple, len(action.exprs))
+		for i, expr := range action.exprs {
+			if vals[i], err = parser.EvalExpr(p.evalCtx, expr, env); err != nil {
+				return err
+			}
+		}
+		existingColIDtoRowIndex := makeColIDtoRowIndex(tableDesc)
+		if err := updateRow(b, tableDesc, action.indexes, existingColIDtoRowIndex,
+			existing, action.cols, vals, ih.checks, ih.fk); err != nil {
+			return err
+		}
+		changed := map[structured.ID]struct{}{}
+		for _, col := range action.cols {
+			changed[col.ID] = struct{}{}
+		}
+		if err := ih.fk.checkReferences(tableDesc, existingColIDtoRowIndex, existing, changed); err != nil {
+			return err
+		}
+		// The existing row has been updated with the new values.
+		if err := rh.append(existingColIDtoRowIndex, existing); err != nil {
+			return err
+		}
+	}
+
+	err = p.txn.Run(b)
+	*b = client.Batch{}
+	return err
+}
+
+// findConflictingRow returns the values of the existing row, in the order of
+// tableDesc.Columns, which has the same key as the inserted row in one of the
+// arbiter indexes. Returns nil if there is no such row. Rows with a NULL
+// value in a unique index never conflict.
+func (p *planner) findConflictingRow(tableDesc *structured.TableDescriptor, colIDtoRowIndex map[structured.ID]int,
+	values parser.DTuple, arbiters []structured.IndexDescriptor) (parser.DTuple, error) {
+	primaryIndexKeyPrefix := structured.MakeIndexKeyPrefix(tableDesc.ID, tableDesc.PrimaryIndex.ID)
+	for _, index := range arbiters {
+		var primaryIndexKeySuffix []byte
+		if index.ID == tableDesc.PrimaryIndex.ID {
+			var err error
+			primaryIndexKeySuffix, _, err = encodeIndexKey(index.ColumnIDs, colIDtoRowIndex, values, nil)
+			if err != nil {
+				return nil, err
+			}
+		} else {
+			indexKey, containsNull, err := encodeIndexKey(index.ColumnIDs, colIDtoRowIndex, values,
+				structured.MakeIndexKeyPrefix(tableDesc.ID, index.ID))
+			if err != nil {
+				return nil, err
+			}
+			if containsNull {
+				continue
+			}
+			gr, err := p.txn.Get(indexKey)
+			if err != nil {
+				return nil, err
+			}
+			if !gr.Exists() {
+				continue
+			}
+			primaryIndexKeySuffix = gr.ValueBytes()
+		}
+
+		primaryIndexKey := bytes.Join([][]byte{primaryIndexKeyPrefix, primaryIndexKeySuffix}, nil)
+		existing, err := fetchRow(p.txn, tableDesc, primaryIndexKey)
+		if err != nil {
+			return nil, err
+		}
+		if existing != nil {
+			return existing, nil
+		}
+	}
+	return nil, nil
+}
+
+// fetchRow retrieves the values of the row with the primary index key, in the
+// order of desc.Columns. Returns nil if the row does not exist.
+func fetchRow(txn *client.Txn, desc *structured.TableDescriptor, primaryIndexKey proto.Key) (parser.DTuple, error) {
+	scan := &scanNode{
+		db:    txn,
+		desc:  desc,
+		index: &desc.PrimaryIndex,
+		spans: []span{{start: primaryIndexKey, end: primaryIndexKey.PrefixEnd()}},
+	}
+	if !scan.Next() {
+		return nil, scan.Err()
+	}
+	values := make(parser.DTuple, len(desc.Columns))
+	for i, col := range desc.Columns {
+		values[i] = parser.DNull
+		if d, ok := scan.vals[col.Name]; ok {
+			values[i] = d
+		}
+	}
+	return values, nil
+}
@@ -29,7 +29,7 @@ func (p *planner) Values(n parser.Values) (planNode, error) {
 		rows: make([]parser.DTuple, 0, len(n)),
 	}
 	for _, tuple := range n {
-		data, err := parser.EvalExpr(tuple, nil)
+		data, err := parser.EvalExpr(p.evalCtx, tuple, nil)
 		if err != nil {
 			return nil, err
 		}
@@ -67,3 +67,7 @@ func (n *valuesNode) Next() bool {
 func (*valuesNode) Err() error {
 	return nil
 }
+
+func (n *valuesNode) ExplainPlan(_ bool) (name, description string, children []planNode) {
+	return "values", fmt.Sprintf("%d rows", len(n.rows)), nil
+}
@@ -68,7 +68,7 @@ func TestValues(t *testing.T) {
 		},
 		{
 			parser.Values{{parser.BytesVal(vStr)}},
-			asRow(parser.DString(vStr)),
+			asRow(parser.DBytes(vStr)),
 			true,
 		},
 		{
@@ -43,6 +43,16 @@ func MakeDescMetadataKey(descID ID) proto.Key {
 	return k
 }
 
+// MakeSerialKey returns the key of the sequence from which the values of the
+// SERIAL column are allocated.
+func MakeSerialKey(tableID, colID ID) proto.Key {
+	k := make([]byte, 0, len(keys.SerialPrefix)+2*encoding.MaxUvarintSize)
+	k = append(k, keys.SerialPrefix...)
+	k = encoding.EncodeUvarint(k, uint64(tableID))
+	k = encoding.EncodeUvarint(k, uint64(colID))
+	return k
+}
+
 // MakeColumnKey returns the key for the column in the given row.
 func MakeColumnKey(colID ID, primaryKey []byte) proto.Key {
 	var key []byte
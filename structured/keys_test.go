@@ -34,6 +34,7 @@ func TestKeyAddress(t *testing.T) {
 		{structured.MakeNameMetadataKey(0, "foo"), proto.Key("\x00name-\bfoo")},
 		{structured.MakeNameMetadataKey(0, "BAR"), proto.Key("\x00name-\bbar")},
 		{structured.MakeDescMetadataKey(123), proto.Key("\x00desc-\t{")},
+		{structured.MakeSerialKey(123, 2), proto.Key("\x00serial-\t{\t\x02")},
 	}
 	for i, test := range testCases {
 		result := keys.KeyAddress(test.key)
@@ -211,9 +211,59 @@ func (desc *TableDescriptor) Validate() error {
 			}
 		}
 	}
+
+	checkNames := map[string]struct{}{}
+	for _, check := range desc.Checks {
+		if err := validateName(check.Name, "check constraint"); err != nil {
+			return err
+		}
+		if _, ok := checkNames[check.Name]; ok {
+			return fmt.Errorf("duplicate check constraint name: \"%s\"", check.Name)
+		}
+		checkNames[check.Name] = struct{}{}
+		if check.Expr == "" {
+			return fmt.Errorf("check constraint \"%s\" has an empty expression", check.Name)
+		}
+	}
+
+	fkNames := map[string]struct{}{}
+	for _, fk := range desc.ForeignKeys {
+		if err := validateName(fk.Name, "foreign key"); err != nil {
+			return err
+		}
+		if _, ok := fkNames[fk.Name]; ok {
+			return fmt.Errorf("duplicate foreign key name: \"%s\"", fk.Name)
+		}
+		fkNames[fk.Name] = struct{}{}
+		if fk.Table == 0 || fk.Index == 0 {
+			return fmt.Errorf("foreign key \"%s\" has no referenced index", fk.Name)
+		}
+		if len(fk.ColumnIDs) == 0 {
+			return fmt.Errorf("foreign key \"%s\" must contain at least 1 column", fk.Name)
+		}
+		for _, id := range fk.ColumnIDs {
+			if _, ok := columnIDs[id]; !ok {
+				return fmt.Errorf("foreign key \"%s\" contains unknown column ID %d", fk.Name, id)
+			}
+		}
+	}
 	return nil
 }
 
+// FindIndexByID finds the index with specified ID, which is either the
+// primary index or a secondary index.
+func (desc *TableDescriptor) FindIndexByID(id ID) (*IndexDescriptor, error) {
+	if desc.PrimaryIndex.ID == id {
+		return &desc.PrimaryIndex, nil
+	}
+	for i, index := range desc.Indexes {
+		if index.ID == id {
+			return &desc.Indexes[i], nil
+		}
+	}
+	return nil, fmt.Errorf("index-id \"%d\" does not exist", id)
+}
+
 // FindColumnByName finds the column with specified name.
 func (desc *TableDescriptor) FindColumnByName(name string) (*ColumnDescriptor, error) {
 	for i, c := range desc.Columns {
@@ -234,6 +284,17 @@ func (desc *TableDescriptor) FindColumnByID(id ID) (*ColumnDescriptor, error) {
 	return nil, fmt.Errorf("column-id \"%d\" does not exist", id)
 }
 
+// VisibleColumns returns the columns of the table which are not hidden.
+func (desc *TableDescriptor) VisibleColumns() []ColumnDescriptor {
+	var cols []ColumnDescriptor
+	for _, col := range desc.Columns {
+		if !col.Hidden {
+			cols = append(cols, col)
+		}
+	}
+	return cols
+}
+
 // SQLString returns the SQL string corresponding to the type.
 func (c *ColumnType) SQLString() string {
 	switch c.Kind {
@@ -13,6 +13,8 @@
 		ColumnDescriptor
 		IndexDescriptor
 		PrivilegeDescriptor
+		CheckConstraint
+		ForeignKeyReference
 		TableDescriptor
 		DatabaseDescriptor
 */
@@ -45,6 +47,7 @@ const (
 	ColumnType_CHAR      ColumnType_Kind = 8
 	ColumnType_TEXT      ColumnType_Kind = 9
 	ColumnType_BLOB      ColumnType_Kind = 10
+	ColumnType_INTERVAL  ColumnType_Kind = 11
 )
 
 var ColumnType_Kind_name = map[int32]string{
@@ -58,6 +61,7 @@ var ColumnType_Kind_name = map[int32]string{
 	8:  "CHAR",
 	9:  "TEXT",
 	10: "BLOB",
+	11: "INTERVAL",
 }
 var ColumnType_Kind_value = map[string]int32{
 	"BIT":       0,
@@ -70,6 +74,7 @@ var ColumnType_Kind_value = map[string]int32{
 	"CHAR":      8,
 	"TEXT":      9,
 	"BLOB":      10,
+	"INTERVAL":  11,
 }
 
 func (x ColumnType_Kind) Enum() *ColumnType_Kind {
@@ -89,6 +94,50 @@ func (x *ColumnType_Kind) UnmarshalJSON(data []byte) error {
 	return nil
 }
 
+// The action taken for the referencing rows when the referenced row is
+// deleted or its referenced columns are updated.
+type ForeignKeyReference_Action int32
+
+const (
+	ForeignKeyReference_NO_ACTION   ForeignKeyReference_Action = 0
+	ForeignKeyReference_RESTRICT    ForeignKeyReference_Action = 1
+	ForeignKeyReference_CASCADE     ForeignKeyReference_Action = 2
+	ForeignKeyReference_SET_NULL    ForeignKeyReference_Action = 3
+	ForeignKeyReference_SET_DEFAULT ForeignKeyReference_Action = 4
+)
+
+var ForeignKeyReference_Action_name = map[int32]string{
+	0: "NO_ACTION",
+	1: "RESTRICT",
+	2: "CASCADE",
+	3: "SET_NULL",
+	4: "SET_DEFAULT",
+}
+var ForeignKeyReference_Action_value = map[string]int32{
+	"NO_ACTION":   0,
+	"RESTRICT":    1,
+	"CASCADE":     2,
+	"SET_NULL":    3,
+	"SET_DEFAULT": 4,
+}
+
+func (x ForeignKeyReference_Action) Enum() *ForeignKeyReference_Action {
+	p := new(ForeignKeyReference_Action)
+	*p = x
+	return p
+}
+func (x ForeignKeyReference_Action) String() string {
+	return proto.EnumName(ForeignKeyReference_Action_name, int32(x))
+}
+func (x *ForeignKeyReference_Action) UnmarshalJSON(data []byte) error {
+	value, err := proto.UnmarshalJSONEnum(ForeignKeyReference_Action_value, data, "ForeignKeyReference_Action")
+	if err != nil {
+		return err
+	}
+	*x = ForeignKeyReference_Action(value)
+	return nil
+}
+
 type ColumnType struct {
 	Kind ColumnType_Kind `protobuf:"varint,1,opt,name=kind,enum=cockroach.structured.ColumnType_Kind" json:"kind"`
 	// BIT, INT, FLOAT, DECIMAL, CHAR and BINARY
@@ -124,11 +173,21 @@ func (m *ColumnType) GetPrecision() int32 {
 }
 
 type ColumnDescriptor struct {
-	Name             string     `protobuf:"bytes,1,opt,name=name" json:"name"`
-	ID               ID         `protobuf:"varint,2,opt,name=id,casttype=ID" json:"id"`
-	Type             ColumnType `protobuf:"bytes,3,opt,name=type" json:"type"`
-	Nullable         bool       `protobuf:"varint,4,opt,name=nullable" json:"nullable"`
-	XXX_unrecognized []byte     `json:"-"`
+	Name     string     `protobuf:"bytes,1,opt,name=name" json:"name"`
+	ID       ID         `protobuf:"varint,2,opt,name=id,casttype=ID" json:"id"`
+	Type     ColumnType `protobuf:"bytes,3,opt,name=type" json:"type"`
+	Nullable bool       `protobuf:"varint,4,opt,name=nullable" json:"nullable"`
+	// default_expr is the text of the expression which is evaluated for the
+	// value of the column when an INSERT does not specify it.
+	DefaultExpr *string `protobuf:"bytes,5,opt,name=default_expr" json:"default_expr,omitempty"`
+	// hidden columns, such as the rowid column of a table without an explicit
+	// primary key, are not returned by SELECT * and are not inserted into by
+	// an INSERT without a column list.
+	Hidden bool `protobuf:"varint,6,opt,name=hidden" json:"hidden"`
+	// serial columns are assigned the next value of their sequence when an
+	// INSERT does not specify them.
+	Serial           bool   `protobuf:"varint,7,opt,name=serial" json:"serial"`
+	XXX_unrecognized []byte `json:"-"`
 }
 
 func (m *ColumnDescriptor) Reset()         { *m = ColumnDescriptor{} }
@@ -156,6 +215,27 @@ func (m *ColumnDescriptor) GetNullable() bool {
 	return false
 }
 
+func (m *ColumnDescriptor) GetDefaultExpr() string {
+	if m != nil && m.DefaultExpr != nil {
+		return *m.DefaultExpr
+	}
+	return ""
+}
+
+func (m *ColumnDescriptor) GetHidden() bool {
+	if m != nil {
+		return m.Hidden
+	}
+	return false
+}
+
+func (m *ColumnDescriptor) GetSerial() bool {
+	if m != nil {
+		return m.Serial
+	}
+	return false
+}
+
 type IndexDescriptor struct {
 	Name   string `protobuf:"bytes,1,opt,name=name" json:"name"`
 	ID     ID     `protobuf:"varint,2,opt,name=id,casttype=ID" json:"id"`
@@ -167,7 +247,11 @@ type IndexDescriptor struct {
 	ColumnNames []string `protobuf:"bytes,4,rep,name=column_names" json:"column_names,omitempty"`
 	// An ordered list of column ids of which the index is comprised. This list
 	// parallels the column_names list.
-	ColumnIDs        []ID   `protobuf:"varint,5,rep,name=column_ids,casttype=ID" json:"column_ids,omitempty"`
+	ColumnIDs []ID `protobuf:"varint,5,rep,name=column_ids,casttype=ID" json:"column_ids,omitempty"`
+	// A write-only index is maintained by writes to the table but is not used
+	// by reads. An index is write-only while its entries for the existing rows
+	// of the table are backfilled.
+	WriteOnly        bool   `protobuf:"varint,6,opt,name=write_only" json:"write_only"`
 	XXX_unrecognized []byte `json:"-"`
 }
 
@@ -196,6 +280,13 @@ func (m *IndexDescriptor) GetColumnNames() []string {
 	return nil
 }
 
+func (m *IndexDescriptor) GetWriteOnly() bool {
+	if m != nil {
+		return m.WriteOnly
+	}
+	return false
+}
+
 // PrivilegeDescriptor represents the sets of privileges on a descriptor.
 type PrivilegeDescriptor struct {
 	// lists of users with read permissions.
@@ -223,6 +314,72 @@ func (m *PrivilegeDescriptor) GetWrite() []string {
 	return nil
 }
 
+// A CheckConstraint is a boolean expression over the columns of a row. A row
+// for which it evaluates to false cannot be written to the table.
+type CheckConstraint struct {
+	Name string `protobuf:"bytes,1,opt,name=name" json:"name"`
+	// expr is the text of the expression.
+	Expr             string `protobuf:"bytes,2,opt,name=expr" json:"expr"`
+	XXX_unrecognized []byte `json:"-"`
+}
+
+func (m *CheckConstraint) Reset()         { *m = CheckConstraint{} }
+func (m *CheckConstraint) String() string { return proto.CompactTextString(m) }
+func (*CheckConstraint) ProtoMessage()    {}
+
+func (m *CheckConstraint) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *CheckConstraint) GetExpr() string {
+	if m != nil {
+		return m.Expr
+	}
+	return ""
+}
+
+// A ForeignKeyReference constrains the values of columns of a table to the
+// values of the columns of a unique index of the referenced table.
+type ForeignKeyReference struct {
+	Name string `protobuf:"bytes,1,opt,name=name" json:"name"`
+	// column_ids are the referencing columns, in the order of the columns of
+	// the referenced index.
+	ColumnIDs        []ID                       `protobuf:"varint,2,rep,name=column_ids,casttype=ID" json:"column_ids,omitempty"`
+	Table            ID                         `protobuf:"varint,3,opt,name=table,casttype=ID" json:"table"`
+	Index            ID                         `protobuf:"varint,4,opt,name=index,casttype=ID" json:"index"`
+	OnDelete         ForeignKeyReference_Action `protobuf:"varint,5,opt,name=on_delete,enum=cockroach.structured.ForeignKeyReference_Action" json:"on_delete"`
+	OnUpdate         ForeignKeyReference_Action `protobuf:"varint,6,opt,name=on_update,enum=cockroach.structured.ForeignKeyReference_Action" json:"on_update"`
+	XXX_unrecognized []byte                     `json:"-"`
+}
+
+func (m *ForeignKeyReference) Reset()         { *m = ForeignKeyReference{} }
+func (m *ForeignKeyReference) String() string { return proto.CompactTextString(m) }
+func (*ForeignKeyReference) ProtoMessage()    {}
+
+func (m *ForeignKeyReference) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *ForeignKeyReference) GetOnDelete() ForeignKeyReference_Action {
+	if m != nil {
+		return m.OnDelete
+	}
+	return ForeignKeyReference_NO_ACTION
+}
+
+func (m *ForeignKeyReference) GetOnUpdate() ForeignKeyReference_Action {
+	if m != nil {
+		return m.OnUpdate
+	}
+	return ForeignKeyReference_NO_ACTION
+}
+
 // A TableDescriptor represents a table and is stored in a structured metadata
 // key. The TableDescriptor has a globally-unique ID, while its member
 // {Column,Index}Descriptors have locally-unique IDs.
@@ -238,7 +395,12 @@ type TableDescriptor struct {
 	// next_index_id is used to ensure that deleted index ids are not reused.
 	NextIndexID         ID `protobuf:"varint,7,opt,name=next_index_id,casttype=ID" json:"next_index_id"`
 	PrivilegeDescriptor `protobuf:"bytes,8,opt,name=privileges,embedded=privileges" json:"privileges"`
-	XXX_unrecognized    []byte `json:"-"`
+	Checks              []CheckConstraint     `protobuf:"bytes,9,rep,name=checks" json:"checks"`
+	ForeignKeys         []ForeignKeyReference `protobuf:"bytes,10,rep,name=foreign_keys" json:"foreign_keys"`
+	// referenced_by are the IDs of the tables with foreign keys referencing
+	// this table.
+	ReferencedBy     []ID   `protobuf:"varint,11,rep,name=referenced_by,casttype=ID" json:"referenced_by,omitempty"`
+	XXX_unrecognized []byte `json:"-"`
 }
 
 func (m *TableDescriptor) Reset()         { *m = TableDescriptor{} }
@@ -273,6 +435,20 @@ func (m *TableDescriptor) GetIndexes() []IndexDescriptor {
 	return nil
 }
 
+func (m *TableDescriptor) GetChecks() []CheckConstraint {
+	if m != nil {
+		return m.Checks
+	}
+	return nil
+}
+
+func (m *TableDescriptor) GetForeignKeys() []ForeignKeyReference {
+	if m != nil {
+		return m.ForeignKeys
+	}
+	return nil
+}
+
 // DatabaseDescriptor represents a namespace (aka database) and is stored
 // in a structured metadata key. The DatabaseDescriptor has a globally-unique
 // ID shared with the TableDescriptor ID.
@@ -297,6 +473,7 @@ func (m *DatabaseDescriptor) GetName() string {
 
 func init() {
 	proto.RegisterEnum("cockroach.structured.ColumnType_Kind", ColumnType_Kind_name, ColumnType_Kind_value)
+	proto.RegisterEnum("cockroach.structured.ForeignKeyReference_Action", ForeignKeyReference_Action_name, ForeignKeyReference_Action_value)
 }
 func (m *ColumnType) Unmarshal(data []byte) error {
 	l := len(data)
@@ -493,6 +670,63 @@ func (m *ColumnDescriptor) Unmarshal(data []byte) error {
 				}
 			}
 			m.Nullable = bool(v != 0)
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DefaultExpr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			postIndex := iNdEx + int(stringLen)
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			s := string(data[iNdEx:postIndex])
+			m.DefaultExpr = &s
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Hidden", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Hidden = bool(v != 0)
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Serial", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Serial = bool(v != 0)
 		default:
 			var sizeOfWire int
 			for {
@@ -633,6 +867,23 @@ func (m *IndexDescriptor) Unmarshal(data []byte) error {
 				}
 			}
 			m.ColumnIDs = append(m.ColumnIDs, v)
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WriteOnly", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.WriteOnly = bool(v != 0)
 		default:
 			var sizeOfWire int
 			for {
@@ -683,46 +934,285 @@ func (m *PrivilegeDescriptor) Unmarshal(data []byte) error {
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Read", wireType)
 			}
-			var stringLen uint64
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			postIndex := iNdEx + int(stringLen)
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Read = append(m.Read, string(data[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Write", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			postIndex := iNdEx + int(stringLen)
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Write = append(m.Write, string(data[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			var sizeOfWire int
+			for {
+				sizeOfWire++
+				wire >>= 7
+				if wire == 0 {
+					break
+				}
+			}
+			iNdEx -= sizeOfWire
+			skippy, err := skipStructured(data[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthStructured
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, data[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	return nil
+}
+func (m *CheckConstraint) Unmarshal(data []byte) error {
+	l := len(data)
+	iNdEx := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := data[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			postIndex := iNdEx + int(stringLen)
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(data[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Expr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			postIndex := iNdEx + int(stringLen)
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Expr = string(data[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			var sizeOfWire int
+			for {
+				sizeOfWire++
+				wire >>= 7
+				if wire == 0 {
+					break
+				}
+			}
+			iNdEx -= sizeOfWire
+			skippy, err := skipStructured(data[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthStructured
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, data[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	return nil
+}
+func (m *ForeignKeyReference) Unmarshal(data []byte) error {
+	l := len(data)
+	iNdEx := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := data[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			postIndex := iNdEx + int(stringLen)
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(data[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ColumnIDs", wireType)
+			}
+			var v ID
+			for shift := uint(0); ; shift += 7 {
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				v |= (ID(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.ColumnIDs = append(m.ColumnIDs, v)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Table", wireType)
+			}
+			m.Table = 0
 			for shift := uint(0); ; shift += 7 {
 				if iNdEx >= l {
 					return io.ErrUnexpectedEOF
 				}
 				b := data[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				m.Table |= (ID(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			postIndex := iNdEx + int(stringLen)
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
 			}
-			m.Read = append(m.Read, string(data[iNdEx:postIndex]))
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Write", wireType)
+			m.Index = 0
+			for shift := uint(0); ; shift += 7 {
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				m.Index |= (ID(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			var stringLen uint64
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OnDelete", wireType)
+			}
+			m.OnDelete = 0
 			for shift := uint(0); ; shift += 7 {
 				if iNdEx >= l {
 					return io.ErrUnexpectedEOF
 				}
 				b := data[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				m.OnDelete |= (ForeignKeyReference_Action(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			postIndex := iNdEx + int(stringLen)
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OnUpdate", wireType)
+			}
+			m.OnUpdate = 0
+			for shift := uint(0); ; shift += 7 {
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				m.OnUpdate |= (ForeignKeyReference_Action(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			m.Write = append(m.Write, string(data[iNdEx:postIndex]))
-			iNdEx = postIndex
 		default:
 			var sizeOfWire int
 			for {
@@ -949,6 +1439,79 @@ func (m *TableDescriptor) Unmarshal(data []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Checks", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			postIndex := iNdEx + msglen
+			if msglen < 0 {
+				return ErrInvalidLengthStructured
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Checks = append(m.Checks, CheckConstraint{})
+			if err := m.Checks[len(m.Checks)-1].Unmarshal(data[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ForeignKeys", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			postIndex := iNdEx + msglen
+			if msglen < 0 {
+				return ErrInvalidLengthStructured
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ForeignKeys = append(m.ForeignKeys, ForeignKeyReference{})
+			if err := m.ForeignKeys[len(m.ForeignKeys)-1].Unmarshal(data[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReferencedBy", wireType)
+			}
+			var v ID
+			for shift := uint(0); ; shift += 7 {
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[iNdEx]
+				iNdEx++
+				v |= (ID(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.ReferencedBy = append(m.ReferencedBy, v)
 		default:
 			var sizeOfWire int
 			for {
@@ -1200,6 +1763,12 @@ func (m *ColumnDescriptor) Size() (n int) {
 	l = m.Type.Size()
 	n += 1 + l + sovStructured(uint64(l))
 	n += 2
+	if m.DefaultExpr != nil {
+		l = len(*m.DefaultExpr)
+		n += 1 + l + sovStructured(uint64(l))
+	}
+	n += 2
+	n += 2
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -1224,6 +1793,7 @@ func (m *IndexDescriptor) Size() (n int) {
 			n += 1 + sovStructured(uint64(e))
 		}
 	}
+	n += 2
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -1251,6 +1821,39 @@ func (m *PrivilegeDescriptor) Size() (n int) {
 	return n
 }
 
+func (m *CheckConstraint) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Name)
+	n += 1 + l + sovStructured(uint64(l))
+	l = len(m.Expr)
+	n += 1 + l + sovStructured(uint64(l))
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ForeignKeyReference) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Name)
+	n += 1 + l + sovStructured(uint64(l))
+	if len(m.ColumnIDs) > 0 {
+		for _, e := range m.ColumnIDs {
+			n += 1 + sovStructured(uint64(e))
+		}
+	}
+	n += 1 + sovStructured(uint64(m.Table))
+	n += 1 + sovStructured(uint64(m.Index))
+	n += 1 + sovStructured(uint64(m.OnDelete))
+	n += 1 + sovStructured(uint64(m.OnUpdate))
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
 func (m *TableDescriptor) Size() (n int) {
 	var l int
 	_ = l
@@ -1275,6 +1878,23 @@ func (m *TableDescriptor) Size() (n int) {
 	n += 1 + sovStructured(uint64(m.NextIndexID))
 	l = m.PrivilegeDescriptor.Size()
 	n += 1 + l + sovStructured(uint64(l))
+	if len(m.Checks) > 0 {
+		for _, e := range m.Checks {
+			l = e.Size()
+			n += 1 + l + sovStructured(uint64(l))
+		}
+	}
+	if len(m.ForeignKeys) > 0 {
+		for _, e := range m.ForeignKeys {
+			l = e.Size()
+			n += 1 + l + sovStructured(uint64(l))
+		}
+	}
+	if len(m.ReferencedBy) > 0 {
+		for _, e := range m.ReferencedBy {
+			n += 1 + sovStructured(uint64(e))
+		}
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -1376,6 +1996,28 @@ func (m *ColumnDescriptor) MarshalTo(data []byte) (n int, err error) {
 		data[i] = 0
 	}
 	i++
+	if m.DefaultExpr != nil {
+		data[i] = 0x2a
+		i++
+		i = encodeVarintStructured(data, i, uint64(len(*m.DefaultExpr)))
+		i += copy(data[i:], *m.DefaultExpr)
+	}
+	data[i] = 0x30
+	i++
+	if m.Hidden {
+		data[i] = 1
+	} else {
+		data[i] = 0
+	}
+	i++
+	data[i] = 0x38
+	i++
+	if m.Serial {
+		data[i] = 1
+	} else {
+		data[i] = 0
+	}
+	i++
 	if m.XXX_unrecognized != nil {
 		i += copy(data[i:], m.XXX_unrecognized)
 	}
@@ -1434,6 +2076,14 @@ func (m *IndexDescriptor) MarshalTo(data []byte) (n int, err error) {
 			i = encodeVarintStructured(data, i, uint64(num))
 		}
 	}
+	data[i] = 0x30
+	i++
+	if m.WriteOnly {
+		data[i] = 1
+	} else {
+		data[i] = 0
+	}
+	i++
 	if m.XXX_unrecognized != nil {
 		i += copy(data[i:], m.XXX_unrecognized)
 	}
@@ -1491,6 +2141,79 @@ func (m *PrivilegeDescriptor) MarshalTo(data []byte) (n int, err error) {
 	return i, nil
 }
 
+func (m *CheckConstraint) Marshal() (data []byte, err error) {
+	size := m.Size()
+	data = make([]byte, size)
+	n, err := m.MarshalTo(data)
+	if err != nil {
+		return nil, err
+	}
+	return data[:n], nil
+}
+
+func (m *CheckConstraint) MarshalTo(data []byte) (n int, err error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	data[i] = 0xa
+	i++
+	i = encodeVarintStructured(data, i, uint64(len(m.Name)))
+	i += copy(data[i:], m.Name)
+	data[i] = 0x12
+	i++
+	i = encodeVarintStructured(data, i, uint64(len(m.Expr)))
+	i += copy(data[i:], m.Expr)
+	if m.XXX_unrecognized != nil {
+		i += copy(data[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *ForeignKeyReference) Marshal() (data []byte, err error) {
+	size := m.Size()
+	data = make([]byte, size)
+	n, err := m.MarshalTo(data)
+	if err != nil {
+		return nil, err
+	}
+	return data[:n], nil
+}
+
+func (m *ForeignKeyReference) MarshalTo(data []byte) (n int, err error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	data[i] = 0xa
+	i++
+	i = encodeVarintStructured(data, i, uint64(len(m.Name)))
+	i += copy(data[i:], m.Name)
+	if len(m.ColumnIDs) > 0 {
+		for _, num := range m.ColumnIDs {
+			data[i] = 0x10
+			i++
+			i = encodeVarintStructured(data, i, uint64(num))
+		}
+	}
+	data[i] = 0x18
+	i++
+	i = encodeVarintStructured(data, i, uint64(m.Table))
+	data[i] = 0x20
+	i++
+	i = encodeVarintStructured(data, i, uint64(m.Index))
+	data[i] = 0x28
+	i++
+	i = encodeVarintStructured(data, i, uint64(m.OnDelete))
+	data[i] = 0x30
+	i++
+	i = encodeVarintStructured(data, i, uint64(m.OnUpdate))
+	if m.XXX_unrecognized != nil {
+		i += copy(data[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
 func (m *TableDescriptor) Marshal() (data []byte, err error) {
 	size := m.Size()
 	data = make([]byte, size)
@@ -1559,6 +2282,37 @@ func (m *TableDescriptor) MarshalTo(data []byte) (n int, err error) {
 		return 0, err
 	}
 	i += n3
+	if len(m.Checks) > 0 {
+		for _, msg := range m.Checks {
+			data[i] = 0x4a
+			i++
+			i = encodeVarintStructured(data, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(data[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if len(m.ForeignKeys) > 0 {
+		for _, msg := range m.ForeignKeys {
+			data[i] = 0x52
+			i++
+			i = encodeVarintStructured(data, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(data[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if len(m.ReferencedBy) > 0 {
+		for _, num := range m.ReferencedBy {
+			data[i] = 0x58
+			i++
+			i = encodeVarintStructured(data, i, uint64(num))
+		}
+	}
 	if m.XXX_unrecognized != nil {
 		i += copy(data[i:], m.XXX_unrecognized)
 	}
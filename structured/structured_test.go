@@ -230,6 +230,62 @@ func TestValidateTableDesc(t *testing.T) {
 				NextColumnID: 2,
 				NextIndexID:  2,
 			}},
+		{`empty check constraint name`,
+			TableDescriptor{
+				ID:   1,
+				Name: "foo",
+				Columns: []ColumnDescriptor{
+					{ID: 1, Name: "bar"},
+				},
+				PrimaryIndex: IndexDescriptor{ID: 1, Name: "bar", ColumnIDs: []ID{1}, ColumnNames: []string{"bar"}},
+				NextColumnID: 2,
+				NextIndexID:  2,
+				Checks:       []CheckConstraint{{Expr: "bar > 0"}},
+			}},
+		{`duplicate check constraint name: "baz"`,
+			TableDescriptor{
+				ID:   1,
+				Name: "foo",
+				Columns: []ColumnDescriptor{
+					{ID: 1, Name: "bar"},
+				},
+				PrimaryIndex: IndexDescriptor{ID: 1, Name: "bar", ColumnIDs: []ID{1}, ColumnNames: []string{"bar"}},
+				NextColumnID: 2,
+				NextIndexID:  2,
+				Checks: []CheckConstraint{
+					{Name: "baz", Expr: "bar > 0"},
+					{Name: "baz", Expr: "bar < 10"},
+				},
+			}},
+		{`duplicate foreign key name: "baz"`,
+			TableDescriptor{
+				ID:   1,
+				Name: "foo",
+				Columns: []ColumnDescriptor{
+					{ID: 1, Name: "bar"},
+				},
+				PrimaryIndex: IndexDescriptor{ID: 1, Name: "bar", ColumnIDs: []ID{1}, ColumnNames: []string{"bar"}},
+				NextColumnID: 2,
+				NextIndexID:  2,
+				ForeignKeys: []ForeignKeyReference{
+					{Name: "baz", ColumnIDs: []ID{1}, Table: 2, Index: 1},
+					{Name: "baz", ColumnIDs: []ID{1}, Table: 3, Index: 1},
+				},
+			}},
+		{`foreign key "baz" contains unknown column ID 2`,
+			TableDescriptor{
+				ID:   1,
+				Name: "foo",
+				Columns: []ColumnDescriptor{
+					{ID: 1, Name: "bar"},
+				},
+				PrimaryIndex: IndexDescriptor{ID: 1, Name: "bar", ColumnIDs: []ID{1}, ColumnNames: []string{"bar"}},
+				NextColumnID: 2,
+				NextIndexID:  2,
+				ForeignKeys: []ForeignKeyReference{
+					{Name: "baz", ColumnIDs: []ID{2}, Table: 2, Index: 1},
+				},
+			}},
 	}
 	for i, d := range testData {
 		if err := d.desc.Validate(); err == nil {
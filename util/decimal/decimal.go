@@ -0,0 +1,316 @@
+// Copyright 2015 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License. See the AUTHORS file
+// for names of contributors.
+
+// Package decimal implements arbitrary-precision decimal numbers.
+package decimal
+
+import (
+	"fmt"
+	"math"
+	"math/big"
+	"strconv"
+	"strings"
+)
+
+// Decimal is an arbitrary-precision decimal number. The value of a Decimal is
+// unscaled * 10^-scale. Decimals are immutable: none of the operations modify
+// their receiver or arguments. The zero value is 0.
+type Decimal struct {
+	unscaled *big.Int
+	scale    int32
+}
+
+var bigOne = big.NewInt(1)
+var bigTen = big.NewInt(10)
+
+// pow10 returns 10^n for n >= 0.
+func pow10(n int32) *big.Int {
+	return new(big.Int).Exp(bigTen, big.NewInt(int64(n)), nil)
+}
+
+// New returns the decimal unscaled * 10^-scale.
+func New(unscaled int64, scale int32) Decimal {
+	return Decimal{unscaled: big.NewInt(unscaled), scale: scale}
+}
+
+// NewFromBigInt returns the decimal unscaled * 10^-scale.
+func NewFromBigInt(unscaled *big.Int, scale int32) Decimal {
+	return Decimal{unscaled: new(big.Int).Set(unscaled), scale: scale}
+}
+
+// NewFromFloat returns the decimal with the shortest representation which
+// converts back to the float. NaN and infinite values cannot be represented.
+func NewFromFloat(f float64) (Decimal, error) {
+	if math.IsNaN(f) || math.IsInf(f, 0) {
+		return Decimal{}, fmt.Errorf("%v cannot be represented as a decimal", f)
+	}
+	return Parse(strconv.FormatFloat(f, 'g', -1, 64))
+}
+
+// MaxScale bounds the scale accepted by Parse, in both directions. Without
+// it an exponent such as 1e2000000000 would expand to an enormous number of
+// digits.
+const MaxScale = 16383
+
+// Parse parses a decimal from its string representation: an optional sign,
+// digits with an optional decimal point and an optional exponent, such as
+// "-12.50" or "1.5e-3". The scale of the result is the number of digits after
+// the decimal point less the exponent, but is never negative.
+func Parse(s string) (Decimal, error) {
+	str := s
+	var exp int64
+	if i := strings.IndexAny(str, "eE"); i >= 0 {
+		var err error
+		if exp, err = strconv.ParseInt(str[i+1:], 10, 32); err != nil {
+			return Decimal{}, fmt.Errorf("invalid decimal: %q", s)
+		}
+		str = str[:i]
+	}
+	var frac string
+	if i := strings.IndexByte(str, '.'); i >= 0 {
+		str, frac = str[:i], str[i+1:]
+	}
+	digits := str + frac
+	if len(digits) > 0 && (digits[0] == '-' || digits[0] == '+') {
+		digits = digits[1:]
+	}
+	if len(digits) == 0 || strings.IndexByte(frac, '-') >= 0 || strings.IndexByte(frac, '+') >= 0 {
+		return Decimal{}, fmt.Errorf("invalid decimal: %q", s)
+	}
+	for _, c := range digits {
+		if c < '0' || c > '9' {
+			return Decimal{}, fmt.Errorf("invalid decimal: %q", s)
+		}
+	}
+	unscaled, ok := new(big.Int).SetString(str+frac, 10)
+	if !ok {
+		return Decimal{}, fmt.Errorf("invalid decimal: %q", s)
+	}
+	scale := int64(len(frac)) - exp
+	if scale < -MaxScale || scale > MaxScale {
+		return Decimal{}, fmt.Errorf("decimal %q out of range", s)
+	}
+	d := Decimal{unscaled: unscaled, scale: int32(scale)}
+	if d.scale < 0 {
+		d = d.Round(0)
+	}
+	return d, nil
+}
+
+func (d Decimal) int() *big.Int {
+	if d.unscaled == nil {
+		return new(big.Int)
+	}
+	return d.unscaled
+}
+
+// Unscaled returns the unscaled value of the decimal.
+func (d Decimal) Unscaled() *big.Int {
+	return new(big.Int).Set(d.int())
+}
+
+// Scale returns the scale of the decimal: the number of digits after the
+// decimal point.
+func (d Decimal) Scale() int32 {
+	return d.scale
+}
+
+// Sign returns -1, 0 or +1 depending on whether the decimal is negative, zero
+// or positive.
+func (d Decimal) Sign() int {
+	return d.int().Sign()
+}
+
+// Precision returns the number of significant digits of the unscaled value.
+// The precision of zero is 1.
+func (d Decimal) Precision() int {
+	u := d.int()
+	if u.Sign() == 0 {
+		return 1
+	}
+	return len(new(big.Int).Abs(u).String())
+}
+
+// rescale returns the unscaled values of x and y at the larger of their scales
+// along with that scale.
+func rescale(x, y Decimal) (*big.Int, *big.Int, int32) {
+	switch {
+	case x.scale < y.scale:
+		return new(big.Int).Mul(x.int(), pow10(y.scale-x.scale)), y.int(), y.scale
+	case x.scale > y.scale:
+		return x.int(), new(big.Int).Mul(y.int(), pow10(x.scale-y.scale)), x.scale
+	}
+	return x.int(), y.int(), x.scale
+}
+
+// Cmp returns -1, 0 or +1 depending on whether d is less than, equal to or
+// greater than o.
+func (d Decimal) Cmp(o Decimal) int {
+	x, y, _ := rescale(d, o)
+	return x.Cmp(y)
+}
+
+// Neg returns -d.
+func (d Decimal) Neg() Decimal {
+	return Decimal{unscaled: new(big.Int).Neg(d.int()), scale: d.scale}
+}
+
+// Add returns d + o. The scale of the result is the larger of the scales of
+// the operands.
+func (d Decimal) Add(o Decimal) Decimal {
+	x, y, scale := rescale(d, o)
+	return Decimal{unscaled: new(big.Int).Add(x, y), scale: scale}
+}
+
+// Sub returns d - o. The scale of the result is the larger of the scales of
+// the operands.
+func (d Decimal) Sub(o Decimal) Decimal {
+	x, y, scale := rescale(d, o)
+	return Decimal{unscaled: new(big.Int).Sub(x, y), scale: scale}
+}
+
+// Mul returns d * o. The scale of the result is the sum of the scales of the
+// operands.
+func (d Decimal) Mul(o Decimal) Decimal {
+	return Decimal{unscaled: new(big.Int).Mul(d.int(), o.int()), scale: d.scale + o.scale}
+}
+
+// Quo returns d / o rounded half away from zero to the given scale. Quo
+// panics if o is zero.
+func (d Decimal) Quo(o Decimal, scale int32) Decimal {
+	num := new(big.Int).Set(d.int())
+	den := new(big.Int).Set(o.int())
+	if shift := scale - d.scale + o.scale; shift >= 0 {
+		num.Mul(num, pow10(shift))
+	} else {
+		den.Mul(den, pow10(-shift))
+	}
+	return Decimal{unscaled: roundQuo(num, den), scale: scale}
+}
+
+// Rem returns the remainder of d / o, where the quotient is truncated towards
+// zero. The result has the sign of d. Rem panics if o is zero.
+func (d Decimal) Rem(o Decimal) Decimal {
+	x, y, scale := rescale(d, o)
+	return Decimal{unscaled: new(big.Int).Rem(x, y), scale: scale}
+}
+
+// Pow returns d^n. The scale of the result is n times the scale of d. Pow
+// panics if n is negative.
+func (d Decimal) Pow(n int64) Decimal {
+	if n < 0 {
+		panic(fmt.Sprintf("negative exponent %d", n))
+	}
+	return Decimal{
+		unscaled: new(big.Int).Exp(d.int(), big.NewInt(n), nil),
+		scale:    d.scale * int32(n),
+	}
+}
+
+// Sqrt returns the square root of d rounded half away from zero to the given
+// scale. Sqrt panics if d is negative.
+func (d Decimal) Sqrt(scale int32) Decimal {
+	if d.Sign() < 0 {
+		panic(fmt.Sprintf("square root of negative number %s", d))
+	}
+	// Compute the square root with one extra digit, truncated, which is
+	// enough to round the result correctly.
+	u := new(big.Int).Set(d.int())
+	if shift := 2*(scale+1) - d.scale; shift >= 0 {
+		u.Mul(u, pow10(shift))
+	} else {
+		u.Quo(u, pow10(-shift))
+	}
+	r := u.Sqrt(u)
+	return Decimal{unscaled: roundQuo(r, bigTen), scale: scale}
+}
+
+// Round returns d rounded half away from zero to the given scale.
+func (d Decimal) Round(scale int32) Decimal {
+	if scale >= d.scale {
+		return Decimal{unscaled: new(big.Int).Mul(d.int(), pow10(scale-d.scale)), scale: scale}
+	}
+	return Decimal{unscaled: roundQuo(d.int(), pow10(d.scale-scale)), scale: scale}
+}
+
+// roundQuo returns num / den rounded half away from zero.
+func roundQuo(num, den *big.Int) *big.Int {
+	q, r := new(big.Int).QuoRem(num, den, new(big.Int))
+	if r.Sign() == 0 {
+		return q
+	}
+	// Round away from zero if the remainder is at least half the denominator.
+	if new(big.Int).Abs(new(big.Int).Lsh(r, 1)).Cmp(new(big.Int).Abs(den)) >= 0 {
+		if num.Sign() == den.Sign() {
+			q.Add(q, bigOne)
+		} else {
+			q.Sub(q, bigOne)
+		}
+	}
+	return q
+}
+
+// Reduce returns d with the trailing zeros after the decimal point removed.
+func (d Decimal) Reduce() Decimal {
+	u := new(big.Int).Set(d.int())
+	scale := d.scale
+	if u.Sign() == 0 {
+		return Decimal{unscaled: u}
+	}
+	q, r := new(big.Int), new(big.Int)
+	for scale > 0 {
+		q.QuoRem(u, bigTen, r)
+		if r.Sign() != 0 {
+			break
+		}
+		u.Set(q)
+		scale--
+	}
+	return Decimal{unscaled: u, scale: scale}
+}
+
+// Int64 returns d rounded half away from zero to an integer, and whether the
+// integer fits in an int64.
+func (d Decimal) Int64() (int64, bool) {
+	u := d.Round(0).int()
+	if u.BitLen() > 63 {
+		return 0, false
+	}
+	return u.Int64(), true
+}
+
+// Float64 returns the float nearest to d.
+func (d Decimal) Float64() float64 {
+	f, _ := strconv.ParseFloat(d.String(), 64)
+	return f
+}
+
+// String returns the representation of d with exactly d.Scale() digits after
+// the decimal point.
+func (d Decimal) String() string {
+	s := new(big.Int).Abs(d.int()).String()
+	if d.scale <= 0 {
+		s += strings.Repeat("0", int(-d.scale))
+	} else {
+		if n := int(d.scale) + 1 - len(s); n > 0 {
+			s = strings.Repeat("0", n) + s
+		}
+		s = s[:len(s)-int(d.scale)] + "." + s[len(s)-int(d.scale):]
+	}
+	if d.Sign() < 0 {
+		return "-" + s
+	}
+	return s
+}
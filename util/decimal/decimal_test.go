@@ -0,0 +1,213 @@
+// Copyright 2015 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License. See the AUTHORS file
+// for names of contributors.
+
+package decimal
+
+import "testing"
+
+func mustParse(t *testing.T, s string) Decimal {
+	d, err := Parse(s)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return d
+}
+
+func TestParse(t *testing.T) {
+	testData := []struct {
+		s        string
+		expected string
+		scale    int32
+	}{
+		{"0", "0", 0},
+		{"-0", "0", 0},
+		{"12", "12", 0},
+		{"+12", "12", 0},
+		{"-12.50", "-12.50", 2},
+		{".5", "0.5", 1},
+		{"-.05", "-0.05", 2},
+		{"5.", "5", 0},
+		{"1.5e3", "1500", 0},
+		{"1.5e-3", "0.0015", 4},
+		{"-2E+2", "-200", 0},
+		{"123456789012345678901234567890.123456789", "123456789012345678901234567890.123456789", 9},
+	}
+	for i, d := range testData {
+		v := mustParse(t, d.s)
+		if s := v.String(); s != d.expected {
+			t.Errorf("%d: expected %s, but found %s", i, d.expected, s)
+		}
+		if v.Scale() != d.scale {
+			t.Errorf("%d: expected scale %d, but found %d", i, d.scale, v.Scale())
+		}
+	}
+
+	if v := mustParse(t, "1e16383"); v.Precision() != 16384 {
+		t.Errorf("expected precision 16384, but found %d", v.Precision())
+	}
+	if v := mustParse(t, "1e-16383"); v.Scale() != 16383 {
+		t.Errorf("expected scale 16383, but found %d", v.Scale())
+	}
+
+	for _, s := range []string{"", "-", ".", "1.2.3", "1e", "e5", "1.-5", "abc", "1 2", "--5",
+		"1e16384", "1e-16384", "1e2000000000", "1e-2000000000", "1e99999999999"} {
+		if _, err := Parse(s); err == nil {
+			t.Errorf("%q: expected error, but found success", s)
+		}
+	}
+}
+
+func TestArithmetic(t *testing.T) {
+	testData := []struct {
+		x, y               string
+		add, sub, mul, quo string
+		rem                string
+		cmp                int
+	}{
+		{"1", "3", "4", "-2", "3", "0.3333", "1", -1},
+		{"2.50", "0.1", "2.60", "2.40", "0.250", "25.0000", "0.00", 1},
+		{"-7.5", "2", "-5.5", "-9.5", "-15.0", "-3.7500", "-1.5", -1},
+		{"0.10", "0.1", "0.20", "0.00", "0.010", "1.0000", "0.00", 0},
+		{"2", "-3", "-1", "5", "-6", "-0.6667", "2", 1},
+	}
+	for i, d := range testData {
+		x, y := mustParse(t, d.x), mustParse(t, d.y)
+		if s := x.Add(y).String(); s != d.add {
+			t.Errorf("%d: %s + %s: expected %s, but found %s", i, d.x, d.y, d.add, s)
+		}
+		if s := x.Sub(y).String(); s != d.sub {
+			t.Errorf("%d: %s - %s: expected %s, but found %s", i, d.x, d.y, d.sub, s)
+		}
+		if s := x.Mul(y).String(); s != d.mul {
+			t.Errorf("%d: %s * %s: expected %s, but found %s", i, d.x, d.y, d.mul, s)
+		}
+		if s := x.Quo(y, 4).String(); s != d.quo {
+			t.Errorf("%d: %s / %s: expected %s, but found %s", i, d.x, d.y, d.quo, s)
+		}
+		if s := x.Rem(y).String(); s != d.rem {
+			t.Errorf("%d: %s %% %s: expected %s, but found %s", i, d.x, d.y, d.rem, s)
+		}
+		if c := x.Cmp(y); c != d.cmp {
+			t.Errorf("%d: cmp(%s, %s): expected %d, but found %d", i, d.x, d.y, d.cmp, c)
+		}
+	}
+}
+
+func TestRound(t *testing.T) {
+	testData := []struct {
+		s        string
+		scale    int32
+		expected string
+	}{
+		{"1.2345", 2, "1.23"},
+		{"1.235", 2, "1.24"},
+		{"-1.235", 2, "-1.24"},
+		{"0.5", 0, "1"},
+		{"-0.5", 0, "-1"},
+		{"0.49", 0, "0"},
+		{"1.5", 3, "1.500"},
+		{"99.995", 2, "100.00"},
+	}
+	for i, d := range testData {
+		if s := mustParse(t, d.s).Round(d.scale).String(); s != d.expected {
+			t.Errorf("%d: expected %s, but found %s", i, d.expected, s)
+		}
+	}
+}
+
+func TestPow(t *testing.T) {
+	testData := []struct {
+		s        string
+		n        int64
+		expected string
+	}{
+		{"2", 10, "1024"},
+		{"1.5", 2, "2.25"},
+		{"-0.1", 3, "-0.001"},
+		{"7.25", 0, "1"},
+		{"0", 0, "1"},
+	}
+	for i, d := range testData {
+		if s := mustParse(t, d.s).Pow(d.n).String(); s != d.expected {
+			t.Errorf("%d: expected %s, but found %s", i, d.expected, s)
+		}
+	}
+}
+
+func TestSqrt(t *testing.T) {
+	testData := []struct {
+		s        string
+		scale    int32
+		expected string
+	}{
+		{"4", 2, "2.00"},
+		{"2", 10, "1.4142135624"},
+		{"0.0001", 2, "0.01"},
+		{"0.0001", 1, "0.0"},
+		{"1000000", 0, "1000"},
+		{"0.25", 1, "0.5"},
+		{"0", 3, "0.000"},
+		{"2.25", 0, "2"},
+		{"6.25", 0, "3"},
+	}
+	for i, d := range testData {
+		if s := mustParse(t, d.s).Sqrt(d.scale).String(); s != d.expected {
+			t.Errorf("%d: expected %s, but found %s", i, d.expected, s)
+		}
+	}
+}
+
+func TestReduce(t *testing.T) {
+	testData := []struct {
+		s        string
+		expected string
+	}{
+		{"0.000", "0"},
+		{"1.500", "1.5"},
+		{"-100.00", "-100"},
+		{"0.0010", "0.001"},
+	}
+	for i, d := range testData {
+		if s := mustParse(t, d.s).Reduce().String(); s != d.expected {
+			t.Errorf("%d: expected %s, but found %s", i, d.expected, s)
+		}
+	}
+}
+
+func TestConversions(t *testing.T) {
+	if p := mustParse(t, "-123.450").Precision(); p != 6 {
+		t.Errorf("expected precision 6, but found %d", p)
+	}
+	if i, ok := mustParse(t, "-2.5").Int64(); !ok || i != -3 {
+		t.Errorf("expected -3, but found %d", i)
+	}
+	if _, ok := mustParse(t, "9223372036854775808").Int64(); ok {
+		t.Errorf("expected overflow")
+	}
+	if f := mustParse(t, "0.125").Float64(); f != 0.125 {
+		t.Errorf("expected 0.125, but found %v", f)
+	}
+	d, err := NewFromFloat(0.1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if s := d.String(); s != "0.1" {
+		t.Errorf("expected 0.1, but found %s", s)
+	}
+	var zero Decimal
+	if s := zero.Add(New(15, 1)).String(); s != "1.5" {
+		t.Errorf("expected 1.5, but found %s", s)
+	}
+}
@@ -26,6 +26,7 @@ import (
 	"math"
 	"reflect"
 	"sync"
+	"time"
 	"unsafe"
 )
 
@@ -539,6 +540,28 @@ func DecodeBytesDecreasing(b []byte, r []byte) ([]byte, []byte) {
 	return b, r
 }
 
+// EncodeTime encodes a time value, appends it to the supplied buffer,
+// and returns the final buffer. The encoding is guaranteed to be
+// ordered such that if t1.Before(t2) then after EncodeTime(b1, t1)
+// and EncodeTime(b2, t2), bytes.Compare(b1, b2) < 0. The time zone
+// offset is not included in the encoding.
+func EncodeTime(b []byte, t time.Time) []byte {
+	// Encode the seconds followed by the nanoseconds. Nanoseconds are
+	// always in the range [0, 1e9) so the combination of the two sorts
+	// in time order.
+	b = EncodeVarint(b, t.Unix())
+	return EncodeVarint(b, int64(t.Nanosecond()))
+}
+
+// DecodeTime decodes a time.Time value which was encoded using
+// EncodeTime. The remainder of the input buffer and the decoded
+// time.Time are returned. The returned time is in UTC.
+func DecodeTime(b []byte) ([]byte, time.Time) {
+	b, sec := DecodeVarint(b)
+	b, nsec := DecodeVarint(b)
+	return b, time.Unix(sec, nsec).UTC()
+}
+
 func parseVerb(format string, i int) (verb byte, ascending bool, width int, newI int) {
 	if format[i] != '%' {
 		panic("invalid format string: " + format)
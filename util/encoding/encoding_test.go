@@ -24,6 +24,7 @@ import (
 	"reflect"
 	"regexp"
 	"testing"
+	"time"
 
 	"github.com/cockroachdb/cockroach/util/randutil"
 )
@@ -539,6 +540,61 @@ func TestEncodeDecodeBytesDecreasing(t *testing.T) {
 	}
 }
 
+func TestEncodeDecodeTime(t *testing.T) {
+	zeroTime := time.Unix(0, 0)
+
+	// The test cases are negative, increasing, duration offsets from
+	// zeroTime. The zero offset and the positive, increasing, offsets are
+	// generated below.
+	testCases := []string{
+		"-1345600h45m34s234ms",
+		"-600h45m34s234ms",
+		"-590h47m34s234ms",
+		"-310h45m34s234ms",
+		"-310h45m34s233ms",
+		"-25h45m34s234ms",
+		"-23h45m35s",
+		"-23h45m34s999999999ns",
+		"-23h45m34s234ms",
+		"-23h45m34s101ms",
+		"-23h45m34s1ns",
+		"-23h45m34s",
+		"-23h45m33s901ms",
+		"-23h45m",
+		"-23h",
+		"-23612ms",
+		"-345ms",
+		"-1ms",
+		"-1us",
+		"-1ns",
+	}
+	n := len(testCases)
+	testCases = append(testCases, "0")
+	for i := n - 1; i >= 0; i-- {
+		testCases = append(testCases, testCases[i][1:])
+	}
+	var lastEncoded []byte
+	for i, c := range testCases {
+		d, err := time.ParseDuration(c)
+		if err != nil {
+			t.Fatal(err)
+		}
+		current := zeroTime.Add(d).UTC()
+		enc := EncodeTime(nil, current)
+		if i > 0 && bytes.Compare(lastEncoded, enc) >= 0 {
+			t.Errorf("%s: expected [% x] to be less than [% x]", c, lastEncoded, enc)
+		}
+		remainder, dec := DecodeTime(append(enc, "remainder"...))
+		if !dec.Equal(current) {
+			t.Errorf("%s: unexpected decoding mismatch: %v != %v", c, dec, current)
+		}
+		if string(remainder) != "remainder" {
+			t.Errorf("%s: unexpected remaining bytes: %q", c, remainder)
+		}
+		lastEncoded = enc
+	}
+}
+
 func TestEncodeDecodeKey(t *testing.T) {
 	testCases := []struct {
 		format   string
@@ -26,7 +26,10 @@ import (
 	"bytes"
 	"fmt"
 	"math"
+	"math/big"
 	"strconv"
+
+	"github.com/cockroachdb/cockroach/util/decimal"
 )
 
 // Direct mappings or prefixes of encoded data dependent on the type.
@@ -249,6 +252,80 @@ func DecodeNumericFloat(buf []byte) ([]byte, float64) {
 	}
 }
 
+// EncodeDecimal returns the resulting byte slice with the encoded decimal
+// appended to b. The encoding is that of EncodeNumericFloat, so the encoded
+// decimals are comparable with the encoded ints and floats. Decimals which are
+// equal have the same encoding regardless of their scale.
+func EncodeDecimal(b []byte, d decimal.Decimal) []byte {
+	if d.Sign() == 0 {
+		return append(b, orderedEncodingZero)
+	}
+	e, m := decimalMandE(d)
+	buf := make([]byte, len(m)+maxVarintSize+2)
+	switch {
+	case e < 0:
+		return append(b, encodeSmallNumber(d.Sign() < 0, e, m, buf)...)
+	case e >= 0 && e <= 10:
+		return append(b, encodeMediumNumber(d.Sign() < 0, e, m, buf)...)
+	}
+	return append(b, encodeLargeNumber(d.Sign() < 0, e, m, buf)...)
+}
+
+// DecodeDecimal returns the remaining byte slice after decoding and the
+// decoded decimal from buf. The decoded decimal has no trailing zeros after
+// the decimal point.
+func DecodeDecimal(buf []byte) ([]byte, decimal.Decimal) {
+	if buf[0] == orderedEncodingZero {
+		return buf[1:], decimal.Decimal{}
+	}
+	idx := bytes.Index(buf, []byte{orderedEncodingTerminator})
+	switch {
+	case buf[0] == 0x08:
+		// Negative large.
+		e, m := decodeLargeNumber(true, buf[:idx+1])
+		return buf[idx+1:], makeDecimalFromMandE(true, e, m)
+	case buf[0] > 0x08 && buf[0] <= 0x13:
+		// Negative medium.
+		e, m := decodeMediumNumber(true, buf[:idx+1])
+		return buf[idx+1:], makeDecimalFromMandE(true, e, m)
+	case buf[0] == 0x14:
+		// Negative small.
+		e, m := decodeSmallNumber(true, buf[:idx+1])
+		return buf[idx+1:], makeDecimalFromMandE(true, e, m)
+	case buf[0] == 0x22:
+		// Positive large.
+		e, m := decodeLargeNumber(false, buf[:idx+1])
+		return buf[idx+1:], makeDecimalFromMandE(false, e, m)
+	case buf[0] >= 0x17 && buf[0] < 0x22:
+		// Positive medium.
+		e, m := decodeMediumNumber(false, buf[:idx+1])
+		return buf[idx+1:], makeDecimalFromMandE(false, e, m)
+	case buf[0] == 0x16:
+		// Positive small.
+		e, m := decodeSmallNumber(false, buf[:idx+1])
+		return buf[idx+1:], makeDecimalFromMandE(false, e, m)
+	default:
+		panic(fmt.Sprintf("unknown prefix of the encoded byte slice: %q", buf))
+	}
+}
+
+// decimalMandE computes and returns the mantissa M and exponent E for d. See
+// floatMandE for the representation of M.
+func decimalMandE(d decimal.Decimal) (int, []byte) {
+	d = d.Reduce()
+	digits := []byte(new(big.Int).Abs(d.Unscaled()).String())
+	e10 := len(digits) - 1 - int(d.Scale())
+	// Trailing zeros of an integer remain after reducing the decimal.
+	digits = bytes.TrimRight(digits, "0")
+	b := make([]byte, 0, len(digits)+1)
+	b = append(b, digits[0])
+	if len(digits) > 1 {
+		b = append(b, '.')
+		b = append(b, digits[1:]...)
+	}
+	return digitsMandE(b, e10)
+}
+
 // floatMandE computes and returns the mantissa M and exponent E for f.
 //
 // The mantissa is a base-100 representation of the value. The exponent E
@@ -294,8 +371,14 @@ func floatMandE(f float64) (int, []byte) {
 	}
 
 	// Strip off the exponent.
-	b = b[:e]
+	return digitsMandE(b[:e], e10)
+}
 
+// digitsMandE computes and returns the mantissa M and exponent E for the
+// positive value d.dddd * 10^e10, where b holds the significant digits in the
+// form "d.dddd", or "d" if there is a single digit. There must be no trailing
+// zeros after the decimal point. The slice b is modified.
+func digitsMandE(b []byte, e10 int) (int, []byte) {
 	// Move all of the digits after the decimal and prepend a leading 0.
 	if len(b) > 1 {
 		// "d.dddd" -> "dddddd"
@@ -345,6 +428,26 @@ func floatMandE(f float64) (int, []byte) {
 // formatting the floating point number to a string and then using the standard
 // library to parse it.
 func makeFloatFromMandE(negative bool, e int, m []byte) float64 {
+	f, err := strconv.ParseFloat(string(formatMandE(negative, e, m)), 64)
+	if err != nil {
+		panic(err)
+	}
+	return f
+}
+
+// makeDecimalFromMandE reconstructs the decimal from the mantissa M and
+// exponent E. The decimal has no trailing zeros after the decimal point.
+func makeDecimalFromMandE(negative bool, e int, m []byte) decimal.Decimal {
+	d, err := decimal.Parse(string(formatMandE(negative, e, m)))
+	if err != nil {
+		panic(err)
+	}
+	return d.Reduce()
+}
+
+// formatMandE formats the value of the mantissa M and exponent E as a base-10
+// number in exponent notation.
+func formatMandE(negative bool, e int, m []byte) []byte {
 	// ±.dddde±dd.
 	b := make([]byte, 0, len(m)*2+6)
 	if negative {
@@ -369,7 +472,7 @@ func makeFloatFromMandE(negative bool, e int, m []byte) float64 {
 		b = append(b, '+')
 	}
 
-	var buf [3]byte
+	var buf [10]byte
 	i := len(buf)
 	for e >= 10 {
 		i--
@@ -379,12 +482,7 @@ func makeFloatFromMandE(negative bool, e int, m []byte) float64 {
 	i--
 	buf[i] = byte(e + '0')
 
-	b = append(b, buf[i:]...)
-	f, err := strconv.ParseFloat(string(b), 64)
-	if err != nil {
-		panic(err)
-	}
-	return f
+	return append(b, buf[i:]...)
 }
 
 func encodeSmallNumber(negative bool, e int, m []byte, buf []byte) []byte {
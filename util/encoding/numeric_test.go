@@ -22,6 +22,7 @@ import (
 	"math"
 	"testing"
 
+	"github.com/cockroachdb/cockroach/util/decimal"
 	"github.com/cockroachdb/cockroach/util/randutil"
 )
 
@@ -232,6 +233,67 @@ func TestEncodeNumericFloat(t *testing.T) {
 	}
 }
 
+func TestEncodeDecimal(t *testing.T) {
+	testCases := []struct {
+		Value    string
+		Encoding []byte
+	}{
+		{"-123456789012345678901234567890", []byte{0x08, 0xf0, 0xe6, 0xba, 0x8e, 0x62, 0x4a, 0xe6, 0xba, 0x8e, 0x62, 0x4a, 0xe6, 0xba, 0x8e, 0x62, 0x4b, 0x00}},
+		{"-10000.00", []byte{0x10, 0xfd, 0x0}},
+		{"-9999", []byte{0x11, 0x38, 0x39, 0x00}},
+		{"-1.00000000000000000001", []byte{0x12, 0xfc, 0xfe, 0xfe, 0xfe, 0xfe, 0xfe, 0xfe, 0xfe, 0xfe, 0xfe, 0xfd, 0x00}},
+		{"-1", []byte{0x12, 0xfd, 0x0}},
+		{"-0.00123", []byte{0x14, 0x1, 0xe6, 0xc3, 0x0}},
+		{"0.000", []byte{0x15}},
+		{"0.00123", []byte{0x16, 0xfe, 0x19, 0x3c, 0x0}},
+		{"0.123", []byte{0x17, 0x19, 0x3c, 0x0}},
+		{"1", []byte{0x18, 0x02, 0x0}},
+		{"1.00000000000000000001", []byte{0x18, 0x03, 0x01, 0x01, 0x01, 0x01, 0x01, 0x01, 0x01, 0x01, 0x01, 0x02, 0x00}},
+		{"12.345", []byte{0x18, 0x19, 0x45, 0x64, 0x0}},
+		{"99.0001", []byte{0x18, 0xc7, 0x01, 0x02, 0x0}},
+		{"100.00", []byte{0x19, 0x02, 0x0}},
+		{"1234.50", []byte{0x19, 0x19, 0x45, 0x64, 0x0}},
+		{"123450", []byte{0x1a, 0x19, 0x45, 0x64, 0x0}},
+		{"123456789012345678901234567890", []byte{0x22, 0x0f, 0x19, 0x45, 0x71, 0x9d, 0xb5, 0x19, 0x45, 0x71, 0x9d, 0xb5, 0x19, 0x45, 0x71, 0x9d, 0xb4, 0x00}},
+	}
+
+	for i, c := range testCases {
+		d, err := decimal.Parse(c.Value)
+		if err != nil {
+			t.Fatal(err)
+		}
+		enc := EncodeDecimal(nil, d)
+		if !bytes.Equal(enc, c.Encoding) {
+			t.Errorf("unexpected mismatch for %v. expected [% x], got [% x]",
+				c.Value, c.Encoding, enc)
+		}
+		if i > 0 {
+			if bytes.Compare(testCases[i-1].Encoding, enc) >= 0 {
+				t.Errorf("%v: expected [% x] to be less than [% x]",
+					c.Value, testCases[i-1].Encoding, enc)
+			}
+		}
+		rem, dec := DecodeDecimal(append(enc, 0xff))
+		if dec.Cmp(d) != 0 || dec.String() != d.Reduce().String() {
+			t.Errorf("unexpected mismatch for %v. got %v", c.Value, dec)
+		}
+		if !bytes.Equal(rem, []byte{0xff}) {
+			t.Errorf("unexpected remaining bytes: % x", rem)
+		}
+	}
+
+	// The decimals are encoded the same as the equal floats.
+	for _, f := range []float64{-1e308, -9999.0, -0.00123, 1e-307, 0.0123, 12.345, 9999.000009, 123450, 1e308} {
+		d, err := decimal.NewFromFloat(f)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if enc, expected := EncodeDecimal(nil, d), EncodeNumericFloat(nil, f); !bytes.Equal(enc, expected) {
+			t.Errorf("%v: expected [% x], got [% x]", f, expected, enc)
+		}
+	}
+}
+
 func BenchmarkEncodeNumericInt(b *testing.B) {
 	rng, _ := randutil.NewPseudoRand()
 